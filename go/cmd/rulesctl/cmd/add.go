@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -19,7 +20,11 @@ var (
 	addOptAction      string
 	addOptPlans       []string
 	addOptTables      []string
+	addOptFingerprint []string
 	addOptQueryRE     string
+	addOptMaxQPS      int
+	addOptMaxConc     int
+	addOptDelay       time.Duration
 	// TODO: other stuff, bind vars etc
 )
 
@@ -36,10 +41,32 @@ func runAdd(cmd *cobra.Command, args []string) {
 		rule.AddTableCond(t)
 	}
 
+	for _, fp := range addOptFingerprint {
+		rule.AddFingerprintCond(fp)
+	}
+
 	if err := rule.SetQueryCond(addOptQueryRE); err != nil {
 		log.Fatalf("Query condition invalid '%v': %v", addOptQueryRE, err)
 	}
 
+	switch ruleAction {
+	case vtrules.QRThrottle:
+		if addOptMaxQPS <= 0 {
+			log.Fatalf("The throttle action requires a positive --max-qps")
+		}
+		rule.SetMaxQPS(addOptMaxQPS)
+	case vtrules.QRConcurrency:
+		if addOptMaxConc <= 0 {
+			log.Fatalf("The concurrency action requires a positive --max-concurrency")
+		}
+		rule.SetMaxConcurrency(addOptMaxConc)
+	case vtrules.QRDelay:
+		if addOptDelay <= 0 {
+			log.Fatalf("The delay action requires a positive --delay")
+		}
+		rule.SetDelay(addOptDelay)
+	}
+
 	var rules *vtrules.Rules
 	_, err := os.Stat(configFile)
 	if os.IsNotExist(err) {
@@ -89,6 +116,12 @@ func mkAction() vtrules.Action {
 		return vtrules.QRFail
 	case "fail_retry":
 		return vtrules.QRFailRetry
+	case "throttle":
+		return vtrules.QRThrottle
+	case "concurrency":
+		return vtrules.QRConcurrency
+	case "delay":
+		return vtrules.QRDelay
 	case "continue":
 		return vtrules.QRContinue
 	default:
@@ -125,7 +158,7 @@ func Add() *cobra.Command {
 		&addOptAction,
 		"action", "a",
 		"",
-		"What action should be taken when this rule is matched {continue, fail, fail-retry} (required)")
+		"What action should be taken when this rule is matched {continue, fail, fail-retry, throttle, concurrency, delay} (required)")
 	addCmd.Flags().StringSliceVarP(
 		&addOptPlans,
 		"plan", "p",
@@ -136,11 +169,31 @@ func Add() *cobra.Command {
 		"table", "t",
 		nil,
 		"Queries will only match if running against these tables; may be specified multiple times")
+	addCmd.Flags().StringSliceVar(
+		&addOptFingerprint,
+		"fingerprint",
+		nil,
+		"Queries will only match if their normalized fingerprint is one of these; may be specified multiple times")
 	addCmd.Flags().StringVarP(
 		&addOptQueryRE,
 		"query", "q",
 		"",
 		"A regexp that will be applied to a query in order to determine if it matches")
+	addCmd.Flags().IntVar(
+		&addOptMaxQPS,
+		"max-qps",
+		0,
+		"The rate in queries per second above which the throttle action rejects the matching queries")
+	addCmd.Flags().IntVar(
+		&addOptMaxConc,
+		"max-concurrency",
+		0,
+		"The number of concurrent matching queries above which the concurrency action rejects them")
+	addCmd.Flags().DurationVar(
+		&addOptDelay,
+		"delay",
+		0,
+		"How long the delay action holds the matching queries for")
 
 	for _, f := range []string{"name", "action"} {
 		addCmd.MarkFlagRequired(f)
@@ -170,6 +170,17 @@ func DualFormatIntVar(p *int, name string, value int, usage string) {
 	}
 }
 
+// DualFormatFloat64Var creates a flag which supports both dashes and underscores
+func DualFormatFloat64Var(p *float64, name string, value float64, usage string) {
+	dashes := strings.Replace(name, "_", "-", -1)
+	underscores := strings.Replace(name, "-", "_", -1)
+
+	flag.Float64Var(p, underscores, value, usage)
+	if dashes != underscores {
+		flag.Float64Var(p, dashes, *p, fmt.Sprintf("Synonym to -%s", underscores))
+	}
+}
+
 // DualFormatBoolVar creates a flag which supports both dashes and underscores
 func DualFormatBoolVar(p *bool, name string, value bool, usage string) {
 	dashes := strings.Replace(name, "_", "-", -1)
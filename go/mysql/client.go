@@ -180,7 +180,7 @@ func Connect(ctx context.Context, params *ConnParams) (*Conn, error) {
 // Ping implements mysql ping command.
 func (c *Conn) Ping() error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 	data, pos := c.startEphemeralPacketWithHeader(1)
 	data[pos] = ComPing
 
@@ -312,6 +312,24 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 		c.Capabilities |= CapabilityClientSessionTrack
 	}
 
+	// Compressed protocol.
+	if params.Compression != "" {
+		capability, err := compressionCapability(params.Compression)
+		if err != nil {
+			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "%v", err)
+		}
+		// If client asked for compression, but server doesn't support
+		// the algorithm, stop right here.
+		if capabilities&capability == 0 {
+			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "server doesn't support %v compression but client asked for it", params.Compression)
+		}
+		c.Capabilities |= capability
+		c.compressionLevel = params.CompressionLevel
+		if c.compressionLevel == 0 {
+			c.compressionLevel = DefaultZstdCompressionLevel
+		}
+	}
+
 	// Build and send our handshake response 41.
 	// Note this one will never have SSL flag on.
 	if err := c.writeHandshakeResponse41(capabilities, scrambledPassword, characterSet, params); err != nil {
@@ -323,6 +341,11 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 		return err
 	}
 
+	// The packets following the handshake are compressed, if negotiated.
+	if err := c.startCompression(); err != nil {
+		return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot start compression: %v", err)
+	}
+
 	// If the server didn't support DbName in its handshake, set
 	// it now. This is what the 'mysql' client does.
 	if capabilities&CapabilityClientConnectWithDB == 0 && params.DbName != "" {
@@ -551,7 +574,9 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		CapabilityClientFoundRows&uint32(params.Flags) |
 		// If the server supported
 		// CapabilityClientSessionTrack, we also support it.
-		c.Capabilities&CapabilityClientSessionTrack
+		c.Capabilities&CapabilityClientSessionTrack |
+		// The compression algorithm we asked for, if any.
+		c.Capabilities&(CapabilityClientCompress|CapabilityClientZstdCompressionAlgorithm)
 
 	// FIXME(alainjobart) add multi statement.
 
@@ -578,6 +603,11 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		length++
 	}
 
+	// The zstd compression level.
+	if capabilityFlags&CapabilityClientZstdCompressionAlgorithm != 0 {
+		length++
+	}
+
 	data, pos := c.startEphemeralPacketWithHeader(length)
 
 	// Client capability flags.
@@ -614,6 +644,11 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 	// Assume native client during response
 	pos = writeNullString(data, pos, c.authPluginName)
 
+	// zstd compression level, only if we asked for zstd.
+	if capabilityFlags&CapabilityClientZstdCompressionAlgorithm != 0 {
+		pos = writeByte(data, pos, byte(c.compressionLevel))
+	}
+
 	// Sanity-check the length.
 	if pos != len(data) {
 		return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "writeHandshakeResponse41: only packed %v bytes, out of %v allocated", pos, len(data))
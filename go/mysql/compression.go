@@ -0,0 +1,380 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// Compression algorithms of the compressed protocol.
+const (
+	// CompressionZlib is the zlib compression, negotiated with
+	// CapabilityClientCompress.
+	CompressionZlib = "zlib"
+
+	// CompressionZstd is the zstd compression, negotiated with
+	// CapabilityClientZstdCompressionAlgorithm (MySQL 8.0.18+).
+	CompressionZstd = "zstd"
+
+	// DefaultZstdCompressionLevel is the zstd compression level used
+	// when none is specified, same as MySQL.
+	DefaultZstdCompressionLevel = 3
+)
+
+const (
+	// compressedHeaderSize is the size of the header of a compressed
+	// packet: the length of the compressed payload (3 bytes), the
+	// compressed sequence (1 byte) and the length of the payload
+	// before compression (3 bytes).
+	compressedHeaderSize = 7
+
+	// minCompressLength is the size under which payloads are sent
+	// uncompressed, as their compressed version wouldn't be smaller.
+	minCompressLength = 50
+
+	// maxRetainedBufferSize is the size above which the buffers of
+	// the compressed packets are not kept for the next packets.
+	maxRetainedBufferSize = 1 << 20
+)
+
+// ParseCompressionAlgorithms parses a comma separated list of
+// compression algorithms.
+func ParseCompressionAlgorithms(s string) ([]string, error) {
+	var algorithms []string
+	for _, algorithm := range strings.Split(s, ",") {
+		algorithm = strings.ToLower(strings.TrimSpace(algorithm))
+		switch algorithm {
+		case "":
+		case CompressionZlib, CompressionZstd:
+			algorithms = append(algorithms, algorithm)
+		default:
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown compression algorithm %q, valid values are %v and %v", algorithm, CompressionZlib, CompressionZstd)
+		}
+	}
+	return algorithms, nil
+}
+
+// compressionCapability returns the capability flag negotiating
+// the compression algorithm.
+func compressionCapability(algorithm string) (uint32, error) {
+	switch algorithm {
+	case CompressionZlib:
+		return CapabilityClientCompress, nil
+	case CompressionZstd:
+		return CapabilityClientZstdCompressionAlgorithm, nil
+	}
+	return 0, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown compression algorithm %q, valid values are %v and %v", algorithm, CompressionZlib, CompressionZstd)
+}
+
+// packetCompressor compresses and decompresses the payloads
+// of compressed packets.
+type packetCompressor interface {
+	// compress appends the compressed src to dst.
+	compress(dst, src []byte) ([]byte, error)
+	// decompress decompresses src into dst, whose length is the
+	// length of the payload before compression.
+	decompress(dst, src []byte) error
+}
+
+// zlibCompressor is the packetCompressor of CompressionZlib.
+// It is not safe for concurrent use.
+type zlibCompressor struct {
+	writer *zlib.Writer
+	reader io.ReadCloser
+	buf    bytes.Buffer
+	src    bytes.Reader
+}
+
+func (z *zlibCompressor) compress(dst, src []byte) ([]byte, error) {
+	z.buf.Reset()
+	if z.writer == nil {
+		z.writer = zlib.NewWriter(&z.buf)
+	} else {
+		z.writer.Reset(&z.buf)
+	}
+	if _, err := z.writer.Write(src); err != nil {
+		return nil, err
+	}
+	if err := z.writer.Close(); err != nil {
+		return nil, err
+	}
+	return append(dst, z.buf.Bytes()...), nil
+}
+
+func (z *zlibCompressor) decompress(dst, src []byte) error {
+	z.src.Reset(src)
+	if z.reader == nil {
+		reader, err := zlib.NewReader(&z.src)
+		if err != nil {
+			return err
+		}
+		z.reader = reader
+	} else if err := z.reader.(zlib.Resetter).Reset(&z.src, nil); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(z.reader, dst); err != nil {
+		return err
+	}
+	return z.reader.Close()
+}
+
+var (
+	// zstdDecoder decodes the payloads of all the connections,
+	// DecodeAll can be called concurrently.
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+	zstdDecoderOnce sync.Once
+
+	// zstdEncoders are the encoders of all the connections, by
+	// compression level. EncodeAll can be called concurrently.
+	zstdEncoders   = make(map[int]*zstd.Encoder)
+	zstdEncodersMu sync.Mutex
+)
+
+// zstdCompressor is the packetCompressor of CompressionZstd.
+type zstdCompressor struct {
+	encoder *zstd.Encoder
+}
+
+func newZstdCompressor(level int) (*zstdCompressor, error) {
+	zstdEncodersMu.Lock()
+	defer zstdEncodersMu.Unlock()
+	encoder, ok := zstdEncoders[level]
+	if !ok {
+		var err error
+		encoder, err = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)), zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		zstdEncoders[level] = encoder
+	}
+	return &zstdCompressor{encoder: encoder}, nil
+}
+
+func (z *zstdCompressor) compress(dst, src []byte) ([]byte, error) {
+	return z.encoder.EncodeAll(src, dst), nil
+}
+
+func (z *zstdCompressor) decompress(dst, src []byte) error {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil)
+	})
+	if zstdDecoderErr != nil {
+		return zstdDecoderErr
+	}
+	out, err := zstdDecoder.DecodeAll(src, dst[:0])
+	if err != nil {
+		return err
+	}
+	if len(out) != len(dst) {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "decompressed payload of length %v, expected %v", len(out), len(dst))
+	}
+	if &out[0] != &dst[0] {
+		copy(dst, out)
+	}
+	return nil
+}
+
+// compressedReader reads the packets of a connection using the
+// compressed protocol: the packets are read from the payloads of
+// compressed packets.
+type compressedReader struct {
+	c          *Conn
+	r          io.Reader
+	compressor packetCompressor
+
+	// data is the part of the current payload not read yet.
+	data       []byte
+	compressed []byte
+	payload    []byte
+}
+
+// Read is part of the io.Reader interface.
+func (cr *compressedReader) Read(p []byte) (int, error) {
+	for len(cr.data) == 0 {
+		if err := cr.readCompressedPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, cr.data)
+	cr.data = cr.data[n:]
+	return n, nil
+}
+
+func (cr *compressedReader) readCompressedPacket() error {
+	var header [compressedHeaderSize]byte
+	// The errors reading the header are returned as is, for
+	// readHeaderFrom to detect the closed connections.
+	if _, err := io.ReadFull(cr.r, header[:]); err != nil {
+		return err
+	}
+
+	sequence := header[3]
+	if sequence != cr.c.compressedSequence {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid compressed sequence, expected %v got %v", cr.c.compressedSequence, sequence)
+	}
+	cr.c.compressedSequence++
+
+	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	uncompressedLength := int(uint32(header[4]) | uint32(header[5])<<8 | uint32(header[6])<<16)
+
+	cr.compressed = growBuffer(cr.compressed, length)
+	if _, err := io.ReadFull(cr.r, cr.compressed); err != nil {
+		return vterrors.Wrapf(err, "io.ReadFull(compressed packet body of length %v) failed", length)
+	}
+
+	// An uncompressed length of 0 means the payload is not compressed.
+	if uncompressedLength == 0 {
+		cr.data = cr.compressed
+		return nil
+	}
+	cr.payload = growBuffer(cr.payload, uncompressedLength)
+	if err := cr.compressor.decompress(cr.payload, cr.compressed); err != nil {
+		return vterrors.Wrapf(err, "cannot decompress packet of length %v", length)
+	}
+	cr.data = cr.payload
+	return nil
+}
+
+// compressedWriter writes the packets of a connection using the
+// compressed protocol: each Write sends the data as the payload of
+// compressed packets.
+type compressedWriter struct {
+	c          *Conn
+	w          io.Writer
+	compressor packetCompressor
+
+	buf []byte
+}
+
+// Write is part of the io.Writer interface.
+func (cw *compressedWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		// The payload of a compressed packet is capped to MaxPacketSize.
+		chunk := p
+		if len(chunk) > MaxPacketSize {
+			chunk = chunk[:MaxPacketSize]
+		}
+		if err := cw.writeCompressedPacket(chunk); err != nil {
+			return written, err
+		}
+		written += len(chunk)
+		p = p[len(chunk):]
+	}
+	return written, nil
+}
+
+func (cw *compressedWriter) writeCompressedPacket(payload []byte) error {
+	buf := growBuffer(cw.buf, compressedHeaderSize)
+	uncompressedLength := 0
+	if len(payload) >= minCompressLength {
+		compressed, err := cw.compressor.compress(buf, payload)
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot compress packet of length %v", len(payload))
+		}
+		// Send the payload as is if compressing doesn't help.
+		if len(compressed)-compressedHeaderSize < len(payload) {
+			buf = compressed
+			uncompressedLength = len(payload)
+		} else {
+			buf = compressed[:compressedHeaderSize]
+		}
+	}
+	if uncompressedLength == 0 {
+		buf = append(buf, payload...)
+	}
+
+	length := len(buf) - compressedHeaderSize
+	buf[0] = byte(length)
+	buf[1] = byte(length >> 8)
+	buf[2] = byte(length >> 16)
+	buf[3] = cw.c.compressedSequence
+	buf[4] = byte(uncompressedLength)
+	buf[5] = byte(uncompressedLength >> 8)
+	buf[6] = byte(uncompressedLength >> 16)
+	cw.buf = buf
+
+	if n, err := cw.w.Write(buf); err != nil {
+		return err
+	} else if n != len(buf) {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "Write(compressed packet) returned a short write: %v < %v", n, len(buf))
+	}
+	cw.c.compressedSequence++
+	return nil
+}
+
+// growBuffer returns a buffer of the given length, reusing buf
+// if it is large enough, but not too large.
+func growBuffer(buf []byte, length int) []byte {
+	if cap(buf) < length || (cap(buf) > maxRetainedBufferSize && length <= maxRetainedBufferSize) {
+		return make([]byte, length)
+	}
+	return buf[:length]
+}
+
+// startCompression switches the connection to the compressed
+// protocol, if it was negotiated during the handshake. It must
+// be called once the handshake is over, by both sides.
+func (c *Conn) startCompression() error {
+	var compressor packetCompressor
+	switch {
+	case c.Capabilities&CapabilityClientZstdCompressionAlgorithm != 0:
+		level := c.compressionLevel
+		if level < 1 || level > 22 {
+			level = DefaultZstdCompressionLevel
+		}
+		z, err := newZstdCompressor(level)
+		if err != nil {
+			return err
+		}
+		compressor = z
+	case c.Capabilities&CapabilityClientCompress != 0:
+		compressor = &zlibCompressor{}
+	default:
+		return nil
+	}
+
+	c.bufMu.Lock()
+	defer c.bufMu.Unlock()
+	c.compressedReader = &compressedReader{
+		c:          c,
+		r:          c.getReader(),
+		compressor: compressor,
+	}
+	c.compressedWriter = &compressedWriter{
+		c:          c,
+		w:          c.conn,
+		compressor: compressor,
+	}
+	if c.bufferedWriter != nil {
+		if err := c.bufferedWriter.Flush(); err != nil {
+			return err
+		}
+		c.bufferedWriter.Reset(c.compressedWriter)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestParseCompressionAlgorithms(t *testing.T) {
+	algorithms, err := ParseCompressionAlgorithms("")
+	require.NoError(t, err)
+	assert.Empty(t, algorithms)
+
+	algorithms, err = ParseCompressionAlgorithms("zlib, ZSTD")
+	require.NoError(t, err)
+	assert.Equal(t, []string{CompressionZlib, CompressionZstd}, algorithms)
+
+	_, err = ParseCompressionAlgorithms("zlib,lz4")
+	assert.EqualError(t, err, `unknown compression algorithm "lz4", valid values are zlib and zstd`)
+}
+
+func TestCompressedPackets(t *testing.T) {
+	zstd, err := newZstdCompressor(DefaultZstdCompressionLevel)
+	require.NoError(t, err)
+
+	// A payload larger than a compressed packet, which is sent
+	// in two compressed packets.
+	large := bytes.Repeat([]byte("0123456789"), MaxPacketSize/10+10)
+
+	for _, compressor := range []packetCompressor{&zlibCompressor{}, zstd} {
+		t.Run(fmt.Sprintf("%T", compressor), func(t *testing.T) {
+			var buf bytes.Buffer
+			c := &Conn{}
+			cw := &compressedWriter{c: c, w: &buf, compressor: compressor}
+			cr := &compressedReader{c: c, r: &buf, compressor: compressor}
+
+			payloads := [][]byte{[]byte("short"), []byte(strings.Repeat("compress me ", 100)), large}
+			for _, payload := range payloads {
+				n, err := cw.Write(payload)
+				require.NoError(t, err)
+				assert.Equal(t, len(payload), n)
+			}
+			assert.EqualValues(t, 4, c.compressedSequence)
+			// The compressible payloads were compressed.
+			assert.Less(t, buf.Len(), len(large)/10)
+
+			c.compressedSequence = 0
+			for _, payload := range payloads {
+				got := make([]byte, len(payload))
+				_, err := io.ReadFull(cr, got)
+				require.NoError(t, err)
+				assert.True(t, bytes.Equal(payload, got))
+			}
+			assert.EqualValues(t, 4, c.compressedSequence)
+
+			// The compressed sequence is checked.
+			_, err := cw.Write([]byte("out of sequence"))
+			require.NoError(t, err)
+			_, err = cr.Read(make([]byte, 1))
+			assert.EqualError(t, err, "invalid compressed sequence, expected 5 got 4")
+		})
+	}
+}
+
+func TestCompressedConnection(t *testing.T) {
+	var rows [][]sqltypes.Value
+	for i := 0; i < 1000; i++ {
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewInt32(int32(i)),
+			sqltypes.NewVarChar(strings.Repeat("some compressible text ", 10)),
+		})
+	}
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: querypb.Type_INT32,
+		}, {
+			Name: "name",
+			Type: querypb.Type_VARCHAR,
+		}},
+		Rows: rows,
+	}
+	th := &testHandler{result: result}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	defer authServer.close()
+
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	require.NoError(t, err)
+	defer l.Close()
+	l.CompressionAlgorithms = []string{CompressionZlib, CompressionZstd}
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+
+	for _, compression := range []string{"", CompressionZlib, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			params := &ConnParams{
+				Host:        host,
+				Port:        port,
+				Uname:       "user1",
+				Pass:        "password1",
+				Compression: compression,
+			}
+			c, err := Connect(context.Background(), params)
+			require.NoError(t, err)
+			defer c.Close()
+			assert.Equal(t, compression != "", c.compressedReader != nil)
+
+			for i := 0; i < 3; i++ {
+				qr, err := c.ExecuteFetch("select rows", 10000, true)
+				require.NoError(t, err)
+				assert.Equal(t, result.Fields, qr.Fields)
+				assert.Equal(t, result.Rows, qr.Rows)
+				require.NoError(t, c.Ping())
+			}
+			server := th.LastConn()
+			switch compression {
+			case CompressionZlib:
+				assert.NotZero(t, server.Capabilities&CapabilityClientCompress)
+			case CompressionZstd:
+				assert.NotZero(t, server.Capabilities&CapabilityClientZstdCompressionAlgorithm)
+				assert.Equal(t, DefaultZstdCompressionLevel, server.compressionLevel)
+			default:
+				assert.Nil(t, server.compressedReader)
+			}
+		})
+	}
+
+	// The client fails to connect if the server doesn't accept the algorithm.
+	zlibListener, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	require.NoError(t, err)
+	defer zlibListener.Close()
+	zlibListener.CompressionAlgorithms = []string{CompressionZlib}
+	go zlibListener.Accept()
+
+	host, port = getHostPort(t, zlibListener.Addr())
+	_, err = Connect(context.Background(), &ConnParams{
+		Host:        host,
+		Port:        port,
+		Uname:       "user1",
+		Pass:        "password1",
+		Compression: CompressionZstd,
+	})
+	assert.Contains(t, err.Error(), "server doesn't support zstd compression but client asked for it")
+}
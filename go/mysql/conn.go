@@ -187,6 +187,18 @@ type Conn struct {
 
 	// Packet encoding variables.
 	sequence uint8
+
+	// compressedReader and compressedWriter are set once the
+	// compressed protocol was negotiated. compressedSequence is the
+	// sequence of the compressed packets, reset with the sequence of
+	// the packets at the start of each command.
+	compressedReader   *compressedReader
+	compressedWriter   *compressedWriter
+	compressedSequence uint8
+
+	// compressionLevel is the zstd compression level, sent by the
+	// client during the handshake.
+	compressionLevel int
 }
 
 // splitStatementFunciton is the function that is used to split the statement in cas ef a multi-statement query.
@@ -200,6 +212,28 @@ type PrepareData struct {
 	BindVars    map[string]*querypb.BindVariable
 	StatementID uint32
 	ParamsCount uint16
+	// CursorType is the cursor type of the COM_STMT_EXECUTE being
+	// handled. When it asks for a cursor, the rows are fetched in
+	// batches by the client, so the handler should stream them.
+	CursorType byte
+
+	// cursor is the cursor opened by the last execution, if any.
+	cursor *cursor
+}
+
+// closeCursor closes the cursor opened by the last execution, if any.
+func (prepare *PrepareData) closeCursor() {
+	if prepare.cursor != nil {
+		prepare.cursor.close()
+		prepare.cursor = nil
+	}
+}
+
+// closeCursors closes all the cursors opened on the connection.
+func (c *Conn) closeCursors() {
+	for _, prepare := range c.PrepareData {
+		prepare.closeCursor()
+	}
 }
 
 // execResult is an enum signifying the result of executing a query
@@ -252,7 +286,11 @@ func (c *Conn) startWriterBuffering() {
 	defer c.bufMu.Unlock()
 
 	c.bufferedWriter = writersPool.Get().(*bufio.Writer)
-	c.bufferedWriter.Reset(c.conn)
+	if c.compressedWriter != nil {
+		c.bufferedWriter.Reset(c.compressedWriter)
+	} else {
+		c.bufferedWriter.Reset(c.conn)
+	}
 }
 
 // endWriterBuffering must be called to terminate startWriteBuffering.
@@ -288,6 +326,9 @@ func (c *Conn) getWriter() (w io.Writer, unget func()) {
 		}
 	}
 	c.bufMu.Unlock()
+	if c.compressedWriter != nil {
+		return c.compressedWriter, func() {}
+	}
 	return c.conn, func() {}
 }
 
@@ -315,8 +356,12 @@ func (c *Conn) stopFlushTimer() {
 }
 
 // getReader returns reader for connection. It can be *bufio.Reader or net.Conn
-// depending on which buffer size was passed to newServerConn.
+// depending on which buffer size was passed to newServerConn, wrapped by
+// the compressedReader if the compressed protocol is used.
 func (c *Conn) getReader() io.Reader {
+	if c.compressedReader != nil {
+		return c.compressedReader
+	}
 	if c.bufferedReader != nil {
 		return c.bufferedReader
 	}
@@ -344,8 +389,11 @@ func (c *Conn) readHeaderFrom(r io.Reader) (int, error) {
 		return 0, vterrors.Wrapf(err, "io.ReadFull(header size) failed")
 	}
 
+	// With the compressed protocol, MySQL resynchronizes the sequence
+	// of the packets with the compressed sequence after each compressed
+	// packet, so only the compressed sequence is checked.
 	sequence := uint8(header[3])
-	if sequence != c.sequence {
+	if c.compressedReader == nil && sequence != c.sequence {
 		return 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid sequence, expected %v got %v", c.sequence, sequence)
 	}
 
@@ -643,13 +691,19 @@ func (c *Conn) recycleWritePacket() {
 	c.currentEphemeralPolicy = ephemeralUnused
 }
 
+// resetSequence resets the sequences at the start of a new command.
+func (c *Conn) resetSequence() {
+	c.sequence = 0
+	c.compressedSequence = 0
+}
+
 // writeComQuit writes a Quit message for the server, to indicate we
 // want to close the connection.
 // Client -> Server.
 // Returns SQLError(CRServerGone) if it can't.
 func (c *Conn) writeComQuit() error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 
 	data, pos := c.startEphemeralPacketWithHeader(1)
 	data[pos] = ComQuit
@@ -851,7 +905,7 @@ func (c *Conn) writeEOFPacket(flags uint16, warnings uint16) error {
 // handleNextCommand is called in the server loop to process
 // incoming packets.
 func (c *Conn) handleNextCommand(handler Handler) bool {
-	c.sequence = 0
+	c.resetSequence()
 	data, err := c.readEphemeralPacket()
 	if err != nil {
 		// Don't log EOF errors. They cause too much spam.
@@ -882,10 +936,15 @@ func (c *Conn) handleNextCommand(handler Handler) bool {
 		return c.handleComStmtExecute(handler, data)
 	case ComStmtSendLongData:
 		return c.handleComStmtSendLongData(data)
+	case ComStmtFetch:
+		return c.handleComStmtFetch(handler, data)
 	case ComStmtClose:
 		stmtID, ok := c.parseComStmtClose(data)
 		c.recycleReadPacket()
 		if ok {
+			if prepare, ok := c.PrepareData[stmtID]; ok {
+				prepare.closeCursor()
+			}
 			delete(c.PrepareData, stmtID)
 		}
 	case ComStmtReset:
@@ -910,6 +969,7 @@ func (c *Conn) handleComResetConnection(handler Handler) {
 	c.recycleReadPacket()
 	handler.ComResetConnection(c)
 	// Reset prepared statements
+	c.closeCursors()
 	c.PrepareData = make(map[uint32]*PrepareData)
 	err := c.writeOKPacket(&PacketOK{})
 	if err != nil {
@@ -940,6 +1000,7 @@ func (c *Conn) handleComStmtReset(data []byte) bool {
 			prepare.BindVars[k] = nil
 		}
 	}
+	prepare.closeCursor()
 
 	if err := c.writeOKPacket(&PacketOK{statusFlags: c.StatusFlags}); err != nil {
 		log.Error("Error writing ComStmtReset OK packet to client %v: %v", c.ConnectionID, err)
@@ -990,7 +1051,7 @@ func (c *Conn) handleComStmtExecute(handler Handler, data []byte) (kontinue bool
 		}
 	}()
 	queryStart := time.Now()
-	stmtID, _, err := c.parseComStmtExecute(c.PrepareData, data)
+	stmtID, cursorType, err := c.parseComStmtExecute(c.PrepareData, data)
 	c.recycleReadPacket()
 
 	if stmtID != uint32(0) {
@@ -1005,10 +1066,20 @@ func (c *Conn) handleComStmtExecute(handler Handler, data []byte) (kontinue bool
 		return c.writeErrorPacketFromErrorAndLog(err)
 	}
 
+	prepare := c.PrepareData[stmtID]
+	// Executing the statement again closes its cursor.
+	prepare.closeCursor()
+	if cursorType&CursorTypeReadOnly != 0 {
+		if !c.openCursor(handler, prepare, cursorType) {
+			return false
+		}
+		timings.Record(queryTimingKey, queryStart)
+		return true
+	}
+
 	fieldSent := false
 	// sendFinished is set if the response should just be an OK packet.
 	sendFinished := false
-	prepare := c.PrepareData[stmtID]
 	err = handler.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
 		if sendFinished {
 			// Failsafe: Unreachable if server is well-behaved.
@@ -1071,6 +1142,106 @@ func (c *Conn) handleComStmtExecute(handler Handler, data []byte) (kontinue bool
 	return true
 }
 
+// openCursor executes the statement for a cursor: only the fields are
+// sent, the rows are read by the client with COM_STMT_FETCH. The
+// statement keeps running in its own goroutine, streaming the rows as
+// they are fetched. If it doesn't return a result set, its result is
+// sent as usual and no cursor is opened.
+func (c *Conn) openCursor(handler Handler, prepare *PrepareData, cursorType byte) bool {
+	// The next commands are handled while the statement is running,
+	// so it gets its own copy of the prepared statement.
+	stmt := *prepare
+	stmt.CursorType = cursorType
+	cur := newCursor()
+	go cur.run(func(callback func(*sqltypes.Result) error) error {
+		return handler.ComStmtExecute(c, &stmt, callback)
+	})
+
+	qr, err := cur.next()
+	if err == nil && qr == nil {
+		// This is just a failsafe. Should never happen.
+		err = NewSQLErrorFromError(errors.New("unexpected: query ended without no results and no error"))
+	}
+	if err != nil {
+		cur.close()
+		return c.writeErrorPacketFromErrorAndLog(err)
+	}
+
+	if len(qr.Fields) == 0 {
+		cur.close()
+		ok := PacketOK{
+			affectedRows:     qr.RowsAffected,
+			lastInsertID:     qr.InsertID,
+			statusFlags:      c.StatusFlags,
+			sessionStateData: qr.SessionStateChanges,
+		}
+		if err := c.writeOKPacket(&ok); err != nil {
+			log.Errorf("Error writing result to %s: %v", c, err)
+			return false
+		}
+		return true
+	}
+
+	cur.fields = qr.Fields
+	cur.pending = qr.Rows
+	prepare.cursor = cur
+	if err := c.writeCursorFields(qr); err != nil {
+		log.Errorf("Error writing result to %s: %v", c, err)
+		return false
+	}
+	return true
+}
+
+func (c *Conn) handleComStmtFetch(handler Handler, data []byte) (kontinue bool) {
+	c.startWriterBuffering()
+	defer func() {
+		if err := c.endWriterBuffering(); err != nil {
+			log.Errorf("conn %v: flush() failed: %v", c.ID(), err)
+			kontinue = false
+		}
+	}()
+	queryStart := time.Now()
+	stmtID, numRows, ok := c.parseComStmtFetch(data)
+	c.recycleReadPacket()
+	if !ok {
+		return c.writeErrorAndLog(CRMalformedPacket, SSUnknownSQLState, "error parsing statement fetch: %v", data)
+	}
+
+	prepare, ok := c.PrepareData[stmtID]
+	if !ok {
+		return c.writeErrorAndLog(ERUnknownStmtHandler, SSUnknownSQLState, "Unknown prepared statement handler (%v) given to mysqld_stmt_fetch", stmtID)
+	}
+	cur := prepare.cursor
+	if cur == nil {
+		return c.writeErrorAndLog(ERStmtHasNoOpenCursor, SSUnknownSQLState, "The statement (%v) has no open cursor.", stmtID)
+	}
+
+	rows, err := cur.fetch(int(numRows))
+	if err != nil {
+		prepare.closeCursor()
+		return c.writeErrorPacketFromErrorAndLog(NewSQLErrorFromError(err))
+	}
+	if err := c.writeBinaryRows(&sqltypes.Result{Fields: cur.fields, Rows: rows}); err != nil {
+		log.Errorf("Error writing result to %s: %v", c, err)
+		return false
+	}
+
+	flags := c.StatusFlags
+	if cur.exhausted() {
+		prepare.closeCursor()
+		flags |= ServerStatusLastRowSent
+	} else {
+		flags |= ServerStatusCursorExists
+	}
+	if err := c.writeEndPacket(flags, 0, 0, handler.WarningCount(c)); err != nil {
+		log.Errorf("Error writing result to %s: %v", c, err)
+		return false
+	}
+
+	timings.Record(queryTimingKey, queryStart)
+	return true
+}
+
 func (c *Conn) handleComPrepare(handler Handler, data []byte) (kontinue bool) {
 	c.startWriterBuffering()
 	defer func() {
@@ -44,6 +44,14 @@ type ConnParams struct {
 	// The following is only set to force the client to connect without
 	// using CapabilityClientDeprecateEOF
 	DisableClientDeprecateEOF bool
+
+	// Compression is the algorithm of the compressed protocol to use
+	// after the handshake: CompressionZlib or CompressionZstd. The
+	// protocol is not compressed if it is empty.
+	Compression string `json:"compression,omitempty"`
+	// CompressionLevel is the zstd compression level. If 0,
+	// DefaultZstdCompressionLevel is used.
+	CompressionLevel int `json:"compression_level,omitempty"`
 }
 
 // EnableSSL will set the right flag on the parameters.
@@ -737,3 +737,170 @@ func (m mockAddress) String() string {
 }
 
 var _ net.Addr = (*mockAddress)(nil)
+
+// cursorHandler streams results of two rows for every COM_STMT_EXECUTE,
+// and reports the error returned by the callback once it is done.
+type cursorHandler struct {
+	testRun
+	results int
+	done    chan error
+}
+
+func (h *cursorHandler) ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error {
+	fields := []*querypb.Field{{Name: "id", Type: querypb.Type_INT64}}
+	err := callback(&sqltypes.Result{Fields: fields})
+	for i := 0; err == nil && i < h.results; i++ {
+		err = callback(&sqltypes.Result{Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt64(int64(2 * i))},
+			{sqltypes.NewInt64(int64(2*i + 1))},
+		}})
+	}
+	h.done <- err
+	return err
+}
+
+func writeStmtCommand(t *testing.T, c *Conn, command byte, args ...uint32) {
+	c.sequence = 0
+	data, pos := c.startEphemeralPacketWithHeader(1 + 4*len(args))
+	pos = writeByte(data, pos, command)
+	for _, arg := range args {
+		pos = writeUint32(data, pos, arg)
+	}
+	require.NoError(t, c.writeEphemeralPacket())
+}
+
+// writeCursorExecute executes a statement without parameters with a read only cursor.
+func writeCursorExecute(t *testing.T, c *Conn, stmtID uint32) {
+	c.sequence = 0
+	data, pos := c.startEphemeralPacketWithHeader(10)
+	pos = writeByte(data, pos, ComStmtExecute)
+	pos = writeUint32(data, pos, stmtID)
+	pos = writeByte(data, pos, CursorTypeReadOnly)
+	writeUint32(data, pos, 1)
+	require.NoError(t, c.writeEphemeralPacket())
+}
+
+func readFetchedRows(t *testing.T, c *Conn) (int, uint16) {
+	rows := 0
+	for {
+		data, err := c.ReadPacket()
+		require.NoError(t, err)
+		if isEOFPacket(data) {
+			_, flags, err := parseEOFPacket(data)
+			require.NoError(t, err)
+			return rows, flags
+		}
+		rows++
+	}
+}
+
+func TestComStmtFetch(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	sConn.PrepareData = map[uint32]*PrepareData{
+		1: {StatementID: 1, PrepareStmt: "select id from t", BindVars: map[string]*querypb.BindVariable{}},
+	}
+	handler := &cursorHandler{results: 3, done: make(chan error, 1)}
+
+	writeCursorExecute(t, cConn, 1)
+	require.True(t, sConn.handleNextCommand(handler))
+
+	// Column count and definition, then the EOF packet flagging the cursor.
+	_, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	_, err = cConn.ReadPacket()
+	require.NoError(t, err)
+	rows, flags := readFetchedRows(t, cConn)
+	assert.Zero(t, rows)
+	assert.NotZero(t, flags&ServerStatusCursorExists)
+
+	writeStmtCommand(t, cConn, ComStmtFetch, 1, 4)
+	require.True(t, sConn.handleNextCommand(handler))
+	rows, flags = readFetchedRows(t, cConn)
+	assert.Equal(t, 4, rows)
+	assert.NotZero(t, flags&ServerStatusCursorExists)
+	assert.Zero(t, flags&ServerStatusLastRowSent)
+
+	writeStmtCommand(t, cConn, ComStmtFetch, 1, 4)
+	require.True(t, sConn.handleNextCommand(handler))
+	rows, flags = readFetchedRows(t, cConn)
+	assert.Equal(t, 2, rows)
+	assert.Zero(t, flags&ServerStatusCursorExists)
+	assert.NotZero(t, flags&ServerStatusLastRowSent)
+	assert.NoError(t, <-handler.done)
+
+	// The cursor is closed once all its rows are sent.
+	writeStmtCommand(t, cConn, ComStmtFetch, 1, 4)
+	require.True(t, sConn.handleNextCommand(handler))
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	sqlErr, ok := ParseErrorPacket(data).(*SQLError)
+	require.True(t, ok)
+	assert.Equal(t, ERStmtHasNoOpenCursor, sqlErr.Number())
+
+	// Closing the statement stops the execution of its cursor.
+	handler.results = 100
+	writeCursorExecute(t, cConn, 1)
+	require.True(t, sConn.handleNextCommand(handler))
+	_, _ = cConn.ReadPacket()
+	_, _ = cConn.ReadPacket()
+	readFetchedRows(t, cConn)
+
+	writeStmtCommand(t, cConn, ComStmtClose, 1)
+	require.True(t, sConn.handleNextCommand(handler))
+	assert.Equal(t, errCursorClosed, <-handler.done)
+}
+
+func TestComStmtFetchDeprecateEOF(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	sConn.Capabilities |= CapabilityClientDeprecateEOF
+	cConn.Capabilities |= CapabilityClientDeprecateEOF
+	sConn.PrepareData = map[uint32]*PrepareData{
+		1: {StatementID: 1, PrepareStmt: "select id from t", BindVars: map[string]*querypb.BindVariable{}},
+	}
+	handler := &cursorHandler{results: 1, done: make(chan error, 1)}
+
+	writeCursorExecute(t, cConn, 1)
+	require.True(t, sConn.handleNextCommand(handler))
+
+	// The fields are followed by a real EOF packet flagging the cursor,
+	// not by an OK packet.
+	_, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	_, err = cConn.ReadPacket()
+	require.NoError(t, err)
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	require.Len(t, data, 5)
+	require.Equal(t, byte(EOFPacket), data[0])
+	_, flags, err := parseEOFPacket(data)
+	require.NoError(t, err)
+	assert.NotZero(t, flags&ServerStatusCursorExists)
+
+	// The fetched rows end with an OK packet.
+	writeStmtCommand(t, cConn, ComStmtFetch, 1, 4)
+	require.True(t, sConn.handleNextCommand(handler))
+	rows := 0
+	for {
+		data, err = cConn.ReadPacket()
+		require.NoError(t, err)
+		if isEOFPacket(data) {
+			break
+		}
+		rows++
+	}
+	assert.Equal(t, 2, rows)
+	ok, err := cConn.parseOKPacket(data)
+	require.NoError(t, err)
+	assert.NotZero(t, ok.statusFlags&ServerStatusLastRowSent)
+	assert.NoError(t, <-handler.done)
+}
@@ -67,8 +67,10 @@ const (
 	// CLIENT_NO_SCHEMA 1 << 4
 	// Do not permit database.table.column. We do permit it.
 
-	// CLIENT_COMPRESS 1 << 5
-	// We do not support compression. CPU is usually our bottleneck.
+	// CapabilityClientCompress is CLIENT_COMPRESS.
+	// Use the zlib compressed protocol after the handshake.
+	// It is only negotiated if configured, CPU is usually our bottleneck.
+	CapabilityClientCompress = 1 << 5
 
 	// CLIENT_ODBC 1 << 6
 	// No special behavior since 3.22.
@@ -139,6 +141,14 @@ const (
 	// CapabilityClientDeprecateEOF is CLIENT_DEPRECATE_EOF
 	// Expects an OK (instead of EOF) after the resultset rows of a Text Resultset.
 	CapabilityClientDeprecateEOF = 1 << 24
+
+	// CLIENT_OPTIONAL_RESULTSET_METADATA 1 << 25
+	// Not yet supported.
+
+	// CapabilityClientZstdCompressionAlgorithm is CLIENT_ZSTD_COMPRESSION_ALGORITHM.
+	// Use the zstd compressed protocol after the handshake. The compression
+	// level is sent at the end of Protocol::HandshakeResponse41.
+	CapabilityClientZstdCompressionAlgorithm = 1 << 26
 )
 
 // Status flags. They are returned by the server in a few cases.
@@ -215,7 +225,7 @@ const (
 	// ComStmtReset is COM_STMT_RESET
 	ComStmtReset = 0x1a
 
-	//ComStmtFetch is COM_STMT_FETCH
+	// ComStmtFetch is COM_STMT_FETCH
 	ComStmtFetch = 0x1c
 
 	// ComSetOption is COM_SET_OPTION
@@ -240,6 +250,21 @@ const (
 	NullValue = 0xfb
 )
 
+// Cursor type flags of COM_STMT_EXECUTE.
+const (
+	// CursorTypeNoCursor is CURSOR_TYPE_NO_CURSOR.
+	CursorTypeNoCursor = 0x00
+
+	// CursorTypeReadOnly is CURSOR_TYPE_READ_ONLY.
+	CursorTypeReadOnly = 0x01
+
+	// CursorTypeForUpdate is CURSOR_TYPE_FOR_UPDATE.
+	CursorTypeForUpdate = 0x02
+
+	// CursorTypeScrollable is CURSOR_TYPE_SCROLLABLE.
+	CursorTypeScrollable = 0x04
+)
+
 // Auth packet types
 const (
 	// AuthMoreDataPacket is sent when server requires more data to authenticate
@@ -398,6 +423,7 @@ const (
 	EROptionPreventsStatement       = 1290
 	ERDuplicatedValueInType         = 1291
 	ERSPDoesNotExist                = 1305
+	ERStmtHasNoOpenCursor           = 1421
 	ERRowIsReferenced2              = 1451
 	ErNoReferencedRow2              = 1452
 	ErSPNotVarArg                   = 1414
@@ -493,6 +519,7 @@ const (
 	ERIncorrectGlobalLocalVar      = 1238
 	ERWrongFKDef                   = 1239
 	ERKeyRefDoNotMatchTableRef     = 1240
+	ERUnknownStmtHandler           = 1243
 	ERCyclicReference              = 1245
 	ERCollationCharsetMismatch     = 1253
 	ERCantAggregate2Collations     = 1267
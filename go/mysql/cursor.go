@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// errCursorClosed is returned to the handler streaming the results
+// of a cursor once the cursor is closed.
+var errCursorClosed = errors.New("cursor closed")
+
+// cursor is a server side cursor, opened by a COM_STMT_EXECUTE
+// with a cursor type and read by COM_STMT_FETCH.
+//
+// The statement is executed by a goroutine which hands the results
+// over one at a time, as they are fetched: at most one result of the
+// handler is buffered, whatever the size of the whole result set.
+type cursor struct {
+	results chan *sqltypes.Result
+	// done is closed when the cursor is closed, to stop the execution.
+	done   chan struct{}
+	closed bool
+	// err is the error of the execution. It is set before results is closed.
+	err error
+
+	fields  []*querypb.Field
+	pending [][]sqltypes.Value
+	eof     bool
+}
+
+func newCursor() *cursor {
+	return &cursor{
+		results: make(chan *sqltypes.Result),
+		done:    make(chan struct{}),
+	}
+}
+
+// run executes the statement. It is meant to be called in its own
+// goroutine and returns once all the results are fetched or the
+// cursor is closed.
+func (cur *cursor) run(execute func(callback func(*sqltypes.Result) error) error) {
+	cur.err = execute(func(qr *sqltypes.Result) error {
+		select {
+		case cur.results <- qr:
+			return nil
+		case <-cur.done:
+			return errCursorClosed
+		}
+	})
+	close(cur.results)
+}
+
+// next returns the next result of the execution, or nil once
+// the execution is over.
+func (cur *cursor) next() (*sqltypes.Result, error) {
+	qr, ok := <-cur.results
+	if !ok {
+		cur.eof = true
+		return nil, cur.err
+	}
+	return qr, nil
+}
+
+// fetch returns the next n rows at most. Once it returns, either
+// more rows are pending or the cursor is exhausted.
+func (cur *cursor) fetch(n int) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	for {
+		for len(cur.pending) == 0 && !cur.eof {
+			qr, err := cur.next()
+			if err != nil {
+				return nil, err
+			}
+			if qr != nil {
+				cur.pending = qr.Rows
+			}
+		}
+		if len(rows) == n || len(cur.pending) == 0 {
+			return rows, nil
+		}
+		count := n - len(rows)
+		if count > len(cur.pending) {
+			count = len(cur.pending)
+		}
+		rows = append(rows, cur.pending[:count]...)
+		cur.pending = cur.pending[count:]
+	}
+}
+
+// exhausted returns true once all the rows were fetched.
+func (cur *cursor) exhausted() bool {
+	return cur.eof && len(cur.pending) == 0
+}
+
+// close stops the execution. It doesn't wait for it to return.
+func (cur *cursor) close() {
+	if cur.closed {
+		return
+	}
+	cur.closed = true
+	cur.pending = nil
+	close(cur.done)
+}
@@ -40,7 +40,7 @@ import (
 // Returns SQLError(CRServerGone) if it can't.
 func (c *Conn) WriteComQuery(query string) error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 
 	data, pos := c.startEphemeralPacketWithHeader(len(query) + 1)
 	data[pos] = ComQuery
@@ -346,8 +346,35 @@ func (c *Conn) ExecuteFetchWithWarningCount(query string, maxrows int, wantfield
 	return res, warnings, err
 }
 
+// ExecuteFetchWithRowCheck is like ExecuteFetch, but calls check with every
+// row before adding it to the result. If check returns an error, the rest of
+// the result is read and ignored, and the error is returned.
+func (c *Conn) ExecuteFetchWithRowCheck(query string, maxrows int, wantfields bool, check func(row []sqltypes.Value) error) (result *sqltypes.Result, err error) {
+	defer func() {
+		if err != nil {
+			if sqlerr, ok := err.(*SQLError); ok {
+				sqlerr.Query = query
+			}
+		}
+	}()
+
+	// Send the query as a COM_QUERY packet.
+	if err = c.WriteComQuery(query); err != nil {
+		return nil, err
+	}
+
+	res, _, _, err := c.readQueryResult(maxrows, wantfields, check)
+	return res, err
+}
+
 // ReadQueryResult gets the result from the last written query.
 func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (*sqltypes.Result, bool, uint16, error) {
+	return c.readQueryResult(maxrows, wantfields, nil)
+}
+
+// readQueryResult gets the result from the last written query. If check is
+// not nil, it is called with every row.
+func (c *Conn) readQueryResult(maxrows int, wantfields bool, check func(row []sqltypes.Value) error) (*sqltypes.Result, bool, uint16, error) {
 	// Get the result.
 	colNumber, packetOk, err := c.readComQueryResponse()
 	if err != nil {
@@ -469,6 +496,15 @@ func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (*sqltypes.Result,
 			c.recycleReadPacket()
 			return nil, false, 0, err
 		}
+		if check != nil {
+			if err := check(row); err != nil {
+				c.recycleReadPacket()
+				if drainErr := c.drainResults(); drainErr != nil {
+					return nil, false, 0, drainErr
+				}
+				return nil, false, 0, err
+			}
+		}
 		result.Rows = append(result.Rows, row)
 		c.recycleReadPacket()
 	}
@@ -877,6 +913,15 @@ func (c *Conn) parseComStmtReset(data []byte) (uint32, bool) {
 	return val, ok
 }
 
+func (c *Conn) parseComStmtFetch(data []byte) (uint32, uint32, bool) {
+	stmtID, pos, ok := readUint32(data, 1)
+	if !ok {
+		return 0, 0, false
+	}
+	numRows, _, ok := readUint32(data, pos)
+	return stmtID, numRows, ok
+}
+
 func (c *Conn) parseComInitDB(data []byte) string {
 	return string(data[1:])
 }
@@ -984,6 +1029,23 @@ func (c *Conn) writeFields(result *sqltypes.Result) error {
 	return nil
 }
 
+// writeCursorFields sends the fields of a Result for which a cursor
+// was opened. They are followed by an EOF packet flagging the cursor,
+// whatever the capabilities of the client: like MySQL, the EOF packet
+// is sent even with CapabilityClientDeprecateEOF, since the clients
+// expect it to tell the cursor apart from a result set.
+func (c *Conn) writeCursorFields(result *sqltypes.Result) error {
+	if err := c.sendColumnCount(uint64(len(result.Fields))); err != nil {
+		return err
+	}
+	for _, field := range result.Fields {
+		if err := c.writeColumnDefinition(field); err != nil {
+			return err
+		}
+	}
+	return c.writeEOFPacket(c.StatusFlags|ServerStatusCursorExists, 0)
+}
+
 // writeRows sends the rows of a Result.
 func (c *Conn) writeRows(result *sqltypes.Result) error {
 	for _, row := range result.Rows {
@@ -997,12 +1059,16 @@ func (c *Conn) writeRows(result *sqltypes.Result) error {
 // writeEndResult concludes the sending of a Result.
 // if more is set to true, then it means there are more results afterwords
 func (c *Conn) writeEndResult(more bool, affectedRows, lastInsertID uint64, warnings uint16) error {
-	// Send either an EOF, or an OK packet.
-	// See doc.go.
 	flags := c.StatusFlags
 	if more {
 		flags |= ServerMoreResultsExists
 	}
+	return c.writeEndPacket(flags, affectedRows, lastInsertID, warnings)
+}
+
+// writeEndPacket sends either an EOF, or an OK packet with the given
+// status flags. See doc.go.
+func (c *Conn) writeEndPacket(flags uint16, affectedRows, lastInsertID uint64, warnings uint16) error {
 	if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
 		if err := c.writeEOFPacket(flags, warnings); err != nil {
 			return err
@@ -17,6 +17,7 @@ limitations under the License.
 package mysql
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
@@ -588,6 +589,55 @@ func TestQueries(t *testing.T) {
 	})
 }
 
+func TestExecuteFetchWithRowCheck(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: querypb.Type_INT32,
+		}},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeTrusted(querypb.Type_INT32, []byte("10"))},
+			{sqltypes.MakeTrusted(querypb.Type_INT32, []byte("20"))},
+			{sqltypes.MakeTrusted(querypb.Type_INT32, []byte("30"))},
+		},
+	}
+
+	var checked int
+	errTooLarge := errors.New("too large")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := cConn.ExecuteFetchWithRowCheck("select rows", 10000, true, func(row []sqltypes.Value) error {
+			checked++
+			if checked == 2 {
+				return errTooLarge
+			}
+			return nil
+		})
+		assert.Equal(t, errTooLarge, err)
+
+		// The rest of the result was read: the connection can be used again.
+		got, err := cConn.ExecuteFetch("select rows", 10000, true)
+		if assert.NoError(t, err) {
+			assert.True(t, got.Equal(result), "got %v, want %v", got, result)
+		}
+	}()
+
+	handler := testHandler{result: result}
+	for i := 0; i < 2; i++ {
+		require.True(t, sConn.handleNextCommand(&handler), "error handling command: %d", i)
+	}
+	<-done
+	assert.Equal(t, 2, checked)
+}
+
 func checkQuery(t *testing.T, query string, sConn, cConn *Conn, result *sqltypes.Result) {
 	// The protocol depends on the CapabilityClientDeprecateEOF flag.
 	// So we want to test both cases.
@@ -22,7 +22,7 @@ package mysql
 // See http://dev.mysql.com/doc/internals/en/com-binlog-dump.html for syntax.
 // Returns a SQLError.
 func (c *Conn) WriteComBinlogDump(serverID uint32, binlogFilename string, binlogPos uint32, flags uint16) error {
-	c.sequence = 0
+	c.resetSequence()
 	length := 1 + // ComBinlogDump
 		4 + // binlog-pos
 		2 + // flags
@@ -44,7 +44,7 @@ func (c *Conn) WriteComBinlogDump(serverID uint32, binlogFilename string, binlog
 // Only works with MySQL 5.6+ (and not MariaDB).
 // See http://dev.mysql.com/doc/internals/en/com-binlog-dump-gtid.html for syntax.
 func (c *Conn) WriteComBinlogDumpGTID(serverID uint32, binlogFilename string, binlogPos uint64, flags uint16, gtidSet []byte) error {
-	c.sequence = 0
+	c.resetSequence()
 	length := 1 + // ComBinlogDumpGTID
 		2 + // flags
 		4 + // server-id
@@ -121,6 +121,14 @@ type Handler interface {
 	ComResetConnection(c *Conn)
 }
 
+// ConnectionReadyHandler is an optional interface of the Handler.
+// If the Handler implements it, ConnectionReady is called after the
+// connection handshake, once the user is authenticated and before
+// any command is handled.
+type ConnectionReadyHandler interface {
+	ConnectionReady(c *Conn)
+}
+
 // Listener is the MySQL server protocol listener.
 type Listener struct {
 	// Construction parameters, set by NewListener.
@@ -156,6 +164,11 @@ type Listener struct {
 	// beyond which a warning is logged to identify the slow connection
 	SlowConnectWarnThreshold sync2.AtomicDuration
 
+	// CompressionAlgorithms are the algorithms of the compressed
+	// protocol the server accepts (CompressionZlib, CompressionZstd).
+	// The compressed protocol is not used if it is empty.
+	CompressionAlgorithms []string
+
 	// The following parameters are changed by the Accept routine.
 
 	// Incrementing ID for connection id.
@@ -312,12 +325,14 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	// Tell the handler about the connection coming and going.
 	l.handler.NewConnection(c)
 	defer l.handler.ConnectionClosed(c)
+	// Stop the statements still running for cursors first.
+	defer c.closeCursors()
 
 	// Adjust the count of open connections
 	defer connCount.Add(-1)
 
 	// First build and send the server handshake packet.
-	salt, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig.Load() != nil)
+	salt, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig.Load() != nil, l.compressionCapabilities())
 	if err != nil {
 		if err != io.EOF {
 			log.Errorf("Cannot send HandshakeV10 packet to %s: %v", c, err)
@@ -476,11 +491,20 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	}
 
 	// Negotiation worked, send OK packet.
+	if h, ok := l.handler.(ConnectionReadyHandler); ok {
+		h.ConnectionReady(c)
+	}
 	if err := c.writeOKPacket(&PacketOK{statusFlags: c.StatusFlags}); err != nil {
 		log.Errorf("Cannot write OK packet to %s: %v", c, err)
 		return
 	}
 
+	// The packets following the handshake are compressed, if negotiated.
+	if err := c.startCompression(); err != nil {
+		log.Errorf("Cannot start compression for %s: %v", c, err)
+		return
+	}
+
 	// Record how long we took to establish the connection
 	timings.Record(connectTimingKey, acceptTime)
 
@@ -499,6 +523,20 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	}
 }
 
+// compressionCapabilities returns the capability flags of the
+// compression algorithms the server accepts.
+func (l *Listener) compressionCapabilities() uint32 {
+	var capabilities uint32
+	for _, algorithm := range l.CompressionAlgorithms {
+		capability, err := compressionCapability(algorithm)
+		if err != nil {
+			continue
+		}
+		capabilities |= capability
+	}
+	return capabilities
+}
+
 // Close stops the listener, which prevents accept of any new connections. Existing connections won't be closed.
 func (l *Listener) Close() {
 	l.listener.Close()
@@ -518,7 +556,7 @@ func (l *Listener) isShutdown() bool {
 
 // writeHandshakeV10 writes the Initial Handshake Packet, server side.
 // It returns the salt data.
-func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS bool) ([]byte, error) {
+func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS bool, compressionCapabilities uint32) ([]byte, error) {
 	capabilities := CapabilityClientLongPassword |
 		CapabilityClientFoundRows |
 		CapabilityClientLongFlag |
@@ -535,6 +573,7 @@ func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, en
 	if enableTLS {
 		capabilities |= CapabilityClientSSL
 	}
+	capabilities |= int(compressionCapabilities)
 
 	length :=
 		1 + // protocol version
@@ -672,6 +711,14 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 		return "", "", nil, nil
 	}
 
+	// Use the compressed protocol if the client asked for an
+	// algorithm we accept, zstd first as MySQL does.
+	if serverCompression := l.compressionCapabilities(); clientFlags&serverCompression&CapabilityClientZstdCompressionAlgorithm != 0 {
+		c.Capabilities |= CapabilityClientZstdCompressionAlgorithm
+	} else if clientFlags&serverCompression&CapabilityClientCompress != 0 {
+		c.Capabilities |= CapabilityClientCompress
+	}
+
 	// username
 	username, pos, ok := readNullString(data, pos)
 	if !ok {
@@ -737,8 +784,17 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 
 	// Decode connection attributes send by the client
 	if clientFlags&CapabilityClientConnAttr != 0 {
-		if _, _, err := parseConnAttrs(data, pos); err != nil {
+		var err error
+		if _, pos, err = parseConnAttrs(data, pos); err != nil {
 			log.Warningf("Decode connection attributes send by the client: %v", err)
+			pos = len(data)
+		}
+	}
+
+	// The zstd compression level comes last.
+	if clientFlags&CapabilityClientZstdCompressionAlgorithm != 0 {
+		if level, _, ok := readByte(data, pos); ok {
+			c.compressionLevel = int(level)
 		}
 	}
 
@@ -108,7 +108,7 @@ func NewSQLErrorFromError(err error) error {
 			num = ERQueryInterrupted
 			ss = SSQueryInterrupted
 		case vtrpcpb.Code_UNKNOWN, vtrpcpb.Code_INVALID_ARGUMENT, vtrpcpb.Code_NOT_FOUND, vtrpcpb.Code_ALREADY_EXISTS,
-			vtrpcpb.Code_FAILED_PRECONDITION, vtrpcpb.Code_OUT_OF_RANGE, vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_DATA_LOSS:
+			vtrpcpb.Code_FAILED_PRECONDITION, vtrpcpb.Code_OUT_OF_RANGE, vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_DATA_LOSS, vtrpcpb.Code_CLUSTER_EVENT:
 			num = ERUnknownError
 		case vtrpcpb.Code_PERMISSION_DENIED, vtrpcpb.Code_UNAUTHENTICATED:
 			num = ERAccessDeniedError
@@ -164,9 +164,11 @@ var stateToMysqlCode = map[vterrors.State]struct {
 	vterrors.EmptyQuery:                   {num: EREmptyQuery, state: SSClientError},
 	vterrors.IncorrectGlobalLocalVar:      {num: ERIncorrectGlobalLocalVar, state: SSUnknownSQLState},
 	vterrors.InnodbReadOnly:               {num: ERInnodbReadOnly, state: SSUnknownSQLState},
+	vterrors.KillDeniedError:              {num: ERKillDenied, state: SSUnknownSQLState},
 	vterrors.LockOrActiveTransaction:      {num: ERLockOrActiveTransaction, state: SSUnknownSQLState},
 	vterrors.NoDB:                         {num: ERNoDb, state: SSNoDB},
 	vterrors.NoSuchTable:                  {num: ERNoSuchTable, state: SSUnknownTable},
+	vterrors.NoSuchThread:                 {num: ERNoSuchThread, state: SSUnknownSQLState},
 	vterrors.NotSupportedYet:              {num: ERNotSupportedYet, state: SSClientError},
 	vterrors.ForbidSchemaChange:           {num: ERForbidSchemaChange, state: SSUnknownSQLState},
 	vterrors.NetPacketTooLarge:            {num: ERNetPacketTooLarge, state: SSNetError},
@@ -177,6 +179,7 @@ var stateToMysqlCode = map[vterrors.State]struct {
 	vterrors.UnsupportedPS:                {num: ERUnsupportedPS, state: SSUnknownSQLState},
 	vterrors.UnknownSystemVariable:        {num: ERUnknownSystemVariable, state: SSUnknownSQLState},
 	vterrors.UnknownTable:                 {num: ERUnknownTable, state: SSUnknownTable},
+	vterrors.UserLimitReached:             {num: ERUserLimitReached, state: SSClientError},
 	vterrors.WrongGroupField:              {num: ERWrongGroupField, state: SSClientError},
 	vterrors.WrongNumberOfColumnsInSelect: {num: ERWrongNumberOfColumnsInSelect, state: SSWrongNumberOfColumns},
 	vterrors.WrongTypeForVar:              {num: ERWrongTypeForVar, state: SSClientError},
@@ -0,0 +1,439 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	// QueryLogJSONFile is the path of the JSON lines query log, which
+	// is rotated when it reaches QueryLogJSONFileMaxSize.
+	QueryLogJSONFile = flag.String("querylog-json-file", "", "Enable query logging as JSON lines to the specified file")
+
+	// QueryLogJSONFileMaxSize is the size at which the JSON lines query log is rotated.
+	QueryLogJSONFileMaxSize = flag.Int64("querylog-json-file-max-size", 100*1024*1024, "Size in bytes at which the -querylog-json-file is rotated")
+
+	// QueryLogJSONFileMaxBackups is the number of rotated JSON lines query logs to keep.
+	QueryLogJSONFileMaxBackups = flag.Int("querylog-json-file-max-backups", 5, "Number of rotated -querylog-json-file files to keep, as <file>.1 (most recent) to <file>.N")
+
+	// QueryLogWebhookURL is the URL to which batches of query log records are POSTed.
+	QueryLogWebhookURL = flag.String("querylog-webhook-url", "", "Enable query logging by POSTing batches of JSON records to the specified URL")
+
+	// QueryLogWebhookFormat is the body format of the webhook batches.
+	QueryLogWebhookFormat = flag.String("querylog-webhook-format", WebhookFormatNDJSON, "Body format of the -querylog-webhook-url batches: \"ndjson\" (one JSON record per line) or \"kafka\" (Kafka REST proxy JSON envelope)")
+
+	// QueryLogWebhookBatchSize is the maximum number of records per webhook batch.
+	QueryLogWebhookBatchSize = flag.Int("querylog-webhook-batch-size", 100, "Maximum number of query log records POSTed to -querylog-webhook-url at once")
+
+	// QueryLogWebhookFlushInterval is how often a partial webhook batch is sent.
+	QueryLogWebhookFlushInterval = flag.Duration("querylog-webhook-flush-interval", time.Second, "Interval at which a partial batch is POSTed to -querylog-webhook-url")
+
+	// QueryLogSlowThreshold only logs to the sinks the queries taking at least this long.
+	QueryLogSlowThreshold = flag.Duration("querylog-slow-threshold", 0, "Minimum duration of a query for it to be logged to -querylog-json-file and -querylog-webhook-url. 0 means all queries will be logged.")
+
+	// QueryLogSampleRate is the fraction of the queries that are logged to the sinks.
+	QueryLogSampleRate = flag.Float64("querylog-sample-rate", 1, "Fraction of the queries that are logged to -querylog-json-file and -querylog-webhook-url, between 0 and 1.")
+
+	sinkErrorCount = stats.NewCountersWithMultiLabels(
+		"StreamlogSinkErrors",
+		"Errors writing stream log records to sinks",
+		[]string{"Log", "Sink"})
+	sinkDropCount = stats.NewCountersWithSingleLabel(
+		"StreamlogSinkDroppedRecords",
+		"Stream log records dropped by sinks, because they could not be sent or too many were pending",
+		"Sink")
+)
+
+const (
+	// WebhookFormatNDJSON posts one JSON record per line.
+	WebhookFormatNDJSON = "ndjson"
+
+	// WebhookFormatKafka posts the records in the envelope accepted by
+	// the Kafka REST proxy: {"records": [{"value": <record>}, ...]}.
+	WebhookFormatKafka = "kafka"
+
+	webhookTimeout = 10 * time.Second
+
+	// webhookMaxPendingBatches is the number of batches a webhook sink
+	// buffers while it cannot send them fast enough. The records written
+	// beyond are dropped.
+	webhookMaxPendingBatches = 10
+)
+
+// Sink receives the formatted records of a StreamLogger.
+type Sink interface {
+	// Write is called with each non-empty formatted record, from a
+	// single goroutine.
+	Write(record []byte) error
+}
+
+// LogToSink formats the messages of the logger with logf and the given
+// params and writes them to the sink. Messages left out of the
+// -querylog-sample-rate sample, faster than -querylog-slow-threshold,
+// or that the formatter filters out (empty output) are not written.
+// Every sink samples the messages on its own.
+//
+// Returns the channel used for the subscription which can be used to close
+// it.
+func (logger *StreamLogger) LogToSink(name string, sink Sink, logf LogFormatter, params url.Values) chan interface{} {
+	logChan := logger.Subscribe(name)
+	go func() {
+		var buf bytes.Buffer
+		for message := range logChan {
+			if !shouldSinkLog(message) {
+				continue
+			}
+			buf.Reset()
+			if err := logf(&buf, params, message); err != nil {
+				log.Errorf("Error formatting %s record for %s: %v", logger.Name(), name, err)
+				sinkErrorCount.Add([]string{logger.Name(), name}, 1)
+				continue
+			}
+			if buf.Len() == 0 {
+				continue
+			}
+			if err := sink.Write(buf.Bytes()); err != nil {
+				log.Errorf("Error writing %s record to %s: %v", logger.Name(), name, err)
+				sinkErrorCount.Add([]string{logger.Name(), name}, 1)
+			}
+		}
+	}()
+	return logChan
+}
+
+// timedRecord is implemented by the records that have a duration, which
+// -querylog-slow-threshold applies to.
+type timedRecord interface {
+	TotalTime() time.Duration
+}
+
+// shouldSinkLog returns whether a message should be written to a sink,
+// according to -querylog-slow-threshold and -querylog-sample-rate.
+func shouldSinkLog(message interface{}) bool {
+	if record, ok := message.(timedRecord); ok && record.TotalTime() < *QueryLogSlowThreshold {
+		return false
+	}
+	switch rate := *QueryLogSampleRate; {
+	case rate >= 1:
+		return true
+	case rate <= 0:
+		return false
+	default:
+		return rand.Float64() < rate
+	}
+}
+
+// LogToSinks starts logging to the sinks enabled by the -querylog-json-file
+// and -querylog-webhook-url flags. The records are formatted as JSON,
+// regardless of -querylog-format.
+func (logger *StreamLogger) LogToSinks(logf LogFormatter) error {
+	params := url.Values{"full": {}, "format": {QueryLogFormatJSON}}
+
+	if *QueryLogJSONFile != "" {
+		sink, err := NewJSONFileSink(*QueryLogJSONFile, *QueryLogJSONFileMaxSize, *QueryLogJSONFileMaxBackups)
+		if err != nil {
+			return err
+		}
+		log.Infof("Logging %s queries as JSON lines to %s", logger.Name(), *QueryLogJSONFile)
+		logger.LogToSink("JSONFile", sink, logf, params)
+	}
+
+	if *QueryLogWebhookURL != "" {
+		sink, err := NewWebhookSink(*QueryLogWebhookURL, *QueryLogWebhookFormat, *QueryLogWebhookBatchSize, *QueryLogWebhookFlushInterval)
+		if err != nil {
+			return err
+		}
+		log.Infof("Logging %s queries to webhook %s", logger.Name(), *QueryLogWebhookURL)
+		logger.LogToSink("Webhook", sink, logf, params)
+	}
+	return nil
+}
+
+// JSONFileSink appends records to a file, and rotates the file when it
+// would grow beyond a maximum size.
+type JSONFileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewJSONFileSink opens (or creates) the file at path. A maxSize of 0
+// disables the rotation.
+func NewJSONFileSink(path string, maxSize int64, maxBackups int) (*JSONFileSink, error) {
+	sink := &JSONFileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+func (sink *JSONFileSink) open() error {
+	f, err := os.OpenFile(sink.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	sink.file = f
+	sink.size = fi.Size()
+	return nil
+}
+
+// Write implements Sink.
+func (sink *JSONFileSink) Write(record []byte) error {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+
+	if sink.file == nil {
+		if err := sink.open(); err != nil {
+			return err
+		}
+	}
+	if sink.maxSize > 0 && sink.size > 0 && sink.size+int64(len(record)) > sink.maxSize {
+		if err := sink.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := sink.file.Write(record)
+	sink.size += int64(n)
+	return err
+}
+
+// rotate shifts <path>.N-1 to <path>.N, ..., <path> to <path>.1 and
+// reopens an empty <path>. The oldest backup is overwritten.
+func (sink *JSONFileSink) rotate() error {
+	sink.file.Close()
+	sink.file = nil
+	if sink.maxBackups <= 0 {
+		if err := os.Remove(sink.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return sink.open()
+	}
+	for i := sink.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(sink.backup(i), sink.backup(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(sink.path, sink.backup(1)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return sink.open()
+}
+
+func (sink *JSONFileSink) backup(i int) string {
+	return fmt.Sprintf("%s.%d", sink.path, i)
+}
+
+// Close closes the file.
+func (sink *JSONFileSink) Close() error {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+
+	if sink.file == nil {
+		return nil
+	}
+	err := sink.file.Close()
+	sink.file = nil
+	return err
+}
+
+// WebhookSink POSTs the records in batches to an HTTP endpoint, from a
+// background goroutine. A batch is sent when it is full, or after the
+// flush interval. Batches that cannot be sent are dropped, as are the
+// records written while too many are pending.
+type WebhookSink struct {
+	url       string
+	format    string
+	batchSize int
+	client    *http.Client
+
+	mu      sync.Mutex
+	pending [][]byte
+
+	// full is signaled when a batch is full.
+	full chan struct{}
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewWebhookSink creates a WebhookSink and starts its flush loop.
+func NewWebhookSink(webhookURL, format string, batchSize int, flushInterval time.Duration) (*WebhookSink, error) {
+	switch format {
+	case WebhookFormatNDJSON, WebhookFormatKafka:
+	default:
+		return nil, fmt.Errorf("invalid webhook format %v: must be either %v or %v", format, WebhookFormatNDJSON, WebhookFormatKafka)
+	}
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("invalid webhook batch size %v: must be positive", batchSize)
+	}
+	if flushInterval <= 0 {
+		return nil, fmt.Errorf("invalid webhook flush interval %v: must be positive", flushInterval)
+	}
+	sink := &WebhookSink{
+		url:       webhookURL,
+		format:    format,
+		batchSize: batchSize,
+		client:    &http.Client{Timeout: webhookTimeout},
+		full:      make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+	sink.wg.Add(1)
+	go sink.flushLoop(flushInterval)
+	return sink, nil
+}
+
+func (sink *WebhookSink) flushLoop(flushInterval time.Duration) {
+	defer sink.wg.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-sink.full:
+		case <-sink.done:
+			return
+		}
+		if err := sink.Flush(); err != nil {
+			log.Errorf("Error flushing query log webhook batch: %v", err)
+		}
+	}
+}
+
+// Write implements Sink. It only buffers the record: the batches are
+// sent by the flush loop.
+func (sink *WebhookSink) Write(record []byte) error {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+
+	if len(sink.pending) >= webhookMaxPendingBatches*sink.batchSize {
+		sinkDropCount.Add("Webhook", 1)
+		return nil
+	}
+	sink.pending = append(sink.pending, append([]byte(nil), bytes.TrimSpace(record)...))
+	if len(sink.pending)%sink.batchSize == 0 {
+		select {
+		case sink.full <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Flush sends the pending records, if any, in batches. It returns the
+// first error, the batches that failed being dropped.
+func (sink *WebhookSink) Flush() error {
+	sink.mu.Lock()
+	pending := sink.pending
+	sink.pending = nil
+	sink.mu.Unlock()
+
+	var firstErr error
+	for len(pending) > 0 {
+		n := sink.batchSize
+		if n > len(pending) {
+			n = len(pending)
+		}
+		if err := sink.send(pending[:n]); err != nil {
+			sinkDropCount.Add("Webhook", int64(n))
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		pending = pending[n:]
+	}
+	return firstErr
+}
+
+// send POSTs a batch.
+func (sink *WebhookSink) send(batch [][]byte) error {
+	body, contentType, err := sink.encode(batch)
+	if err != nil {
+		return err
+	}
+	resp, err := sink.client.Post(sink.url, contentType, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	ioutil.ReadAll(resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook %v returned %v for a batch of %v records", sink.url, resp.Status, len(batch))
+	}
+	return nil
+}
+
+func (sink *WebhookSink) encode(batch [][]byte) ([]byte, string, error) {
+	if sink.format == WebhookFormatNDJSON {
+		var buf bytes.Buffer
+		for _, record := range batch {
+			buf.Write(record)
+			buf.WriteByte('\n')
+		}
+		return buf.Bytes(), "application/x-ndjson", nil
+	}
+
+	type kafkaRecord struct {
+		Value interface{} `json:"value"`
+	}
+	records := make([]kafkaRecord, 0, len(batch))
+	for _, record := range batch {
+		// Records that are not valid JSON are sent as strings rather
+		// than failing the whole batch.
+		if json.Valid(record) {
+			records = append(records, kafkaRecord{Value: json.RawMessage(record)})
+		} else {
+			records = append(records, kafkaRecord{Value: string(record)})
+		}
+	}
+	body, err := json.Marshal(struct {
+		Records []kafkaRecord `json:"records"`
+	}{Records: records})
+	return body, "application/vnd.kafka.json.v2+json", err
+}
+
+// Close stops the flush loop and sends the pending records.
+func (sink *WebhookSink) Close() error {
+	close(sink.done)
+	sink.wg.Wait()
+	return sink.Flush()
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONFileSinkRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streamlog_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	logPath := path.Join(dir, "querylog.json")
+
+	sink, err := NewJSONFileSink(logPath, 10, 2)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	for _, record := range []string{"{\"a\":1}\n", "{\"a\":2}\n", "{\"a\":3}\n", "{\"a\":4}\n"} {
+		require.NoError(t, sink.Write([]byte(record)))
+	}
+
+	read := func(name string) string {
+		data, err := ioutil.ReadFile(name)
+		require.NoError(t, err)
+		return string(data)
+	}
+	assert.Equal(t, "{\"a\":4}\n", read(logPath))
+	assert.Equal(t, "{\"a\":3}\n", read(logPath+".1"))
+	assert.Equal(t, "{\"a\":2}\n", read(logPath+".2"))
+	_, err = os.Stat(logPath + ".3")
+	assert.True(t, os.IsNotExist(err), "only 2 backups should be kept")
+}
+
+func TestJSONFileSinkAppends(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streamlog_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	logPath := path.Join(dir, "querylog.json")
+	require.NoError(t, ioutil.WriteFile(logPath, []byte("{\"a\":1}\n"), 0644))
+
+	// The existing content counts towards the maximum size.
+	sink, err := NewJSONFileSink(logPath, 10, 1)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write([]byte("{\"a\":2}\n")))
+	require.NoError(t, sink.Close())
+
+	data, err := ioutil.ReadFile(logPath + ".1")
+	require.NoError(t, err)
+	assert.Equal(t, "{\"a\":1}\n", string(data))
+}
+
+type webhookRecorder struct {
+	mu           sync.Mutex
+	contentTypes []string
+	bodies       []string
+}
+
+func (wr *webhookRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	wr.contentTypes = append(wr.contentTypes, r.Header.Get("Content-Type"))
+	wr.bodies = append(wr.bodies, string(body))
+}
+
+func (wr *webhookRecorder) requests() ([]string, []string) {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	return append([]string(nil), wr.contentTypes...), append([]string(nil), wr.bodies...)
+}
+
+func TestWebhookSinkBatches(t *testing.T) {
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	sink, err := NewWebhookSink(server.URL, WebhookFormatNDJSON, 2, time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Write([]byte("{\"a\":1}\n")))
+	_, bodies := recorder.requests()
+	assert.Empty(t, bodies, "a partial batch should not be sent")
+
+	require.NoError(t, sink.Write([]byte("{\"a\":2}\n")))
+	require.NoError(t, sink.Write([]byte("{\"a\":3}\n")))
+	require.NoError(t, sink.Close())
+
+	contentTypes, bodies := recorder.requests()
+	assert.Equal(t, []string{"application/x-ndjson", "application/x-ndjson"}, contentTypes)
+	assert.Equal(t, []string{"{\"a\":1}\n{\"a\":2}\n", "{\"a\":3}\n"}, bodies)
+}
+
+func TestWebhookSinkFlushInterval(t *testing.T) {
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	sink, err := NewWebhookSink(server.URL, WebhookFormatNDJSON, 100, 10*time.Millisecond)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Write([]byte("{\"a\":1}\n")))
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if _, bodies := recorder.requests(); len(bodies) > 0 {
+			assert.Equal(t, []string{"{\"a\":1}\n"}, bodies)
+			return
+		}
+	}
+	t.Fatal("partial batch was not flushed")
+}
+
+func TestWebhookSinkKafka(t *testing.T) {
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	sink, err := NewWebhookSink(server.URL, WebhookFormatKafka, 100, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write([]byte("{\"a\":1}\n")))
+	require.NoError(t, sink.Write([]byte("not json\n")))
+	require.NoError(t, sink.Close())
+
+	contentTypes, bodies := recorder.requests()
+	assert.Equal(t, []string{"application/vnd.kafka.json.v2+json"}, contentTypes)
+	assert.Equal(t, []string{`{"records":[{"value":{"a":1}},{"value":"not json"}]}`}, bodies)
+}
+
+func TestWebhookSinkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sink, err := NewWebhookSink(server.URL, WebhookFormatNDJSON, 2, time.Hour)
+	require.NoError(t, err)
+
+	// The batches are sent in the background, and dropped on errors.
+	dropped := sinkDropCount.Counts()["Webhook"]
+	require.NoError(t, sink.Write([]byte("{\"a\":1}\n")))
+	err = sink.Close()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+	assert.Equal(t, dropped+1, sinkDropCount.Counts()["Webhook"])
+
+	_, err = NewWebhookSink(server.URL, "xml", 1, time.Hour)
+	assert.EqualError(t, err, "invalid webhook format xml: must be either ndjson or kafka")
+}
+
+func TestWebhookSinkDropsPending(t *testing.T) {
+	unblock := make(chan struct{})
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+		recorder.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	sink, err := NewWebhookSink(server.URL, WebhookFormatNDJSON, 1, time.Hour)
+	require.NoError(t, err)
+
+	// Writes don't wait for the webhook, and the records beyond the
+	// pending batches are dropped.
+	dropped := sinkDropCount.Counts()["Webhook"]
+	for i := 0; i < 2*webhookMaxPendingBatches+1; i++ {
+		require.NoError(t, sink.Write([]byte("{\"a\":1}\n")))
+	}
+	assert.Less(t, dropped, sinkDropCount.Counts()["Webhook"])
+	close(unblock)
+	require.NoError(t, sink.Close())
+
+	_, bodies := recorder.requests()
+	assert.Equal(t, 2*webhookMaxPendingBatches+1, len(bodies)+int(sinkDropCount.Counts()["Webhook"]-dropped))
+}
+
+type recordingSink struct {
+	records chan string
+}
+
+func (rs *recordingSink) Write(record []byte) error {
+	rs.records <- string(record)
+	return nil
+}
+
+func TestLogToSink(t *testing.T) {
+	logger := New("logger", 10)
+	sink := &recordingSink{records: make(chan string, 10)}
+	logf := func(w io.Writer, params url.Values, m interface{}) error {
+		// An empty record is filtered out.
+		if m.(*logMessage).val == "" {
+			return nil
+		}
+		_, err := io.WriteString(w, params.Get("format")+":"+m.(*logMessage).val+"\n")
+		return err
+	}
+	ch := logger.LogToSink("test", sink, logf, url.Values{"format": {"json"}})
+	defer logger.Unsubscribe(ch)
+
+	logger.Send(&logMessage{""})
+	logger.Send(&logMessage{"test 1"})
+	select {
+	case got := <-sink.records:
+		assert.Equal(t, "json:test 1\n", got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("record was not written to the sink")
+	}
+}
+
+type timedMessage struct {
+	val       string
+	totalTime time.Duration
+}
+
+func (tm *timedMessage) TotalTime() time.Duration {
+	return tm.totalTime
+}
+
+func TestLogToSinkFilters(t *testing.T) {
+	defer func(threshold time.Duration) { *QueryLogSlowThreshold = threshold }(*QueryLogSlowThreshold)
+	*QueryLogSlowThreshold = time.Second
+
+	logger := New("logger", 10)
+	sink := &recordingSink{records: make(chan string, 10)}
+	logf := func(w io.Writer, params url.Values, m interface{}) error {
+		_, err := io.WriteString(w, m.(*timedMessage).val)
+		return err
+	}
+	ch := logger.LogToSink("test", sink, logf, url.Values{})
+	defer logger.Unsubscribe(ch)
+	other := logger.Subscribe("other")
+	defer logger.Unsubscribe(other)
+
+	// The filters only apply to the sinks: the other subscribers get
+	// every message.
+	logger.Send(&timedMessage{"fast", time.Millisecond})
+	logger.Send(&timedMessage{"slow", time.Second})
+	for _, want := range []string{"fast", "slow"} {
+		assert.Equal(t, want, (<-other).(*timedMessage).val)
+	}
+	select {
+	case got := <-sink.records:
+		assert.Equal(t, "slow", got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("record was not written to the sink")
+	}
+}
+
+func TestShouldSinkLog(t *testing.T) {
+	defer func(threshold time.Duration, rate float64) {
+		*QueryLogSlowThreshold = threshold
+		*QueryLogSampleRate = rate
+	}(*QueryLogSlowThreshold, *QueryLogSampleRate)
+
+	assert.True(t, shouldSinkLog(&timedMessage{"fast", 0}))
+	assert.True(t, shouldSinkLog(&logMessage{"untimed"}))
+	*QueryLogSlowThreshold = time.Second
+	assert.False(t, shouldSinkLog(&timedMessage{"fast", time.Millisecond}))
+	assert.True(t, shouldSinkLog(&timedMessage{"slow", time.Second}))
+	assert.True(t, shouldSinkLog(&logMessage{"untimed"}))
+	*QueryLogSampleRate = 0
+	assert.False(t, shouldSinkLog(&timedMessage{"slow", time.Second}))
+}
+
+func TestLogFormat(t *testing.T) {
+	assert.Equal(t, QueryLogFormatText, LogFormat(url.Values{}))
+	assert.Equal(t, QueryLogFormatJSON, LogFormat(url.Values{"format": {"json"}}))
+}
@@ -209,6 +209,15 @@ func GetFormatter(logger *StreamLogger) LogFormatter {
 	}
 }
 
+// LogFormat returns the format of the query log records written with
+// the given params: the "format" param if set, or -querylog-format.
+func LogFormat(params url.Values) string {
+	if format := params.Get("format"); format != "" {
+		return format
+	}
+	return *QueryLogFormat
+}
+
 // ShouldEmitLog returns whether the log with the given SQL query
 // should be emitted or filtered
 func ShouldEmitLog(sql string, rowsAffected, rowsReturned uint64) bool {
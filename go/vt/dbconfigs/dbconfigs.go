@@ -82,6 +82,8 @@ type DBConfigs struct {
 	ServerName                 string `json:"serverName,omitempty"`
 	ConnectTimeoutMilliseconds int    `json:"connectTimeoutMilliseconds,omitempty"`
 	DBName                     string `json:"dbName,omitempty"`
+	Compression                string `json:"compression,omitempty"`
+	CompressionLevel           int    `json:"compressionLevel,omitempty"`
 
 	App          UserConfig `json:"app,omitempty"`
 	Dba          UserConfig `json:"dba,omitempty"`
@@ -132,6 +134,8 @@ func registerBaseFlags() {
 	flag.StringVar(&GlobalDBConfigs.SslKey, "db_ssl_key", "", "connection ssl key")
 	flag.StringVar(&GlobalDBConfigs.ServerName, "db_server_name", "", "server name of the DB we are connecting to.")
 	flag.IntVar(&GlobalDBConfigs.ConnectTimeoutMilliseconds, "db_connect_timeout_ms", 0, "connection timeout to mysqld in milliseconds (0 for no timeout)")
+	flag.StringVar(&GlobalDBConfigs.Compression, "db_compression", "", "Compression algorithm of the protocol with mysqld: zlib, or zstd (MySQL 8.0.18+). The protocol is not compressed if empty.")
+	flag.IntVar(&GlobalDBConfigs.CompressionLevel, "db_compression_level", 0, "zstd compression level of the protocol with mysqld (0 for the default level)")
 }
 
 // The flags will change the global singleton
@@ -359,6 +363,8 @@ func (dbcfgs *DBConfigs) InitWithSocket(defaultSocketFile string) {
 			cp.Flavor = dbcfgs.Flavor
 		}
 		cp.ConnectTimeoutMs = uint64(dbcfgs.ConnectTimeoutMilliseconds)
+		cp.Compression = dbcfgs.Compression
+		cp.CompressionLevel = dbcfgs.CompressionLevel
 
 		cp.Uname = uc.User
 		cp.Pass = uc.Password
@@ -53,6 +53,7 @@ func TestInit(t *testing.T) {
 		SslCert:                    "f",
 		SslKey:                     "g",
 		ConnectTimeoutMilliseconds: 250,
+		Compression:                "zstd",
 		App: UserConfig{
 			User:     "app",
 			Password: "apppass",
@@ -84,6 +85,7 @@ func TestInit(t *testing.T) {
 		Flags:            2,
 		Flavor:           "flavor",
 		ConnectTimeoutMs: 250,
+		Compression:      "zstd",
 	}
 	assert.Equal(t, want, dbConfigs.appParams)
 
@@ -99,6 +101,7 @@ func TestInit(t *testing.T) {
 		SslCert:          "f",
 		SslKey:           "g",
 		ConnectTimeoutMs: 250,
+		Compression:      "zstd",
 	}
 	assert.Equal(t, want, dbConfigs.appdebugParams)
 	want = mysql.ConnParams{
@@ -115,6 +118,7 @@ func TestInit(t *testing.T) {
 		SslCert:          "f",
 		SslKey:           "g",
 		ConnectTimeoutMs: 250,
+		Compression:      "zstd",
 	}
 	assert.Equal(t, want, dbConfigs.dbaParams)
 
@@ -502,6 +502,34 @@ func (ExecuteOptions_PlannerVersion) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{6, 3}
 }
 
+type ExecuteOptions_Priority int32
+
+const (
+	ExecuteOptions_NORMAL ExecuteOptions_Priority = 0
+	ExecuteOptions_HIGH   ExecuteOptions_Priority = 1
+	ExecuteOptions_LOW    ExecuteOptions_Priority = 2
+)
+
+var ExecuteOptions_Priority_name = map[int32]string{
+	0: "NORMAL",
+	1: "HIGH",
+	2: "LOW",
+}
+
+var ExecuteOptions_Priority_value = map[string]int32{
+	"NORMAL": 0,
+	"HIGH":   1,
+	"LOW":    2,
+}
+
+func (x ExecuteOptions_Priority) String() string {
+	return proto.EnumName(ExecuteOptions_Priority_name, int32(x))
+}
+
+func (ExecuteOptions_Priority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{6, 4}
+}
+
 // The category of one statement.
 type StreamEvent_Statement_Category int32
 
@@ -952,10 +980,14 @@ type ExecuteOptions struct {
 	// has_created_temp_tables signals whether plans created in this session should be cached or not
 	// if the user has created temp tables, Vitess will not reuse plans created for this session in other sessions.
 	// The current session can still use other sessions cached plans.
-	HasCreatedTempTables bool     `protobuf:"varint,12,opt,name=has_created_temp_tables,json=hasCreatedTempTables,proto3" json:"has_created_temp_tables,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	HasCreatedTempTables bool `protobuf:"varint,12,opt,name=has_created_temp_tables,json=hasCreatedTempTables,proto3" json:"has_created_temp_tables,omitempty"`
+	// priority specifies the priority class of the query in the tablet:
+	// requests waiting for a connection are served in priority order, and
+	// low priority requests are shed first when the tablet is overloaded.
+	Priority             ExecuteOptions_Priority `protobuf:"varint,13,opt,name=priority,proto3,enum=query.ExecuteOptions_Priority" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *ExecuteOptions) Reset()         { *m = ExecuteOptions{} }
@@ -1047,6 +1079,13 @@ func (m *ExecuteOptions) GetHasCreatedTempTables() bool {
 	return false
 }
 
+func (m *ExecuteOptions) GetPriority() ExecuteOptions_Priority {
+	if m != nil {
+		return m.Priority
+	}
+	return ExecuteOptions_NORMAL
+}
+
 // Field describes a single column returned by a query
 type Field struct {
 	// name of the field as returned by mysql C API
@@ -3664,7 +3703,9 @@ type MessageStreamRequest struct {
 	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
 	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
 	// name is the message table name.
-	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// group is the consumer group. An empty group is the default group.
+	Group                string   `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3731,6 +3772,13 @@ func (m *MessageStreamRequest) GetName() string {
 	return ""
 }
 
+func (m *MessageStreamRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
 // MessageStreamResponse is a response for MessageStream.
 type MessageStreamResponse struct {
 	Result               *QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -3785,8 +3833,10 @@ type MessageAckRequest struct {
 	ImmediateCallerId *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
 	Target            *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
 	// name is the message table name.
-	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Ids                  []*Value `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+	Name string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Ids  []*Value `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+	// group is the consumer group. An empty group is the default group.
+	Group                string   `protobuf:"bytes,6,opt,name=group,proto3" json:"group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3860,6 +3910,13 @@ func (m *MessageAckRequest) GetIds() []*Value {
 	return nil
 }
 
+func (m *MessageAckRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
 // MessageAckResponse is the response for MessageAck.
 type MessageAckResponse struct {
 	// result contains the result of the ack operation.
@@ -4441,7 +4498,11 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// table_schema_changed is the list of tables whose schema has changed
+	// since the last health message. It is used by vtgate to keep its
+	// knowledge of the table schemas up to date.
+	TableSchemaChanged   []string `protobuf:"bytes,7,rep,name=table_schema_changed,json=tableSchemaChanged,proto3" json:"table_schema_changed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4522,6 +4583,13 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetTableSchemaChanged() []string {
+	if m != nil {
+		return m.TableSchemaChanged
+	}
+	return nil
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -4807,6 +4875,7 @@ func init() {
 	proto.RegisterEnum("query.ExecuteOptions_Workload", ExecuteOptions_Workload_name, ExecuteOptions_Workload_value)
 	proto.RegisterEnum("query.ExecuteOptions_TransactionIsolation", ExecuteOptions_TransactionIsolation_name, ExecuteOptions_TransactionIsolation_value)
 	proto.RegisterEnum("query.ExecuteOptions_PlannerVersion", ExecuteOptions_PlannerVersion_name, ExecuteOptions_PlannerVersion_value)
+	proto.RegisterEnum("query.ExecuteOptions_Priority", ExecuteOptions_Priority_name, ExecuteOptions_Priority_value)
 	proto.RegisterEnum("query.StreamEvent_Statement_Category", StreamEvent_Statement_Category_name, StreamEvent_Statement_Category_value)
 	proto.RegisterType((*Target)(nil), "query.Target")
 	proto.RegisterType((*VTGateCallerID)(nil), "query.VTGateCallerID")
@@ -4875,214 +4944,218 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x90, 0x1b, 0x49,
-	0x56, 0xee, 0x2a, 0xfd, 0xb4, 0xf4, 0xd4, 0x52, 0x67, 0x67, 0x77, 0xdb, 0x9a, 0xf6, 0x8c, 0xa7,
-	0xb7, 0x76, 0x67, 0xd7, 0x18, 0x68, 0x7b, 0xda, 0x5e, 0x63, 0x66, 0x17, 0x98, 0x6a, 0x75, 0xb5,
-	0x47, 0xb6, 0x54, 0x92, 0x53, 0x25, 0x7b, 0x3d, 0x41, 0x44, 0x45, 0x59, 0x4a, 0xab, 0x2b, 0xba,
-	0x54, 0xa5, 0xae, 0xaa, 0x6e, 0x8f, 0x6e, 0x86, 0x65, 0x59, 0xfe, 0x59, 0xfe, 0x77, 0xd9, 0x60,
-	0x83, 0x08, 0x0e, 0x04, 0x17, 0x22, 0xb8, 0x71, 0xe6, 0x30, 0x41, 0x70, 0x20, 0xe0, 0x08, 0x1c,
-	0x58, 0x86, 0x20, 0xe0, 0xb4, 0x41, 0x70, 0xe0, 0xc0, 0x81, 0x20, 0xf2, 0xa7, 0x4a, 0x52, 0xb7,
-	0xc6, 0xee, 0xf5, 0x32, 0xb1, 0x61, 0x8f, 0x6f, 0xf9, 0x7e, 0xf2, 0xe7, 0x7d, 0xf9, 0xf2, 0xbd,
-	0x57, 0xa9, 0x14, 0x94, 0x0e, 0x8f, 0x68, 0x38, 0xde, 0x1a, 0x85, 0x41, 0x1c, 0xe0, 0x1c, 0x27,
-	0x36, 0x2a, 0x71, 0x30, 0x0a, 0xfa, 0x4e, 0xec, 0x08, 0xf6, 0x46, 0xe9, 0x38, 0x0e, 0x47, 0x3d,
-	0x41, 0x68, 0x5f, 0x53, 0x20, 0x6f, 0x39, 0xe1, 0x80, 0xc6, 0x78, 0x03, 0x0a, 0x07, 0x74, 0x1c,
-	0x8d, 0x9c, 0x1e, 0xad, 0x2a, 0x9b, 0xca, 0xa5, 0x22, 0x49, 0x69, 0xbc, 0x06, 0xb9, 0x68, 0xdf,
-	0x09, 0xfb, 0x55, 0x95, 0x0b, 0x04, 0x81, 0xbf, 0x08, 0xa5, 0xd8, 0x79, 0xe8, 0xd1, 0xd8, 0x8e,
-	0xc7, 0x23, 0x5a, 0xcd, 0x6c, 0x2a, 0x97, 0x2a, 0xdb, 0x6b, 0x5b, 0xe9, 0x7c, 0x16, 0x17, 0x5a,
-	0xe3, 0x11, 0x25, 0x10, 0xa7, 0x6d, 0x8c, 0x21, 0xdb, 0xa3, 0x9e, 0x57, 0xcd, 0xf2, 0xb1, 0x78,
-	0x5b, 0xdb, 0x85, 0xca, 0x3d, 0xeb, 0x96, 0x13, 0xd3, 0x9a, 0xe3, 0x79, 0x34, 0xac, 0xef, 0xb2,
-	0xe5, 0x1c, 0x45, 0x34, 0xf4, 0x9d, 0x61, 0xba, 0x9c, 0x84, 0xc6, 0xe7, 0x20, 0x3f, 0x08, 0x83,
-	0xa3, 0x51, 0x54, 0x55, 0x37, 0x33, 0x97, 0x8a, 0x44, 0x52, 0xda, 0xcf, 0x02, 0x18, 0xc7, 0xd4,
-	0x8f, 0xad, 0xe0, 0x80, 0xfa, 0xf8, 0x75, 0x28, 0xc6, 0xee, 0x90, 0x46, 0xb1, 0x33, 0x1c, 0xf1,
-	0x21, 0x32, 0x64, 0xc2, 0xf8, 0x18, 0x93, 0x36, 0xa0, 0x30, 0x0a, 0x22, 0x37, 0x76, 0x03, 0x9f,
-	0xdb, 0x53, 0x24, 0x29, 0xad, 0xfd, 0x34, 0xe4, 0xee, 0x39, 0xde, 0x11, 0xc5, 0x6f, 0x42, 0x96,
-	0x1b, 0xac, 0x70, 0x83, 0x4b, 0x5b, 0x02, 0x74, 0x6e, 0x27, 0x17, 0xb0, 0xb1, 0x8f, 0x99, 0x26,
-	0x1f, 0x7b, 0x89, 0x08, 0x42, 0x3b, 0x80, 0xa5, 0x1d, 0xd7, 0xef, 0xdf, 0x73, 0x42, 0x97, 0x81,
-	0xf1, 0x9c, 0xc3, 0xe0, 0xcf, 0x41, 0x9e, 0x37, 0xa2, 0x6a, 0x66, 0x33, 0x73, 0xa9, 0xb4, 0xbd,
-	0x24, 0x3b, 0xf2, 0xb5, 0x11, 0x29, 0xd3, 0xfe, 0x4a, 0x01, 0xd8, 0x09, 0x8e, 0xfc, 0xfe, 0x5d,
-	0x26, 0xc4, 0x08, 0x32, 0xd1, 0xa1, 0x27, 0x81, 0x64, 0x4d, 0x7c, 0x07, 0x2a, 0x0f, 0x5d, 0xbf,
-	0x6f, 0x1f, 0xcb, 0xe5, 0x08, 0x2c, 0x4b, 0xdb, 0x9f, 0x93, 0xc3, 0x4d, 0x3a, 0x6f, 0x4d, 0xaf,
-	0x3a, 0x32, 0xfc, 0x38, 0x1c, 0x93, 0xf2, 0xc3, 0x69, 0xde, 0x46, 0x17, 0xf0, 0x69, 0x25, 0x36,
-	0xe9, 0x01, 0x1d, 0x27, 0x93, 0x1e, 0xd0, 0x31, 0xfe, 0x91, 0x69, 0x8b, 0x4a, 0xdb, 0xab, 0xc9,
-	0x5c, 0x53, 0x7d, 0xa5, 0x99, 0xef, 0xa8, 0x37, 0x15, 0xed, 0x2f, 0x16, 0xa1, 0x62, 0x7c, 0x40,
-	0x7b, 0x47, 0x31, 0x6d, 0x8d, 0xd8, 0x1e, 0x44, 0xb8, 0x09, 0xcb, 0xae, 0xdf, 0xf3, 0x8e, 0xfa,
-	0xb4, 0x6f, 0x3f, 0x72, 0xa9, 0xd7, 0x8f, 0xb8, 0x1f, 0x55, 0xd2, 0x75, 0xcf, 0xea, 0x6f, 0xd5,
-	0xa5, 0xf2, 0x1e, 0xd7, 0x25, 0x15, 0x77, 0x86, 0xc6, 0x97, 0x61, 0xa5, 0xe7, 0xb9, 0xd4, 0x8f,
-	0xed, 0x47, 0xcc, 0x5e, 0x3b, 0x0c, 0x1e, 0x47, 0xd5, 0xdc, 0xa6, 0x72, 0xa9, 0x40, 0x96, 0x85,
-	0x60, 0x8f, 0xf1, 0x49, 0xf0, 0x38, 0xc2, 0xef, 0x40, 0xe1, 0x71, 0x10, 0x1e, 0x78, 0x81, 0xd3,
-	0xaf, 0xe6, 0xf9, 0x9c, 0x17, 0xe7, 0xcf, 0x79, 0x5f, 0x6a, 0x91, 0x54, 0x1f, 0x5f, 0x02, 0x14,
-	0x1d, 0x7a, 0x76, 0x44, 0x3d, 0xda, 0x8b, 0x6d, 0xcf, 0x1d, 0xba, 0x71, 0xb5, 0xc0, 0x5d, 0xb2,
-	0x12, 0x1d, 0x7a, 0x1d, 0xce, 0x6e, 0x30, 0x2e, 0xb6, 0x61, 0x3d, 0x0e, 0x1d, 0x3f, 0x72, 0x7a,
-	0x6c, 0x30, 0xdb, 0x8d, 0x02, 0xcf, 0xe1, 0xee, 0x58, 0xe4, 0x53, 0x5e, 0x9e, 0x3f, 0xa5, 0x35,
-	0xe9, 0x52, 0x4f, 0x7a, 0x90, 0xb5, 0x78, 0x0e, 0x17, 0xbf, 0x0d, 0xeb, 0xd1, 0x81, 0x3b, 0xb2,
-	0xf9, 0x38, 0xf6, 0xc8, 0x73, 0x7c, 0xbb, 0xe7, 0xf4, 0xf6, 0x69, 0x15, 0xb8, 0xd9, 0x98, 0x09,
-	0xf9, 0xbe, 0xb7, 0x3d, 0xc7, 0xaf, 0x31, 0x09, 0x03, 0x9d, 0xe9, 0xf9, 0x34, 0xb4, 0x8f, 0x69,
-	0x18, 0xb1, 0xd5, 0x94, 0x9e, 0x06, 0x7a, 0x5b, 0x28, 0xdf, 0x13, 0xba, 0xa4, 0x32, 0x9a, 0xa1,
-	0xf1, 0x17, 0xe1, 0xfc, 0xbe, 0x13, 0xd9, 0xbd, 0x90, 0x3a, 0x31, 0xed, 0xdb, 0x31, 0x1d, 0x8e,
-	0xec, 0x58, 0xf8, 0xe0, 0x12, 0x5f, 0xc3, 0xda, 0xbe, 0x13, 0xd5, 0x84, 0xd4, 0xa2, 0xc3, 0x11,
-	0x8f, 0x23, 0x91, 0xf6, 0x25, 0xa8, 0xcc, 0xee, 0x26, 0x5e, 0x81, 0xb2, 0xf5, 0xa0, 0x6d, 0xd8,
-	0xba, 0xb9, 0x6b, 0x9b, 0x7a, 0xd3, 0x40, 0x0b, 0xb8, 0x0c, 0x45, 0xce, 0x6a, 0x99, 0x8d, 0x07,
-	0x48, 0xc1, 0x8b, 0x90, 0xd1, 0x1b, 0x0d, 0xa4, 0x6a, 0x37, 0xa1, 0x90, 0x6c, 0x0b, 0x5e, 0x86,
-	0x52, 0xd7, 0xec, 0xb4, 0x8d, 0x5a, 0x7d, 0xaf, 0x6e, 0xec, 0xa2, 0x05, 0x5c, 0x80, 0x6c, 0xab,
-	0x61, 0xb5, 0x91, 0x22, 0x5a, 0x7a, 0x1b, 0xa9, 0xac, 0xe7, 0xee, 0x8e, 0x8e, 0x32, 0xda, 0x9f,
-	0x2a, 0xb0, 0x36, 0x0f, 0x5e, 0x5c, 0x82, 0xc5, 0x5d, 0x63, 0x4f, 0xef, 0x36, 0x2c, 0xb4, 0x80,
-	0x57, 0x61, 0x99, 0x18, 0x6d, 0x43, 0xb7, 0xf4, 0x9d, 0x86, 0x61, 0x13, 0x43, 0xdf, 0x45, 0x0a,
-	0xc6, 0x50, 0x61, 0x2d, 0xbb, 0xd6, 0x6a, 0x36, 0xeb, 0x96, 0x65, 0xec, 0x22, 0x15, 0xaf, 0x01,
-	0xe2, 0xbc, 0xae, 0x39, 0xe1, 0x66, 0x30, 0x82, 0xa5, 0x8e, 0x41, 0xea, 0x7a, 0xa3, 0xfe, 0x3e,
-	0x1b, 0x00, 0x65, 0xf1, 0x67, 0xe0, 0x8d, 0x5a, 0xcb, 0xec, 0xd4, 0x3b, 0x96, 0x61, 0x5a, 0x76,
-	0xc7, 0xd4, 0xdb, 0x9d, 0xf7, 0x5a, 0x16, 0x1f, 0x59, 0x18, 0x97, 0xc3, 0x15, 0x00, 0xbd, 0x6b,
-	0xb5, 0xc4, 0x38, 0x28, 0xaf, 0x1d, 0x42, 0x65, 0x16, 0x79, 0xb6, 0x2a, 0xb9, 0x44, 0xbb, 0xdd,
-	0xd0, 0x4d, 0xd3, 0x20, 0x68, 0x01, 0xe7, 0x41, 0xbd, 0x77, 0x4d, 0xd8, 0x7a, 0x8b, 0xfa, 0xd7,
-	0x91, 0xca, 0x06, 0x62, 0xad, 0x5b, 0x21, 0xa5, 0xfd, 0x31, 0xca, 0xb0, 0x75, 0x33, 0xba, 0x41,
-	0x1f, 0xc5, 0xdb, 0xc4, 0x1d, 0xec, 0xc7, 0x28, 0xcb, 0xd6, 0xcd, 0x78, 0xf7, 0xdd, 0x78, 0x7f,
-	0xcf, 0xf1, 0xbc, 0x87, 0x4e, 0xef, 0x00, 0xe5, 0x6e, 0x67, 0x0b, 0x0a, 0x52, 0x6f, 0x67, 0x0b,
-	0x2a, 0xca, 0xdc, 0xce, 0x16, 0x32, 0x28, 0xab, 0xfd, 0xa5, 0x0a, 0x39, 0xbe, 0x3d, 0x2c, 0xce,
-	0x4f, 0x45, 0x6f, 0xde, 0x4e, 0x63, 0x9e, 0xfa, 0x94, 0x98, 0xc7, 0x5d, 0x41, 0x46, 0x5f, 0x41,
-	0xe0, 0x0b, 0x50, 0x0c, 0xc2, 0x81, 0x70, 0x12, 0x99, 0x37, 0x0a, 0x41, 0x38, 0xe0, 0x8e, 0xc1,
-	0x62, 0x36, 0x4b, 0x37, 0x0f, 0x9d, 0x88, 0xf2, 0xa3, 0x5b, 0x24, 0x29, 0x8d, 0x5f, 0x03, 0xa6,
-	0x67, 0xf3, 0x75, 0xe4, 0xb9, 0x6c, 0x31, 0x08, 0x07, 0x26, 0x5b, 0xca, 0x67, 0xa1, 0xdc, 0x0b,
-	0xbc, 0xa3, 0xa1, 0x6f, 0x7b, 0xd4, 0x1f, 0xc4, 0xfb, 0xd5, 0xc5, 0x4d, 0xe5, 0x52, 0x99, 0x2c,
-	0x09, 0x66, 0x83, 0xf3, 0x70, 0x15, 0x16, 0x7b, 0xfb, 0x4e, 0x18, 0x51, 0x71, 0x5c, 0xcb, 0x24,
-	0x21, 0xf9, 0xac, 0xb4, 0xe7, 0x0e, 0x1d, 0x2f, 0xe2, 0x47, 0xb3, 0x4c, 0x52, 0x9a, 0x19, 0xf1,
-	0xc8, 0x73, 0x06, 0x11, 0x3f, 0x52, 0x65, 0x22, 0x08, 0xfc, 0x26, 0x94, 0xe4, 0x84, 0x1c, 0x82,
-	0x12, 0x5f, 0x0e, 0x08, 0x16, 0x43, 0x40, 0xfb, 0x09, 0xc8, 0x90, 0xe0, 0x31, 0x9b, 0x53, 0xac,
-	0x28, 0xaa, 0x2a, 0x9b, 0x99, 0x4b, 0x98, 0x24, 0x24, 0xcb, 0x7b, 0x32, 0xf4, 0x8b, 0x8c, 0x90,
-	0x04, 0xfb, 0x6f, 0x2b, 0x50, 0xe2, 0x47, 0x96, 0xd0, 0xe8, 0xc8, 0x8b, 0x59, 0x8a, 0x90, 0xb1,
-	0x51, 0x99, 0x49, 0x11, 0x7c, 0x5f, 0x88, 0x94, 0x31, 0x00, 0x58, 0xb8, 0xb3, 0x9d, 0x47, 0x8f,
-	0x68, 0x2f, 0xa6, 0x22, 0x13, 0x66, 0xc9, 0x12, 0x63, 0xea, 0x92, 0xc7, 0x90, 0x77, 0xfd, 0x88,
-	0x86, 0xb1, 0xed, 0xf6, 0xf9, 0x9e, 0x64, 0x49, 0x41, 0x30, 0xea, 0x7d, 0x7c, 0x11, 0xb2, 0x3c,
-	0x60, 0x66, 0xf9, 0x2c, 0x20, 0x67, 0x21, 0xc1, 0x63, 0xc2, 0xf9, 0xb7, 0xb3, 0x85, 0x1c, 0xca,
-	0x6b, 0x5f, 0x86, 0x25, 0xbe, 0xb8, 0xfb, 0x4e, 0xe8, 0xbb, 0xfe, 0x80, 0xe7, 0xff, 0xa0, 0x2f,
-	0xfc, 0xa2, 0x4c, 0x78, 0x9b, 0xd9, 0x3c, 0xa4, 0x51, 0xe4, 0x0c, 0xa8, 0xcc, 0xc7, 0x09, 0xa9,
-	0xfd, 0x71, 0x06, 0x4a, 0x9d, 0x38, 0xa4, 0xce, 0x90, 0xa7, 0x76, 0xfc, 0x65, 0x80, 0x28, 0x76,
-	0x62, 0x3a, 0xa4, 0x7e, 0x9c, 0xd8, 0xf7, 0xba, 0x9c, 0x79, 0x4a, 0x6f, 0xab, 0x93, 0x28, 0x91,
-	0x29, 0x7d, 0xbc, 0x0d, 0x25, 0xca, 0xc4, 0x76, 0xcc, 0x4a, 0x04, 0x99, 0x86, 0x56, 0x92, 0x28,
-	0x96, 0xd6, 0x0e, 0x04, 0x68, 0xda, 0xde, 0xf8, 0x8e, 0x0a, 0xc5, 0x74, 0x34, 0xac, 0x43, 0xa1,
-	0xe7, 0xc4, 0x74, 0x10, 0x84, 0x63, 0x99, 0xb9, 0xdf, 0x7a, 0xda, 0xec, 0x5b, 0x35, 0xa9, 0x4c,
-	0xd2, 0x6e, 0xf8, 0x0d, 0x10, 0xe5, 0x90, 0x70, 0x4b, 0x61, 0x6f, 0x91, 0x73, 0xb8, 0x63, 0xbe,
-	0x03, 0x78, 0x14, 0xba, 0x43, 0x27, 0x1c, 0xdb, 0x07, 0x74, 0x9c, 0x64, 0xb9, 0xcc, 0x9c, 0x9d,
-	0x44, 0x52, 0xef, 0x0e, 0x1d, 0xcb, 0x88, 0x78, 0x73, 0xb6, 0xaf, 0xf4, 0x96, 0xd3, 0xfb, 0x33,
-	0xd5, 0x93, 0xd7, 0x0d, 0x51, 0x52, 0x21, 0xe4, 0xb8, 0x63, 0xb1, 0xa6, 0xf6, 0x05, 0x28, 0x24,
-	0x8b, 0xc7, 0x45, 0xc8, 0x19, 0x61, 0x18, 0x84, 0x68, 0x81, 0x07, 0xc6, 0x66, 0x43, 0xc4, 0xd6,
-	0xdd, 0x5d, 0x16, 0x5b, 0xff, 0x45, 0x4d, 0xd3, 0x34, 0xa1, 0x87, 0x47, 0x34, 0x8a, 0xf1, 0xcf,
-	0xc0, 0x2a, 0xe5, 0x2e, 0xe4, 0x1e, 0x53, 0xbb, 0xc7, 0x6b, 0x3a, 0xe6, 0x40, 0x0a, 0xc7, 0x7b,
-	0x79, 0x4b, 0x94, 0xa0, 0x49, 0xad, 0x47, 0x56, 0x52, 0x5d, 0xc9, 0xea, 0x63, 0x03, 0x56, 0xdd,
-	0xe1, 0x90, 0xf6, 0x5d, 0x27, 0x9e, 0x1e, 0x40, 0x6c, 0xd8, 0x7a, 0x52, 0xf2, 0xcc, 0x94, 0x8c,
-	0x64, 0x25, 0xed, 0x91, 0x0e, 0xf3, 0x16, 0xe4, 0x63, 0x5e, 0xde, 0x72, 0xdf, 0x2d, 0x6d, 0x97,
-	0x93, 0x88, 0xc3, 0x99, 0x44, 0x0a, 0xf1, 0x17, 0x40, 0x14, 0xcb, 0x3c, 0xb6, 0x4c, 0x1c, 0x62,
-	0x52, 0x03, 0x11, 0x21, 0xc7, 0x6f, 0x41, 0x65, 0x26, 0x3b, 0xf7, 0x39, 0x60, 0x19, 0x52, 0x9e,
-	0x4e, 0xb5, 0x7d, 0x7c, 0x05, 0x16, 0x03, 0x91, 0x0b, 0x79, 0xd4, 0x99, 0xac, 0x78, 0x36, 0x51,
-	0x92, 0x44, 0x8b, 0xc5, 0x86, 0x90, 0x46, 0x34, 0x3c, 0xa6, 0x7d, 0x36, 0xe8, 0x22, 0x1f, 0x14,
-	0x12, 0x56, 0xbd, 0xaf, 0xfd, 0x14, 0x2c, 0xa7, 0x10, 0x47, 0xa3, 0xc0, 0x8f, 0x28, 0xbe, 0x0c,
-	0xf9, 0x90, 0x9f, 0x77, 0x09, 0x2b, 0x96, 0x73, 0x4c, 0x45, 0x02, 0x22, 0x35, 0xb4, 0x3e, 0x2c,
-	0x0b, 0x0e, 0x8b, 0xdf, 0x7c, 0x27, 0xf1, 0x5b, 0x90, 0xa3, 0xac, 0x71, 0x62, 0x53, 0x48, 0xbb,
-	0xc6, 0xe5, 0x44, 0x48, 0xa7, 0x66, 0x51, 0x9f, 0x39, 0xcb, 0x7f, 0xaa, 0xb0, 0x2a, 0x57, 0xb9,
-	0xe3, 0xc4, 0xbd, 0xfd, 0x17, 0xd4, 0x1b, 0x7e, 0x14, 0x16, 0x19, 0xdf, 0x4d, 0x4f, 0xce, 0x1c,
-	0x7f, 0x48, 0x34, 0x98, 0x47, 0x38, 0x91, 0x3d, 0xb5, 0xfd, 0xb2, 0x7c, 0x2c, 0x3b, 0xd1, 0x54,
-	0xd5, 0x30, 0xc7, 0x71, 0xf2, 0xcf, 0x70, 0x9c, 0xc5, 0xb3, 0x38, 0x8e, 0xb6, 0x0b, 0x6b, 0xb3,
-	0x88, 0x4b, 0xe7, 0xf8, 0x31, 0x58, 0x14, 0x9b, 0x92, 0xc4, 0xc8, 0x79, 0xfb, 0x96, 0xa8, 0x68,
-	0x1f, 0xaa, 0xb0, 0x26, 0xc3, 0xd7, 0xa7, 0xe3, 0x1c, 0x4f, 0xe1, 0x9c, 0x3b, 0xd3, 0x01, 0x3d,
-	0xdb, 0xfe, 0x69, 0x35, 0x58, 0x3f, 0x81, 0xe3, 0x73, 0x1c, 0xd6, 0xef, 0x29, 0xb0, 0xb4, 0x43,
-	0x07, 0xae, 0xff, 0x82, 0xee, 0xc2, 0x14, 0xb8, 0xd9, 0x33, 0x39, 0xf1, 0x08, 0xca, 0xd2, 0x5e,
-	0x89, 0xd6, 0x69, 0xb4, 0x95, 0x79, 0xa7, 0xe5, 0x26, 0x2c, 0xc9, 0x0b, 0x08, 0xc7, 0x73, 0x9d,
-	0x28, 0xb5, 0xe7, 0xc4, 0x0d, 0x84, 0xce, 0x84, 0x44, 0xde, 0x55, 0x70, 0x42, 0xfb, 0x37, 0x05,
-	0xca, 0xb5, 0x60, 0x38, 0x74, 0xe3, 0x17, 0x14, 0xe3, 0xd3, 0x08, 0x65, 0xe7, 0xf9, 0xe3, 0xdb,
-	0x50, 0x49, 0xcc, 0x94, 0xd0, 0x9e, 0xc8, 0x34, 0xca, 0xa9, 0x4c, 0xf3, 0xef, 0x0a, 0x2c, 0x93,
-	0x40, 0x54, 0xf8, 0x2f, 0x37, 0x38, 0xd7, 0x00, 0x4d, 0x0c, 0x3d, 0x2b, 0x3c, 0xff, 0xa3, 0x40,
-	0xa5, 0x1d, 0xd2, 0x91, 0x13, 0xd2, 0x97, 0x1a, 0x1d, 0x56, 0xa6, 0xf7, 0x63, 0x59, 0xe0, 0x14,
-	0x09, 0x6f, 0x6b, 0x2b, 0xb0, 0x9c, 0xda, 0x2e, 0x00, 0xd3, 0xfe, 0x51, 0x81, 0x75, 0xe1, 0x62,
-	0x52, 0xd2, 0x7f, 0x41, 0x61, 0x49, 0xec, 0xcd, 0x4e, 0xd9, 0x5b, 0x85, 0x73, 0x27, 0x6d, 0x93,
-	0x66, 0x7f, 0x55, 0x85, 0xf3, 0x89, 0xf3, 0xbc, 0xe0, 0x86, 0xff, 0x00, 0xfe, 0xb0, 0x01, 0xd5,
-	0xd3, 0x20, 0x48, 0x84, 0xbe, 0xa1, 0x42, 0x55, 0x5c, 0xe2, 0x4c, 0xd5, 0x41, 0x2f, 0x8f, 0x6f,
-	0xe0, 0xb7, 0x61, 0x69, 0xe4, 0x84, 0xb1, 0xdb, 0x73, 0x47, 0x0e, 0xfb, 0x14, 0xcd, 0xf1, 0x32,
-	0xeb, 0xc4, 0x00, 0x33, 0x2a, 0xda, 0x05, 0x78, 0x6d, 0x0e, 0x22, 0x12, 0xaf, 0xff, 0x55, 0x00,
-	0x77, 0x62, 0x27, 0x8c, 0x3f, 0x05, 0x79, 0x69, 0xae, 0x33, 0xad, 0xc3, 0xea, 0x8c, 0xfd, 0xd3,
-	0xb8, 0xd0, 0xf8, 0x53, 0x91, 0x92, 0x3e, 0x16, 0x97, 0x69, 0xfb, 0x25, 0x2e, 0xff, 0xac, 0xc0,
-	0x46, 0x2d, 0x10, 0x17, 0xa2, 0x2f, 0xe5, 0x09, 0xd3, 0xde, 0x80, 0x0b, 0x73, 0x0d, 0x94, 0x00,
-	0xfc, 0x93, 0x02, 0xe7, 0x08, 0x75, 0xfa, 0x2f, 0xa7, 0xf1, 0x77, 0xe1, 0xfc, 0x29, 0xe3, 0x64,
-	0x8d, 0x72, 0x03, 0x0a, 0x43, 0x1a, 0x3b, 0xac, 0xc2, 0x95, 0x26, 0x6d, 0x24, 0xe3, 0x4e, 0xb4,
-	0x9b, 0x52, 0x83, 0xa4, 0xba, 0xda, 0x77, 0x55, 0x58, 0xe5, 0x75, 0xf6, 0xab, 0x8f, 0xbc, 0x33,
-	0xdd, 0xc2, 0xe4, 0x4f, 0x16, 0x7f, 0x4c, 0x61, 0x14, 0x52, 0x3b, 0xb9, 0x1d, 0x58, 0xe4, 0xbf,
-	0x3e, 0xc2, 0x28, 0xa4, 0x77, 0x05, 0x47, 0xfb, 0x1b, 0x05, 0xd6, 0x66, 0x21, 0x4e, 0xbf, 0x68,
-	0xfe, 0xbf, 0x6f, 0x5b, 0xe6, 0x84, 0x94, 0xcc, 0x59, 0x3e, 0x92, 0xb2, 0x67, 0xfe, 0x48, 0xfa,
-	0x5b, 0x15, 0xaa, 0xd3, 0xc6, 0xbc, 0xba, 0xd3, 0x99, 0xbd, 0xd3, 0xf9, 0x7e, 0x6f, 0xf9, 0xb4,
-	0xbf, 0x57, 0xe0, 0xb5, 0x39, 0x80, 0x7e, 0x7f, 0x2e, 0x32, 0x75, 0xb3, 0xa3, 0x3e, 0xf3, 0x66,
-	0xe7, 0x93, 0x77, 0x92, 0x7f, 0x50, 0x60, 0xad, 0x29, 0xee, 0xea, 0xc5, 0xcd, 0xc7, 0x8b, 0x1b,
-	0x83, 0xf9, 0x75, 0x7c, 0x76, 0xf2, 0x6b, 0x95, 0x56, 0x83, 0xf5, 0x13, 0xa6, 0x3d, 0xc7, 0x6d,
-	0xce, 0x7f, 0x2b, 0xb0, 0x22, 0x47, 0xd1, 0x5f, 0xd8, 0xf2, 0x65, 0x0e, 0x3a, 0xf8, 0x22, 0x64,
-	0xdc, 0x7e, 0x52, 0xf7, 0xce, 0xbe, 0x42, 0x60, 0x02, 0xed, 0x5d, 0xc0, 0xd3, 0x76, 0x3f, 0x07,
-	0x74, 0xff, 0xa1, 0xc2, 0x3a, 0x11, 0xd1, 0xf7, 0xd5, 0xef, 0x0b, 0x3f, 0xe8, 0xef, 0x0b, 0x4f,
-	0x4f, 0x5c, 0x1f, 0xf2, 0x62, 0x6a, 0x16, 0xea, 0x4f, 0x2e, 0x75, 0x9d, 0x48, 0xb4, 0x99, 0x53,
-	0x89, 0xf6, 0xf9, 0xe3, 0xd1, 0x87, 0x2a, 0x6c, 0x48, 0x43, 0x5e, 0xd5, 0x3a, 0x67, 0xf7, 0x88,
-	0xfc, 0x29, 0x8f, 0xf8, 0x2f, 0x05, 0x2e, 0xcc, 0x05, 0xf2, 0x87, 0x5e, 0xd1, 0x9c, 0xf0, 0x9e,
-	0xec, 0x33, 0xbd, 0x27, 0x77, 0x66, 0xef, 0xf9, 0xba, 0x0a, 0x15, 0x42, 0x3d, 0xea, 0x44, 0x2f,
-	0xf9, 0xed, 0xde, 0x09, 0x0c, 0x73, 0xa7, 0xee, 0x39, 0x57, 0x60, 0x39, 0x05, 0x42, 0x7e, 0x70,
-	0xf1, 0x0f, 0x74, 0x96, 0x07, 0xdf, 0xa3, 0x8e, 0x17, 0x27, 0x95, 0xa0, 0xf6, 0x27, 0x2a, 0x94,
-	0x09, 0xe3, 0xb8, 0x43, 0xda, 0x89, 0x9d, 0x38, 0xc2, 0x9f, 0x81, 0xa5, 0x7d, 0xae, 0x62, 0x4f,
-	0x3c, 0xa4, 0x48, 0x4a, 0x82, 0x27, 0x7e, 0x7d, 0xdc, 0x86, 0xf5, 0x88, 0xf6, 0x02, 0xbf, 0x1f,
-	0xd9, 0x0f, 0xe9, 0xbe, 0xeb, 0xf7, 0xed, 0xa1, 0x13, 0xc5, 0x34, 0xe4, 0xb0, 0x94, 0xc9, 0xaa,
-	0x14, 0xee, 0x70, 0x59, 0x93, 0x8b, 0xf0, 0x55, 0x58, 0x7b, 0xe8, 0xfa, 0x5e, 0x30, 0xb0, 0x47,
-	0x9e, 0x33, 0xa6, 0x61, 0x64, 0xf7, 0x82, 0x23, 0x5f, 0xe0, 0x91, 0x23, 0x58, 0xc8, 0xda, 0x42,
-	0x54, 0x63, 0x12, 0xfc, 0x3e, 0x5c, 0x9e, 0x3b, 0x8b, 0xfd, 0xc8, 0xf5, 0x62, 0x1a, 0xd2, 0xbe,
-	0x1d, 0xd2, 0x91, 0xe7, 0xf6, 0xc4, 0x0b, 0x2b, 0x01, 0xd4, 0xe7, 0xe7, 0x4c, 0xbd, 0x27, 0xd5,
-	0xc9, 0x44, 0x1b, 0x5f, 0x80, 0x62, 0x6f, 0x74, 0x64, 0x1f, 0xf1, 0x47, 0x0b, 0x0c, 0x3f, 0x85,
-	0x14, 0x7a, 0xa3, 0xa3, 0x2e, 0xa3, 0x31, 0x82, 0xcc, 0xe1, 0x48, 0x04, 0x67, 0x85, 0xb0, 0xa6,
-	0xf6, 0x3d, 0x05, 0x2a, 0xfa, 0x60, 0x10, 0xd2, 0x81, 0x13, 0x4b, 0x98, 0xae, 0xc2, 0x9a, 0x80,
-	0x64, 0x6c, 0x4b, 0x77, 0x15, 0xf6, 0x28, 0xc2, 0x1e, 0x29, 0x13, 0xbe, 0x2a, 0xec, 0xb9, 0x0e,
-	0xe7, 0x8e, 0xfc, 0xb9, 0x7d, 0x54, 0xde, 0x67, 0x2d, 0x95, 0x4e, 0xf7, 0xfa, 0x49, 0x78, 0x6d,
-	0x3e, 0x0a, 0x43, 0x57, 0xbc, 0x72, 0x2c, 0x93, 0x73, 0x73, 0x8c, 0x6e, 0xba, 0xfe, 0x53, 0xba,
-	0x3a, 0x1f, 0x70, 0xbc, 0x3e, 0xa6, 0xab, 0xf3, 0x81, 0xf6, 0x67, 0xe9, 0x6f, 0x8a, 0x89, 0xbb,
-	0xa4, 0x81, 0x23, 0x71, 0x64, 0xe5, 0x69, 0x8e, 0x5c, 0x85, 0x45, 0xe6, 0x8c, 0xae, 0x3f, 0xe0,
-	0xc6, 0x15, 0x48, 0x42, 0xe2, 0x0e, 0x7c, 0x5e, 0xda, 0x4e, 0x3f, 0x88, 0x69, 0xe8, 0x3b, 0x9e,
-	0x37, 0xb6, 0xc5, 0xf5, 0xa3, 0xcf, 0x1f, 0x94, 0xa5, 0xaf, 0x3e, 0x45, 0xf8, 0xf8, 0xac, 0xd0,
-	0x36, 0x52, 0x65, 0x92, 0xea, 0x5a, 0xe9, 0x7b, 0xd0, 0x2f, 0x41, 0x25, 0x94, 0x4e, 0x6c, 0x47,
-	0x6c, 0x7b, 0x64, 0xc8, 0x5d, 0x4b, 0x5e, 0x4d, 0x4c, 0x7b, 0x38, 0x29, 0x87, 0x33, 0x0e, 0xff,
-	0xdc, 0x01, 0xe7, 0x76, 0xb6, 0x90, 0x47, 0x8b, 0xda, 0x9f, 0x2b, 0xb0, 0x3a, 0xe7, 0xdb, 0x3d,
-	0xbd, 0x18, 0x50, 0xa6, 0xee, 0x1d, 0x7f, 0x1c, 0x72, 0xfc, 0x41, 0x8b, 0x7c, 0x43, 0x75, 0xfe,
-	0xf4, 0xa7, 0x3f, 0x7f, 0x7c, 0x42, 0x84, 0x16, 0x3b, 0x8b, 0xdc, 0x26, 0xf9, 0xda, 0x4e, 0x42,
-	0x52, 0x62, 0x3c, 0xf9, 0xc4, 0xee, 0xd4, 0x4d, 0x66, 0xf6, 0x99, 0x37, 0x99, 0x97, 0x7f, 0x3b,
-	0x03, 0xc5, 0xe6, 0xb8, 0x73, 0xe8, 0xed, 0x79, 0xce, 0x80, 0xbf, 0x0e, 0x69, 0xb6, 0xad, 0x07,
-	0x68, 0x01, 0xaf, 0x40, 0xd9, 0x6c, 0x59, 0xb6, 0xd9, 0x6d, 0x34, 0xec, 0xbd, 0x86, 0x7e, 0x0b,
-	0x29, 0x18, 0xc1, 0x52, 0x9b, 0xd4, 0xed, 0x3b, 0xc6, 0x03, 0xc1, 0x51, 0xf1, 0x2a, 0x2c, 0x77,
-	0xcd, 0xfa, 0xdd, 0xae, 0x31, 0x61, 0x66, 0xf1, 0x3a, 0xac, 0x34, 0xbb, 0x0d, 0xab, 0xde, 0x6e,
-	0x4c, 0xb1, 0x0b, 0xb8, 0x0c, 0xc5, 0x9d, 0x46, 0x6b, 0x47, 0x90, 0x88, 0x8d, 0xdf, 0x35, 0x3b,
-	0xf5, 0x5b, 0xa6, 0xb1, 0x2b, 0x58, 0x9b, 0x8c, 0xf5, 0xbe, 0x41, 0x5a, 0x7b, 0xf5, 0x64, 0xca,
-	0x77, 0x31, 0x82, 0xd2, 0x4e, 0xdd, 0xd4, 0x89, 0x1c, 0xe5, 0x89, 0x82, 0x2b, 0x50, 0x34, 0xcc,
-	0x6e, 0x53, 0xd2, 0x2a, 0xae, 0xc2, 0xaa, 0xde, 0xb5, 0x5a, 0x76, 0xdd, 0xac, 0x11, 0xa3, 0x69,
-	0x98, 0x96, 0x94, 0x64, 0xf1, 0x2a, 0x54, 0xac, 0x7a, 0xd3, 0xe8, 0x58, 0x7a, 0xb3, 0x2d, 0x99,
-	0x6c, 0x15, 0x85, 0x8e, 0x91, 0xe8, 0x20, 0xbc, 0x01, 0xeb, 0x66, 0xcb, 0x4e, 0x9e, 0xd6, 0xdd,
-	0xd3, 0x1b, 0x5d, 0x43, 0xca, 0x36, 0xf1, 0x79, 0xc0, 0x2d, 0xd3, 0xee, 0xb6, 0x77, 0x75, 0xcb,
-	0xb0, 0xcd, 0xd6, 0x7d, 0x29, 0x78, 0x17, 0x57, 0xa0, 0x30, 0x59, 0xc1, 0x13, 0x86, 0x42, 0xb9,
-	0xad, 0x13, 0x6b, 0x62, 0xec, 0x93, 0x27, 0x0c, 0x2c, 0xb8, 0x45, 0x5a, 0xdd, 0xf6, 0x44, 0x6d,
-	0x05, 0x4a, 0x12, 0x2c, 0xc9, 0xca, 0x32, 0xd6, 0x4e, 0xdd, 0xac, 0xa5, 0xeb, 0x7b, 0x52, 0xd8,
-	0x50, 0x91, 0x72, 0xf9, 0x00, 0xb2, 0x7c, 0x3b, 0x0a, 0x90, 0x35, 0x5b, 0xa6, 0x81, 0x16, 0xf0,
-	0x32, 0x40, 0xbd, 0x53, 0x37, 0x2d, 0xe3, 0x16, 0xd1, 0x1b, 0xcc, 0x6c, 0xce, 0x48, 0x00, 0x64,
-	0xd6, 0x2e, 0xc1, 0x62, 0xbd, 0xb3, 0xd7, 0x68, 0xe9, 0x96, 0x34, 0xb3, 0xde, 0xb9, 0xdb, 0x6d,
-	0x59, 0x4c, 0x88, 0x70, 0x09, 0xf2, 0xf5, 0x8e, 0x65, 0x7c, 0xc5, 0x62, 0x76, 0x71, 0x99, 0x40,
-	0x15, 0x3d, 0x79, 0xf7, 0xf2, 0xb7, 0x32, 0x90, 0xe5, 0xcf, 0xb9, 0xcb, 0x50, 0xe4, 0xbb, 0x6d,
-	0x3d, 0x68, 0xb3, 0x29, 0x8b, 0x90, 0xad, 0x9b, 0xd6, 0x4d, 0xf4, 0x73, 0x2a, 0x06, 0xc8, 0x75,
-	0x79, 0xfb, 0xe7, 0xf3, 0xac, 0x5d, 0x37, 0xad, 0xb7, 0x6f, 0xa0, 0xaf, 0xaa, 0x6c, 0xd8, 0xae,
-	0x20, 0x7e, 0x21, 0x11, 0x6c, 0x5f, 0x47, 0x5f, 0x4b, 0x05, 0xdb, 0xd7, 0xd1, 0x2f, 0x26, 0x82,
-	0x6b, 0xdb, 0xe8, 0xeb, 0xa9, 0xe0, 0xda, 0x36, 0xfa, 0xa5, 0x44, 0x70, 0xe3, 0x3a, 0xfa, 0xe5,
-	0x54, 0x70, 0xe3, 0x3a, 0xfa, 0x95, 0x3c, 0xb3, 0x85, 0x5b, 0x72, 0x6d, 0x1b, 0xfd, 0x6a, 0x21,
-	0xa5, 0x6e, 0x5c, 0x47, 0xbf, 0x56, 0x60, 0xfb, 0x9f, 0xee, 0x2a, 0xfa, 0x75, 0xc4, 0x96, 0xc9,
-	0x36, 0x08, 0xfd, 0x06, 0x6f, 0x32, 0x11, 0xfa, 0x4d, 0xc4, 0x6c, 0x64, 0x5c, 0x4e, 0x7e, 0x83,
-	0x4b, 0x1e, 0x18, 0x3a, 0x41, 0xbf, 0x95, 0x17, 0x8f, 0x3d, 0x6b, 0xf5, 0xa6, 0xde, 0x40, 0x98,
-	0xf7, 0x60, 0xa8, 0xfc, 0xce, 0x55, 0xd6, 0x64, 0xee, 0x89, 0x7e, 0xb7, 0xcd, 0x26, 0xbc, 0xa7,
-	0x93, 0xda, 0x7b, 0x3a, 0x41, 0xbf, 0x77, 0x95, 0x4d, 0x78, 0x4f, 0x27, 0x12, 0xaf, 0xdf, 0x6f,
-	0x33, 0x45, 0x2e, 0xfa, 0x83, 0xab, 0x6c, 0xd1, 0x92, 0xff, 0xcd, 0x36, 0x2e, 0x40, 0x66, 0xa7,
-	0x6e, 0xa1, 0x6f, 0xf1, 0xd9, 0x98, 0x8b, 0xa2, 0x3f, 0x44, 0x8c, 0xd9, 0x31, 0x2c, 0xf4, 0x6d,
-	0xc6, 0xcc, 0x59, 0xdd, 0x76, 0xc3, 0x40, 0xaf, 0xb3, 0xc5, 0xdd, 0x32, 0x5a, 0x4d, 0xc3, 0x22,
-	0x0f, 0xd0, 0x1f, 0x71, 0xf5, 0xdb, 0x9d, 0x96, 0x89, 0xbe, 0x83, 0x70, 0x05, 0xc0, 0xf8, 0x4a,
-	0x9b, 0x18, 0x9d, 0x4e, 0xbd, 0x65, 0xa2, 0x37, 0x2f, 0xef, 0x01, 0x3a, 0x19, 0x0e, 0x98, 0x01,
-	0x5d, 0xf3, 0x8e, 0xd9, 0xba, 0x6f, 0xa2, 0x05, 0x46, 0xb4, 0x89, 0xd1, 0xd6, 0x89, 0x81, 0x14,
-	0x0c, 0x90, 0x97, 0x4f, 0x48, 0x55, 0xbc, 0x04, 0x05, 0xd2, 0x6a, 0x34, 0x76, 0xf4, 0xda, 0x1d,
-	0x94, 0xd9, 0x31, 0xfe, 0xfa, 0xa3, 0x8b, 0xca, 0xdf, 0x7d, 0x74, 0x51, 0xf9, 0xee, 0x47, 0x17,
-	0x95, 0x6f, 0xfe, 0xeb, 0xc5, 0x05, 0x58, 0x76, 0x83, 0xad, 0x63, 0x37, 0xa6, 0x51, 0x24, 0xfe,
-	0x40, 0xf0, 0xbe, 0x26, 0x29, 0x37, 0xb8, 0x22, 0x5a, 0x57, 0x06, 0xc1, 0x95, 0xe3, 0xf8, 0x0a,
-	0x97, 0x5e, 0xe1, 0x11, 0xe4, 0x61, 0x9e, 0x13, 0xd7, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc4,
-	0x64, 0x72, 0x89, 0x9e, 0x30, 0x00, 0x00,
+	// 3371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4f, 0x70, 0x1b, 0x59,
+	0x5a, 0x77, 0xb7, 0xfe, 0x58, 0xfa, 0x64, 0xc9, 0xcf, 0xcf, 0x76, 0xa2, 0xf1, 0xcc, 0x64, 0xbc,
+	0xbd, 0x3b, 0xbb, 0xd9, 0x00, 0x4e, 0xc6, 0xc9, 0x86, 0x30, 0xbb, 0xc0, 0xb4, 0xe5, 0xb6, 0xa3,
+	0x44, 0x6a, 0x29, 0x4f, 0xad, 0x64, 0x33, 0x45, 0x55, 0x57, 0x47, 0x7a, 0x91, 0xbb, 0xdc, 0xea,
+	0x56, 0xba, 0xdb, 0xce, 0xe8, 0x16, 0x58, 0x96, 0xe5, 0xcf, 0x02, 0xcb, 0xff, 0x59, 0xb6, 0xd8,
+	0xe2, 0x46, 0x71, 0xe1, 0xcc, 0x99, 0xc3, 0x14, 0xc5, 0x81, 0x82, 0x03, 0x17, 0x38, 0xb0, 0x0c,
+	0x45, 0xc1, 0x09, 0x28, 0x8e, 0x1c, 0x28, 0xea, 0xfd, 0xe9, 0x96, 0x64, 0x6b, 0x12, 0x6f, 0x96,
+	0x29, 0x2a, 0x99, 0x5c, 0x5c, 0xef, 0xfb, 0xd3, 0xef, 0x7d, 0xdf, 0xef, 0x7d, 0xfd, 0xbd, 0x4f,
+	0xaf, 0x3f, 0x43, 0xe9, 0xd1, 0x11, 0x0d, 0xc7, 0x5b, 0xa3, 0x30, 0x88, 0x03, 0x9c, 0xe3, 0xc4,
+	0x46, 0x25, 0x0e, 0x46, 0x41, 0xdf, 0x89, 0x1d, 0xc1, 0xde, 0x28, 0x1d, 0xc7, 0xe1, 0xa8, 0x27,
+	0x08, 0xed, 0x9b, 0x0a, 0xe4, 0x2d, 0x27, 0x1c, 0xd0, 0x18, 0x6f, 0x40, 0xe1, 0x90, 0x8e, 0xa3,
+	0x91, 0xd3, 0xa3, 0x55, 0x65, 0x53, 0xb9, 0x58, 0x24, 0x29, 0x8d, 0xd7, 0x20, 0x17, 0x1d, 0x38,
+	0x61, 0xbf, 0xaa, 0x72, 0x81, 0x20, 0xf0, 0x57, 0xa0, 0x14, 0x3b, 0x0f, 0x3c, 0x1a, 0xdb, 0xf1,
+	0x78, 0x44, 0xab, 0x99, 0x4d, 0xe5, 0x62, 0x65, 0x7b, 0x6d, 0x2b, 0x5d, 0xcf, 0xe2, 0x42, 0x6b,
+	0x3c, 0xa2, 0x04, 0xe2, 0x74, 0x8c, 0x31, 0x64, 0x7b, 0xd4, 0xf3, 0xaa, 0x59, 0x3e, 0x17, 0x1f,
+	0x6b, 0xbb, 0x50, 0xb9, 0x6b, 0xed, 0x3b, 0x31, 0xad, 0x39, 0x9e, 0x47, 0xc3, 0xfa, 0x2e, 0x33,
+	0xe7, 0x28, 0xa2, 0xa1, 0xef, 0x0c, 0x53, 0x73, 0x12, 0x1a, 0x9f, 0x83, 0xfc, 0x20, 0x0c, 0x8e,
+	0x46, 0x51, 0x55, 0xdd, 0xcc, 0x5c, 0x2c, 0x12, 0x49, 0x69, 0x3f, 0x07, 0x60, 0x1c, 0x53, 0x3f,
+	0xb6, 0x82, 0x43, 0xea, 0xe3, 0x37, 0xa0, 0x18, 0xbb, 0x43, 0x1a, 0xc5, 0xce, 0x70, 0xc4, 0xa7,
+	0xc8, 0x90, 0x09, 0xe3, 0x13, 0x5c, 0xda, 0x80, 0xc2, 0x28, 0x88, 0xdc, 0xd8, 0x0d, 0x7c, 0xee,
+	0x4f, 0x91, 0xa4, 0xb4, 0xf6, 0x33, 0x90, 0xbb, 0xeb, 0x78, 0x47, 0x14, 0xbf, 0x05, 0x59, 0xee,
+	0xb0, 0xc2, 0x1d, 0x2e, 0x6d, 0x09, 0xd0, 0xb9, 0x9f, 0x5c, 0xc0, 0xe6, 0x3e, 0x66, 0x9a, 0x7c,
+	0xee, 0x25, 0x22, 0x08, 0xed, 0x10, 0x96, 0x76, 0x5c, 0xbf, 0x7f, 0xd7, 0x09, 0x5d, 0x06, 0xc6,
+	0x73, 0x4e, 0x83, 0xbf, 0x00, 0x79, 0x3e, 0x88, 0xaa, 0x99, 0xcd, 0xcc, 0xc5, 0xd2, 0xf6, 0x92,
+	0x7c, 0x90, 0xdb, 0x46, 0xa4, 0x4c, 0xfb, 0x0b, 0x05, 0x60, 0x27, 0x38, 0xf2, 0xfb, 0x77, 0x98,
+	0x10, 0x23, 0xc8, 0x44, 0x8f, 0x3c, 0x09, 0x24, 0x1b, 0xe2, 0xdb, 0x50, 0x79, 0xe0, 0xfa, 0x7d,
+	0xfb, 0x58, 0x9a, 0x23, 0xb0, 0x2c, 0x6d, 0x7f, 0x41, 0x4e, 0x37, 0x79, 0x78, 0x6b, 0xda, 0xea,
+	0xc8, 0xf0, 0xe3, 0x70, 0x4c, 0xca, 0x0f, 0xa6, 0x79, 0x1b, 0x5d, 0xc0, 0xa7, 0x95, 0xd8, 0xa2,
+	0x87, 0x74, 0x9c, 0x2c, 0x7a, 0x48, 0xc7, 0xf8, 0xcb, 0xd3, 0x1e, 0x95, 0xb6, 0x57, 0x93, 0xb5,
+	0xa6, 0x9e, 0x95, 0x6e, 0xbe, 0xab, 0xde, 0x50, 0xb4, 0x0f, 0x0b, 0x50, 0x31, 0x3e, 0xa0, 0xbd,
+	0xa3, 0x98, 0xb6, 0x46, 0x6c, 0x0f, 0x22, 0xdc, 0x84, 0x65, 0xd7, 0xef, 0x79, 0x47, 0x7d, 0xda,
+	0xb7, 0x1f, 0xba, 0xd4, 0xeb, 0x47, 0x3c, 0x8e, 0x2a, 0xa9, 0xdd, 0xb3, 0xfa, 0x5b, 0x75, 0xa9,
+	0xbc, 0xc7, 0x75, 0x49, 0xc5, 0x9d, 0xa1, 0xf1, 0x25, 0x58, 0xe9, 0x79, 0x2e, 0xf5, 0x63, 0xfb,
+	0x21, 0xf3, 0xd7, 0x0e, 0x83, 0xc7, 0x51, 0x35, 0xb7, 0xa9, 0x5c, 0x2c, 0x90, 0x65, 0x21, 0xd8,
+	0x63, 0x7c, 0x12, 0x3c, 0x8e, 0xf0, 0xbb, 0x50, 0x78, 0x1c, 0x84, 0x87, 0x5e, 0xe0, 0xf4, 0xab,
+	0x79, 0xbe, 0xe6, 0x85, 0xf9, 0x6b, 0xde, 0x93, 0x5a, 0x24, 0xd5, 0xc7, 0x17, 0x01, 0x45, 0x8f,
+	0x3c, 0x3b, 0xa2, 0x1e, 0xed, 0xc5, 0xb6, 0xe7, 0x0e, 0xdd, 0xb8, 0x5a, 0xe0, 0x21, 0x59, 0x89,
+	0x1e, 0x79, 0x1d, 0xce, 0x6e, 0x30, 0x2e, 0xb6, 0x61, 0x3d, 0x0e, 0x1d, 0x3f, 0x72, 0x7a, 0x6c,
+	0x32, 0xdb, 0x8d, 0x02, 0xcf, 0x61, 0xa3, 0x6a, 0x91, 0x2f, 0x79, 0x69, 0xfe, 0x92, 0xd6, 0xe4,
+	0x91, 0x7a, 0xf2, 0x04, 0x59, 0x8b, 0xe7, 0x70, 0xf1, 0x3b, 0xb0, 0x1e, 0x1d, 0xba, 0x23, 0x9b,
+	0xcf, 0x63, 0x8f, 0x3c, 0xc7, 0xb7, 0x7b, 0x4e, 0xef, 0x80, 0x56, 0x81, 0xbb, 0x8d, 0x99, 0x90,
+	0xef, 0x7b, 0xdb, 0x73, 0xfc, 0x1a, 0x93, 0x30, 0xd0, 0x99, 0x9e, 0x4f, 0x43, 0xfb, 0x98, 0x86,
+	0x11, 0xb3, 0xa6, 0xf4, 0x34, 0xd0, 0xdb, 0x42, 0xf9, 0xae, 0xd0, 0x25, 0x95, 0xd1, 0x0c, 0x8d,
+	0xbf, 0x02, 0xe7, 0x0f, 0x9c, 0xc8, 0xee, 0x85, 0xd4, 0x89, 0x69, 0xdf, 0x8e, 0xe9, 0x70, 0x64,
+	0xc7, 0x22, 0x06, 0x97, 0xb8, 0x0d, 0x6b, 0x07, 0x4e, 0x54, 0x13, 0x52, 0x8b, 0x0e, 0x47, 0x3c,
+	0x8f, 0x70, 0xfc, 0x47, 0xa1, 0x1b, 0x84, 0x6e, 0x3c, 0xae, 0x96, 0x9f, 0x86, 0x7f, 0x5b, 0x6a,
+	0x91, 0x54, 0x5f, 0xfb, 0x2a, 0x54, 0x66, 0x23, 0x01, 0xaf, 0x40, 0xd9, 0xba, 0xdf, 0x36, 0x6c,
+	0xdd, 0xdc, 0xb5, 0x4d, 0xbd, 0x69, 0xa0, 0x05, 0x5c, 0x86, 0x22, 0x67, 0xb5, 0xcc, 0xc6, 0x7d,
+	0xa4, 0xe0, 0x45, 0xc8, 0xe8, 0x8d, 0x06, 0x52, 0xb5, 0x1b, 0x50, 0x48, 0xb6, 0x14, 0x2f, 0x43,
+	0xa9, 0x6b, 0x76, 0xda, 0x46, 0xad, 0xbe, 0x57, 0x37, 0x76, 0xd1, 0x02, 0x2e, 0x40, 0xb6, 0xd5,
+	0xb0, 0xda, 0x48, 0x11, 0x23, 0xbd, 0x8d, 0x54, 0xf6, 0xe4, 0xee, 0x8e, 0x8e, 0x32, 0xda, 0x9f,
+	0x28, 0xb0, 0x36, 0x6f, 0x6b, 0x70, 0x09, 0x16, 0x77, 0x8d, 0x3d, 0xbd, 0xdb, 0xb0, 0xd0, 0x02,
+	0x5e, 0x85, 0x65, 0x62, 0xb4, 0x0d, 0xdd, 0xd2, 0x77, 0x1a, 0x86, 0x4d, 0x0c, 0x7d, 0x17, 0x29,
+	0x18, 0x43, 0x85, 0x8d, 0xec, 0x5a, 0xab, 0xd9, 0xac, 0x5b, 0x96, 0xb1, 0x8b, 0x54, 0xbc, 0x06,
+	0x88, 0xf3, 0xba, 0xe6, 0x84, 0x9b, 0xc1, 0x08, 0x96, 0x3a, 0x06, 0xa9, 0xeb, 0x8d, 0xfa, 0xfb,
+	0x6c, 0x02, 0x94, 0xc5, 0x9f, 0x83, 0x37, 0x6b, 0x2d, 0xb3, 0x53, 0xef, 0x58, 0x86, 0x69, 0xd9,
+	0x1d, 0x53, 0x6f, 0x77, 0x6e, 0xb6, 0x2c, 0x3e, 0xb3, 0x70, 0x2e, 0x87, 0x2b, 0x00, 0x7a, 0xd7,
+	0x6a, 0x89, 0x79, 0x50, 0x5e, 0x7b, 0x04, 0x95, 0xd9, 0x5d, 0x63, 0x56, 0x49, 0x13, 0xed, 0x76,
+	0x43, 0x37, 0x4d, 0x83, 0xa0, 0x05, 0x9c, 0x07, 0xf5, 0xee, 0x55, 0xe1, 0xeb, 0x3e, 0xf5, 0xaf,
+	0x21, 0x95, 0x4d, 0xc4, 0x46, 0xfb, 0x21, 0xa5, 0xfd, 0x31, 0xca, 0x30, 0xbb, 0x19, 0xdd, 0xa0,
+	0x0f, 0xe3, 0x6d, 0xe2, 0x0e, 0x0e, 0x62, 0x94, 0x65, 0x76, 0x33, 0xde, 0x3d, 0x37, 0x3e, 0xd8,
+	0x73, 0x3c, 0xef, 0x81, 0xd3, 0x3b, 0x44, 0x39, 0xed, 0xcb, 0x50, 0x48, 0x76, 0x0a, 0x03, 0xe4,
+	0xcd, 0x16, 0x69, 0xea, 0x0d, 0x81, 0xe8, 0xcd, 0xfa, 0xfe, 0x4d, 0xb1, 0x03, 0x8d, 0xd6, 0x3d,
+	0xa4, 0xde, 0xca, 0x16, 0x14, 0xfe, 0x57, 0x45, 0x99, 0x5b, 0xd9, 0x42, 0x06, 0x65, 0xb5, 0x3f,
+	0x57, 0x21, 0xc7, 0x77, 0x92, 0x1d, 0x27, 0x53, 0x87, 0x04, 0x1f, 0xa7, 0xa9, 0x55, 0x7d, 0x4a,
+	0x6a, 0xe5, 0x11, 0x27, 0x93, 0xbc, 0x20, 0xf0, 0xeb, 0x50, 0x0c, 0xc2, 0x81, 0x88, 0x45, 0x79,
+	0x3c, 0x15, 0x82, 0x70, 0xc0, 0xe3, 0x8f, 0x1d, 0x0d, 0xec, 0x54, 0x7b, 0xe0, 0x44, 0x94, 0x67,
+	0x88, 0x22, 0x49, 0x69, 0xfc, 0x1a, 0x30, 0x3d, 0x9b, 0xdb, 0x91, 0xe7, 0xb2, 0xc5, 0x20, 0x1c,
+	0x98, 0xcc, 0x94, 0xcf, 0x43, 0xb9, 0x17, 0x78, 0x47, 0x43, 0xdf, 0xf6, 0xa8, 0x3f, 0x88, 0x0f,
+	0xaa, 0x8b, 0x9b, 0xca, 0xc5, 0x32, 0x59, 0x12, 0xcc, 0x06, 0xe7, 0xe1, 0x2a, 0x2c, 0xf6, 0x0e,
+	0x9c, 0x30, 0xa2, 0x22, 0x2b, 0x94, 0x49, 0x42, 0xf2, 0x55, 0x69, 0xcf, 0x1d, 0x3a, 0x5e, 0xc4,
+	0x33, 0x40, 0x99, 0xa4, 0x34, 0x73, 0xe2, 0xa1, 0xe7, 0x0c, 0x22, 0xfe, 0xe6, 0x96, 0x89, 0x20,
+	0xf0, 0x5b, 0x50, 0x92, 0x0b, 0x72, 0x08, 0x4a, 0xdc, 0x1c, 0x10, 0x2c, 0x86, 0x80, 0xf6, 0x93,
+	0x90, 0x21, 0xc1, 0x63, 0xb6, 0xa6, 0xb0, 0x28, 0xaa, 0x2a, 0x9b, 0x99, 0x8b, 0x98, 0x24, 0x24,
+	0x3b, 0x5e, 0xe5, 0x09, 0x23, 0x0e, 0x9e, 0xe4, 0x4c, 0xf9, 0x9e, 0x02, 0x25, 0x9e, 0x19, 0x08,
+	0x8d, 0x8e, 0xbc, 0x98, 0x9d, 0x44, 0x32, 0x05, 0x2b, 0x33, 0x27, 0x11, 0xdf, 0x17, 0x22, 0x65,
+	0x0c, 0x00, 0x96, 0x55, 0x6d, 0xe7, 0xe1, 0x43, 0xda, 0x8b, 0xa9, 0x38, 0x70, 0xb3, 0x64, 0x89,
+	0x31, 0x75, 0xc9, 0x63, 0xc8, 0xbb, 0x7e, 0x44, 0xc3, 0xd8, 0x76, 0xfb, 0x7c, 0x4f, 0xb2, 0xa4,
+	0x20, 0x18, 0xf5, 0x3e, 0xbe, 0x00, 0x59, 0x9e, 0x97, 0xb3, 0x7c, 0x15, 0x90, 0xab, 0x90, 0xe0,
+	0x31, 0xe1, 0xfc, 0x5b, 0xd9, 0x42, 0x0e, 0xe5, 0xb5, 0xaf, 0xc1, 0x12, 0x37, 0xee, 0x9e, 0x13,
+	0xfa, 0xae, 0x3f, 0xe0, 0x65, 0x46, 0xd0, 0x17, 0x71, 0x51, 0x26, 0x7c, 0xcc, 0x7c, 0x1e, 0xd2,
+	0x28, 0x72, 0x06, 0x54, 0x1e, 0xfb, 0x09, 0xa9, 0xfd, 0x71, 0x06, 0x4a, 0x9d, 0x38, 0xa4, 0xce,
+	0x90, 0x57, 0x10, 0xf8, 0x6b, 0x00, 0x51, 0xec, 0xc4, 0x74, 0x48, 0xfd, 0x38, 0xf1, 0xef, 0x0d,
+	0xb9, 0xf2, 0x94, 0xde, 0x56, 0x27, 0x51, 0x22, 0x53, 0xfa, 0x78, 0x1b, 0x4a, 0x94, 0x89, 0xed,
+	0x98, 0x55, 0x22, 0xf2, 0xb4, 0x5b, 0x49, 0xb2, 0x55, 0x5a, 0xa2, 0x10, 0xa0, 0xe9, 0x78, 0xe3,
+	0xfb, 0x2a, 0x14, 0xd3, 0xd9, 0xb0, 0x0e, 0x85, 0x9e, 0x13, 0xd3, 0x41, 0x10, 0x8e, 0x65, 0x81,
+	0xf0, 0xf6, 0xd3, 0x56, 0xdf, 0xaa, 0x49, 0x65, 0x92, 0x3e, 0x86, 0xdf, 0x04, 0x51, 0x75, 0x89,
+	0xb0, 0x14, 0xfe, 0x16, 0x39, 0x87, 0x07, 0xe6, 0xbb, 0x80, 0x47, 0xa1, 0x3b, 0x74, 0xc2, 0xb1,
+	0x7d, 0x48, 0xc7, 0xc9, 0x61, 0x9a, 0x99, 0xb3, 0x93, 0x48, 0xea, 0xdd, 0xa6, 0x63, 0x99, 0x3c,
+	0x6f, 0xcc, 0x3e, 0x2b, 0xa3, 0xe5, 0xf4, 0xfe, 0x4c, 0x3d, 0xc9, 0xcb, 0x93, 0x28, 0x29, 0x44,
+	0x72, 0x3c, 0xb0, 0xd8, 0x50, 0xfb, 0x12, 0x14, 0x12, 0xe3, 0x71, 0x11, 0x72, 0x46, 0x18, 0x06,
+	0x21, 0x5a, 0xe0, 0x39, 0xb4, 0xd9, 0x10, 0x49, 0x60, 0x77, 0x97, 0xa5, 0xe1, 0x7f, 0x52, 0xd3,
+	0x6a, 0x80, 0xd0, 0x47, 0x47, 0x34, 0x8a, 0xf1, 0xcf, 0xc2, 0x2a, 0xe5, 0x21, 0xe4, 0x1e, 0x53,
+	0xbb, 0xc7, 0x4b, 0x47, 0x16, 0x40, 0x0a, 0xc7, 0x7b, 0x79, 0x4b, 0x54, 0xba, 0x49, 0x49, 0x49,
+	0x56, 0x52, 0x5d, 0xc9, 0xea, 0x63, 0x03, 0x56, 0xdd, 0xe1, 0x90, 0xf6, 0x5d, 0x27, 0x9e, 0x9e,
+	0x40, 0x6c, 0xd8, 0x7a, 0x52, 0x59, 0xcd, 0x54, 0xa6, 0x64, 0x25, 0x7d, 0x22, 0x9d, 0xe6, 0x6d,
+	0xc8, 0xc7, 0xbc, 0x8a, 0xe6, 0xb1, 0x5b, 0xda, 0x2e, 0x27, 0x19, 0x87, 0x33, 0x89, 0x14, 0xe2,
+	0x2f, 0x81, 0xa8, 0xc9, 0x79, 0x6e, 0x99, 0x04, 0xc4, 0xa4, 0xd4, 0x22, 0x42, 0x8e, 0xdf, 0x86,
+	0xca, 0x4c, 0x11, 0xd0, 0xe7, 0x80, 0x65, 0x48, 0x79, 0x8a, 0x5b, 0xef, 0xe3, 0xcb, 0xb0, 0x18,
+	0x88, 0x33, 0xaf, 0x9a, 0x9f, 0xb1, 0x78, 0xf6, 0x40, 0x24, 0x89, 0x16, 0xcb, 0x0d, 0x21, 0x8d,
+	0x68, 0x78, 0x4c, 0xfb, 0x6c, 0xd2, 0x45, 0x3e, 0x29, 0x24, 0xac, 0x7a, 0x5f, 0xfb, 0x69, 0x58,
+	0x4e, 0x21, 0x8e, 0x46, 0x81, 0x1f, 0x51, 0x7c, 0x09, 0xf2, 0x21, 0x7f, 0xdf, 0x25, 0xac, 0x58,
+	0xae, 0x31, 0x95, 0x09, 0x88, 0xd4, 0xd0, 0xfa, 0xb0, 0x2c, 0x38, 0x2c, 0xd5, 0xf3, 0x9d, 0xc4,
+	0x6f, 0x43, 0x8e, 0xb2, 0xc1, 0x89, 0x4d, 0x21, 0xed, 0x1a, 0x97, 0x13, 0x21, 0x9d, 0x5a, 0x45,
+	0x7d, 0xe6, 0x2a, 0xff, 0xa9, 0xc2, 0xaa, 0xb4, 0x72, 0xc7, 0x89, 0x7b, 0x07, 0x2f, 0x68, 0x34,
+	0xfc, 0x18, 0x2c, 0x32, 0xbe, 0x9b, 0xbe, 0x39, 0x73, 0xe2, 0x21, 0xd1, 0x60, 0x11, 0xe1, 0x44,
+	0xf6, 0xd4, 0xf6, 0xcb, 0x2a, 0xb5, 0xec, 0x44, 0x53, 0x05, 0xc6, 0x9c, 0xc0, 0xc9, 0x3f, 0x23,
+	0x70, 0x16, 0xcf, 0x12, 0x38, 0xda, 0x2e, 0xac, 0xcd, 0x22, 0x2e, 0x83, 0xe3, 0xc7, 0x61, 0x51,
+	0x6c, 0x4a, 0x92, 0x23, 0xe7, 0xed, 0x5b, 0xa2, 0xa2, 0x7d, 0xa4, 0xc2, 0x9a, 0x4c, 0x5f, 0x9f,
+	0x8d, 0xf7, 0x78, 0x0a, 0xe7, 0xdc, 0x99, 0x5e, 0xd0, 0xb3, 0xed, 0x9f, 0x56, 0x83, 0xf5, 0x13,
+	0x38, 0x3e, 0xc7, 0xcb, 0xfa, 0xef, 0x0a, 0x2c, 0xed, 0xd0, 0x81, 0xeb, 0xbf, 0xa0, 0xbb, 0x30,
+	0x05, 0x6e, 0xf6, 0x4c, 0x41, 0x3c, 0x82, 0xb2, 0xf4, 0x57, 0xa2, 0x75, 0x1a, 0x6d, 0x65, 0xde,
+	0xdb, 0x72, 0x03, 0x96, 0xe4, 0x3d, 0x87, 0xe3, 0xb9, 0x4e, 0x94, 0xfa, 0x73, 0xe2, 0xa2, 0x43,
+	0x67, 0x42, 0x52, 0x8a, 0x27, 0x84, 0xf6, 0x2f, 0x0a, 0x94, 0x6b, 0xc1, 0x70, 0xe8, 0xc6, 0x2f,
+	0x28, 0xc6, 0xa7, 0x11, 0xca, 0xce, 0x8b, 0xc7, 0x77, 0xa0, 0x92, 0xb8, 0x29, 0xa1, 0x3d, 0x71,
+	0xd2, 0x28, 0xa7, 0x4e, 0x9a, 0x7f, 0x55, 0x60, 0x99, 0x04, 0xe2, 0xc7, 0xc0, 0xcb, 0x0d, 0xce,
+	0x55, 0x40, 0x13, 0x47, 0xcf, 0x0a, 0xcf, 0x7f, 0x2b, 0x50, 0x69, 0x87, 0x74, 0xe4, 0x84, 0xf4,
+	0xa5, 0x46, 0x87, 0x95, 0xe9, 0xfd, 0x58, 0x16, 0x38, 0x45, 0xc2, 0xc7, 0xda, 0x0a, 0x2c, 0xa7,
+	0xbe, 0x0b, 0xc0, 0xb4, 0xbf, 0x57, 0x60, 0x5d, 0x84, 0x98, 0x94, 0xf4, 0x5f, 0x50, 0x58, 0x12,
+	0x7f, 0xb3, 0x53, 0xfe, 0x56, 0xe1, 0xdc, 0x49, 0xdf, 0xa4, 0xdb, 0xdf, 0x50, 0xe1, 0x7c, 0x12,
+	0x3c, 0x2f, 0xb8, 0xe3, 0x3f, 0x42, 0x3c, 0x6c, 0x40, 0xf5, 0x34, 0x08, 0x12, 0xa1, 0xef, 0xa8,
+	0x50, 0x15, 0x77, 0x45, 0x53, 0x75, 0xd0, 0xcb, 0x13, 0x1b, 0xf8, 0x1d, 0x58, 0x1a, 0x39, 0x61,
+	0xec, 0xf6, 0xdc, 0x91, 0xc3, 0x7e, 0x8a, 0xe6, 0x36, 0x33, 0xa7, 0x27, 0x98, 0x51, 0xd1, 0x5e,
+	0x87, 0xd7, 0xe6, 0x20, 0x22, 0xf1, 0xfa, 0x1f, 0x05, 0x70, 0x27, 0x76, 0xc2, 0xf8, 0x33, 0x70,
+	0x2e, 0xcd, 0x0d, 0xa6, 0x75, 0x58, 0x9d, 0xf1, 0x7f, 0x1a, 0x17, 0x1a, 0x7f, 0x26, 0x8e, 0xa4,
+	0x4f, 0xc4, 0x65, 0xda, 0x7f, 0x89, 0xcb, 0x3f, 0x2a, 0xb0, 0x51, 0x0b, 0xc4, 0xdd, 0xe9, 0x4b,
+	0xf9, 0x86, 0x69, 0x6f, 0xc2, 0xeb, 0x73, 0x1d, 0x94, 0x00, 0xfc, 0x83, 0x02, 0xe7, 0x08, 0x75,
+	0xfa, 0x2f, 0xa7, 0xf3, 0x77, 0xe0, 0xfc, 0x29, 0xe7, 0x64, 0x8d, 0x72, 0x1d, 0x0a, 0x43, 0x1a,
+	0x3b, 0x7d, 0x27, 0x76, 0xa4, 0x4b, 0x1b, 0xc9, 0xbc, 0x13, 0xed, 0xa6, 0xd4, 0x20, 0xa9, 0xae,
+	0xf6, 0x03, 0x15, 0x56, 0x79, 0x9d, 0xfd, 0xea, 0x47, 0xde, 0x99, 0x6e, 0x61, 0xf2, 0x27, 0x8b,
+	0x3f, 0xa6, 0x30, 0x0a, 0xa9, 0x9d, 0xdc, 0x0e, 0x2c, 0xf2, 0x8f, 0x9c, 0x30, 0x0a, 0xe9, 0x1d,
+	0xc1, 0xd1, 0xfe, 0x4a, 0x81, 0xb5, 0x59, 0x88, 0xd3, 0x5f, 0x34, 0xff, 0xd7, 0xb7, 0x2d, 0x73,
+	0x52, 0x4a, 0xe6, 0x2c, 0x3f, 0x92, 0xb2, 0x67, 0xfe, 0x91, 0xf4, 0xd7, 0x2a, 0x54, 0xa7, 0x9d,
+	0x79, 0x75, 0xa7, 0x33, 0x7b, 0xa7, 0xf3, 0xc3, 0xde, 0xf2, 0x69, 0x7f, 0xab, 0xc0, 0x6b, 0x73,
+	0x00, 0xfd, 0xe1, 0x42, 0x64, 0xea, 0x66, 0x47, 0x7d, 0xe6, 0xcd, 0xce, 0xa7, 0x1f, 0x24, 0xff,
+	0xa1, 0xc0, 0x5a, 0x53, 0xdc, 0xd5, 0x8b, 0x9b, 0x8f, 0x17, 0x37, 0x07, 0xf3, 0xeb, 0xf8, 0xec,
+	0xd4, 0xd7, 0xaa, 0x35, 0xc8, 0xf1, 0x06, 0x06, 0x79, 0x1c, 0x0b, 0x82, 0xdd, 0xf1, 0x9c, 0x70,
+	0xf8, 0x39, 0xee, 0x78, 0xbe, 0xad, 0xc2, 0x8a, 0x9c, 0x45, 0xef, 0x1d, 0xbe, 0x44, 0x98, 0x5d,
+	0x80, 0x8c, 0xdb, 0x4f, 0xaa, 0xe1, 0xd9, 0x16, 0x08, 0x26, 0x98, 0x60, 0x9a, 0x9f, 0xc6, 0xf4,
+	0x3d, 0xc0, 0xd3, 0x68, 0x3c, 0x07, 0xa0, 0xff, 0xa6, 0xc2, 0x3a, 0x11, 0x99, 0xfa, 0xd5, 0xb7,
+	0x88, 0x1f, 0xf5, 0x5b, 0xc4, 0xd3, 0x0f, 0xb9, 0x8f, 0x78, 0xe1, 0x35, 0x0b, 0xf5, 0xa7, 0x77,
+	0xcc, 0x9d, 0x38, 0x94, 0x33, 0xa7, 0x0e, 0xe5, 0xe7, 0xcf, 0x5d, 0x1f, 0xa9, 0xb0, 0x21, 0x1d,
+	0x79, 0x55, 0x17, 0x9d, 0x3d, 0x22, 0xf2, 0xa7, 0x22, 0xe2, 0xbf, 0x14, 0x78, 0x7d, 0x2e, 0x90,
+	0xff, 0xef, 0xd5, 0xcf, 0x89, 0xe8, 0xc9, 0x3e, 0x33, 0x7a, 0x72, 0x67, 0x8e, 0x9e, 0x6f, 0xa9,
+	0x50, 0x21, 0xd4, 0xa3, 0x4e, 0xf4, 0x92, 0xdf, 0x04, 0x9e, 0xc0, 0x30, 0x77, 0xea, 0x4e, 0x74,
+	0x05, 0x96, 0x53, 0x20, 0xe4, 0x8f, 0x33, 0xfe, 0x63, 0x9e, 0x9d, 0x8e, 0x37, 0xa9, 0xe3, 0xc5,
+	0x49, 0xd5, 0xa8, 0xfd, 0x9d, 0x0a, 0x65, 0xc2, 0x38, 0xee, 0x90, 0xb2, 0x6f, 0xe4, 0x11, 0xfe,
+	0x1c, 0x2c, 0x1d, 0x70, 0x15, 0x7b, 0x12, 0x21, 0x45, 0x52, 0x12, 0x3c, 0xf1, 0xa5, 0x72, 0x1b,
+	0xd6, 0x23, 0xda, 0x0b, 0xfc, 0x7e, 0x64, 0x3f, 0xa0, 0x07, 0xac, 0x37, 0x6e, 0xe8, 0x44, 0x31,
+	0x0d, 0x39, 0x2c, 0x65, 0xb2, 0x2a, 0x85, 0x3b, 0x5c, 0xd6, 0xe4, 0x22, 0x7c, 0x05, 0xd6, 0x1e,
+	0xb8, 0xbe, 0x17, 0x0c, 0x58, 0x23, 0xd5, 0x98, 0x86, 0x91, 0xdd, 0x0b, 0x8e, 0x7c, 0x81, 0x47,
+	0x8e, 0x60, 0x21, 0x6b, 0x0b, 0x51, 0x8d, 0x49, 0xf0, 0xfb, 0x70, 0x69, 0xee, 0x2a, 0xf6, 0x43,
+	0xd7, 0x8b, 0x69, 0x48, 0xfb, 0x76, 0x48, 0x47, 0x9e, 0xdb, 0x13, 0x4d, 0x5f, 0x02, 0xa8, 0x2f,
+	0xce, 0x59, 0x7a, 0x4f, 0xaa, 0x93, 0x89, 0x36, 0xeb, 0xa2, 0xe8, 0x8d, 0x8e, 0xec, 0x23, 0xde,
+	0xe0, 0xc0, 0xf0, 0x53, 0x48, 0xa1, 0x37, 0x3a, 0xea, 0x32, 0x9a, 0x7d, 0x79, 0x7f, 0x34, 0x12,
+	0xc9, 0x59, 0x21, 0x6c, 0xc8, 0x8c, 0x17, 0x0d, 0x02, 0x51, 0xef, 0x80, 0x0e, 0x1d, 0xbb, 0x77,
+	0xe0, 0xf8, 0x03, 0xda, 0x97, 0xa9, 0x18, 0x73, 0x59, 0x87, 0x8b, 0x6a, 0x42, 0xc2, 0x3e, 0x19,
+	0x55, 0xf4, 0xc1, 0x20, 0xa4, 0x03, 0x27, 0x96, 0xc0, 0x5e, 0x81, 0x35, 0x01, 0xe2, 0xd8, 0x96,
+	0x01, 0x2e, 0x10, 0x50, 0x04, 0x02, 0x52, 0x26, 0xa2, 0x5b, 0x20, 0x70, 0x0d, 0xce, 0x1d, 0xf9,
+	0x73, 0x9f, 0x51, 0xf9, 0x33, 0x6b, 0x47, 0xfe, 0x9c, 0xa7, 0x7e, 0x0a, 0x5e, 0x9b, 0x8f, 0xdb,
+	0xd0, 0x15, 0xad, 0x9a, 0x65, 0x72, 0x6e, 0x0e, 0x4c, 0x4d, 0xd7, 0x7f, 0xca, 0xa3, 0xce, 0x07,
+	0xd5, 0xec, 0x27, 0x3f, 0xea, 0x7c, 0xa0, 0xfd, 0x69, 0xfa, 0xc5, 0x32, 0x09, 0xb0, 0x34, 0xd5,
+	0x24, 0xa1, 0xaf, 0x3c, 0x2d, 0xf4, 0xab, 0xb0, 0xc8, 0xc2, 0xd7, 0xf5, 0x07, 0xdc, 0xb9, 0x02,
+	0x49, 0x48, 0xdc, 0x81, 0x2f, 0x4a, 0xdf, 0xe9, 0x07, 0x31, 0x0d, 0x7d, 0xc7, 0xf3, 0xc6, 0xb6,
+	0xb8, 0xdc, 0xf4, 0x79, 0x57, 0x5c, 0xda, 0xba, 0x2a, 0x12, 0xce, 0xe7, 0x85, 0xb6, 0x91, 0x2a,
+	0x93, 0x54, 0xd7, 0x4a, 0x54, 0xf1, 0x57, 0xa1, 0x12, 0xca, 0xb0, 0xb7, 0x23, 0xb6, 0x3d, 0x32,
+	0x49, 0xaf, 0x49, 0xeb, 0x66, 0xde, 0x09, 0x52, 0x0e, 0xa7, 0xc9, 0xe7, 0x4f, 0x51, 0xb7, 0xb2,
+	0x85, 0x3c, 0x5a, 0xd4, 0xfe, 0x4c, 0x81, 0xd5, 0x39, 0x37, 0x03, 0xe9, 0xb5, 0x83, 0x32, 0x75,
+	0xab, 0xf9, 0x13, 0x90, 0x63, 0xf6, 0x25, 0x1d, 0x5a, 0xe7, 0x4f, 0x5f, 0x2c, 0x30, 0x9b, 0x28,
+	0x11, 0x5a, 0xec, 0xed, 0xe5, 0x3e, 0xc9, 0x96, 0x41, 0x09, 0x49, 0x89, 0xf1, 0x64, 0x9f, 0xe0,
+	0xa9, 0x7b, 0xd2, 0xec, 0x33, 0xef, 0x49, 0x2f, 0xfd, 0x76, 0x06, 0x8a, 0xcd, 0x71, 0xe7, 0x91,
+	0xb7, 0xe7, 0x39, 0x03, 0xde, 0x7b, 0xd2, 0x6c, 0x5b, 0xf7, 0xd1, 0x02, 0xeb, 0x0d, 0x34, 0x5b,
+	0x96, 0x6d, 0x76, 0x1b, 0x0d, 0x7b, 0xaf, 0xa1, 0xef, 0x23, 0x85, 0x35, 0xd9, 0xb5, 0x49, 0xdd,
+	0xbe, 0x6d, 0xdc, 0x17, 0x1c, 0x95, 0xf5, 0xc7, 0x75, 0xcd, 0xfa, 0x9d, 0xae, 0x31, 0x61, 0x66,
+	0xf1, 0x3a, 0xac, 0x34, 0xbb, 0x0d, 0xab, 0xde, 0x6e, 0x4c, 0xb1, 0x0b, 0xac, 0xb3, 0x70, 0xa7,
+	0xd1, 0xda, 0x11, 0x24, 0x62, 0xf3, 0x77, 0xcd, 0x4e, 0x7d, 0xdf, 0x34, 0x76, 0x05, 0x6b, 0x93,
+	0xb1, 0xde, 0x37, 0x48, 0x6b, 0xaf, 0x9e, 0x2c, 0xf9, 0x1e, 0x46, 0x50, 0xda, 0xa9, 0x9b, 0x3a,
+	0x91, 0xb3, 0x3c, 0x51, 0x70, 0x05, 0x8a, 0x86, 0xd9, 0x6d, 0x4a, 0x5a, 0xc5, 0x55, 0x58, 0x65,
+	0x4d, 0x7c, 0x76, 0xdd, 0xac, 0x11, 0xa3, 0xc9, 0x7a, 0xfd, 0x84, 0x24, 0x8b, 0x57, 0xa1, 0x62,
+	0xd5, 0x9b, 0x46, 0xc7, 0xd2, 0x9b, 0x6d, 0xc9, 0x64, 0x56, 0x14, 0x3a, 0x46, 0xa2, 0x83, 0xf0,
+	0x06, 0xac, 0x9b, 0x2d, 0x3b, 0xe9, 0xf1, 0xbb, 0xab, 0x37, 0xba, 0x86, 0x94, 0x6d, 0xe2, 0xf3,
+	0x80, 0x5b, 0xa6, 0xdd, 0x6d, 0xef, 0xea, 0x96, 0x61, 0x9b, 0xad, 0x7b, 0x52, 0xf0, 0x1e, 0xae,
+	0x40, 0x61, 0x62, 0xc1, 0x13, 0x86, 0x42, 0xb9, 0xad, 0x13, 0x6b, 0xe2, 0xec, 0x93, 0x27, 0x0c,
+	0x2c, 0xd8, 0x27, 0xad, 0x6e, 0x7b, 0xa2, 0xb6, 0x02, 0x25, 0x09, 0x96, 0x64, 0x65, 0x19, 0x6b,
+	0xa7, 0x6e, 0xd6, 0x52, 0xfb, 0x9e, 0x14, 0x36, 0x54, 0xa4, 0x5c, 0x3a, 0x84, 0x2c, 0xdf, 0x8e,
+	0x02, 0x64, 0xcd, 0x96, 0xc9, 0xda, 0x32, 0x97, 0x01, 0xea, 0x9d, 0xba, 0x69, 0x19, 0xfb, 0x44,
+	0x6f, 0x30, 0xb7, 0x39, 0x23, 0x01, 0x90, 0x79, 0xbb, 0x04, 0x8b, 0xf5, 0xce, 0x5e, 0xa3, 0xa5,
+	0x5b, 0xd2, 0xcd, 0x7a, 0xe7, 0x4e, 0xb7, 0xc5, 0xba, 0x23, 0x9f, 0x20, 0x5c, 0x82, 0x3c, 0x6b,
+	0x84, 0xfc, 0xba, 0xc5, 0xfc, 0xe2, 0x32, 0x81, 0x2a, 0x7a, 0xf2, 0xde, 0xa5, 0xef, 0x66, 0x20,
+	0xcb, 0x7b, 0xd2, 0xcb, 0x50, 0xe4, 0xbb, 0xcd, 0xfa, 0x3f, 0xd1, 0x02, 0x2e, 0x42, 0xb6, 0x6e,
+	0x5a, 0x37, 0xd0, 0xcf, 0xab, 0x18, 0x20, 0xd7, 0xe5, 0xe3, 0x5f, 0xc8, 0xb3, 0x71, 0xdd, 0xb4,
+	0xde, 0xb9, 0x8e, 0xbe, 0xa1, 0xb2, 0x69, 0xbb, 0x82, 0xf8, 0xc5, 0x44, 0xb0, 0x7d, 0x0d, 0x7d,
+	0x33, 0x15, 0x6c, 0x5f, 0x43, 0xbf, 0x94, 0x08, 0xae, 0x6e, 0xa3, 0x6f, 0xa5, 0x82, 0xab, 0xdb,
+	0xe8, 0x97, 0x13, 0xc1, 0xf5, 0x6b, 0xe8, 0x57, 0x52, 0xc1, 0xf5, 0x6b, 0xe8, 0x57, 0xf3, 0xcc,
+	0x17, 0xee, 0xc9, 0xd5, 0x6d, 0xf4, 0x6b, 0x85, 0x94, 0xba, 0x7e, 0x0d, 0x7d, 0xbb, 0xc0, 0xf6,
+	0x3f, 0xdd, 0x55, 0xf4, 0xeb, 0x88, 0x99, 0xc9, 0x36, 0x08, 0xfd, 0x06, 0x1f, 0x32, 0x11, 0xfa,
+	0x4d, 0xc4, 0x7c, 0x64, 0x5c, 0x4e, 0x7e, 0x87, 0x4b, 0xee, 0x1b, 0x3a, 0x41, 0xbf, 0x95, 0x17,
+	0x5d, 0xa7, 0xb5, 0x3a, 0x6b, 0xb3, 0xc4, 0xfc, 0x09, 0x86, 0xca, 0xef, 0x5c, 0x61, 0x43, 0x16,
+	0x9e, 0xe8, 0x77, 0xdb, 0x6c, 0xc1, 0xbb, 0x3a, 0xa9, 0xdd, 0xd4, 0x09, 0xfa, 0xbd, 0x2b, 0x6c,
+	0xc1, 0xbb, 0x3a, 0x91, 0x78, 0xfd, 0x7e, 0x9b, 0x29, 0x72, 0xd1, 0x1f, 0x5c, 0x61, 0x46, 0x4b,
+	0xfe, 0x87, 0x6d, 0x5c, 0x80, 0xcc, 0x4e, 0xdd, 0x42, 0xdf, 0xe5, 0xab, 0xb1, 0x10, 0x45, 0x7f,
+	0x88, 0x18, 0xb3, 0x63, 0x58, 0xe8, 0x7b, 0x8c, 0x99, 0xb3, 0xba, 0xed, 0x86, 0x81, 0xde, 0x60,
+	0xc6, 0xed, 0x1b, 0xad, 0xa6, 0x61, 0x91, 0xfb, 0xe8, 0x8f, 0xb8, 0xfa, 0xad, 0x4e, 0xcb, 0x44,
+	0xdf, 0x47, 0xac, 0x91, 0xd4, 0xf8, 0x7a, 0x9b, 0x18, 0x9d, 0x4e, 0xbd, 0x65, 0xa2, 0xb7, 0x2e,
+	0xed, 0x01, 0x3a, 0x99, 0x0e, 0x98, 0x03, 0x5d, 0xf3, 0xb6, 0xd9, 0xba, 0x67, 0xa2, 0x05, 0x46,
+	0xb4, 0x89, 0xd1, 0xd6, 0x89, 0x81, 0x14, 0xd6, 0x40, 0x2a, 0x7b, 0x59, 0x55, 0xbc, 0x04, 0x05,
+	0xd2, 0x6a, 0x34, 0x76, 0xf4, 0xda, 0x6d, 0x94, 0xd9, 0x31, 0xfe, 0xf2, 0xe3, 0x0b, 0xca, 0xdf,
+	0x7c, 0x7c, 0x41, 0xf9, 0xc1, 0xc7, 0x17, 0x94, 0x0f, 0xff, 0xf9, 0xc2, 0x02, 0x2c, 0xbb, 0xc1,
+	0xd6, 0xb1, 0x1b, 0xd3, 0x28, 0x12, 0xff, 0x05, 0xf1, 0xbe, 0x26, 0x29, 0x37, 0xb8, 0x2c, 0x46,
+	0x97, 0x07, 0xc1, 0xe5, 0xe3, 0xf8, 0x32, 0x97, 0x5e, 0xe6, 0x19, 0xe4, 0x41, 0x9e, 0x13, 0x57,
+	0xff, 0x77, 0x00, 0x3c, 0x14, 0x00, 0x65, 0x63, 0x31, 0x00, 0x00,
 }
 
 func (m *Target) Marshal() (dAtA []byte, err error) {
@@ -5403,6 +5476,11 @@ func (m *ExecuteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Priority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.HasCreatedTempTables {
 		i--
 		if m.HasCreatedTempTables {
@@ -7805,6 +7883,13 @@ func (m *MessageStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -7914,6 +7999,13 @@ func (m *MessageAckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Ids) > 0 {
 		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8502,6 +8594,15 @@ func (m *RealtimeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TableSchemaChanged) > 0 {
+		for iNdEx := len(m.TableSchemaChanged) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TableSchemaChanged[iNdEx])
+			copy(dAtA[i:], m.TableSchemaChanged[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TableSchemaChanged[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.Qps != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Qps))))
@@ -8908,6 +9009,9 @@ func (m *ExecuteOptions) Size() (n int) {
 	if m.HasCreatedTempTables {
 		n += 2
 	}
+	if m.Priority != 0 {
+		n += 1 + sovQuery(uint64(m.Priority))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9906,6 +10010,10 @@ func (m *MessageStreamRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9956,6 +10064,10 @@ func (m *MessageAckRequest) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10193,6 +10305,12 @@ func (m *RealtimeStats) Size() (n int) {
 	if m.Qps != 0 {
 		n += 9
 	}
+	if len(m.TableSchemaChanged) > 0 {
+		for _, s := range m.TableSchemaChanged {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11358,6 +11476,25 @@ func (m *ExecuteOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HasCreatedTempTables = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= ExecuteOptions_Priority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -17687,6 +17824,38 @@ func (m *MessageStreamRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18005,6 +18174,38 @@ func (m *MessageAckRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -19500,6 +19701,38 @@ func (m *RealtimeStats) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.Qps = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableSchemaChanged", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableSchemaChanged = append(m.TableSchemaChanged, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
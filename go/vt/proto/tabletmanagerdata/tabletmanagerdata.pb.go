@@ -4558,6 +4558,417 @@ func (m *VExecResponse) GetResult() *query.QueryResult {
 	return nil
 }
 
+type GetHotRowsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetHotRowsRequest) Reset()         { *m = GetHotRowsRequest{} }
+func (m *GetHotRowsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHotRowsRequest) ProtoMessage()    {}
+func (*GetHotRowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
+}
+func (m *GetHotRowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHotRowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHotRowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHotRowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHotRowsRequest.Merge(m, src)
+}
+func (m *GetHotRowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHotRowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHotRowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHotRowsRequest proto.InternalMessageInfo
+
+// HotRow is a row (range) for which several transactions are in flight or
+// queued by the hot row protection of the tablet.
+type HotRow struct {
+	// key identifies the row (range), e.g. by table name and WHERE clause.
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// pending is the number of transactions in flight or queued.
+	Pending int64 `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	// max is the maximum of pending since the row was last idle.
+	Max int64 `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+	// count is the number of transactions since the row was last idle.
+	Count                int64    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HotRow) Reset()         { *m = HotRow{} }
+func (m *HotRow) String() string { return proto.CompactTextString(m) }
+func (*HotRow) ProtoMessage()    {}
+func (*HotRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
+}
+func (m *HotRow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HotRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HotRow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HotRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotRow.Merge(m, src)
+}
+func (m *HotRow) XXX_Size() int {
+	return m.Size()
+}
+func (m *HotRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HotRow proto.InternalMessageInfo
+
+func (m *HotRow) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HotRow) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *HotRow) GetPending() int64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *HotRow) GetMax() int64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *HotRow) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetHotRowsResponse struct {
+	HotRows              []*HotRow `protobuf:"bytes,1,rep,name=hot_rows,json=hotRows,proto3" json:"hot_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetHotRowsResponse) Reset()         { *m = GetHotRowsResponse{} }
+func (m *GetHotRowsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHotRowsResponse) ProtoMessage()    {}
+func (*GetHotRowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{96}
+}
+func (m *GetHotRowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHotRowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHotRowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHotRowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHotRowsResponse.Merge(m, src)
+}
+func (m *GetHotRowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHotRowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHotRowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHotRowsResponse proto.InternalMessageInfo
+
+func (m *GetHotRowsResponse) GetHotRows() []*HotRow {
+	if m != nil {
+		return m.HotRows
+	}
+	return nil
+}
+
+// VDiffOptions are the options of a tablet-side vdiff.
+type VDiffOptions struct {
+	// source_cell is the cell of the source tablets. Defaults to the
+	// cell of the target tablet.
+	SourceCell string `protobuf:"bytes,1,opt,name=source_cell,json=sourceCell,proto3" json:"source_cell,omitempty"`
+	// tablet_types is a comma separated list of source tablet types.
+	TabletTypes string `protobuf:"bytes,2,opt,name=tablet_types,json=tabletTypes,proto3" json:"tablet_types,omitempty"`
+	// tables is a comma separated list of the tables to diff. Defaults
+	// to all the tables of the workflow.
+	Tables string `protobuf:"bytes,3,opt,name=tables,proto3" json:"tables,omitempty"`
+	// max_rows is the maximum number of rows to compare per table.
+	MaxRows int64 `protobuf:"varint,4,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	// filtered_replication_wait_time is the maximum number of seconds
+	// to wait for the sources and the workflow to reach a position.
+	FilteredReplicationWaitTime int64    `protobuf:"varint,5,opt,name=filtered_replication_wait_time,json=filteredReplicationWaitTime,proto3" json:"filtered_replication_wait_time,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
+}
+
+func (m *VDiffOptions) Reset()         { *m = VDiffOptions{} }
+func (m *VDiffOptions) String() string { return proto.CompactTextString(m) }
+func (*VDiffOptions) ProtoMessage()    {}
+func (*VDiffOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{97}
+}
+func (m *VDiffOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VDiffOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VDiffOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VDiffOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffOptions.Merge(m, src)
+}
+func (m *VDiffOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *VDiffOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffOptions proto.InternalMessageInfo
+
+func (m *VDiffOptions) GetSourceCell() string {
+	if m != nil {
+		return m.SourceCell
+	}
+	return ""
+}
+
+func (m *VDiffOptions) GetTabletTypes() string {
+	if m != nil {
+		return m.TabletTypes
+	}
+	return ""
+}
+
+func (m *VDiffOptions) GetTables() string {
+	if m != nil {
+		return m.Tables
+	}
+	return ""
+}
+
+func (m *VDiffOptions) GetMaxRows() int64 {
+	if m != nil {
+		return m.MaxRows
+	}
+	return 0
+}
+
+func (m *VDiffOptions) GetFilteredReplicationWaitTime() int64 {
+	if m != nil {
+		return m.FilteredReplicationWaitTime
+	}
+	return 0
+}
+
+type VDiffRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// action is one of start, stop, resume, show or delete.
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// action_arg is the argument of show and delete: a vdiff uuid, "last" or "all".
+	ActionArg            string        `protobuf:"bytes,4,opt,name=action_arg,json=actionArg,proto3" json:"action_arg,omitempty"`
+	VdiffUuid            string        `protobuf:"bytes,5,opt,name=vdiff_uuid,json=vdiffUuid,proto3" json:"vdiff_uuid,omitempty"`
+	Options              *VDiffOptions `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VDiffRequest) Reset()         { *m = VDiffRequest{} }
+func (m *VDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*VDiffRequest) ProtoMessage()    {}
+func (*VDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{98}
+}
+func (m *VDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffRequest.Merge(m, src)
+}
+func (m *VDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffRequest proto.InternalMessageInfo
+
+func (m *VDiffRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetActionArg() string {
+	if m != nil {
+		return m.ActionArg
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetVdiffUuid() string {
+	if m != nil {
+		return m.VdiffUuid
+	}
+	return ""
+}
+
+func (m *VDiffRequest) GetOptions() *VDiffOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type VDiffResponse struct {
+	Id                   int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Output               *query.QueryResult `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	VdiffUuid            string             `protobuf:"bytes,3,opt,name=vdiff_uuid,json=vdiffUuid,proto3" json:"vdiff_uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *VDiffResponse) Reset()         { *m = VDiffResponse{} }
+func (m *VDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*VDiffResponse) ProtoMessage()    {}
+func (*VDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{99}
+}
+func (m *VDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VDiffResponse.Merge(m, src)
+}
+func (m *VDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VDiffResponse proto.InternalMessageInfo
+
+func (m *VDiffResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *VDiffResponse) GetOutput() *query.QueryResult {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *VDiffResponse) GetVdiffUuid() string {
+	if m != nil {
+		return m.VdiffUuid
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TableDefinition)(nil), "tabletmanagerdata.TableDefinition")
 	proto.RegisterType((*SchemaDefinition)(nil), "tabletmanagerdata.SchemaDefinition")
@@ -4656,150 +5067,172 @@ func init() {
 	proto.RegisterType((*RestoreFromBackupResponse)(nil), "tabletmanagerdata.RestoreFromBackupResponse")
 	proto.RegisterType((*VExecRequest)(nil), "tabletmanagerdata.VExecRequest")
 	proto.RegisterType((*VExecResponse)(nil), "tabletmanagerdata.VExecResponse")
+	proto.RegisterType((*GetHotRowsRequest)(nil), "tabletmanagerdata.GetHotRowsRequest")
+	proto.RegisterType((*HotRow)(nil), "tabletmanagerdata.HotRow")
+	proto.RegisterType((*GetHotRowsResponse)(nil), "tabletmanagerdata.GetHotRowsResponse")
+	proto.RegisterType((*VDiffOptions)(nil), "tabletmanagerdata.VDiffOptions")
+	proto.RegisterType((*VDiffRequest)(nil), "tabletmanagerdata.VDiffRequest")
+	proto.RegisterType((*VDiffResponse)(nil), "tabletmanagerdata.VDiffResponse")
 }
 
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x39, 0x4b, 0x73, 0xdb, 0xd6,
-	0xd5, 0x1f, 0xa8, 0x87, 0xa5, 0xc3, 0x87, 0x28, 0x90, 0x12, 0x21, 0xfa, 0x33, 0x2d, 0xc3, 0x4e,
-	0xe2, 0x49, 0xa6, 0x54, 0x23, 0x27, 0x99, 0x4c, 0xd2, 0x76, 0x22, 0xdb, 0x92, 0x9d, 0x58, 0x8e,
-	0x15, 0xc8, 0x8f, 0x4e, 0xa6, 0x53, 0x0c, 0x08, 0x5c, 0x91, 0x18, 0x81, 0xb8, 0xf0, 0xbd, 0x17,
-	0xa4, 0xb8, 0xe9, 0x4f, 0x68, 0xb7, 0x5d, 0x75, 0xd3, 0x99, 0x76, 0xdf, 0x1f, 0xd1, 0xe9, 0xb2,
-	0xab, 0x74, 0xdb, 0x71, 0x7f, 0x44, 0x17, 0x5d, 0xb4, 0x73, 0x1f, 0x20, 0x01, 0x02, 0xb2, 0x65,
-	0x8d, 0x3b, 0xd3, 0x8d, 0x06, 0xe7, 0xfd, 0xb8, 0xe7, 0x9e, 0x73, 0x2e, 0x05, 0x2d, 0xe6, 0xf4,
-	0x02, 0xc4, 0x86, 0x4e, 0xe8, 0xf4, 0x11, 0xf1, 0x1c, 0xe6, 0x74, 0x23, 0x82, 0x19, 0xd6, 0xd7,
-	0x73, 0x84, 0x76, 0xf9, 0x65, 0x8c, 0xc8, 0x44, 0xd2, 0xdb, 0x35, 0x86, 0x23, 0x3c, 0xe3, 0x6f,
-	0x6f, 0x10, 0x14, 0x05, 0xbe, 0xeb, 0x30, 0x1f, 0x87, 0x29, 0x74, 0x35, 0xc0, 0xfd, 0x98, 0xf9,
-	0x81, 0x04, 0xcd, 0x7f, 0x6b, 0xb0, 0xf6, 0x94, 0x2b, 0xbe, 0x8f, 0x4e, 0xfc, 0xd0, 0xe7, 0xcc,
-	0xba, 0x0e, 0x8b, 0xa1, 0x33, 0x44, 0x86, 0xb6, 0xad, 0xdd, 0x5e, 0xb5, 0xc4, 0xb7, 0xbe, 0x09,
-	0xcb, 0xd4, 0x1d, 0xa0, 0xa1, 0x63, 0x94, 0x04, 0x56, 0x41, 0xba, 0x01, 0x57, 0x5c, 0x1c, 0xc4,
-	0xc3, 0x90, 0x1a, 0x0b, 0xdb, 0x0b, 0xb7, 0x57, 0xad, 0x04, 0xd4, 0xbb, 0xd0, 0x88, 0x88, 0x3f,
-	0x74, 0xc8, 0xc4, 0x3e, 0x45, 0x13, 0x3b, 0xe1, 0x5a, 0x14, 0x5c, 0xeb, 0x8a, 0xf4, 0x08, 0x4d,
-	0xee, 0x29, 0x7e, 0x1d, 0x16, 0xd9, 0x24, 0x42, 0xc6, 0x92, 0xb4, 0xca, 0xbf, 0xf5, 0xeb, 0x50,
-	0xe6, 0xae, 0xdb, 0x01, 0x0a, 0xfb, 0x6c, 0x60, 0x2c, 0x6f, 0x6b, 0xb7, 0x17, 0x2d, 0xe0, 0xa8,
-	0x43, 0x81, 0xd1, 0xaf, 0xc2, 0x2a, 0xc1, 0x63, 0xdb, 0xc5, 0x71, 0xc8, 0x8c, 0x2b, 0x82, 0xbc,
-	0x42, 0xf0, 0xf8, 0x1e, 0x87, 0xf5, 0x5b, 0xb0, 0x7c, 0xe2, 0xa3, 0xc0, 0xa3, 0xc6, 0xca, 0xf6,
-	0xc2, 0xed, 0xf2, 0x6e, 0xa5, 0x2b, 0xf3, 0x75, 0xc0, 0x91, 0x96, 0xa2, 0x99, 0x7f, 0xd0, 0xa0,
-	0x7e, 0x2c, 0x82, 0x49, 0xa5, 0xe0, 0x03, 0x58, 0xe3, 0x56, 0x7a, 0x0e, 0x45, 0xb6, 0x8a, 0x5b,
-	0x66, 0xa3, 0x96, 0xa0, 0xa5, 0x88, 0xfe, 0x04, 0xe4, 0xb9, 0xd8, 0xde, 0x54, 0x98, 0x1a, 0x25,
-	0x61, 0xce, 0xec, 0xe6, 0x8f, 0x72, 0x2e, 0xd5, 0x56, 0x9d, 0x65, 0x11, 0x94, 0x27, 0x74, 0x84,
-	0x08, 0xf5, 0x71, 0x68, 0x2c, 0x08, 0x8b, 0x09, 0xc8, 0x1d, 0xd5, 0xa5, 0xd5, 0x7b, 0x03, 0x27,
-	0xec, 0x23, 0x0b, 0xd1, 0x38, 0x60, 0xfa, 0x43, 0xa8, 0xf6, 0xd0, 0x09, 0x26, 0x19, 0x47, 0xcb,
-	0xbb, 0x37, 0x0b, 0xac, 0xcf, 0x87, 0x69, 0x55, 0xa4, 0xa4, 0x8a, 0xe5, 0x00, 0x2a, 0xce, 0x09,
-	0x43, 0xc4, 0x4e, 0x9d, 0xf4, 0x05, 0x15, 0x95, 0x85, 0xa0, 0x44, 0x9b, 0xff, 0xd4, 0xa0, 0xf6,
-	0x8c, 0x22, 0x72, 0x84, 0xc8, 0xd0, 0xa7, 0x54, 0x95, 0xd4, 0x00, 0x53, 0x96, 0x94, 0x14, 0xff,
-	0xe6, 0xb8, 0x98, 0x22, 0xa2, 0x0a, 0x4a, 0x7c, 0xeb, 0x1f, 0xc1, 0x7a, 0xe4, 0x50, 0x3a, 0xc6,
-	0xc4, 0xb3, 0xdd, 0x01, 0x72, 0x4f, 0x69, 0x3c, 0x14, 0x79, 0x58, 0xb4, 0xea, 0x09, 0xe1, 0x9e,
-	0xc2, 0xeb, 0xdf, 0x01, 0x44, 0xc4, 0x1f, 0xf9, 0x01, 0xea, 0x23, 0x59, 0x58, 0xe5, 0xdd, 0x8f,
-	0x0b, 0xbc, 0xcd, 0xfa, 0xd2, 0x3d, 0x9a, 0xca, 0xec, 0x87, 0x8c, 0x4c, 0xac, 0x94, 0x92, 0xf6,
-	0x4f, 0x61, 0x6d, 0x8e, 0xac, 0xd7, 0x61, 0xe1, 0x14, 0x4d, 0x94, 0xe7, 0xfc, 0x53, 0x6f, 0xc2,
-	0xd2, 0xc8, 0x09, 0x62, 0xa4, 0x3c, 0x97, 0xc0, 0x17, 0xa5, 0xcf, 0x35, 0xf3, 0x07, 0x0d, 0x2a,
-	0xf7, 0x7b, 0x6f, 0x88, 0xbb, 0x06, 0x25, 0xaf, 0xa7, 0x64, 0x4b, 0x5e, 0x6f, 0x9a, 0x87, 0x85,
-	0x54, 0x1e, 0x9e, 0x14, 0x84, 0xb6, 0x53, 0x10, 0x5a, 0xda, 0xd8, 0x7f, 0x33, 0xb0, 0xdf, 0x6b,
-	0x50, 0x9e, 0x59, 0xa2, 0xfa, 0x21, 0xd4, 0xb9, 0x9f, 0x76, 0x34, 0xc3, 0x19, 0x9a, 0xf0, 0xf2,
-	0xc6, 0x1b, 0x0f, 0xc0, 0x5a, 0x8b, 0x33, 0x30, 0xd5, 0x0f, 0xa0, 0xe6, 0xf5, 0x32, 0xba, 0xe4,
-	0x0d, 0xba, 0xfe, 0x86, 0x88, 0xad, 0xaa, 0x97, 0x82, 0xa8, 0xf9, 0x01, 0x94, 0x8f, 0xfc, 0xb0,
-	0x6f, 0xa1, 0x97, 0x31, 0xa2, 0x8c, 0x5f, 0xa5, 0xc8, 0x99, 0x04, 0xd8, 0xf1, 0x54, 0x90, 0x09,
-	0x68, 0xde, 0x86, 0x8a, 0x64, 0xa4, 0x11, 0x0e, 0x29, 0x7a, 0x0d, 0xe7, 0x87, 0x50, 0x39, 0x0e,
-	0x10, 0x8a, 0x12, 0x9d, 0x6d, 0x58, 0xf1, 0x62, 0x22, 0x9a, 0xaa, 0x60, 0x5d, 0xb0, 0xa6, 0xb0,
-	0xb9, 0x06, 0x55, 0xc5, 0x2b, 0xd5, 0x9a, 0x7f, 0xd3, 0x40, 0xdf, 0x3f, 0x43, 0x6e, 0xcc, 0xd0,
-	0x43, 0x8c, 0x4f, 0x13, 0x1d, 0x45, 0xfd, 0xb5, 0x03, 0x10, 0x39, 0xc4, 0x19, 0x22, 0x86, 0x88,
-	0x0c, 0x7f, 0xd5, 0x4a, 0x61, 0xf4, 0x23, 0x58, 0x45, 0x67, 0x8c, 0x38, 0x36, 0x0a, 0x47, 0xa2,
-	0xd3, 0x96, 0x77, 0xef, 0x14, 0x64, 0x27, 0x6f, 0xad, 0xbb, 0xcf, 0xc5, 0xf6, 0xc3, 0x91, 0xac,
-	0x89, 0x15, 0xa4, 0xc0, 0xf6, 0x97, 0x50, 0xcd, 0x90, 0xde, 0xaa, 0x1e, 0x4e, 0xa0, 0x91, 0x31,
-	0xa5, 0xf2, 0x78, 0x1d, 0xca, 0xe8, 0xcc, 0x67, 0x36, 0x65, 0x0e, 0x8b, 0xa9, 0x4a, 0x10, 0x70,
-	0xd4, 0xb1, 0xc0, 0x88, 0x31, 0xc2, 0x3c, 0x1c, 0xb3, 0xe9, 0x18, 0x11, 0x90, 0xc2, 0x23, 0x92,
-	0xdc, 0x02, 0x05, 0x99, 0x23, 0xa8, 0x3f, 0x40, 0x4c, 0xf6, 0x95, 0x24, 0x7d, 0x9b, 0xb0, 0x2c,
-	0x02, 0x97, 0x15, 0xb7, 0x6a, 0x29, 0x48, 0xbf, 0x09, 0x55, 0x3f, 0x74, 0x83, 0xd8, 0x43, 0xf6,
-	0xc8, 0x47, 0x63, 0x2a, 0x4c, 0xac, 0x58, 0x15, 0x85, 0x7c, 0xce, 0x71, 0xfa, 0x7b, 0x50, 0x43,
-	0x67, 0x92, 0x49, 0x29, 0x91, 0x63, 0xab, 0xaa, 0xb0, 0xa2, 0x41, 0x53, 0x13, 0xc1, 0x7a, 0xca,
-	0xae, 0x8a, 0xee, 0x08, 0xd6, 0x65, 0x67, 0x4c, 0x35, 0xfb, 0xb7, 0xe9, 0xb6, 0x75, 0x3a, 0x87,
-	0x31, 0x5b, 0xb0, 0xf1, 0x00, 0xb1, 0x54, 0x09, 0xab, 0x18, 0xcd, 0xef, 0x61, 0x73, 0x9e, 0xa0,
-	0x9c, 0xf8, 0x0a, 0xca, 0xd9, 0x4b, 0xc7, 0xcd, 0x77, 0x0a, 0xcc, 0xa7, 0x85, 0xd3, 0x22, 0x66,
-	0x13, 0xf4, 0x63, 0xc4, 0x2c, 0xe4, 0x78, 0x4f, 0xc2, 0x60, 0x92, 0x58, 0xdc, 0x80, 0x46, 0x06,
-	0xab, 0x4a, 0x78, 0x86, 0x7e, 0x41, 0x7c, 0x86, 0x12, 0xee, 0x4d, 0x68, 0x66, 0xd1, 0x8a, 0xfd,
-	0x1b, 0x58, 0x97, 0xc3, 0xe9, 0xe9, 0x24, 0x4a, 0x98, 0xf5, 0x4f, 0xa1, 0x2c, 0xdd, 0xb3, 0xc5,
-	0x80, 0xe7, 0x2e, 0xd7, 0x76, 0x9b, 0xdd, 0xe9, 0xbe, 0x22, 0x72, 0xce, 0x84, 0x04, 0xb0, 0xe9,
-	0x37, 0xf7, 0x33, 0xad, 0x6b, 0xe6, 0x90, 0x85, 0x4e, 0x08, 0xa2, 0x03, 0x5e, 0x52, 0x69, 0x87,
-	0xb2, 0x68, 0xc5, 0xde, 0x82, 0x0d, 0x2b, 0x0e, 0x1f, 0x22, 0x27, 0x60, 0x03, 0x31, 0x38, 0x12,
-	0x01, 0x03, 0x36, 0xe7, 0x09, 0x4a, 0xe4, 0x13, 0x30, 0xbe, 0xee, 0x87, 0x98, 0x20, 0x49, 0xdc,
-	0x27, 0x04, 0x93, 0x4c, 0x4b, 0x61, 0x0c, 0x91, 0x70, 0xd6, 0x28, 0x04, 0x68, 0x5e, 0x85, 0xad,
-	0x02, 0x29, 0xa5, 0xf2, 0x0b, 0xee, 0x34, 0xef, 0x27, 0xd9, 0x4a, 0xbe, 0x09, 0xd5, 0xb1, 0xe3,
-	0x33, 0x3b, 0xc2, 0x74, 0x56, 0x4c, 0xab, 0x56, 0x85, 0x23, 0x8f, 0x14, 0x4e, 0x46, 0x96, 0x96,
-	0x55, 0x3a, 0x77, 0x61, 0xf3, 0x88, 0xa0, 0x93, 0xc0, 0xef, 0x0f, 0xe6, 0x2e, 0x08, 0xdf, 0xc9,
-	0x44, 0xe2, 0x92, 0x1b, 0x92, 0x80, 0x66, 0x1f, 0x5a, 0x39, 0x19, 0x55, 0x57, 0x87, 0x50, 0x93,
-	0x5c, 0x36, 0x11, 0x7b, 0x45, 0xd2, 0xcf, 0xdf, 0x3b, 0xb7, 0xb2, 0xd3, 0x5b, 0x88, 0x55, 0x75,
-	0x53, 0x10, 0x35, 0xff, 0xa5, 0x81, 0xbe, 0x17, 0x45, 0xc1, 0x24, 0xeb, 0x59, 0x1d, 0x16, 0xe8,
-	0xcb, 0x20, 0x69, 0x31, 0xf4, 0x65, 0xc0, 0x5b, 0xcc, 0x09, 0x26, 0x2e, 0x52, 0x97, 0x55, 0x02,
-	0x7c, 0x0d, 0x70, 0x82, 0x00, 0x8f, 0xed, 0xd4, 0x0e, 0x2b, 0x3a, 0xc3, 0x8a, 0x55, 0x17, 0x04,
-	0x6b, 0x86, 0xcf, 0x2f, 0x40, 0x8b, 0xef, 0x6a, 0x01, 0x5a, 0xba, 0xe4, 0x02, 0xf4, 0x47, 0x0d,
-	0x1a, 0x99, 0xe8, 0x55, 0x8e, 0xff, 0xf7, 0x56, 0xb5, 0x06, 0xac, 0x1f, 0x62, 0xf7, 0x54, 0x76,
-	0xbd, 0xe4, 0x6a, 0x34, 0x41, 0x4f, 0x23, 0x67, 0x17, 0xef, 0x59, 0x18, 0xe4, 0x98, 0x37, 0xa1,
-	0x99, 0x45, 0x2b, 0xf6, 0x3f, 0x69, 0x60, 0xa8, 0x11, 0x71, 0x80, 0x98, 0x3b, 0xd8, 0xa3, 0xf7,
-	0x7b, 0xd3, 0x3a, 0x68, 0xc2, 0x92, 0x58, 0xc5, 0x45, 0x02, 0x2a, 0x96, 0x04, 0xf4, 0x16, 0x5c,
-	0xf1, 0x7a, 0xb6, 0x18, 0x8d, 0x6a, 0x3a, 0x78, 0xbd, 0x6f, 0xf9, 0x70, 0xdc, 0x82, 0x95, 0xa1,
-	0x73, 0x66, 0x13, 0x3c, 0xa6, 0x6a, 0x19, 0xbc, 0x32, 0x74, 0xce, 0x2c, 0x3c, 0xa6, 0x62, 0x51,
-	0xf7, 0xa9, 0xd8, 0xc0, 0x7b, 0x7e, 0x18, 0xe0, 0x3e, 0x15, 0xc7, 0xbf, 0x62, 0xd5, 0x14, 0xfa,
-	0xae, 0xc4, 0xf2, 0xbb, 0x46, 0xc4, 0x35, 0x4a, 0x1f, 0xee, 0x8a, 0x55, 0x21, 0xa9, 0xbb, 0x65,
-	0x3e, 0x80, 0xad, 0x02, 0x9f, 0xd5, 0xe9, 0x7d, 0x08, 0xcb, 0xf2, 0x6a, 0xa8, 0x63, 0xd3, 0xd5,
-	0x73, 0xe2, 0x3b, 0xfe, 0x57, 0x5d, 0x03, 0xc5, 0x61, 0xfe, 0x5a, 0x83, 0x6b, 0x59, 0x4d, 0x7b,
-	0x41, 0xc0, 0x17, 0x30, 0xfa, 0xee, 0x53, 0x90, 0x8b, 0x6c, 0xb1, 0x20, 0xb2, 0x43, 0xe8, 0x9c,
-	0xe7, 0xcf, 0x25, 0xc2, 0x7b, 0x34, 0x7f, 0xb6, 0x7b, 0x51, 0xf4, 0xfa, 0xc0, 0xd2, 0xfe, 0x97,
-	0x32, 0xfe, 0xe7, 0x93, 0x2e, 0x94, 0x5d, 0xc2, 0xab, 0x36, 0x18, 0xa9, 0xbe, 0x20, 0x37, 0x8e,
-	0xa4, 0x4c, 0x0f, 0x61, 0xab, 0x80, 0xa6, 0x8c, 0xec, 0xf0, 0xed, 0x63, 0xba, 0xb1, 0x94, 0x77,
-	0x5b, 0xdd, 0xf9, 0xb7, 0xb3, 0x12, 0x50, 0x6c, 0xfc, 0x2e, 0x3c, 0x76, 0x28, 0xbf, 0x46, 0x19,
-	0x23, 0x8f, 0xa1, 0x99, 0x45, 0x2b, 0xfd, 0x9f, 0xce, 0xe9, 0xbf, 0x96, 0xd3, 0x9f, 0x11, 0x4b,
-	0xac, 0xb4, 0x60, 0x43, 0xe2, 0x93, 0x59, 0x90, 0xd8, 0xf9, 0x04, 0x36, 0xe7, 0x09, 0xca, 0x52,
-	0x1b, 0x56, 0xe6, 0x86, 0xc9, 0x14, 0xe6, 0x52, 0x2f, 0x1c, 0x9f, 0x1d, 0xe0, 0x79, 0x7d, 0xaf,
-	0x95, 0xda, 0x82, 0x56, 0x4e, 0x4a, 0x5d, 0x71, 0x03, 0x36, 0x8f, 0x19, 0x8e, 0x52, 0x79, 0x4d,
-	0x1c, 0xdc, 0x82, 0x56, 0x8e, 0xa2, 0x84, 0x7e, 0x09, 0xd7, 0xe6, 0x48, 0x8f, 0xfd, 0xd0, 0x1f,
-	0xc6, 0xc3, 0x0b, 0x38, 0xa3, 0xdf, 0x00, 0x31, 0x1b, 0x6d, 0xe6, 0x0f, 0x51, 0xb2, 0x44, 0x2e,
-	0x58, 0x65, 0x8e, 0x7b, 0x2a, 0x51, 0xe6, 0x4f, 0xa0, 0x73, 0x9e, 0xfe, 0x0b, 0xe4, 0x48, 0x38,
-	0xee, 0x10, 0x56, 0x10, 0x53, 0x1b, 0x8c, 0x3c, 0x49, 0x05, 0xd5, 0x83, 0x1b, 0xf3, 0xb4, 0x67,
-	0x21, 0xf3, 0x83, 0x3d, 0xde, 0x6a, 0xdf, 0x51, 0x60, 0xb7, 0xc0, 0x7c, 0x9d, 0x0d, 0xe5, 0x49,
-	0x13, 0xf4, 0x07, 0x28, 0xe1, 0x99, 0x16, 0xe6, 0x47, 0xd0, 0xc8, 0x60, 0x55, 0x26, 0x9a, 0xb0,
-	0xe4, 0x78, 0x1e, 0x49, 0xd6, 0x04, 0x09, 0xf0, 0x1c, 0x58, 0x88, 0xa2, 0x73, 0x72, 0x90, 0x27,
-	0x29, 0xcb, 0x3b, 0xd0, 0x7a, 0x9e, 0xc2, 0xf3, 0x2b, 0x5d, 0xd8, 0x12, 0x56, 0x55, 0x4b, 0x30,
-	0x0f, 0xc0, 0xc8, 0x0b, 0x5c, 0xaa, 0x19, 0x5d, 0x4b, 0xeb, 0x99, 0x55, 0x6b, 0x62, 0xbe, 0x06,
-	0x25, 0xdf, 0x53, 0x8f, 0x91, 0x92, 0xef, 0x65, 0x0e, 0xa2, 0x34, 0x57, 0x00, 0xdb, 0xd0, 0x39,
-	0x4f, 0x99, 0x8a, 0xb3, 0x01, 0xeb, 0x5f, 0x87, 0x3e, 0x93, 0x17, 0x30, 0x49, 0xcc, 0x8f, 0x41,
-	0x4f, 0x23, 0x2f, 0x50, 0x69, 0x3f, 0x68, 0xd0, 0x39, 0xc2, 0x51, 0x1c, 0x88, 0x6d, 0x35, 0x72,
-	0x08, 0x0a, 0xd9, 0x37, 0x38, 0x26, 0xa1, 0x13, 0x24, 0x7e, 0xbf, 0x0f, 0x6b, 0xbc, 0x1e, 0x6c,
-	0x97, 0x20, 0x87, 0x21, 0xcf, 0x0e, 0x93, 0x17, 0x55, 0x95, 0xa3, 0xef, 0x49, 0xec, 0xb7, 0x94,
-	0xbf, 0xba, 0x1c, 0x97, 0x2b, 0x4d, 0x0f, 0x0e, 0x90, 0x28, 0x31, 0x3c, 0x3e, 0x87, 0xca, 0x50,
-	0x78, 0x66, 0x3b, 0x81, 0xef, 0xc8, 0x01, 0x52, 0xde, 0xdd, 0x98, 0xdf, 0xc0, 0xf7, 0x38, 0xd1,
-	0x2a, 0x4b, 0x56, 0x01, 0xe8, 0x1f, 0x43, 0x33, 0xd5, 0xaa, 0x66, 0x8b, 0xea, 0xa2, 0xb0, 0xd1,
-	0x48, 0xd1, 0xa6, 0xfb, 0xea, 0x0d, 0xb8, 0x7e, 0x6e, 0x5c, 0x2a, 0x85, 0xbf, 0xd3, 0x64, 0xba,
-	0x54, 0xa2, 0x93, 0x78, 0x7f, 0x04, 0xcb, 0x92, 0x5f, 0x1d, 0xfa, 0x39, 0x0e, 0x2a, 0xa6, 0x73,
-	0x7d, 0x2b, 0x9d, 0xeb, 0x5b, 0x51, 0x46, 0x17, 0x0a, 0x32, 0xca, 0xfb, 0x7b, 0xc6, 0xbf, 0xd9,
-	0x0a, 0x74, 0x1f, 0x0d, 0x31, 0x43, 0xd9, 0xc3, 0xff, 0x8d, 0x06, 0xcd, 0x2c, 0x5e, 0x9d, 0xff,
-	0x1d, 0x68, 0x78, 0x28, 0x22, 0xc8, 0x15, 0xc6, 0xb2, 0xa5, 0x70, 0xb7, 0x64, 0x68, 0x96, 0x3e,
-	0x23, 0x4f, 0x7d, 0xbc, 0x0b, 0x55, 0x75, 0x58, 0x6a, 0x66, 0x94, 0x2e, 0x32, 0x33, 0xd4, 0x01,
-	0x4b, 0x88, 0x5f, 0xe1, 0x67, 0xa1, 0x87, 0x8b, 0x9c, 0x6d, 0x83, 0x91, 0x27, 0xa9, 0xf8, 0xae,
-	0x4e, 0x87, 0xe4, 0x0b, 0x87, 0x1e, 0x11, 0xcc, 0x59, 0xbc, 0x44, 0xf0, 0xff, 0xa1, 0x5d, 0x44,
-	0x54, 0xa2, 0x7f, 0xd6, 0xa0, 0x7e, 0x8c, 0xb2, 0xb7, 0xe2, 0x6d, 0x0f, 0xb4, 0xe0, 0x74, 0x4a,
-	0x45, 0xf5, 0xfe, 0x19, 0xb4, 0xc4, 0x33, 0x81, 0x27, 0x88, 0xb0, 0x82, 0x37, 0xc2, 0x86, 0x20,
-	0xcf, 0x77, 0xcb, 0xfc, 0x73, 0x6b, 0xb1, 0xe0, 0xb9, 0xd5, 0x80, 0xf5, 0x54, 0x1c, 0x2a, 0xba,
-	0x47, 0xe9, 0xd8, 0x2d, 0x24, 0xec, 0x4e, 0x33, 0xf3, 0x96, 0x61, 0x9a, 0xd7, 0xe0, 0x6a, 0xa1,
-	0x32, 0x65, 0xeb, 0x57, 0xbc, 0xcf, 0x67, 0x06, 0xd8, 0x5e, 0xe8, 0x3d, 0x40, 0x2c, 0xb3, 0x6a,
-	0xe8, 0x3f, 0x87, 0x0d, 0xca, 0x70, 0x94, 0x0e, 0xde, 0x1e, 0x62, 0x2f, 0x79, 0x5d, 0xdf, 0x2a,
-	0xd8, 0x60, 0xb2, 0x43, 0x11, 0x7b, 0xc8, 0x6a, 0xd0, 0x3c, 0x92, 0x3f, 0x5e, 0x6e, 0xbe, 0xd6,
-	0x81, 0xe9, 0x0f, 0x11, 0xd5, 0xc1, 0xa4, 0x47, 0x7c, 0xcf, 0xbe, 0xd0, 0xee, 0x24, 0xea, 0xbd,
-	0x22, 0x25, 0xd4, 0x8f, 0x41, 0x3f, 0x9b, 0xae, 0x45, 0xb2, 0xc4, 0xdf, 0x7f, 0x93, 0xd3, 0xf9,
-	0xfd, 0x48, 0xd5, 0x61, 0xb6, 0x91, 0xf0, 0x4d, 0x67, 0x9e, 0x70, 0x81, 0x8e, 0x7c, 0x0c, 0xd5,
-	0xbb, 0x8e, 0x7b, 0x1a, 0x4f, 0x37, 0xd9, 0x6d, 0x28, 0xbb, 0x38, 0x74, 0x63, 0x42, 0x50, 0xe8,
-	0x4e, 0x54, 0xef, 0x4d, 0xa3, 0x38, 0x87, 0x78, 0x8e, 0xca, 0x72, 0x51, 0x6f, 0xd8, 0x34, 0xca,
-	0xfc, 0x0c, 0x6a, 0x89, 0x52, 0xe5, 0xc2, 0x2d, 0x58, 0x42, 0xa3, 0x59, 0xb1, 0xd4, 0xba, 0xc9,
-	0x3f, 0x64, 0xf6, 0x39, 0xd6, 0x92, 0x44, 0x35, 0x69, 0x19, 0x26, 0xe8, 0x80, 0xe0, 0x61, 0xc6,
-	0x2f, 0x73, 0x8f, 0x5f, 0xd3, 0x1c, 0xed, 0xad, 0xd4, 0xff, 0x02, 0x2a, 0xcf, 0xdf, 0x38, 0xa1,
-	0x79, 0xb6, 0xc6, 0x98, 0x9c, 0x9e, 0x04, 0x78, 0x9c, 0x0c, 0xca, 0x04, 0xe6, 0xb4, 0x53, 0x34,
-	0xa1, 0x91, 0xe3, 0x22, 0xf5, 0x9b, 0xdd, 0x14, 0x36, 0xbf, 0x84, 0xea, 0xf3, 0xcb, 0x8e, 0xf3,
-	0xbb, 0x5f, 0xfd, 0xe5, 0x55, 0x47, 0xfb, 0xeb, 0xab, 0x8e, 0xf6, 0xf7, 0x57, 0x1d, 0xed, 0xb7,
-	0xff, 0xe8, 0xfc, 0xdf, 0xf7, 0xdd, 0x91, 0xcf, 0x10, 0xa5, 0x5d, 0x1f, 0xef, 0xc8, 0xaf, 0x9d,
-	0x3e, 0xde, 0x19, 0xb1, 0x1d, 0xf1, 0x1f, 0xac, 0x9d, 0xdc, 0x93, 0xb7, 0xb7, 0x2c, 0x08, 0x77,
-	0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x11, 0xbb, 0x4b, 0xec, 0x4b, 0x1b, 0x00, 0x00,
+	// 2461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x19, 0x4b, 0x6f, 0x1b, 0xc7,
+	0xb9, 0x4b, 0xea, 0xf9, 0xf1, 0x21, 0x69, 0x49, 0x49, 0x94, 0x5c, 0xc9, 0xf2, 0xda, 0x49, 0x0c,
+	0x07, 0x95, 0x1a, 0xd9, 0x09, 0xd2, 0xa4, 0x2d, 0x22, 0xeb, 0x61, 0xc7, 0x96, 0x63, 0x65, 0xe5,
+	0x47, 0x11, 0x14, 0x5d, 0x2c, 0xb9, 0x43, 0x72, 0xab, 0xe5, 0xce, 0x7a, 0x66, 0x96, 0x12, 0x2f,
+	0xfd, 0x09, 0xed, 0xb5, 0xa7, 0x5e, 0x0a, 0xb4, 0xf7, 0xfe, 0x88, 0xa2, 0xe8, 0xa9, 0xa7, 0xe4,
+	0x5a, 0xb8, 0x3f, 0xa2, 0x87, 0x1e, 0x5a, 0xcc, 0x8b, 0xdc, 0x5d, 0xae, 0x64, 0x59, 0x30, 0x8a,
+	0x5c, 0x88, 0xfd, 0xde, 0x8f, 0xf9, 0xe6, 0x9b, 0x6f, 0x86, 0xb0, 0xcc, 0xdc, 0x66, 0x80, 0x58,
+	0xcf, 0x0d, 0xdd, 0x0e, 0x22, 0x9e, 0xcb, 0xdc, 0xcd, 0x88, 0x60, 0x86, 0xcd, 0x85, 0x31, 0xc2,
+	0x6a, 0xe9, 0x55, 0x8c, 0xc8, 0x40, 0xd2, 0x57, 0xab, 0x0c, 0x47, 0x78, 0xc4, 0xbf, 0xba, 0x48,
+	0x50, 0x14, 0xf8, 0x2d, 0x97, 0xf9, 0x38, 0x4c, 0xa0, 0x2b, 0x01, 0xee, 0xc4, 0xcc, 0x0f, 0x24,
+	0x68, 0xfd, 0xd7, 0x80, 0xb9, 0x67, 0x5c, 0xf1, 0x1e, 0x6a, 0xfb, 0xa1, 0xcf, 0x99, 0x4d, 0x13,
+	0x26, 0x42, 0xb7, 0x87, 0x1a, 0xc6, 0x86, 0x71, 0x7b, 0xd6, 0x16, 0xdf, 0xe6, 0x12, 0x4c, 0xd1,
+	0x56, 0x17, 0xf5, 0xdc, 0x46, 0x41, 0x60, 0x15, 0x64, 0x36, 0x60, 0xba, 0x85, 0x83, 0xb8, 0x17,
+	0xd2, 0x46, 0x71, 0xa3, 0x78, 0x7b, 0xd6, 0xd6, 0xa0, 0xb9, 0x09, 0xb5, 0x88, 0xf8, 0x3d, 0x97,
+	0x0c, 0x9c, 0x13, 0x34, 0x70, 0x34, 0xd7, 0x84, 0xe0, 0x5a, 0x50, 0xa4, 0xc7, 0x68, 0xb0, 0xab,
+	0xf8, 0x4d, 0x98, 0x60, 0x83, 0x08, 0x35, 0x26, 0xa5, 0x55, 0xfe, 0x6d, 0x5e, 0x87, 0x12, 0x77,
+	0xdd, 0x09, 0x50, 0xd8, 0x61, 0xdd, 0xc6, 0xd4, 0x86, 0x71, 0x7b, 0xc2, 0x06, 0x8e, 0x3a, 0x14,
+	0x18, 0xf3, 0x1a, 0xcc, 0x12, 0x7c, 0xea, 0xb4, 0x70, 0x1c, 0xb2, 0xc6, 0xb4, 0x20, 0xcf, 0x10,
+	0x7c, 0xba, 0xcb, 0x61, 0xf3, 0x16, 0x4c, 0xb5, 0x7d, 0x14, 0x78, 0xb4, 0x31, 0xb3, 0x51, 0xbc,
+	0x5d, 0xda, 0x2e, 0x6f, 0xca, 0x7c, 0x1d, 0x70, 0xa4, 0xad, 0x68, 0xd6, 0x9f, 0x0c, 0x98, 0x3f,
+	0x16, 0xc1, 0x24, 0x52, 0xf0, 0x01, 0xcc, 0x71, 0x2b, 0x4d, 0x97, 0x22, 0x47, 0xc5, 0x2d, 0xb3,
+	0x51, 0xd5, 0x68, 0x29, 0x62, 0x3e, 0x05, 0xb9, 0x2e, 0x8e, 0x37, 0x14, 0xa6, 0x8d, 0x82, 0x30,
+	0x67, 0x6d, 0x8e, 0x2f, 0x65, 0x26, 0xd5, 0xf6, 0x3c, 0x4b, 0x23, 0x28, 0x4f, 0x68, 0x1f, 0x11,
+	0xea, 0xe3, 0xb0, 0x51, 0x14, 0x16, 0x35, 0xc8, 0x1d, 0x35, 0xa5, 0xd5, 0xdd, 0xae, 0x1b, 0x76,
+	0x90, 0x8d, 0x68, 0x1c, 0x30, 0xf3, 0x21, 0x54, 0x9a, 0xa8, 0x8d, 0x49, 0xca, 0xd1, 0xd2, 0xf6,
+	0xcd, 0x1c, 0xeb, 0xd9, 0x30, 0xed, 0xb2, 0x94, 0x54, 0xb1, 0x1c, 0x40, 0xd9, 0x6d, 0x33, 0x44,
+	0x9c, 0xc4, 0x4a, 0x5f, 0x52, 0x51, 0x49, 0x08, 0x4a, 0xb4, 0xf5, 0x6f, 0x03, 0xaa, 0xcf, 0x29,
+	0x22, 0x47, 0x88, 0xf4, 0x7c, 0x4a, 0x55, 0x49, 0x75, 0x31, 0x65, 0xba, 0xa4, 0xf8, 0x37, 0xc7,
+	0xc5, 0x14, 0x11, 0x55, 0x50, 0xe2, 0xdb, 0xfc, 0x10, 0x16, 0x22, 0x97, 0xd2, 0x53, 0x4c, 0x3c,
+	0xa7, 0xd5, 0x45, 0xad, 0x13, 0x1a, 0xf7, 0x44, 0x1e, 0x26, 0xec, 0x79, 0x4d, 0xd8, 0x55, 0x78,
+	0xf3, 0x6b, 0x80, 0x88, 0xf8, 0x7d, 0x3f, 0x40, 0x1d, 0x24, 0x0b, 0xab, 0xb4, 0xfd, 0x51, 0x8e,
+	0xb7, 0x69, 0x5f, 0x36, 0x8f, 0x86, 0x32, 0xfb, 0x21, 0x23, 0x03, 0x3b, 0xa1, 0x64, 0xf5, 0x67,
+	0x30, 0x97, 0x21, 0x9b, 0xf3, 0x50, 0x3c, 0x41, 0x03, 0xe5, 0x39, 0xff, 0x34, 0xeb, 0x30, 0xd9,
+	0x77, 0x83, 0x18, 0x29, 0xcf, 0x25, 0xf0, 0x59, 0xe1, 0x53, 0xc3, 0xfa, 0xd6, 0x80, 0xf2, 0x5e,
+	0xf3, 0x0d, 0x71, 0x57, 0xa1, 0xe0, 0x35, 0x95, 0x6c, 0xc1, 0x6b, 0x0e, 0xf3, 0x50, 0x4c, 0xe4,
+	0xe1, 0x69, 0x4e, 0x68, 0x5b, 0x39, 0xa1, 0xed, 0x35, 0xff, 0x3f, 0x81, 0xfd, 0xd1, 0x80, 0xd2,
+	0xc8, 0x12, 0x35, 0x0f, 0x61, 0x9e, 0xfb, 0xe9, 0x44, 0x23, 0x5c, 0xc3, 0x10, 0x5e, 0xde, 0x78,
+	0xe3, 0x02, 0xd8, 0x73, 0x71, 0x0a, 0xa6, 0xe6, 0x01, 0x54, 0xbd, 0x66, 0x4a, 0x97, 0xdc, 0x41,
+	0xd7, 0xdf, 0x10, 0xb1, 0x5d, 0xf1, 0x12, 0x10, 0xb5, 0x3e, 0x80, 0xd2, 0x91, 0x1f, 0x76, 0x6c,
+	0xf4, 0x2a, 0x46, 0x94, 0xf1, 0xad, 0x14, 0xb9, 0x83, 0x00, 0xbb, 0x9e, 0x0a, 0x52, 0x83, 0xd6,
+	0x6d, 0x28, 0x4b, 0x46, 0x1a, 0xe1, 0x90, 0xa2, 0x0b, 0x38, 0xef, 0x40, 0xf9, 0x38, 0x40, 0x28,
+	0xd2, 0x3a, 0x57, 0x61, 0xc6, 0x8b, 0x89, 0x68, 0xaa, 0x82, 0xb5, 0x68, 0x0f, 0x61, 0x6b, 0x0e,
+	0x2a, 0x8a, 0x57, 0xaa, 0xb5, 0xbe, 0x33, 0xc0, 0xdc, 0x3f, 0x43, 0xad, 0x98, 0xa1, 0x87, 0x18,
+	0x9f, 0x68, 0x1d, 0x79, 0xfd, 0x75, 0x1d, 0x20, 0x72, 0x89, 0xdb, 0x43, 0x0c, 0x11, 0x19, 0xfe,
+	0xac, 0x9d, 0xc0, 0x98, 0x47, 0x30, 0x8b, 0xce, 0x18, 0x71, 0x1d, 0x14, 0xf6, 0x45, 0xa7, 0x2d,
+	0x6d, 0xdf, 0xcd, 0xc9, 0xce, 0xb8, 0xb5, 0xcd, 0x7d, 0x2e, 0xb6, 0x1f, 0xf6, 0x65, 0x4d, 0xcc,
+	0x20, 0x05, 0xae, 0x7e, 0x0e, 0x95, 0x14, 0xe9, 0xad, 0xea, 0xa1, 0x0d, 0xb5, 0x94, 0x29, 0x95,
+	0xc7, 0xeb, 0x50, 0x42, 0x67, 0x3e, 0x73, 0x28, 0x73, 0x59, 0x4c, 0x55, 0x82, 0x80, 0xa3, 0x8e,
+	0x05, 0x46, 0x1c, 0x23, 0xcc, 0xc3, 0x31, 0x1b, 0x1e, 0x23, 0x02, 0x52, 0x78, 0x44, 0xf4, 0x2e,
+	0x50, 0x90, 0xd5, 0x87, 0xf9, 0x07, 0x88, 0xc9, 0xbe, 0xa2, 0xd3, 0xb7, 0x04, 0x53, 0x22, 0x70,
+	0x59, 0x71, 0xb3, 0xb6, 0x82, 0xcc, 0x9b, 0x50, 0xf1, 0xc3, 0x56, 0x10, 0x7b, 0xc8, 0xe9, 0xfb,
+	0xe8, 0x94, 0x0a, 0x13, 0x33, 0x76, 0x59, 0x21, 0x5f, 0x70, 0x9c, 0xf9, 0x1e, 0x54, 0xd1, 0x99,
+	0x64, 0x52, 0x4a, 0xe4, 0xb1, 0x55, 0x51, 0x58, 0xd1, 0xa0, 0xa9, 0x85, 0x60, 0x21, 0x61, 0x57,
+	0x45, 0x77, 0x04, 0x0b, 0xb2, 0x33, 0x26, 0x9a, 0xfd, 0xdb, 0x74, 0xdb, 0x79, 0x9a, 0xc1, 0x58,
+	0xcb, 0xb0, 0xf8, 0x00, 0xb1, 0x44, 0x09, 0xab, 0x18, 0xad, 0x6f, 0x60, 0x29, 0x4b, 0x50, 0x4e,
+	0x7c, 0x01, 0xa5, 0xf4, 0xa6, 0xe3, 0xe6, 0xd7, 0x73, 0xcc, 0x27, 0x85, 0x93, 0x22, 0x56, 0x1d,
+	0xcc, 0x63, 0xc4, 0x6c, 0xe4, 0x7a, 0x4f, 0xc3, 0x60, 0xa0, 0x2d, 0x2e, 0x42, 0x2d, 0x85, 0x55,
+	0x25, 0x3c, 0x42, 0xbf, 0x24, 0x3e, 0x43, 0x9a, 0x7b, 0x09, 0xea, 0x69, 0xb4, 0x62, 0x7f, 0x04,
+	0x0b, 0xf2, 0x70, 0x7a, 0x36, 0x88, 0x34, 0xb3, 0xf9, 0x31, 0x94, 0xa4, 0x7b, 0x8e, 0x38, 0xe0,
+	0xb9, 0xcb, 0xd5, 0xed, 0xfa, 0xe6, 0x70, 0x5e, 0x11, 0x39, 0x67, 0x42, 0x02, 0xd8, 0xf0, 0x9b,
+	0xfb, 0x99, 0xd4, 0x35, 0x72, 0xc8, 0x46, 0x6d, 0x82, 0x68, 0x97, 0x97, 0x54, 0xd2, 0xa1, 0x34,
+	0x5a, 0xb1, 0x2f, 0xc3, 0xa2, 0x1d, 0x87, 0x0f, 0x91, 0x1b, 0xb0, 0xae, 0x38, 0x38, 0xb4, 0x40,
+	0x03, 0x96, 0xb2, 0x04, 0x25, 0x72, 0x0f, 0x1a, 0x5f, 0x76, 0x42, 0x4c, 0x90, 0x24, 0xee, 0x13,
+	0x82, 0x49, 0xaa, 0xa5, 0x30, 0x86, 0x48, 0x38, 0x6a, 0x14, 0x02, 0xb4, 0xae, 0xc1, 0x4a, 0x8e,
+	0x94, 0x52, 0xf9, 0x19, 0x77, 0x9a, 0xf7, 0x93, 0x74, 0x25, 0xdf, 0x84, 0xca, 0xa9, 0xeb, 0x33,
+	0x27, 0xc2, 0x74, 0x54, 0x4c, 0xb3, 0x76, 0x99, 0x23, 0x8f, 0x14, 0x4e, 0x46, 0x96, 0x94, 0x55,
+	0x3a, 0xb7, 0x61, 0xe9, 0x88, 0xa0, 0x76, 0xe0, 0x77, 0xba, 0x99, 0x0d, 0xc2, 0x67, 0x32, 0x91,
+	0x38, 0xbd, 0x43, 0x34, 0x68, 0x75, 0x60, 0x79, 0x4c, 0x46, 0xd5, 0xd5, 0x21, 0x54, 0x25, 0x97,
+	0x43, 0xc4, 0x5c, 0xa1, 0xfb, 0xf9, 0x7b, 0xe7, 0x56, 0x76, 0x72, 0x0a, 0xb1, 0x2b, 0xad, 0x04,
+	0x44, 0xad, 0xff, 0x18, 0x60, 0xee, 0x44, 0x51, 0x30, 0x48, 0x7b, 0x36, 0x0f, 0x45, 0xfa, 0x2a,
+	0xd0, 0x2d, 0x86, 0xbe, 0x0a, 0x78, 0x8b, 0x69, 0x63, 0xd2, 0x42, 0x6a, 0xb3, 0x4a, 0x80, 0x8f,
+	0x01, 0x6e, 0x10, 0xe0, 0x53, 0x27, 0x31, 0xc3, 0x8a, 0xce, 0x30, 0x63, 0xcf, 0x0b, 0x82, 0x3d,
+	0xc2, 0x8f, 0x0f, 0x40, 0x13, 0xef, 0x6a, 0x00, 0x9a, 0xbc, 0xe2, 0x00, 0xf4, 0x67, 0x03, 0x6a,
+	0xa9, 0xe8, 0x55, 0x8e, 0xbf, 0x7f, 0xa3, 0x5a, 0x0d, 0x16, 0x0e, 0x71, 0xeb, 0x44, 0x76, 0x3d,
+	0xbd, 0x35, 0xea, 0x60, 0x26, 0x91, 0xa3, 0x8d, 0xf7, 0x3c, 0x0c, 0xc6, 0x98, 0x97, 0xa0, 0x9e,
+	0x46, 0x2b, 0xf6, 0xbf, 0x18, 0xd0, 0x50, 0x47, 0xc4, 0x01, 0x62, 0xad, 0xee, 0x0e, 0xdd, 0x6b,
+	0x0e, 0xeb, 0xa0, 0x0e, 0x93, 0x62, 0x14, 0x17, 0x09, 0x28, 0xdb, 0x12, 0x30, 0x97, 0x61, 0xda,
+	0x6b, 0x3a, 0xe2, 0x68, 0x54, 0xa7, 0x83, 0xd7, 0xfc, 0x8a, 0x1f, 0x8e, 0x2b, 0x30, 0xd3, 0x73,
+	0xcf, 0x1c, 0x82, 0x4f, 0xa9, 0x1a, 0x06, 0xa7, 0x7b, 0xee, 0x99, 0x8d, 0x4f, 0xa9, 0x18, 0xd4,
+	0x7d, 0x2a, 0x26, 0xf0, 0xa6, 0x1f, 0x06, 0xb8, 0x43, 0xc5, 0xf2, 0xcf, 0xd8, 0x55, 0x85, 0xbe,
+	0x2f, 0xb1, 0x7c, 0xaf, 0x11, 0xb1, 0x8d, 0x92, 0x8b, 0x3b, 0x63, 0x97, 0x49, 0x62, 0x6f, 0x59,
+	0x0f, 0x60, 0x25, 0xc7, 0x67, 0xb5, 0x7a, 0x77, 0x60, 0x4a, 0x6e, 0x0d, 0xb5, 0x6c, 0xa6, 0xba,
+	0x4e, 0x7c, 0xcd, 0x7f, 0xd5, 0x36, 0x50, 0x1c, 0xd6, 0x6f, 0x0d, 0x58, 0x4b, 0x6b, 0xda, 0x09,
+	0x02, 0x3e, 0x80, 0xd1, 0x77, 0x9f, 0x82, 0xb1, 0xc8, 0x26, 0x72, 0x22, 0x3b, 0x84, 0xf5, 0xf3,
+	0xfc, 0xb9, 0x42, 0x78, 0x8f, 0xb3, 0x6b, 0xbb, 0x13, 0x45, 0x17, 0x07, 0x96, 0xf4, 0xbf, 0x90,
+	0xf2, 0x7f, 0x3c, 0xe9, 0x42, 0xd9, 0x15, 0xbc, 0x5a, 0x85, 0x46, 0xa2, 0x2f, 0xc8, 0x89, 0x43,
+	0x97, 0xe9, 0x21, 0xac, 0xe4, 0xd0, 0x94, 0x91, 0x2d, 0x3e, 0x7d, 0x0c, 0x27, 0x96, 0xd2, 0xf6,
+	0xf2, 0x66, 0xf6, 0xee, 0xac, 0x04, 0x14, 0x1b, 0xdf, 0x0b, 0x4f, 0x5c, 0xca, 0xb7, 0x51, 0xca,
+	0xc8, 0x13, 0xa8, 0xa7, 0xd1, 0x4a, 0xff, 0xc7, 0x19, 0xfd, 0x6b, 0x63, 0xfa, 0x53, 0x62, 0xda,
+	0xca, 0x32, 0x2c, 0x4a, 0xbc, 0x3e, 0x0b, 0xb4, 0x9d, 0x7b, 0xb0, 0x94, 0x25, 0x28, 0x4b, 0xab,
+	0x30, 0x93, 0x39, 0x4c, 0x86, 0x30, 0x97, 0x7a, 0xe9, 0xfa, 0xec, 0x00, 0x67, 0xf5, 0x5d, 0x28,
+	0xb5, 0x02, 0xcb, 0x63, 0x52, 0x6a, 0x8b, 0x37, 0x60, 0xe9, 0x98, 0xe1, 0x28, 0x91, 0x57, 0xed,
+	0xe0, 0x0a, 0x2c, 0x8f, 0x51, 0x94, 0xd0, 0xaf, 0x60, 0x2d, 0x43, 0x7a, 0xe2, 0x87, 0x7e, 0x2f,
+	0xee, 0x5d, 0xc2, 0x19, 0xf3, 0x06, 0x88, 0xb3, 0xd1, 0x61, 0x7e, 0x0f, 0xe9, 0x21, 0xb2, 0x68,
+	0x97, 0x38, 0xee, 0x99, 0x44, 0x59, 0x3f, 0x85, 0xf5, 0xf3, 0xf4, 0x5f, 0x22, 0x47, 0xc2, 0x71,
+	0x97, 0xb0, 0x9c, 0x98, 0x56, 0xa1, 0x31, 0x4e, 0x52, 0x41, 0x35, 0xe1, 0x46, 0x96, 0xf6, 0x3c,
+	0x64, 0x7e, 0xb0, 0xc3, 0x5b, 0xed, 0x3b, 0x0a, 0xec, 0x16, 0x58, 0x17, 0xd9, 0x50, 0x9e, 0xd4,
+	0xc1, 0x7c, 0x80, 0x34, 0xcf, 0xb0, 0x30, 0x3f, 0x84, 0x5a, 0x0a, 0xab, 0x32, 0x51, 0x87, 0x49,
+	0xd7, 0xf3, 0x88, 0x1e, 0x13, 0x24, 0xc0, 0x73, 0x60, 0x23, 0x8a, 0xce, 0xc9, 0xc1, 0x38, 0x49,
+	0x59, 0xde, 0x82, 0xe5, 0x17, 0x09, 0x3c, 0xdf, 0xd2, 0xb9, 0x2d, 0x61, 0x56, 0xb5, 0x04, 0xeb,
+	0x00, 0x1a, 0xe3, 0x02, 0x57, 0x6a, 0x46, 0x6b, 0x49, 0x3d, 0xa3, 0x6a, 0xd5, 0xe6, 0xab, 0x50,
+	0xf0, 0x3d, 0x75, 0x19, 0x29, 0xf8, 0x5e, 0x6a, 0x21, 0x0a, 0x99, 0x02, 0xd8, 0x80, 0xf5, 0xf3,
+	0x94, 0xa9, 0x38, 0x6b, 0xb0, 0xf0, 0x65, 0xe8, 0x33, 0xb9, 0x01, 0x75, 0x62, 0x7e, 0x0c, 0x66,
+	0x12, 0x79, 0x89, 0x4a, 0xfb, 0xd6, 0x80, 0xf5, 0x23, 0x1c, 0xc5, 0x81, 0x98, 0x56, 0x23, 0x97,
+	0xa0, 0x90, 0x3d, 0xc2, 0x31, 0x09, 0xdd, 0x40, 0xfb, 0xfd, 0x3e, 0xcc, 0xf1, 0x7a, 0x70, 0x5a,
+	0x04, 0xb9, 0x0c, 0x79, 0x4e, 0xa8, 0x6f, 0x54, 0x15, 0x8e, 0xde, 0x95, 0xd8, 0xaf, 0x28, 0xbf,
+	0x75, 0xb9, 0x2d, 0xae, 0x34, 0x79, 0x70, 0x80, 0x44, 0x89, 0xc3, 0xe3, 0x53, 0x28, 0xf7, 0x84,
+	0x67, 0x8e, 0x1b, 0xf8, 0xae, 0x3c, 0x40, 0x4a, 0xdb, 0x8b, 0xd9, 0x09, 0x7c, 0x87, 0x13, 0xed,
+	0x92, 0x64, 0x15, 0x80, 0xf9, 0x11, 0xd4, 0x13, 0xad, 0x6a, 0x34, 0xa8, 0x4e, 0x08, 0x1b, 0xb5,
+	0x04, 0x6d, 0x38, 0xaf, 0xde, 0x80, 0xeb, 0xe7, 0xc6, 0xa5, 0x52, 0xf8, 0x07, 0x43, 0xa6, 0x4b,
+	0x25, 0x5a, 0xc7, 0xfb, 0x23, 0x98, 0x92, 0xfc, 0x0d, 0xe3, 0x22, 0x07, 0x15, 0xd3, 0xb9, 0xbe,
+	0x15, 0xce, 0xf5, 0x2d, 0x2f, 0xa3, 0xc5, 0x9c, 0x8c, 0xf2, 0xfe, 0x9e, 0xf2, 0x6f, 0x34, 0x02,
+	0xed, 0xa1, 0x1e, 0x66, 0x28, 0xbd, 0xf8, 0xbf, 0x33, 0xa0, 0x9e, 0xc6, 0xab, 0xf5, 0xbf, 0x0b,
+	0x35, 0x0f, 0x45, 0x04, 0xb5, 0x84, 0xb1, 0x74, 0x29, 0xdc, 0x2f, 0x34, 0x0c, 0xdb, 0x1c, 0x91,
+	0x87, 0x3e, 0xde, 0x87, 0x8a, 0x5a, 0x2c, 0x75, 0x66, 0x14, 0x2e, 0x73, 0x66, 0x94, 0x7b, 0x09,
+	0x88, 0x6f, 0xe1, 0xe7, 0xa1, 0x87, 0xf3, 0x9c, 0x5d, 0x85, 0xc6, 0x38, 0x49, 0xc5, 0x77, 0x6d,
+	0x78, 0x48, 0xbe, 0x74, 0xe9, 0x11, 0xc1, 0x9c, 0xc5, 0xd3, 0x82, 0x3f, 0x84, 0xd5, 0x3c, 0xa2,
+	0x12, 0xfd, 0x2b, 0x7f, 0x45, 0x45, 0xe9, 0x5d, 0xf1, 0xb6, 0x0b, 0x9a, 0xb3, 0x3a, 0x85, 0xbc,
+	0x7a, 0xff, 0x04, 0x96, 0xc5, 0x35, 0x81, 0x27, 0x88, 0xb0, 0x9c, 0x3b, 0xc2, 0xa2, 0x20, 0x67,
+	0xbb, 0xe5, 0xf8, 0x75, 0x6b, 0x22, 0xe7, 0xba, 0x55, 0x83, 0x85, 0x44, 0x1c, 0x2a, 0xba, 0xc7,
+	0xc9, 0xd8, 0x6d, 0x24, 0xec, 0x22, 0xef, 0x6a, 0x61, 0x5a, 0x6b, 0x70, 0x2d, 0x57, 0x99, 0xb2,
+	0xf5, 0x1b, 0xde, 0xe7, 0x53, 0x07, 0xd8, 0x4e, 0xe8, 0xf1, 0xc7, 0x88, 0xe4, 0xa8, 0x61, 0xfe,
+	0x02, 0x16, 0x29, 0xc3, 0x51, 0x32, 0x78, 0xa7, 0x87, 0x3d, 0x7d, 0xbb, 0xbe, 0x95, 0x33, 0xc1,
+	0xa4, 0x0f, 0x45, 0xec, 0x21, 0xbb, 0x46, 0xc7, 0x91, 0xfc, 0xf2, 0x72, 0xf3, 0x42, 0x07, 0x86,
+	0x0f, 0x11, 0x95, 0xee, 0xa0, 0x49, 0x7c, 0xcf, 0xb9, 0xd4, 0xec, 0x24, 0xea, 0xbd, 0x2c, 0x25,
+	0x24, 0xc6, 0xfc, 0xf9, 0x70, 0x2c, 0x92, 0x25, 0xfe, 0xfe, 0x9b, 0x9c, 0x1e, 0x9f, 0x8f, 0x54,
+	0x1d, 0xa6, 0x1b, 0x09, 0x9f, 0x74, 0xb2, 0x84, 0x4b, 0x74, 0xe4, 0x63, 0xa8, 0xdc, 0x77, 0x5b,
+	0x27, 0xf1, 0x70, 0x92, 0xdd, 0x80, 0x52, 0x0b, 0x87, 0xad, 0x98, 0x10, 0x14, 0xb6, 0x06, 0xaa,
+	0xf7, 0x26, 0x51, 0x9c, 0x43, 0x5c, 0x47, 0x65, 0xb9, 0xa8, 0x3b, 0x6c, 0x12, 0x65, 0x7d, 0x02,
+	0x55, 0xad, 0x54, 0xb9, 0x70, 0x0b, 0x26, 0x51, 0x7f, 0x54, 0x2c, 0xd5, 0x4d, 0xfd, 0x87, 0xcc,
+	0x3e, 0xc7, 0xda, 0x92, 0xa8, 0x4e, 0x5a, 0x86, 0x09, 0x3a, 0x20, 0xb8, 0x97, 0xf2, 0xcb, 0xda,
+	0x81, 0x95, 0x1c, 0xda, 0x5b, 0xa9, 0xff, 0x25, 0x94, 0x5f, 0xbc, 0xf1, 0x84, 0xe6, 0xd9, 0x3a,
+	0xc5, 0xe4, 0xa4, 0x1d, 0xe0, 0x53, 0x7d, 0x50, 0x6a, 0x98, 0xd3, 0x4e, 0xd0, 0x80, 0x46, 0x6e,
+	0x0b, 0xa9, 0x37, 0xbb, 0x21, 0x6c, 0x7d, 0x0e, 0x95, 0x17, 0x57, 0x3e, 0xce, 0x6b, 0xe2, 0xe9,
+	0xed, 0x21, 0x66, 0xfc, 0x72, 0xa0, 0x43, 0x26, 0x30, 0x25, 0x31, 0xf9, 0xaf, 0x94, 0xe2, 0xda,
+	0xab, 0x5f, 0x29, 0x05, 0x20, 0x5e, 0x6a, 0x50, 0xe8, 0xf9, 0x61, 0x47, 0xb5, 0x78, 0x0d, 0x72,
+	0x0d, 0x3d, 0xf7, 0x4c, 0x6c, 0xfe, 0xa2, 0xcd, 0x3f, 0xb9, 0x06, 0xf9, 0x0f, 0xd2, 0xa4, 0xc0,
+	0x49, 0xc0, 0x7a, 0x24, 0x46, 0xa9, 0xa1, 0x23, 0x2a, 0x94, 0x7b, 0x30, 0xd3, 0xc5, 0x4c, 0x5e,
+	0x64, 0xe4, 0x0b, 0xc9, 0x4a, 0xce, 0xad, 0x5b, 0x4a, 0xd9, 0xd3, 0x5d, 0x29, 0x6d, 0xfd, 0xdd,
+	0x80, 0xf2, 0x8b, 0x3d, 0xbf, 0xdd, 0x7e, 0x1a, 0xc9, 0xbf, 0x79, 0xae, 0x43, 0x89, 0xe2, 0x98,
+	0x37, 0xb1, 0x16, 0x0a, 0xf4, 0x8b, 0x08, 0x48, 0xd4, 0x2e, 0x0a, 0x02, 0x3e, 0x11, 0x26, 0x1e,
+	0xcd, 0xa8, 0x0a, 0xae, 0x34, 0x7a, 0x1f, 0xa3, 0x89, 0x87, 0x50, 0xf5, 0x68, 0x2a, 0xa1, 0xd4,
+	0x5d, 0x4b, 0x46, 0x39, 0xbc, 0x2b, 0xee, 0xc2, 0x7a, 0xdb, 0x0f, 0x18, 0x22, 0xc8, 0x4b, 0xb5,
+	0x8e, 0xe1, 0xf0, 0xa9, 0x52, 0x70, 0x4d, 0x73, 0x65, 0x66, 0x21, 0x3e, 0x8c, 0x5a, 0xdf, 0xe9,
+	0x60, 0x12, 0x93, 0xed, 0xb0, 0x16, 0x8c, 0x74, 0x2d, 0x5c, 0x58, 0x43, 0x4b, 0x30, 0x25, 0xa7,
+	0x14, 0x1d, 0x80, 0x84, 0xcc, 0x35, 0x50, 0xd3, 0x8b, 0xe3, 0x92, 0x8e, 0xea, 0xd2, 0xb3, 0x12,
+	0xb3, 0x43, 0x3a, 0x9c, 0xdc, 0xf7, 0xfc, 0x76, 0xdb, 0x89, 0x63, 0xdf, 0x53, 0xff, 0x17, 0xce,
+	0x0a, 0xcc, 0xf3, 0xd8, 0xf7, 0xcc, 0x9f, 0xc0, 0x34, 0x96, 0x59, 0x16, 0x7f, 0x18, 0xe6, 0xff,
+	0x8d, 0x90, 0x5c, 0x0c, 0x5b, 0xf3, 0x5b, 0xbf, 0x86, 0x8a, 0x0a, 0x4c, 0xad, 0x76, 0x76, 0x74,
+	0xbc, 0x03, 0x53, 0x38, 0x66, 0x91, 0x9a, 0xd0, 0xcf, 0x29, 0x64, 0xc9, 0x91, 0x71, 0xb3, 0x98,
+	0x71, 0xf3, 0xfe, 0x17, 0x7f, 0x7b, 0xbd, 0x6e, 0xfc, 0xe3, 0xf5, 0xba, 0xf1, 0xcf, 0xd7, 0xeb,
+	0xc6, 0xef, 0xff, 0xb5, 0xfe, 0x83, 0x6f, 0x36, 0xfb, 0x3e, 0x43, 0x94, 0x6e, 0xfa, 0x78, 0x4b,
+	0x7e, 0x6d, 0x75, 0xf0, 0x56, 0x9f, 0x6d, 0x89, 0x7f, 0x6a, 0xb7, 0xc6, 0x62, 0x68, 0x4e, 0x09,
+	0xc2, 0xdd, 0xff, 0x0d, 0x00, 0xe4, 0xec, 0xfa, 0x1c, 0x33, 0x1e, 0x00, 0x00,
 }
 
 func (m *TableDefinition) Marshal() (dAtA []byte, err error) {
@@ -8263,21 +8696,328 @@ func (m *VExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTabletmanagerdata(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTabletmanagerdata(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetHotRowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TableDefinition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetHotRowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHotRowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HotRow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HotRow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HotRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Max != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pending != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHotRowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHotRowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHotRowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.HotRows) > 0 {
+		for iNdEx := len(m.HotRows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HotRows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VDiffOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VDiffOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VDiffOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FilteredReplicationWaitTime != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.FilteredReplicationWaitTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRows != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.MaxRows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Tables) > 0 {
+		i -= len(m.Tables)
+		copy(dAtA[i:], m.Tables)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Tables)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TabletTypes) > 0 {
+		i -= len(m.TabletTypes)
+		copy(dAtA[i:], m.TabletTypes)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.TabletTypes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceCell) > 0 {
+		i -= len(m.SourceCell)
+		copy(dAtA[i:], m.SourceCell)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.SourceCell)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VdiffUuid) > 0 {
+		i -= len(m.VdiffUuid)
+		copy(dAtA[i:], m.VdiffUuid)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.VdiffUuid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ActionArg) > 0 {
+		i -= len(m.ActionArg)
+		copy(dAtA[i:], m.ActionArg)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.ActionArg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Workflow) > 0 {
+		i -= len(m.Workflow)
+		copy(dAtA[i:], m.Workflow)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Workflow)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keyspace) > 0 {
+		i -= len(m.Keyspace)
+		copy(dAtA[i:], m.Keyspace)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.Keyspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.VdiffUuid) > 0 {
+		i -= len(m.VdiffUuid)
+		copy(dAtA[i:], m.VdiffUuid)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.VdiffUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Output != nil {
+		{
+			size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTabletmanagerdata(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTabletmanagerdata(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TableDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Name)
@@ -9829,8 +10569,156 @@ func (m *VExecResponse) Size() (n int) {
 	return n
 }
 
-func sovTabletmanagerdata(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *GetHotRowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HotRow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.Pending))
+	}
+	if m.Max != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.Max))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHotRowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HotRows) > 0 {
+		for _, e := range m.HotRows {
+			l = e.Size()
+			n += 1 + l + sovTabletmanagerdata(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VDiffOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceCell)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.TabletTypes)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.Tables)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.MaxRows != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.MaxRows))
+	}
+	if m.FilteredReplicationWaitTime != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.FilteredReplicationWaitTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keyspace)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.Workflow)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.ActionArg)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.VdiffUuid)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTabletmanagerdata(uint64(m.Id))
+	}
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	l = len(m.VdiffUuid)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTabletmanagerdata(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTabletmanagerdata(x uint64) (n int) {
 	return sovTabletmanagerdata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -18319,6 +19207,902 @@ func (m *VExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetHotRowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHotRowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHotRowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotRow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotRow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotRow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHotRowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHotRowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHotRowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotRows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotRows = append(m.HotRows, &HotRow{})
+			if err := m.HotRows[len(m.HotRows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VDiffOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VDiffOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VDiffOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCell = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TabletTypes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRows", wireType)
+			}
+			m.MaxRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredReplicationWaitTime", wireType)
+			}
+			m.FilteredReplicationWaitTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilteredReplicationWaitTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionArg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionArg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VdiffUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VdiffUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &VDiffOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTabletmanagerdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &query.QueryResult{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VdiffUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VdiffUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTabletmanagerdata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
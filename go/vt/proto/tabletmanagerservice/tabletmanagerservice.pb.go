@@ -29,72 +29,73 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor_9ee75fe63cfd9360) }
 
 var fileDescriptor_9ee75fe63cfd9360 = []byte{
-	// 1025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xdf, 0x6f, 0x1b, 0x45,
-	0x10, 0xc7, 0x6b, 0x89, 0x56, 0x62, 0xf9, 0xbd, 0x20, 0x2a, 0x05, 0xc9, 0x14, 0x9a, 0x42, 0x69,
-	0x21, 0x6e, 0x0b, 0xe5, 0xdd, 0x4d, 0x9b, 0x34, 0x28, 0x11, 0xc6, 0x6e, 0x12, 0x04, 0x12, 0xd2,
-	0xc6, 0x9e, 0xd8, 0x47, 0xce, 0xb7, 0xc7, 0xee, 0xda, 0x22, 0x4f, 0x48, 0xbc, 0x22, 0xf1, 0xcc,
-	0xdf, 0xc2, 0x5f, 0xc0, 0x23, 0x7f, 0x02, 0x0a, 0xff, 0x48, 0x75, 0xe7, 0xdb, 0xbd, 0xd9, 0xbb,
-	0xb9, 0xf5, 0xf9, 0xcd, 0xf2, 0x7c, 0x66, 0xbe, 0xbb, 0x73, 0xb3, 0x33, 0x7b, 0xc7, 0xb6, 0x8c,
-	0x38, 0x8b, 0xc1, 0xcc, 0x45, 0x22, 0xa6, 0xa0, 0x34, 0xa8, 0x65, 0x34, 0x86, 0x9d, 0x54, 0x49,
-	0x23, 0xf9, 0x7b, 0x94, 0x6d, 0xeb, 0xa6, 0xf7, 0xef, 0x44, 0x18, 0xb1, 0xc2, 0x1f, 0xfd, 0xbd,
-	0xcd, 0xde, 0x78, 0x91, 0xdb, 0x8e, 0x56, 0x36, 0x7e, 0xc0, 0x5e, 0x19, 0x44, 0xc9, 0x94, 0x77,
-	0x77, 0xea, 0x3e, 0x99, 0x61, 0x08, 0xbf, 0x2c, 0x40, 0x9b, 0xad, 0x0f, 0x1b, 0xed, 0x3a, 0x95,
-	0x89, 0x86, 0x8f, 0xaf, 0xf1, 0x43, 0x76, 0x7d, 0x14, 0x03, 0xa4, 0x9c, 0x62, 0x73, 0x8b, 0x0d,
-	0x76, 0xab, 0x19, 0x70, 0xd1, 0x7e, 0x62, 0xaf, 0x3d, 0xfb, 0x15, 0xc6, 0x0b, 0x03, 0xcf, 0xa5,
-	0xbc, 0xe0, 0x77, 0x08, 0x17, 0x64, 0xb7, 0x91, 0x3f, 0x59, 0x87, 0xb9, 0xf8, 0xdf, 0xb3, 0x57,
-	0xf7, 0xc1, 0x8c, 0xc6, 0x33, 0x98, 0x0b, 0x7e, 0x9b, 0x70, 0x73, 0x56, 0x1b, 0x7b, 0x3b, 0x0c,
-	0xb9, 0xc8, 0x53, 0xf6, 0xe6, 0x3e, 0x98, 0x01, 0xa8, 0x79, 0xa4, 0x75, 0x24, 0x13, 0xcd, 0xef,
-	0xd2, 0x9e, 0x08, 0xb1, 0x1a, 0x9f, 0xb5, 0x20, 0x71, 0x8a, 0x46, 0x60, 0x86, 0x20, 0x26, 0xdf,
-	0x26, 0xf1, 0x25, 0x99, 0x22, 0x64, 0x0f, 0xa5, 0xc8, 0xc3, 0x5c, 0x7c, 0xc1, 0x5e, 0x2f, 0x0c,
-	0xa7, 0x2a, 0x32, 0xc0, 0x03, 0x9e, 0x39, 0x60, 0x15, 0x3e, 0x5d, 0xcb, 0x39, 0x89, 0x1f, 0x19,
-	0xdb, 0x9d, 0x89, 0x64, 0x0a, 0x2f, 0x2e, 0x53, 0xe0, 0x54, 0x86, 0x4b, 0xb3, 0x0d, 0x7f, 0x67,
-	0x0d, 0x85, 0xd7, 0x3f, 0x84, 0x73, 0x05, 0x7a, 0x36, 0x32, 0xa2, 0x61, 0xfd, 0x18, 0x08, 0xad,
-	0xdf, 0xe7, 0xf0, 0xb3, 0x1e, 0x2e, 0x92, 0xe7, 0x20, 0x62, 0x33, 0xdb, 0x9d, 0xc1, 0xf8, 0x82,
-	0x7c, 0xd6, 0x3e, 0x12, 0x7a, 0xd6, 0x55, 0xd2, 0x09, 0xa5, 0xec, 0x9d, 0x83, 0x69, 0x22, 0x15,
-	0xac, 0xcc, 0xcf, 0x94, 0x92, 0x8a, 0xdf, 0x27, 0x22, 0xd4, 0x28, 0x2b, 0xf7, 0x79, 0x3b, 0xd8,
-	0xcf, 0x5e, 0x2c, 0xc5, 0xa4, 0x38, 0x23, 0x74, 0xf6, 0x4a, 0x20, 0x9c, 0x3d, 0xcc, 0x39, 0x89,
-	0x9f, 0xd9, 0x5b, 0x03, 0x05, 0xe7, 0x71, 0x34, 0x9d, 0xd9, 0x93, 0x48, 0x25, 0xa5, 0xc2, 0x58,
-	0xa1, 0x7b, 0x6d, 0x50, 0x7c, 0x58, 0xfa, 0x69, 0x1a, 0x5f, 0x16, 0x3a, 0x54, 0x11, 0x21, 0x7b,
-	0xe8, 0xb0, 0x78, 0x18, 0xae, 0xe4, 0x43, 0x39, 0xbe, 0xc8, 0xbb, 0xab, 0x26, 0x2b, 0xb9, 0x34,
-	0x87, 0x2a, 0x19, 0x53, 0xf8, 0x59, 0x1c, 0x27, 0x71, 0x19, 0x9e, 0x5a, 0x16, 0x06, 0x42, 0xcf,
-	0xc2, 0xe7, 0x70, 0x81, 0x15, 0x8d, 0x72, 0x0f, 0xcc, 0x78, 0xd6, 0xd7, 0x4f, 0xcf, 0x04, 0x59,
-	0x60, 0x35, 0x2a, 0x54, 0x60, 0x04, 0xec, 0x14, 0x7f, 0x63, 0xef, 0xfb, 0xe6, 0x7e, 0x1c, 0x0f,
-	0x54, 0xb4, 0xd4, 0xfc, 0xc1, 0xda, 0x48, 0x16, 0xb5, 0xda, 0x0f, 0x37, 0xf0, 0x68, 0xde, 0x72,
-	0x3f, 0x4d, 0x5b, 0x6c, 0xb9, 0x9f, 0xa6, 0xed, 0xb7, 0x9c, 0xc3, 0x58, 0x71, 0x08, 0x69, 0x1c,
-	0x8d, 0x85, 0x89, 0x64, 0x92, 0x35, 0x93, 0x85, 0x26, 0x15, 0x6b, 0x54, 0x48, 0x91, 0x80, 0x71,
-	0xe5, 0x1c, 0x09, 0x6d, 0x40, 0x15, 0x62, 0x54, 0xe5, 0x60, 0x20, 0x54, 0x39, 0x3e, 0x87, 0x7b,
-	0xe0, 0xca, 0x32, 0x90, 0x3a, 0xca, 0x16, 0x41, 0xf6, 0x40, 0x1f, 0x09, 0xf5, 0xc0, 0x2a, 0x89,
-	0xdb, 0xc5, 0xa9, 0x88, 0xcc, 0x9e, 0x2c, 0x95, 0x28, 0xff, 0x0a, 0x13, 0x6a, 0x17, 0x35, 0x14,
-	0x6b, 0x8d, 0x8c, 0x4c, 0x51, 0x6a, 0x49, 0xad, 0x0a, 0x13, 0xd2, 0xaa, 0xa1, 0xf8, 0x20, 0x54,
-	0x8c, 0x47, 0x51, 0x12, 0xcd, 0x17, 0x73, 0xf2, 0x20, 0xd0, 0x68, 0xe8, 0x20, 0x34, 0x79, 0xb8,
-	0x05, 0xcc, 0xd9, 0xdb, 0x23, 0x23, 0x94, 0xc1, 0xbb, 0xa5, 0xb7, 0xe0, 0x43, 0x56, 0xf4, 0x7e,
-	0x2b, 0xd6, 0xc9, 0xfd, 0xd1, 0x61, 0x5b, 0x55, 0xf3, 0x71, 0x62, 0xa2, 0xb8, 0x7f, 0x6e, 0x40,
-	0xf1, 0xaf, 0x5a, 0x44, 0x2b, 0x71, 0xbb, 0x86, 0xc7, 0x1b, 0x7a, 0xe1, 0xc1, 0xb0, 0x0f, 0x96,
-	0xd2, 0xe4, 0x60, 0x40, 0xf6, 0xd0, 0x60, 0xf0, 0x30, 0x9c, 0xdc, 0x13, 0xb4, 0x86, 0xac, 0x3d,
-	0x90, 0xc9, 0xad, 0x42, 0xa1, 0xe4, 0xd6, 0x59, 0x5c, 0x4c, 0xd8, 0x5a, 0x56, 0x38, 0x59, 0x4c,
-	0x34, 0x1a, 0x2a, 0xa6, 0x26, 0x0f, 0xbc, 0xdf, 0x21, 0x68, 0x58, 0x5b, 0x4c, 0x55, 0x28, 0xb4,
-	0xdf, 0x3a, 0x8b, 0xe7, 0xee, 0x41, 0x12, 0x99, 0x55, 0xd3, 0x20, 0xe7, 0x6e, 0x69, 0x0e, 0xcd,
-	0x5d, 0x4c, 0xb9, 0xe0, 0xbf, 0x77, 0xd8, 0xcd, 0x81, 0x4c, 0x17, 0x71, 0x7e, 0xeb, 0x4b, 0x85,
-	0x82, 0xc4, 0x7c, 0x23, 0x17, 0x2a, 0x11, 0x31, 0xa7, 0x92, 0xd3, 0xc0, 0x5a, 0xdd, 0x47, 0x9b,
-	0xb8, 0xe0, 0x02, 0xcd, 0x16, 0x57, 0x6c, 0x9f, 0x37, 0x2d, 0xbe, 0xb0, 0x87, 0x0a, 0xd4, 0xc3,
-	0xf0, 0x88, 0x78, 0x0a, 0x73, 0x69, 0xa0, 0xc8, 0x21, 0xe5, 0x89, 0x81, 0xd0, 0x88, 0xf0, 0x39,
-	0x5c, 0x13, 0xc7, 0xc9, 0x44, 0x7a, 0x32, 0xf7, 0xc8, 0xbb, 0x89, 0x0f, 0x85, 0x6a, 0xa2, 0xce,
-	0x3a, 0x39, 0xcd, 0x78, 0xb1, 0xcd, 0x53, 0xa1, 0x07, 0x4a, 0x66, 0xd0, 0x84, 0x07, 0x46, 0x27,
-	0xc2, 0xac, 0xe4, 0x17, 0x2d, 0x69, 0xfc, 0x42, 0x39, 0x02, 0x5b, 0x87, 0xb7, 0xe9, 0x57, 0x20,
-	0x7f, 0x57, 0xdb, 0x61, 0xc8, 0x45, 0x5e, 0xb2, 0x77, 0x4b, 0xe5, 0x21, 0xe8, 0xac, 0xab, 0xc1,
-	0x84, 0x87, 0x57, 0xe8, 0x38, 0xab, 0xb6, 0xd3, 0x16, 0x77, 0xba, 0x7f, 0x76, 0xd8, 0x07, 0x95,
-	0xd9, 0xd1, 0x4f, 0x26, 0xd9, 0x2b, 0xef, 0xea, 0x2e, 0xf1, 0x78, 0xfd, 0xac, 0xc1, 0xbc, 0x5d,
-	0xc8, 0xd7, 0x9b, 0xba, 0xe1, 0x9b, 0x46, 0x91, 0x78, 0x7b, 0x18, 0xee, 0x92, 0xef, 0x00, 0x18,
-	0x09, 0xdd, 0x34, 0xaa, 0xa4, 0x13, 0xfa, 0x8e, 0xdd, 0x78, 0x22, 0xc6, 0x17, 0x8b, 0x94, 0x53,
-	0x9f, 0x2a, 0x56, 0x26, 0x1b, 0xf8, 0xa3, 0x00, 0x61, 0x03, 0x3e, 0xe8, 0x70, 0x95, 0x5d, 0xfd,
-	0xb4, 0x91, 0x0a, 0xf6, 0x94, 0x9c, 0x17, 0xd1, 0x1b, 0x7a, 0x9d, 0x4f, 0x85, 0xaf, 0x7e, 0x35,
-	0x18, 0x69, 0x1e, 0xb2, 0xeb, 0x27, 0xf9, 0xbc, 0xa1, 0xbe, 0xc8, 0x9c, 0xe0, 0x21, 0x73, 0xab,
-	0x19, 0xb0, 0xf1, 0x9e, 0xec, 0xfe, 0x73, 0xd5, 0xed, 0xfc, 0x7b, 0xd5, 0xed, 0xfc, 0x77, 0xd5,
-	0xed, 0xfc, 0xf5, 0x7f, 0xf7, 0xda, 0x0f, 0x0f, 0x97, 0x91, 0x01, 0xad, 0x77, 0x22, 0xd9, 0x5b,
-	0xfd, 0xea, 0x4d, 0x65, 0x6f, 0x69, 0x7a, 0xf9, 0xc7, 0xa6, 0x1e, 0xf5, 0x69, 0xea, 0xec, 0x46,
-	0x6e, 0xfb, 0xf2, 0x65, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x51, 0x5d, 0x35, 0xd5, 0x12, 0x00,
-	0x00,
+	// 1052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc7, 0x37, 0x12, 0xbb, 0x12, 0xc3, 0xf7, 0x80, 0x58, 0xa9, 0x48, 0x61, 0x61, 0xb7, 0xb0,
+	0x6c, 0xa1, 0xd9, 0x5d, 0x58, 0xee, 0xb3, 0xed, 0xf6, 0x03, 0xb5, 0x22, 0x38, 0xfd, 0x40, 0x20,
+	0x21, 0x4d, 0x93, 0x93, 0x64, 0xa8, 0xe3, 0x31, 0x33, 0x93, 0x40, 0xaf, 0x90, 0xb8, 0x45, 0xe2,
+	0x9a, 0x47, 0xe2, 0x92, 0x47, 0x40, 0x45, 0xbc, 0x07, 0x72, 0xec, 0xb1, 0x8f, 0xed, 0xe3, 0x89,
+	0x7b, 0x57, 0xf5, 0xff, 0x3b, 0xe7, 0x7f, 0xe6, 0xe4, 0xcc, 0x8c, 0x6d, 0xb6, 0x61, 0xc5, 0x45,
+	0x08, 0x76, 0x2e, 0x22, 0x31, 0x05, 0x6d, 0x40, 0x2f, 0xe5, 0x08, 0xb6, 0x63, 0xad, 0xac, 0xe2,
+	0xef, 0x50, 0xda, 0xc6, 0xdd, 0xd2, 0x7f, 0xc7, 0xc2, 0x8a, 0x14, 0x7f, 0xfa, 0xdf, 0x26, 0x7b,
+	0xed, 0x64, 0xa5, 0x1d, 0xa7, 0x1a, 0x3f, 0x64, 0x2f, 0x0d, 0x64, 0x34, 0xe5, 0xdd, 0xed, 0x7a,
+	0x4c, 0x22, 0x04, 0xf0, 0xd3, 0x02, 0x8c, 0xdd, 0x78, 0xbf, 0x51, 0x37, 0xb1, 0x8a, 0x0c, 0x7c,
+	0x78, 0x8b, 0x1f, 0xb1, 0xdb, 0xc3, 0x10, 0x20, 0xe6, 0x14, 0xbb, 0x52, 0x5c, 0xb2, 0x7b, 0xcd,
+	0x40, 0x9e, 0xed, 0x07, 0xf6, 0xca, 0x8b, 0x5f, 0x60, 0xb4, 0xb0, 0x70, 0xa0, 0xd4, 0x25, 0xdf,
+	0x24, 0x42, 0x90, 0xee, 0x32, 0x7f, 0xb4, 0x0e, 0xcb, 0xf3, 0x7f, 0xcb, 0x5e, 0xde, 0x07, 0x3b,
+	0x1c, 0xcd, 0x60, 0x2e, 0xf8, 0x7d, 0x22, 0x2c, 0x57, 0x5d, 0xee, 0x07, 0x7e, 0x28, 0xcf, 0x3c,
+	0x65, 0xaf, 0xef, 0x83, 0x1d, 0x80, 0x9e, 0x4b, 0x63, 0xa4, 0x8a, 0x0c, 0x7f, 0x48, 0x47, 0x22,
+	0xc4, 0x79, 0x7c, 0xd2, 0x82, 0xcc, 0x8d, 0xbe, 0x67, 0x6c, 0x1f, 0xec, 0x81, 0xb2, 0x81, 0xfa,
+	0xd9, 0xf0, 0x86, 0xf2, 0x32, 0xd9, 0x19, 0x6c, 0xae, 0xa1, 0x70, 0xff, 0x87, 0x60, 0x03, 0x10,
+	0xe3, 0xaf, 0xa3, 0xf0, 0x8a, 0xec, 0x3f, 0xd2, 0x7d, 0xfd, 0x2f, 0x61, 0x79, 0x7e, 0xc1, 0x5e,
+	0xcd, 0x84, 0x73, 0x2d, 0x2d, 0x70, 0x4f, 0xe4, 0x0a, 0x70, 0x0e, 0x1f, 0xaf, 0xe5, 0x70, 0x7f,
+	0x76, 0x66, 0x22, 0x9a, 0xc2, 0xc9, 0x55, 0x0c, 0x64, 0x7f, 0x0a, 0xd9, 0xd7, 0x1f, 0x4c, 0xe1,
+	0xfa, 0x03, 0x98, 0x68, 0x30, 0xb3, 0xa1, 0x15, 0x0d, 0xf5, 0x63, 0xc0, 0x57, 0x7f, 0x99, 0xc3,
+	0x83, 0x14, 0x2c, 0xa2, 0x03, 0x10, 0xa1, 0x9d, 0xed, 0xcc, 0x60, 0x74, 0x49, 0x0e, 0x52, 0x19,
+	0xf1, 0x0d, 0x52, 0x95, 0xcc, 0x8d, 0x62, 0xf6, 0xd6, 0xe1, 0x34, 0x52, 0x1a, 0x52, 0xf9, 0x85,
+	0xd6, 0x4a, 0xf3, 0x2d, 0x22, 0x43, 0x8d, 0x72, 0x76, 0x9f, 0xb6, 0x83, 0xcb, 0xdd, 0x0b, 0x95,
+	0x18, 0x67, 0x1b, 0x90, 0xee, 0x5e, 0x01, 0xf8, 0xbb, 0x87, 0xb9, 0xdc, 0xe2, 0x47, 0xf6, 0xc6,
+	0x40, 0xc3, 0x24, 0x94, 0xd3, 0x99, 0xdb, 0xe6, 0x54, 0x53, 0x2a, 0x8c, 0x33, 0x7a, 0xd4, 0x06,
+	0xc5, 0x9b, 0xa5, 0x1f, 0xc7, 0xe1, 0x55, 0xe6, 0x43, 0x0d, 0x11, 0xd2, 0x7d, 0x9b, 0xa5, 0x84,
+	0xe1, 0x49, 0x3e, 0x52, 0xa3, 0xcb, 0xd5, 0xd1, 0x4d, 0xef, 0xf4, 0x42, 0xf6, 0x4d, 0x32, 0xa6,
+	0xf0, 0x6f, 0x71, 0x1a, 0x85, 0x45, 0x7a, 0xaa, 0x2c, 0x0c, 0xf8, 0x7e, 0x8b, 0x32, 0x87, 0x07,
+	0x2c, 0x3b, 0x85, 0xf7, 0xc0, 0x8e, 0x66, 0x7d, 0xb3, 0x7b, 0x21, 0xc8, 0x01, 0xab, 0x51, 0xbe,
+	0x01, 0x23, 0xe0, 0xdc, 0xf1, 0x57, 0xf6, 0x6e, 0x59, 0xee, 0x87, 0xe1, 0x40, 0xcb, 0xa5, 0xe1,
+	0x8f, 0xd7, 0x66, 0x72, 0xa8, 0xf3, 0x7e, 0x72, 0x83, 0x88, 0xe6, 0x25, 0xf7, 0xe3, 0xb8, 0xc5,
+	0x92, 0xfb, 0x71, 0xdc, 0x7e, 0xc9, 0x2b, 0x18, 0x3b, 0x06, 0x10, 0x87, 0x72, 0x24, 0xac, 0x54,
+	0xd1, 0xd0, 0x0a, 0xbb, 0x30, 0xa4, 0x63, 0x8d, 0xf2, 0x39, 0x12, 0x30, 0x9e, 0x9c, 0x63, 0x61,
+	0x2c, 0xe8, 0xcc, 0x8c, 0x9a, 0x1c, 0x0c, 0xf8, 0x26, 0xa7, 0xcc, 0xe1, 0x33, 0x30, 0x55, 0x06,
+	0xca, 0xc8, 0xa4, 0x08, 0xf2, 0x0c, 0x2c, 0x23, 0xbe, 0x33, 0xb0, 0x4a, 0xe2, 0xe3, 0xe2, 0x5c,
+	0x48, 0xbb, 0xa7, 0x0a, 0x27, 0x2a, 0xbe, 0xc2, 0xf8, 0x8e, 0x8b, 0x1a, 0x8a, 0xbd, 0x86, 0x56,
+	0xc5, 0xa8, 0xb5, 0xa4, 0x57, 0x85, 0xf1, 0x79, 0xd5, 0x50, 0xbc, 0x11, 0x2a, 0xe2, 0xb1, 0x8c,
+	0xe4, 0x7c, 0x31, 0x27, 0x37, 0x02, 0x8d, 0xfa, 0x36, 0x42, 0x53, 0x44, 0x5e, 0xc0, 0x9c, 0xbd,
+	0x39, 0xb4, 0x42, 0x5b, 0xbc, 0x5a, 0x7a, 0x09, 0x65, 0xc8, 0x99, 0x6e, 0xb5, 0x62, 0x73, 0xbb,
+	0xdf, 0x3b, 0x6c, 0xa3, 0x2a, 0x9f, 0x46, 0x56, 0x86, 0xfd, 0x89, 0x05, 0xcd, 0xbf, 0x68, 0x91,
+	0xad, 0xc0, 0x5d, 0x0d, 0xcf, 0x6e, 0x18, 0x85, 0x2f, 0x86, 0x7d, 0x70, 0x94, 0xe1, 0x0d, 0x4f,
+	0x5f, 0x4e, 0xf7, 0x5d, 0x0c, 0x25, 0x0c, 0x37, 0xf7, 0x0c, 0xd5, 0x90, 0x1c, 0x0f, 0x64, 0x73,
+	0xab, 0x90, 0xaf, 0xb9, 0x75, 0x16, 0x0f, 0x13, 0x56, 0x8b, 0x09, 0x27, 0x87, 0x89, 0x46, 0x7d,
+	0xc3, 0xd4, 0x14, 0x81, 0xd7, 0x1b, 0x80, 0x81, 0xb5, 0xc3, 0x54, 0x85, 0x7c, 0xeb, 0xad, 0xb3,
+	0xf8, 0xde, 0x3d, 0x8c, 0xa4, 0x4d, 0x0f, 0x0d, 0xf2, 0xde, 0x2d, 0x64, 0xdf, 0xbd, 0x8b, 0xa9,
+	0x3c, 0xf9, 0x6f, 0x1d, 0x76, 0x77, 0xa0, 0xe2, 0x45, 0x28, 0x2c, 0x04, 0x10, 0x0b, 0x0d, 0x91,
+	0xfd, 0x4a, 0x2d, 0x74, 0x24, 0x42, 0x4e, 0x35, 0xa7, 0x81, 0x75, 0xbe, 0x4f, 0x6f, 0x12, 0x82,
+	0x07, 0x34, 0x29, 0x2e, 0x5b, 0x3e, 0x6f, 0x2a, 0x3e, 0xd3, 0x7d, 0x03, 0x5a, 0xc2, 0xf0, 0x15,
+	0xb1, 0x0b, 0x73, 0x65, 0x21, 0xeb, 0x21, 0x15, 0x89, 0x01, 0xdf, 0x15, 0x51, 0xe6, 0xf0, 0x4c,
+	0x9c, 0x46, 0x63, 0x55, 0xb2, 0x79, 0x44, 0x3e, 0x9b, 0x8c, 0x15, 0x65, 0xb5, 0xd5, 0x8a, 0xcd,
+	0xed, 0x0c, 0xe3, 0xd9, 0x32, 0xcf, 0x85, 0x19, 0x68, 0x95, 0x40, 0x63, 0xee, 0xb9, 0x3a, 0x11,
+	0xe6, 0x2c, 0x3f, 0x6b, 0x49, 0xe3, 0xb7, 0xd5, 0x21, 0xb8, 0x39, 0xbc, 0x4f, 0xbf, 0x02, 0x95,
+	0x57, 0xf5, 0xc0, 0x0f, 0xe5, 0x99, 0x97, 0xec, 0xed, 0xc2, 0x39, 0x00, 0x63, 0x85, 0x4e, 0xd6,
+	0xe3, 0xaf, 0x30, 0xe7, 0x9c, 0xdb, 0x76, 0x5b, 0x3c, 0xf7, 0xfd, 0xa3, 0xc3, 0xde, 0xab, 0xdc,
+	0x1d, 0xfd, 0x68, 0x9c, 0xbc, 0x4f, 0xa7, 0xcf, 0x12, 0xcf, 0xd6, 0xdf, 0x35, 0x98, 0x77, 0x85,
+	0x7c, 0x79, 0xd3, 0x30, 0xfc, 0xa4, 0x91, 0x35, 0xde, 0x6d, 0x86, 0x87, 0xe4, 0x3b, 0x00, 0x46,
+	0x7c, 0x4f, 0x1a, 0x55, 0x32, 0x37, 0xfa, 0x86, 0xdd, 0x79, 0x2e, 0x46, 0x97, 0x8b, 0x98, 0x53,
+	0xdf, 0x41, 0x52, 0xc9, 0x25, 0xfe, 0xc0, 0x43, 0xb8, 0x84, 0x8f, 0x3b, 0x5c, 0x27, 0x8f, 0x7e,
+	0xc6, 0x2a, 0x0d, 0x7b, 0x5a, 0xcd, 0xb3, 0xec, 0x0d, 0x67, 0x5d, 0x99, 0xf2, 0x3f, 0xfa, 0xd5,
+	0x60, 0xe4, 0x79, 0xc4, 0x6e, 0x9f, 0xad, 0xee, 0x1b, 0xea, 0x73, 0xcf, 0x19, 0xbe, 0x64, 0xee,
+	0x35, 0x03, 0xf8, 0xe3, 0xd1, 0xd9, 0xae, 0x9c, 0x4c, 0xe8, 0x6c, 0x89, 0xe2, 0xcd, 0x96, 0x02,
+	0x2e, 0xdb, 0xf3, 0x9d, 0xbf, 0xae, 0xbb, 0x9d, 0xbf, 0xaf, 0xbb, 0x9d, 0x7f, 0xae, 0xbb, 0x9d,
+	0x3f, 0xff, 0xed, 0xde, 0xfa, 0xee, 0xc9, 0x52, 0x5a, 0x30, 0x66, 0x5b, 0xaa, 0x5e, 0xfa, 0x57,
+	0x6f, 0xaa, 0x7a, 0x4b, 0xdb, 0x5b, 0x7d, 0x17, 0xeb, 0x51, 0x5f, 0xd1, 0x2e, 0xee, 0xac, 0xb4,
+	0xcf, 0xff, 0x1f, 0x00, 0x9b, 0xfa, 0x0c, 0x14, 0x80, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -119,6 +120,8 @@ type TabletManagerClient interface {
 	GetSchema(ctx context.Context, in *tabletmanagerdata.GetSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(ctx context.Context, in *tabletmanagerdata.GetPermissionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPermissionsResponse, error)
+	// GetHotRows asks the tablet for the rows contended by several transactions
+	GetHotRows(ctx context.Context, in *tabletmanagerdata.GetHotRowsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetHotRowsResponse, error)
 	SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error)
 	SetReadWrite(ctx context.Context, in *tabletmanagerdata.SetReadWriteRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadWriteResponse, error)
 	// ChangeType asks the remote tablet to change its type
@@ -186,6 +189,9 @@ type TabletManagerClient interface {
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
 	// Generic VExec request. Can be used for various purposes
 	VExec(ctx context.Context, in *tabletmanagerdata.VExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VExecResponse, error)
+	// VDiff starts, stops, resumes, shows or deletes the diffs of a
+	// vreplication workflow that run on the target tablet.
+	VDiff(ctx context.Context, in *tabletmanagerdata.VDiffRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VDiffResponse, error)
 }
 
 type tabletManagerClient struct {
@@ -241,6 +247,15 @@ func (c *tabletManagerClient) GetPermissions(ctx context.Context, in *tabletmana
 	return out, nil
 }
 
+func (c *tabletManagerClient) GetHotRows(ctx context.Context, in *tabletmanagerdata.GetHotRowsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetHotRowsResponse, error) {
+	out := new(tabletmanagerdata.GetHotRowsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetHotRows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error) {
 	out := new(tabletmanagerdata.SetReadOnlyResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReadOnly", in, out, opts...)
@@ -638,6 +653,15 @@ func (c *tabletManagerClient) VExec(ctx context.Context, in *tabletmanagerdata.V
 	return out, nil
 }
 
+func (c *tabletManagerClient) VDiff(ctx context.Context, in *tabletmanagerdata.VDiffRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VDiffResponse, error) {
+	out := new(tabletmanagerdata.VDiffResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/VDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabletManagerServer is the server API for TabletManager service.
 type TabletManagerServer interface {
 	// Ping returns the input payload
@@ -650,6 +674,8 @@ type TabletManagerServer interface {
 	GetSchema(context.Context, *tabletmanagerdata.GetSchemaRequest) (*tabletmanagerdata.GetSchemaResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(context.Context, *tabletmanagerdata.GetPermissionsRequest) (*tabletmanagerdata.GetPermissionsResponse, error)
+	// GetHotRows asks the tablet for the rows contended by several transactions
+	GetHotRows(context.Context, *tabletmanagerdata.GetHotRowsRequest) (*tabletmanagerdata.GetHotRowsResponse, error)
 	SetReadOnly(context.Context, *tabletmanagerdata.SetReadOnlyRequest) (*tabletmanagerdata.SetReadOnlyResponse, error)
 	SetReadWrite(context.Context, *tabletmanagerdata.SetReadWriteRequest) (*tabletmanagerdata.SetReadWriteResponse, error)
 	// ChangeType asks the remote tablet to change its type
@@ -717,6 +743,9 @@ type TabletManagerServer interface {
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
 	// Generic VExec request. Can be used for various purposes
 	VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error)
+	// VDiff starts, stops, resumes, shows or deletes the diffs of a
+	// vreplication workflow that run on the target tablet.
+	VDiff(context.Context, *tabletmanagerdata.VDiffRequest) (*tabletmanagerdata.VDiffResponse, error)
 }
 
 // UnimplementedTabletManagerServer can be embedded to have forward compatible implementations.
@@ -738,6 +767,9 @@ func (*UnimplementedTabletManagerServer) GetSchema(ctx context.Context, req *tab
 func (*UnimplementedTabletManagerServer) GetPermissions(ctx context.Context, req *tabletmanagerdata.GetPermissionsRequest) (*tabletmanagerdata.GetPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
 }
+func (*UnimplementedTabletManagerServer) GetHotRows(ctx context.Context, req *tabletmanagerdata.GetHotRowsRequest) (*tabletmanagerdata.GetHotRowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHotRows not implemented")
+}
 func (*UnimplementedTabletManagerServer) SetReadOnly(ctx context.Context, req *tabletmanagerdata.SetReadOnlyRequest) (*tabletmanagerdata.SetReadOnlyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetReadOnly not implemented")
 }
@@ -855,6 +887,9 @@ func (*UnimplementedTabletManagerServer) RestoreFromBackup(req *tabletmanagerdat
 func (*UnimplementedTabletManagerServer) VExec(ctx context.Context, req *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VExec not implemented")
 }
+func (*UnimplementedTabletManagerServer) VDiff(ctx context.Context, req *tabletmanagerdata.VDiffRequest) (*tabletmanagerdata.VDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiff not implemented")
+}
 
 func RegisterTabletManagerServer(s *grpc.Server, srv TabletManagerServer) {
 	s.RegisterService(&_TabletManager_serviceDesc, srv)
@@ -950,6 +985,24 @@ func _TabletManager_GetPermissions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_GetHotRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetHotRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetHotRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetHotRows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetHotRows(ctx, req.(*tabletmanagerdata.GetHotRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.SetReadOnlyRequest)
 	if err := dec(in); err != nil {
@@ -1658,6 +1711,24 @@ func _TabletManager_VExec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_VDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.VDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).VDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/VDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).VDiff(ctx, req.(*tabletmanagerdata.VDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TabletManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tabletmanagerservice.TabletManager",
 	HandlerType: (*TabletManagerServer)(nil),
@@ -1682,6 +1753,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPermissions",
 			Handler:    _TabletManager_GetPermissions_Handler,
 		},
+		{
+			MethodName: "GetHotRows",
+			Handler:    _TabletManager_GetHotRows_Handler,
+		},
 		{
 			MethodName: "SetReadOnly",
 			Handler:    _TabletManager_SetReadOnly_Handler,
@@ -1830,6 +1905,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VExec",
 			Handler:    _TabletManager_VExec_Handler,
 		},
+		{
+			MethodName: "VDiff",
+			Handler:    _TabletManager_VDiff_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
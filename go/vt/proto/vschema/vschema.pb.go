@@ -138,10 +138,13 @@ type Keyspace struct {
 	Vindexes map[string]*Vindex `protobuf:"bytes,2,rep,name=vindexes,proto3" json:"vindexes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Tables   map[string]*Table  `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// If require_explicit_routing is true, vindexes and tables are not added to global routing
-	RequireExplicitRouting bool     `protobuf:"varint,4,opt,name=require_explicit_routing,json=requireExplicitRouting,proto3" json:"require_explicit_routing,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	RequireExplicitRouting bool `protobuf:"varint,4,opt,name=require_explicit_routing,json=requireExplicitRouting,proto3" json:"require_explicit_routing,omitempty"`
+	// views maps the name of each view of the keyspace to its select
+	// statement. The views are expanded by vtgate during planning.
+	Views                map[string]string `protobuf:"bytes,5,rep,name=views,proto3" json:"views,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Keyspace) Reset()         { *m = Keyspace{} }
@@ -205,6 +208,13 @@ func (m *Keyspace) GetRequireExplicitRouting() bool {
 	return false
 }
 
+func (m *Keyspace) GetViews() map[string]string {
+	if m != nil {
+		return m.Views
+	}
+	return nil
+}
+
 // Vindex is the vindex info for a Keyspace.
 type Vindex struct {
 	// The type must match one of the predefined
@@ -305,10 +315,14 @@ type Table struct {
 	// column_list_authoritative is set to true if columns is
 	// an authoritative list for the table. This allows
 	// us to expand 'select *' expressions.
-	ColumnListAuthoritative bool     `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	ColumnListAuthoritative bool `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
+	// result_cache is set to true if vtgate may cache the results
+	// of the read-only queries on this table. The cached results are
+	// invalidated by the changes streamed from the table.
+	ResultCache          bool     `protobuf:"varint,7,opt,name=result_cache,json=resultCache,proto3" json:"result_cache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Table) Reset()         { *m = Table{} }
@@ -386,6 +400,13 @@ func (m *Table) GetColumnListAuthoritative() bool {
 	return false
 }
 
+func (m *Table) GetResultCache() bool {
+	if m != nil {
+		return m.ResultCache
+	}
+	return false
+}
+
 // ColumnVindex is used to associate a column to a vindex.
 type ColumnVindex struct {
 	// Legacy implementation, moving forward all vindexes should define a list of columns.
@@ -629,6 +650,7 @@ func init() {
 	proto.RegisterType((*Keyspace)(nil), "vschema.Keyspace")
 	proto.RegisterMapType((map[string]*Table)(nil), "vschema.Keyspace.TablesEntry")
 	proto.RegisterMapType((map[string]*Vindex)(nil), "vschema.Keyspace.VindexesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "vschema.Keyspace.ViewsEntry")
 	proto.RegisterType((*Vindex)(nil), "vschema.Vindex")
 	proto.RegisterMapType((map[string]string)(nil), "vschema.Vindex.ParamsEntry")
 	proto.RegisterType((*Table)(nil), "vschema.Table")
@@ -642,51 +664,54 @@ func init() {
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xc1, 0x4e, 0xdb, 0x4c,
-	0x10, 0xfe, 0x9d, 0x90, 0x90, 0x8c, 0x49, 0xf8, 0xbb, 0x02, 0xea, 0x06, 0x11, 0x22, 0x8b, 0xaa,
-	0x69, 0x0f, 0x89, 0x14, 0xd4, 0x8a, 0xa6, 0xa2, 0x2a, 0x45, 0x1c, 0x50, 0x91, 0x5a, 0x19, 0xc4,
-	0xa1, 0x17, 0xcb, 0x38, 0x5b, 0x58, 0x91, 0x78, 0xcd, 0xee, 0xda, 0x25, 0x6f, 0xd2, 0x5e, 0xfb,
-	0x34, 0x3d, 0xf6, 0xde, 0x4b, 0x45, 0x8f, 0x7d, 0x89, 0xca, 0xbb, 0x6b, 0xb3, 0x81, 0xf4, 0xb6,
-	0xdf, 0xce, 0xcc, 0x37, 0xdf, 0xce, 0xce, 0x0c, 0x34, 0x52, 0x1e, 0x5e, 0xe0, 0x49, 0xd0, 0x8b,
-	0x19, 0x15, 0x14, 0x2d, 0x6a, 0xd8, 0xb2, 0xaf, 0x12, 0xcc, 0xa6, 0xea, 0xd6, 0x1d, 0xc2, 0x92,
-	0x47, 0x13, 0x41, 0xa2, 0x73, 0x2f, 0x19, 0x63, 0x8e, 0x9e, 0x41, 0x85, 0x65, 0x07, 0xc7, 0xea,
-	0x94, 0xbb, 0xf6, 0x60, 0xa5, 0x97, 0x93, 0x18, 0x5e, 0x9e, 0x72, 0x71, 0x0f, 0xc1, 0x36, 0x6e,
-	0xd1, 0x06, 0xc0, 0x27, 0x46, 0x27, 0xbe, 0x08, 0xce, 0xc6, 0xd8, 0xb1, 0x3a, 0x56, 0xb7, 0xee,
-	0xd5, 0xb3, 0x9b, 0x93, 0xec, 0x02, 0xad, 0x43, 0x5d, 0x50, 0x65, 0xe4, 0x4e, 0xa9, 0x53, 0xee,
-	0xd6, 0xbd, 0x9a, 0xa0, 0xd2, 0xc6, 0xdd, 0x3f, 0x25, 0xa8, 0xbd, 0xc3, 0x53, 0x1e, 0x07, 0x21,
-	0x46, 0x0e, 0x2c, 0xf2, 0x8b, 0x80, 0x8d, 0xf0, 0x48, 0xb2, 0xd4, 0xbc, 0x1c, 0xa2, 0x57, 0x50,
-	0x4b, 0x49, 0x34, 0xc2, 0xd7, 0x9a, 0xc2, 0x1e, 0x6c, 0x16, 0x02, 0xf3, 0xf0, 0xde, 0xa9, 0xf6,
-	0x38, 0x88, 0x04, 0x9b, 0x7a, 0x45, 0x00, 0x7a, 0x0e, 0x55, 0x9d, 0xbd, 0x2c, 0x43, 0x37, 0xee,
-	0x87, 0x2a, 0x35, 0x2a, 0x50, 0x3b, 0xa3, 0x1d, 0x70, 0x18, 0xbe, 0x4a, 0x08, 0xc3, 0x3e, 0xbe,
-	0x8e, 0xc7, 0x24, 0x24, 0xc2, 0x67, 0xea, 0xd9, 0xce, 0x82, 0x94, 0xb7, 0xa6, 0xed, 0x07, 0xda,
-	0xac, 0x8b, 0xd2, 0x3a, 0x82, 0xc6, 0x8c, 0x16, 0xf4, 0x3f, 0x94, 0x2f, 0xf1, 0x54, 0x97, 0x26,
-	0x3b, 0xa2, 0xc7, 0x50, 0x49, 0x83, 0x71, 0x82, 0x9d, 0x52, 0xc7, 0xea, 0xda, 0x83, 0xe5, 0x42,
-	0x92, 0x0a, 0xf4, 0x94, 0x75, 0x58, 0xda, 0xb1, 0x5a, 0x87, 0x60, 0x1b, 0xf2, 0xe6, 0x70, 0x6d,
-	0xcd, 0x72, 0x35, 0x0b, 0x2e, 0x19, 0x66, 0x50, 0xb9, 0xdf, 0x2c, 0xa8, 0xaa, 0x04, 0x08, 0xc1,
-	0x82, 0x98, 0xc6, 0xf9, 0x77, 0xc9, 0x33, 0xda, 0x86, 0x6a, 0x1c, 0xb0, 0x60, 0x92, 0xd7, 0x78,
-	0xfd, 0x8e, 0xaa, 0xde, 0x07, 0x69, 0xd5, 0x65, 0x52, 0xae, 0x68, 0x05, 0x2a, 0xf4, 0x73, 0x84,
-	0x99, 0x53, 0x96, 0x4c, 0x0a, 0xb4, 0x5e, 0x82, 0x6d, 0x38, 0xcf, 0x11, 0xbd, 0x62, 0x8a, 0xae,
-	0x9b, 0x22, 0xbf, 0x96, 0xa0, 0xa2, 0x3a, 0x67, 0x9e, 0xc6, 0xd7, 0xb0, 0x1c, 0xd2, 0x71, 0x32,
-	0x89, 0xfc, 0x3b, 0x0d, 0xb1, 0x5a, 0x88, 0xdd, 0x97, 0x76, 0x5d, 0xc8, 0x66, 0x68, 0x20, 0xcc,
-	0xd1, 0x2e, 0x34, 0x83, 0x44, 0x50, 0x9f, 0x44, 0x21, 0xc3, 0x13, 0x1c, 0x09, 0xa9, 0xdb, 0x1e,
-	0xac, 0x15, 0xe1, 0x7b, 0x89, 0xa0, 0x87, 0xb9, 0xd5, 0x6b, 0x04, 0x26, 0x44, 0x4f, 0x61, 0x51,
-	0x11, 0x72, 0x67, 0x41, 0xa6, 0x5d, 0xbe, 0x93, 0xd6, 0xcb, 0xed, 0x68, 0x0d, 0xaa, 0x31, 0x89,
-	0x22, 0x3c, 0x72, 0x2a, 0x52, 0xbf, 0x46, 0x68, 0x08, 0x8f, 0xf4, 0x0b, 0xc6, 0x84, 0x0b, 0x3f,
-	0x48, 0xc4, 0x05, 0x65, 0x44, 0x04, 0x82, 0xa4, 0xd8, 0xa9, 0xca, 0xc6, 0x7a, 0xa8, 0x1c, 0x8e,
-	0x08, 0x17, 0x7b, 0xa6, 0xd9, 0x3d, 0x81, 0x25, 0xf3, 0x75, 0x59, 0x0e, 0xe5, 0xaa, 0x6b, 0xa4,
-	0x51, 0x56, 0xb9, 0x28, 0x98, 0xe4, 0xc5, 0x95, 0xe7, 0x6c, 0xba, 0x72, 0xe9, 0x65, 0x39, 0x85,
-	0x39, 0x74, 0xf7, 0xa1, 0x31, 0xf3, 0xe8, 0x7f, 0xd2, 0xb6, 0xa0, 0xc6, 0xf1, 0x55, 0x82, 0xa3,
-	0x30, 0xa7, 0x2e, 0xb0, 0xbb, 0x0b, 0xd5, 0xfd, 0xd9, 0xe4, 0x96, 0x91, 0x7c, 0x53, 0x7f, 0x65,
-	0x16, 0xd5, 0x1c, 0xd8, 0x3d, 0xb5, 0x8a, 0x4e, 0xa6, 0x31, 0x56, 0xff, 0xea, 0xfe, 0xb4, 0x00,
-	0x8e, 0x59, 0x7a, 0x7a, 0x2c, 0x8b, 0x89, 0xde, 0x40, 0xfd, 0x52, 0x0f, 0x67, 0xbe, 0x92, 0xdc,
-	0xa2, 0xd2, 0xb7, 0x7e, 0xc5, 0x04, 0xeb, 0xa6, 0xbc, 0x0d, 0x42, 0x43, 0x68, 0xe8, 0x69, 0xf5,
-	0xd5, 0x62, 0x53, 0xd3, 0xb1, 0x3a, 0x6f, 0xb1, 0x71, 0x6f, 0x89, 0x19, 0xa8, 0xf5, 0x1e, 0x9a,
-	0xb3, 0xc4, 0x73, 0x1a, 0xf8, 0xc9, 0xec, 0xd4, 0x3d, 0xb8, 0xb7, 0x54, 0x8c, 0x9e, 0x7e, 0xfb,
-	0xe2, 0xfb, 0x4d, 0xdb, 0xfa, 0x71, 0xd3, 0xb6, 0x7e, 0xdd, 0xb4, 0xad, 0x2f, 0xbf, 0xdb, 0xff,
-	0x7d, 0xdc, 0x4a, 0x89, 0xc0, 0x9c, 0xf7, 0x08, 0xed, 0xab, 0x53, 0xff, 0x9c, 0xf6, 0x53, 0xd1,
-	0x97, 0xdb, 0xb9, 0xaf, 0xb9, 0xce, 0xaa, 0x12, 0x6e, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x39, 0x77, 0x25, 0xd3, 0x05, 0x00, 0x00,
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x10, 0xff, 0x3b, 0x6e, 0xbe, 0xc6, 0x49, 0xfa, 0x67, 0xd5, 0x16, 0x93, 0xd2, 0x34, 0x58, 0x45,
+	0x04, 0x0e, 0x89, 0x94, 0x0a, 0x54, 0x82, 0x8a, 0x28, 0x51, 0x0f, 0x15, 0x95, 0x40, 0x6e, 0xd5,
+	0x03, 0x17, 0xcb, 0x75, 0x96, 0xc6, 0x6a, 0x62, 0xa7, 0xbb, 0x6b, 0xb7, 0x79, 0x13, 0xce, 0x3c,
+	0x07, 0x0f, 0xc0, 0x91, 0x2b, 0xe2, 0x82, 0xca, 0x8b, 0x20, 0xef, 0xae, 0xdd, 0x75, 0x1b, 0x0e,
+	0xdc, 0x76, 0xbe, 0x7e, 0xf3, 0xdb, 0x99, 0xd9, 0x59, 0xa8, 0xc7, 0xd4, 0x1b, 0xe3, 0xa9, 0xdb,
+	0x9d, 0x91, 0x90, 0x85, 0xa8, 0x2c, 0xc5, 0xa6, 0x71, 0x11, 0x61, 0x32, 0x17, 0x5a, 0x6b, 0x00,
+	0x35, 0x3b, 0x8c, 0x98, 0x1f, 0x9c, 0xd9, 0xd1, 0x04, 0x53, 0xf4, 0x0c, 0x8a, 0x24, 0x39, 0x98,
+	0x5a, 0x5b, 0xef, 0x18, 0xfd, 0x95, 0x6e, 0x0a, 0xa2, 0x78, 0xd9, 0xc2, 0xc5, 0x3a, 0x00, 0x43,
+	0xd1, 0xa2, 0x0d, 0x80, 0x4f, 0x24, 0x9c, 0x3a, 0xcc, 0x3d, 0x9d, 0x60, 0x53, 0x6b, 0x6b, 0x9d,
+	0xaa, 0x5d, 0x4d, 0x34, 0xc7, 0x89, 0x02, 0xad, 0x43, 0x95, 0x85, 0xc2, 0x48, 0xcd, 0x42, 0x5b,
+	0xef, 0x54, 0xed, 0x0a, 0x0b, 0xb9, 0x8d, 0x5a, 0x3f, 0x74, 0xa8, 0xbc, 0xc3, 0x73, 0x3a, 0x73,
+	0x3d, 0x8c, 0x4c, 0x28, 0xd3, 0xb1, 0x4b, 0x46, 0x78, 0xc4, 0x51, 0x2a, 0x76, 0x2a, 0xa2, 0x57,
+	0x50, 0x89, 0xfd, 0x60, 0x84, 0xaf, 0x24, 0x84, 0xd1, 0xdf, 0xcc, 0x08, 0xa6, 0xe1, 0xdd, 0x13,
+	0xe9, 0xb1, 0x1f, 0x30, 0x32, 0xb7, 0xb3, 0x00, 0xf4, 0x1c, 0x4a, 0x32, 0xbb, 0xce, 0x43, 0x37,
+	0xee, 0x86, 0x0a, 0x36, 0x22, 0x50, 0x3a, 0xa3, 0x1d, 0x30, 0x09, 0xbe, 0x88, 0x7c, 0x82, 0x1d,
+	0x7c, 0x35, 0x9b, 0xf8, 0x9e, 0xcf, 0x1c, 0x22, 0xae, 0x6d, 0x2e, 0x71, 0x7a, 0x6b, 0xd2, 0xbe,
+	0x2f, 0xcd, 0xb2, 0x28, 0xa8, 0x0f, 0xc5, 0xd8, 0xc7, 0x97, 0xd4, 0x2c, 0xf2, 0x7c, 0x0f, 0x17,
+	0x51, 0xc5, 0x97, 0x32, 0x9d, 0x70, 0x6d, 0x1e, 0x42, 0x3d, 0xc7, 0x1f, 0xfd, 0x0f, 0xfa, 0x39,
+	0x9e, 0xcb, 0x72, 0x26, 0x47, 0xf4, 0x18, 0x8a, 0xb1, 0x3b, 0x89, 0xb0, 0x59, 0x68, 0x6b, 0x1d,
+	0xa3, 0xbf, 0x9c, 0xc1, 0x8a, 0x40, 0x5b, 0x58, 0x07, 0x85, 0x1d, 0xad, 0x79, 0x00, 0x86, 0x72,
+	0xa5, 0x05, 0x58, 0x5b, 0x79, 0xac, 0x46, 0x86, 0xc5, 0xc3, 0x54, 0xa8, 0x1d, 0x80, 0x1b, 0xb6,
+	0x0b, 0x90, 0x56, 0x54, 0xa4, 0xaa, 0x12, 0x69, 0x7d, 0xd1, 0xa0, 0x24, 0xa8, 0x21, 0x04, 0x4b,
+	0x6c, 0x3e, 0x4b, 0x87, 0x83, 0x9f, 0xd1, 0x36, 0x94, 0x66, 0x2e, 0x71, 0xa7, 0x69, 0x47, 0xd7,
+	0x6f, 0xdd, 0xa7, 0xfb, 0x81, 0x5b, 0x65, 0x53, 0x84, 0x6b, 0x92, 0x2d, 0xbc, 0x0c, 0x30, 0x31,
+	0x75, 0x91, 0x8d, 0x0b, 0xcd, 0x97, 0x60, 0x28, 0xce, 0xff, 0x44, 0xf2, 0x6b, 0x01, 0x8a, 0x62,
+	0x4e, 0x17, 0x71, 0x7c, 0x0d, 0xcb, 0x5e, 0x38, 0x89, 0xa6, 0x81, 0x73, 0x6b, 0xfc, 0x56, 0x33,
+	0xb2, 0x43, 0x6e, 0x97, 0x2d, 0x68, 0x78, 0x8a, 0x84, 0x29, 0xda, 0x85, 0x86, 0x1b, 0xb1, 0xd0,
+	0xf1, 0x03, 0x8f, 0xe0, 0x29, 0x0e, 0x18, 0xe7, 0x6d, 0xf4, 0xd7, 0xb2, 0xf0, 0xbd, 0x88, 0x85,
+	0x07, 0xa9, 0xd5, 0xae, 0xbb, 0xaa, 0x88, 0x9e, 0x42, 0x59, 0x00, 0x52, 0x73, 0xa9, 0xad, 0xe7,
+	0x7a, 0x2e, 0xd2, 0xda, 0xa9, 0x1d, 0xad, 0x41, 0x69, 0xe6, 0x07, 0x01, 0x1e, 0x99, 0x45, 0xce,
+	0x5f, 0x4a, 0x68, 0x00, 0x0f, 0xe4, 0x0d, 0x26, 0x3e, 0x65, 0x8e, 0x1b, 0xb1, 0x71, 0x48, 0x7c,
+	0xe6, 0x32, 0x3f, 0xc6, 0x66, 0x89, 0x8f, 0xf1, 0x7d, 0xe1, 0x70, 0xe8, 0x53, 0xb6, 0xa7, 0x9a,
+	0xd1, 0x23, 0xa8, 0x11, 0x4c, 0xa3, 0x09, 0x73, 0x3c, 0xd7, 0x1b, 0x63, 0xb3, 0xcc, 0xdd, 0x0d,
+	0xa1, 0x1b, 0x26, 0x2a, 0xeb, 0x18, 0x6a, 0x6a, 0x01, 0x12, 0x1a, 0x02, 0x4d, 0x96, 0x51, 0x4a,
+	0x49, 0x71, 0x03, 0x77, 0x9a, 0xd6, 0x9f, 0x9f, 0x93, 0xe7, 0x9e, 0xde, 0x4e, 0xe7, 0x6b, 0x21,
+	0x15, 0xad, 0x21, 0xd4, 0x73, 0x75, 0xf9, 0x2b, 0x6c, 0x13, 0x2a, 0x14, 0x5f, 0x44, 0x38, 0xf0,
+	0x52, 0xe8, 0x4c, 0xb6, 0x76, 0xa1, 0x34, 0xcc, 0x27, 0xd7, 0x94, 0xe4, 0x9b, 0xb2, 0xdb, 0x49,
+	0x54, 0xa3, 0x6f, 0x74, 0xc5, 0x6e, 0x3c, 0x9e, 0xcf, 0xb0, 0x68, 0xbd, 0xf5, 0x53, 0x03, 0x38,
+	0x22, 0xf1, 0xc9, 0x11, 0xaf, 0x37, 0x7a, 0x03, 0xd5, 0x73, 0xf9, 0x7a, 0xd3, 0x1d, 0x69, 0x65,
+	0xcd, 0xb8, 0xf1, 0xcb, 0x9e, 0xb8, 0x9c, 0xdb, 0x9b, 0x20, 0x34, 0x80, 0xba, 0x5c, 0x1f, 0x8e,
+	0xd8, 0xb4, 0xe2, 0xe9, 0xad, 0x2e, 0xda, 0xb4, 0xd4, 0xae, 0x11, 0x45, 0x6a, 0xbe, 0x87, 0x46,
+	0x1e, 0x78, 0xc1, 0x8c, 0x3f, 0xc9, 0x3f, 0xe9, 0x7b, 0x77, 0xb6, 0x8e, 0x32, 0xf6, 0x6f, 0x5f,
+	0x7c, 0xbb, 0x6e, 0x69, 0xdf, 0xaf, 0x5b, 0xda, 0xaf, 0xeb, 0x96, 0xf6, 0xf9, 0x77, 0xeb, 0xbf,
+	0x8f, 0x5b, 0xb1, 0xcf, 0x30, 0xa5, 0x5d, 0x3f, 0xec, 0x89, 0x53, 0xef, 0x2c, 0xec, 0xc5, 0xac,
+	0xc7, 0xbf, 0x8b, 0x9e, 0xc4, 0x3a, 0x2d, 0x71, 0x71, 0xfb, 0xcf, 0x00, 0xc3, 0x45, 0xd4, 0x99,
+	0x64, 0x06, 0x00, 0x00,
 }
 
 func (m *RoutingRules) Marshal() (dAtA []byte, err error) {
@@ -797,6 +822,25 @@ func (m *Keyspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Views) > 0 {
+		for k := range m.Views {
+			v := m.Views[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintVschema(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintVschema(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintVschema(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.RequireExplicitRouting {
 		i--
 		if m.RequireExplicitRouting {
@@ -956,6 +1000,16 @@ func (m *Table) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ResultCache {
+		i--
+		if m.ResultCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.ColumnListAuthoritative {
 		i--
 		if m.ColumnListAuthoritative {
@@ -1307,6 +1361,14 @@ func (m *Keyspace) Size() (n int) {
 	if m.RequireExplicitRouting {
 		n += 2
 	}
+	if len(m.Views) > 0 {
+		for k, v := range m.Views {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovVschema(uint64(len(k))) + 1 + len(v) + sovVschema(uint64(len(v)))
+			n += mapEntrySize + 1 + sovVschema(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1374,6 +1436,9 @@ func (m *Table) Size() (n int) {
 	if m.ColumnListAuthoritative {
 		n += 2
 	}
+	if m.ResultCache {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2013,6 +2078,133 @@ func (m *Keyspace) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequireExplicitRouting = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Views", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Views == nil {
+				m.Views = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVschema
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVschema
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthVschema
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthVschema
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVschema
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthVschema
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthVschema
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipVschema(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthVschema
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Views[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVschema(dAtA[iNdEx:])
@@ -2500,6 +2692,26 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ColumnListAuthoritative = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResultCache = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVschema(dAtA[iNdEx:])
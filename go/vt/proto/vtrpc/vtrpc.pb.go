@@ -128,6 +128,10 @@ const (
 	Code_UNAVAILABLE Code = 14
 	// DATA_LOSS indicates unrecoverable data loss or corruption.
 	Code_DATA_LOSS Code = 15
+	// CLUSTER_EVENT indicates that a cluster operation, like the cutover of
+	// a resharding or MoveTables workflow, is changing the routing of the
+	// request. The request should be routed again once it is over.
+	Code_CLUSTER_EVENT Code = 17
 )
 
 var Code_name = map[int32]string{
@@ -148,6 +152,7 @@ var Code_name = map[int32]string{
 	13: "INTERNAL",
 	14: "UNAVAILABLE",
 	15: "DATA_LOSS",
+	17: "CLUSTER_EVENT",
 }
 
 var Code_value = map[string]int32{
@@ -168,6 +173,7 @@ var Code_value = map[string]int32{
 	"INTERNAL":            13,
 	"UNAVAILABLE":         14,
 	"DATA_LOSS":           15,
+	"CLUSTER_EVENT":       17,
 }
 
 func (x Code) String() string {
@@ -447,47 +453,47 @@ func init() {
 func init() { proto.RegisterFile("vtrpc.proto", fileDescriptor_750b4cf641561858) }
 
 var fileDescriptor_750b4cf641561858 = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xcb, 0x4e, 0x1b, 0x3f,
-	0x14, 0xc6, 0xc9, 0x85, 0x5c, 0x4e, 0x02, 0x31, 0xe6, 0x16, 0xfe, 0x7f, 0x9a, 0x56, 0x59, 0x55,
-	0x2c, 0x88, 0xd4, 0x2e, 0xba, 0x76, 0xc6, 0x87, 0x60, 0x31, 0x78, 0x52, 0x8f, 0x87, 0x92, 0x6e,
-	0xac, 0x10, 0x46, 0x28, 0x55, 0x60, 0xa2, 0x49, 0x8a, 0xd4, 0x4d, 0x9f, 0xa3, 0x4f, 0xd2, 0x67,
-	0xe8, 0xb2, 0x8f, 0x50, 0xd1, 0x4d, 0x1f, 0xa3, 0xb2, 0x93, 0x29, 0x0a, 0xec, 0xe6, 0x7c, 0xbf,
-	0xe3, 0xe3, 0xef, 0x7c, 0x4e, 0xa0, 0x76, 0x3f, 0x4f, 0xa7, 0xa3, 0xe3, 0x69, 0x9a, 0xcc, 0x13,
-	0xba, 0xee, 0x8a, 0xf6, 0x27, 0xa8, 0x78, 0xc3, 0xc9, 0x24, 0x4e, 0x05, 0xa7, 0x87, 0x50, 0x9d,
-	0xa6, 0xe3, 0xbb, 0xd1, 0x78, 0x3a, 0x9c, 0x34, 0x73, 0xaf, 0x72, 0xaf, 0xab, 0xea, 0x51, 0xb0,
-	0x74, 0x94, 0xdc, 0x4e, 0x93, 0xbb, 0xf8, 0x6e, 0xde, 0xcc, 0x2f, 0xe8, 0x3f, 0x81, 0xb6, 0xa1,
-	0x3e, 0xfb, 0x7c, 0xf5, 0xd8, 0x50, 0x70, 0x0d, 0x2b, 0x5a, 0xfb, 0x2b, 0x54, 0x54, 0xdf, 0xc3,
-	0x34, 0x4d, 0x52, 0xfa, 0x0e, 0x6a, 0x93, 0xf8, 0x66, 0x38, 0xfa, 0x62, 0x46, 0xc9, 0x75, 0xec,
-	0x6e, 0xdb, 0x7c, 0xb3, 0x77, 0xbc, 0x70, 0xe8, 0x3b, 0xe2, 0x1a, 0xbd, 0xe4, 0x3a, 0x56, 0xb0,
-	0x68, 0xb5, 0xdf, 0xb4, 0x09, 0xe5, 0xdb, 0x78, 0x36, 0x1b, 0xde, 0xc4, 0x4b, 0x13, 0x59, 0x49,
-	0x5f, 0x42, 0xd1, 0xcd, 0x2a, 0xb8, 0x59, 0xb5, 0xe5, 0x2c, 0x37, 0xc0, 0x81, 0xa3, 0xef, 0x79,
-	0x28, 0xba, 0x19, 0x25, 0xc8, 0x07, 0x67, 0x64, 0x8d, 0xd6, 0xa1, 0xe2, 0x31, 0xe9, 0xa1, 0x8f,
-	0x9c, 0xe4, 0x68, 0x0d, 0xca, 0x91, 0x3c, 0x93, 0xc1, 0x07, 0x49, 0xf2, 0x74, 0x07, 0x88, 0x90,
-	0x17, 0xcc, 0x17, 0xdc, 0x30, 0xd5, 0x8b, 0xce, 0x51, 0x6a, 0x52, 0xa0, 0xbb, 0xb0, 0xc5, 0x91,
-	0x71, 0x5f, 0x48, 0x34, 0x78, 0xe9, 0x21, 0x72, 0xe4, 0xa4, 0x48, 0x37, 0xa0, 0x2a, 0x03, 0x6d,
-	0x4e, 0x82, 0x48, 0x72, 0xb2, 0x4e, 0x29, 0x6c, 0x32, 0x5f, 0x21, 0xe3, 0x03, 0x83, 0x97, 0x22,
-	0xd4, 0x21, 0x29, 0xd9, 0x93, 0x7d, 0x54, 0xe7, 0x22, 0x0c, 0x45, 0x20, 0x0d, 0x47, 0x29, 0x90,
-	0x93, 0x32, 0xdd, 0x86, 0x46, 0x24, 0x59, 0xa4, 0x4f, 0x51, 0x6a, 0xe1, 0x31, 0x8d, 0x9c, 0x10,
-	0xba, 0x07, 0x54, 0x61, 0x18, 0x44, 0xca, 0xb3, 0xb7, 0x9c, 0xb2, 0x28, 0xb4, 0x7a, 0x85, 0xee,
-	0xc3, 0xf6, 0x09, 0x13, 0x3e, 0x72, 0xd3, 0x57, 0xe8, 0x05, 0x92, 0x0b, 0x2d, 0x02, 0x49, 0xaa,
-	0xd6, 0x39, 0xeb, 0x06, 0xca, 0x76, 0x01, 0x25, 0x50, 0x0f, 0x22, 0x6d, 0x82, 0x13, 0xa3, 0x98,
-	0xec, 0x21, 0xa9, 0xd1, 0x2d, 0xd8, 0x88, 0xa4, 0x38, 0xef, 0xfb, 0x68, 0xd7, 0x40, 0x4e, 0xea,
-	0x76, 0x73, 0x21, 0x35, 0x2a, 0xc9, 0x7c, 0xb2, 0x41, 0x1b, 0x50, 0x8b, 0x24, 0xbb, 0x60, 0xc2,
-	0x67, 0x5d, 0x1f, 0xc9, 0xa6, 0x5d, 0x88, 0x33, 0xcd, 0x8c, 0x1f, 0x84, 0x21, 0x69, 0x1c, 0xfd,
-	0xc9, 0x43, 0xe3, 0xc9, 0x9b, 0xd8, 0x25, 0xc3, 0xc8, 0xf3, 0x30, 0x0c, 0x8d, 0x8f, 0x3d, 0xe6,
-	0x0d, 0xc8, 0x9a, 0x0d, 0x6d, 0x91, 0xa7, 0xf5, 0xb8, 0x54, 0x73, 0xb4, 0x09, 0x3b, 0xcb, 0x5c,
-	0x0d, 0x2a, 0x15, 0xa8, 0x8c, 0xb8, 0x90, 0xbb, 0x8c, 0x1b, 0x21, 0xfb, 0x91, 0xce, 0xd4, 0x02,
-	0x3d, 0x84, 0xe6, 0xb3, 0x90, 0x33, 0x5a, 0xa4, 0xff, 0xc1, 0x9e, 0x75, 0xde, 0x53, 0x42, 0x0f,
-	0x56, 0xe7, 0xad, 0xdb, 0x93, 0xcf, 0x42, 0xce, 0x68, 0x89, 0xbe, 0x80, 0x83, 0xe7, 0xb1, 0x66,
-	0xb8, 0x4c, 0xff, 0x87, 0xfd, 0xf7, 0x11, 0xaa, 0x81, 0xb1, 0x4f, 0x19, 0xa2, 0xba, 0x78, 0x84,
-	0x15, 0xeb, 0xd4, 0xca, 0x42, 0x1a, 0x7d, 0x99, 0xa9, 0x55, 0x7a, 0x00, 0xbb, 0x59, 0x8a, 0xab,
-	0x56, 0xc0, 0xda, 0xd4, 0x8a, 0xc9, 0x50, 0xa0, 0xd4, 0xab, 0xac, 0x66, 0xd9, 0x93, 0x47, 0xcf,
-	0x58, 0xbd, 0x8b, 0x3f, 0x1e, 0x5a, 0xb9, 0x9f, 0x0f, 0xad, 0xdc, 0xaf, 0x87, 0x56, 0xee, 0xdb,
-	0xef, 0xd6, 0x1a, 0x34, 0xc6, 0xc9, 0xf1, 0xfd, 0x78, 0x1e, 0xcf, 0x66, 0x8b, 0x7f, 0xee, 0xc7,
-	0xf6, 0xb2, 0x1a, 0x27, 0x9d, 0xc5, 0x57, 0xe7, 0x26, 0xe9, 0xdc, 0xcf, 0x3b, 0x8e, 0x76, 0xdc,
-	0xaf, 0xfe, 0xaa, 0xe4, 0x8a, 0xb7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x67, 0xe4, 0x15,
-	0xf3, 0x03, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0x4d, 0x4f, 0x1b, 0x3b,
+	0x14, 0x25, 0x1f, 0xe4, 0xe3, 0x26, 0x10, 0x63, 0xbe, 0xc2, 0x7b, 0xbc, 0xbc, 0xa7, 0xac, 0x9e,
+	0x58, 0x10, 0xa9, 0x5d, 0x74, 0xed, 0x8c, 0x2f, 0xc1, 0x62, 0xf0, 0xa4, 0x1e, 0x4f, 0x4a, 0xba,
+	0xb1, 0x42, 0x18, 0xa1, 0x54, 0x81, 0x89, 0x26, 0x29, 0x52, 0x37, 0xfd, 0x1d, 0xfd, 0x49, 0xed,
+	0xae, 0x3f, 0xa1, 0xa2, 0x9b, 0xfe, 0x8c, 0xca, 0x4e, 0xa6, 0x28, 0xb0, 0xf3, 0x3d, 0xe7, 0xfa,
+	0xf8, 0xdc, 0x63, 0x1b, 0x6a, 0x0f, 0x8b, 0x74, 0x36, 0x3e, 0x9d, 0xa5, 0xc9, 0x22, 0xa1, 0x9b,
+	0xae, 0x68, 0x7f, 0x80, 0x8a, 0x37, 0x9a, 0x4e, 0xe3, 0x54, 0x70, 0x7a, 0x0c, 0xd5, 0x59, 0x3a,
+	0xb9, 0x1f, 0x4f, 0x66, 0xa3, 0x69, 0x33, 0xf7, 0x5f, 0xee, 0xff, 0xaa, 0x7a, 0x02, 0x2c, 0x3b,
+	0x4e, 0xee, 0x66, 0xc9, 0x7d, 0x7c, 0xbf, 0x68, 0xe6, 0x97, 0xec, 0x1f, 0x80, 0xb6, 0xa1, 0x3e,
+	0xff, 0x78, 0xfd, 0xd4, 0x50, 0x70, 0x0d, 0x6b, 0x58, 0xfb, 0x33, 0x54, 0x54, 0xdf, 0xc3, 0x34,
+	0x4d, 0x52, 0xfa, 0x06, 0x6a, 0xd3, 0xf8, 0x76, 0x34, 0xfe, 0x64, 0xc6, 0xc9, 0x4d, 0xec, 0x4e,
+	0xdb, 0x7e, 0x75, 0x70, 0xba, 0x74, 0xe8, 0x3b, 0xc6, 0x35, 0x7a, 0xc9, 0x4d, 0xac, 0x60, 0xd9,
+	0x6a, 0xd7, 0xb4, 0x09, 0xe5, 0xbb, 0x78, 0x3e, 0x1f, 0xdd, 0xc6, 0x2b, 0x13, 0x59, 0x49, 0xff,
+	0x85, 0xa2, 0xd3, 0x2a, 0x38, 0xad, 0xda, 0x4a, 0xcb, 0x09, 0x38, 0xe2, 0xe4, 0x5b, 0x1e, 0x8a,
+	0x4e, 0xa3, 0x04, 0xf9, 0xe0, 0x82, 0x6c, 0xd0, 0x3a, 0x54, 0x3c, 0x26, 0x3d, 0xf4, 0x91, 0x93,
+	0x1c, 0xad, 0x41, 0x39, 0x92, 0x17, 0x32, 0x78, 0x27, 0x49, 0x9e, 0xee, 0x01, 0x11, 0x72, 0xc0,
+	0x7c, 0xc1, 0x0d, 0x53, 0xbd, 0xe8, 0x12, 0xa5, 0x26, 0x05, 0xba, 0x0f, 0x3b, 0x1c, 0x19, 0xf7,
+	0x85, 0x44, 0x83, 0x57, 0x1e, 0x22, 0x47, 0x4e, 0x8a, 0x74, 0x0b, 0xaa, 0x32, 0xd0, 0xe6, 0x2c,
+	0x88, 0x24, 0x27, 0x9b, 0x94, 0xc2, 0x36, 0xf3, 0x15, 0x32, 0x3e, 0x34, 0x78, 0x25, 0x42, 0x1d,
+	0x92, 0x92, 0xdd, 0xd9, 0x47, 0x75, 0x29, 0xc2, 0x50, 0x04, 0xd2, 0x70, 0x94, 0x02, 0x39, 0x29,
+	0xd3, 0x5d, 0x68, 0x44, 0x92, 0x45, 0xfa, 0x1c, 0xa5, 0x16, 0x1e, 0xd3, 0xc8, 0x09, 0xa1, 0x07,
+	0x40, 0x15, 0x86, 0x41, 0xa4, 0x3c, 0x7b, 0xca, 0x39, 0x8b, 0x42, 0x8b, 0x57, 0xe8, 0x21, 0xec,
+	0x9e, 0x31, 0xe1, 0x23, 0x37, 0x7d, 0x85, 0x5e, 0x20, 0xb9, 0xd0, 0x22, 0x90, 0xa4, 0x6a, 0x9d,
+	0xb3, 0x6e, 0xa0, 0x6c, 0x17, 0x50, 0x02, 0xf5, 0x20, 0xd2, 0x26, 0x38, 0x33, 0x8a, 0xc9, 0x1e,
+	0x92, 0x1a, 0xdd, 0x81, 0xad, 0x48, 0x8a, 0xcb, 0xbe, 0x8f, 0x76, 0x0c, 0xe4, 0xa4, 0x6e, 0x27,
+	0x17, 0x52, 0xa3, 0x92, 0xcc, 0x27, 0x5b, 0xb4, 0x01, 0xb5, 0x48, 0xb2, 0x01, 0x13, 0x3e, 0xeb,
+	0xfa, 0x48, 0xb6, 0xed, 0x40, 0x9c, 0x69, 0x66, 0xfc, 0x20, 0x0c, 0x49, 0xc3, 0x0a, 0x78, 0xbe,
+	0x75, 0xa1, 0x0c, 0x0e, 0x6c, 0x12, 0x3b, 0x27, 0xbf, 0xf2, 0xd0, 0x78, 0x76, 0x4d, 0x76, 0xee,
+	0x30, 0xf2, 0x3c, 0x0c, 0x43, 0xe3, 0x63, 0x8f, 0x79, 0x43, 0xb2, 0x61, 0x73, 0x5c, 0x46, 0x6c,
+	0x6d, 0xaf, 0xd0, 0x1c, 0x6d, 0xc2, 0xde, 0x2a, 0x6a, 0x83, 0x4a, 0x05, 0x2a, 0x63, 0x5c, 0xee,
+	0x5d, 0xc6, 0x8d, 0x90, 0xfd, 0x48, 0x67, 0x68, 0x81, 0x1e, 0x43, 0xf3, 0x45, 0xee, 0x19, 0x5b,
+	0xa4, 0x7f, 0xc1, 0x81, 0x1d, 0xa6, 0xa7, 0x84, 0x1e, 0xae, 0xeb, 0x6d, 0xda, 0x9d, 0x2f, 0x72,
+	0xcf, 0xd8, 0x12, 0xfd, 0x07, 0x8e, 0x5e, 0x26, 0x9d, 0xd1, 0x65, 0xfa, 0x37, 0x1c, 0xbe, 0x8d,
+	0x50, 0x0d, 0x8d, 0xbd, 0xdd, 0x10, 0xd5, 0xe0, 0x89, 0xac, 0x58, 0xa7, 0x16, 0x16, 0xd2, 0xe8,
+	0xab, 0x0c, 0xad, 0xd2, 0x23, 0xd8, 0xcf, 0x82, 0x5d, 0xb7, 0x02, 0xd6, 0xa6, 0x56, 0x4c, 0x86,
+	0x02, 0xa5, 0x5e, 0xe7, 0x6a, 0x96, 0x7b, 0xf6, 0x0e, 0x32, 0xae, 0xde, 0xc5, 0xaf, 0x8f, 0xad,
+	0xdc, 0xf7, 0xc7, 0x56, 0xee, 0xc7, 0x63, 0x2b, 0xf7, 0xe5, 0x67, 0x6b, 0x03, 0x1a, 0x93, 0xe4,
+	0xf4, 0x61, 0xb2, 0x88, 0xe7, 0xf3, 0xe5, 0x67, 0x7e, 0xdf, 0x5e, 0x55, 0x93, 0xa4, 0xb3, 0x5c,
+	0x75, 0x6e, 0x93, 0xce, 0xc3, 0xa2, 0xe3, 0xd8, 0x8e, 0xfb, 0x08, 0xd7, 0x25, 0x57, 0xbc, 0xfe,
+	0x3d, 0x00, 0x6d, 0x9b, 0xde, 0x4a, 0x06, 0x04, 0x00, 0x00,
 }
 
 func (m *CallerID) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servenv
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/stats"
+)
+
+// replyReleasesKey is the context key of the releases of a gRPC call.
+type replyReleasesKey struct{}
+
+// replyReleases are the functions to call once the reply of a gRPC call
+// has been sent.
+type replyReleases struct {
+	mu       sync.Mutex
+	releases []func()
+	done     bool
+}
+
+// ReleaseAfterReply registers release to be called once the reply of the
+// gRPC call served with ctx has been sent, so that the memory held by the
+// reply can be accounted for until then. It returns false, and registers
+// nothing, if ctx is not the context of a gRPC call served by this
+// process, or if its reply has already been sent: the caller then has to
+// release itself once it returned its reply.
+func ReleaseAfterReply(ctx context.Context, release func()) bool {
+	rr, ok := ctx.Value(replyReleasesKey{}).(*replyReleases)
+	if !ok {
+		return false
+	}
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	if rr.done {
+		return false
+	}
+	rr.releases = append(rr.releases, release)
+	return true
+}
+
+// replyStatsHandler calls the functions registered by ReleaseAfterReply
+// when the gRPC calls end, after their reply has been sent.
+type replyStatsHandler struct{}
+
+// TagRPC is part of the stats.Handler interface.
+func (replyStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, replyReleasesKey{}, &replyReleases{})
+}
+
+// HandleRPC is part of the stats.Handler interface.
+func (replyStatsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	if _, ok := s.(*stats.End); !ok {
+		return
+	}
+	rr, ok := ctx.Value(replyReleasesKey{}).(*replyReleases)
+	if !ok {
+		return
+	}
+	rr.mu.Lock()
+	releases := rr.releases
+	rr.releases = nil
+	rr.done = true
+	rr.mu.Unlock()
+	for _, release := range releases {
+		release()
+	}
+}
+
+// TagConn is part of the stats.Handler interface.
+func (replyStatsHandler) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn is part of the stats.Handler interface.
+func (replyStatsHandler) HandleConn(ctx context.Context, s stats.ConnStats) {}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servenv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/stats"
+)
+
+func TestReleaseAfterReply(t *testing.T) {
+	released := 0
+	release := func() { released++ }
+
+	// Outside of a gRPC call, the caller releases itself.
+	assert.False(t, ReleaseAfterReply(context.Background(), release))
+
+	var h replyStatsHandler
+	ctx := h.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: "/queryservice.Query/Execute"})
+	assert.True(t, ReleaseAfterReply(ctx, release))
+	assert.True(t, ReleaseAfterReply(ctx, release))
+
+	h.HandleRPC(ctx, &stats.OutPayload{})
+	assert.Equal(t, 0, released)
+	h.HandleRPC(ctx, &stats.End{})
+	assert.Equal(t, 2, released)
+
+	// Once the reply has been sent, the caller releases itself.
+	assert.False(t, ReleaseAfterReply(ctx, release))
+	h.HandleRPC(ctx, &stats.End{})
+	assert.Equal(t, 2, released)
+}
@@ -175,6 +175,7 @@ func createGRPCServer() {
 	}
 
 	opts = append(opts, interceptors()...)
+	opts = append(opts, grpc.StatsHandler(replyStatsHandler{}))
 
 	GRPCServer = grpc.NewServer(opts...)
 }
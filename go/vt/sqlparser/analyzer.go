@@ -62,6 +62,7 @@ const (
 	StmtFlush
 	StmtCallProc
 	StmtRevert
+	StmtKill
 )
 
 //ASTToStatementType returns a StatementType from an AST stmt
@@ -83,6 +84,8 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtDDL
 	case *RevertMigration:
 		return StmtRevert
+	case *Kill:
+		return StmtKill
 	case *Use:
 		return StmtUse
 	case *OtherRead, *OtherAdmin, *Load:
@@ -174,6 +177,8 @@ func Preview(sql string) StatementType {
 		return StmtVStream
 	case "revert":
 		return StmtRevert
+	case "kill":
+		return StmtKill
 	case "insert":
 		return StmtInsert
 	case "replace":
@@ -238,6 +243,8 @@ func (s StatementType) String() string {
 		return "VSTREAM"
 	case StmtRevert:
 		return "REVERT"
+	case StmtKill:
+		return "KILL"
 	case StmtInsert:
 		return "INSERT"
 	case StmtReplace:
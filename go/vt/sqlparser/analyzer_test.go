@@ -43,6 +43,7 @@ func TestPreview(t *testing.T) {
 		{"Update", StmtUpdate},
 		{"UPDATE ...", StmtUpdate},
 		{"\n\t    delete ...", StmtDelete},
+		{"kill query 1", StmtKill},
 		{"", StmtUnknown},
 		{" ", StmtUnknown},
 		{"begin", StmtBegin},
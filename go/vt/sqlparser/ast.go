@@ -254,6 +254,7 @@ type (
 		Comments   Comments
 		SelectExpr SelectExpr
 		Table      TableName
+		Group      ColIdent
 	}
 
 	// Insert represents an INSERT or REPLACE statement.
@@ -414,6 +415,15 @@ type (
 		UUID string
 	}
 
+	// KillType is an enum for Kill.Type
+	KillType int8
+
+	// Kill represents a KILL [CONNECTION | QUERY] statement
+	Kill struct {
+		Type          KillType
+		ProcesslistID uint64
+	}
+
 	// AlterMigrationType represents the type of operation in an ALTER VITESS_MIGRATION statement
 	AlterMigrationType int8
 
@@ -423,6 +433,17 @@ type (
 		UUID string
 	}
 
+	// AlterDeadLettersType represents the type of operation in an ALTER VITESS_DEAD_LETTERS statement
+	AlterDeadLettersType int8
+
+	// AlterDeadLetters represents a ALTER VITESS_DEAD_LETTERS statement,
+	// which requeues or purges the dead letters of a message table.
+	AlterDeadLetters struct {
+		Type  AlterDeadLettersType
+		Table TableName
+		Where *Where
+	}
+
 	// AlterTable represents a ALTER TABLE statement.
 	AlterTable struct {
 		Table         TableName
@@ -612,7 +633,9 @@ func (*UnlockTables) iStatement()      {}
 func (*AlterTable) iStatement()        {}
 func (*AlterVschema) iStatement()      {}
 func (*AlterMigration) iStatement()    {}
+func (*AlterDeadLetters) iStatement()  {}
 func (*RevertMigration) iStatement()   {}
+func (*Kill) iStatement()              {}
 func (*DropTable) iStatement()         {}
 func (*DropView) iStatement()          {}
 func (*TruncateTable) iStatement()     {}
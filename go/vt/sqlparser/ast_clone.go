@@ -43,6 +43,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfAlterColumn(in)
 	case *AlterDatabase:
 		return CloneRefOfAlterDatabase(in)
+	case *AlterDeadLetters:
+		return CloneRefOfAlterDeadLetters(in)
 	case *AlterMigration:
 		return CloneRefOfAlterMigration(in)
 	case *AlterTable:
@@ -161,6 +163,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfJoinTableExpr(in)
 	case *KeyState:
 		return CloneRefOfKeyState(in)
+	case *Kill:
+		return CloneRefOfKill(in)
 	case *Limit:
 		return CloneRefOfLimit(in)
 	case ListArg:
@@ -408,6 +412,17 @@ func CloneRefOfAlterDatabase(n *AlterDatabase) *AlterDatabase {
 	return &out
 }
 
+// CloneRefOfAlterDeadLetters creates a deep clone of the input.
+func CloneRefOfAlterDeadLetters(n *AlterDeadLetters) *AlterDeadLetters {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Table = CloneTableName(n.Table)
+	out.Where = CloneRefOfWhere(n.Where)
+	return &out
+}
+
 // CloneRefOfAlterMigration creates a deep clone of the input.
 func CloneRefOfAlterMigration(n *AlterMigration) *AlterMigration {
 	if n == nil {
@@ -997,6 +1012,15 @@ func CloneRefOfKeyState(n *KeyState) *KeyState {
 	return &out
 }
 
+// CloneRefOfKill creates a deep clone of the input.
+func CloneRefOfKill(n *Kill) *Kill {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfLimit creates a deep clone of the input.
 func CloneRefOfLimit(n *Limit) *Limit {
 	if n == nil {
@@ -1479,6 +1503,7 @@ func CloneRefOfStream(n *Stream) *Stream {
 	out.Comments = CloneComments(n.Comments)
 	out.SelectExpr = CloneSelectExpr(n.SelectExpr)
 	out.Table = CloneTableName(n.Table)
+	out.Group = CloneColIdent(n.Group)
 	return &out
 }
 
@@ -2106,6 +2131,8 @@ func CloneStatement(in Statement) Statement {
 	switch in := in.(type) {
 	case *AlterDatabase:
 		return CloneRefOfAlterDatabase(in)
+	case *AlterDeadLetters:
+		return CloneRefOfAlterDeadLetters(in)
 	case *AlterMigration:
 		return CloneRefOfAlterMigration(in)
 	case *AlterTable:
@@ -2142,6 +2169,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfFlush(in)
 	case *Insert:
 		return CloneRefOfInsert(in)
+	case *Kill:
+		return CloneRefOfKill(in)
 	case *Load:
 		return CloneRefOfLoad(in)
 	case *LockTables:
@@ -86,6 +86,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfAlterDatabase(a, b)
+	case *AlterDeadLetters:
+		b, ok := inB.(*AlterDeadLetters)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterDeadLetters(a, b)
 	case *AlterMigration:
 		b, ok := inB.(*AlterMigration)
 		if !ok {
@@ -440,6 +446,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfKeyState(a, b)
+	case *Kill:
+		b, ok := inB.(*Kill)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfKill(a, b)
 	case *Limit:
 		b, ok := inB.(*Limit)
 		if !ok {
@@ -1008,6 +1020,19 @@ func EqualsRefOfAlterDatabase(a, b *AlterDatabase) bool {
 		EqualsSliceOfCollateAndCharset(a.AlterOptions, b.AlterOptions)
 }
 
+// EqualsRefOfAlterDeadLetters does deep equals between the two objects.
+func EqualsRefOfAlterDeadLetters(a, b *AlterDeadLetters) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsRefOfWhere(a.Where, b.Where)
+}
+
 // EqualsRefOfAlterMigration does deep equals between the two objects.
 func EqualsRefOfAlterMigration(a, b *AlterMigration) bool {
 	if a == b {
@@ -1737,6 +1762,18 @@ func EqualsRefOfKeyState(a, b *KeyState) bool {
 	return a.Enable == b.Enable
 }
 
+// EqualsRefOfKill does deep equals between the two objects.
+func EqualsRefOfKill(a, b *Kill) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.ProcesslistID == b.ProcesslistID &&
+		a.Type == b.Type
+}
+
 // EqualsRefOfLimit does deep equals between the two objects.
 func EqualsRefOfLimit(a, b *Limit) bool {
 	if a == b {
@@ -2322,7 +2359,8 @@ func EqualsRefOfStream(a, b *Stream) bool {
 	}
 	return EqualsComments(a.Comments, b.Comments) &&
 		EqualsSelectExpr(a.SelectExpr, b.SelectExpr) &&
-		EqualsTableName(a.Table, b.Table)
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsColIdent(a.Group, b.Group)
 }
 
 // EqualsRefOfSubquery does deep equals between the two objects.
@@ -3391,6 +3429,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfAlterDatabase(a, b)
+	case *AlterDeadLetters:
+		b, ok := inB.(*AlterDeadLetters)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterDeadLetters(a, b)
 	case *AlterMigration:
 		b, ok := inB.(*AlterMigration)
 		if !ok {
@@ -3499,6 +3543,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfInsert(a, b)
+	case *Kill:
+		b, ok := inB.(*Kill)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfKill(a, b)
 	case *Load:
 		b, ok := inB.(*Load)
 		if !ok {
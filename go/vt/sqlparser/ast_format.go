@@ -17,6 +17,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -83,6 +84,9 @@ func (node *VStream) Format(buf *TrackedBuffer) {
 func (node *Stream) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "stream %v%v from %v",
 		node.Comments, node.SelectExpr, node.Table)
+	if !node.Group.IsEmpty() {
+		buf.astPrintf(node, " group %v", node.Group)
+	}
 }
 
 // Format formats the node.
@@ -237,11 +241,28 @@ func (node *AlterMigration) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, " %s", alterType)
 }
 
+// Format formats the node.
+func (node *AlterDeadLetters) Format(buf *TrackedBuffer) {
+	var alterType string
+	switch node.Type {
+	case RequeueDeadLettersType:
+		alterType = "requeue"
+	case PurgeDeadLettersType:
+		alterType = "purge"
+	}
+	buf.astPrintf(node, "alter vitess_dead_letters %v %s%v", node.Table, alterType, node.Where)
+}
+
 // Format formats the node.
 func (node *RevertMigration) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "revert vitess_migration '%s'", node.UUID)
 }
 
+// Format formats the node.
+func (node *Kill) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "kill %s %s", node.Type.ToString(), strconv.FormatUint(node.ProcesslistID, 10))
+}
+
 // Format formats the node.
 func (node *OptLike) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "like %v", node.LikeTable)
@@ -18,6 +18,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -115,6 +116,10 @@ func (node *Stream) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(" from ")
 	node.Table.formatFast(buf)
 
+	if !node.Group.IsEmpty() {
+		buf.WriteString(" group ")
+		node.Group.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -366,6 +371,22 @@ func (node *AlterMigration) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(alterType)
 }
 
+// formatFast formats the node.
+func (node *AlterDeadLetters) formatFast(buf *TrackedBuffer) {
+	var alterType string
+	switch node.Type {
+	case RequeueDeadLettersType:
+		alterType = "requeue"
+	case PurgeDeadLettersType:
+		alterType = "purge"
+	}
+	buf.WriteString("alter vitess_dead_letters ")
+	node.Table.formatFast(buf)
+	buf.WriteByte(' ')
+	buf.WriteString(alterType)
+	node.Where.formatFast(buf)
+}
+
 // formatFast formats the node.
 func (node *RevertMigration) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("revert vitess_migration '")
@@ -373,6 +394,14 @@ func (node *RevertMigration) formatFast(buf *TrackedBuffer) {
 	buf.WriteByte('\'')
 }
 
+// formatFast formats the node.
+func (node *Kill) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("kill ")
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte(' ')
+	buf.WriteString(strconv.FormatUint(node.ProcesslistID, 10))
+}
+
 // formatFast formats the node.
 func (node *OptLike) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("like ")
@@ -1200,6 +1200,18 @@ func (ty LockType) ToString() string {
 	}
 }
 
+// ToString returns the type as a string
+func (ty KillType) ToString() string {
+	switch ty {
+	case ConnectionType:
+		return ConnectionStr
+	case QueryType:
+		return QueryStr
+	default:
+		return "Unknown KillType"
+	}
+}
+
 // ToString returns ShowCommandType as a string
 func (ty ShowCommandType) ToString() string {
 	switch ty {
@@ -1257,6 +1269,12 @@ func (ty ShowCommandType) ToString() string {
 		return VitessMigrationsStr
 	case Keyspace:
 		return KeyspaceStr
+	case Processlist:
+		return ProcesslistStr
+	case VitessPlans:
+		return VitessPlansStr
+	case VitessDeadLetters:
+		return VitessDeadLettersStr
 	default:
 		return "" +
 			"Unknown ShowCommandType"
@@ -42,6 +42,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfAlterColumn(parent, node, replacer)
 	case *AlterDatabase:
 		return a.rewriteRefOfAlterDatabase(parent, node, replacer)
+	case *AlterDeadLetters:
+		return a.rewriteRefOfAlterDeadLetters(parent, node, replacer)
 	case *AlterMigration:
 		return a.rewriteRefOfAlterMigration(parent, node, replacer)
 	case *AlterTable:
@@ -160,6 +162,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfJoinTableExpr(parent, node, replacer)
 	case *KeyState:
 		return a.rewriteRefOfKeyState(parent, node, replacer)
+	case *Kill:
+		return a.rewriteRefOfKill(parent, node, replacer)
 	case *Limit:
 		return a.rewriteRefOfLimit(parent, node, replacer)
 	case ListArg:
@@ -571,6 +575,38 @@ func (a *application) rewriteRefOfAlterDatabase(parent SQLNode, node *AlterDatab
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterDeadLetters(parent SQLNode, node *AlterDeadLetters, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*AlterDeadLetters).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWhere(node, node.Where, func(newNode, parent SQLNode) {
+		parent.(*AlterDeadLetters).Where = newNode.(*Where)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterMigration(parent SQLNode, node *AlterMigration, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -2346,6 +2382,30 @@ func (a *application) rewriteRefOfKeyState(parent SQLNode, node *KeyState, repla
 	}
 	return true
 }
+func (a *application) rewriteRefOfKill(parent SQLNode, node *Kill, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfLimit(parent SQLNode, node *Limit, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -3747,6 +3807,11 @@ func (a *application) rewriteRefOfStream(parent SQLNode, node *Stream, replacer
 	}) {
 		return false
 	}
+	if !a.rewriteColIdent(node, node.Group, func(newNode, parent SQLNode) {
+		parent.(*Stream).Group = newNode.(ColIdent)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4988,6 +5053,8 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 	switch node := node.(type) {
 	case *AlterDatabase:
 		return a.rewriteRefOfAlterDatabase(parent, node, replacer)
+	case *AlterDeadLetters:
+		return a.rewriteRefOfAlterDeadLetters(parent, node, replacer)
 	case *AlterMigration:
 		return a.rewriteRefOfAlterMigration(parent, node, replacer)
 	case *AlterTable:
@@ -5024,6 +5091,8 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfFlush(parent, node, replacer)
 	case *Insert:
 		return a.rewriteRefOfInsert(parent, node, replacer)
+	case *Kill:
+		return a.rewriteRefOfKill(parent, node, replacer)
 	case *Load:
 		return a.rewriteRefOfLoad(parent, node, replacer)
 	case *LockTables:
@@ -248,6 +248,7 @@ func (er *expressionRewriter) sysVarRewrite(cursor *Cursor, node *ColName) {
 		sysvars.SessionEnableSystemSettings.Name,
 		sysvars.SessionTrackGTIDs.Name,
 		sysvars.SessionUUID.Name,
+		sysvars.Priority.Name,
 		sysvars.SkipQueryPlanCache.Name,
 		sysvars.Socket.Name,
 		sysvars.SQLSelectLimit.Name,
@@ -42,6 +42,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfAlterColumn(in, f)
 	case *AlterDatabase:
 		return VisitRefOfAlterDatabase(in, f)
+	case *AlterDeadLetters:
+		return VisitRefOfAlterDeadLetters(in, f)
 	case *AlterMigration:
 		return VisitRefOfAlterMigration(in, f)
 	case *AlterTable:
@@ -160,6 +162,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfJoinTableExpr(in, f)
 	case *KeyState:
 		return VisitRefOfKeyState(in, f)
+	case *Kill:
+		return VisitRefOfKill(in, f)
 	case *Limit:
 		return VisitRefOfLimit(in, f)
 	case ListArg:
@@ -435,6 +439,21 @@ func VisitRefOfAlterDatabase(in *AlterDatabase, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterDeadLetters(in *AlterDeadLetters, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWhere(in.Where, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAlterMigration(in *AlterMigration, f Visit) error {
 	if in == nil {
 		return nil
@@ -1245,6 +1264,15 @@ func VisitRefOfKeyState(in *KeyState, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfKill(in *Kill, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
 func VisitRefOfLimit(in *Limit, f Visit) error {
 	if in == nil {
 		return nil
@@ -1870,6 +1898,9 @@ func VisitRefOfStream(in *Stream, f Visit) error {
 	if err := VisitTableName(in.Table, f); err != nil {
 		return err
 	}
+	if err := VisitColIdent(in.Group, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfSubquery(in *Subquery, f Visit) error {
@@ -2593,6 +2624,8 @@ func VisitStatement(in Statement, f Visit) error {
 	switch in := in.(type) {
 	case *AlterDatabase:
 		return VisitRefOfAlterDatabase(in, f)
+	case *AlterDeadLetters:
+		return VisitRefOfAlterDeadLetters(in, f)
 	case *AlterMigration:
 		return VisitRefOfAlterMigration(in, f)
 	case *AlterTable:
@@ -2629,6 +2662,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfFlush(in, f)
 	case *Insert:
 		return VisitRefOfInsert(in, f)
+	case *Kill:
+		return VisitRefOfKill(in, f)
 	case *Load:
 		return VisitRefOfLoad(in, f)
 	case *LockTables:
@@ -156,6 +156,20 @@ func (cached *AlterDatabase) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *AlterDeadLetters) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Where *vitess.io/vitess/go/vt/sqlparser.Where
+	size += cached.Where.CachedSize(true)
+	return size
+}
 func (cached *AlterMigration) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1213,6 +1227,16 @@ func (cached *KeyState) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Kill) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	return size
+}
 func (cached *Limit) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1887,7 +1911,7 @@ func (cached *Stream) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(72)
+		size += int64(112)
 	}
 	// field Comments vitess.io/vitess/go/vt/sqlparser.Comments
 	{
@@ -1902,6 +1926,8 @@ func (cached *Stream) CachedSize(alloc bool) int64 {
 	}
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
+	// field Group vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Group.CachedSize(false)
 	return size
 }
 func (cached *Subquery) CachedSize(alloc bool) int64 {
@@ -20,6 +20,8 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 const (
@@ -36,6 +38,11 @@ const (
 	DirectiveIgnoreMaxPayloadSize = "IGNORE_MAX_PAYLOAD_SIZE"
 	// DirectiveIgnoreMaxMemoryRows skips memory row validation when set.
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
+	// DirectiveResultCache allows vtgate to cache the results of a select query.
+	DirectiveResultCache = "RESULT_CACHE"
+	// DirectivePriority sets the priority class of a query in the tablets:
+	// HIGH, NORMAL or LOW.
+	DirectivePriority = "PRIORITY"
 )
 
 func isNonSpace(r rune) bool {
@@ -347,3 +354,50 @@ func IgnoreMaxMaxMemoryRowsDirective(stmt Statement) bool {
 		return false
 	}
 }
+
+// ResultCacheDirective returns true if the result cache directive is set to
+// true in a select query. For a union, the directive is read from the first
+// select.
+func ResultCacheDirective(stmt Statement) bool {
+	switch stmt := stmt.(type) {
+	case *Select:
+		directives := ExtractCommentDirectives(stmt.Comments)
+		return directives.IsSet(DirectiveResultCache)
+	case *Union:
+		return ResultCacheDirective(stmt.FirstStatement)
+	case *ParenSelect:
+		return ResultCacheDirective(stmt.Select)
+	default:
+		return false
+	}
+}
+
+// PriorityDirective returns the priority class set by the priority
+// directive of a query, and whether the directive is set to a valid
+// priority. For a union, the directive is read from the first select.
+func PriorityDirective(stmt Statement) (querypb.ExecuteOptions_Priority, bool) {
+	var comments Comments
+	switch stmt := stmt.(type) {
+	case *Select:
+		comments = stmt.Comments
+	case *Union:
+		return PriorityDirective(stmt.FirstStatement)
+	case *ParenSelect:
+		return PriorityDirective(stmt.Select)
+	case *Insert:
+		comments = stmt.Comments
+	case *Update:
+		comments = stmt.Comments
+	case *Delete:
+		comments = stmt.Comments
+	default:
+		return querypb.ExecuteOptions_NORMAL, false
+	}
+	directives := ExtractCommentDirectives(comments)
+	val, ok := directives[DirectivePriority].(string)
+	if !ok {
+		return querypb.ExecuteOptions_NORMAL, false
+	}
+	priority, ok := querypb.ExecuteOptions_Priority_value[strings.ToUpper(val)]
+	return querypb.ExecuteOptions_Priority(priority), ok
+}
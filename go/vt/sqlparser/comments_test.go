@@ -22,6 +22,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 func TestSplitComments(t *testing.T) {
@@ -440,3 +443,55 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestResultCacheDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected bool
+	}{
+		{"select /*vt+ RESULT_CACHE */ * from users", true},
+		{"select /*vt+ RESULT_CACHE=0 */ * from users", false},
+		{"select * from users", false},
+		{"select /*vt+ RESULT_CACHE */ id from users union select id from admins", true},
+		{"select id from users union select /*vt+ RESULT_CACHE */ id from admins", false},
+		{"update /*vt+ RESULT_CACHE */ users set name=1", false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ResultCacheDirective(stmt))
+		})
+	}
+}
+
+func TestPriorityDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		priority querypb.ExecuteOptions_Priority
+		ok       bool
+	}{
+		{"select /*vt+ PRIORITY=low */ * from users", querypb.ExecuteOptions_LOW, true},
+		{"select /*vt+ PRIORITY=HIGH */ * from users", querypb.ExecuteOptions_HIGH, true},
+		{"select /*vt+ PRIORITY=normal */ * from users", querypb.ExecuteOptions_NORMAL, true},
+		{"select /*vt+ PRIORITY=urgent */ * from users", querypb.ExecuteOptions_NORMAL, false},
+		{"select /*vt+ PRIORITY */ * from users", querypb.ExecuteOptions_NORMAL, false},
+		{"select * from users", querypb.ExecuteOptions_NORMAL, false},
+		{"select /*vt+ PRIORITY=low */ id from users union select id from admins", querypb.ExecuteOptions_LOW, true},
+		{"insert /*vt+ PRIORITY=low */ into users(id) values (1)", querypb.ExecuteOptions_LOW, true},
+		{"update /*vt+ PRIORITY=low */ users set name=1", querypb.ExecuteOptions_LOW, true},
+		{"delete /*vt+ PRIORITY=low */ from users", querypb.ExecuteOptions_LOW, true},
+		{"show /*vt+ PRIORITY=low */ create table users", querypb.ExecuteOptions_NORMAL, false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			priority, ok := PriorityDirective(stmt)
+			assert.Equal(t, test.priority, priority)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
@@ -210,34 +210,41 @@ const (
 	WriteStr            = "write"
 	LowPriorityWriteStr = "low_priority write"
 
+	// Kill Types
+	ConnectionStr = "connection"
+	QueryStr      = "query"
+
 	// ShowCommand Types
-	CharsetStr          = " charset"
-	CollationStr        = " collation"
-	ColumnStr           = " columns"
-	CreateDbStr         = " create database"
-	CreateEStr          = " create event"
-	CreateFStr          = " create function"
-	CreateProcStr       = " create procedure"
-	CreateTblStr        = " create table"
-	CreateTrStr         = " create trigger"
-	CreateVStr          = " create view"
-	DatabaseStr         = " databases"
-	FunctionCStr        = " function code"
-	FunctionStr         = " function status"
-	IndexStr            = " indexes"
-	OpenTableStr        = " open tables"
-	PrivilegeStr        = " privileges"
-	ProcedureCStr       = " procedure code"
-	ProcedureStr        = " procedure status"
-	StatusGlobalStr     = " global status"
-	StatusSessionStr    = " status"
-	TableStr            = " tables"
-	TableStatusStr      = " table status"
-	TriggerStr          = " triggers"
-	VariableGlobalStr   = " global variables"
-	VariableSessionStr  = " variables"
-	KeyspaceStr         = " keyspaces"
-	VitessMigrationsStr = " vitess_migrations"
+	CharsetStr           = " charset"
+	CollationStr         = " collation"
+	ColumnStr            = " columns"
+	CreateDbStr          = " create database"
+	CreateEStr           = " create event"
+	CreateFStr           = " create function"
+	CreateProcStr        = " create procedure"
+	CreateTblStr         = " create table"
+	CreateTrStr          = " create trigger"
+	CreateVStr           = " create view"
+	DatabaseStr          = " databases"
+	FunctionCStr         = " function code"
+	FunctionStr          = " function status"
+	IndexStr             = " indexes"
+	OpenTableStr         = " open tables"
+	PrivilegeStr         = " privileges"
+	ProcedureCStr        = " procedure code"
+	ProcedureStr         = " procedure status"
+	StatusGlobalStr      = " global status"
+	StatusSessionStr     = " status"
+	TableStr             = " tables"
+	TableStatusStr       = " table status"
+	TriggerStr           = " triggers"
+	VariableGlobalStr    = " global variables"
+	VariableSessionStr   = " variables"
+	KeyspaceStr          = " keyspaces"
+	VitessMigrationsStr  = " vitess_migrations"
+	ProcesslistStr       = " processlist"
+	VitessPlansStr       = " vitess_plans"
+	VitessDeadLettersStr = " vitess_dead_letters"
 
 	// DropKeyType strings
 	PrimaryKeyTypeStr = "primary key"
@@ -465,6 +472,12 @@ const (
 	LowPriorityWrite
 )
 
+// KillType constants
+const (
+	ConnectionType KillType = iota
+	QueryType
+)
+
 // ShowCommandType constants
 const (
 	UnknownCommandType ShowCommandType = iota
@@ -495,6 +508,9 @@ const (
 	VariableSession
 	VitessMigrations
 	Keyspace
+	Processlist
+	VitessPlans
+	VitessDeadLetters
 )
 
 // DropKeyType constants
@@ -518,3 +534,9 @@ const (
 	CancelMigrationType
 	CancelAllMigrationType
 )
+
+// AlterDeadLettersType constants
+const (
+	RequeueDeadLettersType AlterDeadLettersType = iota
+	PurgeDeadLettersType
+)
@@ -251,7 +251,7 @@ var keywords = []keyword{
 	{"keys", KEYS},
 	{"keyspaces", KEYSPACES},
 	{"key_block_size", KEY_BLOCK_SIZE},
-	{"kill", UNUSED},
+	{"kill", KILL},
 	{"last", LAST},
 	{"language", LANGUAGE},
 	{"last_insert_id", LAST_INSERT_ID},
@@ -336,6 +336,7 @@ var keywords = []keyword{
 	{"privileges", PRIVILEGES},
 	{"processlist", PROCESSLIST},
 	{"procedure", PROCEDURE},
+	{"purge", PURGE},
 	{"query", QUERY},
 	{"range", UNUSED},
 	{"read", READ},
@@ -355,6 +356,7 @@ var keywords = []keyword{
 	{"repeat", UNUSED},
 	{"repeatable", REPEATABLE},
 	{"replace", REPLACE},
+	{"requeue", REQUEUE},
 	{"require", UNUSED},
 	{"resignal", UNUSED},
 	{"restrict", RESTRICT},
@@ -469,6 +471,8 @@ var keywords = []keyword{
 	{"vitess_tablets", VITESS_TABLETS},
 	{"vitess_migration", VITESS_MIGRATION},
 	{"vitess_migrations", VITESS_MIGRATIONS},
+	{"vitess_plans", VITESS_PLANS},
+	{"vitess_dead_letters", VITESS_DEAD_LETTERS},
 	{"vschema", VSCHEMA},
 	{"warnings", WARNINGS},
 	{"when", WHEN},
@@ -1429,8 +1429,12 @@ var (
 		input:  "show processlist",
 		output: "show processlist",
 	}, {
-		input:  "show full processlist",
-		output: "show processlist",
+		input: "show full processlist",
+	}, {
+		input:  "show processlist like 'a%'",
+		output: "show processlist like 'a%'",
+	}, {
+		input: "show full processlist where command = 'Query'",
 	}, {
 		input:  "show profile cpu for query 1",
 		output: "show profile",
@@ -1550,8 +1554,34 @@ var (
 		input: `show vitess_migrations from ks like '%pattern'`,
 	}, {
 		input: "show vitess_migrations like '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90'",
+	}, {
+		input: "show vitess_plans",
+	}, {
+		input: "show vitess_plans like 'select%'",
+	}, {
+		input: "show vitess_plans where Errors > 10",
+	}, {
+		input: "show vitess_dead_letters from msg",
+	}, {
+		input: "show vitess_dead_letters from ks.msg where time_dead < 1000",
+	}, {
+		input: "alter vitess_dead_letters msg requeue",
+	}, {
+		input: "alter vitess_dead_letters ks.msg requeue where id in (1, 2)",
+	}, {
+		input: "alter vitess_dead_letters msg purge where time_dead < 1000",
+	}, {
+		input:  "select purge, requeue from t",
+		output: "select `purge`, `requeue` from t",
 	}, {
 		input: "revert vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90'",
+	}, {
+		input:  "kill 12",
+		output: "kill connection 12",
+	}, {
+		input: "kill connection 12",
+	}, {
+		input: "kill query 12",
 	}, {
 		input: "alter vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' retry",
 	}, {
@@ -1777,6 +1807,11 @@ var (
 		input: "vstream * from t",
 	}, {
 		input: "stream /* comment */ * from t",
+	}, {
+		input: "stream * from t group g1",
+	}, {
+		input:  "stream * from t GROUP `G1`",
+		output: "stream * from t group G1",
 	}, {
 		input: "begin",
 	}, {
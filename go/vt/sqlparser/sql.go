@@ -10,6 +10,8 @@ import (
 
 //line sql.y:18
 
+import "strconv"
+
 func setParseTree(yylex yyLexer, stmt Statement) {
 	yylex.(*Tokenizer).ParseTree = stmt
 }
@@ -187,317 +189,322 @@ const FLUSH = 57484
 const CHANGE = 57485
 const MODIFY = 57486
 const REVERT = 57487
-const SCHEMA = 57488
-const TABLE = 57489
-const INDEX = 57490
-const VIEW = 57491
-const TO = 57492
-const IGNORE = 57493
-const IF = 57494
-const UNIQUE = 57495
-const PRIMARY = 57496
-const COLUMN = 57497
-const SPATIAL = 57498
-const FULLTEXT = 57499
-const KEY_BLOCK_SIZE = 57500
-const CHECK = 57501
-const INDEXES = 57502
-const ACTION = 57503
-const CASCADE = 57504
-const CONSTRAINT = 57505
-const FOREIGN = 57506
-const NO = 57507
-const REFERENCES = 57508
-const RESTRICT = 57509
-const SHOW = 57510
-const DESCRIBE = 57511
-const EXPLAIN = 57512
-const DATE = 57513
-const ESCAPE = 57514
-const REPAIR = 57515
-const OPTIMIZE = 57516
-const TRUNCATE = 57517
-const COALESCE = 57518
-const EXCHANGE = 57519
-const REBUILD = 57520
-const PARTITIONING = 57521
-const REMOVE = 57522
-const MAXVALUE = 57523
-const PARTITION = 57524
-const REORGANIZE = 57525
-const LESS = 57526
-const THAN = 57527
-const PROCEDURE = 57528
-const TRIGGER = 57529
-const VINDEX = 57530
-const VINDEXES = 57531
-const DIRECTORY = 57532
-const NAME = 57533
-const UPGRADE = 57534
-const STATUS = 57535
-const VARIABLES = 57536
-const WARNINGS = 57537
-const CASCADED = 57538
-const DEFINER = 57539
-const OPTION = 57540
-const SQL = 57541
-const UNDEFINED = 57542
-const SEQUENCE = 57543
-const MERGE = 57544
-const TEMPORARY = 57545
-const TEMPTABLE = 57546
-const INVOKER = 57547
-const SECURITY = 57548
-const FIRST = 57549
-const AFTER = 57550
-const LAST = 57551
-const VITESS_MIGRATION = 57552
-const CANCEL = 57553
-const RETRY = 57554
-const COMPLETE = 57555
-const BEGIN = 57556
-const START = 57557
-const TRANSACTION = 57558
-const COMMIT = 57559
-const ROLLBACK = 57560
-const SAVEPOINT = 57561
-const RELEASE = 57562
-const WORK = 57563
-const BIT = 57564
-const TINYINT = 57565
-const SMALLINT = 57566
-const MEDIUMINT = 57567
-const INT = 57568
-const INTEGER = 57569
-const BIGINT = 57570
-const INTNUM = 57571
-const REAL = 57572
-const DOUBLE = 57573
-const FLOAT_TYPE = 57574
-const DECIMAL = 57575
-const NUMERIC = 57576
-const TIME = 57577
-const TIMESTAMP = 57578
-const DATETIME = 57579
-const YEAR = 57580
-const CHAR = 57581
-const VARCHAR = 57582
-const BOOL = 57583
-const CHARACTER = 57584
-const VARBINARY = 57585
-const NCHAR = 57586
-const TEXT = 57587
-const TINYTEXT = 57588
-const MEDIUMTEXT = 57589
-const LONGTEXT = 57590
-const BLOB = 57591
-const TINYBLOB = 57592
-const MEDIUMBLOB = 57593
-const LONGBLOB = 57594
-const JSON = 57595
-const ENUM = 57596
-const GEOMETRY = 57597
-const POINT = 57598
-const LINESTRING = 57599
-const POLYGON = 57600
-const GEOMETRYCOLLECTION = 57601
-const MULTIPOINT = 57602
-const MULTILINESTRING = 57603
-const MULTIPOLYGON = 57604
-const NULLX = 57605
-const AUTO_INCREMENT = 57606
-const APPROXNUM = 57607
-const SIGNED = 57608
-const UNSIGNED = 57609
-const ZEROFILL = 57610
-const COLLATION = 57611
-const DATABASES = 57612
-const SCHEMAS = 57613
-const TABLES = 57614
-const VITESS_METADATA = 57615
-const VSCHEMA = 57616
-const FULL = 57617
-const PROCESSLIST = 57618
-const COLUMNS = 57619
-const FIELDS = 57620
-const ENGINES = 57621
-const PLUGINS = 57622
-const EXTENDED = 57623
-const KEYSPACES = 57624
-const VITESS_KEYSPACES = 57625
-const VITESS_SHARDS = 57626
-const VITESS_TABLETS = 57627
-const VITESS_MIGRATIONS = 57628
-const CODE = 57629
-const PRIVILEGES = 57630
-const FUNCTION = 57631
-const OPEN = 57632
-const TRIGGERS = 57633
-const EVENT = 57634
-const USER = 57635
-const NAMES = 57636
-const CHARSET = 57637
-const GLOBAL = 57638
-const SESSION = 57639
-const ISOLATION = 57640
-const LEVEL = 57641
-const READ = 57642
-const WRITE = 57643
-const ONLY = 57644
-const REPEATABLE = 57645
-const COMMITTED = 57646
-const UNCOMMITTED = 57647
-const SERIALIZABLE = 57648
-const CURRENT_TIMESTAMP = 57649
-const DATABASE = 57650
-const CURRENT_DATE = 57651
-const CURRENT_TIME = 57652
-const LOCALTIME = 57653
-const LOCALTIMESTAMP = 57654
-const CURRENT_USER = 57655
-const UTC_DATE = 57656
-const UTC_TIME = 57657
-const UTC_TIMESTAMP = 57658
-const REPLACE = 57659
-const CONVERT = 57660
-const CAST = 57661
-const SUBSTR = 57662
-const SUBSTRING = 57663
-const GROUP_CONCAT = 57664
-const SEPARATOR = 57665
-const TIMESTAMPADD = 57666
-const TIMESTAMPDIFF = 57667
-const MATCH = 57668
-const AGAINST = 57669
-const BOOLEAN = 57670
-const LANGUAGE = 57671
-const WITH = 57672
-const QUERY = 57673
-const EXPANSION = 57674
-const WITHOUT = 57675
-const VALIDATION = 57676
-const UNUSED = 57677
-const ARRAY = 57678
-const CUME_DIST = 57679
-const DESCRIPTION = 57680
-const DENSE_RANK = 57681
-const EMPTY = 57682
-const EXCEPT = 57683
-const FIRST_VALUE = 57684
-const GROUPING = 57685
-const GROUPS = 57686
-const JSON_TABLE = 57687
-const LAG = 57688
-const LAST_VALUE = 57689
-const LATERAL = 57690
-const LEAD = 57691
-const MEMBER = 57692
-const NTH_VALUE = 57693
-const NTILE = 57694
-const OF = 57695
-const OVER = 57696
-const PERCENT_RANK = 57697
-const RANK = 57698
-const RECURSIVE = 57699
-const ROW_NUMBER = 57700
-const SYSTEM = 57701
-const WINDOW = 57702
-const ACTIVE = 57703
-const ADMIN = 57704
-const BUCKETS = 57705
-const CLONE = 57706
-const COMPONENT = 57707
-const DEFINITION = 57708
-const ENFORCED = 57709
-const EXCLUDE = 57710
-const FOLLOWING = 57711
-const GEOMCOLLECTION = 57712
-const GET_MASTER_PUBLIC_KEY = 57713
-const HISTOGRAM = 57714
-const HISTORY = 57715
-const INACTIVE = 57716
-const INVISIBLE = 57717
-const LOCKED = 57718
-const MASTER_COMPRESSION_ALGORITHMS = 57719
-const MASTER_PUBLIC_KEY_PATH = 57720
-const MASTER_TLS_CIPHERSUITES = 57721
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57722
-const NESTED = 57723
-const NETWORK_NAMESPACE = 57724
-const NOWAIT = 57725
-const NULLS = 57726
-const OJ = 57727
-const OLD = 57728
-const OPTIONAL = 57729
-const ORDINALITY = 57730
-const ORGANIZATION = 57731
-const OTHERS = 57732
-const PATH = 57733
-const PERSIST = 57734
-const PERSIST_ONLY = 57735
-const PRECEDING = 57736
-const PRIVILEGE_CHECKS_USER = 57737
-const PROCESS = 57738
-const RANDOM = 57739
-const REFERENCE = 57740
-const REQUIRE_ROW_FORMAT = 57741
-const RESOURCE = 57742
-const RESPECT = 57743
-const RESTART = 57744
-const RETAIN = 57745
-const REUSE = 57746
-const ROLE = 57747
-const SECONDARY = 57748
-const SECONDARY_ENGINE = 57749
-const SECONDARY_LOAD = 57750
-const SECONDARY_UNLOAD = 57751
-const SKIP = 57752
-const SRID = 57753
-const THREAD_PRIORITY = 57754
-const TIES = 57755
-const UNBOUNDED = 57756
-const VCPU = 57757
-const VISIBLE = 57758
-const FORMAT = 57759
-const TREE = 57760
-const VITESS = 57761
-const TRADITIONAL = 57762
-const LOCAL = 57763
-const LOW_PRIORITY = 57764
-const NO_WRITE_TO_BINLOG = 57765
-const LOGS = 57766
-const ERROR = 57767
-const GENERAL = 57768
-const HOSTS = 57769
-const OPTIMIZER_COSTS = 57770
-const USER_RESOURCES = 57771
-const SLOW = 57772
-const CHANNEL = 57773
-const RELAY = 57774
-const EXPORT = 57775
-const AVG_ROW_LENGTH = 57776
-const CONNECTION = 57777
-const CHECKSUM = 57778
-const DELAY_KEY_WRITE = 57779
-const ENCRYPTION = 57780
-const ENGINE = 57781
-const INSERT_METHOD = 57782
-const MAX_ROWS = 57783
-const MIN_ROWS = 57784
-const PACK_KEYS = 57785
-const PASSWORD = 57786
-const FIXED = 57787
-const DYNAMIC = 57788
-const COMPRESSED = 57789
-const REDUNDANT = 57790
-const COMPACT = 57791
-const ROW_FORMAT = 57792
-const STATS_AUTO_RECALC = 57793
-const STATS_PERSISTENT = 57794
-const STATS_SAMPLE_PAGES = 57795
-const STORAGE = 57796
-const MEMORY = 57797
-const DISK = 57798
+const KILL = 57488
+const SCHEMA = 57489
+const TABLE = 57490
+const INDEX = 57491
+const VIEW = 57492
+const TO = 57493
+const IGNORE = 57494
+const IF = 57495
+const UNIQUE = 57496
+const PRIMARY = 57497
+const COLUMN = 57498
+const SPATIAL = 57499
+const FULLTEXT = 57500
+const KEY_BLOCK_SIZE = 57501
+const CHECK = 57502
+const INDEXES = 57503
+const ACTION = 57504
+const CASCADE = 57505
+const CONSTRAINT = 57506
+const FOREIGN = 57507
+const NO = 57508
+const REFERENCES = 57509
+const RESTRICT = 57510
+const SHOW = 57511
+const DESCRIBE = 57512
+const EXPLAIN = 57513
+const DATE = 57514
+const ESCAPE = 57515
+const REPAIR = 57516
+const OPTIMIZE = 57517
+const TRUNCATE = 57518
+const COALESCE = 57519
+const EXCHANGE = 57520
+const REBUILD = 57521
+const PARTITIONING = 57522
+const REMOVE = 57523
+const MAXVALUE = 57524
+const PARTITION = 57525
+const REORGANIZE = 57526
+const LESS = 57527
+const THAN = 57528
+const PROCEDURE = 57529
+const TRIGGER = 57530
+const VINDEX = 57531
+const VINDEXES = 57532
+const DIRECTORY = 57533
+const NAME = 57534
+const UPGRADE = 57535
+const STATUS = 57536
+const VARIABLES = 57537
+const WARNINGS = 57538
+const CASCADED = 57539
+const DEFINER = 57540
+const OPTION = 57541
+const SQL = 57542
+const UNDEFINED = 57543
+const SEQUENCE = 57544
+const MERGE = 57545
+const TEMPORARY = 57546
+const TEMPTABLE = 57547
+const INVOKER = 57548
+const SECURITY = 57549
+const FIRST = 57550
+const AFTER = 57551
+const LAST = 57552
+const VITESS_MIGRATION = 57553
+const CANCEL = 57554
+const RETRY = 57555
+const COMPLETE = 57556
+const VITESS_DEAD_LETTERS = 57557
+const REQUEUE = 57558
+const PURGE = 57559
+const BEGIN = 57560
+const START = 57561
+const TRANSACTION = 57562
+const COMMIT = 57563
+const ROLLBACK = 57564
+const SAVEPOINT = 57565
+const RELEASE = 57566
+const WORK = 57567
+const BIT = 57568
+const TINYINT = 57569
+const SMALLINT = 57570
+const MEDIUMINT = 57571
+const INT = 57572
+const INTEGER = 57573
+const BIGINT = 57574
+const INTNUM = 57575
+const REAL = 57576
+const DOUBLE = 57577
+const FLOAT_TYPE = 57578
+const DECIMAL = 57579
+const NUMERIC = 57580
+const TIME = 57581
+const TIMESTAMP = 57582
+const DATETIME = 57583
+const YEAR = 57584
+const CHAR = 57585
+const VARCHAR = 57586
+const BOOL = 57587
+const CHARACTER = 57588
+const VARBINARY = 57589
+const NCHAR = 57590
+const TEXT = 57591
+const TINYTEXT = 57592
+const MEDIUMTEXT = 57593
+const LONGTEXT = 57594
+const BLOB = 57595
+const TINYBLOB = 57596
+const MEDIUMBLOB = 57597
+const LONGBLOB = 57598
+const JSON = 57599
+const ENUM = 57600
+const GEOMETRY = 57601
+const POINT = 57602
+const LINESTRING = 57603
+const POLYGON = 57604
+const GEOMETRYCOLLECTION = 57605
+const MULTIPOINT = 57606
+const MULTILINESTRING = 57607
+const MULTIPOLYGON = 57608
+const NULLX = 57609
+const AUTO_INCREMENT = 57610
+const APPROXNUM = 57611
+const SIGNED = 57612
+const UNSIGNED = 57613
+const ZEROFILL = 57614
+const COLLATION = 57615
+const DATABASES = 57616
+const SCHEMAS = 57617
+const TABLES = 57618
+const VITESS_METADATA = 57619
+const VSCHEMA = 57620
+const FULL = 57621
+const PROCESSLIST = 57622
+const COLUMNS = 57623
+const FIELDS = 57624
+const ENGINES = 57625
+const PLUGINS = 57626
+const EXTENDED = 57627
+const KEYSPACES = 57628
+const VITESS_KEYSPACES = 57629
+const VITESS_SHARDS = 57630
+const VITESS_TABLETS = 57631
+const VITESS_MIGRATIONS = 57632
+const VITESS_PLANS = 57633
+const CODE = 57634
+const PRIVILEGES = 57635
+const FUNCTION = 57636
+const OPEN = 57637
+const TRIGGERS = 57638
+const EVENT = 57639
+const USER = 57640
+const NAMES = 57641
+const CHARSET = 57642
+const GLOBAL = 57643
+const SESSION = 57644
+const ISOLATION = 57645
+const LEVEL = 57646
+const READ = 57647
+const WRITE = 57648
+const ONLY = 57649
+const REPEATABLE = 57650
+const COMMITTED = 57651
+const UNCOMMITTED = 57652
+const SERIALIZABLE = 57653
+const CURRENT_TIMESTAMP = 57654
+const DATABASE = 57655
+const CURRENT_DATE = 57656
+const CURRENT_TIME = 57657
+const LOCALTIME = 57658
+const LOCALTIMESTAMP = 57659
+const CURRENT_USER = 57660
+const UTC_DATE = 57661
+const UTC_TIME = 57662
+const UTC_TIMESTAMP = 57663
+const REPLACE = 57664
+const CONVERT = 57665
+const CAST = 57666
+const SUBSTR = 57667
+const SUBSTRING = 57668
+const GROUP_CONCAT = 57669
+const SEPARATOR = 57670
+const TIMESTAMPADD = 57671
+const TIMESTAMPDIFF = 57672
+const MATCH = 57673
+const AGAINST = 57674
+const BOOLEAN = 57675
+const LANGUAGE = 57676
+const WITH = 57677
+const QUERY = 57678
+const EXPANSION = 57679
+const WITHOUT = 57680
+const VALIDATION = 57681
+const UNUSED = 57682
+const ARRAY = 57683
+const CUME_DIST = 57684
+const DESCRIPTION = 57685
+const DENSE_RANK = 57686
+const EMPTY = 57687
+const EXCEPT = 57688
+const FIRST_VALUE = 57689
+const GROUPING = 57690
+const GROUPS = 57691
+const JSON_TABLE = 57692
+const LAG = 57693
+const LAST_VALUE = 57694
+const LATERAL = 57695
+const LEAD = 57696
+const MEMBER = 57697
+const NTH_VALUE = 57698
+const NTILE = 57699
+const OF = 57700
+const OVER = 57701
+const PERCENT_RANK = 57702
+const RANK = 57703
+const RECURSIVE = 57704
+const ROW_NUMBER = 57705
+const SYSTEM = 57706
+const WINDOW = 57707
+const ACTIVE = 57708
+const ADMIN = 57709
+const BUCKETS = 57710
+const CLONE = 57711
+const COMPONENT = 57712
+const DEFINITION = 57713
+const ENFORCED = 57714
+const EXCLUDE = 57715
+const FOLLOWING = 57716
+const GEOMCOLLECTION = 57717
+const GET_MASTER_PUBLIC_KEY = 57718
+const HISTOGRAM = 57719
+const HISTORY = 57720
+const INACTIVE = 57721
+const INVISIBLE = 57722
+const LOCKED = 57723
+const MASTER_COMPRESSION_ALGORITHMS = 57724
+const MASTER_PUBLIC_KEY_PATH = 57725
+const MASTER_TLS_CIPHERSUITES = 57726
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57727
+const NESTED = 57728
+const NETWORK_NAMESPACE = 57729
+const NOWAIT = 57730
+const NULLS = 57731
+const OJ = 57732
+const OLD = 57733
+const OPTIONAL = 57734
+const ORDINALITY = 57735
+const ORGANIZATION = 57736
+const OTHERS = 57737
+const PATH = 57738
+const PERSIST = 57739
+const PERSIST_ONLY = 57740
+const PRECEDING = 57741
+const PRIVILEGE_CHECKS_USER = 57742
+const PROCESS = 57743
+const RANDOM = 57744
+const REFERENCE = 57745
+const REQUIRE_ROW_FORMAT = 57746
+const RESOURCE = 57747
+const RESPECT = 57748
+const RESTART = 57749
+const RETAIN = 57750
+const REUSE = 57751
+const ROLE = 57752
+const SECONDARY = 57753
+const SECONDARY_ENGINE = 57754
+const SECONDARY_LOAD = 57755
+const SECONDARY_UNLOAD = 57756
+const SKIP = 57757
+const SRID = 57758
+const THREAD_PRIORITY = 57759
+const TIES = 57760
+const UNBOUNDED = 57761
+const VCPU = 57762
+const VISIBLE = 57763
+const FORMAT = 57764
+const TREE = 57765
+const VITESS = 57766
+const TRADITIONAL = 57767
+const LOCAL = 57768
+const LOW_PRIORITY = 57769
+const NO_WRITE_TO_BINLOG = 57770
+const LOGS = 57771
+const ERROR = 57772
+const GENERAL = 57773
+const HOSTS = 57774
+const OPTIMIZER_COSTS = 57775
+const USER_RESOURCES = 57776
+const SLOW = 57777
+const CHANNEL = 57778
+const RELAY = 57779
+const EXPORT = 57780
+const AVG_ROW_LENGTH = 57781
+const CONNECTION = 57782
+const CHECKSUM = 57783
+const DELAY_KEY_WRITE = 57784
+const ENCRYPTION = 57785
+const ENGINE = 57786
+const INSERT_METHOD = 57787
+const MAX_ROWS = 57788
+const MIN_ROWS = 57789
+const PACK_KEYS = 57790
+const PASSWORD = 57791
+const FIXED = 57792
+const DYNAMIC = 57793
+const COMPRESSED = 57794
+const REDUNDANT = 57795
+const COMPACT = 57796
+const ROW_FORMAT = 57797
+const STATS_AUTO_RECALC = 57798
+const STATS_PERSISTENT = 57799
+const STATS_SAMPLE_PAGES = 57800
+const STORAGE = 57801
+const MEMORY = 57802
+const DISK = 57803
 
 var yyToknames = [...]string{
 	"$end",
@@ -662,6 +669,7 @@ var yyToknames = [...]string{
 	"CHANGE",
 	"MODIFY",
 	"REVERT",
+	"KILL",
 	"SCHEMA",
 	"TABLE",
 	"INDEX",
@@ -730,6 +738,9 @@ var yyToknames = [...]string{
 	"CANCEL",
 	"RETRY",
 	"COMPLETE",
+	"VITESS_DEAD_LETTERS",
+	"REQUEUE",
+	"PURGE",
 	"BEGIN",
 	"START",
 	"TRANSACTION",
@@ -803,6 +814,7 @@ var yyToknames = [...]string{
 	"VITESS_SHARDS",
 	"VITESS_TABLETS",
 	"VITESS_MIGRATIONS",
+	"VITESS_PLANS",
 	"CODE",
 	"PRIVILEGES",
 	"FUNCTION",
@@ -987,624 +999,2958 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 44,
-	164, 934,
-	-2, 91,
 	-1, 45,
-	1, 112,
-	474, 112,
-	-2, 118,
+	165, 944,
+	-2, 93,
 	-1, 46,
-	143, 118,
-	259, 118,
-	312, 118,
-	-2, 326,
-	-1, 53,
-	34, 472,
-	165, 472,
-	177, 472,
-	210, 486,
-	211, 486,
-	-2, 474,
-	-1, 58,
-	167, 496,
-	-2, 494,
-	-1, 84,
-	56, 564,
-	-2, 572,
-	-1, 109,
-	1, 113,
-	474, 113,
-	-2, 118,
-	-1, 119,
-	170, 231,
-	171, 231,
-	-2, 320,
-	-1, 138,
-	143, 118,
-	259, 118,
-	312, 118,
-	-2, 335,
-	-1, 579,
-	150, 955,
-	-2, 951,
-	-1, 580,
-	150, 956,
-	-2, 952,
-	-1, 599,
-	56, 565,
-	-2, 577,
-	-1, 600,
-	56, 566,
-	-2, 578,
-	-1, 621,
-	118, 1299,
-	-2, 84,
-	-1, 622,
-	118, 1181,
-	-2, 85,
-	-1, 628,
-	118, 1231,
-	-2, 928,
-	-1, 766,
-	118, 1118,
-	-2, 925,
-	-1, 799,
-	176, 38,
-	181, 38,
-	-2, 242,
-	-1, 880,
-	1, 373,
-	474, 373,
-	-2, 118,
-	-1, 1120,
-	1, 269,
-	474, 269,
-	-2, 118,
-	-1, 1198,
-	170, 231,
-	171, 231,
-	-2, 320,
-	-1, 1207,
-	176, 39,
-	181, 39,
-	-2, 243,
-	-1, 1420,
-	150, 960,
-	-2, 954,
-	-1, 1512,
-	74, 66,
-	82, 66,
-	-2, 70,
-	-1, 1533,
-	1, 270,
-	474, 270,
-	-2, 118,
-	-1, 1945,
-	5, 821,
-	18, 821,
-	20, 821,
-	32, 821,
-	83, 821,
-	-2, 604,
-	-1, 2157,
-	46, 896,
-	-2, 890,
+	1, 114,
+	479, 114,
+	-2, 120,
+	-1, 47,
+	143, 120,
+	263, 120,
+	317, 120,
+	-2, 328,
+	-1, 54,
+	34, 478,
+	166, 478,
+	178, 478,
+	211, 492,
+	212, 492,
+	-2, 480,
+	-1, 59,
+	168, 502,
+	-2, 500,
+	-1, 86,
+	56, 574,
+	-2, 582,
+	-1, 111,
+	1, 115,
+	479, 115,
+	-2, 120,
+	-1, 121,
+	171, 233,
+	172, 233,
+	-2, 322,
+	-1, 140,
+	143, 120,
+	263, 120,
+	317, 120,
+	-2, 337,
+	-1, 588,
+	150, 965,
+	-2, 961,
+	-1, 589,
+	150, 966,
+	-2, 962,
+	-1, 611,
+	56, 575,
+	-2, 587,
+	-1, 612,
+	56, 576,
+	-2, 588,
+	-1, 633,
+	118, 1311,
+	-2, 86,
+	-1, 634,
+	118, 1191,
+	-2, 87,
+	-1, 640,
+	118, 1241,
+	-2, 938,
+	-1, 778,
+	118, 1128,
+	-2, 935,
+	-1, 811,
+	177, 39,
+	182, 39,
+	-2, 244,
+	-1, 893,
+	1, 375,
+	479, 375,
+	-2, 120,
+	-1, 1136,
+	1, 271,
+	479, 271,
+	-2, 120,
+	-1, 1214,
+	171, 233,
+	172, 233,
+	-2, 322,
+	-1, 1223,
+	177, 40,
+	182, 40,
+	-2, 245,
+	-1, 1439,
+	150, 970,
+	-2, 964,
+	-1, 1531,
+	74, 68,
+	82, 68,
+	-2, 72,
+	-1, 1552,
+	1, 272,
+	479, 272,
+	-2, 120,
+	-1, 1969,
+	5, 831,
+	18, 831,
+	20, 831,
+	32, 831,
+	83, 831,
+	-2, 614,
+	-1, 2181,
+	46, 906,
+	-2, 900,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 28297
+const yyLast = 28518
 
 var yyAct = [...]int{
-	579, 2242, 2186, 1858, 2231, 2170, 1997, 2208, 1746, 1548,
-	2158, 522, 2086, 939, 551, 2108, 1925, 1827, 1021, 1530,
-	1713, 1926, 1994, 537, 1457, 1597, 1068, 1747, 1922, 1563,
-	1831, 1733, 83, 3, 520, 892, 1183, 1811, 1568, 1812,
-	829, 769, 1509, 1075, 1937, 147, 1884, 1223, 1673, 180,
-	1406, 1414, 180, 1810, 485, 180, 1647, 1570, 1595, 1320,
-	501, 1804, 180, 1112, 794, 133, 1105, 1498, 626, 1096,
-	180, 1491, 1073, 1205, 1078, 919, 601, 81, 1459, 1095,
-	1098, 1060, 524, 1440, 513, 586, 1383, 957, 33, 776,
-	1102, 1182, 501, 1295, 807, 501, 180, 501, 777, 1559,
-	781, 773, 800, 795, 79, 623, 1212, 1474, 796, 1111,
-	1514, 1085, 1325, 886, 1417, 797, 110, 1172, 937, 150,
-	111, 1549, 1197, 116, 1180, 1109, 117, 508, 871, 8,
-	7, 6, 78, 1177, 1034, 1850, 1849, 1626, 1282, 1872,
-	2110, 1873, 1454, 1455, 1372, 1037, 182, 183, 184, 1371,
-	1370, 1369, 1368, 1367, 511, 1360, 512, 2200, 1711, 770,
-	592, 608, 612, 2154, 1971, 112, 2065, 958, 587, 118,
-	2132, 2131, 833, 180, 2081, 460, 831, 2082, 2248, 832,
-	2205, 610, 84, 2241, 834, 80, 2181, 2234, 1998, 845,
-	846, 509, 849, 850, 851, 852, 1614, 1663, 855, 856,
-	857, 858, 859, 860, 861, 862, 863, 864, 865, 866,
-	867, 868, 869, 627, 958, 811, 2204, 620, 171, 86,
-	87, 88, 89, 90, 91, 1901, 788, 2029, 786, 112,
-	787, 2180, 1712, 968, 789, 1633, 810, 1951, 926, 1632,
-	928, 842, 1871, 113, 177, 135, 1661, 514, 107, 1524,
-	453, 454, 1184, 489, 155, 835, 836, 837, 564, 1515,
-	570, 571, 568, 569, 1456, 567, 566, 565, 1777, 1952,
-	1953, 1776, 847, 1573, 1778, 572, 573, 925, 927, 911,
-	968, 1525, 1526, 912, 35, 145, 785, 72, 39, 40,
-	134, 1113, 905, 1114, 899, 900, 935, 583, 112, 582,
-	1794, 171, 182, 183, 184, 105, 488, 848, 152, 1542,
-	153, 790, 956, 2020, 1860, 122, 123, 144, 143, 170,
-	1361, 1362, 1363, 2018, 499, 1359, 113, 503, 964, 104,
-	107, 172, 497, 585, 877, 2183, 1832, 155, 1596, 2144,
-	983, 982, 992, 993, 985, 986, 987, 988, 989, 990,
-	991, 984, 897, 1572, 994, 1854, 898, 899, 900, 71,
-	1306, 1304, 1305, 1855, 1301, 1629, 1296, 139, 120, 146,
-	127, 119, 1272, 140, 141, 964, 872, 913, 156, 934,
-	924, 2233, 2201, 923, 929, 107, 906, 99, 161, 128,
-	1861, 152, 102, 153, 176, 101, 100, 916, 917, 922,
-	932, 918, 170, 131, 129, 124, 125, 126, 130, 1641,
-	106, 914, 915, 121, 1273, 1308, 1274, 1309, 1302, 1310,
-	1862, 881, 132, 489, 1863, 1298, 854, 489, 853, 1885,
-	2128, 2076, 1598, 1492, 1300, 818, 816, 827, 1970, 826,
-	825, 824, 105, 823, 822, 809, 821, 820, 809, 815,
-	517, 791, 180, 1191, 885, 828, 2246, 180, 175, 1515,
-	180, 156, 2077, 109, 963, 960, 961, 962, 967, 969,
-	966, 161, 965, 1887, 878, 1299, 488, 489, 930, 959,
-	488, 1631, 2249, 1646, 774, 2220, 501, 501, 501, 803,
-	774, 774, 106, 802, 148, 772, 909, 895, 1864, 901,
-	902, 903, 904, 931, 501, 501, 2179, 1211, 1210, 1791,
-	1786, 963, 960, 961, 962, 967, 969, 966, 887, 965,
-	936, 1181, 614, 1620, 1662, 1574, 959, 2171, 819, 817,
-	488, 1714, 1716, 1313, 944, 1889, 838, 1893, 950, 1888,
-	1820, 1886, 1628, 809, 1910, 1909, 1891, 106, 142, 2184,
-	1908, 784, 783, 1787, 782, 1890, 1649, 933, 2145, 1443,
-	136, 1648, 1842, 137, 884, 1616, 780, 459, 1892, 1894,
-	1284, 1283, 1285, 1286, 1287, 1789, 451, 148, 1784, 1640,
-	1649, 808, 1639, 180, 808, 1648, 2165, 812, 802, 2049,
-	1785, 802, 805, 806, 809, 774, 1950, 813, 1738, 799,
-	803, 1681, 1004, 896, 2244, 941, 942, 2245, 809, 2243,
-	1531, 501, 73, 1606, 180, 814, 180, 180, 798, 501,
-	1692, 1066, 1006, 1007, 1520, 501, 1089, 908, 1715, 1019,
-	1065, 1689, 623, 890, 994, 953, 951, 952, 888, 910,
-	1773, 1022, 880, 595, 984, 1390, 844, 994, 1470, 894,
-	1792, 1790, 809, 876, 1355, 974, 2136, 830, 1094, 1388,
-	1389, 1387, 94, 1061, 149, 154, 151, 157, 158, 159,
-	160, 162, 163, 164, 165, 972, 973, 971, 1079, 808,
-	166, 167, 168, 169, 1935, 920, 802, 805, 806, 1615,
-	774, 1326, 971, 974, 799, 803, 1297, 1580, 975, 1036,
-	1039, 1041, 1043, 1044, 1046, 1048, 1049, 95, 974, 1058,
-	1115, 1040, 1042, 1077, 1045, 1047, 954, 1050, 983, 982,
-	992, 993, 985, 986, 987, 988, 989, 990, 991, 984,
-	808, 879, 994, 873, 514, 874, 812, 802, 875, 1903,
-	627, 973, 971, 1032, 808, 1441, 813, 149, 154, 151,
-	157, 158, 159, 160, 162, 163, 164, 165, 974, 1006,
-	1007, 1788, 893, 166, 167, 168, 169, 1613, 1611, 180,
-	1006, 1007, 1067, 1173, 818, 1071, 1074, 816, 1674, 972,
-	973, 971, 1955, 1185, 1186, 1187, 1608, 1905, 808, 1608,
-	843, 987, 988, 989, 990, 991, 984, 974, 501, 994,
-	1207, 921, 182, 183, 184, 1082, 1408, 1327, 1216, 1441,
-	1612, 1699, 1220, 1610, 174, 501, 501, 2235, 501, 1291,
-	501, 501, 1217, 501, 501, 501, 501, 501, 501, 2250,
-	1189, 1190, 182, 183, 184, 2064, 1799, 71, 501, 1203,
-	1289, 1110, 180, 1256, 613, 2236, 2063, 1251, 1252, 1386,
-	985, 986, 987, 988, 989, 990, 991, 984, 1269, 1196,
-	994, 1279, 1409, 1225, 1976, 1226, 1808, 1228, 1230, 501,
-	2225, 1234, 1236, 1238, 1240, 1242, 1807, 180, 1290, 1253,
-	1577, 1215, 1472, 1292, 1378, 1380, 1381, 180, 1259, 1260,
-	1277, 180, 1800, 1276, 1265, 1266, 1379, 2251, 2226, 1288,
-	1213, 1213, 1275, 1687, 1666, 1667, 1668, 180, 1179, 1267,
-	1188, 1686, 779, 1214, 180, 1193, 1194, 1206, 1912, 1192,
-	1278, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	501, 501, 501, 618, 615, 616, 972, 973, 971, 1261,
-	1258, 1330, 182, 183, 184, 1471, 1780, 2229, 1334, 1257,
-	1336, 1337, 1338, 1339, 974, 1341, 1232, 180, 2032, 1322,
-	1254, 182, 183, 184, 596, 1590, 1913, 2228, 2227, 1356,
-	972, 973, 971, 1328, 1329, 596, 1008, 1009, 1010, 1011,
-	1012, 1013, 1014, 1015, 1016, 1017, 2216, 1333, 974, 1475,
-	1476, 2214, 1384, 2099, 1340, 1407, 2061, 788, 1314, 2037,
-	112, 787, 1319, 1958, 1410, 983, 982, 992, 993, 985,
-	986, 987, 988, 989, 990, 991, 984, 1914, 501, 994,
-	1332, 983, 982, 992, 993, 985, 986, 987, 988, 989,
-	990, 991, 984, 1418, 1688, 994, 182, 183, 184, 1429,
-	1432, 1411, 1412, 1809, 1817, 1442, 1805, 1366, 1351, 1352,
-	1353, 1657, 501, 501, 182, 183, 184, 1624, 1588, 1623,
-	1385, 1323, 1280, 180, 1268, 1264, 1424, 972, 973, 971,
-	1263, 972, 973, 971, 1262, 1064, 1324, 501, 1857, 1419,
-	1983, 2219, 1464, 2126, 180, 974, 2125, 501, 1420, 974,
-	1923, 180, 1996, 180, 1022, 80, 182, 183, 184, 1934,
-	1270, 180, 180, 1418, 1983, 2177, 1448, 1449, 501, 1983,
-	2166, 501, 1983, 596, 1983, 2134, 2079, 596, 972, 973,
-	971, 623, 501, 1834, 623, 1421, 540, 539, 542, 543,
-	544, 545, 1819, 1510, 1516, 541, 974, 546, 1425, 1426,
-	1608, 596, 1431, 1434, 1435, 1550, 1551, 1552, 1734, 1489,
-	2047, 596, 1373, 1374, 1375, 1376, 1485, 1516, 1420, 1983,
-	1988, 1968, 1967, 1535, 1964, 1965, 1534, 1609, 1447, 1964,
-	1963, 1450, 1451, 596, 1483, 596, 35, 501, 1515, 1851,
-	1734, 180, 1176, 1836, 82, 501, 1538, 1829, 1830, 180,
-	1587, 1589, 1513, 1495, 596, 35, 1517, 1539, 1487, 970,
-	596, 1565, 1934, 501, 1519, 1176, 1175, 1427, 1428, 501,
-	1121, 1120, 1571, 1216, 1518, 1216, 2044, 1495, 1522, 1517,
-	1741, 1494, 1608, 1607, 2115, 1537, 1465, 1515, 970, 627,
-	1536, 1767, 627, 1521, 1484, 2135, 1477, 1983, 1966, 1515,
-	1495, 2066, 1523, 1742, 1594, 514, 35, 1704, 1703, 1934,
-	1483, 71, 589, 501, 1483, 1407, 1608, 1591, 1473, 1452,
-	1407, 1407, 1543, 1364, 1544, 1545, 1546, 1547, 1561, 1562,
-	71, 1814, 1495, 1578, 1566, 1575, 580, 1583, 1584, 1585,
-	1555, 1556, 1557, 1558, 1312, 1576, 1604, 1107, 1605, 2067,
-	2068, 2069, 1247, 2169, 1617, 180, 811, 1529, 1600, 180,
-	180, 180, 180, 180, 1483, 1619, 1213, 793, 1566, 1599,
-	1621, 1622, 1618, 180, 180, 180, 180, 810, 1603, 792,
-	180, 71, 71, 2088, 1995, 181, 180, 71, 181, 2055,
-	1178, 181, 1564, 180, 1856, 1601, 502, 1560, 181, 2031,
-	1248, 1249, 1250, 1944, 1554, 1553, 181, 1294, 1208, 1204,
-	1174, 96, 177, 2070, 1938, 1939, 1567, 1859, 180, 501,
-	2089, 1184, 2238, 2232, 1652, 1653, 1941, 1923, 502, 1655,
-	1825, 502, 181, 502, 1813, 1824, 1656, 1244, 1823, 1581,
-	1357, 1315, 1943, 1755, 1754, 1627, 983, 982, 992, 993,
-	985, 986, 987, 988, 989, 990, 991, 984, 2071, 2072,
-	994, 1760, 1384, 1504, 1505, 1644, 983, 982, 992, 993,
-	985, 986, 987, 988, 989, 990, 991, 984, 2222, 1814,
-	994, 1758, 1245, 1246, 1756, 2203, 1759, 1382, 1915, 1757,
-	1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400,
-	1401, 1402, 1403, 1404, 1405, 1723, 1076, 2188, 2048, 181,
-	1986, 1732, 1660, 180, 1731, 2187, 1500, 1503, 1504, 1505,
-	1501, 180, 1502, 1506, 2159, 2161, 1938, 1939, 2224, 2207,
-	1385, 2209, 2191, 2162, 1669, 992, 993, 985, 986, 987,
-	988, 989, 990, 991, 984, 180, 98, 994, 2156, 1444,
-	1311, 581, 1720, 1818, 1437, 840, 180, 180, 180, 180,
-	180, 103, 606, 602, 1727, 1721, 1748, 1682, 180, 1438,
-	587, 839, 180, 1722, 1069, 180, 180, 2007, 603, 180,
-	180, 180, 1739, 1698, 1743, 1813, 1070, 1736, 1870, 943,
-	1678, 1679, 1779, 1061, 1710, 1844, 1843, 452, 113, 1718,
-	2113, 1080, 1081, 605, 1765, 604, 1726, 1960, 1959, 173,
-	1798, 1696, 455, 1602, 1795, 1796, 1222, 1768, 1735, 1221,
-	1209, 1770, 1737, 2042, 1475, 1476, 1750, 1751, 1797, 1753,
-	1801, 1802, 1803, 1468, 1761, 1749, 1821, 1318, 1752, 1782,
-	1422, 1423, 180, 2127, 1766, 1683, 1771, 1774, 2083, 1322,
-	1730, 1508, 1307, 501, 590, 591, 1665, 593, 1729, 501,
-	1783, 2215, 501, 2213, 1216, 2212, 1571, 2192, 1833, 501,
-	2190, 2041, 1982, 1816, 1592, 594, 1806, 82, 1918, 1837,
-	1839, 1848, 2040, 1700, 606, 602, 1466, 1815, 1734, 180,
-	1500, 1503, 1504, 1505, 1501, 1693, 1502, 1506, 1690, 1847,
-	603, 2240, 2239, 589, 1090, 1083, 2240, 180, 2163, 1846,
-	1957, 1469, 80, 1724, 1725, 1074, 85, 1196, 77, 1,
-	472, 1453, 1059, 599, 600, 605, 1419, 604, 484, 2230,
-	1281, 1271, 1999, 2085, 1989, 1420, 1845, 1838, 1569, 801,
-	138, 1532, 501, 1533, 2173, 93, 767, 92, 1407, 804,
-	907, 1593, 2080, 1793, 1541, 1881, 1127, 1125, 1126, 1866,
-	1124, 1865, 1129, 1128, 1123, 1358, 498, 1507, 178, 1116,
-	1883, 1084, 841, 462, 1969, 1354, 1625, 468, 501, 1874,
-	1002, 1728, 1775, 624, 617, 1929, 1882, 1868, 181, 180,
-	1869, 2185, 1880, 181, 2155, 1896, 181, 2157, 1895, 501,
-	1902, 2109, 2160, 2153, 2223, 501, 501, 2206, 1540, 1467,
-	1924, 1072, 1881, 1748, 2039, 1917, 1697, 1927, 1031, 1439,
-	1099, 523, 502, 502, 502, 1911, 1463, 1377, 180, 538,
-	535, 536, 1478, 1740, 976, 521, 515, 1091, 1933, 1499,
-	502, 502, 982, 992, 993, 985, 986, 987, 988, 989,
-	990, 991, 984, 1932, 1497, 994, 1496, 1942, 1946, 1316,
-	1948, 1103, 1949, 1940, 1936, 1097, 1482, 1630, 1853, 955,
-	1947, 598, 510, 97, 1436, 1961, 1962, 2026, 2143, 1977,
-	1664, 180, 2028, 597, 180, 180, 180, 1954, 61, 38,
-	501, 505, 2199, 946, 607, 32, 31, 30, 29, 1670,
-	1671, 1672, 28, 180, 1985, 23, 22, 21, 20, 19,
-	1973, 1972, 25, 18, 1974, 1975, 17, 16, 108, 181,
-	2000, 501, 501, 501, 48, 180, 45, 1990, 43, 115,
-	114, 1984, 46, 1571, 2008, 1987, 1993, 42, 882, 1992,
-	27, 1904, 26, 15, 14, 13, 12, 502, 11, 10,
-	181, 9, 181, 181, 5, 502, 4, 949, 24, 1020,
-	1921, 502, 2, 0, 2005, 2006, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1919, 0, 0, 2016,
-	0, 0, 0, 2038, 0, 0, 983, 982, 992, 993,
-	985, 986, 987, 988, 989, 990, 991, 984, 0, 0,
-	994, 0, 0, 0, 0, 0, 1748, 0, 0, 0,
-	0, 0, 0, 2043, 0, 0, 0, 0, 0, 0,
-	0, 0, 2052, 0, 0, 0, 0, 0, 0, 0,
-	1676, 0, 0, 2060, 1677, 2062, 0, 2051, 0, 2058,
-	0, 2059, 0, 501, 501, 1684, 1685, 0, 0, 0,
-	2057, 1691, 0, 0, 1694, 1695, 501, 0, 0, 501,
-	2074, 2073, 1701, 0, 1702, 0, 0, 1705, 1706, 1707,
-	1708, 1709, 2087, 2084, 0, 2092, 0, 0, 0, 2013,
-	2014, 0, 2015, 1719, 2091, 2017, 0, 2019, 0, 0,
-	0, 0, 0, 0, 501, 501, 501, 180, 2090, 0,
-	0, 0, 0, 2011, 0, 181, 0, 2107, 501, 0,
-	501, 2102, 2104, 2105, 0, 0, 501, 0, 0, 1927,
-	2112, 2106, 0, 1927, 0, 2118, 2114, 0, 0, 1763,
-	1764, 0, 0, 2121, 502, 0, 0, 0, 180, 2116,
-	2123, 0, 2124, 0, 0, 0, 0, 2030, 0, 501,
-	180, 502, 502, 0, 502, 0, 502, 502, 0, 502,
-	502, 502, 502, 502, 502, 2130, 2137, 0, 0, 0,
-	514, 0, 0, 0, 502, 0, 2133, 2053, 181, 0,
-	2054, 0, 0, 2056, 0, 1876, 1877, 2152, 0, 0,
-	1927, 0, 2164, 0, 0, 0, 501, 501, 0, 0,
-	1897, 1898, 0, 1899, 1900, 502, 0, 0, 2172, 2087,
-	2174, 0, 0, 181, 1906, 1907, 0, 0, 0, 0,
-	2167, 0, 0, 181, 550, 2025, 501, 181, 2189, 2182,
-	501, 0, 2193, 2195, 0, 1748, 0, 0, 0, 0,
-	0, 2202, 0, 181, 0, 0, 0, 2198, 2211, 2098,
-	181, 0, 2210, 182, 183, 184, 0, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 502, 502, 502, 2221,
-	0, 0, 2120, 179, 0, 0, 458, 0, 2122, 496,
-	0, 171, 2111, 514, 0, 0, 458, 0, 0, 0,
-	0, 2024, 0, 181, 458, 2237, 0, 1956, 1878, 1879,
-	0, 0, 2247, 0, 0, 0, 113, 0, 0, 0,
-	0, 611, 611, 477, 0, 0, 0, 155, 0, 0,
-	458, 0, 476, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 474, 983, 982, 992, 993, 985, 986,
-	987, 988, 989, 990, 991, 984, 0, 0, 994, 0,
-	0, 0, 0, 0, 502, 0, 0, 0, 1781, 0,
-	0, 0, 0, 0, 1930, 0, 0, 0, 0, 0,
-	0, 152, 471, 153, 0, 2023, 0, 0, 0, 0,
-	0, 483, 170, 0, 0, 1945, 2009, 0, 502, 502,
-	0, 0, 0, 0, 0, 0, 0, 458, 0, 181,
-	983, 982, 992, 993, 985, 986, 987, 988, 989, 990,
-	991, 984, 0, 502, 994, 0, 0, 0, 0, 0,
-	181, 0, 0, 502, 0, 0, 0, 181, 489, 181,
-	0, 0, 0, 0, 0, 0, 0, 181, 181, 0,
-	0, 156, 0, 0, 502, 0, 0, 502, 0, 0,
-	0, 161, 0, 0, 0, 461, 463, 464, 502, 480,
-	482, 490, 0, 0, 0, 478, 479, 491, 465, 466,
-	495, 494, 481, 0, 470, 467, 469, 475, 0, 0,
-	0, 488, 473, 492, 983, 982, 992, 993, 985, 986,
-	987, 988, 989, 990, 991, 984, 0, 0, 994, 0,
-	2010, 0, 0, 0, 2012, 0, 0, 0, 0, 0,
-	0, 0, 0, 502, 0, 2021, 2022, 181, 0, 0,
-	0, 502, 0, 0, 0, 181, 0, 0, 0, 0,
-	0, 2036, 0, 0, 2093, 2094, 2095, 2096, 2097, 502,
-	0, 0, 2100, 2101, 0, 502, 0, 0, 2045, 2046,
-	0, 978, 2050, 981, 0, 0, 0, 148, 0, 995,
-	996, 997, 998, 999, 1000, 1001, 0, 979, 980, 977,
-	983, 982, 992, 993, 985, 986, 987, 988, 989, 990,
-	991, 984, 0, 549, 994, 0, 0, 0, 0, 502,
-	1875, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 493, 0, 2078,
-	983, 982, 992, 993, 985, 986, 987, 988, 989, 990,
-	991, 984, 0, 0, 994, 486, 0, 0, 0, 0,
-	0, 181, 0, 0, 0, 181, 181, 181, 181, 181,
-	487, 0, 0, 500, 0, 0, 0, 0, 0, 181,
-	181, 181, 181, 2103, 1144, 0, 181, 0, 0, 0,
-	0, 0, 181, 0, 0, 0, 0, 0, 0, 181,
-	0, 0, 0, 0, 0, 625, 458, 1675, 771, 2196,
-	778, 458, 0, 0, 458, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 181, 502, 0, 983, 982, 992,
-	993, 985, 986, 987, 988, 989, 990, 991, 984, 0,
-	0, 994, 0, 2139, 2140, 2141, 2142, 0, 2146, 0,
-	2147, 2148, 2149, 0, 2150, 2151, 0, 149, 154, 151,
-	157, 158, 159, 160, 162, 163, 164, 165, 0, 0,
-	0, 0, 0, 166, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2178, 0, 0, 0, 1132, 0, 0,
-	0, 0, 0, 0, 35, 36, 37, 72, 39, 40,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
-	0, 0, 0, 0, 76, 0, 0, 181, 0, 41,
-	67, 68, 0, 65, 69, 0, 0, 458, 0, 0,
-	66, 1145, 0, 0, 0, 0, 2217, 2218, 0, 0,
-	0, 181, 0, 611, 0, 0, 0, 0, 0, 0,
-	0, 0, 181, 181, 181, 181, 181, 0, 458, 54,
-	458, 1106, 0, 0, 181, 0, 0, 0, 181, 71,
-	0, 181, 181, 0, 0, 181, 181, 181, 0, 0,
-	0, 0, 1158, 1161, 1162, 1163, 1164, 1165, 1166, 0,
-	1167, 1168, 1169, 1170, 1171, 1146, 1147, 1148, 1149, 1130,
-	1131, 1159, 0, 1133, 0, 1134, 1135, 1136, 1137, 1138,
-	1139, 1140, 1141, 1142, 1143, 1150, 1151, 1152, 1153, 1154,
-	1155, 1156, 1157, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 181, 0,
-	0, 44, 47, 50, 49, 52, 0, 64, 0, 502,
-	70, 0, 0, 0, 0, 502, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 502, 0, 0, 0, 0,
-	0, 0, 0, 53, 75, 74, 0, 0, 62, 63,
-	51, 0, 0, 0, 0, 181, 0, 0, 1160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 181, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 458, 0, 0, 0, 0, 0, 55,
-	56, 0, 57, 58, 59, 60, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1219, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	1219, 1219, 0, 0, 0, 181, 458, 0, 0, 625,
-	625, 625, 0, 0, 0, 502, 0, 0, 0, 0,
-	0, 502, 502, 0, 0, 0, 0, 945, 947, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 458, 73, 0, 181, 0, 0, 0, 0, 0,
-	0, 458, 0, 0, 0, 1321, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 458, 0, 0, 0, 0, 0, 0, 458, 0,
-	0, 0, 0, 0, 0, 1342, 1343, 458, 458, 458,
-	458, 458, 458, 458, 0, 0, 0, 181, 0, 0,
-	181, 181, 181, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
-	0, 458, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1062, 1087, 0, 0, 502, 502, 502,
-	0, 181, 625, 0, 0, 0, 0, 0, 1117, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 611, 1321, 457, 0, 0, 611, 611,
-	0, 0, 611, 611, 611, 504, 0, 0, 1219, 0,
-	0, 0, 0, 584, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 611, 611,
-	611, 611, 611, 0, 0, 0, 0, 1461, 0, 775,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 458, 552,
-	34, 0, 0, 0, 1321, 458, 0, 458, 0, 502,
-	502, 0, 0, 0, 0, 458, 458, 0, 0, 0,
-	0, 0, 502, 0, 0, 502, 0, 0, 0, 0,
-	0, 0, 0, 0, 34, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 870, 0, 0, 0,
-	502, 502, 502, 181, 0, 0, 0, 0, 0, 0,
-	0, 771, 0, 0, 502, 0, 502, 0, 0, 588,
-	0, 0, 502, 0, 1218, 0, 0, 0, 1224, 1224,
-	0, 1224, 0, 1224, 1224, 458, 1233, 1224, 1224, 1224,
-	1224, 1224, 0, 1586, 181, 0, 0, 0, 0, 1218,
-	1218, 771, 0, 0, 0, 502, 181, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 502, 502, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 502, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 625, 625, 625, 0, 0, 0, 458,
-	0, 0, 0, 458, 458, 458, 458, 458, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 458, 458, 458,
-	458, 0, 0, 0, 1650, 0, 0, 0, 0, 0,
-	458, 0, 0, 0, 0, 0, 0, 458, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 458, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1413, 0, 625, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1218, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1445, 1446, 0, 0, 0,
-	611, 611, 0, 0, 0, 883, 0, 0, 0, 0,
-	889, 0, 0, 891, 0, 0, 0, 0, 0, 0,
-	1479, 611, 0, 0, 0, 0, 0, 0, 0, 0,
-	1087, 0, 0, 625, 0, 0, 0, 458, 0, 0,
-	0, 0, 0, 0, 0, 1461, 0, 0, 0, 0,
-	0, 625, 0, 0, 625, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 771, 0, 0, 611, 458,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1219,
-	458, 458, 458, 458, 458, 0, 0, 0, 0, 0,
-	0, 0, 1762, 0, 0, 0, 458, 0, 0, 458,
-	458, 0, 0, 458, 1772, 1321, 0, 0, 0, 0,
-	0, 171, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 1826, 0, 0, 0, 0, 0, 1582, 0,
-	0, 0, 0, 0, 0, 0, 113, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 771, 155, 0, 0,
-	0, 0, 778, 0, 0, 938, 938, 938, 0, 0,
-	0, 0, 0, 0, 0, 0, 458, 1093, 0, 0,
-	1104, 0, 0, 0, 0, 34, 0, 0, 145, 0,
-	0, 1219, 0, 134, 0, 0, 0, 0, 1003, 1005,
-	0, 1321, 0, 0, 0, 0, 771, 0, 0, 0,
-	0, 152, 0, 153, 0, 0, 0, 0, 1199, 1200,
-	144, 143, 170, 458, 0, 0, 0, 0, 0, 1018,
-	0, 0, 0, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
-	1030, 458, 1033, 1035, 1038, 1038, 1038, 1035, 1038, 1038,
-	1035, 1038, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 0,
-	0, 0, 0, 0, 1063, 0, 0, 0, 34, 0,
-	139, 1201, 146, 171, 1198, 611, 140, 141, 0, 0,
-	0, 156, 0, 0, 1195, 0, 0, 0, 0, 0,
-	0, 161, 0, 0, 0, 1100, 0, 0, 113, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 155,
-	0, 0, 1659, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 458, 0, 0, 0, 0, 0, 0,
-	0, 0, 1122, 0, 0, 0, 1219, 0, 0, 0,
-	145, 0, 0, 0, 0, 134, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 458, 152, 0, 153, 0, 0, 0, 0,
-	1199, 1200, 144, 143, 170, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 148, 0, 0,
-	0, 0, 0, 0, 0, 1255, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 458, 0, 0, 458, 458,
-	458, 0, 139, 1201, 146, 0, 1198, 1219, 140, 141,
-	0, 0, 0, 156, 0, 0, 0, 458, 0, 0,
-	1303, 0, 0, 161, 0, 0, 0, 0, 1218, 0,
-	1317, 142, 0, 0, 0, 0, 0, 0, 0, 458,
-	0, 0, 0, 136, 0, 0, 137, 0, 0, 0,
-	1331, 0, 0, 0, 0, 0, 0, 1335, 0, 0,
-	0, 0, 0, 0, 0, 0, 1344, 1345, 1346, 1347,
-	1348, 1349, 1350, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1104, 0, 0, 0, 0, 0, 0, 0, 0, 1219,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1828, 0, 0, 148,
-	1218, 0, 1835, 0, 0, 1828, 0, 0, 0, 0,
-	625, 0, 1840, 0, 0, 0, 0, 149, 154, 151,
-	157, 158, 159, 160, 162, 163, 164, 165, 0, 0,
-	0, 0, 0, 166, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 142, 0, 0, 0, 0, 0, 938,
-	938, 938, 0, 0, 0, 136, 0, 0, 137, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1461, 0, 0, 0, 625, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1486, 0, 0,
-	0, 0, 0, 0, 1490, 0, 1493, 0, 0, 0,
-	0, 0, 0, 0, 0, 1512, 0, 0, 0, 0,
-	0, 1224, 458, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 458, 0, 0, 0, 0, 0,
-	0, 0, 625, 0, 0, 1218, 0, 0, 1931, 1224,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 149,
-	154, 151, 157, 158, 159, 160, 162, 163, 164, 165,
-	0, 0, 0, 0, 0, 166, 167, 168, 169, 0,
-	0, 0, 0, 0, 1579, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1219, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1511, 0, 0, 771, 0, 0, 1218, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2001, 2002, 2003, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1104, 0,
-	0, 0, 1634, 1635, 1636, 1637, 1638, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1642, 1643, 1104, 1645,
-	0, 0, 0, 0, 0, 0, 0, 0, 1218, 1651,
-	0, 0, 0, 0, 0, 0, 1654, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1658, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1828, 2075, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1828,
-	0, 0, 625, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1828, 1828, 1828,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2117, 0, 2119, 0, 0, 0, 0, 0, 1828,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1828, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1769,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 625,
-	625, 0, 0, 0, 0, 0, 0, 0, 1680, 0,
-	0, 588, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1218, 0, 2194,
-	0, 0, 0, 1828, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1717, 0,
-	0, 0, 0, 0, 0, 1822, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1100, 0, 0, 0, 0, 0,
-	0, 1744, 1745, 0, 0, 1100, 1100, 1100, 1100, 1100,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1511, 1852, 0, 1100, 0, 0, 0, 1100, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1867, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	588, 2266, 2255, 2210, 1881, 2232, 2194, 1769, 2021, 2182,
+	2132, 531, 2110, 954, 1736, 1950, 560, 1850, 1476, 1949,
+	1549, 546, 1036, 2018, 1770, 1946, 1582, 1616, 1462, 1567,
+	1854, 1091, 85, 3, 529, 1084, 1199, 905, 781, 1660,
+	1587, 841, 1835, 1528, 1834, 1961, 1239, 149, 932, 1425,
+	183, 1908, 1696, 183, 1669, 494, 183, 1614, 1833, 604,
+	1433, 510, 1338, 183, 1589, 135, 83, 638, 1827, 1121,
+	1128, 183, 1221, 1510, 806, 613, 1517, 1089, 1114, 1112,
+	1094, 1478, 1075, 1459, 533, 34, 1402, 598, 1193, 522,
+	1198, 1111, 972, 1228, 510, 819, 809, 510, 183, 510,
+	1118, 785, 793, 788, 1311, 1493, 812, 635, 807, 789,
+	1578, 808, 1533, 1101, 607, 1127, 81, 1436, 952, 1343,
+	899, 152, 112, 1125, 1213, 118, 119, 1196, 1049, 113,
+	883, 1568, 80, 8, 1188, 517, 1052, 1645, 7, 6,
+	1873, 1872, 1896, 1298, 597, 1897, 2134, 1473, 1474, 1391,
+	185, 186, 187, 1390, 1389, 1388, 1387, 1386, 520, 2224,
+	521, 1379, 1734, 2178, 2089, 2156, 1995, 599, 114, 620,
+	624, 782, 120, 2155, 2105, 183, 973, 2106, 2272, 843,
+	183, 2265, 845, 844, 2229, 1686, 467, 82, 846, 86,
+	2205, 2258, 857, 858, 2022, 861, 862, 863, 864, 1633,
+	518, 867, 868, 869, 870, 871, 872, 873, 874, 875,
+	876, 877, 878, 879, 880, 881, 973, 632, 823, 822,
+	185, 186, 187, 2228, 173, 639, 1925, 88, 89, 90,
+	91, 92, 93, 114, 800, 2053, 2204, 798, 847, 848,
+	849, 799, 1735, 983, 854, 1975, 801, 1534, 596, 115,
+	573, 137, 579, 580, 577, 578, 1895, 576, 575, 574,
+	157, 1976, 1977, 1544, 1545, 1200, 1684, 581, 582, 1475,
+	36, 1543, 498, 74, 40, 41, 924, 860, 1652, 106,
+	484, 592, 1651, 983, 859, 925, 1592, 1800, 918, 483,
+	1799, 147, 1129, 1801, 1130, 797, 136, 185, 186, 187,
+	910, 481, 1561, 114, 911, 912, 913, 109, 950, 460,
+	461, 912, 913, 802, 154, 591, 155, 180, 1817, 2044,
+	2207, 124, 125, 146, 145, 172, 497, 1380, 1381, 1382,
+	2042, 508, 1378, 512, 506, 594, 109, 1883, 101, 1615,
+	478, 1855, 979, 104, 1648, 73, 103, 102, 2257, 492,
+	889, 2168, 998, 997, 1007, 1008, 1000, 1001, 1002, 1003,
+	1004, 1005, 1006, 999, 107, 971, 1009, 1591, 490, 1312,
+	1324, 1325, 884, 141, 122, 148, 129, 121, 931, 142,
+	143, 1317, 979, 926, 1877, 158, 919, 945, 2225, 1322,
+	1320, 1321, 1878, 107, 949, 163, 130, 498, 1326, 498,
+	1327, 1887, 1328, 894, 109, 174, 929, 930, 1814, 1809,
+	133, 131, 126, 127, 128, 132, 1884, 927, 928, 1663,
+	123, 1885, 866, 939, 1316, 941, 468, 470, 471, 134,
+	487, 491, 499, 498, 865, 1318, 485, 486, 500, 472,
+	473, 504, 503, 488, 489, 1994, 477, 474, 476, 482,
+	1288, 497, 1810, 497, 480, 501, 821, 1314, 2152, 183,
+	830, 898, 938, 940, 183, 1315, 828, 183, 178, 1617,
+	2100, 1511, 179, 108, 1812, 839, 498, 1807, 978, 975,
+	976, 977, 982, 984, 981, 943, 980, 497, 838, 1808,
+	837, 890, 1289, 974, 1290, 510, 510, 510, 836, 835,
+	834, 833, 108, 832, 150, 827, 908, 803, 914, 915,
+	916, 917, 944, 510, 510, 1207, 2203, 1685, 978, 975,
+	976, 977, 982, 984, 981, 840, 980, 947, 1160, 1650,
+	497, 951, 2101, 974, 2195, 177, 1534, 946, 2208, 786,
+	1668, 786, 111, 1593, 815, 784, 2270, 965, 2273, 2244,
+	1815, 1813, 786, 814, 831, 922, 1227, 1226, 900, 144,
+	829, 1737, 1739, 1197, 626, 1888, 1639, 1331, 959, 937,
+	108, 138, 936, 942, 139, 2169, 821, 850, 1843, 502,
+	1300, 1299, 1301, 1302, 1303, 1647, 821, 1934, 935, 1933,
+	856, 1932, 183, 820, 796, 795, 821, 495, 794, 821,
+	814, 817, 818, 75, 786, 1865, 897, 792, 811, 815,
+	466, 458, 496, 1019, 956, 957, 909, 1659, 1021, 1022,
+	1658, 2189, 2073, 510, 1974, 1761, 183, 810, 183, 183,
+	1081, 510, 1704, 1082, 1671, 1625, 888, 510, 1671, 1670,
+	1796, 1148, 1539, 1670, 635, 821, 1105, 1037, 968, 1034,
+	903, 1550, 901, 966, 967, 1009, 1489, 1373, 1409, 1738,
+	1635, 1715, 989, 1712, 1811, 185, 186, 187, 2160, 1427,
+	1076, 1110, 1407, 1408, 1406, 151, 156, 153, 159, 160,
+	161, 162, 164, 165, 166, 167, 1161, 921, 933, 1095,
+	842, 168, 169, 170, 171, 2268, 1959, 1313, 2269, 923,
+	2267, 1131, 1051, 1054, 1056, 1058, 1059, 1061, 1063, 1064,
+	1344, 1055, 1057, 820, 1060, 1062, 885, 1065, 886, 824,
+	814, 887, 969, 820, 893, 1428, 1073, 892, 1093, 825,
+	814, 817, 818, 820, 786, 855, 820, 1927, 811, 815,
+	1174, 1177, 1178, 1179, 1180, 1181, 1182, 826, 1183, 1184,
+	1185, 1186, 1187, 1162, 1163, 1164, 1165, 1146, 1147, 1175,
+	907, 1149, 639, 1150, 1151, 1152, 1153, 1154, 1155, 1156,
+	1157, 1158, 1159, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
+	1173, 183, 820, 999, 1634, 1189, 1009, 1460, 824, 814,
+	1599, 1083, 1632, 1630, 830, 1201, 1202, 1203, 825, 1627,
+	1021, 1022, 1021, 1022, 934, 988, 986, 987, 988, 986,
+	510, 96, 1223, 1002, 1003, 1004, 1005, 1006, 999, 828,
+	1232, 1009, 989, 1631, 1236, 989, 1345, 510, 510, 986,
+	510, 2274, 510, 510, 1233, 510, 510, 510, 510, 510,
+	510, 2088, 1979, 1205, 1206, 989, 73, 1176, 1219, 1460,
+	510, 1722, 2259, 1627, 183, 1272, 97, 176, 1405, 1267,
+	1268, 1098, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 999,
+	1285, 1212, 1009, 906, 1241, 2249, 1242, 1629, 1244, 1246,
+	2260, 510, 1250, 1252, 1254, 1256, 1258, 1909, 1269, 183,
+	1880, 2253, 1231, 185, 186, 187, 1307, 1822, 2252, 2275,
+	183, 1275, 1276, 2250, 183, 1305, 2087, 1281, 1282, 1397,
+	1399, 1400, 1230, 1229, 1229, 987, 988, 986, 1126, 2000,
+	183, 1398, 1195, 1929, 1295, 1204, 1831, 183, 625, 1209,
+	1210, 1208, 1911, 989, 183, 183, 183, 183, 183, 183,
+	183, 183, 183, 510, 510, 510, 1222, 1830, 1936, 183,
+	1711, 1596, 1308, 1823, 1293, 1306, 1348, 791, 1292, 1346,
+	1347, 1291, 1283, 1352, 1304, 1354, 1355, 1356, 1357, 1277,
+	1359, 1340, 183, 1351, 1689, 1690, 1691, 185, 186, 187,
+	1358, 1803, 1270, 1294, 1374, 997, 1007, 1008, 1000, 1001,
+	1002, 1003, 1004, 1005, 1006, 999, 1937, 1913, 1009, 1917,
+	1832, 1912, 1274, 1910, 1273, 1248, 1958, 1080, 1915, 1403,
+	1426, 2251, 630, 2240, 2238, 114, 800, 1914, 1332, 1429,
+	627, 628, 1337, 799, 987, 988, 986, 2123, 2085, 2061,
+	1916, 1918, 1982, 510, 987, 988, 986, 185, 186, 187,
+	1350, 1609, 989, 185, 186, 187, 1938, 1607, 1437, 1840,
+	1494, 1495, 989, 1828, 1448, 1451, 1430, 1431, 1679, 1643,
+	1461, 1369, 1370, 1371, 1441, 1442, 1642, 510, 510, 1443,
+	1341, 1296, 1385, 185, 186, 187, 1284, 1404, 183, 1280,
+	1279, 998, 997, 1007, 1008, 1000, 1001, 1002, 1003, 1004,
+	1005, 1006, 999, 510, 1278, 1009, 1079, 1438, 2007, 2243,
+	183, 1483, 1439, 510, 2007, 2201, 1037, 183, 2068, 183,
+	82, 1485, 1710, 608, 1491, 2007, 2190, 183, 183, 1437,
+	1709, 185, 186, 187, 510, 1286, 2150, 510, 2007, 608,
+	1467, 1468, 987, 988, 986, 2007, 2158, 635, 510, 2149,
+	635, 1484, 1697, 2103, 608, 987, 988, 986, 2020, 1529,
+	989, 1496, 1440, 1627, 608, 1857, 1444, 1445, 2071, 608,
+	1450, 1453, 1454, 989, 2007, 2012, 1992, 1991, 1508, 1988,
+	1989, 1504, 1535, 1439, 1988, 1987, 1554, 1490, 1502, 608,
+	1842, 1569, 1570, 1571, 1553, 1558, 1466, 1661, 608, 1469,
+	1470, 1534, 1874, 510, 1192, 1859, 985, 183, 1852, 1853,
+	36, 510, 987, 988, 986, 183, 1606, 1608, 1514, 608,
+	985, 608, 1557, 2159, 1584, 2007, 1506, 2090, 1532, 510,
+	989, 1192, 1191, 1137, 1136, 510, 1990, 1513, 1590, 1232,
+	2193, 1232, 1537, 1562, 1536, 1563, 1564, 1565, 1566, 1626,
+	1541, 1947, 1538, 1661, 84, 1514, 1556, 1540, 2139, 1555,
+	1958, 1574, 1575, 1576, 1577, 639, 1514, 1613, 639, 549,
+	548, 551, 552, 553, 554, 2091, 2092, 2093, 550, 510,
+	555, 1426, 36, 1535, 1542, 73, 1426, 1426, 1514, 1007,
+	1008, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 999, 589,
+	1585, 1009, 1602, 1603, 1604, 1580, 1581, 1503, 1790, 1595,
+	1597, 1623, 1594, 1624, 1628, 36, 1534, 1636, 1263, 1727,
+	1502, 183, 1958, 823, 822, 183, 183, 183, 183, 183,
+	1638, 1622, 1726, 1229, 1585, 1640, 1641, 1637, 1619, 1618,
+	1764, 183, 183, 183, 183, 1536, 1502, 1627, 183, 184,
+	601, 1610, 184, 1534, 183, 184, 1492, 73, 2094, 1471,
+	511, 183, 184, 1765, 1383, 1330, 1264, 1265, 1266, 1627,
+	184, 1123, 805, 804, 73, 1662, 1968, 1502, 2112, 2019,
+	2079, 1194, 1583, 1879, 1620, 1579, 1573, 183, 510, 1572,
+	73, 1310, 1224, 511, 1674, 1675, 511, 184, 511, 1677,
+	1220, 1190, 98, 2095, 2096, 1882, 1678, 1837, 998, 997,
+	1007, 1008, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 999,
+	1836, 1646, 1009, 1680, 1260, 73, 180, 2113, 993, 1200,
+	996, 1962, 1963, 1403, 2262, 2256, 1010, 1011, 1012, 1013,
+	1014, 1015, 1016, 1666, 994, 995, 992, 998, 997, 1007,
+	1008, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 999, 1965,
+	1947, 1009, 1848, 1847, 1846, 1837, 1600, 1967, 1699, 1261,
+	1262, 1376, 1700, 1333, 184, 1781, 1779, 1778, 1777, 184,
+	1782, 1780, 183, 1707, 1708, 1783, 1683, 1523, 1524, 1714,
+	183, 2246, 1717, 1718, 2227, 1939, 1746, 1092, 2072, 2010,
+	1724, 1404, 1725, 1692, 1755, 1728, 1729, 1730, 1731, 1732,
+	1754, 2248, 2231, 1706, 183, 2233, 1519, 1522, 1523, 1524,
+	1520, 1742, 1521, 1525, 100, 183, 183, 183, 183, 183,
+	1743, 2183, 2185, 105, 2215, 1771, 599, 183, 1705, 2212,
+	2186, 183, 1750, 2180, 183, 183, 1744, 2211, 183, 183,
+	183, 1756, 1721, 1766, 1745, 1329, 590, 1758, 1841, 1762,
+	1076, 1802, 1701, 1702, 1733, 852, 1456, 1786, 1787, 851,
+	2031, 1741, 1085, 1788, 1836, 1894, 459, 958, 1867, 1821,
+	1749, 1457, 175, 1719, 1086, 462, 1866, 1791, 115, 2137,
+	1759, 1793, 1984, 1757, 1983, 1621, 1238, 1237, 1820, 1225,
+	1824, 1825, 1826, 1818, 1819, 1773, 1774, 2066, 1776, 1487,
+	1805, 183, 1784, 1494, 1495, 1789, 1772, 1340, 1794, 1775,
+	1844, 1336, 510, 1797, 1753, 2151, 2107, 1527, 510, 602,
+	603, 510, 1752, 1232, 1323, 1590, 1688, 1856, 510, 1806,
+	605, 618, 614, 1839, 2239, 2237, 2236, 2216, 1860, 1862,
+	1871, 2214, 1829, 2065, 2006, 1611, 606, 615, 183, 84,
+	2064, 1942, 1760, 1838, 1519, 1522, 1523, 1524, 1520, 1870,
+	1521, 1525, 1661, 1716, 1962, 1963, 2264, 2263, 1869, 183,
+	1096, 1097, 617, 1713, 616, 1106, 1212, 1099, 948, 2264,
+	2187, 1981, 1488, 601, 82, 87, 79, 1, 1438, 479,
+	595, 1472, 1074, 1439, 1868, 1861, 493, 2254, 1297, 1287,
+	2023, 2109, 2013, 1588, 813, 510, 140, 1551, 1552, 2197,
+	95, 1426, 779, 94, 816, 920, 1612, 2104, 1905, 1816,
+	1560, 1889, 1892, 1143, 1141, 1893, 1890, 1142, 1140, 1145,
+	1902, 1903, 1144, 1139, 1377, 507, 1526, 1906, 618, 614,
+	181, 510, 1907, 1132, 1100, 853, 1898, 469, 184, 526,
+	1993, 1926, 183, 184, 615, 1372, 184, 1644, 475, 1017,
+	1919, 510, 510, 1920, 1904, 1751, 1798, 636, 510, 510,
+	629, 1953, 1948, 2209, 2179, 1905, 1771, 611, 612, 617,
+	2181, 616, 1951, 2133, 511, 511, 511, 1935, 2184, 2177,
+	2247, 183, 2230, 1559, 1486, 1088, 1954, 2063, 1941, 1720,
+	1046, 1458, 511, 511, 1115, 532, 1482, 1396, 608, 1957,
+	547, 544, 545, 1497, 1763, 1956, 991, 1969, 530, 524,
+	1107, 1966, 1945, 1518, 1516, 1515, 1334, 1119, 1964, 1970,
+	1960, 1972, 1113, 1973, 1971, 1501, 1649, 1876, 970, 1985,
+	1986, 610, 2001, 519, 183, 99, 1455, 183, 183, 183,
+	2167, 1687, 1978, 510, 998, 997, 1007, 1008, 1000, 1001,
+	1002, 1003, 1004, 1005, 1006, 999, 183, 2009, 1009, 2052,
+	609, 62, 39, 514, 2223, 961, 619, 1996, 1998, 1999,
+	1997, 184, 33, 2024, 510, 510, 510, 32, 31, 183,
+	30, 2008, 2014, 29, 28, 23, 1590, 22, 2032, 2017,
+	2011, 21, 2016, 20, 2056, 19, 25, 18, 17, 16,
+	110, 49, 511, 46, 44, 184, 117, 184, 184, 116,
+	511, 47, 43, 895, 27, 26, 511, 15, 14, 13,
+	2029, 2030, 12, 2034, 11, 10, 9, 2036, 5, 4,
+	2040, 964, 24, 1035, 2, 0, 0, 0, 2045, 2046,
+	0, 998, 997, 1007, 1008, 1000, 1001, 1002, 1003, 1004,
+	1005, 1006, 999, 0, 2060, 1009, 2035, 2062, 0, 0,
+	1771, 0, 0, 0, 2067, 0, 0, 0, 0, 0,
+	0, 2069, 2070, 2076, 0, 2074, 0, 0, 0, 2075,
+	0, 0, 0, 0, 0, 0, 2055, 0, 2037, 2038,
+	0, 2039, 2081, 2083, 2041, 0, 2043, 510, 510, 0,
+	0, 2082, 0, 0, 0, 0, 0, 2084, 0, 2086,
+	510, 0, 0, 510, 2098, 0, 2097, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2111, 2108, 0, 2116,
+	0, 0, 2102, 998, 997, 1007, 1008, 1000, 1001, 1002,
+	1003, 1004, 1005, 1006, 999, 0, 0, 1009, 510, 510,
+	510, 183, 0, 0, 0, 0, 0, 0, 2115, 0,
+	184, 2114, 510, 0, 510, 2126, 2128, 2129, 0, 2130,
+	510, 0, 0, 0, 2138, 1951, 2127, 0, 2136, 1951,
+	0, 2131, 2142, 0, 0, 0, 0, 2145, 0, 511,
+	0, 0, 183, 2140, 0, 2147, 0, 2148, 0, 0,
+	0, 0, 2122, 510, 183, 0, 511, 511, 0, 511,
+	0, 511, 511, 0, 511, 511, 511, 511, 511, 511,
+	2161, 2154, 0, 0, 0, 2144, 0, 0, 0, 511,
+	2157, 2146, 0, 184, 0, 2176, 2163, 2164, 2165, 2166,
+	0, 2170, 0, 2171, 2172, 2173, 1951, 2174, 2175, 2188,
+	510, 510, 0, 0, 0, 0, 0, 0, 0, 0,
+	511, 0, 2196, 2111, 2198, 0, 0, 0, 184, 0,
+	0, 0, 0, 0, 2191, 2050, 0, 0, 2206, 184,
+	510, 2213, 0, 184, 510, 2217, 2202, 0, 2219, 1771,
+	0, 0, 0, 0, 0, 0, 2226, 0, 0, 184,
+	0, 2222, 0, 2235, 2234, 0, 184, 0, 0, 0,
+	0, 0, 0, 184, 184, 184, 184, 184, 184, 184,
+	184, 184, 511, 511, 511, 2245, 0, 0, 184, 0,
+	0, 0, 0, 0, 173, 0, 0, 0, 0, 2241,
+	2242, 0, 0, 0, 0, 1849, 0, 2261, 0, 0,
+	2049, 184, 0, 0, 0, 0, 2271, 0, 0, 115,
+	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	157, 0, 0, 0, 1023, 1024, 1025, 1026, 1027, 1028,
+	1029, 1030, 1031, 1032, 998, 997, 1007, 1008, 1000, 1001,
+	1002, 1003, 1004, 1005, 1006, 999, 0, 0, 1009, 0,
+	0, 147, 0, 0, 0, 0, 136, 0, 0, 0,
+	0, 0, 511, 0, 0, 0, 0, 0, 0, 0,
+	173, 0, 0, 0, 154, 0, 155, 0, 0, 0,
+	0, 1215, 1216, 146, 145, 172, 0, 0, 0, 2048,
+	0, 0, 0, 0, 0, 115, 511, 511, 0, 0,
+	0, 0, 0, 0, 0, 0, 157, 184, 0, 998,
+	997, 1007, 1008, 1000, 1001, 1002, 1003, 1004, 1005, 1006,
+	999, 0, 511, 1009, 0, 0, 559, 0, 0, 184,
+	0, 0, 511, 141, 1217, 148, 184, 1214, 184, 142,
+	143, 0, 0, 0, 0, 158, 184, 184, 0, 0,
+	173, 0, 0, 511, 0, 163, 511, 2047, 0, 0,
+	154, 0, 155, 0, 0, 0, 0, 511, 0, 0,
+	0, 172, 0, 0, 0, 115, 182, 0, 0, 465,
+	0, 0, 505, 0, 0, 0, 157, 0, 0, 465,
+	0, 0, 0, 0, 0, 0, 0, 465, 998, 997,
+	1007, 1008, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 999,
+	0, 0, 1009, 0, 0, 623, 623, 0, 0, 0,
+	0, 0, 511, 0, 465, 0, 184, 1804, 0, 0,
+	511, 158, 0, 0, 184, 0, 0, 0, 0, 0,
+	154, 163, 155, 0, 0, 0, 0, 0, 511, 0,
+	0, 172, 0, 0, 511, 0, 0, 0, 0, 0,
+	0, 1899, 0, 0, 150, 0, 998, 997, 1007, 1008,
+	1000, 1001, 1002, 1003, 1004, 1005, 1006, 999, 0, 0,
+	1009, 998, 997, 1007, 1008, 1000, 1001, 1002, 1003, 1004,
+	1005, 1006, 999, 0, 0, 1009, 0, 558, 511, 0,
+	0, 465, 0, 0, 0, 0, 465, 0, 0, 0,
+	0, 158, 0, 0, 0, 0, 0, 0, 0, 144,
+	0, 163, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 138, 0, 0, 139, 0, 0, 0, 0, 0,
+	184, 0, 0, 0, 184, 184, 184, 184, 184, 0,
+	150, 0, 0, 0, 0, 0, 0, 0, 509, 0,
+	184, 184, 184, 184, 0, 0, 0, 184, 0, 0,
+	0, 0, 0, 184, 0, 0, 0, 0, 0, 0,
+	184, 0, 0, 0, 0, 0, 0, 0, 1698, 0,
+	0, 637, 0, 0, 783, 0, 790, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 184, 511, 998, 997,
+	1007, 1008, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 999,
+	0, 0, 1009, 0, 0, 0, 561, 35, 0, 0,
+	150, 0, 0, 0, 0, 151, 156, 153, 159, 160,
+	161, 162, 164, 165, 166, 167, 0, 0, 0, 0,
+	0, 168, 169, 170, 171, 0, 0, 0, 0, 0,
+	0, 0, 35, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1401, 0, 0, 1410, 1411, 1412, 1413, 1414, 1415,
+	1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424, 0,
+	0, 184, 0, 0, 0, 0, 0, 622, 600, 184,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 151, 156, 153, 159, 160, 161, 162, 164, 165,
+	166, 167, 0, 184, 0, 0, 0, 168, 169, 170,
+	171, 0, 0, 1463, 184, 184, 184, 184, 184, 0,
+	0, 0, 0, 0, 0, 0, 184, 0, 0, 0,
+	184, 0, 0, 184, 184, 0, 0, 184, 184, 184,
+	0, 0, 0, 0, 523, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 465, 0, 0, 0, 0,
+	465, 0, 0, 465, 0, 0, 0, 0, 0, 0,
+	0, 151, 156, 153, 159, 160, 161, 162, 164, 165,
+	166, 167, 0, 0, 0, 0, 0, 168, 169, 170,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	184, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 511, 0, 0, 0, 0, 0, 511, 0, 0,
+	511, 0, 0, 0, 0, 0, 0, 511, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 184, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 184, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 465, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 511, 0, 0, 623, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 465, 0, 465, 1122, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	511, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
+	511, 511, 637, 637, 637, 0, 1077, 511, 511, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	960, 962, 0, 0, 0, 0, 0, 0, 0, 0,
+	184, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 513,
+	0, 0, 0, 0, 0, 0, 0, 593, 0, 0,
+	0, 0, 0, 184, 0, 0, 184, 184, 184, 0,
+	0, 0, 511, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 787, 184, 0, 1693, 1694, 1695,
+	0, 0, 0, 0, 0, 0, 0, 465, 0, 0,
+	0, 0, 0, 511, 511, 511, 0, 0, 184, 0,
+	1103, 953, 953, 953, 0, 0, 0, 0, 637, 0,
+	0, 0, 0, 0, 1133, 0, 0, 0, 0, 0,
+	0, 35, 0, 0, 0, 0, 0, 0, 0, 1235,
+	0, 0, 0, 0, 1018, 1020, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 882, 0, 0, 1235, 1235, 891, 0, 0, 0,
+	465, 0, 0, 0, 0, 1033, 0, 0, 0, 1038,
+	1039, 1040, 1041, 1042, 1043, 1044, 1045, 0, 1048, 1050,
+	1053, 1053, 1053, 1050, 1053, 1053, 1050, 1053, 1066, 1067,
+	1068, 1069, 1070, 1071, 1072, 465, 0, 0, 0, 0,
+	1078, 0, 0, 0, 0, 0, 465, 35, 0, 0,
+	1339, 0, 0, 990, 0, 0, 511, 511, 0, 0,
+	0, 0, 0, 0, 0, 0, 465, 0, 0, 511,
+	0, 0, 511, 465, 1116, 0, 0, 0, 0, 0,
+	1360, 1361, 465, 465, 465, 465, 465, 465, 465, 523,
+	0, 0, 0, 0, 0, 465, 0, 0, 1047, 0,
+	0, 0, 0, 0, 0, 0, 0, 511, 511, 511,
+	184, 0, 0, 0, 0, 0, 0, 0, 465, 0,
+	0, 511, 0, 511, 0, 0, 0, 783, 0, 511,
+	0, 0, 0, 1087, 1090, 0, 0, 0, 0, 0,
+	1234, 0, 0, 0, 1240, 1240, 0, 1240, 0, 1240,
+	1240, 184, 1249, 1240, 1240, 1240, 1240, 1240, 0, 0,
+	0, 0, 511, 184, 0, 1234, 1234, 783, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	623, 1339, 0, 0, 0, 623, 623, 0, 0, 623,
+	623, 623, 0, 0, 0, 1235, 0, 0, 1309, 0,
+	0, 0, 0, 0, 0, 0, 0, 1900, 1901, 511,
+	511, 0, 0, 0, 0, 623, 623, 623, 623, 623,
+	0, 0, 1921, 1922, 1480, 1923, 1924, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1930, 1931, 0, 511,
+	0, 0, 0, 511, 0, 0, 465, 0, 0, 0,
+	0, 0, 1339, 465, 0, 465, 0, 0, 0, 0,
+	637, 637, 637, 465, 465, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 896, 0, 0, 0, 0,
+	902, 0, 0, 904, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1980,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 465, 0, 0, 0, 0, 0, 0,
+	1432, 1605, 637, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1464, 1465, 0, 0, 0, 953,
+	953, 953, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2033,
+	1498, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1103, 0, 0, 637, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1342, 0, 0, 0, 0,
+	0, 637, 1109, 0, 637, 1120, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 783, 0, 465, 0, 0,
+	0, 465, 465, 465, 465, 465, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 465, 465, 465,
+	465, 0, 0, 0, 1672, 0, 0, 0, 0, 0,
+	465, 0, 0, 0, 0, 0, 0, 465, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	790, 0, 0, 1392, 1393, 1394, 1395, 0, 1601, 0,
+	0, 0, 0, 465, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 783, 0, 0, 0,
+	0, 0, 790, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2117, 2118, 2119,
+	2120, 2121, 0, 1530, 0, 2124, 2125, 0, 1446, 1447,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 623, 623, 0, 0, 0, 783, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1138, 0, 0,
+	0, 0, 623, 0, 0, 0, 523, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 465, 0,
+	0, 0, 0, 0, 0, 0, 1480, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 623,
+	465, 0, 0, 0, 0, 0, 0, 0, 0, 1548,
+	1235, 465, 465, 465, 465, 465, 0, 0, 0, 0,
+	1271, 0, 0, 1785, 0, 0, 0, 465, 0, 0,
+	465, 465, 0, 0, 465, 1795, 1339, 0, 0, 0,
+	0, 0, 0, 0, 0, 1682, 0, 0, 0, 0,
+	0, 0, 2220, 0, 0, 1319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1335, 0, 1586, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1349, 0, 0, 0,
+	0, 0, 0, 1353, 0, 0, 0, 465, 0, 0,
+	0, 0, 1362, 1363, 1364, 1365, 1366, 1367, 1368, 0,
+	0, 0, 1235, 0, 0, 1375, 0, 0, 0, 0,
+	0, 0, 1339, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1120, 0,
+	0, 0, 0, 0, 465, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 465, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1234, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	623, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1703, 0, 0, 600, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 465, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1235, 0, 0, 0, 0, 1505, 0, 0, 0,
+	0, 0, 0, 1509, 1740, 1512, 0, 0, 0, 1851,
+	0, 0, 0, 1234, 1531, 1858, 0, 465, 1851, 0,
+	0, 0, 0, 637, 0, 1863, 0, 0, 0, 0,
+	1116, 0, 0, 0, 0, 0, 0, 1767, 1768, 0,
+	0, 1116, 1116, 1116, 1116, 1116, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1530, 0, 0,
+	1116, 0, 0, 0, 1116, 0, 0, 0, 1723, 0,
+	465, 0, 0, 465, 465, 465, 0, 0, 0, 0,
+	0, 0, 1235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 465, 1598, 0, 0, 0, 0, 1747, 1748,
+	1090, 0, 637, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 465, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1240, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1864, 0, 0, 1943, 637,
+	0, 0, 1234, 0, 0, 1955, 1240, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1235, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1120, 0, 0,
+	0, 1653, 1654, 1655, 1656, 1657, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1664, 1665, 1120,
+	1667, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1673, 0, 0, 0, 0, 0, 0, 1676, 0, 0,
+	783, 0, 0, 1234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1886,
+	0, 0, 0, 1681, 0, 0, 0, 0, 0, 0,
+	0, 2025, 2026, 2027, 0, 0, 0, 1480, 0, 0,
+	0, 0, 1952, 0, 35, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1116, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 465, 0,
+	1928, 0, 0, 0, 0, 173, 0, 0, 0, 0,
+	465, 0, 0, 0, 0, 0, 1211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1234, 0, 0, 0,
+	115, 0, 137, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 0, 1851, 2099, 0, 136, 0, 0,
+	0, 0, 0, 0, 1235, 0, 0, 1851, 0, 0,
+	637, 0, 0, 0, 0, 154, 0, 155, 0, 0,
+	0, 1792, 1215, 1216, 146, 145, 172, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2051, 0, 0, 1851, 1851, 1851, 0, 2057,
+	2058, 2059, 0, 0, 0, 0, 0, 0, 0, 2141,
+	0, 2143, 0, 0, 0, 0, 0, 1851, 0, 0,
+	0, 0, 0, 0, 141, 1217, 148, 0, 1214, 0,
+	142, 143, 0, 0, 0, 0, 158, 1845, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 0, 0, 0,
+	1851, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2054, 0, 0,
+	0, 0, 0, 0, 1875, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 637, 637, 0,
+	523, 0, 0, 0, 0, 1891, 0, 2077, 0, 0,
+	2078, 0, 0, 2080, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1234, 0, 2218, 0, 0,
+	0, 1851, 0, 0, 0, 1952, 0, 35, 0, 1952,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 35, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1940, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1952, 0, 0, 0,
+	144, 0, 2135, 523, 0, 0, 0, 0, 35, 2192,
+	0, 0, 138, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2002, 0, 0, 2003, 2004, 2005, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2015, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2028, 151, 156, 153, 159,
+	160, 161, 162, 164, 165, 166, 167, 0, 0, 0,
+	0, 0, 168, 169, 170, 171, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 762, 749, 0, 0, 698, 765, 669, 687,
+	774, 689, 692, 732, 648, 711, 330, 684, 0, 673,
+	644, 680, 645, 671, 700, 240, 704, 668, 751, 714,
+	764, 288, 0, 650, 674, 344, 734, 384, 226, 297,
+	295, 412, 250, 243, 239, 225, 272, 303, 342, 402,
+	336, 771, 292, 721, 0, 393, 315, 0, 0, 0,
+	702, 754, 709, 745, 697, 733, 658, 720, 766, 685,
+	729, 767, 278, 224, 193, 327, 394, 254, 0, 0,
+	0, 185, 186, 187, 0, 2199, 2200, 0, 0, 0,
+	0, 0, 215, 0, 222, 726, 761, 682, 728, 236,
+	276, 242, 235, 409, 731, 777, 643, 723, 0, 646,
+	649, 773, 757, 677, 678, 0, 0, 0, 0, 0,
+	0, 0, 701, 710, 742, 695, 0, 0, 0, 0,
+	0, 0, 0, 0, 675, 0, 719, 0, 0, 0,
+	654, 647, 0, 0, 0, 0, 699, 0, 2153, 0,
+	657, 0, 676, 743, 0, 641, 262, 651, 316, 0,
+	2162, 747, 756, 696, 440, 760, 694, 693, 763, 738,
+	655, 753, 688, 287, 653, 284, 189, 204, 0, 686,
+	326, 366, 373, 752, 672, 681, 227, 679, 370, 340,
+	426, 211, 252, 363, 345, 368, 718, 736, 369, 293,
+	414, 357, 424, 441, 442, 234, 320, 432, 406, 438,
+	454, 205, 231, 334, 399, 429, 390, 313, 410, 411,
+	283, 389, 260, 192, 291, 449, 203, 379, 219, 452,
+	372, 359, 196, 401, 422, 216, 382, 0, 0, 0,
+	198, 420, 398, 310, 280, 281, 197, 0, 362, 238,
+	258, 229, 329, 417, 418, 228, 456, 207, 437, 200,
+	955, 436, 322, 413, 421, 311, 302, 199, 419, 309,
+	301, 286, 248, 268, 355, 296, 356, 269, 318, 317,
+	319, 0, 194, 0, 395, 430, 457, 213, 667, 748,
+	408, 446, 453, 0, 358, 214, 259, 247, 354, 257,
+	289, 445, 447, 448, 450, 451, 212, 352, 265, 333,
+	425, 251, 433, 321, 208, 271, 391, 285, 294, 740,
+	776, 339, 371, 217, 428, 392, 662, 666, 660, 661,
+	712, 713, 663, 768, 769, 770, 744, 656, 0, 664,
+	665, 0, 750, 758, 759, 717, 188, 201, 290, 772,
+	360, 255, 455, 435, 431, 642, 659, 233, 670, 0,
+	0, 683, 690, 691, 703, 705, 706, 707, 708, 716,
+	724, 725, 727, 735, 737, 739, 741, 746, 755, 775,
+	190, 191, 202, 210, 220, 232, 245, 253, 263, 267,
+	270, 273, 274, 277, 282, 299, 304, 305, 306, 307,
+	323, 324, 325, 328, 331, 332, 335, 337, 338, 341,
+	347, 348, 349, 350, 351, 353, 361, 365, 374, 375,
+	376, 377, 378, 380, 381, 385, 386, 387, 388, 396,
+	400, 415, 416, 427, 439, 443, 264, 423, 444, 0,
+	298, 715, 722, 300, 249, 266, 275, 730, 434, 397,
+	206, 367, 256, 195, 223, 209, 230, 244, 246, 279,
+	308, 314, 343, 346, 261, 241, 221, 364, 218, 383,
+	403, 404, 405, 407, 312, 237, 762, 749, 0, 0,
+	698, 765, 669, 687, 774, 689, 692, 732, 648, 711,
+	330, 684, 0, 673, 644, 680, 645, 671, 700, 240,
+	704, 668, 751, 714, 764, 288, 0, 650, 674, 344,
+	734, 384, 226, 297, 295, 412, 250, 243, 239, 225,
+	272, 303, 342, 402, 336, 771, 292, 721, 0, 393,
+	315, 0, 0, 0, 702, 754, 709, 745, 697, 733,
+	658, 720, 766, 685, 729, 767, 278, 224, 193, 327,
+	394, 254, 0, 0, 0, 185, 186, 187, 0, 0,
+	0, 0, 0, 0, 0, 0, 215, 0, 222, 726,
+	761, 682, 728, 236, 276, 242, 235, 409, 731, 777,
+	643, 723, 0, 646, 649, 773, 757, 677, 678, 0,
+	0, 0, 0, 0, 0, 0, 701, 710, 742, 695,
+	0, 0, 0, 0, 0, 0, 1944, 0, 675, 0,
+	719, 0, 0, 0, 654, 647, 0, 0, 0, 0,
+	699, 0, 0, 0, 657, 0, 676, 743, 0, 641,
+	262, 651, 316, 0, 0, 747, 756, 696, 440, 760,
+	694, 693, 763, 738, 655, 753, 688, 287, 653, 284,
+	189, 204, 0, 686, 326, 366, 373, 752, 672, 681,
+	227, 679, 370, 340, 426, 211, 252, 363, 345, 368,
+	718, 736, 369, 293, 414, 357, 424, 441, 442, 234,
+	320, 432, 406, 438, 454, 205, 231, 334, 399, 429,
+	390, 313, 410, 411, 283, 389, 260, 192, 291, 449,
+	203, 379, 219, 452, 372, 359, 196, 401, 422, 216,
+	382, 0, 0, 0, 198, 420, 398, 310, 280, 281,
+	197, 0, 362, 238, 258, 229, 329, 417, 418, 228,
+	456, 207, 437, 200, 955, 436, 322, 413, 421, 311,
+	302, 199, 419, 309, 301, 286, 248, 268, 355, 296,
+	356, 269, 318, 317, 319, 0, 194, 0, 395, 430,
+	457, 213, 667, 748, 408, 446, 453, 0, 358, 214,
+	259, 247, 354, 257, 289, 445, 447, 448, 450, 451,
+	212, 352, 265, 333, 425, 251, 433, 321, 208, 271,
+	391, 285, 294, 740, 776, 339, 371, 217, 428, 392,
+	662, 666, 660, 661, 712, 713, 663, 768, 769, 770,
+	744, 656, 0, 664, 665, 0, 750, 758, 759, 717,
+	188, 201, 290, 772, 360, 255, 455, 435, 431, 642,
+	659, 233, 670, 0, 0, 683, 690, 691, 703, 705,
+	706, 707, 708, 716, 724, 725, 727, 735, 737, 739,
+	741, 746, 755, 775, 190, 191, 202, 210, 220, 232,
+	245, 253, 263, 267, 270, 273, 274, 277, 282, 299,
+	304, 305, 306, 307, 323, 324, 325, 328, 331, 332,
+	335, 337, 338, 341, 347, 348, 349, 350, 351, 353,
+	361, 365, 374, 375, 376, 377, 378, 380, 381, 385,
+	386, 387, 388, 396, 400, 415, 416, 427, 439, 443,
+	264, 423, 444, 0, 298, 715, 722, 300, 249, 266,
+	275, 730, 434, 397, 206, 367, 256, 195, 223, 209,
+	230, 244, 246, 279, 308, 314, 343, 346, 261, 241,
+	221, 364, 218, 383, 403, 404, 405, 407, 312, 237,
+	762, 749, 0, 0, 698, 765, 669, 687, 774, 689,
+	692, 732, 648, 711, 330, 684, 0, 673, 644, 680,
+	645, 671, 700, 240, 704, 668, 751, 714, 764, 288,
+	0, 650, 674, 344, 734, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 771,
+	292, 721, 0, 393, 315, 0, 0, 0, 702, 754,
+	709, 745, 697, 733, 658, 720, 766, 685, 729, 767,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 726, 761, 682, 728, 236, 276, 242,
+	235, 409, 731, 777, 643, 723, 0, 646, 649, 773,
+	757, 677, 678, 0, 0, 0, 0, 0, 0, 0,
+	701, 710, 742, 695, 0, 0, 0, 0, 0, 0,
+	1796, 0, 675, 0, 719, 0, 0, 0, 654, 647,
+	0, 0, 0, 0, 699, 0, 0, 0, 657, 0,
+	676, 743, 0, 641, 262, 651, 316, 0, 0, 747,
+	756, 696, 440, 760, 694, 693, 763, 738, 655, 753,
+	688, 287, 653, 284, 189, 204, 0, 686, 326, 366,
+	373, 752, 672, 681, 227, 679, 370, 340, 426, 211,
+	252, 363, 345, 368, 718, 736, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 955, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 667, 748, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 740, 776, 339,
+	371, 217, 428, 392, 662, 666, 660, 661, 712, 713,
+	663, 768, 769, 770, 744, 656, 0, 664, 665, 0,
+	750, 758, 759, 717, 188, 201, 290, 772, 360, 255,
+	455, 435, 431, 642, 659, 233, 670, 0, 0, 683,
+	690, 691, 703, 705, 706, 707, 708, 716, 724, 725,
+	727, 735, 737, 739, 741, 746, 755, 775, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 715,
+	722, 300, 249, 266, 275, 730, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 762, 749, 0, 0, 698, 765,
+	669, 687, 774, 689, 692, 732, 648, 711, 330, 684,
+	0, 673, 644, 680, 645, 671, 700, 240, 704, 668,
+	751, 714, 764, 288, 0, 650, 674, 344, 734, 384,
+	226, 297, 295, 412, 250, 243, 239, 225, 272, 303,
+	342, 402, 336, 771, 292, 721, 0, 393, 315, 0,
+	0, 0, 702, 754, 709, 745, 697, 733, 658, 720,
+	766, 685, 729, 767, 278, 224, 193, 327, 394, 254,
+	0, 0, 0, 185, 186, 187, 0, 0, 0, 0,
+	0, 0, 0, 0, 215, 0, 222, 726, 761, 682,
+	728, 236, 276, 242, 235, 409, 731, 777, 643, 723,
+	0, 646, 649, 773, 757, 677, 678, 0, 0, 0,
+	0, 0, 0, 0, 701, 710, 742, 695, 0, 0,
+	0, 0, 0, 0, 1507, 0, 675, 0, 719, 0,
+	0, 0, 654, 647, 0, 0, 0, 0, 699, 0,
+	0, 0, 657, 0, 676, 743, 0, 641, 262, 651,
+	316, 0, 0, 747, 756, 696, 440, 760, 694, 693,
+	763, 738, 655, 753, 688, 287, 653, 284, 189, 204,
+	0, 686, 326, 366, 373, 752, 672, 681, 227, 679,
+	370, 340, 426, 211, 252, 363, 345, 368, 718, 736,
+	369, 293, 414, 357, 424, 441, 442, 234, 320, 432,
+	406, 438, 454, 205, 231, 334, 399, 429, 390, 313,
+	410, 411, 283, 389, 260, 192, 291, 449, 203, 379,
+	219, 452, 372, 359, 196, 401, 422, 216, 382, 0,
+	0, 0, 198, 420, 398, 310, 280, 281, 197, 0,
+	362, 238, 258, 229, 329, 417, 418, 228, 456, 207,
+	437, 200, 955, 436, 322, 413, 421, 311, 302, 199,
+	419, 309, 301, 286, 248, 268, 355, 296, 356, 269,
+	318, 317, 319, 0, 194, 0, 395, 430, 457, 213,
+	667, 748, 408, 446, 453, 0, 358, 214, 259, 247,
+	354, 257, 289, 445, 447, 448, 450, 451, 212, 352,
+	265, 333, 425, 251, 433, 321, 208, 271, 391, 285,
+	294, 740, 776, 339, 371, 217, 428, 392, 662, 666,
+	660, 661, 712, 713, 663, 768, 769, 770, 744, 656,
+	0, 664, 665, 0, 750, 758, 759, 717, 188, 201,
+	290, 772, 360, 255, 455, 435, 431, 642, 659, 233,
+	670, 0, 0, 683, 690, 691, 703, 705, 706, 707,
+	708, 716, 724, 725, 727, 735, 737, 739, 741, 746,
+	755, 775, 190, 191, 202, 210, 220, 232, 245, 253,
+	263, 267, 270, 273, 274, 277, 282, 299, 304, 305,
+	306, 307, 323, 324, 325, 328, 331, 332, 335, 337,
+	338, 341, 347, 348, 349, 350, 351, 353, 361, 365,
+	374, 375, 376, 377, 378, 380, 381, 385, 386, 387,
+	388, 396, 400, 415, 416, 427, 439, 443, 264, 423,
+	444, 0, 298, 715, 722, 300, 249, 266, 275, 730,
+	434, 397, 206, 367, 256, 195, 223, 209, 230, 244,
+	246, 279, 308, 314, 343, 346, 261, 241, 221, 364,
+	218, 383, 403, 404, 405, 407, 312, 237, 762, 749,
+	0, 0, 698, 765, 669, 687, 774, 689, 692, 732,
+	648, 711, 330, 684, 0, 673, 644, 680, 645, 671,
+	700, 240, 704, 668, 751, 714, 764, 288, 0, 650,
+	674, 344, 734, 384, 226, 297, 295, 412, 250, 243,
+	239, 225, 272, 303, 342, 402, 336, 771, 292, 721,
+	0, 393, 315, 0, 0, 0, 702, 754, 709, 745,
+	697, 733, 658, 720, 766, 685, 729, 767, 278, 224,
+	193, 327, 394, 254, 73, 0, 0, 185, 186, 187,
+	0, 0, 0, 0, 0, 0, 0, 0, 215, 0,
+	222, 726, 761, 682, 728, 236, 276, 242, 235, 409,
+	731, 777, 643, 723, 0, 646, 649, 773, 757, 677,
+	678, 0, 0, 0, 0, 0, 0, 0, 701, 710,
+	742, 695, 0, 0, 0, 0, 0, 0, 0, 0,
+	675, 0, 719, 0, 0, 0, 654, 647, 0, 0,
+	0, 0, 699, 0, 0, 0, 657, 0, 676, 743,
+	0, 641, 262, 651, 316, 0, 0, 747, 756, 696,
+	440, 760, 694, 693, 763, 738, 655, 753, 688, 287,
+	653, 284, 189, 204, 0, 686, 326, 366, 373, 752,
+	672, 681, 227, 679, 370, 340, 426, 211, 252, 363,
+	345, 368, 718, 736, 369, 293, 414, 357, 424, 441,
+	442, 234, 320, 432, 406, 438, 454, 205, 231, 334,
+	399, 429, 390, 313, 410, 411, 283, 389, 260, 192,
+	291, 449, 203, 379, 219, 452, 372, 359, 196, 401,
+	422, 216, 382, 0, 0, 0, 198, 420, 398, 310,
+	280, 281, 197, 0, 362, 238, 258, 229, 329, 417,
+	418, 228, 456, 207, 437, 200, 955, 436, 322, 413,
+	421, 311, 302, 199, 419, 309, 301, 286, 248, 268,
+	355, 296, 356, 269, 318, 317, 319, 0, 194, 0,
+	395, 430, 457, 213, 667, 748, 408, 446, 453, 0,
+	358, 214, 259, 247, 354, 257, 289, 445, 447, 448,
+	450, 451, 212, 352, 265, 333, 425, 251, 433, 321,
+	208, 271, 391, 285, 294, 740, 776, 339, 371, 217,
+	428, 392, 662, 666, 660, 661, 712, 713, 663, 768,
+	769, 770, 744, 656, 0, 664, 665, 0, 750, 758,
+	759, 717, 188, 201, 290, 772, 360, 255, 455, 435,
+	431, 642, 659, 233, 670, 0, 0, 683, 690, 691,
+	703, 705, 706, 707, 708, 716, 724, 725, 727, 735,
+	737, 739, 741, 746, 755, 775, 190, 191, 202, 210,
+	220, 232, 245, 253, 263, 267, 270, 273, 274, 277,
+	282, 299, 304, 305, 306, 307, 323, 324, 325, 328,
+	331, 332, 335, 337, 338, 341, 347, 348, 349, 350,
+	351, 353, 361, 365, 374, 375, 376, 377, 378, 380,
+	381, 385, 386, 387, 388, 396, 400, 415, 416, 427,
+	439, 443, 264, 423, 444, 0, 298, 715, 722, 300,
+	249, 266, 275, 730, 434, 397, 206, 367, 256, 195,
+	223, 209, 230, 244, 246, 279, 308, 314, 343, 346,
+	261, 241, 221, 364, 218, 383, 403, 404, 405, 407,
+	312, 237, 762, 749, 0, 0, 698, 765, 669, 687,
+	774, 689, 692, 732, 648, 711, 330, 684, 0, 673,
+	644, 680, 645, 671, 700, 240, 704, 668, 751, 714,
+	764, 288, 0, 650, 674, 344, 734, 384, 226, 297,
+	295, 412, 250, 243, 239, 225, 272, 303, 342, 402,
+	336, 771, 292, 721, 0, 393, 315, 0, 0, 0,
+	702, 754, 709, 745, 697, 733, 658, 720, 766, 685,
+	729, 767, 278, 224, 193, 327, 394, 254, 0, 0,
+	0, 185, 186, 187, 0, 0, 0, 0, 0, 0,
+	0, 0, 215, 0, 222, 726, 761, 682, 728, 236,
+	276, 242, 235, 409, 731, 777, 643, 723, 0, 646,
+	649, 773, 757, 677, 678, 0, 0, 0, 0, 0,
+	0, 0, 701, 710, 742, 695, 0, 0, 0, 0,
+	0, 0, 0, 0, 675, 0, 719, 0, 0, 0,
+	654, 647, 0, 0, 0, 0, 699, 0, 0, 0,
+	657, 0, 676, 743, 0, 641, 262, 651, 316, 0,
+	0, 747, 756, 696, 440, 760, 694, 693, 763, 738,
+	655, 753, 688, 287, 653, 284, 189, 204, 0, 686,
+	326, 366, 373, 752, 672, 681, 227, 679, 370, 340,
+	426, 211, 252, 363, 345, 368, 718, 736, 369, 293,
+	414, 357, 424, 441, 442, 234, 320, 432, 406, 438,
+	454, 205, 231, 334, 399, 429, 390, 313, 410, 411,
+	283, 389, 260, 192, 291, 449, 203, 379, 219, 452,
+	372, 359, 196, 401, 422, 216, 382, 0, 0, 0,
+	198, 420, 398, 310, 280, 281, 197, 0, 362, 238,
+	258, 229, 329, 417, 418, 228, 456, 207, 437, 200,
+	955, 436, 322, 413, 421, 311, 302, 199, 419, 309,
+	301, 286, 248, 268, 355, 296, 356, 269, 318, 317,
+	319, 0, 194, 0, 395, 430, 457, 213, 667, 748,
+	408, 446, 453, 0, 358, 214, 259, 247, 354, 257,
+	289, 445, 447, 448, 450, 451, 212, 352, 265, 333,
+	425, 251, 433, 321, 208, 271, 391, 285, 294, 740,
+	776, 339, 371, 217, 428, 392, 662, 666, 660, 661,
+	712, 713, 663, 768, 769, 770, 744, 656, 0, 664,
+	665, 0, 750, 758, 759, 717, 188, 201, 290, 772,
+	360, 255, 455, 435, 431, 642, 659, 233, 670, 0,
+	0, 683, 690, 691, 703, 705, 706, 707, 708, 716,
+	724, 725, 727, 735, 737, 739, 741, 746, 755, 775,
+	190, 191, 202, 210, 220, 232, 245, 253, 263, 267,
+	270, 273, 274, 277, 282, 299, 304, 305, 306, 307,
+	323, 324, 325, 328, 331, 332, 335, 337, 338, 341,
+	347, 348, 349, 350, 351, 353, 361, 365, 374, 375,
+	376, 377, 378, 380, 381, 385, 386, 387, 388, 396,
+	400, 415, 416, 427, 439, 443, 264, 423, 444, 0,
+	298, 715, 722, 300, 249, 266, 275, 730, 434, 397,
+	206, 367, 256, 195, 223, 209, 230, 244, 246, 279,
+	308, 314, 343, 346, 261, 241, 221, 364, 218, 383,
+	403, 404, 405, 407, 312, 237, 762, 749, 0, 0,
+	698, 765, 669, 687, 774, 689, 692, 732, 648, 711,
+	330, 684, 0, 673, 644, 680, 645, 671, 700, 240,
+	704, 668, 751, 714, 764, 288, 0, 650, 674, 344,
+	734, 384, 226, 297, 295, 412, 250, 243, 239, 225,
+	272, 303, 342, 402, 336, 771, 292, 721, 0, 393,
+	315, 0, 0, 0, 702, 754, 709, 745, 697, 733,
+	658, 720, 766, 685, 729, 767, 278, 224, 193, 327,
+	394, 254, 0, 0, 0, 185, 186, 187, 0, 0,
+	0, 0, 0, 0, 0, 0, 215, 0, 222, 726,
+	761, 682, 728, 236, 276, 242, 235, 409, 731, 777,
+	643, 723, 0, 646, 649, 773, 757, 677, 678, 0,
+	0, 0, 0, 0, 0, 0, 701, 710, 742, 695,
+	0, 0, 0, 0, 0, 0, 0, 0, 675, 0,
+	719, 0, 0, 0, 654, 647, 0, 0, 0, 0,
+	699, 0, 0, 0, 657, 0, 676, 743, 0, 641,
+	262, 651, 316, 0, 0, 747, 756, 696, 440, 760,
+	694, 693, 763, 738, 655, 753, 688, 287, 653, 284,
+	189, 204, 0, 686, 326, 366, 373, 752, 672, 681,
+	227, 679, 370, 340, 426, 211, 252, 363, 345, 368,
+	718, 736, 369, 293, 414, 357, 424, 441, 442, 234,
+	320, 432, 406, 438, 454, 205, 231, 334, 399, 429,
+	390, 313, 410, 411, 283, 389, 260, 192, 291, 449,
+	203, 379, 219, 452, 372, 359, 196, 401, 422, 216,
+	382, 0, 0, 0, 198, 420, 398, 310, 280, 281,
+	197, 0, 362, 238, 258, 229, 329, 417, 418, 228,
+	456, 207, 437, 200, 652, 436, 322, 413, 421, 311,
+	302, 199, 419, 309, 301, 286, 248, 268, 355, 296,
+	356, 269, 318, 317, 319, 0, 194, 0, 395, 430,
+	457, 213, 667, 748, 408, 446, 453, 0, 358, 214,
+	259, 247, 354, 257, 289, 445, 447, 448, 450, 451,
+	212, 352, 265, 333, 425, 251, 433, 640, 778, 634,
+	633, 285, 294, 740, 776, 339, 371, 217, 428, 392,
+	662, 666, 660, 661, 712, 713, 663, 768, 769, 770,
+	744, 656, 0, 664, 665, 0, 750, 758, 759, 717,
+	188, 201, 290, 772, 360, 255, 455, 435, 431, 642,
+	659, 233, 670, 0, 0, 683, 690, 691, 703, 705,
+	706, 707, 708, 716, 724, 725, 727, 735, 737, 739,
+	741, 746, 755, 775, 190, 191, 202, 210, 220, 232,
+	245, 253, 263, 267, 270, 273, 274, 277, 282, 299,
+	304, 305, 306, 307, 323, 324, 325, 328, 331, 332,
+	335, 337, 338, 341, 347, 348, 349, 350, 351, 353,
+	361, 365, 374, 375, 376, 377, 378, 380, 381, 385,
+	386, 387, 388, 396, 400, 415, 416, 427, 439, 443,
+	264, 423, 444, 0, 298, 715, 722, 300, 249, 266,
+	275, 730, 434, 397, 206, 367, 256, 195, 223, 209,
+	230, 244, 246, 279, 308, 314, 343, 346, 261, 241,
+	221, 364, 218, 383, 403, 404, 405, 407, 312, 237,
+	762, 749, 0, 0, 698, 765, 669, 687, 774, 689,
+	692, 732, 648, 711, 330, 684, 0, 673, 644, 680,
+	645, 671, 700, 240, 704, 668, 751, 714, 764, 288,
+	0, 650, 674, 344, 734, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 771,
+	292, 721, 0, 393, 315, 0, 0, 0, 702, 754,
+	709, 745, 697, 733, 658, 720, 766, 685, 729, 767,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 726, 761, 682, 728, 236, 276, 242,
+	235, 409, 731, 777, 643, 723, 0, 646, 649, 773,
+	757, 677, 678, 0, 0, 0, 0, 0, 0, 0,
+	701, 710, 742, 695, 0, 0, 0, 0, 0, 0,
+	0, 0, 675, 0, 719, 0, 0, 0, 654, 647,
+	0, 0, 0, 0, 699, 0, 0, 0, 657, 0,
+	676, 743, 0, 641, 262, 651, 316, 0, 0, 747,
+	756, 696, 440, 760, 694, 693, 763, 738, 655, 753,
+	688, 287, 653, 284, 189, 204, 0, 686, 326, 366,
+	373, 752, 672, 681, 227, 679, 370, 340, 426, 211,
+	252, 363, 345, 368, 718, 736, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 1124, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 652, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 667, 748, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 640, 778, 634, 633, 285, 294, 740, 776, 339,
+	371, 217, 428, 392, 662, 666, 660, 661, 712, 713,
+	663, 768, 769, 770, 744, 656, 0, 664, 665, 0,
+	750, 758, 759, 717, 188, 201, 290, 772, 360, 255,
+	455, 435, 431, 642, 659, 233, 670, 0, 0, 683,
+	690, 691, 703, 705, 706, 707, 708, 716, 724, 725,
+	727, 735, 737, 739, 741, 746, 755, 775, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 715,
+	722, 300, 249, 266, 275, 730, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 762, 749, 0, 0, 698, 765,
+	669, 687, 774, 689, 692, 732, 648, 711, 330, 684,
+	0, 673, 644, 680, 645, 671, 700, 240, 704, 668,
+	751, 714, 764, 288, 0, 650, 674, 344, 734, 384,
+	226, 297, 295, 412, 250, 243, 239, 225, 272, 303,
+	342, 402, 336, 771, 292, 721, 0, 393, 315, 0,
+	0, 0, 702, 754, 709, 745, 697, 733, 658, 720,
+	766, 685, 729, 767, 278, 224, 193, 327, 394, 254,
+	0, 0, 0, 185, 186, 187, 0, 0, 0, 0,
+	0, 0, 0, 0, 215, 0, 222, 726, 761, 682,
+	728, 236, 276, 242, 235, 409, 731, 777, 643, 723,
+	0, 646, 649, 773, 757, 677, 678, 0, 0, 0,
+	0, 0, 0, 0, 701, 710, 742, 695, 0, 0,
+	0, 0, 0, 0, 0, 0, 675, 0, 719, 0,
+	0, 0, 654, 647, 0, 0, 0, 0, 699, 0,
+	0, 0, 657, 0, 676, 743, 0, 641, 262, 651,
+	316, 0, 0, 747, 756, 696, 440, 760, 694, 693,
+	763, 738, 655, 753, 688, 287, 653, 284, 189, 204,
+	0, 686, 326, 366, 373, 752, 672, 681, 227, 679,
+	370, 340, 426, 211, 252, 363, 345, 368, 718, 736,
+	369, 293, 414, 357, 424, 441, 442, 234, 320, 432,
+	406, 438, 454, 205, 231, 334, 399, 429, 390, 313,
+	410, 411, 283, 389, 260, 192, 291, 449, 203, 379,
+	219, 452, 372, 359, 196, 401, 631, 216, 382, 0,
+	0, 0, 198, 420, 398, 310, 280, 281, 197, 0,
+	362, 238, 258, 229, 329, 417, 418, 228, 456, 207,
+	437, 200, 652, 436, 322, 413, 421, 311, 302, 199,
+	419, 309, 301, 286, 248, 268, 355, 296, 356, 269,
+	318, 317, 319, 0, 194, 0, 395, 430, 457, 213,
+	667, 748, 408, 446, 453, 0, 358, 214, 259, 247,
+	354, 257, 289, 445, 447, 448, 450, 451, 212, 352,
+	265, 333, 425, 251, 433, 640, 778, 634, 633, 285,
+	294, 740, 776, 339, 371, 217, 428, 392, 662, 666,
+	660, 661, 712, 713, 663, 768, 769, 770, 744, 656,
+	0, 664, 665, 0, 750, 758, 759, 717, 188, 201,
+	290, 772, 360, 255, 455, 435, 431, 642, 659, 233,
+	670, 0, 0, 683, 690, 691, 703, 705, 706, 707,
+	708, 716, 724, 725, 727, 735, 737, 739, 741, 746,
+	755, 775, 190, 191, 202, 210, 220, 232, 245, 253,
+	263, 267, 270, 273, 274, 277, 282, 299, 304, 305,
+	306, 307, 323, 324, 325, 328, 331, 332, 335, 337,
+	338, 341, 347, 348, 349, 350, 351, 353, 361, 365,
+	374, 375, 376, 377, 378, 380, 381, 385, 386, 387,
+	388, 396, 400, 415, 416, 427, 439, 443, 264, 423,
+	444, 0, 298, 715, 722, 300, 249, 266, 275, 730,
+	434, 397, 206, 367, 256, 195, 223, 209, 230, 244,
+	246, 279, 308, 314, 343, 346, 261, 241, 221, 364,
+	218, 383, 403, 404, 405, 407, 312, 237, 330, 0,
+	0, 1434, 0, 528, 0, 0, 0, 240, 0, 527,
+	0, 0, 0, 288, 0, 0, 1435, 344, 0, 384,
+	226, 297, 295, 412, 250, 243, 239, 225, 272, 303,
+	342, 402, 336, 571, 292, 0, 0, 393, 315, 0,
+	0, 0, 0, 0, 562, 563, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 224, 193, 327, 394, 254,
+	73, 0, 0, 185, 186, 187, 549, 548, 551, 552,
+	553, 554, 0, 0, 215, 550, 222, 555, 556, 557,
+	0, 236, 276, 242, 235, 409, 0, 0, 0, 525,
+	542, 0, 570, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 539, 540, 621, 0, 0, 0, 586, 0,
+	541, 0, 0, 534, 535, 537, 536, 538, 543, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	316, 0, 0, 585, 0, 0, 440, 0, 0, 583,
+	0, 0, 0, 0, 0, 287, 0, 284, 189, 204,
+	0, 0, 326, 366, 373, 0, 0, 0, 227, 0,
+	370, 340, 426, 211, 252, 363, 345, 368, 0, 0,
+	369, 293, 414, 357, 424, 441, 442, 234, 320, 432,
+	406, 438, 454, 205, 231, 334, 399, 429, 390, 313,
+	410, 411, 283, 389, 260, 192, 291, 449, 203, 379,
+	219, 452, 372, 359, 196, 401, 422, 216, 382, 0,
+	0, 0, 198, 420, 398, 310, 280, 281, 197, 0,
+	362, 238, 258, 229, 329, 417, 418, 228, 456, 207,
+	437, 200, 0, 436, 322, 413, 421, 311, 302, 199,
+	419, 309, 301, 286, 248, 268, 355, 296, 356, 269,
+	318, 317, 319, 0, 194, 0, 395, 430, 457, 213,
+	0, 0, 408, 446, 453, 0, 358, 214, 259, 247,
+	354, 257, 289, 445, 447, 448, 450, 451, 212, 352,
+	265, 333, 425, 251, 433, 321, 208, 271, 391, 285,
+	294, 0, 0, 339, 371, 217, 428, 392, 573, 584,
+	579, 580, 577, 578, 572, 576, 575, 574, 587, 564,
+	565, 566, 567, 569, 0, 581, 582, 568, 188, 201,
+	290, 0, 360, 255, 455, 435, 431, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 191, 202, 210, 220, 232, 245, 253,
+	263, 267, 270, 273, 274, 277, 282, 299, 304, 305,
+	306, 307, 323, 324, 325, 328, 331, 332, 335, 337,
+	338, 341, 347, 348, 349, 350, 351, 353, 361, 365,
+	374, 375, 376, 377, 378, 380, 381, 385, 386, 387,
+	388, 396, 400, 415, 416, 427, 439, 443, 264, 423,
+	444, 0, 298, 0, 0, 300, 249, 266, 275, 0,
+	434, 397, 206, 367, 256, 195, 223, 209, 230, 244,
+	246, 279, 308, 314, 343, 346, 261, 241, 221, 364,
+	218, 383, 403, 404, 405, 407, 312, 237, 330, 0,
+	0, 0, 0, 528, 0, 0, 0, 240, 0, 527,
+	0, 0, 0, 288, 0, 0, 0, 344, 0, 384,
+	226, 297, 295, 412, 250, 243, 239, 225, 272, 303,
+	342, 402, 336, 571, 292, 0, 0, 393, 315, 0,
+	0, 0, 0, 0, 562, 563, 0, 0, 0, 0,
+	0, 0, 1546, 0, 278, 224, 193, 327, 394, 254,
+	73, 0, 0, 185, 186, 187, 549, 548, 551, 552,
+	553, 554, 0, 0, 215, 550, 222, 555, 556, 557,
+	1547, 236, 276, 242, 235, 409, 0, 0, 0, 525,
+	542, 0, 570, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 539, 540, 0, 0, 0, 0, 586, 0,
+	541, 0, 0, 534, 535, 537, 536, 538, 543, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	316, 0, 0, 585, 0, 0, 440, 0, 0, 583,
+	0, 0, 0, 0, 0, 287, 0, 284, 189, 204,
+	0, 0, 326, 366, 373, 0, 0, 0, 227, 0,
+	370, 340, 426, 211, 252, 363, 345, 368, 0, 0,
+	369, 293, 414, 357, 424, 441, 442, 234, 320, 432,
+	406, 438, 454, 205, 231, 334, 399, 429, 390, 313,
+	410, 411, 283, 389, 260, 192, 291, 449, 203, 379,
+	219, 452, 372, 359, 196, 401, 422, 216, 382, 0,
+	0, 0, 198, 420, 398, 310, 280, 281, 197, 0,
+	362, 238, 258, 229, 329, 417, 418, 228, 456, 207,
+	437, 200, 0, 436, 322, 413, 421, 311, 302, 199,
+	419, 309, 301, 286, 248, 268, 355, 296, 356, 269,
+	318, 317, 319, 0, 194, 0, 395, 430, 457, 213,
+	0, 0, 408, 446, 453, 0, 358, 214, 259, 247,
+	354, 257, 289, 445, 447, 448, 450, 451, 212, 352,
+	265, 333, 425, 251, 433, 321, 208, 271, 391, 285,
+	294, 0, 0, 339, 371, 217, 428, 392, 573, 584,
+	579, 580, 577, 578, 572, 576, 575, 574, 587, 564,
+	565, 566, 567, 569, 0, 581, 582, 568, 188, 201,
+	290, 0, 360, 255, 455, 435, 431, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 191, 202, 210, 220, 232, 245, 253,
+	263, 267, 270, 273, 274, 277, 282, 299, 304, 305,
+	306, 307, 323, 324, 325, 328, 331, 332, 335, 337,
+	338, 341, 347, 348, 349, 350, 351, 353, 361, 365,
+	374, 375, 376, 377, 378, 380, 381, 385, 386, 387,
+	388, 396, 400, 415, 416, 427, 439, 443, 264, 423,
+	444, 0, 298, 0, 0, 300, 249, 266, 275, 0,
+	434, 397, 206, 367, 256, 195, 223, 209, 230, 244,
+	246, 279, 308, 314, 343, 346, 261, 241, 221, 364,
+	218, 383, 403, 404, 405, 407, 312, 237, 330, 0,
+	0, 0, 0, 528, 0, 0, 0, 240, 0, 527,
+	0, 0, 0, 288, 0, 0, 0, 344, 0, 384,
+	226, 297, 295, 412, 250, 243, 239, 225, 272, 303,
+	342, 402, 336, 571, 292, 0, 0, 393, 315, 0,
+	0, 0, 0, 0, 562, 563, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 224, 193, 327, 394, 254,
+	73, 0, 608, 185, 186, 187, 549, 548, 551, 552,
+	553, 554, 0, 0, 215, 550, 222, 555, 556, 557,
+	0, 236, 276, 242, 235, 409, 0, 0, 0, 525,
+	542, 0, 570, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 539, 540, 0, 0, 0, 0, 586, 0,
+	541, 0, 0, 534, 535, 537, 536, 538, 543, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	316, 0, 0, 585, 0, 0, 440, 0, 0, 583,
+	0, 0, 0, 0, 0, 287, 0, 284, 189, 204,
+	0, 0, 326, 366, 373, 0, 0, 0, 227, 0,
+	370, 340, 426, 211, 252, 363, 345, 368, 0, 0,
+	369, 293, 414, 357, 424, 441, 442, 234, 320, 432,
+	406, 438, 454, 205, 231, 334, 399, 429, 390, 313,
+	410, 411, 283, 389, 260, 192, 291, 449, 203, 379,
+	219, 452, 372, 359, 196, 401, 422, 216, 382, 0,
+	0, 0, 198, 420, 398, 310, 280, 281, 197, 0,
+	362, 238, 258, 229, 329, 417, 418, 228, 456, 207,
+	437, 200, 0, 436, 322, 413, 421, 311, 302, 199,
+	419, 309, 301, 286, 248, 268, 355, 296, 356, 269,
+	318, 317, 319, 0, 194, 0, 395, 430, 457, 213,
+	0, 0, 408, 446, 453, 0, 358, 214, 259, 247,
+	354, 257, 289, 445, 447, 448, 450, 451, 212, 352,
+	265, 333, 425, 251, 433, 321, 208, 271, 391, 285,
+	294, 0, 0, 339, 371, 217, 428, 392, 573, 584,
+	579, 580, 577, 578, 572, 576, 575, 574, 587, 564,
+	565, 566, 567, 569, 0, 581, 582, 568, 188, 201,
+	290, 0, 360, 255, 455, 435, 431, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 191, 202, 210, 220, 232, 245, 253,
+	263, 267, 270, 273, 274, 277, 282, 299, 304, 305,
+	306, 307, 323, 324, 325, 328, 331, 332, 335, 337,
+	338, 341, 347, 348, 349, 350, 351, 353, 361, 365,
+	374, 375, 376, 377, 378, 380, 381, 385, 386, 387,
+	388, 396, 400, 415, 416, 427, 439, 443, 264, 423,
+	444, 0, 298, 0, 0, 300, 249, 266, 275, 0,
+	434, 397, 206, 367, 256, 195, 223, 209, 230, 244,
+	246, 279, 308, 314, 343, 346, 261, 241, 221, 364,
+	218, 383, 403, 404, 405, 407, 312, 237, 330, 0,
+	0, 0, 0, 528, 0, 0, 0, 240, 0, 527,
+	0, 0, 0, 288, 0, 0, 0, 344, 0, 384,
+	226, 297, 295, 412, 250, 243, 239, 225, 272, 303,
+	342, 402, 336, 571, 292, 0, 0, 393, 315, 0,
+	0, 0, 0, 0, 562, 563, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 224, 193, 327, 394, 254,
+	73, 0, 0, 185, 186, 187, 549, 548, 551, 552,
+	553, 554, 0, 0, 215, 550, 222, 555, 556, 557,
+	0, 236, 276, 242, 235, 409, 0, 0, 0, 525,
+	542, 0, 570, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 539, 540, 621, 0, 0, 0, 586, 0,
+	541, 0, 0, 534, 535, 537, 536, 538, 543, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	316, 0, 0, 585, 0, 0, 440, 0, 0, 583,
+	0, 0, 0, 0, 0, 287, 0, 284, 189, 204,
+	0, 0, 326, 366, 373, 0, 0, 0, 227, 0,
+	370, 340, 426, 211, 252, 363, 345, 368, 0, 0,
+	369, 293, 414, 357, 424, 441, 442, 234, 320, 432,
+	406, 438, 454, 205, 231, 334, 399, 429, 390, 313,
+	410, 411, 283, 389, 260, 192, 291, 449, 203, 379,
+	219, 452, 372, 359, 196, 401, 422, 216, 382, 0,
+	0, 0, 198, 420, 398, 310, 280, 281, 197, 0,
+	362, 238, 258, 229, 329, 417, 418, 228, 456, 207,
+	437, 200, 0, 436, 322, 413, 421, 311, 302, 199,
+	419, 309, 301, 286, 248, 268, 355, 296, 356, 269,
+	318, 317, 319, 0, 194, 0, 395, 430, 457, 213,
+	0, 0, 408, 446, 453, 0, 358, 214, 259, 247,
+	354, 257, 289, 445, 447, 448, 450, 451, 212, 352,
+	265, 333, 425, 251, 433, 321, 208, 271, 391, 285,
+	294, 0, 0, 339, 371, 217, 428, 392, 573, 584,
+	579, 580, 577, 578, 572, 576, 575, 574, 587, 564,
+	565, 566, 567, 569, 0, 581, 582, 568, 188, 201,
+	290, 0, 360, 255, 455, 435, 431, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 191, 202, 210, 220, 232, 245, 253,
+	263, 267, 270, 273, 274, 277, 282, 299, 304, 305,
+	306, 307, 323, 324, 325, 328, 331, 332, 335, 337,
+	338, 341, 347, 348, 349, 350, 351, 353, 361, 365,
+	374, 375, 376, 377, 378, 380, 381, 385, 386, 387,
+	388, 396, 400, 415, 416, 427, 439, 443, 264, 423,
+	444, 0, 298, 0, 0, 300, 249, 266, 275, 0,
+	434, 397, 206, 367, 256, 195, 223, 209, 230, 244,
+	246, 279, 308, 314, 343, 346, 261, 241, 221, 364,
+	218, 383, 403, 404, 405, 407, 312, 237, 330, 0,
+	0, 0, 0, 528, 0, 0, 0, 240, 0, 527,
+	0, 0, 0, 288, 0, 0, 0, 344, 0, 384,
+	226, 297, 295, 412, 250, 243, 239, 225, 272, 303,
+	342, 402, 336, 571, 292, 0, 0, 393, 315, 0,
+	0, 0, 0, 0, 562, 563, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 224, 193, 327, 394, 254,
+	73, 0, 0, 185, 186, 187, 549, 1452, 551, 552,
+	553, 554, 0, 0, 215, 550, 222, 555, 556, 557,
+	0, 236, 276, 242, 235, 409, 0, 0, 0, 525,
+	542, 0, 570, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 539, 540, 621, 0, 0, 0, 586, 0,
+	541, 0, 0, 534, 535, 537, 536, 538, 543, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	316, 0, 0, 585, 0, 0, 440, 0, 0, 583,
+	0, 0, 0, 0, 0, 287, 0, 284, 189, 204,
+	0, 0, 326, 366, 373, 0, 0, 0, 227, 0,
+	370, 340, 426, 211, 252, 363, 345, 368, 0, 0,
+	369, 293, 414, 357, 424, 441, 442, 234, 320, 432,
+	406, 438, 454, 205, 231, 334, 399, 429, 390, 313,
+	410, 411, 283, 389, 260, 192, 291, 449, 203, 379,
+	219, 452, 372, 359, 196, 401, 422, 216, 382, 0,
+	0, 0, 198, 420, 398, 310, 280, 281, 197, 0,
+	362, 238, 258, 229, 329, 417, 418, 228, 456, 207,
+	437, 200, 0, 436, 322, 413, 421, 311, 302, 199,
+	419, 309, 301, 286, 248, 268, 355, 296, 356, 269,
+	318, 317, 319, 0, 194, 0, 395, 430, 457, 213,
+	0, 0, 408, 446, 453, 0, 358, 214, 259, 247,
+	354, 257, 289, 445, 447, 448, 450, 451, 212, 352,
+	265, 333, 425, 251, 433, 321, 208, 271, 391, 285,
+	294, 0, 0, 339, 371, 217, 428, 392, 573, 584,
+	579, 580, 577, 578, 572, 576, 575, 574, 587, 564,
+	565, 566, 567, 569, 0, 581, 582, 568, 188, 201,
+	290, 0, 360, 255, 455, 435, 431, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 191, 202, 210, 220, 232, 245, 253,
+	263, 267, 270, 273, 274, 277, 282, 299, 304, 305,
+	306, 307, 323, 324, 325, 328, 331, 332, 335, 337,
+	338, 341, 347, 348, 349, 350, 351, 353, 361, 365,
+	374, 375, 376, 377, 378, 380, 381, 385, 386, 387,
+	388, 396, 400, 415, 416, 427, 439, 443, 264, 423,
+	444, 0, 298, 0, 0, 300, 249, 266, 275, 0,
+	434, 397, 206, 367, 256, 195, 223, 209, 230, 244,
+	246, 279, 308, 314, 343, 346, 261, 241, 221, 364,
+	218, 383, 403, 404, 405, 407, 312, 237, 330, 0,
+	0, 0, 0, 528, 0, 0, 0, 240, 0, 527,
+	0, 0, 0, 288, 0, 0, 0, 344, 0, 384,
+	226, 297, 295, 412, 250, 243, 239, 225, 272, 303,
+	342, 402, 336, 571, 292, 0, 0, 393, 315, 0,
+	0, 0, 0, 0, 562, 563, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 224, 193, 327, 394, 254,
+	73, 0, 0, 185, 186, 187, 549, 1449, 551, 552,
+	553, 554, 0, 0, 215, 550, 222, 555, 556, 557,
+	0, 236, 276, 242, 235, 409, 0, 0, 0, 525,
+	542, 0, 570, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 539, 540, 621, 0, 0, 0, 586, 0,
+	541, 0, 0, 534, 535, 537, 536, 538, 543, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	316, 0, 0, 585, 0, 0, 440, 0, 0, 583,
+	0, 0, 0, 0, 0, 287, 0, 284, 189, 204,
+	0, 0, 326, 366, 373, 0, 0, 0, 227, 0,
+	370, 340, 426, 211, 252, 363, 345, 368, 0, 0,
+	369, 293, 414, 357, 424, 441, 442, 234, 320, 432,
+	406, 438, 454, 205, 231, 334, 399, 429, 390, 313,
+	410, 411, 283, 389, 260, 192, 291, 449, 203, 379,
+	219, 452, 372, 359, 196, 401, 422, 216, 382, 0,
+	0, 0, 198, 420, 398, 310, 280, 281, 197, 0,
+	362, 238, 258, 229, 329, 417, 418, 228, 456, 207,
+	437, 200, 0, 436, 322, 413, 421, 311, 302, 199,
+	419, 309, 301, 286, 248, 268, 355, 296, 356, 269,
+	318, 317, 319, 0, 194, 0, 395, 430, 457, 213,
+	0, 0, 408, 446, 453, 0, 358, 214, 259, 247,
+	354, 257, 289, 445, 447, 448, 450, 451, 212, 352,
+	265, 333, 425, 251, 433, 321, 208, 271, 391, 285,
+	294, 0, 0, 339, 371, 217, 428, 392, 573, 584,
+	579, 580, 577, 578, 572, 576, 575, 574, 587, 564,
+	565, 566, 567, 569, 0, 581, 582, 568, 188, 201,
+	290, 0, 360, 255, 455, 435, 431, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 191, 202, 210, 220, 232, 245, 253,
+	263, 267, 270, 273, 274, 277, 282, 299, 304, 305,
+	306, 307, 323, 324, 325, 328, 331, 332, 335, 337,
+	338, 341, 347, 348, 349, 350, 351, 353, 361, 365,
+	374, 375, 376, 377, 378, 380, 381, 385, 386, 387,
+	388, 396, 400, 415, 416, 427, 439, 443, 264, 423,
+	444, 0, 298, 0, 0, 300, 249, 266, 275, 0,
+	434, 397, 206, 367, 256, 195, 223, 209, 230, 244,
+	246, 279, 308, 314, 343, 346, 261, 241, 221, 364,
+	218, 383, 403, 404, 405, 407, 312, 237, 601, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 330, 0, 0, 0, 0, 528, 0, 0, 0,
+	240, 0, 527, 0, 0, 0, 288, 0, 0, 0,
+	344, 0, 384, 226, 297, 295, 412, 250, 243, 239,
+	225, 272, 303, 342, 402, 336, 571, 292, 0, 0,
+	393, 315, 0, 0, 0, 0, 0, 562, 563, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 224, 193,
+	327, 394, 254, 73, 0, 0, 185, 186, 187, 549,
+	548, 551, 552, 553, 554, 0, 0, 215, 550, 222,
+	555, 556, 557, 0, 236, 276, 242, 235, 409, 0,
+	0, 0, 525, 542, 0, 570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 539, 540, 0, 0, 0,
+	0, 586, 0, 541, 0, 0, 534, 535, 537, 536,
+	538, 543, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 316, 0, 0, 585, 0, 0, 440,
+	0, 0, 583, 0, 0, 0, 0, 0, 287, 0,
+	284, 189, 204, 0, 0, 326, 366, 373, 0, 0,
+	0, 227, 0, 370, 340, 426, 211, 252, 363, 345,
+	368, 0, 0, 369, 293, 414, 357, 424, 441, 442,
+	234, 320, 432, 406, 438, 454, 205, 231, 334, 399,
+	429, 390, 313, 410, 411, 283, 389, 260, 192, 291,
+	449, 203, 379, 219, 452, 372, 359, 196, 401, 422,
+	216, 382, 0, 0, 0, 198, 420, 398, 310, 280,
+	281, 197, 0, 362, 238, 258, 229, 329, 417, 418,
+	228, 456, 207, 437, 200, 0, 436, 322, 413, 421,
+	311, 302, 199, 419, 309, 301, 286, 248, 268, 355,
+	296, 356, 269, 318, 317, 319, 0, 194, 0, 395,
+	430, 457, 213, 0, 0, 408, 446, 453, 0, 358,
+	214, 259, 247, 354, 257, 289, 445, 447, 448, 450,
+	451, 212, 352, 265, 333, 425, 251, 433, 321, 208,
+	271, 391, 285, 294, 0, 0, 339, 371, 217, 428,
+	392, 573, 584, 579, 580, 577, 578, 572, 576, 575,
+	574, 587, 564, 565, 566, 567, 569, 0, 581, 582,
+	568, 188, 201, 290, 0, 360, 255, 455, 435, 431,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 191, 202, 210, 220,
+	232, 245, 253, 263, 267, 270, 273, 274, 277, 282,
+	299, 304, 305, 306, 307, 323, 324, 325, 328, 331,
+	332, 335, 337, 338, 341, 347, 348, 349, 350, 351,
+	353, 361, 365, 374, 375, 376, 377, 378, 380, 381,
+	385, 386, 387, 388, 396, 400, 415, 416, 427, 439,
+	443, 264, 423, 444, 0, 298, 0, 0, 300, 249,
+	266, 275, 0, 434, 397, 206, 367, 256, 195, 223,
+	209, 230, 244, 246, 279, 308, 314, 343, 346, 261,
+	241, 221, 364, 218, 383, 403, 404, 405, 407, 312,
+	237, 330, 0, 0, 0, 0, 528, 0, 0, 0,
+	240, 0, 527, 0, 0, 0, 288, 0, 0, 0,
+	344, 0, 384, 226, 297, 295, 412, 250, 243, 239,
+	225, 272, 303, 342, 402, 336, 571, 292, 0, 0,
+	393, 315, 0, 0, 0, 0, 0, 562, 563, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 224, 193,
+	327, 394, 254, 73, 0, 0, 185, 186, 187, 549,
+	548, 551, 552, 553, 554, 0, 0, 215, 550, 222,
+	555, 556, 557, 0, 236, 276, 242, 235, 409, 0,
+	0, 0, 525, 542, 0, 570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 539, 540, 0, 0, 0,
+	0, 586, 0, 541, 0, 0, 534, 535, 537, 536,
+	538, 543, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 316, 0, 0, 585, 0, 0, 440,
+	0, 0, 583, 0, 0, 0, 0, 0, 287, 0,
+	284, 189, 204, 0, 0, 326, 366, 373, 0, 0,
+	0, 227, 0, 370, 340, 426, 211, 252, 363, 345,
+	368, 0, 0, 369, 293, 414, 357, 424, 441, 442,
+	234, 320, 432, 406, 438, 454, 205, 231, 334, 399,
+	429, 390, 313, 410, 411, 283, 389, 260, 192, 291,
+	449, 203, 379, 219, 452, 372, 359, 196, 401, 422,
+	216, 382, 0, 0, 0, 198, 420, 398, 310, 280,
+	281, 197, 0, 362, 238, 258, 229, 329, 417, 418,
+	228, 456, 207, 437, 200, 0, 436, 322, 413, 421,
+	311, 302, 199, 419, 309, 301, 286, 248, 268, 355,
+	296, 356, 269, 318, 317, 319, 0, 194, 0, 395,
+	430, 457, 213, 0, 0, 408, 446, 453, 0, 358,
+	214, 259, 247, 354, 257, 289, 445, 447, 448, 450,
+	451, 212, 352, 265, 333, 425, 251, 433, 321, 208,
+	271, 391, 285, 294, 0, 0, 339, 371, 217, 428,
+	392, 573, 584, 579, 580, 577, 578, 572, 576, 575,
+	574, 587, 564, 565, 566, 567, 569, 0, 581, 582,
+	568, 188, 201, 290, 0, 360, 255, 455, 435, 431,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 191, 202, 210, 220,
+	232, 245, 253, 263, 267, 270, 273, 274, 277, 282,
+	299, 304, 305, 306, 307, 323, 324, 325, 328, 331,
+	332, 335, 337, 338, 341, 347, 348, 349, 350, 351,
+	353, 361, 365, 374, 375, 376, 377, 378, 380, 381,
+	385, 386, 387, 388, 396, 400, 415, 416, 427, 439,
+	443, 264, 423, 444, 0, 298, 0, 0, 300, 249,
+	266, 275, 0, 434, 397, 206, 367, 256, 195, 223,
+	209, 230, 244, 246, 279, 308, 314, 343, 346, 261,
+	241, 221, 364, 218, 383, 403, 404, 405, 407, 312,
+	237, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 0, 0, 0, 0, 288, 0, 0, 0,
+	344, 0, 384, 226, 297, 295, 412, 250, 243, 239,
+	225, 272, 303, 342, 402, 336, 571, 292, 0, 0,
+	393, 315, 0, 0, 0, 0, 0, 562, 563, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 224, 193,
+	327, 394, 254, 73, 0, 0, 185, 186, 187, 549,
+	548, 551, 552, 553, 554, 0, 0, 215, 550, 222,
+	555, 556, 557, 0, 236, 276, 242, 235, 409, 0,
+	0, 0, 0, 542, 0, 570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 539, 540, 0, 0, 0,
+	0, 586, 0, 541, 0, 0, 534, 535, 537, 536,
+	538, 543, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 316, 0, 0, 585, 0, 0, 440,
+	0, 0, 583, 0, 0, 0, 0, 0, 287, 0,
+	284, 189, 204, 0, 0, 326, 366, 373, 0, 0,
+	0, 227, 0, 370, 340, 426, 211, 252, 363, 345,
+	368, 2221, 0, 369, 293, 414, 357, 424, 441, 442,
+	234, 320, 432, 406, 438, 454, 205, 231, 334, 399,
+	429, 390, 313, 410, 411, 283, 389, 260, 192, 291,
+	449, 203, 379, 219, 452, 372, 359, 196, 401, 422,
+	216, 382, 0, 0, 0, 198, 420, 398, 310, 280,
+	281, 197, 0, 362, 238, 258, 229, 329, 417, 418,
+	228, 456, 207, 437, 200, 0, 436, 322, 413, 421,
+	311, 302, 199, 419, 309, 301, 286, 248, 268, 355,
+	296, 356, 269, 318, 317, 319, 0, 194, 0, 395,
+	430, 457, 213, 0, 0, 408, 446, 453, 0, 358,
+	214, 259, 247, 354, 257, 289, 445, 447, 448, 450,
+	451, 212, 352, 265, 333, 425, 251, 433, 321, 208,
+	271, 391, 285, 294, 0, 0, 339, 371, 217, 428,
+	392, 573, 584, 579, 580, 577, 578, 572, 576, 575,
+	574, 587, 564, 565, 566, 567, 569, 0, 581, 582,
+	568, 188, 201, 290, 0, 360, 255, 455, 435, 431,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 191, 202, 210, 220,
+	232, 245, 253, 263, 267, 270, 273, 274, 277, 282,
+	299, 304, 305, 306, 307, 323, 324, 325, 328, 331,
+	332, 335, 337, 338, 341, 347, 348, 349, 350, 351,
+	353, 361, 365, 374, 375, 376, 377, 378, 380, 381,
+	385, 386, 387, 388, 396, 400, 415, 416, 427, 439,
+	443, 264, 423, 444, 0, 298, 0, 0, 300, 249,
+	266, 275, 0, 434, 397, 206, 367, 256, 195, 223,
+	209, 230, 244, 246, 279, 308, 314, 343, 346, 261,
+	241, 221, 364, 218, 383, 403, 404, 405, 407, 312,
+	237, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 0, 0, 0, 0, 288, 0, 0, 0,
+	344, 0, 384, 226, 297, 295, 412, 250, 243, 239,
+	225, 272, 303, 342, 402, 336, 571, 292, 0, 0,
+	393, 315, 0, 0, 0, 0, 0, 562, 563, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 224, 193,
+	327, 394, 254, 73, 0, 608, 185, 186, 187, 549,
+	548, 551, 552, 553, 554, 0, 0, 215, 550, 222,
+	555, 556, 557, 0, 236, 276, 242, 235, 409, 0,
+	0, 0, 0, 542, 0, 570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 539, 540, 0, 0, 0,
+	0, 586, 0, 541, 0, 0, 534, 535, 537, 536,
+	538, 543, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 316, 0, 0, 585, 0, 0, 440,
+	0, 0, 583, 0, 0, 0, 0, 0, 287, 0,
+	284, 189, 204, 0, 0, 326, 366, 373, 0, 0,
+	0, 227, 0, 370, 340, 426, 211, 252, 363, 345,
+	368, 0, 0, 369, 293, 414, 357, 424, 441, 442,
+	234, 320, 432, 406, 438, 454, 205, 231, 334, 399,
+	429, 390, 313, 410, 411, 283, 389, 260, 192, 291,
+	449, 203, 379, 219, 452, 372, 359, 196, 401, 422,
+	216, 382, 0, 0, 0, 198, 420, 398, 310, 280,
+	281, 197, 0, 362, 238, 258, 229, 329, 417, 418,
+	228, 456, 207, 437, 200, 0, 436, 322, 413, 421,
+	311, 302, 199, 419, 309, 301, 286, 248, 268, 355,
+	296, 356, 269, 318, 317, 319, 0, 194, 0, 395,
+	430, 457, 213, 0, 0, 408, 446, 453, 0, 358,
+	214, 259, 247, 354, 257, 289, 445, 447, 448, 450,
+	451, 212, 352, 265, 333, 425, 251, 433, 321, 208,
+	271, 391, 285, 294, 0, 0, 339, 371, 217, 428,
+	392, 573, 584, 579, 580, 577, 578, 572, 576, 575,
+	574, 587, 564, 565, 566, 567, 569, 0, 581, 582,
+	568, 188, 201, 290, 0, 360, 255, 455, 435, 431,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 191, 202, 210, 220,
+	232, 245, 253, 263, 267, 270, 273, 274, 277, 282,
+	299, 304, 305, 306, 307, 323, 324, 325, 328, 331,
+	332, 335, 337, 338, 341, 347, 348, 349, 350, 351,
+	353, 361, 365, 374, 375, 376, 377, 378, 380, 381,
+	385, 386, 387, 388, 396, 400, 415, 416, 427, 439,
+	443, 264, 423, 444, 0, 298, 0, 0, 300, 249,
+	266, 275, 0, 434, 397, 206, 367, 256, 195, 223,
+	209, 230, 244, 246, 279, 308, 314, 343, 346, 261,
+	241, 221, 364, 218, 383, 403, 404, 405, 407, 312,
+	237, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 0, 0, 0, 0, 288, 0, 0, 0,
+	344, 0, 384, 226, 297, 295, 412, 250, 243, 239,
+	225, 272, 303, 342, 402, 336, 571, 292, 0, 0,
+	393, 315, 0, 0, 0, 0, 0, 562, 563, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 224, 193,
+	327, 394, 254, 73, 0, 0, 185, 186, 187, 549,
+	548, 551, 552, 553, 554, 0, 0, 215, 550, 222,
+	555, 556, 557, 0, 236, 276, 242, 235, 409, 0,
+	0, 0, 0, 542, 0, 570, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 539, 540, 0, 0, 0,
+	0, 586, 0, 541, 0, 0, 534, 535, 537, 536,
+	538, 543, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 316, 0, 0, 585, 0, 0, 440,
+	0, 0, 583, 0, 0, 0, 0, 0, 287, 0,
+	284, 189, 204, 0, 0, 326, 366, 373, 0, 0,
+	0, 227, 0, 370, 340, 426, 211, 252, 363, 345,
+	368, 0, 0, 369, 293, 414, 357, 424, 441, 442,
+	234, 320, 432, 406, 438, 454, 205, 231, 334, 399,
+	429, 390, 313, 410, 411, 283, 389, 260, 192, 291,
+	449, 203, 379, 219, 452, 372, 359, 196, 401, 422,
+	216, 382, 0, 0, 0, 198, 420, 398, 310, 280,
+	281, 197, 0, 362, 238, 258, 229, 329, 417, 418,
+	228, 456, 207, 437, 200, 0, 436, 322, 413, 421,
+	311, 302, 199, 419, 309, 301, 286, 248, 268, 355,
+	296, 356, 269, 318, 317, 319, 0, 194, 0, 395,
+	430, 457, 213, 0, 0, 408, 446, 453, 0, 358,
+	214, 259, 247, 354, 257, 289, 445, 447, 448, 450,
+	451, 212, 352, 265, 333, 425, 251, 433, 321, 208,
+	271, 391, 285, 294, 0, 0, 339, 371, 217, 428,
+	392, 573, 584, 579, 580, 577, 578, 572, 576, 575,
+	574, 587, 564, 565, 566, 567, 569, 0, 581, 582,
+	568, 188, 201, 290, 0, 360, 255, 455, 435, 431,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 191, 202, 210, 220,
+	232, 245, 253, 263, 267, 270, 273, 274, 277, 282,
+	299, 304, 305, 306, 307, 323, 324, 325, 328, 331,
+	332, 335, 337, 338, 341, 347, 348, 349, 350, 351,
+	353, 361, 365, 374, 375, 376, 377, 378, 380, 381,
+	385, 386, 387, 388, 396, 400, 415, 416, 427, 439,
+	443, 264, 423, 444, 0, 298, 0, 0, 300, 249,
+	266, 275, 0, 434, 397, 206, 367, 256, 195, 223,
+	209, 230, 244, 246, 279, 308, 314, 343, 346, 261,
+	241, 221, 364, 218, 383, 403, 404, 405, 407, 312,
+	237, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 0, 0, 0, 0, 288, 0, 0, 0,
+	344, 0, 384, 226, 297, 295, 412, 250, 243, 239,
+	225, 272, 303, 342, 402, 336, 0, 292, 0, 0,
+	393, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 224, 193,
+	327, 394, 254, 0, 0, 0, 185, 186, 187, 0,
+	0, 0, 0, 0, 0, 0, 0, 215, 0, 222,
+	0, 0, 0, 0, 236, 276, 242, 235, 409, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 998, 997, 1007, 1008, 1000, 1001, 1002, 1003, 1004,
+	1005, 1006, 999, 0, 0, 1009, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 316, 0, 0, 0, 0, 0, 440,
+	0, 0, 0, 0, 0, 0, 0, 0, 287, 0,
+	284, 189, 204, 0, 0, 326, 366, 373, 0, 0,
+	0, 227, 0, 370, 340, 426, 211, 252, 363, 345,
+	368, 0, 0, 369, 293, 414, 357, 424, 441, 442,
+	234, 320, 432, 406, 438, 454, 205, 231, 334, 399,
+	429, 390, 313, 410, 411, 283, 389, 260, 192, 291,
+	449, 203, 379, 219, 452, 372, 359, 196, 401, 422,
+	216, 382, 0, 0, 0, 198, 420, 398, 310, 280,
+	281, 197, 0, 362, 238, 258, 229, 329, 417, 418,
+	228, 456, 207, 437, 200, 0, 436, 322, 413, 421,
+	311, 302, 199, 419, 309, 301, 286, 248, 268, 355,
+	296, 356, 269, 318, 317, 319, 0, 194, 0, 395,
+	430, 457, 213, 0, 0, 408, 446, 453, 0, 358,
+	214, 259, 247, 354, 257, 289, 445, 447, 448, 450,
+	451, 212, 352, 265, 333, 425, 251, 433, 321, 208,
+	271, 391, 285, 294, 0, 0, 339, 371, 217, 428,
+	392, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 188, 201, 290, 0, 360, 255, 455, 435, 431,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 191, 202, 210, 220,
+	232, 245, 253, 263, 267, 270, 273, 274, 277, 282,
+	299, 304, 305, 306, 307, 323, 324, 325, 328, 331,
+	332, 335, 337, 338, 341, 347, 348, 349, 350, 351,
+	353, 361, 365, 374, 375, 376, 377, 378, 380, 381,
+	385, 386, 387, 388, 396, 400, 415, 416, 427, 439,
+	443, 264, 423, 444, 0, 298, 0, 0, 300, 249,
+	266, 275, 0, 434, 397, 206, 367, 256, 195, 223,
+	209, 230, 244, 246, 279, 308, 314, 343, 346, 261,
+	241, 221, 364, 218, 383, 403, 404, 405, 407, 312,
+	237, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 821, 0, 0, 0, 0, 288, 0, 0, 0,
+	344, 0, 384, 226, 297, 295, 412, 250, 243, 239,
+	225, 272, 303, 342, 402, 336, 0, 292, 0, 0,
+	393, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 224, 193,
+	327, 394, 254, 0, 0, 0, 185, 186, 187, 0,
+	0, 0, 0, 0, 0, 0, 0, 215, 0, 222,
+	0, 0, 0, 0, 236, 276, 242, 235, 409, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 316, 0, 0, 0, 0, 820, 440,
+	0, 0, 0, 0, 0, 0, 817, 818, 287, 786,
+	284, 189, 204, 811, 815, 326, 366, 373, 0, 0,
+	0, 227, 0, 370, 340, 426, 211, 252, 363, 345,
+	368, 0, 0, 369, 293, 414, 357, 424, 441, 442,
+	234, 320, 432, 406, 438, 454, 205, 231, 334, 399,
+	429, 390, 313, 410, 411, 283, 389, 260, 192, 291,
+	449, 203, 379, 219, 452, 372, 359, 196, 401, 422,
+	216, 382, 0, 0, 0, 198, 420, 398, 310, 280,
+	281, 197, 0, 362, 238, 258, 229, 329, 417, 418,
+	228, 456, 207, 437, 200, 0, 436, 322, 413, 421,
+	311, 302, 199, 419, 309, 301, 286, 248, 268, 355,
+	296, 356, 269, 318, 317, 319, 0, 194, 0, 395,
+	430, 457, 213, 0, 0, 408, 446, 453, 0, 358,
+	214, 259, 247, 354, 257, 289, 445, 447, 448, 450,
+	451, 212, 352, 265, 333, 425, 251, 433, 321, 208,
+	271, 391, 285, 294, 0, 0, 339, 371, 217, 428,
+	392, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 188, 201, 290, 0, 360, 255, 455, 435, 431,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 191, 202, 210, 220,
+	232, 245, 253, 263, 267, 270, 273, 274, 277, 282,
+	299, 304, 305, 306, 307, 323, 324, 325, 328, 331,
+	332, 335, 337, 338, 341, 347, 348, 349, 350, 351,
+	353, 361, 365, 374, 375, 376, 377, 378, 380, 381,
+	385, 386, 387, 388, 396, 400, 415, 416, 427, 439,
+	443, 264, 423, 444, 0, 298, 0, 0, 300, 249,
+	266, 275, 0, 434, 397, 206, 367, 256, 195, 223,
+	209, 230, 244, 246, 279, 308, 314, 343, 346, 261,
+	241, 221, 364, 218, 383, 403, 404, 405, 407, 312,
+	237, 330, 0, 0, 0, 1102, 0, 0, 0, 0,
+	240, 0, 0, 0, 0, 0, 288, 0, 0, 0,
+	344, 0, 384, 226, 297, 295, 412, 250, 243, 239,
+	225, 272, 303, 342, 402, 336, 0, 292, 0, 0,
+	393, 315, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 278, 224, 193,
+	327, 394, 254, 0, 0, 0, 185, 186, 187, 0,
+	1104, 0, 0, 0, 0, 0, 0, 215, 0, 222,
+	0, 0, 0, 0, 236, 276, 242, 235, 409, 987,
+	988, 986, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 989, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 316, 0, 0, 0, 0, 0, 440,
+	0, 0, 0, 0, 0, 0, 0, 0, 287, 0,
+	284, 189, 204, 0, 0, 326, 366, 373, 0, 0,
+	0, 227, 0, 370, 340, 426, 211, 252, 363, 345,
+	368, 0, 0, 369, 293, 414, 357, 424, 441, 442,
+	234, 320, 432, 406, 438, 454, 205, 231, 334, 399,
+	429, 390, 313, 410, 411, 283, 389, 260, 192, 291,
+	449, 203, 379, 219, 452, 372, 359, 196, 401, 422,
+	216, 382, 0, 0, 0, 198, 420, 398, 310, 280,
+	281, 197, 0, 362, 238, 258, 229, 329, 417, 418,
+	228, 456, 207, 437, 200, 0, 436, 322, 413, 421,
+	311, 302, 199, 419, 309, 301, 286, 248, 268, 355,
+	296, 356, 269, 318, 317, 319, 0, 194, 0, 395,
+	430, 457, 213, 0, 0, 408, 446, 453, 0, 358,
+	214, 259, 247, 354, 257, 289, 445, 447, 448, 450,
+	451, 212, 352, 265, 333, 425, 251, 433, 321, 208,
+	271, 391, 285, 294, 0, 0, 339, 371, 217, 428,
+	392, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 188, 201, 290, 0, 360, 255, 455, 435, 431,
+	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 191, 202, 210, 220,
+	232, 245, 253, 263, 267, 270, 273, 274, 277, 282,
+	299, 304, 305, 306, 307, 323, 324, 325, 328, 331,
+	332, 335, 337, 338, 341, 347, 348, 349, 350, 351,
+	353, 361, 365, 374, 375, 376, 377, 378, 380, 381,
+	385, 386, 387, 388, 396, 400, 415, 416, 427, 439,
+	443, 264, 423, 444, 0, 298, 0, 0, 300, 249,
+	266, 275, 0, 434, 397, 206, 367, 256, 195, 223,
+	209, 230, 244, 246, 279, 308, 314, 343, 346, 261,
+	241, 221, 364, 218, 383, 403, 404, 405, 407, 312,
+	237, 36, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 73, 0, 608, 185,
+	186, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 316, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 284, 189, 204, 0, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 0, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 330, 0, 0, 0, 1479, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 1481, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 316, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 284, 189, 204, 0, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 1477, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 780, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 316, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 786, 284, 189, 204, 784, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 0, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 330, 0, 0, 0, 1479, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 1481, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 316, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 284, 189, 204, 0, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 0, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 36, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 73,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 1499, 0, 0,
+	1500, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 1135, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 1134, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 608, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 73,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 1481, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 1104, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	1384, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 1259,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 1257,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 1255,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 1253,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 1251,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 1247,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 1245,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 1243,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 0,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 0, 288, 0, 0, 0, 344, 0, 384, 226,
+	297, 295, 412, 250, 243, 239, 225, 272, 303, 342,
+	402, 336, 0, 292, 0, 0, 393, 315, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 278, 224, 193, 327, 394, 254, 1218,
+	0, 0, 185, 186, 187, 0, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 222, 0, 0, 0, 0,
+	236, 276, 242, 235, 409, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 316,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 284, 189, 204, 0,
+	0, 326, 366, 373, 0, 0, 0, 227, 0, 370,
+	340, 426, 211, 252, 363, 345, 368, 0, 0, 369,
+	293, 414, 357, 424, 441, 442, 234, 320, 432, 406,
+	438, 454, 205, 231, 334, 399, 429, 390, 313, 410,
+	411, 283, 389, 260, 192, 291, 449, 203, 379, 219,
+	452, 372, 359, 196, 401, 422, 216, 382, 0, 0,
+	0, 198, 420, 398, 310, 280, 281, 197, 0, 362,
+	238, 258, 229, 329, 417, 418, 228, 456, 207, 437,
+	200, 0, 436, 322, 413, 421, 311, 302, 199, 419,
+	309, 301, 286, 248, 268, 355, 296, 356, 269, 318,
+	317, 319, 0, 194, 0, 395, 430, 457, 213, 0,
+	0, 408, 446, 453, 0, 358, 214, 259, 247, 354,
+	257, 289, 445, 447, 448, 450, 451, 212, 352, 265,
+	333, 425, 251, 433, 321, 208, 271, 391, 285, 294,
+	0, 0, 339, 371, 217, 428, 392, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 188, 201, 290,
+	0, 360, 255, 455, 435, 431, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 191, 202, 210, 220, 232, 245, 253, 263,
+	267, 270, 273, 274, 277, 282, 299, 304, 305, 306,
+	307, 323, 324, 325, 328, 331, 332, 335, 337, 338,
+	341, 347, 348, 349, 350, 351, 353, 361, 365, 374,
+	375, 376, 377, 378, 380, 381, 385, 386, 387, 388,
+	396, 400, 415, 416, 427, 439, 443, 264, 423, 444,
+	0, 298, 0, 0, 300, 249, 266, 275, 0, 434,
+	397, 206, 367, 256, 195, 223, 209, 230, 244, 246,
+	279, 308, 314, 343, 346, 261, 241, 221, 364, 218,
+	383, 403, 404, 405, 407, 312, 237, 1117, 0, 0,
+	0, 0, 0, 0, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 316, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 284, 189, 204, 0, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 0, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 330, 0, 0, 0, 0, 0,
+	0, 0, 1108, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 316, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 284, 189, 204, 0, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 0, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 963, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 316, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 284, 189, 204, 0, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 0, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 516, 0, 262, 0, 316, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 284, 189, 204, 0, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 0, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 515, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 316, 0, 0, 0,
+	463, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 284, 189, 204, 0, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 0, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 0, 288,
+	0, 0, 0, 344, 0, 384, 226, 297, 295, 412,
+	250, 243, 239, 225, 272, 303, 342, 402, 336, 0,
+	292, 0, 0, 393, 315, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	278, 224, 193, 327, 394, 254, 0, 0, 0, 185,
+	186, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 0, 222, 0, 0, 0, 0, 236, 276, 242,
+	235, 409, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1841,
-	0, 0, 1916, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 316, 0, 0, 0,
+	0, 0, 440, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 284, 189, 204, 0, 0, 326, 366,
+	373, 0, 0, 0, 227, 0, 370, 340, 426, 211,
+	252, 363, 345, 368, 0, 0, 369, 293, 414, 357,
+	424, 441, 442, 234, 320, 432, 406, 438, 454, 205,
+	231, 334, 399, 429, 390, 313, 410, 411, 283, 389,
+	260, 192, 291, 449, 203, 379, 219, 452, 372, 359,
+	196, 401, 422, 216, 382, 0, 0, 0, 198, 420,
+	398, 310, 280, 281, 197, 0, 362, 238, 258, 229,
+	329, 417, 418, 228, 456, 207, 437, 200, 0, 436,
+	322, 413, 421, 311, 302, 199, 419, 309, 301, 286,
+	248, 268, 355, 296, 356, 269, 318, 317, 319, 0,
+	194, 0, 395, 430, 457, 213, 0, 0, 408, 446,
+	453, 0, 358, 214, 259, 247, 354, 257, 289, 445,
+	447, 448, 450, 451, 212, 352, 265, 333, 425, 251,
+	433, 321, 208, 271, 391, 285, 294, 0, 0, 339,
+	371, 217, 428, 392, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 188, 201, 290, 0, 360, 255,
+	455, 435, 431, 0, 0, 233, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 191,
+	202, 210, 220, 232, 245, 253, 263, 267, 270, 273,
+	274, 277, 282, 299, 304, 305, 306, 307, 323, 324,
+	325, 328, 331, 332, 335, 337, 338, 341, 347, 348,
+	349, 350, 351, 353, 361, 365, 374, 375, 376, 377,
+	378, 380, 381, 385, 386, 387, 388, 396, 400, 415,
+	416, 427, 439, 443, 264, 423, 444, 0, 298, 0,
+	0, 300, 249, 266, 275, 0, 434, 397, 206, 367,
+	256, 195, 223, 209, 230, 244, 246, 279, 308, 314,
+	343, 346, 261, 241, 221, 364, 218, 383, 403, 404,
+	405, 407, 312, 237, 36, 37, 38, 74, 40, 41,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 78, 0, 0, 0, 0, 42,
+	68, 69, 0, 66, 70, 0, 0, 0, 0, 0,
+	67, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 55,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 73,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1978, 0, 0, 1979, 1980, 1981,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1991, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1928, 0, 34, 0, 0, 0, 2004, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1100, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 45, 48, 51, 50, 53, 0, 65, 0, 0,
+	71, 72, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 54, 77, 76, 0, 0, 63,
+	64, 52, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 56, 57, 0, 58, 59, 60, 61,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1612,2343 +3958,31 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2027, 0, 0, 0, 0, 0, 0, 2033, 2034,
-	2035, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2129, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1928, 0, 34, 0, 1928, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 34, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1928, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 750, 737, 34, 2168, 686,
-	753, 657, 675, 762, 677, 680, 720, 636, 699, 327,
-	672, 0, 661, 632, 668, 633, 659, 688, 237, 692,
-	656, 739, 702, 752, 285, 0, 638, 662, 341, 722,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 759, 289, 709, 0, 388, 312,
-	0, 0, 0, 690, 742, 697, 733, 685, 721, 646,
-	708, 754, 673, 717, 755, 275, 221, 190, 324, 389,
-	251, 0, 0, 0, 182, 183, 184, 0, 2175, 2176,
-	0, 0, 0, 0, 0, 212, 0, 219, 714, 749,
-	670, 716, 233, 273, 239, 232, 404, 719, 765, 631,
-	711, 0, 634, 637, 761, 745, 665, 666, 0, 0,
-	0, 0, 0, 0, 0, 689, 698, 730, 683, 0,
-	0, 0, 0, 0, 0, 0, 0, 663, 0, 707,
-	0, 0, 0, 642, 635, 0, 0, 0, 0, 687,
-	0, 0, 0, 645, 0, 664, 731, 0, 629, 259,
-	639, 313, 0, 735, 744, 684, 435, 748, 682, 681,
-	751, 726, 643, 741, 676, 284, 641, 281, 186, 201,
-	0, 674, 323, 362, 368, 740, 660, 669, 224, 667,
-	366, 337, 421, 208, 249, 359, 342, 364, 706, 724,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 940,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 655, 736, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 728, 764, 336,
-	367, 214, 423, 387, 650, 654, 648, 649, 700, 701,
-	651, 756, 757, 758, 732, 644, 0, 652, 653, 0,
-	738, 746, 747, 705, 185, 198, 287, 760, 356, 252,
-	448, 430, 426, 630, 647, 230, 658, 0, 0, 671,
-	678, 679, 691, 693, 694, 695, 696, 704, 712, 713,
-	715, 723, 725, 727, 729, 734, 743, 763, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 703,
-	710, 297, 246, 263, 272, 718, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 750, 737, 0, 0, 686, 753,
-	657, 675, 762, 677, 680, 720, 636, 699, 327, 672,
-	0, 661, 632, 668, 633, 659, 688, 237, 692, 656,
-	739, 702, 752, 285, 0, 638, 662, 341, 722, 379,
-	223, 294, 292, 407, 247, 240, 236, 222, 269, 300,
-	339, 397, 333, 759, 289, 709, 0, 388, 312, 0,
-	0, 0, 690, 742, 697, 733, 685, 721, 646, 708,
-	754, 673, 717, 755, 275, 221, 190, 324, 389, 251,
-	0, 0, 0, 182, 183, 184, 0, 0, 0, 0,
-	0, 0, 0, 0, 212, 0, 219, 714, 749, 670,
-	716, 233, 273, 239, 232, 404, 719, 765, 631, 711,
-	0, 634, 637, 761, 745, 665, 666, 0, 0, 0,
-	0, 0, 0, 0, 689, 698, 730, 683, 0, 0,
-	0, 0, 0, 0, 1920, 0, 663, 0, 707, 0,
-	0, 0, 642, 635, 0, 0, 0, 0, 687, 0,
-	0, 0, 645, 0, 664, 731, 0, 629, 259, 639,
-	313, 0, 735, 744, 684, 435, 748, 682, 681, 751,
-	726, 643, 741, 676, 284, 641, 281, 186, 201, 0,
-	674, 323, 362, 368, 740, 660, 669, 224, 667, 366,
-	337, 421, 208, 249, 359, 342, 364, 706, 724, 365,
-	290, 409, 354, 419, 436, 437, 231, 317, 427, 401,
-	433, 447, 202, 228, 331, 394, 424, 385, 310, 405,
-	406, 280, 384, 257, 189, 288, 444, 200, 374, 216,
-	193, 396, 417, 213, 377, 0, 0, 0, 195, 415,
-	393, 307, 277, 278, 194, 0, 358, 235, 255, 226,
-	326, 412, 413, 225, 449, 204, 432, 197, 940, 431,
-	319, 408, 416, 308, 299, 196, 414, 306, 298, 283,
-	245, 265, 352, 293, 353, 266, 315, 314, 316, 0,
-	191, 0, 390, 425, 450, 210, 655, 736, 403, 441,
-	446, 0, 355, 211, 256, 244, 351, 254, 286, 440,
-	442, 443, 445, 209, 349, 262, 330, 420, 248, 428,
-	318, 205, 268, 386, 282, 291, 728, 764, 336, 367,
-	214, 423, 387, 650, 654, 648, 649, 700, 701, 651,
-	756, 757, 758, 732, 644, 0, 652, 653, 0, 738,
-	746, 747, 705, 185, 198, 287, 760, 356, 252, 448,
-	430, 426, 630, 647, 230, 658, 0, 0, 671, 678,
-	679, 691, 693, 694, 695, 696, 704, 712, 713, 715,
-	723, 725, 727, 729, 734, 743, 763, 187, 188, 199,
-	207, 217, 229, 242, 250, 260, 264, 267, 270, 271,
-	274, 279, 296, 301, 302, 303, 304, 320, 321, 322,
-	325, 328, 329, 332, 334, 335, 338, 344, 345, 346,
-	347, 348, 350, 357, 361, 369, 370, 371, 372, 373,
-	375, 376, 380, 381, 382, 383, 391, 395, 410, 411,
-	422, 434, 438, 261, 418, 439, 0, 295, 703, 710,
-	297, 246, 263, 272, 718, 429, 392, 203, 363, 253,
-	192, 220, 206, 227, 241, 243, 276, 305, 311, 340,
-	343, 258, 238, 218, 360, 215, 378, 398, 399, 400,
-	402, 309, 234, 750, 737, 0, 0, 686, 753, 657,
-	675, 762, 677, 680, 720, 636, 699, 327, 672, 0,
-	661, 632, 668, 633, 659, 688, 237, 692, 656, 739,
-	702, 752, 285, 0, 638, 662, 341, 722, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 759, 289, 709, 0, 388, 312, 0, 0,
-	0, 690, 742, 697, 733, 685, 721, 646, 708, 754,
-	673, 717, 755, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 714, 749, 670, 716,
-	233, 273, 239, 232, 404, 719, 765, 631, 711, 0,
-	634, 637, 761, 745, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 689, 698, 730, 683, 0, 0, 0,
-	0, 0, 0, 1773, 0, 663, 0, 707, 0, 0,
-	0, 642, 635, 0, 0, 0, 0, 687, 0, 0,
-	0, 645, 0, 664, 731, 0, 629, 259, 639, 313,
-	0, 735, 744, 684, 435, 748, 682, 681, 751, 726,
-	643, 741, 676, 284, 641, 281, 186, 201, 0, 674,
-	323, 362, 368, 740, 660, 669, 224, 667, 366, 337,
-	421, 208, 249, 359, 342, 364, 706, 724, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 940, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 655, 736, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 728, 764, 336, 367, 214,
-	423, 387, 650, 654, 648, 649, 700, 701, 651, 756,
-	757, 758, 732, 644, 0, 652, 653, 0, 738, 746,
-	747, 705, 185, 198, 287, 760, 356, 252, 448, 430,
-	426, 630, 647, 230, 658, 0, 0, 671, 678, 679,
-	691, 693, 694, 695, 696, 704, 712, 713, 715, 723,
-	725, 727, 729, 734, 743, 763, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 703, 710, 297,
-	246, 263, 272, 718, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 750, 737, 0, 0, 686, 753, 657, 675,
-	762, 677, 680, 720, 636, 699, 327, 672, 0, 661,
-	632, 668, 633, 659, 688, 237, 692, 656, 739, 702,
-	752, 285, 0, 638, 662, 341, 722, 379, 223, 294,
-	292, 407, 247, 240, 236, 222, 269, 300, 339, 397,
-	333, 759, 289, 709, 0, 388, 312, 0, 0, 0,
-	690, 742, 697, 733, 685, 721, 646, 708, 754, 673,
-	717, 755, 275, 221, 190, 324, 389, 251, 0, 0,
-	0, 182, 183, 184, 0, 0, 0, 0, 0, 0,
-	0, 0, 212, 0, 219, 714, 749, 670, 716, 233,
-	273, 239, 232, 404, 719, 765, 631, 711, 0, 634,
-	637, 761, 745, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 689, 698, 730, 683, 0, 0, 0, 0,
-	0, 0, 1488, 0, 663, 0, 707, 0, 0, 0,
-	642, 635, 0, 0, 0, 0, 687, 0, 0, 0,
-	645, 0, 664, 731, 0, 629, 259, 639, 313, 0,
-	735, 744, 684, 435, 748, 682, 681, 751, 726, 643,
-	741, 676, 284, 641, 281, 186, 201, 0, 674, 323,
-	362, 368, 740, 660, 669, 224, 667, 366, 337, 421,
-	208, 249, 359, 342, 364, 706, 724, 365, 290, 409,
-	354, 419, 436, 437, 231, 317, 427, 401, 433, 447,
-	202, 228, 331, 394, 424, 385, 310, 405, 406, 280,
-	384, 257, 189, 288, 444, 200, 374, 216, 193, 396,
-	417, 213, 377, 0, 0, 0, 195, 415, 393, 307,
-	277, 278, 194, 0, 358, 235, 255, 226, 326, 412,
-	413, 225, 449, 204, 432, 197, 940, 431, 319, 408,
-	416, 308, 299, 196, 414, 306, 298, 283, 245, 265,
-	352, 293, 353, 266, 315, 314, 316, 0, 191, 0,
-	390, 425, 450, 210, 655, 736, 403, 441, 446, 0,
-	355, 211, 256, 244, 351, 254, 286, 440, 442, 443,
-	445, 209, 349, 262, 330, 420, 248, 428, 318, 205,
-	268, 386, 282, 291, 728, 764, 336, 367, 214, 423,
-	387, 650, 654, 648, 649, 700, 701, 651, 756, 757,
-	758, 732, 644, 0, 652, 653, 0, 738, 746, 747,
-	705, 185, 198, 287, 760, 356, 252, 448, 430, 426,
-	630, 647, 230, 658, 0, 0, 671, 678, 679, 691,
-	693, 694, 695, 696, 704, 712, 713, 715, 723, 725,
-	727, 729, 734, 743, 763, 187, 188, 199, 207, 217,
-	229, 242, 250, 260, 264, 267, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 410, 411, 422, 434,
-	438, 261, 418, 439, 0, 295, 703, 710, 297, 246,
-	263, 272, 718, 429, 392, 203, 363, 253, 192, 220,
-	206, 227, 241, 243, 276, 305, 311, 340, 343, 258,
-	238, 218, 360, 215, 378, 398, 399, 400, 402, 309,
-	234, 750, 737, 0, 0, 686, 753, 657, 675, 762,
-	677, 680, 720, 636, 699, 327, 672, 0, 661, 632,
-	668, 633, 659, 688, 237, 692, 656, 739, 702, 752,
-	285, 0, 638, 662, 341, 722, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	759, 289, 709, 0, 388, 312, 0, 0, 0, 690,
-	742, 697, 733, 685, 721, 646, 708, 754, 673, 717,
-	755, 275, 221, 190, 324, 389, 251, 71, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 714, 749, 670, 716, 233, 273,
-	239, 232, 404, 719, 765, 631, 711, 0, 634, 637,
-	761, 745, 665, 666, 0, 0, 0, 0, 0, 0,
-	0, 689, 698, 730, 683, 0, 0, 0, 0, 0,
-	0, 0, 0, 663, 0, 707, 0, 0, 0, 642,
-	635, 0, 0, 0, 0, 687, 0, 0, 0, 645,
-	0, 664, 731, 0, 629, 259, 639, 313, 0, 735,
-	744, 684, 435, 748, 682, 681, 751, 726, 643, 741,
-	676, 284, 641, 281, 186, 201, 0, 674, 323, 362,
-	368, 740, 660, 669, 224, 667, 366, 337, 421, 208,
-	249, 359, 342, 364, 706, 724, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 940, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 655, 736, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 728, 764, 336, 367, 214, 423, 387,
-	650, 654, 648, 649, 700, 701, 651, 756, 757, 758,
-	732, 644, 0, 652, 653, 0, 738, 746, 747, 705,
-	185, 198, 287, 760, 356, 252, 448, 430, 426, 630,
-	647, 230, 658, 0, 0, 671, 678, 679, 691, 693,
-	694, 695, 696, 704, 712, 713, 715, 723, 725, 727,
-	729, 734, 743, 763, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 703, 710, 297, 246, 263,
-	272, 718, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	750, 737, 0, 0, 686, 753, 657, 675, 762, 677,
-	680, 720, 636, 699, 327, 672, 0, 661, 632, 668,
-	633, 659, 688, 237, 692, 656, 739, 702, 752, 285,
-	0, 638, 662, 341, 722, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 759,
-	289, 709, 0, 388, 312, 0, 0, 0, 690, 742,
-	697, 733, 685, 721, 646, 708, 754, 673, 717, 755,
-	275, 221, 190, 324, 389, 251, 0, 0, 0, 182,
-	183, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	212, 0, 219, 714, 749, 670, 716, 233, 273, 239,
-	232, 404, 719, 765, 631, 711, 0, 634, 637, 761,
-	745, 665, 666, 0, 0, 0, 0, 0, 0, 0,
-	689, 698, 730, 683, 0, 0, 0, 0, 0, 0,
-	0, 0, 663, 0, 707, 0, 0, 0, 642, 635,
-	0, 0, 0, 0, 687, 0, 0, 0, 645, 0,
-	664, 731, 0, 629, 259, 639, 313, 0, 735, 744,
-	684, 435, 748, 682, 681, 751, 726, 643, 741, 676,
-	284, 641, 281, 186, 201, 0, 674, 323, 362, 368,
-	740, 660, 669, 224, 667, 366, 337, 421, 208, 249,
-	359, 342, 364, 706, 724, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 940, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 655, 736, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 728, 764, 336, 367, 214, 423, 387, 650,
-	654, 648, 649, 700, 701, 651, 756, 757, 758, 732,
-	644, 0, 652, 653, 0, 738, 746, 747, 705, 185,
-	198, 287, 760, 356, 252, 448, 430, 426, 630, 647,
-	230, 658, 0, 0, 671, 678, 679, 691, 693, 694,
-	695, 696, 704, 712, 713, 715, 723, 725, 727, 729,
-	734, 743, 763, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 703, 710, 297, 246, 263, 272,
-	718, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 750,
-	737, 0, 0, 686, 753, 657, 675, 762, 677, 680,
-	720, 636, 699, 327, 672, 0, 661, 632, 668, 633,
-	659, 688, 237, 692, 656, 739, 702, 752, 285, 0,
-	638, 662, 341, 722, 379, 223, 294, 292, 407, 247,
-	240, 236, 222, 269, 300, 339, 397, 333, 759, 289,
-	709, 0, 388, 312, 0, 0, 0, 690, 742, 697,
-	733, 685, 721, 646, 708, 754, 673, 717, 755, 275,
-	221, 190, 324, 389, 251, 0, 0, 0, 182, 183,
-	184, 0, 0, 0, 0, 0, 0, 0, 0, 212,
-	0, 219, 714, 749, 670, 716, 233, 273, 239, 232,
-	404, 719, 765, 631, 711, 0, 634, 637, 761, 745,
-	665, 666, 0, 0, 0, 0, 0, 0, 0, 689,
-	698, 730, 683, 0, 0, 0, 0, 0, 0, 0,
-	0, 663, 0, 707, 0, 0, 0, 642, 635, 0,
-	0, 0, 0, 687, 0, 0, 0, 645, 0, 664,
-	731, 0, 629, 259, 639, 313, 0, 735, 744, 684,
-	435, 748, 682, 681, 751, 726, 643, 741, 676, 284,
-	641, 281, 186, 201, 0, 674, 323, 362, 368, 740,
-	660, 669, 224, 667, 366, 337, 421, 208, 249, 359,
-	342, 364, 706, 724, 365, 290, 409, 354, 419, 436,
-	437, 231, 317, 427, 401, 433, 447, 202, 228, 331,
-	394, 424, 385, 310, 405, 406, 280, 384, 257, 189,
-	288, 444, 200, 374, 216, 193, 396, 417, 213, 377,
-	0, 0, 0, 195, 415, 393, 307, 277, 278, 194,
-	0, 358, 235, 255, 226, 326, 412, 413, 225, 449,
-	204, 432, 197, 640, 431, 319, 408, 416, 308, 299,
-	196, 414, 306, 298, 283, 245, 265, 352, 293, 353,
-	266, 315, 314, 316, 0, 191, 0, 390, 425, 450,
-	210, 655, 736, 403, 441, 446, 0, 355, 211, 256,
-	244, 351, 254, 286, 440, 442, 443, 445, 209, 349,
-	262, 330, 420, 248, 428, 628, 766, 622, 621, 282,
-	291, 728, 764, 336, 367, 214, 423, 387, 650, 654,
-	648, 649, 700, 701, 651, 756, 757, 758, 732, 644,
-	0, 652, 653, 0, 738, 746, 747, 705, 185, 198,
-	287, 760, 356, 252, 448, 430, 426, 630, 647, 230,
-	658, 0, 0, 671, 678, 679, 691, 693, 694, 695,
-	696, 704, 712, 713, 715, 723, 725, 727, 729, 734,
-	743, 763, 187, 188, 199, 207, 217, 229, 242, 250,
-	260, 264, 267, 270, 271, 274, 279, 296, 301, 302,
-	303, 304, 320, 321, 322, 325, 328, 329, 332, 334,
-	335, 338, 344, 345, 346, 347, 348, 350, 357, 361,
-	369, 370, 371, 372, 373, 375, 376, 380, 381, 382,
-	383, 391, 395, 410, 411, 422, 434, 438, 261, 418,
-	439, 0, 295, 703, 710, 297, 246, 263, 272, 718,
-	429, 392, 203, 363, 253, 192, 220, 206, 227, 241,
-	243, 276, 305, 311, 340, 343, 258, 238, 218, 360,
-	215, 378, 398, 399, 400, 402, 309, 234, 750, 737,
-	0, 0, 686, 753, 657, 675, 762, 677, 680, 720,
-	636, 699, 327, 672, 0, 661, 632, 668, 633, 659,
-	688, 237, 692, 656, 739, 702, 752, 285, 0, 638,
-	662, 341, 722, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 759, 289, 709,
-	0, 388, 312, 0, 0, 0, 690, 742, 697, 733,
-	685, 721, 646, 708, 754, 673, 717, 755, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 714, 749, 670, 716, 233, 273, 239, 232, 404,
-	719, 765, 631, 711, 0, 634, 637, 761, 745, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 689, 698,
-	730, 683, 0, 0, 0, 0, 0, 0, 0, 0,
-	663, 0, 707, 0, 0, 0, 642, 635, 0, 0,
-	0, 0, 687, 0, 0, 0, 645, 0, 664, 731,
-	0, 629, 259, 639, 313, 0, 735, 744, 684, 435,
-	748, 682, 681, 751, 726, 643, 741, 676, 284, 641,
-	281, 186, 201, 0, 674, 323, 362, 368, 740, 660,
-	669, 224, 667, 366, 337, 421, 208, 249, 359, 342,
-	364, 706, 724, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 1108, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 640, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	655, 736, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 628, 766, 622, 621, 282, 291,
-	728, 764, 336, 367, 214, 423, 387, 650, 654, 648,
-	649, 700, 701, 651, 756, 757, 758, 732, 644, 0,
-	652, 653, 0, 738, 746, 747, 705, 185, 198, 287,
-	760, 356, 252, 448, 430, 426, 630, 647, 230, 658,
-	0, 0, 671, 678, 679, 691, 693, 694, 695, 696,
-	704, 712, 713, 715, 723, 725, 727, 729, 734, 743,
-	763, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 703, 710, 297, 246, 263, 272, 718, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 750, 737, 0,
-	0, 686, 753, 657, 675, 762, 677, 680, 720, 636,
-	699, 327, 672, 0, 661, 632, 668, 633, 659, 688,
-	237, 692, 656, 739, 702, 752, 285, 0, 638, 662,
-	341, 722, 379, 223, 294, 292, 407, 247, 240, 236,
-	222, 269, 300, 339, 397, 333, 759, 289, 709, 0,
-	388, 312, 0, 0, 0, 690, 742, 697, 733, 685,
-	721, 646, 708, 754, 673, 717, 755, 275, 221, 190,
-	324, 389, 251, 0, 0, 0, 182, 183, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 212, 0, 219,
-	714, 749, 670, 716, 233, 273, 239, 232, 404, 719,
-	765, 631, 711, 0, 634, 637, 761, 745, 665, 666,
-	0, 0, 0, 0, 0, 0, 0, 689, 698, 730,
-	683, 0, 0, 0, 0, 0, 0, 0, 0, 663,
-	0, 707, 0, 0, 0, 642, 635, 0, 0, 0,
-	0, 687, 0, 0, 0, 645, 0, 664, 731, 0,
-	629, 259, 639, 313, 0, 735, 744, 684, 435, 748,
-	682, 681, 751, 726, 643, 741, 676, 284, 641, 281,
-	186, 201, 0, 674, 323, 362, 368, 740, 660, 669,
-	224, 667, 366, 337, 421, 208, 249, 359, 342, 364,
-	706, 724, 365, 290, 409, 354, 419, 436, 437, 231,
-	317, 427, 401, 433, 447, 202, 228, 331, 394, 424,
-	385, 310, 405, 406, 280, 384, 257, 189, 288, 444,
-	200, 374, 216, 193, 396, 619, 213, 377, 0, 0,
-	0, 195, 415, 393, 307, 277, 278, 194, 0, 358,
-	235, 255, 226, 326, 412, 413, 225, 449, 204, 432,
-	197, 640, 431, 319, 408, 416, 308, 299, 196, 414,
-	306, 298, 283, 245, 265, 352, 293, 353, 266, 315,
-	314, 316, 0, 191, 0, 390, 425, 450, 210, 655,
-	736, 403, 441, 446, 0, 355, 211, 256, 244, 351,
-	254, 286, 440, 442, 443, 445, 209, 349, 262, 330,
-	420, 248, 428, 628, 766, 622, 621, 282, 291, 728,
-	764, 336, 367, 214, 423, 387, 650, 654, 648, 649,
-	700, 701, 651, 756, 757, 758, 732, 644, 0, 652,
-	653, 0, 738, 746, 747, 705, 185, 198, 287, 760,
-	356, 252, 448, 430, 426, 630, 647, 230, 658, 0,
-	0, 671, 678, 679, 691, 693, 694, 695, 696, 704,
-	712, 713, 715, 723, 725, 727, 729, 734, 743, 763,
-	187, 188, 199, 207, 217, 229, 242, 250, 260, 264,
-	267, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 410, 411, 422, 434, 438, 261, 418, 439, 0,
-	295, 703, 710, 297, 246, 263, 272, 718, 429, 392,
-	203, 363, 253, 192, 220, 206, 227, 241, 243, 276,
-	305, 311, 340, 343, 258, 238, 218, 360, 215, 378,
-	398, 399, 400, 402, 309, 234, 327, 0, 0, 1415,
-	0, 519, 0, 0, 0, 237, 0, 518, 0, 0,
-	0, 285, 0, 0, 1416, 341, 0, 379, 223, 294,
-	292, 407, 247, 240, 236, 222, 269, 300, 339, 397,
-	333, 562, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 553, 554, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 221, 190, 324, 389, 251, 71, 0,
-	0, 182, 183, 184, 540, 539, 542, 543, 544, 545,
-	0, 0, 212, 541, 219, 546, 547, 548, 0, 233,
-	273, 239, 232, 404, 0, 0, 0, 516, 533, 0,
-	561, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 531, 609, 0, 0, 0, 577, 0, 532, 0,
-	0, 525, 526, 528, 527, 529, 534, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 313, 0,
-	576, 0, 0, 435, 0, 0, 574, 0, 0, 0,
-	0, 0, 284, 0, 281, 186, 201, 0, 0, 323,
-	362, 368, 0, 0, 0, 224, 0, 366, 337, 421,
-	208, 249, 359, 342, 364, 0, 0, 365, 290, 409,
-	354, 419, 436, 437, 231, 317, 427, 401, 433, 447,
-	202, 228, 331, 394, 424, 385, 310, 405, 406, 280,
-	384, 257, 189, 288, 444, 200, 374, 216, 193, 396,
-	417, 213, 377, 0, 0, 0, 195, 415, 393, 307,
-	277, 278, 194, 0, 358, 235, 255, 226, 326, 412,
-	413, 225, 449, 204, 432, 197, 0, 431, 319, 408,
-	416, 308, 299, 196, 414, 306, 298, 283, 245, 265,
-	352, 293, 353, 266, 315, 314, 316, 0, 191, 0,
-	390, 425, 450, 210, 0, 0, 403, 441, 446, 0,
-	355, 211, 256, 244, 351, 254, 286, 440, 442, 443,
-	445, 209, 349, 262, 330, 420, 248, 428, 318, 205,
-	268, 386, 282, 291, 0, 0, 336, 367, 214, 423,
-	387, 564, 575, 570, 571, 568, 569, 563, 567, 566,
-	565, 578, 555, 556, 557, 558, 560, 0, 572, 573,
-	559, 185, 198, 287, 0, 356, 252, 448, 430, 426,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 188, 199, 207, 217,
-	229, 242, 250, 260, 264, 267, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 410, 411, 422, 434,
-	438, 261, 418, 439, 0, 295, 0, 0, 297, 246,
-	263, 272, 0, 429, 392, 203, 363, 253, 192, 220,
-	206, 227, 241, 243, 276, 305, 311, 340, 343, 258,
-	238, 218, 360, 215, 378, 398, 399, 400, 402, 309,
-	234, 327, 0, 0, 0, 0, 519, 0, 0, 0,
-	237, 0, 518, 0, 0, 0, 285, 0, 0, 0,
-	341, 0, 379, 223, 294, 292, 407, 247, 240, 236,
-	222, 269, 300, 339, 397, 333, 562, 289, 0, 0,
-	388, 312, 0, 0, 0, 0, 0, 553, 554, 0,
-	0, 0, 0, 0, 0, 1527, 0, 275, 221, 190,
-	324, 389, 251, 71, 0, 0, 182, 183, 184, 540,
-	539, 542, 543, 544, 545, 0, 0, 212, 541, 219,
-	546, 547, 548, 1528, 233, 273, 239, 232, 404, 0,
-	0, 0, 516, 533, 0, 561, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 530, 531, 0, 0, 0,
-	0, 577, 0, 532, 0, 0, 525, 526, 528, 527,
-	529, 534, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 313, 0, 576, 0, 0, 435, 0,
-	0, 574, 0, 0, 0, 0, 0, 284, 0, 281,
-	186, 201, 0, 0, 323, 362, 368, 0, 0, 0,
-	224, 0, 366, 337, 421, 208, 249, 359, 342, 364,
-	0, 0, 365, 290, 409, 354, 419, 436, 437, 231,
-	317, 427, 401, 433, 447, 202, 228, 331, 394, 424,
-	385, 310, 405, 406, 280, 384, 257, 189, 288, 444,
-	200, 374, 216, 193, 396, 417, 213, 377, 0, 0,
-	0, 195, 415, 393, 307, 277, 278, 194, 0, 358,
-	235, 255, 226, 326, 412, 413, 225, 449, 204, 432,
-	197, 0, 431, 319, 408, 416, 308, 299, 196, 414,
-	306, 298, 283, 245, 265, 352, 293, 353, 266, 315,
-	314, 316, 0, 191, 0, 390, 425, 450, 210, 0,
-	0, 403, 441, 446, 0, 355, 211, 256, 244, 351,
-	254, 286, 440, 442, 443, 445, 209, 349, 262, 330,
-	420, 248, 428, 318, 205, 268, 386, 282, 291, 0,
-	0, 336, 367, 214, 423, 387, 564, 575, 570, 571,
-	568, 569, 563, 567, 566, 565, 578, 555, 556, 557,
-	558, 560, 0, 572, 573, 559, 185, 198, 287, 0,
-	356, 252, 448, 430, 426, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 188, 199, 207, 217, 229, 242, 250, 260, 264,
-	267, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 410, 411, 422, 434, 438, 261, 418, 439, 0,
-	295, 0, 0, 297, 246, 263, 272, 0, 429, 392,
-	203, 363, 253, 192, 220, 206, 227, 241, 243, 276,
-	305, 311, 340, 343, 258, 238, 218, 360, 215, 378,
-	398, 399, 400, 402, 309, 234, 327, 0, 0, 0,
-	0, 519, 0, 0, 0, 237, 0, 518, 0, 0,
-	0, 285, 0, 0, 0, 341, 0, 379, 223, 294,
-	292, 407, 247, 240, 236, 222, 269, 300, 339, 397,
-	333, 562, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 553, 554, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 221, 190, 324, 389, 251, 71, 0,
-	596, 182, 183, 184, 540, 539, 542, 543, 544, 545,
-	0, 0, 212, 541, 219, 546, 547, 548, 0, 233,
-	273, 239, 232, 404, 0, 0, 0, 516, 533, 0,
-	561, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 531, 0, 0, 0, 0, 577, 0, 532, 0,
-	0, 525, 526, 528, 527, 529, 534, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 313, 0,
-	576, 0, 0, 435, 0, 0, 574, 0, 0, 0,
-	0, 0, 284, 0, 281, 186, 201, 0, 0, 323,
-	362, 368, 0, 0, 0, 224, 0, 366, 337, 421,
-	208, 249, 359, 342, 364, 0, 0, 365, 290, 409,
-	354, 419, 436, 437, 231, 317, 427, 401, 433, 447,
-	202, 228, 331, 394, 424, 385, 310, 405, 406, 280,
-	384, 257, 189, 288, 444, 200, 374, 216, 193, 396,
-	417, 213, 377, 0, 0, 0, 195, 415, 393, 307,
-	277, 278, 194, 0, 358, 235, 255, 226, 326, 412,
-	413, 225, 449, 204, 432, 197, 0, 431, 319, 408,
-	416, 308, 299, 196, 414, 306, 298, 283, 245, 265,
-	352, 293, 353, 266, 315, 314, 316, 0, 191, 0,
-	390, 425, 450, 210, 0, 0, 403, 441, 446, 0,
-	355, 211, 256, 244, 351, 254, 286, 440, 442, 443,
-	445, 209, 349, 262, 330, 420, 248, 428, 318, 205,
-	268, 386, 282, 291, 0, 0, 336, 367, 214, 423,
-	387, 564, 575, 570, 571, 568, 569, 563, 567, 566,
-	565, 578, 555, 556, 557, 558, 560, 0, 572, 573,
-	559, 185, 198, 287, 0, 356, 252, 448, 430, 426,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 188, 199, 207, 217,
-	229, 242, 250, 260, 264, 267, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 410, 411, 422, 434,
-	438, 261, 418, 439, 0, 295, 0, 0, 297, 246,
-	263, 272, 0, 429, 392, 203, 363, 253, 192, 220,
-	206, 227, 241, 243, 276, 305, 311, 340, 343, 258,
-	238, 218, 360, 215, 378, 398, 399, 400, 402, 309,
-	234, 327, 0, 0, 0, 0, 519, 0, 0, 0,
-	237, 0, 518, 0, 0, 0, 285, 0, 0, 0,
-	341, 0, 379, 223, 294, 292, 407, 247, 240, 236,
-	222, 269, 300, 339, 397, 333, 562, 289, 0, 0,
-	388, 312, 0, 0, 0, 0, 0, 553, 554, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 221, 190,
-	324, 389, 251, 71, 0, 0, 182, 183, 184, 540,
-	539, 542, 543, 544, 545, 0, 0, 212, 541, 219,
-	546, 547, 548, 0, 233, 273, 239, 232, 404, 0,
-	0, 0, 516, 533, 0, 561, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 530, 531, 609, 0, 0,
-	0, 577, 0, 532, 0, 0, 525, 526, 528, 527,
-	529, 534, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 313, 0, 576, 0, 0, 435, 0,
-	0, 574, 0, 0, 0, 0, 0, 284, 0, 281,
-	186, 201, 0, 0, 323, 362, 368, 0, 0, 0,
-	224, 0, 366, 337, 421, 208, 249, 359, 342, 364,
-	0, 0, 365, 290, 409, 354, 419, 436, 437, 231,
-	317, 427, 401, 433, 447, 202, 228, 331, 394, 424,
-	385, 310, 405, 406, 280, 384, 257, 189, 288, 444,
-	200, 374, 216, 193, 396, 417, 213, 377, 0, 0,
-	0, 195, 415, 393, 307, 277, 278, 194, 0, 358,
-	235, 255, 226, 326, 412, 413, 225, 449, 204, 432,
-	197, 0, 431, 319, 408, 416, 308, 299, 196, 414,
-	306, 298, 283, 245, 265, 352, 293, 353, 266, 315,
-	314, 316, 0, 191, 0, 390, 425, 450, 210, 0,
-	0, 403, 441, 446, 0, 355, 211, 256, 244, 351,
-	254, 286, 440, 442, 443, 445, 209, 349, 262, 330,
-	420, 248, 428, 318, 205, 268, 386, 282, 291, 0,
-	0, 336, 367, 214, 423, 387, 564, 575, 570, 571,
-	568, 569, 563, 567, 566, 565, 578, 555, 556, 557,
-	558, 560, 0, 572, 573, 559, 185, 198, 287, 0,
-	356, 252, 448, 430, 426, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 188, 199, 207, 217, 229, 242, 250, 260, 264,
-	267, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 410, 411, 422, 434, 438, 261, 418, 439, 0,
-	295, 0, 0, 297, 246, 263, 272, 0, 429, 392,
-	203, 363, 253, 192, 220, 206, 227, 241, 243, 276,
-	305, 311, 340, 343, 258, 238, 218, 360, 215, 378,
-	398, 399, 400, 402, 309, 234, 327, 0, 0, 0,
-	0, 519, 0, 0, 0, 237, 0, 518, 0, 0,
-	0, 285, 0, 0, 0, 341, 0, 379, 223, 294,
-	292, 407, 247, 240, 236, 222, 269, 300, 339, 397,
-	333, 562, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 553, 554, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 221, 190, 324, 389, 251, 71, 0,
-	0, 182, 183, 184, 540, 1433, 542, 543, 544, 545,
-	0, 0, 212, 541, 219, 546, 547, 548, 0, 233,
-	273, 239, 232, 404, 0, 0, 0, 516, 533, 0,
-	561, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 531, 609, 0, 0, 0, 577, 0, 532, 0,
-	0, 525, 526, 528, 527, 529, 534, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 313, 0,
-	576, 0, 0, 435, 0, 0, 574, 0, 0, 0,
-	0, 0, 284, 0, 281, 186, 201, 0, 0, 323,
-	362, 368, 0, 0, 0, 224, 0, 366, 337, 421,
-	208, 249, 359, 342, 364, 0, 0, 365, 290, 409,
-	354, 419, 436, 437, 231, 317, 427, 401, 433, 447,
-	202, 228, 331, 394, 424, 385, 310, 405, 406, 280,
-	384, 257, 189, 288, 444, 200, 374, 216, 193, 396,
-	417, 213, 377, 0, 0, 0, 195, 415, 393, 307,
-	277, 278, 194, 0, 358, 235, 255, 226, 326, 412,
-	413, 225, 449, 204, 432, 197, 0, 431, 319, 408,
-	416, 308, 299, 196, 414, 306, 298, 283, 245, 265,
-	352, 293, 353, 266, 315, 314, 316, 0, 191, 0,
-	390, 425, 450, 210, 0, 0, 403, 441, 446, 0,
-	355, 211, 256, 244, 351, 254, 286, 440, 442, 443,
-	445, 209, 349, 262, 330, 420, 248, 428, 318, 205,
-	268, 386, 282, 291, 0, 0, 336, 367, 214, 423,
-	387, 564, 575, 570, 571, 568, 569, 563, 567, 566,
-	565, 578, 555, 556, 557, 558, 560, 0, 572, 573,
-	559, 185, 198, 287, 0, 356, 252, 448, 430, 426,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 188, 199, 207, 217,
-	229, 242, 250, 260, 264, 267, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 410, 411, 422, 434,
-	438, 261, 418, 439, 0, 295, 0, 0, 297, 246,
-	263, 272, 0, 429, 392, 203, 363, 253, 192, 220,
-	206, 227, 241, 243, 276, 305, 311, 340, 343, 258,
-	238, 218, 360, 215, 378, 398, 399, 400, 402, 309,
-	234, 327, 0, 0, 0, 0, 519, 0, 0, 0,
-	237, 0, 518, 0, 0, 0, 285, 0, 0, 0,
-	341, 0, 379, 223, 294, 292, 407, 247, 240, 236,
-	222, 269, 300, 339, 397, 333, 562, 289, 0, 0,
-	388, 312, 0, 0, 0, 0, 0, 553, 554, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 221, 190,
-	324, 389, 251, 71, 0, 0, 182, 183, 184, 540,
-	1430, 542, 543, 544, 545, 0, 0, 212, 541, 219,
-	546, 547, 548, 0, 233, 273, 239, 232, 404, 0,
-	0, 0, 516, 533, 0, 561, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 530, 531, 609, 0, 0,
-	0, 577, 0, 532, 0, 0, 525, 526, 528, 527,
-	529, 534, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 313, 0, 576, 0, 0, 435, 0,
-	0, 574, 0, 0, 0, 0, 0, 284, 0, 281,
-	186, 201, 0, 0, 323, 362, 368, 0, 0, 0,
-	224, 0, 366, 337, 421, 208, 249, 359, 342, 364,
-	0, 0, 365, 290, 409, 354, 419, 436, 437, 231,
-	317, 427, 401, 433, 447, 202, 228, 331, 394, 424,
-	385, 310, 405, 406, 280, 384, 257, 189, 288, 444,
-	200, 374, 216, 193, 396, 417, 213, 377, 0, 0,
-	0, 195, 415, 393, 307, 277, 278, 194, 0, 358,
-	235, 255, 226, 326, 412, 413, 225, 449, 204, 432,
-	197, 0, 431, 319, 408, 416, 308, 299, 196, 414,
-	306, 298, 283, 245, 265, 352, 293, 353, 266, 315,
-	314, 316, 0, 191, 0, 390, 425, 450, 210, 0,
-	0, 403, 441, 446, 0, 355, 211, 256, 244, 351,
-	254, 286, 440, 442, 443, 445, 209, 349, 262, 330,
-	420, 248, 428, 318, 205, 268, 386, 282, 291, 0,
-	0, 336, 367, 214, 423, 387, 564, 575, 570, 571,
-	568, 569, 563, 567, 566, 565, 578, 555, 556, 557,
-	558, 560, 0, 572, 573, 559, 185, 198, 287, 0,
-	356, 252, 448, 430, 426, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 188, 199, 207, 217, 229, 242, 250, 260, 264,
-	267, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 410, 411, 422, 434, 438, 261, 418, 439, 0,
-	295, 0, 0, 297, 246, 263, 272, 0, 429, 392,
-	203, 363, 253, 192, 220, 206, 227, 241, 243, 276,
-	305, 311, 340, 343, 258, 238, 218, 360, 215, 378,
-	398, 399, 400, 402, 309, 234, 589, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	0, 0, 0, 0, 519, 0, 0, 0, 237, 0,
-	518, 0, 0, 0, 285, 0, 0, 0, 341, 0,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 562, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 553, 554, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 221, 190, 324, 389,
-	251, 71, 0, 0, 182, 183, 184, 540, 539, 542,
-	543, 544, 545, 0, 0, 212, 541, 219, 546, 547,
-	548, 0, 233, 273, 239, 232, 404, 0, 0, 0,
-	516, 533, 0, 561, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 530, 531, 0, 0, 0, 0, 577,
-	0, 532, 0, 0, 525, 526, 528, 527, 529, 534,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 313, 0, 576, 0, 0, 435, 0, 0, 574,
-	0, 0, 0, 0, 0, 284, 0, 281, 186, 201,
-	0, 0, 323, 362, 368, 0, 0, 0, 224, 0,
-	366, 337, 421, 208, 249, 359, 342, 364, 0, 0,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 0,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 0, 0, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 0, 0, 336,
-	367, 214, 423, 387, 564, 575, 570, 571, 568, 569,
-	563, 567, 566, 565, 578, 555, 556, 557, 558, 560,
-	0, 572, 573, 559, 185, 198, 287, 0, 356, 252,
-	448, 430, 426, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 0,
-	0, 297, 246, 263, 272, 0, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 327, 0, 0, 0, 0, 519,
-	0, 0, 0, 237, 0, 518, 0, 0, 0, 285,
-	0, 0, 0, 341, 0, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 562,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	553, 554, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 221, 190, 324, 389, 251, 71, 0, 0, 182,
-	183, 184, 540, 539, 542, 543, 544, 545, 0, 0,
-	212, 541, 219, 546, 547, 548, 0, 233, 273, 239,
-	232, 404, 0, 0, 0, 516, 533, 0, 561, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 531,
-	0, 0, 0, 0, 577, 0, 532, 0, 0, 525,
-	526, 528, 527, 529, 534, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 313, 0, 576, 0,
-	0, 435, 0, 0, 574, 0, 0, 0, 0, 0,
-	284, 0, 281, 186, 201, 0, 0, 323, 362, 368,
-	0, 0, 0, 224, 0, 366, 337, 421, 208, 249,
-	359, 342, 364, 0, 0, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 0, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 0, 0, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 0, 0, 336, 367, 214, 423, 387, 564,
-	575, 570, 571, 568, 569, 563, 567, 566, 565, 578,
-	555, 556, 557, 558, 560, 0, 572, 573, 559, 185,
-	198, 287, 0, 356, 252, 448, 430, 426, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 0, 0, 297, 246, 263, 272,
-	0, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 285, 0, 0, 0, 341, 0,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 562, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 553, 554, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 221, 190, 324, 389,
-	251, 71, 0, 0, 182, 183, 184, 540, 539, 542,
-	543, 544, 545, 0, 0, 212, 541, 219, 546, 547,
-	548, 0, 233, 273, 239, 232, 404, 0, 0, 0,
-	0, 533, 0, 561, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 530, 531, 0, 0, 0, 0, 577,
-	0, 532, 0, 0, 525, 526, 528, 527, 529, 534,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 313, 0, 576, 0, 0, 435, 0, 0, 574,
-	0, 0, 0, 0, 0, 284, 0, 281, 186, 201,
-	0, 0, 323, 362, 368, 0, 0, 0, 224, 0,
-	366, 337, 421, 208, 249, 359, 342, 364, 2197, 0,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 0,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 0, 0, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 0, 0, 336,
-	367, 214, 423, 387, 564, 575, 570, 571, 568, 569,
-	563, 567, 566, 565, 578, 555, 556, 557, 558, 560,
-	0, 572, 573, 559, 185, 198, 287, 0, 356, 252,
-	448, 430, 426, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 0,
-	0, 297, 246, 263, 272, 0, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 285,
-	0, 0, 0, 341, 0, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 562,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	553, 554, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 221, 190, 324, 389, 251, 71, 0, 596, 182,
-	183, 184, 540, 539, 542, 543, 544, 545, 0, 0,
-	212, 541, 219, 546, 547, 548, 0, 233, 273, 239,
-	232, 404, 0, 0, 0, 0, 533, 0, 561, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 531,
-	0, 0, 0, 0, 577, 0, 532, 0, 0, 525,
-	526, 528, 527, 529, 534, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 313, 0, 576, 0,
-	0, 435, 0, 0, 574, 0, 0, 0, 0, 0,
-	284, 0, 281, 186, 201, 0, 0, 323, 362, 368,
-	0, 0, 0, 224, 0, 366, 337, 421, 208, 249,
-	359, 342, 364, 0, 0, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 0, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 0, 0, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 0, 0, 336, 367, 214, 423, 387, 564,
-	575, 570, 571, 568, 569, 563, 567, 566, 565, 578,
-	555, 556, 557, 558, 560, 0, 572, 573, 559, 185,
-	198, 287, 0, 356, 252, 448, 430, 426, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 0, 0, 297, 246, 263, 272,
-	0, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 285, 0, 0, 0, 341, 0,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 562, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 553, 554, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 221, 190, 324, 389,
-	251, 71, 0, 0, 182, 183, 184, 540, 539, 542,
-	543, 544, 545, 0, 0, 212, 541, 219, 546, 547,
-	548, 0, 233, 273, 239, 232, 404, 0, 0, 0,
-	0, 533, 0, 561, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 530, 531, 0, 0, 0, 0, 577,
-	0, 532, 0, 0, 525, 526, 528, 527, 529, 534,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 313, 0, 576, 0, 0, 435, 0, 0, 574,
-	0, 0, 0, 0, 0, 284, 0, 281, 186, 201,
-	0, 0, 323, 362, 368, 0, 0, 0, 224, 0,
-	366, 337, 421, 208, 249, 359, 342, 364, 0, 0,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 0,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 0, 0, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 0, 0, 336,
-	367, 214, 423, 387, 564, 575, 570, 571, 568, 569,
-	563, 567, 566, 565, 578, 555, 556, 557, 558, 560,
-	0, 572, 573, 559, 185, 198, 287, 0, 356, 252,
-	448, 430, 426, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 0,
-	0, 297, 246, 263, 272, 0, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 285,
-	0, 0, 0, 341, 0, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 0,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 221, 190, 324, 389, 251, 0, 0, 0, 182,
-	183, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	212, 0, 219, 0, 0, 0, 0, 233, 273, 239,
-	232, 404, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 983, 982, 992, 993, 985, 986,
-	987, 988, 989, 990, 991, 984, 0, 0, 994, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 313, 0, 0, 0,
-	0, 435, 0, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 186, 201, 0, 0, 323, 362, 368,
-	0, 0, 0, 224, 0, 366, 337, 421, 208, 249,
-	359, 342, 364, 0, 0, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 0, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 0, 0, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 0, 0, 336, 367, 214, 423, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
-	198, 287, 0, 356, 252, 448, 430, 426, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 0, 0, 297, 246, 263, 272,
-	0, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 809,
-	0, 0, 0, 0, 285, 0, 0, 0, 341, 0,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 0, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 221, 190, 324, 389,
-	251, 0, 0, 0, 182, 183, 184, 0, 0, 0,
-	0, 0, 0, 0, 0, 212, 0, 219, 0, 0,
-	0, 0, 233, 273, 239, 232, 404, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 313, 0, 0, 0, 808, 435, 0, 0, 0,
-	0, 0, 0, 805, 806, 284, 774, 281, 186, 201,
-	799, 803, 323, 362, 368, 0, 0, 0, 224, 0,
-	366, 337, 421, 208, 249, 359, 342, 364, 0, 0,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 0,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 0, 0, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 0, 0, 336,
-	367, 214, 423, 387, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 185, 198, 287, 0, 356, 252,
-	448, 430, 426, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 0,
-	0, 297, 246, 263, 272, 0, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 327, 0, 0, 0, 1086, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 285,
-	0, 0, 0, 341, 0, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 0,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 221, 190, 324, 389, 251, 0, 0, 0, 182,
-	183, 184, 0, 1088, 0, 0, 0, 0, 0, 0,
-	212, 0, 219, 0, 0, 0, 0, 233, 273, 239,
-	232, 404, 972, 973, 971, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	974, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 313, 0, 0, 0,
-	0, 435, 0, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 186, 201, 0, 0, 323, 362, 368,
-	0, 0, 0, 224, 0, 366, 337, 421, 208, 249,
-	359, 342, 364, 0, 0, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 0, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 0, 0, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 0, 0, 336, 367, 214, 423, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
-	198, 287, 0, 356, 252, 448, 430, 426, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 0, 0, 297, 246, 263, 272,
-	0, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 35,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 71, 0, 596, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 186, 201, 0, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 327, 0, 0,
-	0, 1460, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 1462, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 1458, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 768,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 774,
-	281, 186, 201, 772, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 327, 0, 0,
-	0, 1460, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 1462, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 0, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 35, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 71, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	1480, 0, 0, 1481, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 1119, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 1118, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 596, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 71, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 1462,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 1088, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 1365, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 1243, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 1241, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 1239, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 1237, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 1235, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 1231, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 1229, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 1227, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 1202, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	1101, 0, 0, 0, 0, 0, 0, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 0, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 327, 0, 0, 0, 0, 0, 0, 0,
-	1092, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 186, 201, 0, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 948, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 0, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	507, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 186, 201, 0, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 506, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 456, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 0, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 186, 201, 0, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234,
+	0, 0, 0, 0, 0, 0, 0, 75,
 }
 
 var yyPact = [...]int{
-	2708, -1000, -342, 1647, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	28178, -1000, -347, 1679, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1601, 1240, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 581, 1270, 222, 1508, 213, 167, 27823, 412,
-	85, 27368, 403, 2109, 27823, -1000, 99, -1000, 86, 27823,
-	91, 26913, -1000, -1000, -284, 12775, 1450, 10, 8, 27823,
-	106, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1246,
-	1573, 1579, 1598, 1090, 1603, -1000, 10942, 10942, 354, 354,
-	354, 9122, -1000, -1000, 16883, 27823, 27823, 1275, 402, 952,
-	389, 387, 386, -1000, -106, -1000, -1000, -1000, -1000, 1508,
-	-1000, -1000, 168, -1000, 254, 1237, -1000, 1225, -1000, 419,
-	416, 250, 330, 329, 248, 247, 245, 244, 242, 241,
-	240, 238, 259, -1000, 539, 539, -172, -179, 296, 321,
-	321, 321, 369, 1477, 1461, -1000, 623, -1000, 539, 539,
-	164, 539, 539, 539, 539, 221, 219, 539, 539, 539,
-	539, 539, 539, 539, 539, 539, 539, 539, 539, 539,
-	539, 539, 27823, -1000, 162, 580, 246, 613, 1508, 212,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1633, 1266, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 730, 1311, 172, 1548, 219, 240, 27705,
+	446, 143, 27245, 445, 136, 27705, -1000, 97, -1000, 89,
+	27705, 93, 26785, -1000, -1000, -285, 12492, 1505, 22, -12,
+	27705, 107, -209, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1334, 1598, 1612, 1629, 1105, 1717, -1000, 10639, 10639,
+	395, 395, 395, 8799, -1000, -1000, 16645, 27705, 27705, 1339,
+	442, 989, 432, 429, 428, -1000, -102, -1000, -1000, -1000,
+	-1000, 1548, -1000, -1000, 170, -1000, 309, 1281, -1000, 1280,
+	-1000, 427, 547, 305, 360, 354, 303, 301, 300, 299,
+	298, 290, 288, 275, 328, -1000, 572, 572, -173, -174,
+	2325, 380, 380, 380, 409, 1525, 1521, -1000, 567, -1000,
+	572, 572, 134, 572, 572, 572, 572, 226, 214, 572,
+	572, 572, 572, 572, 572, 572, 572, 572, 572, 572,
+	572, 572, 572, 572, 27705, -1000, 157, 563, 262, 27705,
+	609, 1548, 193, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3975,26 +4009,27 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 27823, 400, 952, 349, -1000, 27823, -1000, 483, 27823,
-	636, 636, 63, 636, 636, 636, 636, 82, 462, -10,
-	-1000, 73, 201, 187, 191, 673, 74, 62, -1000, -1000,
-	189, 673, 90, -1000, 636, 7246, 7246, 7246, -1000, 1498,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 367, -1000,
-	-1000, -1000, -1000, 27823, 26458, 278, 598, -1000, -1000, -1000,
-	23, -1000, -1000, 1146, 568, -1000, 12775, 2381, 1241, 1241,
-	-1000, -1000, 471, -1000, -1000, 14140, 14140, 14140, 14140, 14140,
-	14140, 14140, 14140, 14140, 14140, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1241,
-	479, -1000, 12320, 1241, 1241, 1241, 1241, 1241, 1241, 1241,
-	1241, 12775, 1241, 1241, 1241, 1241, 1241, 1241, 1241, 1241,
-	1241, 1241, 1241, 1241, 1241, 1241, 1241, 1241, 1241, -1000,
-	-1000, -1000, 27823, -1000, 1241, 987, 1601, -1000, 1240, -1000,
-	-1000, -1000, 1494, 12775, 12775, 1601, -1000, 1390, 10942, -1000,
-	-1000, 1481, -1000, -1000, -1000, -1000, -1000, 711, 1633, -1000,
-	15505, 476, 1632, 26003, -1000, 19626, 25548, 1205, 8653, -24,
-	-1000, -1000, -1000, 592, 18716, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 27705, 441,
+	989, 388, -1000, 27705, -1000, 500, 27705, 747, 747, 7,
+	747, 747, 747, 747, 77, 521, -17, -1000, 74, 206,
+	195, 167, 676, 258, 66, -1000, -1000, 175, 676, 747,
+	1666, 101, -1000, 747, 6903, 6903, 6903, -1000, 1536, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 400, -1000, -1000,
+	-1000, -1000, 27705, 26325, 264, 604, -1000, -1000, -1000, 72,
+	-1000, -1000, 1114, 700, -1000, 12492, 1308, 1283, 1283, -1000,
+	-1000, 467, -1000, -1000, 13872, 13872, 13872, 13872, 13872, 13872,
+	13872, 13872, 13872, 13872, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1283, 499,
+	-1000, 12032, 1283, 1283, 1283, 1283, 1283, 1283, 1283, 1283,
+	12492, 1283, 1283, 1283, 1283, 1283, 1283, 1283, 1283, 1283,
+	1283, 1283, 1283, 1283, 1283, 1283, 1283, 1283, -1000, -1000,
+	-1000, 27705, -1000, 1283, 1008, 918, -1000, -1000, 1633, -1000,
+	1266, -1000, -1000, -1000, 1542, 12492, 12492, 1633, -1000, 1431,
+	10639, -1000, -1000, 1610, -1000, -1000, -1000, -1000, -1000, 767,
+	1665, -1000, 15252, 496, 1663, 25865, -1000, 19418, 25405, 1279,
+	8325, -28, -1000, -1000, -1000, 583, 18498, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1498, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1536, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4006,191 +4041,192 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1128, 27823, -1000,
-	-1000, 2563, 952, -1000, 1269, -1000, 1123, -1000, 1249, 162,
-	352, 1287, 952, 952, 952, 352, -1000, -1000, -1000, 539,
-	539, 257, 213, 3838, -1000, -1000, -1000, 25086, 1268, 952,
-	-1000, 1267, -1000, 1531, 337, 579, 579, 952, -1000, -1000,
-	27823, 952, 1530, 1527, 27823, 27823, -1000, 24631, -1000, 24176,
-	23721, 867, 27823, 23266, 22811, 22356, 21901, 21446, -1000, 1347,
-	-1000, 1262, -1000, -1000, -1000, 27823, 27823, 27823, -6, -1000,
-	-1000, 27823, 952, -1000, -1000, 860, 851, 539, 539, 850,
-	986, 982, 977, 539, 539, 820, 976, 1012, 190, 813,
-	804, 801, 831, 974, 108, 810, 789, 794, 27823, 1266,
-	-1000, 150, 578, 220, 270, 200, 27823, 132, 1571, 198,
-	1508, 1449, 1202, 366, 349, 1308, 27823, 1553, 349, -1000,
-	7715, -1000, -1000, 973, 12775, -1000, 679, 673, 673, -1000,
-	-1000, -1000, -1000, -1000, -1000, 636, 27823, 679, -1000, -1000,
-	-1000, 673, 636, 27823, 636, 636, 636, 636, 673, 636,
-	27823, 27823, 27823, 27823, 27823, 27823, 27823, 27823, 27823, 7246,
-	7246, 7246, 528, 636, -1000, 1307, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 89, -1000, -1000, -1000, -1000, -1000,
-	1647, -1000, -1000, -1000, -115, 1181, 20991, -1000, -288, -289,
-	-290, -291, -1000, -1000, -1000, -292, -297, -1000, -1000, -1000,
-	12775, 12775, 12775, 12775, 786, 530, 14140, 756, 533, 14140,
-	14140, 14140, 14140, 14140, 14140, 14140, 14140, 14140, 14140, 14140,
-	14140, 14140, 14140, 14140, 718, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 952, -1000, 1637, 1039, 1039, 491, 491,
-	491, 491, 491, 491, 491, 491, 491, 14595, 9577, 7715,
-	1090, 1117, 1601, 10942, 10942, 12775, 12775, 11852, 11397, 10942,
-	1472, 631, 568, 27823, -1000, 881, -1000, -1000, 13685, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1141,
+	27705, -1000, -1000, 497, 989, -1000, 1310, -1000, 1139, -1000,
+	1290, 157, 393, 1345, 989, 989, 989, 393, -1000, -1000,
+	-1000, 572, 572, 318, 219, 4490, -1000, -1000, -1000, 24938,
+	1309, 989, -1000, 1301, -1000, 1560, 385, 570, 570, 989,
+	-1000, -1000, 27705, 989, 1558, 1557, 27705, 27705, -1000, 24478,
+	-1000, 24018, 23558, 916, 27705, 23098, 22638, 22178, 21718, 21258,
+	-1000, 1384, -1000, 1278, -1000, -1000, -1000, 27705, 27705, 27705,
+	9, -1000, -1000, 27705, 989, -1000, -1000, 915, 913, 572,
+	572, 880, 1006, 992, 991, 572, 572, 873, 988, 1037,
+	267, 872, 869, 865, 894, 983, 113, 875, 866, 863,
+	27705, 1300, -1000, 152, 579, 251, 259, 216, 27705, 160,
+	1603, 137, 180, 1548, 1504, 1273, 399, 388, 1390, 27705,
+	1587, 388, -1000, 7377, -1000, -1000, 982, 12492, -1000, 698,
+	676, 676, -1000, -1000, -1000, -1000, -1000, -1000, 747, 27705,
+	698, -1000, -1000, -1000, 676, 747, 27705, 747, 747, 747,
+	747, 676, 747, 27705, 27705, 27705, 27705, 27705, 27705, 27705,
+	27705, 27705, 6903, 6903, 6903, 531, 747, -1000, 27705, -1000,
+	1388, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 92,
+	-1000, -1000, -1000, -1000, -1000, 1679, -1000, -1000, -1000, -113,
+	1272, 20798, -1000, -289, -290, -291, -292, -1000, -1000, -1000,
+	-293, -297, -1000, -1000, -1000, 12492, 12492, 12492, 12492, 811,
+	537, 13872, 765, 546, 13872, 13872, 13872, 13872, 13872, 13872,
+	13872, 13872, 13872, 13872, 13872, 13872, 13872, 13872, 13872, 581,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 989, -1000,
+	1677, 1172, 1172, 512, 512, 512, 512, 512, 512, 512,
+	512, 512, 14332, 9259, 7377, 1105, 1128, 1633, 10639, 10639,
+	12492, 12492, 11559, 11099, 10639, 1534, 673, 700, 27705, -1000,
+	1030, -1000, -1000, 13412, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 27705, 27705, 10639, 10639,
+	10639, 10639, 10639, -1000, 1267, -1000, -175, 16185, 12492, -1000,
+	-1000, 1612, 1105, 1610, 1572, 1672, 528, 1095, 1264, -1000,
+	1025, 1612, 18038, 1285, -1000, 1610, -1000, -1000, -1000, 27705,
+	-1000, -1000, 20338, -1000, -1000, 6429, 27705, 271, 27705, -1000,
+	1196, 1443, -1000, -1000, -1000, 1594, 17578, 27705, 1261, 1160,
+	-1000, -1000, 492, 7851, -28, -1000, 7851, 1192, -1000, -50,
+	-60, 9719, 508, -1000, -1000, -1000, 2325, 14792, 1102, -1000,
+	14, -1000, -1000, -1000, 1290, -1000, 1290, 1290, 1290, 1290,
+	9, 9, 9, 9, -1000, -1000, -1000, -1000, -1000, 1298,
+	1295, -1000, 1290, 1290, 1290, 1290, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 27823, 27823, 10942, 10942, 10942, 10942, 10942, -1000, 1177,
-	-1000, -175, 16428, 12775, -1000, 1579, 1090, 1481, 1546, 1641,
-	520, 863, 1176, -1000, 964, 1579, 18261, 1222, -1000, 1481,
-	-1000, -1000, -1000, 27823, -1000, -1000, 20536, -1000, -1000, 6777,
-	27823, 234, 27823, -1000, 1190, 1567, -1000, -1000, -1000, 1568,
-	17806, 27823, 1145, 1122, -1000, -1000, 474, 8184, -24, -1000,
-	8184, 1160, -1000, -67, -37, 10032, 467, -1000, -1000, -1000,
-	296, 15050, 1114, -1000, 25, -1000, -1000, -1000, 1249, -1000,
-	1249, 1249, 1249, 1249, -6, -6, -6, -6, -1000, -1000,
-	-1000, -1000, -1000, 1264, 1263, -1000, 1249, 1249, 1249, 1249,
+	-1000, -1000, -1000, 1294, 1294, 1294, 1291, 1291, 375, -1000,
+	12492, 191, 27705, 1578, 862, 152, 27705, 680, 1383, -1000,
+	27705, 1345, 1345, 1345, 27705, 959, 953, -1000, 1259, -1000,
+	-1000, 1628, -1000, -1000, 557, 713, 688, 616, 27705, 114,
+	269, -1000, 362, -1000, 27705, 1293, 1556, 570, 989, -1000,
+	989, -1000, -1000, -1000, -1000, 485, -1000, -1000, 989, 1255,
+	-1000, 1277, 771, 687, 717, 686, 1255, -1000, -1000, -153,
+	1255, -1000, 1255, -1000, 1255, -1000, 1255, -1000, 1255, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 629, 27705, 114,
+	581, -1000, 398, -1000, -1000, 581, 581, -1000, -1000, -1000,
+	-1000, 978, 971, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1256, 1256, 1256,
-	1251, 1251, 314, -1000, 12775, 178, 27823, 1539, 791, 150,
-	27823, 587, 1306, -1000, 27823, 1287, 1287, 1287, 27823, 970,
-	877, -1000, 1175, -1000, -1000, 1597, -1000, -1000, 514, 671,
-	668, 565, 27823, 114, 233, -1000, 308, -1000, 27823, 1254,
-	1524, 579, 952, -1000, 952, -1000, -1000, -1000, -1000, 463,
-	-1000, -1000, 952, 1174, -1000, 1140, 707, 662, 704, 661,
-	1174, -1000, -1000, -151, 1174, -1000, 1174, -1000, 1174, -1000,
-	1174, -1000, 1174, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 534, 27823, 114, 718, -1000, 356, -1000, -1000, 718,
-	718, -1000, -1000, -1000, -1000, 971, 969, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -339,
+	27705, 418, 120, 194, 27705, 27705, 27705, 27705, 27705, 462,
+	-1000, -1000, -1000, -1000, 1649, 1649, -1000, -1000, -1000, 211,
+	27705, 27705, 27705, 27705, 458, -1000, -1000, 27705, -1000, -1000,
+	-1000, -1000, 700, 27705, -1000, -1000, 747, 747, -1000, -1000,
+	27705, 747, -1000, -1000, -1000, -1000, -1000, -1000, 747, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -334, 27823, 376, 142, 151, 27823, 27823,
-	27823, 27823, 27823, 424, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 202, 27823, 27823, 27823, 27823, 401, -1000, -1000, 27823,
-	-1000, -1000, -1000, -1000, 568, 27823, -1000, -1000, 636, 636,
-	-1000, -1000, 27823, 636, -1000, -1000, -1000, -1000, -1000, -1000,
-	636, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 963, -1000, 27823, 27823, -1000,
-	-1000, -1000, -1000, -1000, 70, -71, 177, -1000, -1000, -1000,
-	-1000, 1576, -1000, 568, 530, 633, 583, -1000, -1000, 806,
-	-1000, -1000, 1277, -1000, -1000, -1000, -1000, 756, 14140, 14140,
-	14140, 589, 1277, 2508, 1344, 1652, 491, 656, 656, 504,
-	504, 504, 504, 504, 717, 717, -1000, -1000, -1000, -1000,
-	881, -1000, -1000, -1000, 881, 10942, 10942, 1172, 1241, 451,
-	-1000, 1246, -1000, -1000, 1579, 1092, 1092, 829, 1011, 619,
-	1626, 1092, 608, 1623, 1092, 1092, 10942, -1000, -1000, 695,
-	-1000, 12775, 881, -1000, 892, 1166, 1165, 1092, 881, 881,
-	1092, 1092, 27823, -1000, -280, -1000, -86, 460, 1241, -1000,
-	20081, -1000, -1000, 881, 1146, 1494, -1000, -1000, 1466, -1000,
-	1387, 12775, 12775, 12775, -1000, -1000, -1000, 1494, 1578, -1000,
-	1400, 1397, 1615, 10942, 19626, 1481, -1000, -1000, -1000, 448,
-	1615, 1189, 1241, -1000, 27823, 19626, 19626, 19626, 19626, 19626,
-	-1000, 1321, 1320, -1000, 1361, 1358, 1338, 27823, -1000, 1111,
-	1090, 17806, 234, 1157, 19626, 27823, -1000, -1000, 19626, 27823,
-	6308, -1000, 1160, -24, -49, -1000, -1000, -1000, -1000, 568,
-	-1000, 858, -1000, 2216, -1000, 315, -1000, -1000, -1000, -1000,
-	480, 15, -1000, -1000, -6, -6, -1000, -1000, 467, 748,
-	467, 467, 467, 958, 958, -1000, -1000, -1000, -1000, -1000,
-	787, -1000, -1000, -1000, 777, -1000, -1000, 960, 1342, 178,
-	-1000, -1000, 539, 956, 1455, -1000, -1000, 1049, 374, -1000,
-	1552, 27823, -1000, 1305, 1302, 1297, -1000, -1000, -1000, -1000,
-	-1000, 3686, 27823, 1105, -1000, 111, 27823, 1040, 27823, -1000,
-	1100, 27823, -1000, 952, -1000, -1000, 7715, -1000, 27823, 1241,
-	-1000, -1000, -1000, -1000, 398, 1506, 1505, 114, 111, 467,
-	952, -1000, -1000, -1000, -1000, -1000, -337, 1096, 27823, 141,
-	-1000, 1253, 993, -1000, 1283, -1000, -1000, -1000, -1000, 109,
-	215, 216, 331, -1000, 377, 1342, 27823, -1000, -1000, -1000,
-	-1000, 673, -1000, -1000, 673, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1496, -75, -311, -1000, -307, -1000, -1000, -1000, -1000,
-	589, 1277, 2421, -1000, 14140, 14140, -1000, -1000, 1092, 1092,
-	10942, 7715, 1601, 1494, -1000, -1000, 285, 718, 285, 14140,
-	14140, -1000, 14140, 14140, -1000, -119, 1168, 622, -1000, 12775,
-	672, -1000, -1000, 14140, 14140, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 385, 380, 379, 27823, -1000, -1000,
-	-1000, 878, 929, 1369, 568, 568, -1000, -1000, 27823, -1000,
-	-1000, -1000, -1000, 1604, 12775, -1000, 1158, -1000, 5839, 1579,
-	1294, 27823, 1241, 1647, 15973, 27823, 1167, -1000, 566, 1567,
-	1281, 1293, 1393, -1000, -1000, -1000, -1000, 1319, -1000, 1280,
-	-1000, -1000, -1000, -1000, -1000, 1090, 1615, 19626, 1135, -1000,
-	1135, -1000, 446, -1000, -1000, -1000, -80, -52, -1000, -1000,
-	-1000, 296, -1000, -1000, -1000, 684, 14140, 1640, -1000, 915,
-	1519, -1000, 1518, -1000, -1000, 467, 467, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1087, -1000, 1082, 1156, 1079, 54,
-	-1000, 1194, 1493, 539, 539, -1000, 775, -1000, 952, -1000,
-	27823, -1000, -1000, 27823, 27823, 27823, 1595, 1155, -1000, 27823,
-	-1000, -1000, 27823, -1000, -1000, 1396, 178, 1077, -1000, -1000,
-	-1000, 233, 27823, -1000, 1039, 111, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1243, -1000, -1000, -1000, 1009, -1000, -159, 952,
-	27823, 27823, 27823, -1000, 27823, -1000, -1000, -1000, 636, 636,
-	-1000, 1485, -1000, 952, -1000, 14140, 1277, 1277, -1000, -1000,
-	881, -1000, 1579, -1000, 881, 1249, 1249, -1000, 1249, 1251,
-	-1000, 1249, 79, 1249, 69, 881, 881, 2295, 2211, 2145,
-	1797, 1241, -113, -1000, 568, 12775, 1257, 876, 1241, 1241,
-	1241, 1058, 911, -6, -1000, -1000, -1000, 1607, 1594, 568,
-	-1000, -1000, -1000, 1535, 1017, 1134, -1000, -1000, 10487, 1068,
-	1394, 439, 1058, 1601, 27823, 12775, -1000, -1000, 12775, 1248,
-	-1000, 12775, -1000, -1000, -1000, 1601, 1601, 1135, -1000, -1000,
-	505, -1000, -1000, -1000, -1000, -1000, 1277, -66, -1000, -1000,
-	-1000, -1000, -1000, -6, 908, -6, 757, -1000, 746, -1000,
-	-1000, -218, -1000, -1000, 1211, 1323, -1000, -1000, 1243, -1000,
-	-1000, -1000, 27823, 27823, -1000, -1000, 230, -1000, 279, 1034,
-	-1000, -173, -1000, -1000, 1565, 27823, -1000, -1000, 7715, -1000,
-	-1000, 1242, 1286, -1000, -1000, -1000, -1000, -1000, -1000, 1277,
-	-1000, 1494, -1000, -1000, 218, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 14140, 14140, 14140, 14140, 14140, 1579, 905,
-	568, 14140, 14140, 19171, 27823, 27823, 17338, -6, 0, -1000,
-	12775, 12775, 1511, -1000, 1241, -1000, 1170, 27823, 1241, 27823,
-	-1000, 1579, -1000, 568, 568, 27823, 568, 1579, -1000, -1000,
-	467, -1000, 467, 1003, 1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1560, 1155, -1000, 228, 27823, -1000, 233,
-	-1000, -180, -181, 1240, 1032, 1153, -1000, 538, 27823, 27823,
-	-1000, -1000, -1000, 892, 892, 892, 892, 211, 881, -1000,
-	892, 892, 1030, -1000, 1030, 1030, 460, -271, -1000, 1445,
-	1420, 568, 1146, 1638, -1000, 1241, 1647, 436, 1134, -1000,
-	-1000, 1027, -1000, -1000, -1000, -1000, -1000, 1240, 1241, 1212,
-	-1000, -1000, -1000, 180, -1000, 7715, 5370, 1022, -1000, -1000,
-	-1000, -1000, -1000, 881, 161, -162, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 0, 287, -1000, 1403, 1420, -1000, 1593,
-	1426, 1590, -1000, 27823, 1134, 27823, -1000, 180, 13230, 27823,
-	-1000, -56, -1000, -1000, -1000, -1000, -1000, 1283, -1000, 1366,
-	-130, -169, 1419, 1422, 1422, 1403, -1000, 1588, 1586, -1000,
-	903, 1584, 898, 1120, -1000, -1000, 892, 881, 998, 309,
-	-1000, -1000, -159, -1000, 1359, -1000, 1417, 797, -1000, -1000,
-	-1000, -1000, 880, 879, -1000, 859, -1000, -1000, -1000, 1290,
-	166, -1000, -160, -1000, 744, -1000, -1000, -1000, -1000, -1000,
-	1289, -1000, 1631, -1000, -165, -1000, -1000, -1000, 1636, 425,
-	425, -171, -1000, -1000, -1000, 304, 799, -1000, -1000, -1000,
-	-1000, -1000,
+	-1000, -1000, -1000, 970, -1000, 747, 27705, 27705, -1000, -1000,
+	-1000, -1000, -1000, 32, -56, 165, -1000, -1000, -1000, -1000,
+	1606, -1000, 700, 537, 697, 720, -1000, -1000, 876, -1000,
+	-1000, 1269, -1000, -1000, -1000, -1000, 765, 13872, 13872, 13872,
+	952, 1269, 2539, 1148, 855, 512, 678, 678, 643, 643,
+	643, 643, 643, 729, 729, -1000, -1000, -1000, -1000, 1030,
+	-1000, -1000, -1000, 1030, 10639, 10639, 1254, 1283, 482, -1000,
+	1334, -1000, -1000, 1612, 1096, 1096, 1038, 927, 651, 1661,
+	1096, 649, 1651, 1096, 1096, 10639, -1000, -1000, 735, -1000,
+	12492, 1030, -1000, 1725, 1240, 1227, 1096, 1030, 1030, 1096,
+	1096, 27705, -1000, -281, -1000, -81, 490, 1283, -1000, 19878,
+	-1000, -1000, 1030, 1114, 1542, -1000, -1000, 1497, -1000, 1428,
+	12492, 12492, 12492, -1000, -1000, -1000, 1542, 1602, -1000, 1446,
+	1440, 1649, 10639, 19418, 1610, 1638, -1000, -1000, 475, 1649,
+	1299, 1283, -1000, 27705, 19418, 19418, 19418, 19418, 19418, -1000,
+	1405, 1404, -1000, 1403, 1402, 1412, 27705, -1000, 1126, 1105,
+	17578, 271, 1224, 19418, 27705, -1000, -1000, 19418, 27705, 5955,
+	-1000, 1192, -28, -35, -1000, -1000, -1000, -1000, 700, -1000,
+	893, -1000, 2405, -1000, 364, -1000, -1000, -1000, -1000, 379,
+	29, -1000, -1000, 9, 9, -1000, -1000, 508, 809, 508,
+	508, 508, 965, 965, -1000, -1000, -1000, -1000, -1000, 858,
+	-1000, -1000, -1000, 837, -1000, -1000, 917, 1378, 191, -1000,
+	-1000, 572, 961, 1510, -1000, -1000, 1097, 411, -1000, 1586,
+	27705, -1000, 1381, 1380, 1379, -1000, -1000, -1000, -1000, -1000,
+	2239, 27705, 1116, -1000, 115, 27705, 1072, 27705, -1000, 1112,
+	27705, -1000, 989, -1000, -1000, 7377, -1000, 27705, 1283, -1000,
+	-1000, -1000, -1000, 440, 1546, 1538, 114, 115, 508, 989,
+	-1000, -1000, -1000, -1000, -1000, -337, 1109, 27705, 169, -1000,
+	1292, 805, -1000, 1321, -1000, -1000, -1000, -1000, 131, 215,
+	-1000, 12492, -1000, 192, 397, -1000, 454, 1378, 27705, -1000,
+	-1000, -1000, -1000, 676, -1000, -1000, 676, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1533, -66, -313, -1000, -308, -1000,
+	-1000, -1000, -1000, 952, 1269, 2412, -1000, 13872, 13872, -1000,
+	-1000, 1096, 1096, 10639, 7377, 1633, 1542, -1000, -1000, 743,
+	581, 743, 13872, 13872, -1000, 13872, 13872, -1000, -123, 1228,
+	620, -1000, 12492, 808, -1000, -1000, 13872, 13872, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 425, 423, 421,
+	27705, -1000, -1000, -1000, 908, 958, 1426, 700, 700, -1000,
+	-1000, 27705, -1000, -1000, -1000, -1000, 1637, -1000, 1163, -1000,
+	27705, 5481, 1612, 1377, 27705, 1283, 1679, 15725, 27705, 1230,
+	-1000, 578, 1443, 1348, 1376, 1591, -1000, -1000, -1000, -1000,
+	1394, -1000, 1303, -1000, -1000, -1000, -1000, -1000, 1105, 1649,
+	19418, 1174, -1000, 1174, -1000, 474, -1000, -1000, -1000, -77,
+	-65, -1000, -1000, -1000, 2325, -1000, -1000, -1000, 744, 13872,
+	1671, -1000, 944, 1555, -1000, 1553, -1000, -1000, 508, 508,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1092, -1000, 1087,
+	1144, 1084, 56, -1000, 1320, 1532, 572, 572, -1000, 830,
+	-1000, 989, -1000, 27705, -1000, -1000, 27705, 27705, 27705, 1627,
+	1133, -1000, 27705, -1000, -1000, 27705, -1000, -1000, 1435, 191,
+	1082, -1000, -1000, -1000, 269, 27705, -1000, 1172, 115, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1288, -1000, -1000, -1000, 1065,
+	-1000, -158, 989, 27705, 27705, 27705, 700, -1000, 27705, -1000,
+	-1000, -1000, 747, 747, -1000, 1528, -1000, 989, -1000, 13872,
+	1269, 1269, -1000, -1000, 1030, -1000, 1612, -1000, 1030, 1290,
+	1290, -1000, 1290, 1291, -1000, 1290, 82, 1290, 71, 1030,
+	1030, 2397, 2329, 2240, 2165, 1283, -110, -1000, 700, 12492,
+	1914, 1822, 1283, 1283, 1283, 1071, 941, 9, -1000, -1000,
+	-1000, 1635, 1626, -1000, -1000, -1000, -1000, 1569, 1168, 1026,
+	-1000, -1000, 10179, 1076, 1434, 472, 1071, 1633, 27705, 12492,
+	-1000, -1000, 12492, 1289, -1000, 12492, -1000, -1000, -1000, 1633,
+	1633, 1174, -1000, -1000, 505, -1000, -1000, -1000, -1000, -1000,
+	1269, -79, -1000, -1000, -1000, -1000, -1000, 9, 940, 9,
+	817, -1000, 752, -1000, -1000, -225, -1000, -1000, 1187, 1318,
+	-1000, -1000, 1288, -1000, -1000, -1000, 27705, 27705, -1000, -1000,
+	268, -1000, 348, 1061, -1000, -178, -1000, -1000, 1593, 27705,
+	-1000, -1000, 7377, -1000, -1000, 1287, 1343, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1269, -1000, 1542, -1000, -1000, 213, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 13872, 13872, 13872,
+	13872, 13872, 1612, 939, 700, 13872, 13872, 18958, 27705, 27705,
+	17105, 9, 13, -1000, 12492, 12492, 1550, -1000, 1283, -1000,
+	1194, 27705, 1283, 27705, -1000, 1612, -1000, 700, 700, 27705,
+	700, 1612, -1000, -1000, 508, -1000, 508, 1056, 1043, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1592, 1133, -1000,
+	255, 27705, -1000, 269, -1000, -183, -191, 1266, 1053, 1131,
+	-1000, 550, 27705, 27705, -1000, -1000, -1000, 1725, 1725, 1725,
+	1725, 223, 1030, -1000, 1725, 1725, 1046, -1000, 1046, 1046,
+	490, -276, -1000, 1490, 1477, 700, 1114, 1670, -1000, 1283,
+	1679, 471, 1026, -1000, -1000, 1033, -1000, -1000, -1000, -1000,
+	-1000, 1266, 1283, 1149, -1000, -1000, -1000, 182, -1000, 7377,
+	5007, 1022, -1000, -1000, -1000, -1000, -1000, 1030, 166, -163,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 13, 272, -1000,
+	1485, 1477, -1000, 1624, 1478, 1620, -1000, 27705, 1026, 27705,
+	-1000, 182, 12952, 27705, -1000, -55, -1000, -1000, -1000, -1000,
+	-1000, 1321, -1000, 1425, -128, -170, 1452, 1456, 1456, 1485,
+	-1000, 1619, 1618, -1000, 926, 1617, 925, 924, -1000, -1000,
+	1725, 1030, 1016, 372, -1000, -1000, -158, -1000, 1422, -1000,
+	1450, 802, -1000, -1000, -1000, -1000, 923, 810, -1000, 803,
+	-1000, -1000, -1000, 1352, 132, -1000, -161, -1000, 779, -1000,
+	-1000, -1000, -1000, -1000, 1351, -1000, 1656, -1000, -172, -1000,
+	-1000, -1000, 1669, 515, 515, -176, -1000, -1000, -1000, 369,
+	801, -1000, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1902, 1899, 32, 88, 85, 1898, 1897, 1896, 1894,
-	131, 130, 129, 1891, 1889, 1888, 1886, 1885, 1884, 1883,
-	1882, 1880, 1878, 1877, 1872, 65, 122, 37, 39, 126,
-	1870, 1869, 53, 1868, 1866, 1864, 120, 116, 463, 1858,
-	119, 1857, 1856, 1853, 1852, 1849, 1848, 1847, 1846, 1845,
-	1842, 1838, 1837, 1836, 1835, 182, 1834, 1833, 5, 1832,
-	56, 1831, 1829, 1828, 1823, 1822, 87, 1820, 1818, 1814,
-	104, 1813, 1812, 48, 114, 51, 74, 1811, 1809, 76,
-	814, 1808, 93, 128, 1807, 181, 1806, 42, 79, 69,
-	1805, 44, 1804, 1803, 90, 1801, 1799, 1796, 67, 1794,
-	1779, 3133, 1777, 66, 80, 20, 31, 1776, 1775, 1774,
-	1773, 34, 450, 1772, 1771, 23, 1770, 1769, 145, 1767,
-	86, 18, 1766, 16, 14, 21, 1761, 82, 1760, 11,
-	58, 30, 1759, 83, 1758, 1756, 1755, 1754, 43, 1751,
-	72, 107, 160, 1749, 1748, 7, 15, 1747, 1744, 1743,
-	1742, 1741, 1737, 10, 1734, 2, 1731, 26, 1725, 17,
-	22, 71, 47, 28, 8, 1724, 121, 1723, 27, 125,
-	63, 109, 1722, 1721, 1720, 844, 140, 1717, 1716, 35,
-	1715, 113, 124, 1714, 1486, 1713, 1712, 68, 1276, 2523,
-	13, 111, 1711, 1709, 2164, 59, 78, 24, 1708, 75,
-	1707, 1706, 1705, 127, 118, 50, 841, 46, 1704, 1703,
-	1702, 1700, 1698, 1697, 1696, 133, 9, 19, 99, 29,
-	1694, 1693, 1692, 61, 41, 1691, 108, 103, 64, 94,
-	1690, 112, 106, 73, 1689, 40, 1687, 1686, 1685, 1684,
-	45, 1683, 1681, 1680, 1679, 98, 89, 57, 36, 1678,
-	38, 91, 102, 101, 1674, 25, 123, 12, 1673, 6,
-	0, 1672, 3, 117, 1501, 115, 1671, 1670, 1, 1669,
-	4, 1668, 1662, 81, 1661, 1660, 1659, 1658, 3249, 559,
-	110, 1656, 134,
+	0, 1944, 1943, 32, 85, 87, 1942, 1941, 1939, 1938,
+	139, 138, 133, 1936, 1935, 1934, 1932, 1929, 1928, 1927,
+	1925, 1924, 1923, 1922, 1921, 65, 124, 44, 42, 126,
+	1919, 1916, 58, 1914, 1913, 1911, 129, 122, 542, 1910,
+	121, 1909, 1908, 1907, 1906, 1905, 1903, 1901, 1897, 1895,
+	1894, 1893, 1890, 1888, 1887, 1882, 189, 1876, 1875, 6,
+	1874, 54, 1873, 1872, 1871, 1870, 1869, 92, 1851, 1850,
+	1846, 116, 1845, 1843, 52, 117, 60, 80, 1841, 1838,
+	75, 857, 1837, 104, 130, 1836, 2767, 1835, 43, 91,
+	79, 1832, 45, 1830, 1828, 100, 1827, 1826, 1825, 76,
+	1824, 1823, 3056, 1820, 69, 78, 14, 39, 1819, 1818,
+	1816, 1814, 34, 1749, 1813, 1812, 21, 1811, 1810, 136,
+	1807, 86, 22, 1806, 19, 16, 15, 1805, 84, 1804,
+	11, 57, 30, 1801, 83, 1800, 1799, 1798, 1797, 31,
+	1795, 77, 105, 59, 1794, 1793, 5, 10, 1792, 1790,
+	1789, 1788, 1783, 1780, 9, 1774, 3, 1773, 35, 1771,
+	17, 23, 73, 46, 25, 7, 1770, 131, 1767, 24,
+	123, 70, 115, 1766, 1765, 1759, 928, 146, 1758, 1757,
+	37, 1755, 120, 127, 1750, 1514, 1747, 1745, 67, 1289,
+	2557, 13, 113, 1744, 1743, 2386, 62, 81, 18, 1740,
+	48, 1736, 1735, 1734, 135, 118, 49, 918, 51, 1733,
+	1732, 1729, 1728, 1727, 1724, 1723, 88, 29, 20, 110,
+	26, 1720, 1719, 1717, 68, 38, 1716, 111, 108, 74,
+	95, 1715, 119, 93, 72, 1714, 41, 1713, 1712, 1710,
+	1709, 47, 1708, 1707, 1706, 1704, 109, 103, 64, 36,
+	1703, 40, 90, 106, 101, 1702, 27, 125, 12, 1701,
+	8, 0, 1700, 4, 134, 1523, 96, 1699, 1698, 1,
+	1697, 2, 1696, 1692, 82, 1691, 1690, 1689, 1687, 1686,
+	2686, 28, 112, 1685, 128,
 }
 
-//line sql.y:5200
+//line sql.y:5265
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -4417,6 +4453,11 @@ func (st *yySymType) joinTypeUnion() JoinType {
 	return v
 }
 
+func (st *yySymType) killTypeUnion() KillType {
+	v, _ := st.union.(KillType)
+	return v
+}
+
 func (st *yySymType) limitUnion() *Limit {
 	v, _ := st.union.(*Limit)
 	return v
@@ -4628,129 +4669,104 @@ func (st *yySymType) whensUnion() []*When {
 }
 
 var yyR1 = [...]int{
-	0, 276, 277, 277, 1, 1, 1, 1, 1, 1,
+	0, 278, 279, 279, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 260, 260, 260, 263, 263,
-	21, 50, 3, 3, 3, 3, 2, 2, 8, 9,
-	4, 5, 5, 10, 10, 62, 62, 11, 12, 12,
-	12, 12, 280, 280, 96, 96, 94, 94, 95, 95,
-	161, 161, 13, 14, 14, 171, 171, 170, 170, 170,
-	172, 172, 172, 172, 206, 206, 15, 15, 15, 15,
-	15, 71, 71, 262, 262, 261, 259, 259, 258, 258,
-	257, 23, 24, 33, 33, 33, 33, 34, 35, 264,
-	264, 236, 39, 39, 38, 38, 38, 38, 40, 40,
-	37, 37, 36, 36, 238, 238, 225, 225, 237, 237,
-	237, 237, 237, 237, 237, 224, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 208, 208, 208,
-	208, 211, 211, 209, 209, 209, 209, 209, 209, 209,
-	209, 209, 210, 210, 210, 210, 210, 212, 212, 212,
-	212, 212, 213, 213, 213, 213, 213, 213, 213, 213,
-	213, 213, 213, 213, 213, 213, 213, 214, 214, 214,
-	214, 214, 214, 214, 214, 223, 223, 215, 215, 218,
-	218, 219, 219, 219, 220, 220, 221, 221, 216, 216,
-	216, 216, 217, 217, 217, 226, 250, 250, 249, 249,
-	247, 247, 247, 247, 235, 235, 244, 244, 244, 244,
-	244, 234, 234, 230, 230, 230, 231, 231, 232, 232,
-	229, 229, 233, 233, 246, 246, 245, 227, 227, 228,
-	228, 252, 252, 252, 252, 253, 269, 270, 268, 268,
-	268, 268, 268, 60, 60, 60, 183, 183, 183, 242,
-	242, 241, 241, 241, 243, 243, 240, 240, 240, 240,
-	240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
-	240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
-	240, 240, 240, 240, 240, 178, 178, 178, 267, 267,
-	267, 267, 267, 267, 266, 266, 266, 239, 239, 239,
-	265, 265, 130, 130, 131, 131, 30, 30, 30, 30,
-	30, 30, 29, 29, 29, 25, 25, 25, 25, 25,
+	1, 1, 1, 1, 1, 1, 261, 261, 261, 264,
+	264, 21, 50, 3, 3, 3, 3, 2, 2, 8,
+	8, 9, 4, 5, 5, 10, 10, 63, 63, 11,
+	12, 12, 12, 12, 282, 282, 97, 97, 95, 95,
+	96, 96, 162, 162, 13, 14, 14, 172, 172, 171,
+	171, 171, 173, 173, 173, 173, 207, 207, 15, 15,
+	15, 15, 15, 72, 72, 263, 263, 262, 260, 260,
+	259, 259, 258, 23, 24, 33, 33, 33, 33, 34,
+	35, 265, 265, 237, 39, 39, 38, 38, 38, 38,
+	40, 40, 37, 37, 36, 36, 239, 239, 226, 226,
+	238, 238, 238, 238, 238, 238, 238, 225, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 209,
+	209, 209, 209, 212, 212, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 211, 211, 211, 211, 211, 213,
+	213, 213, 213, 213, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 215,
+	215, 215, 215, 215, 215, 215, 215, 224, 224, 216,
+	216, 219, 219, 220, 220, 220, 221, 221, 222, 222,
+	217, 217, 217, 217, 218, 218, 218, 227, 251, 251,
+	250, 250, 248, 248, 248, 248, 236, 236, 245, 245,
+	245, 245, 245, 235, 235, 231, 231, 231, 232, 232,
+	233, 233, 230, 230, 234, 234, 247, 247, 246, 228,
+	228, 229, 229, 253, 253, 253, 253, 254, 270, 271,
+	269, 269, 269, 269, 269, 61, 61, 61, 184, 184,
+	184, 243, 243, 242, 242, 242, 244, 244, 241, 241,
+	241, 241, 241, 241, 241, 241, 241, 241, 241, 241,
+	241, 241, 241, 241, 241, 241, 241, 241, 241, 241,
+	241, 241, 241, 241, 241, 241, 241, 179, 179, 179,
+	268, 268, 268, 268, 268, 268, 267, 267, 267, 240,
+	240, 240, 266, 266, 131, 131, 132, 132, 30, 30,
+	30, 30, 30, 30, 29, 29, 29, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 31, 31, 26,
-	26, 26, 26, 26, 26, 26, 26, 26, 16, 16,
+	25, 25, 25, 25, 25, 25, 25, 25, 25, 31,
+	31, 26, 26, 26, 26, 26, 26, 26, 26, 26,
 	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 256, 256, 256,
-	256, 256, 256, 256, 256, 256, 256, 256, 256, 256,
-	256, 256, 256, 256, 256, 256, 256, 256, 256, 222,
-	222, 222, 254, 254, 255, 255, 17, 22, 22, 18,
-	18, 18, 18, 19, 19, 41, 42, 42, 42, 42,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+	16, 257, 257, 257, 257, 257, 257, 257, 257, 257,
+	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
+	257, 257, 257, 223, 223, 223, 255, 255, 256, 256,
+	17, 22, 22, 18, 18, 18, 18, 19, 19, 41,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	271, 271, 177, 177, 185, 185, 176, 176, 199, 199,
-	199, 179, 179, 179, 180, 180, 275, 275, 275, 43,
-	43, 45, 45, 46, 47, 47, 201, 201, 202, 202,
-	48, 49, 61, 61, 61, 61, 61, 61, 63, 63,
-	63, 7, 7, 7, 7, 57, 57, 57, 6, 6,
-	44, 44, 51, 272, 272, 273, 274, 274, 274, 274,
-	52, 54, 20, 20, 20, 20, 20, 20, 78, 78,
-	66, 66, 66, 66, 66, 66, 66, 66, 66, 66,
-	66, 66, 72, 72, 72, 67, 67, 281, 55, 56,
-	56, 70, 70, 70, 64, 64, 64, 69, 69, 69,
-	75, 75, 77, 77, 77, 77, 77, 79, 79, 79,
-	79, 79, 79, 79, 74, 74, 76, 76, 76, 76,
-	192, 192, 192, 191, 191, 86, 86, 87, 87, 88,
-	88, 89, 89, 89, 128, 104, 104, 160, 160, 159,
-	159, 162, 162, 90, 90, 90, 90, 91, 91, 92,
-	92, 93, 93, 200, 200, 197, 197, 197, 196, 196,
-	97, 97, 97, 99, 98, 98, 98, 98, 100, 100,
-	102, 102, 101, 101, 103, 105, 105, 105, 105, 105,
-	106, 106, 85, 85, 85, 85, 85, 85, 85, 85,
-	174, 174, 108, 108, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 119, 119, 119, 119, 119, 119,
-	109, 109, 109, 109, 109, 109, 109, 73, 73, 120,
-	120, 120, 127, 121, 121, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 112, 112, 112, 112, 112, 116,
-	116, 116, 116, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 282, 282, 118, 117, 117, 117, 117,
-	117, 117, 117, 68, 68, 68, 68, 68, 205, 205,
-	205, 207, 207, 207, 207, 207, 207, 207, 207, 207,
-	207, 207, 207, 207, 134, 134, 65, 65, 132, 132,
-	133, 135, 135, 129, 129, 129, 111, 111, 111, 111,
-	111, 111, 111, 111, 113, 113, 113, 136, 136, 137,
-	137, 138, 138, 139, 139, 140, 141, 141, 141, 142,
-	142, 142, 142, 32, 32, 32, 32, 32, 27, 27,
-	27, 27, 28, 28, 28, 80, 80, 80, 80, 82,
-	82, 81, 81, 58, 58, 59, 59, 59, 83, 83,
-	84, 84, 84, 84, 157, 157, 157, 143, 143, 143,
-	143, 149, 149, 149, 145, 145, 147, 147, 147, 148,
-	148, 148, 146, 154, 154, 156, 156, 155, 155, 151,
-	151, 152, 152, 153, 153, 153, 150, 150, 110, 110,
-	110, 110, 110, 158, 158, 158, 158, 163, 163, 123,
-	123, 125, 125, 124, 126, 164, 164, 168, 165, 165,
-	169, 169, 169, 169, 169, 166, 166, 167, 167, 193,
-	193, 193, 173, 173, 184, 184, 181, 181, 182, 182,
-	175, 175, 186, 186, 186, 53, 122, 122, 251, 251,
-	248, 189, 189, 190, 190, 194, 194, 198, 198, 195,
-	195, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	42, 42, 42, 42, 42, 42, 272, 272, 178, 178,
+	186, 186, 177, 177, 200, 200, 200, 180, 180, 180,
+	181, 181, 277, 277, 277, 43, 43, 45, 45, 46,
+	47, 47, 202, 202, 203, 203, 48, 49, 62, 62,
+	62, 62, 62, 62, 64, 64, 64, 7, 7, 7,
+	7, 58, 58, 58, 6, 6, 44, 44, 51, 273,
+	273, 274, 275, 275, 275, 275, 52, 54, 55, 276,
+	276, 276, 20, 20, 20, 20, 20, 20, 79, 79,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 67, 73, 73, 73, 68, 68, 283, 56, 57,
+	57, 71, 71, 71, 65, 65, 65, 70, 70, 70,
+	76, 76, 78, 78, 78, 78, 78, 80, 80, 80,
+	80, 80, 80, 80, 75, 75, 77, 77, 77, 77,
+	193, 193, 193, 192, 192, 87, 87, 88, 88, 89,
+	89, 90, 90, 90, 129, 105, 105, 161, 161, 160,
+	160, 163, 163, 91, 91, 91, 91, 92, 92, 93,
+	93, 94, 94, 201, 201, 198, 198, 198, 197, 197,
+	98, 98, 98, 100, 99, 99, 99, 99, 101, 101,
+	103, 103, 102, 102, 104, 106, 106, 106, 106, 106,
+	107, 107, 86, 86, 86, 86, 86, 86, 86, 86,
+	175, 175, 109, 109, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 120, 120, 120, 120, 120, 120,
+	110, 110, 110, 110, 110, 110, 110, 74, 74, 121,
+	121, 121, 128, 122, 122, 113, 113, 113, 113, 113,
+	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
+	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
+	113, 113, 113, 113, 113, 113, 113, 113, 113, 117,
+	117, 117, 117, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 115, 115, 115, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 284, 284, 119, 118, 118, 118, 118,
+	118, 118, 118, 69, 69, 69, 69, 69, 206, 206,
+	206, 208, 208, 208, 208, 208, 208, 208, 208, 208,
+	208, 208, 208, 208, 135, 135, 66, 66, 133, 133,
+	134, 136, 136, 130, 130, 130, 112, 112, 112, 112,
+	112, 112, 112, 112, 114, 114, 114, 137, 137, 138,
+	138, 139, 139, 140, 140, 141, 142, 142, 142, 143,
+	143, 143, 143, 32, 32, 32, 32, 32, 27, 27,
+	27, 27, 28, 28, 28, 81, 81, 81, 81, 83,
+	83, 82, 82, 59, 59, 60, 60, 60, 84, 84,
+	85, 85, 85, 85, 158, 158, 158, 144, 144, 144,
+	144, 150, 150, 150, 146, 146, 148, 148, 148, 149,
+	149, 149, 147, 155, 155, 157, 157, 156, 156, 152,
+	152, 153, 153, 154, 154, 154, 151, 151, 111, 111,
+	111, 111, 111, 159, 159, 159, 159, 164, 164, 124,
+	124, 126, 126, 125, 127, 165, 165, 169, 166, 166,
+	170, 170, 170, 170, 170, 167, 167, 168, 168, 194,
+	194, 194, 174, 174, 185, 185, 182, 182, 183, 183,
+	176, 176, 187, 187, 187, 53, 123, 123, 252, 252,
+	249, 190, 190, 191, 191, 195, 195, 199, 199, 196,
+	196, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
@@ -4763,65 +4779,93 @@ var yyR1 = [...]int{
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 278, 279, 203, 204, 204, 204,
+	188, 188, 188, 188, 188, 188, 188, 188, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 280, 281,
+	204, 205, 205, 205,
 }
 
 var yyR2 = [...]int{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
-	2, 3, 5, 6, 6, 7, 4, 6, 5, 7,
-	8, 1, 3, 7, 8, 1, 1, 9, 9, 8,
-	7, 7, 1, 1, 1, 3, 1, 3, 1, 3,
-	0, 4, 3, 5, 4, 1, 3, 3, 2, 2,
-	2, 2, 2, 1, 1, 1, 2, 2, 6, 11,
-	2, 0, 2, 0, 2, 1, 0, 2, 1, 3,
-	3, 5, 3, 6, 7, 7, 7, 5, 2, 1,
-	1, 4, 0, 1, 1, 1, 2, 2, 0, 1,
-	4, 4, 4, 4, 2, 4, 1, 3, 1, 1,
-	3, 4, 3, 3, 3, 3, 0, 2, 3, 3,
-	4, 2, 3, 3, 2, 3, 2, 3, 1, 1,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 2, 2, 1, 2, 2,
-	2, 2, 4, 4, 2, 2, 3, 3, 3, 3,
-	1, 1, 1, 1, 1, 6, 6, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
-	5, 0, 3, 5, 0, 1, 0, 1, 0, 2,
-	2, 2, 0, 2, 2, 5, 0, 1, 1, 2,
-	1, 3, 2, 3, 0, 1, 3, 3, 3, 4,
-	2, 0, 2, 1, 1, 1, 1, 1, 0, 1,
-	1, 1, 0, 1, 1, 3, 3, 3, 1, 3,
-	1, 10, 11, 11, 12, 5, 3, 3, 1, 1,
-	2, 2, 2, 0, 1, 1, 0, 1, 2, 0,
-	1, 1, 3, 2, 1, 2, 3, 3, 4, 4,
-	3, 3, 3, 3, 4, 4, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 0,
+	1, 2, 3, 5, 6, 6, 7, 4, 6, 5,
+	7, 7, 8, 1, 3, 7, 8, 1, 1, 9,
+	9, 8, 7, 7, 1, 1, 1, 3, 1, 3,
+	1, 3, 0, 4, 3, 5, 4, 1, 3, 3,
+	2, 2, 2, 2, 2, 1, 1, 1, 2, 2,
+	6, 11, 2, 0, 2, 0, 2, 1, 0, 2,
+	1, 3, 3, 5, 3, 6, 7, 7, 7, 5,
+	2, 1, 1, 4, 0, 1, 1, 1, 2, 2,
+	0, 1, 4, 4, 4, 4, 2, 4, 1, 3,
+	1, 1, 3, 4, 3, 3, 3, 3, 0, 2,
+	3, 3, 4, 2, 3, 3, 2, 3, 2, 3,
+	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
+	2, 2, 2, 2, 4, 4, 2, 2, 3, 3,
+	3, 3, 1, 1, 1, 1, 1, 6, 6, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
+	3, 0, 5, 0, 3, 5, 0, 1, 0, 1,
+	0, 2, 2, 2, 0, 2, 2, 5, 0, 1,
+	1, 2, 1, 3, 2, 3, 0, 1, 3, 3,
+	3, 4, 2, 0, 2, 1, 1, 1, 1, 1,
+	0, 1, 1, 1, 0, 1, 1, 3, 3, 3,
+	1, 3, 1, 10, 11, 11, 12, 5, 3, 3,
+	1, 1, 2, 2, 2, 0, 1, 1, 0, 1,
+	2, 0, 1, 1, 3, 2, 1, 2, 3, 3,
+	4, 4, 3, 3, 3, 3, 4, 4, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 4, 5, 0, 2, 2, 1, 1,
+	3, 3, 3, 3, 3, 4, 5, 0, 2, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 1, 0, 2, 0, 2, 0, 1, 5, 1,
-	3, 7, 1, 3, 3, 1, 2, 2, 2, 5,
-	5, 5, 6, 6, 5, 5, 2, 2, 2, 2,
-	3, 3, 3, 4, 1, 3, 5, 1, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 2, 4,
-	4, 2, 10, 3, 6, 7, 5, 5, 5, 12,
-	7, 5, 9, 4, 4, 4, 4, 5, 3, 7,
-	4, 4, 4, 4, 3, 3, 3, 7, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 2, 0,
-	2, 2, 1, 3, 8, 8, 3, 3, 5, 6,
-	6, 5, 5, 3, 2, 3, 3, 3, 7, 3,
-	3, 3, 3, 4, 7, 5, 2, 4, 4, 4,
-	4, 4, 5, 5, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 4, 4, 2, 4, 2, 4, 5,
-	4, 4, 3, 3, 5, 2, 3, 3, 3, 3,
-	1, 1, 0, 1, 0, 1, 1, 1, 0, 2,
-	2, 0, 2, 2, 0, 2, 0, 1, 1, 2,
-	1, 1, 2, 1, 1, 5, 0, 1, 0, 1,
-	2, 3, 0, 3, 3, 3, 3, 1, 1, 1,
-	1, 1, 1, 1, 1, 0, 1, 1, 3, 3,
-	2, 2, 3, 1, 3, 2, 1, 2, 1, 2,
-	2, 3, 3, 3, 6, 4, 7, 6, 1, 3,
+	1, 1, 0, 1, 0, 2, 0, 2, 0, 1,
+	5, 1, 3, 7, 1, 3, 3, 1, 2, 2,
+	2, 5, 5, 5, 6, 6, 5, 5, 2, 2,
+	2, 2, 3, 3, 3, 4, 1, 3, 5, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 4, 4, 2, 10, 3, 6, 7, 5, 5,
+	5, 12, 7, 5, 9, 4, 4, 4, 4, 5,
+	5, 5, 3, 7, 4, 4, 4, 4, 3, 3,
+	3, 7, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 2, 0, 2, 2, 1, 3, 8, 8,
+	3, 3, 5, 6, 6, 5, 5, 3, 2, 3,
+	3, 3, 7, 3, 3, 3, 3, 4, 7, 5,
+	2, 4, 4, 4, 4, 4, 5, 5, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 2,
+	4, 2, 4, 5, 4, 4, 3, 5, 3, 3,
+	5, 2, 3, 3, 3, 3, 1, 1, 0, 1,
+	0, 1, 1, 1, 0, 2, 2, 0, 2, 2,
+	0, 2, 0, 1, 1, 2, 1, 1, 2, 1,
+	1, 5, 0, 1, 0, 1, 2, 3, 0, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 1, 1, 3, 3, 2, 2, 3, 1,
+	3, 2, 1, 2, 1, 2, 2, 3, 3, 0,
+	1, 1, 3, 3, 6, 4, 7, 6, 1, 3,
 	2, 2, 2, 2, 1, 1, 1, 3, 2, 1,
 	1, 1, 0, 1, 1, 0, 3, 0, 2, 0,
 	2, 1, 2, 2, 0, 1, 1, 0, 1, 1,
@@ -4904,465 +4948,470 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -276, -1, -3, -8, -9, -10, -11, -12, -13,
+	-1000, -278, -1, -3, -8, -9, -10, -11, -12, -13,
 	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
 	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -54, -4, -278, 6, 7, 8, -62, 10,
-	11, 31, -23, -33, 153, -34, -24, 154, -35, 156,
-	155, 192, 157, 185, 71, 231, 232, 234, 235, 236,
-	237, -63, 190, 191, 159, 35, 42, 32, 33, 36,
-	162, 81, 9, 334, 187, 186, 26, -277, 474, -70,
-	5, -138, 16, -3, -55, -281, -55, -55, -55, -55,
-	-55, -55, -236, -238, 81, 126, 81, -71, -184, 165,
-	174, 173, 170, -264, 107, 220, 325, 163, -39, -38,
-	-37, -36, -40, 30, -30, -31, -256, -29, -26, 158,
-	155, 200, 102, 103, 192, 193, 194, 157, 176, 191,
-	195, 190, 209, -25, 77, 32, 347, 350, -243, 154,
-	160, 161, 335, 105, 104, 72, 156, -240, 281, 451,
-	-40, 453, 95, 97, 452, 41, 165, 454, 455, 456,
-	457, 175, 458, 459, 460, 461, 467, 468, 469, 470,
-	106, 5, 164, -264, -80, 291, 227, 77, -198, -194,
-	-260, -188, 84, 85, 86, 344, 178, 378, 379, 225,
-	77, 281, 451, 231, 245, 239, 266, 258, 345, 380,
-	228, 179, 213, 448, 256, 312, 453, 381, 193, 304,
-	286, 294, 95, 234, 321, 466, 230, 382, 464, 97,
-	452, 76, 48, 41, 188, 254, 250, 454, 214, 383,
-	355, 207, 105, 102, 473, 248, 47, 28, 463, 104,
-	46, 455, 384, 456, 296, 271, 442, 45, 309, 194,
-	385, 80, 349, 450, 298, 249, 295, 224, 462, 159,
-	386, 434, 306, 443, 387, 272, 276, 388, 313, 49,
-	389, 390, 444, 103, 391, 75, 457, 243, 244, 392,
-	222, 177, 315, 270, 175, 34, 299, 346, 226, 55,
-	201, 316, 43, 274, 42, 438, 393, 441, 269, 265,
-	50, 394, 395, 396, 397, 458, 268, 242, 264, 472,
-	219, 459, 59, 161, 278, 277, 279, 208, 311, 261,
-	398, 399, 400, 182, 78, 401, 251, 19, 402, 403,
-	307, 215, 404, 53, 405, 406, 319, 191, 407, 51,
-	460, 38, 196, 461, 408, 409, 410, 411, 412, 305,
-	413, 297, 273, 275, 203, 293, 348, 414, 247, 195,
-	465, 415, 183, 449, 197, 200, 190, 320, 184, 416,
-	417, 418, 419, 420, 229, 421, 422, 235, 467, 40,
-	423, 424, 425, 426, 223, 218, 314, 323, 58, 79,
-	283, 427, 447, 241, 216, 428, 232, 52, 468, 469,
-	470, 210, 471, 289, 106, 220, 221, 44, 262, 202,
-	429, 430, 252, 253, 267, 240, 263, 233, 435, 204,
-	308, 192, 431, 322, 217, 284, 352, 209, 310, 446,
-	351, 260, 257, 211, 432, 166, 205, 206, 433, 436,
-	300, 290, 301, 302, 227, 303, 291, 212, 350, 255,
-	285, 164, -184, 165, 166, -264, 164, -101, -194, 164,
-	-166, 286, -185, 287, 288, 299, 300, 306, -177, 307,
-	305, 203, -275, 313, 164, 308, 153, 144, 296, 297,
-	290, 303, 291, 212, -271, -260, 456, 471, 312, 259,
-	292, 298, 314, 438, 302, 301, -194, 233, -201, 238,
-	-189, -260, -188, 236, -101, -61, 434, 157, -203, -203,
-	-72, 438, 440, -121, -85, -107, 110, -112, 30, 24,
-	-111, -108, -129, -126, -127, 144, 145, 147, 146, 148,
-	133, 134, 141, 111, 149, -116, -114, -115, -117, 88,
-	87, 96, 89, 90, 91, 92, 98, 99, 100, -189,
-	-194, -124, -278, 65, 66, 335, 336, 337, 338, 343,
-	339, 113, 54, 330, 324, 333, 332, 331, 328, 329,
-	326, 327, 341, 342, 169, 325, 163, 139, 334, -260,
-	-188, 41, 289, 289, -101, 227, -5, -4, -278, 6,
-	21, 22, -142, 18, 17, -279, 83, -64, -77, 60,
-	61, -79, 22, 37, 64, 62, 21, -56, -76, 135,
-	-85, -194, -76, -175, 168, -175, -175, -165, -206, 233,
-	-169, 314, 313, -190, -167, -189, -187, -166, 311, 158,
-	353, 109, 23, 25, 112, 144, 17, 113, 36, 160,
-	259, 176, 143, 172, 335, 153, 69, 354, 326, 327,
-	324, 330, 337, 338, 325, 287, 30, 11, 356, 26,
-	186, 22, 37, 137, 155, 116, 117, 189, 24, 187,
-	100, 359, 20, 72, 181, 12, 174, 14, 360, 361,
-	15, 169, 168, 128, 165, 67, 9, 149, 27, 125,
-	63, 362, 29, 363, 364, 365, 366, 65, 126, 18,
-	328, 329, 32, 439, 367, 343, 198, 139, 70, 56,
-	440, 110, 368, 369, 98, 370, 101, 73, 445, 107,
-	16, 68, 39, 371, 199, 372, 171, 373, 317, 374,
-	127, 156, 334, 66, 375, 163, 288, 6, 340, 31,
-	185, 173, 64, 376, 164, 115, 341, 342, 167, 99,
-	5, 170, 33, 10, 71, 74, 331, 332, 333, 54,
-	347, 114, 13, 377, 318, 108, 312, -237, 126, -224,
-	-228, -189, 180, -253, 176, -101, -246, -245, -189, -80,
-	164, -260, 165, 165, 165, -55, 334, -36, -37, -166,
-	143, 197, 82, 82, -228, -227, -226, -265, 199, 180,
-	-252, -244, 172, 181, -234, 173, 174, -229, 165, 29,
-	-265, -229, 171, 181, 199, 199, 106, 199, 106, 199,
-	199, 199, 199, 199, 199, 199, 199, 199, 196, -235,
-	118, -235, 351, 351, -240, -265, -265, -265, 167, 34,
-	34, -186, -229, 167, 23, -235, -235, -166, 143, -235,
-	-235, -235, -235, 207, 207, -235, -235, -235, -235, -235,
-	-235, -235, -235, -235, -235, -235, -235, -235, -235, -235,
-	-101, -83, 214, 153, 155, 158, 73, 88, 228, 118,
-	-38, 209, -22, -101, 164, -260, -181, 169, -55, -101,
-	150, -101, -179, 126, 13, -179, -176, 289, 293, 294,
-	295, -179, -179, -179, -179, 210, 304, -230, 165, 34,
-	177, 289, 210, 304, 210, 211, 210, 211, 210, -199,
-	12, 128, 325, 309, 306, 203, 164, 204, 166, 310,
-	-260, 441, 211, -199, 289, 206, -179, -204, -278, -190,
-	259, -204, -204, 31, 167, -189, -57, -189, 88, -7,
-	-3, -11, -10, -12, 118, -78, 289, -66, 144, 456,
-	442, 443, 444, 441, 305, 449, 447, 445, 210, 446,
-	82, 109, 107, 108, 125, -85, -109, 128, 110, 126,
-	127, 112, 130, 129, 140, 133, 134, 135, 136, 137,
-	138, 139, 131, 132, 143, 118, 119, 120, 121, 122,
-	123, 124, -174, -278, -127, -278, 151, 152, -112, -112,
-	-112, -112, -112, -112, -112, -112, -112, -112, -278, 150,
-	-2, -121, -4, -278, -278, -278, -278, -278, -278, -278,
-	-278, -134, -85, -278, -282, -278, -282, -118, -278, -282,
-	-118, -282, -118, -282, -282, -118, -282, -118, -282, -282,
-	-118, -278, -278, -278, -278, -278, -278, -278, -203, -272,
-	-273, -104, -101, -278, 88, -138, -3, -55, -157, 20,
-	32, -85, -139, -140, -85, -138, 56, -74, -76, -79,
-	60, 61, 94, 12, -192, -191, 23, -189, 88, 150,
-	12, -102, 27, -101, -87, -88, -89, -90, -104, -128,
-	-278, 12, -94, -95, -101, -103, -194, 82, 233, -169,
-	-206, -171, -170, 315, 317, 118, -193, -189, 88, 30,
-	83, 82, -101, -208, -211, -213, -212, -214, -209, -210,
-	256, 257, 144, 260, 262, 263, 264, 265, 266, 267,
-	268, 269, 270, 271, 31, 188, 252, 253, 254, 255,
-	272, 273, 274, 275, 276, 277, 278, 279, 239, 258,
-	345, 240, 241, 242, 243, 244, 245, 247, 248, 249,
-	250, 251, -263, -260, 81, 83, 82, -215, 81, -83,
-	-182, 169, -251, -248, 74, -260, -260, -260, -182, -235,
-	-235, 196, -29, -26, -256, 16, -25, -26, 158, 102,
-	103, 155, 81, -224, 81, -233, -263, -260, 81, 29,
-	171, 170, -232, -229, -232, -233, -260, -129, -189, -194,
-	-260, 29, 29, -162, -189, -162, -162, 21, -162, 21,
-	-162, 21, 89, -189, -162, 21, -162, 21, -162, 21,
-	-162, 21, -162, 21, 30, 75, 76, 30, 78, 79,
-	80, -129, -129, -224, -166, -101, -260, 89, 89, -235,
-	-235, 89, 88, 88, 88, -235, -235, 89, 88, -260,
-	88, -266, 182, 224, 226, 89, 89, 89, 89, 30,
-	88, -267, 30, 463, 462, 464, 465, 466, 89, 30,
-	89, 30, 89, -189, 81, -82, 216, 118, 205, 205,
-	164, 164, 218, -101, 229, 230, 228, 21, 217, 219,
-	221, 41, 82, 167, -181, 73, -96, -101, 24, -181,
-	-195, -194, -187, 88, -85, -231, 12, 128, -199, -199,
-	-179, -101, -231, -199, -179, -101, -179, -179, -179, -179,
-	-199, -179, -194, -194, -101, -101, -101, -101, -101, -101,
-	-101, -204, -204, -204, -180, 126, -179, 73, -202, 236,
-	270, 435, 436, 437, 82, 347, -94, 441, 441, 441,
-	441, 441, 441, -85, -85, -85, -85, -119, 98, 110,
-	99, 100, -112, -120, -124, -127, 93, 128, 126, 127,
-	112, -112, -112, -112, -112, -112, -112, -112, -112, -112,
-	-112, -112, -112, -112, -112, -112, -205, -260, 88, 144,
-	-260, -111, -111, -189, -75, 22, 37, -74, -190, -195,
-	-187, -70, -279, -279, -138, -74, -74, -85, -85, -129,
-	88, -74, -129, 88, -74, -74, -69, 22, 37, -132,
-	-133, 114, -129, -279, -112, -189, -189, -74, -75, -75,
-	-74, -74, 82, -274, 317, 318, 439, -197, 199, -196,
-	23, -194, 88, -122, -121, -142, -279, -143, 27, 10,
-	128, 82, 19, 82, -141, 25, 26, -142, -113, -189,
-	89, 92, -86, 82, 12, -79, -101, -191, 135, -195,
-	-101, -161, 199, -101, 31, 82, -97, -99, -98, -100,
-	63, 67, 69, 64, 65, 66, 70, -200, 23, -87,
-	-3, -278, -101, -94, -280, 82, 12, 74, -280, 82,
-	150, -169, -171, 82, 316, 318, 319, 73, 101, -85,
-	-217, 143, -242, -241, -240, -224, -226, -227, -228, 83,
-	-144, -220, 284, -215, -215, -215, -215, -215, -216, -166,
-	-216, -216, -216, 81, 81, -215, -215, -215, -215, -218,
-	81, -218, -218, -219, 81, -219, -253, -85, -250, -249,
-	-247, -248, 175, 95, 347, -245, -141, 89, -82, -101,
-	110, 73, -189, -251, -251, -251, -194, -260, 88, -260,
-	88, 82, 17, -225, -224, -130, 224, -255, 199, -252,
-	-246, 81, 29, -232, -233, -233, 150, -260, 82, 27,
-	106, 106, 106, 106, 347, 155, 31, -224, -130, -205,
-	167, -205, -205, 88, 88, -178, 471, -94, 166, 223,
-	-84, 330, 88, 84, -101, -101, -101, -101, -101, 158,
-	155, 207, -101, -101, -94, -101, 82, -60, 184, 179,
-	-194, -101, -179, -179, -101, -179, -179, 88, -101, -189,
-	-66, 317, 347, 20, -67, 20, 98, 99, 100, -120,
-	-112, -112, -112, -73, 189, 109, -279, -279, -74, -74,
-	-278, 150, -5, -142, -279, -279, 82, 74, 23, 12,
-	12, -279, 12, 12, -279, -279, -74, -135, -133, 116,
-	-85, -279, -279, 82, 82, -279, -279, -279, -279, -279,
-	-273, 438, 318, -105, 71, 168, 72, -278, -196, -279,
-	-157, 39, 47, 58, -85, -85, -140, -157, -173, 20,
-	12, 54, 54, -106, 13, -76, -87, -79, 150, -106,
-	-110, 31, 54, -3, -278, -278, -164, -168, -129, -88,
-	-89, -89, -88, -89, 63, 63, 63, 68, 63, 68,
-	63, -98, -194, -279, -279, -3, -161, 74, -87, -101,
-	-87, -103, -194, 135, -170, -172, 320, 317, 323, -260,
-	88, 82, -240, -228, 98, 110, 30, 73, 281, 95,
-	171, 29, 170, -221, 285, -216, -216, -217, -260, 88,
-	144, -217, -217, -217, -223, 88, -223, 89, 89, 83,
-	-32, -27, -28, 32, 77, -247, -235, 88, 38, 83,
-	166, 24, -101, 73, 73, 73, 16, -159, -189, 82,
-	83, -131, 225, -129, 83, -189, 83, -159, -233, -190,
-	-189, -278, 164, 30, 30, -130, -131, -217, -260, 473,
-	472, 83, -101, -81, 214, 222, 81, 85, -262, 74,
-	205, 281, 205, 208, 167, -60, -32, -101, -199, -199,
-	32, 317, 450, 448, -73, 109, -112, -112, -279, -279,
-	-75, -190, -138, -157, -207, 144, 256, 188, 254, 250,
-	270, 261, 283, 252, 284, -205, -207, -112, -112, -112,
-	-112, 344, -138, 117, -85, 115, -112, -112, 165, 165,
-	165, -162, 40, 88, 88, 59, -101, -136, 14, -85,
-	135, -142, -163, 73, -164, -123, -125, -124, -278, -158,
-	-279, -189, -162, -106, 82, 118, -92, -91, 73, 74,
-	-93, 73, -91, 63, 63, -279, -106, -87, -106, -106,
-	150, 317, 321, 322, -240, 98, -112, 10, 88, 29,
-	29, -217, -217, 83, 82, 83, 82, 83, 82, -183,
-	384, 110, -28, -27, -235, -235, 89, -260, -101, -101,
-	-101, -101, 17, 82, -224, -129, 54, -250, 83, -254,
-	-255, -101, -111, -131, -160, 81, 83, -259, 347, -261,
-	-260, -189, -189, -189, -101, -179, -179, 32, -260, -112,
-	-279, -142, -279, -215, -215, -215, -219, -215, 244, -215,
-	244, -279, -279, 20, 20, 20, 20, -278, -65, 340,
-	-85, 82, 82, -278, -278, -278, -279, 88, -216, -137,
-	15, 17, 28, -163, 82, -279, -279, 82, 54, 150,
-	-279, -138, -168, -85, -85, 81, -85, -138, -106, -115,
-	-216, 88, -216, 89, 89, 384, 30, 78, 79, 80,
-	30, 75, 76, -160, -159, -189, 201, 183, -279, 82,
-	-222, 347, 350, 23, -159, -258, -257, -190, 81, 74,
-	-157, -216, -260, -112, -112, -112, -112, -112, -142, 88,
-	-112, -112, -159, -279, -159, -159, -197, -216, -146, -151,
-	-176, -85, -121, 29, -125, 54, -3, -189, -123, -189,
-	-142, -159, -142, -217, -217, 83, 83, 23, 202, -101,
-	-255, 351, 351, -3, 83, 82, 118, -159, -101, -279,
-	-279, -279, -279, -68, 128, 347, -279, -279, -279, -279,
-	-279, -279, -105, -149, 434, -154, 43, -152, -153, 44,
-	-150, 45, 53, 10, -123, 150, 83, -3, -278, 81,
-	-58, 347, -257, -239, -190, 88, 89, 83, -279, 345,
-	70, 348, -146, 48, 262, -156, -155, 52, 44, -153,
-	17, 46, 17, -164, -189, -58, -112, 198, -159, -59,
-	213, 438, -262, 59, 346, 349, -147, 50, -145, 49,
-	-145, -155, 17, 17, 88, 17, 88, -279, -279, 83,
-	176, -259, 59, -148, 51, 73, 101, 88, 88, 88,
-	-269, -270, 73, 215, 347, 73, 101, -270, 73, 11,
-	10, 348, -268, 184, 179, 182, 31, -268, 349, 178,
-	30, 98,
+	-52, -53, -54, -55, -4, -280, 6, 7, 8, -63,
+	10, 11, 31, -23, -33, 153, -34, -24, 154, -35,
+	156, 155, 193, 157, 186, 71, 235, 236, 238, 239,
+	240, 241, -64, 191, 192, 159, 35, 42, 32, 33,
+	36, 162, 163, 81, 9, 339, 188, 187, 26, -279,
+	479, -71, 5, -139, 16, -3, -56, -283, -56, -56,
+	-56, -56, -56, -56, -237, -239, 81, 126, 81, -72,
+	-185, 166, 175, 174, 171, -265, 107, 221, 330, 164,
+	-39, -38, -37, -36, -40, 30, -30, -31, -257, -29,
+	-26, 158, 155, 201, 102, 103, 193, 194, 195, 157,
+	177, 192, 196, 191, 210, -25, 77, 32, 352, 355,
+	-244, 154, 160, 161, 340, 105, 104, 72, 156, -241,
+	285, 456, -40, 458, 95, 97, 457, 41, 166, 459,
+	460, 461, 462, 176, 463, 464, 465, 466, 472, 473,
+	474, 475, 106, 5, 165, -265, -81, 295, 228, 232,
+	77, -199, -195, -261, -189, 84, 85, 86, 349, 179,
+	383, 384, 226, 77, 285, 456, 235, 249, 243, 270,
+	262, 350, 385, 229, 180, 214, 453, 260, 317, 458,
+	386, 194, 309, 290, 298, 95, 238, 326, 471, 231,
+	387, 469, 97, 457, 76, 48, 41, 189, 258, 254,
+	459, 215, 388, 360, 208, 105, 102, 478, 252, 47,
+	28, 468, 104, 46, 460, 389, 461, 300, 275, 447,
+	45, 314, 195, 390, 80, 354, 455, 302, 253, 299,
+	225, 467, 159, 391, 439, 311, 448, 392, 276, 280,
+	393, 318, 49, 394, 395, 449, 103, 396, 75, 462,
+	247, 248, 397, 223, 178, 320, 274, 176, 34, 303,
+	351, 227, 55, 202, 321, 43, 278, 42, 443, 398,
+	446, 273, 269, 50, 399, 400, 401, 402, 463, 272,
+	246, 268, 477, 220, 464, 59, 161, 282, 281, 283,
+	209, 316, 265, 403, 404, 405, 183, 78, 406, 255,
+	19, 407, 408, 312, 216, 409, 53, 410, 411, 324,
+	192, 412, 51, 465, 38, 197, 466, 413, 414, 415,
+	416, 417, 310, 418, 301, 277, 279, 204, 297, 234,
+	353, 419, 251, 196, 470, 420, 184, 454, 198, 201,
+	191, 325, 233, 185, 421, 422, 423, 424, 425, 230,
+	426, 427, 239, 472, 40, 428, 429, 430, 431, 224,
+	219, 319, 328, 58, 79, 287, 432, 452, 245, 217,
+	433, 236, 52, 473, 474, 475, 211, 476, 293, 106,
+	221, 222, 44, 266, 203, 434, 435, 256, 257, 271,
+	244, 267, 237, 440, 205, 313, 193, 436, 327, 218,
+	288, 357, 210, 315, 451, 356, 264, 261, 212, 437,
+	167, 206, 207, 438, 441, 304, 294, 305, 306, 228,
+	307, 308, 232, 295, 213, 355, 259, 289, 165, -185,
+	166, 167, -265, 165, -102, -195, 165, -167, 290, -186,
+	291, 292, 303, 304, 311, -178, 312, 310, 204, -277,
+	318, 165, 313, 153, 144, 300, 301, 294, 307, 308,
+	232, 295, 213, -272, -261, 461, 476, 317, 263, 296,
+	302, 319, 443, 306, 305, -195, 237, -202, 242, -190,
+	-261, -189, 240, -102, -62, 439, 157, -204, -204, -73,
+	443, 445, -122, -86, -108, 110, -113, 30, 24, -112,
+	-109, -130, -127, -128, 144, 145, 147, 146, 148, 133,
+	134, 141, 111, 149, -117, -115, -116, -118, 88, 87,
+	96, 89, 90, 91, 92, 98, 99, 100, -190, -195,
+	-125, -280, 65, 66, 340, 341, 342, 343, 348, 344,
+	113, 54, 335, 329, 338, 337, 336, 333, 334, 331,
+	332, 346, 347, 170, 330, 164, 139, 339, -261, -189,
+	41, 293, 293, -102, 228, -276, 457, 353, -5, -4,
+	-280, 6, 21, 22, -143, 18, 17, -281, 83, -65,
+	-78, 60, 61, -80, 22, 37, 64, 62, 21, -57,
+	-77, 135, -86, -195, -77, -176, 169, -176, -176, -166,
+	-207, 237, -170, 319, 318, -191, -168, -190, -188, -167,
+	316, 158, 358, 109, 23, 25, 112, 144, 17, 113,
+	36, 160, 263, 177, 143, 173, 340, 153, 69, 359,
+	331, 332, 329, 335, 342, 343, 330, 291, 30, 11,
+	361, 26, 187, 22, 37, 137, 155, 116, 117, 190,
+	24, 188, 100, 364, 20, 72, 182, 12, 175, 14,
+	365, 366, 15, 170, 169, 128, 166, 67, 9, 149,
+	27, 125, 63, 367, 29, 368, 369, 370, 371, 65,
+	126, 18, 333, 334, 32, 444, 372, 348, 199, 139,
+	70, 56, 445, 110, 373, 374, 98, 375, 101, 73,
+	450, 107, 16, 68, 39, 376, 200, 377, 172, 378,
+	322, 379, 127, 156, 339, 66, 380, 164, 292, 6,
+	345, 31, 186, 174, 64, 381, 165, 115, 346, 347,
+	168, 99, 5, 171, 33, 10, 71, 74, 336, 337,
+	338, 54, 352, 114, 13, 382, 323, 108, 317, -238,
+	126, -225, -229, -190, 181, -254, 177, -102, -247, -246,
+	-190, -81, 165, -261, 166, 166, 166, -56, 339, -36,
+	-37, -167, 143, 198, 82, 82, -229, -228, -227, -266,
+	200, 181, -253, -245, 173, 182, -235, 174, 175, -230,
+	166, 29, -266, -230, 172, 182, 200, 200, 106, 200,
+	106, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	197, -236, 118, -236, 356, 356, -241, -266, -266, -266,
+	168, 34, 34, -187, -230, 168, 23, -236, -236, -167,
+	143, -236, -236, -236, -236, 208, 208, -236, -236, -236,
+	-236, -236, -236, -236, -236, -236, -236, -236, -236, -236,
+	-236, -236, -102, -84, 215, 153, 155, 158, 73, 88,
+	229, -102, 118, -38, 210, -22, -102, 165, -261, -182,
+	170, -56, -102, 150, -102, -180, 126, 13, -180, -177,
+	293, 297, 298, 299, -180, -180, -180, -180, 211, 309,
+	-231, 166, 34, 178, 293, 211, 309, 211, 212, 211,
+	212, 211, -200, 12, 128, 330, 314, 311, 204, 165,
+	205, 167, 315, -261, 446, 212, -200, -180, 12, 293,
+	207, -180, -205, -280, -191, 263, -205, -205, 31, 168,
+	-190, -58, -190, 88, -7, -3, -11, -10, -12, 118,
+	-79, 293, -67, 144, 461, 447, 448, 449, 446, 310,
+	454, 452, 450, 211, 451, 82, 109, 107, 108, 125,
+	-86, -110, 128, 110, 126, 127, 112, 130, 129, 140,
+	133, 134, 135, 136, 137, 138, 139, 131, 132, 143,
+	118, 119, 120, 121, 122, 123, 124, -175, -280, -128,
+	-280, 151, 152, -113, -113, -113, -113, -113, -113, -113,
+	-113, -113, -113, -280, 150, -2, -122, -4, -280, -280,
+	-280, -280, -280, -280, -280, -280, -135, -86, -280, -284,
+	-280, -284, -119, -280, -284, -119, -284, -119, -284, -284,
+	-119, -284, -119, -284, -284, -119, -280, -280, -280, -280,
+	-280, -280, -280, -204, -273, -274, -105, -102, -280, 88,
+	89, -139, -3, -56, -158, 20, 32, -86, -140, -141,
+	-86, -139, 56, -75, -77, -80, 60, 61, 94, 12,
+	-193, -192, 23, -190, 88, 150, 12, -103, 27, -102,
+	-88, -89, -90, -91, -105, -129, -280, 12, -95, -96,
+	-102, -104, -195, 82, 237, -170, -207, -172, -171, 320,
+	322, 118, -194, -190, 88, 30, 83, 82, -102, -209,
+	-212, -214, -213, -215, -210, -211, 260, 261, 144, 264,
+	266, 267, 268, 269, 270, 271, 272, 273, 274, 275,
+	31, 189, 256, 257, 258, 259, 276, 277, 278, 279,
+	280, 281, 282, 283, 243, 262, 350, 244, 245, 246,
+	247, 248, 249, 251, 252, 253, 254, 255, -264, -261,
+	81, 83, 82, -216, 81, -84, -183, 170, -252, -249,
+	74, -261, -261, -261, -183, -236, -236, 197, -29, -26,
+	-257, 16, -25, -26, 158, 102, 103, 155, 81, -225,
+	81, -234, -264, -261, 81, 29, 172, 171, -233, -230,
+	-233, -234, -261, -130, -190, -195, -261, 29, 29, -163,
+	-190, -163, -163, 21, -163, 21, -163, 21, 89, -190,
+	-163, 21, -163, 21, -163, 21, -163, 21, -163, 21,
+	30, 75, 76, 30, 78, 79, 80, -130, -130, -225,
+	-167, -102, -261, 89, 89, -236, -236, 89, 88, 88,
+	88, -236, -236, 89, 88, -261, 88, -267, 183, 225,
+	227, 89, 89, 89, 89, 30, 88, -268, 30, 468,
+	467, 469, 470, 471, 89, 30, 89, 30, 89, -190,
+	81, -83, 217, 118, 206, 206, 165, 165, 219, -102,
+	230, 231, 229, 21, 233, 234, 218, 220, 222, 41,
+	82, 168, -182, 73, -97, -102, 24, -182, -196, -195,
+	-188, 88, -86, -232, 12, 128, -200, -200, -180, -102,
+	-232, -200, -180, -102, -180, -180, -180, -180, -200, -180,
+	-195, -195, -102, -102, -102, -102, -102, -102, -102, -205,
+	-205, -205, -181, 126, -180, -102, 73, -203, 240, 274,
+	440, 441, 442, 82, 352, -95, 446, 446, 446, 446,
+	446, 446, -86, -86, -86, -86, -120, 98, 110, 99,
+	100, -113, -121, -125, -128, 93, 128, 126, 127, 112,
+	-113, -113, -113, -113, -113, -113, -113, -113, -113, -113,
+	-113, -113, -113, -113, -113, -206, -261, 88, 144, -261,
+	-112, -112, -190, -76, 22, 37, -75, -191, -196, -188,
+	-71, -281, -281, -139, -75, -75, -86, -86, -130, 88,
+	-75, -130, 88, -75, -75, -70, 22, 37, -133, -134,
+	114, -130, -281, -113, -190, -190, -75, -76, -76, -75,
+	-75, 82, -275, 322, 323, 444, -198, 200, -197, 23,
+	-195, 88, -123, -122, -143, -281, -144, 27, 10, 128,
+	82, 19, 82, -142, 25, 26, -143, -114, -190, 89,
+	92, -87, 82, 12, -80, -102, -192, 135, -196, -102,
+	-162, 200, -102, 31, 82, -98, -100, -99, -101, 63,
+	67, 69, 64, 65, 66, 70, -201, 23, -88, -3,
+	-280, -102, -95, -282, 82, 12, 74, -282, 82, 150,
+	-170, -172, 82, 321, 323, 324, 73, 101, -86, -218,
+	143, -243, -242, -241, -225, -227, -228, -229, 83, -145,
+	-221, 288, -216, -216, -216, -216, -216, -217, -167, -217,
+	-217, -217, 81, 81, -216, -216, -216, -216, -219, 81,
+	-219, -219, -220, 81, -220, -254, -86, -251, -250, -248,
+	-249, 176, 95, 352, -246, -142, 89, -83, -102, 110,
+	73, -190, -252, -252, -252, -195, -261, 88, -261, 88,
+	82, 17, -226, -225, -131, 225, -256, 200, -253, -247,
+	81, 29, -233, -234, -234, 150, -261, 82, 27, 106,
+	106, 106, 106, 352, 155, 31, -225, -131, -206, 168,
+	-206, -206, 88, 88, -179, 476, -95, 167, 224, -85,
+	335, 88, 84, -102, -102, -102, -102, -102, 158, 155,
+	-107, 13, -107, 208, -102, -102, -95, -102, 82, -61,
+	185, 180, -195, -102, -180, -180, -102, -180, -180, 88,
+	-180, -102, -190, -67, 322, 352, 20, -68, 20, 98,
+	99, 100, -121, -113, -113, -113, -74, 190, 109, -281,
+	-281, -75, -75, -280, 150, -5, -143, -281, -281, 82,
+	74, 23, 12, 12, -281, 12, 12, -281, -281, -75,
+	-136, -134, 116, -86, -281, -281, 82, 82, -281, -281,
+	-281, -281, -281, -274, 443, 323, -106, 71, 169, 72,
+	-280, -197, -281, -158, 39, 47, 58, -86, -86, -141,
+	-158, -174, 20, 12, 54, 54, -107, -77, -88, -80,
+	14, 150, -107, -111, 31, 54, -3, -280, -280, -165,
+	-169, -130, -89, -90, -90, -89, -90, 63, 63, 63,
+	68, 63, 68, 63, -99, -195, -281, -281, -3, -162,
+	74, -88, -102, -88, -104, -195, 135, -171, -173, 325,
+	322, 328, -261, 88, 82, -241, -229, 98, 110, 30,
+	73, 285, 95, 172, 29, 171, -222, 289, -217, -217,
+	-218, -261, 88, 144, -218, -218, -218, -224, 88, -224,
+	89, 89, 83, -32, -27, -28, 32, 77, -248, -236,
+	88, 38, 83, 167, 24, -102, 73, 73, 73, 16,
+	-160, -190, 82, 83, -132, 226, -130, 83, -190, 83,
+	-160, -234, -191, -190, -280, 165, 30, 30, -131, -132,
+	-218, -261, 478, 477, 83, -102, -82, 215, 223, 81,
+	85, -263, 74, 206, 285, 206, -86, 209, 168, -61,
+	-32, -102, -200, -200, 32, 322, 455, 453, -74, 109,
+	-113, -113, -281, -281, -76, -191, -139, -158, -208, 144,
+	260, 189, 258, 254, 274, 265, 287, 256, 288, -206,
+	-208, -113, -113, -113, -113, 349, -139, 117, -86, 115,
+	-113, -113, 166, 166, 166, -163, 40, 88, 88, 59,
+	-102, -137, 14, -190, 135, -143, -164, 73, -165, -124,
+	-126, -125, -280, -159, -281, -190, -163, -107, 82, 118,
+	-93, -92, 73, 74, -94, 73, -92, 63, 63, -281,
+	-107, -88, -107, -107, 150, 322, 326, 327, -241, 98,
+	-113, 10, 88, 29, 29, -218, -218, 83, 82, 83,
+	82, 83, 82, -184, 389, 110, -28, -27, -236, -236,
+	89, -261, -102, -102, -102, -102, 17, 82, -225, -130,
+	54, -251, 83, -255, -256, -102, -112, -132, -161, 81,
+	83, -260, 352, -262, -261, -190, -190, -190, -102, -180,
+	-180, 32, -261, -113, -281, -143, -281, -216, -216, -216,
+	-220, -216, 248, -216, 248, -281, -281, 20, 20, 20,
+	20, -280, -66, 345, -86, 82, 82, -280, -280, -280,
+	-281, 88, -217, -138, 15, 17, 28, -164, 82, -281,
+	-281, 82, 54, 150, -281, -139, -169, -86, -86, 81,
+	-86, -139, -107, -116, -217, 88, -217, 89, 89, 389,
+	30, 78, 79, 80, 30, 75, 76, -161, -160, -190,
+	202, 184, -281, 82, -223, 352, 355, 23, -160, -259,
+	-258, -191, 81, 74, -158, -217, -261, -113, -113, -113,
+	-113, -113, -143, 88, -113, -113, -160, -281, -160, -160,
+	-198, -217, -147, -152, -177, -86, -122, 29, -126, 54,
+	-3, -190, -124, -190, -143, -160, -143, -218, -218, 83,
+	83, 23, 203, -102, -256, 356, 356, -3, 83, 82,
+	118, -160, -102, -281, -281, -281, -281, -69, 128, 352,
+	-281, -281, -281, -281, -281, -281, -106, -150, 439, -155,
+	43, -153, -154, 44, -151, 45, 53, 10, -124, 150,
+	83, -3, -280, 81, -59, 352, -258, -240, -191, 88,
+	89, 83, -281, 350, 70, 353, -147, 48, 266, -157,
+	-156, 52, 44, -154, 17, 46, 17, -165, -190, -59,
+	-113, 199, -160, -60, 214, 443, -263, 59, 351, 354,
+	-148, 50, -146, 49, -146, -156, 17, 17, 88, 17,
+	88, -281, -281, 83, 177, -260, 59, -149, 51, 73,
+	101, 88, 88, 88, -270, -271, 73, 216, 352, 73,
+	101, -271, 73, 11, 10, 353, -269, 185, 180, 183,
+	31, -269, 354, 179, 30, 98,
 }
 
 var yyDef = [...]int{
-	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	35, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 821, 0, 557, 557, 557, 557, 557,
-	557, 557, 0, 0, -2, -2, -2, 845, 957, 0,
-	934, 0, 0, -2, 490, 491, 0, 493, -2, 0,
-	0, 502, 1366, 1366, 552, 0, 0, 0, 0, 0,
-	0, 1364, 55, 56, 508, 509, 510, 1, 3, 0,
-	561, 829, 0, 0, -2, 559, 0, 0, 940, 940,
-	940, 0, 86, 87, 0, 0, 0, 845, 0, 0,
-	0, 0, 0, 557, 0, 935, 109, 110, 90, -2,
-	114, 115, 0, 119, 368, 329, 371, 327, 357, -2,
-	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 224, 224, 0, 0, -2, 320,
-	320, 320, 0, 0, 0, 354, 942, 274, 224, 224,
-	0, 224, 224, 224, 224, 0, 0, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 0, 108, 858, 0, 0, 0, 118, 958,
-	955, 956, 35, 36, 37, 1098, 1099, 1100, 1101, 1102,
-	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
-	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122,
-	1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132,
-	1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142,
-	1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
-	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
-	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
-	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
-	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
-	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202,
-	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
-	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
-	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
-	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
-	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
-	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
-	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
-	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
-	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
-	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
-	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
-	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
-	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
-	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
-	1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
-	1363, 0, 0, 0, 936, 557, 0, 424, 642, 0,
-	481, 481, 0, 481, 481, 481, 481, 0, 0, 0,
-	436, 0, 0, 0, 0, 478, 0, 0, 455, 457,
-	0, 478, 0, 465, 481, 1367, 1367, 1367, 925, 0,
-	475, 473, 487, 488, 470, 471, 489, 492, 0, 497,
-	500, 951, 952, 0, 515, 0, 1174, 507, 520, 521,
-	0, 553, 554, 40, 693, 652, 0, 658, 660, 0,
-	695, 696, 697, 698, 699, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 725, 726, 727, 728, 806,
-	807, 808, 809, 810, 811, 812, 813, 662, 663, 803,
-	0, 914, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 794, 0, 763, 763, 763, 763, 763, 763, 763,
-	763, 763, 0, 0, 0, 0, 0, 0, 0, -2,
-	-2, 1366, 0, 530, 0, 0, 821, 51, 0, 557,
-	562, 563, 864, 0, 0, 821, 1365, 0, 0, -2,
-	-2, 573, 579, 580, 581, 582, 583, 558, 0, 586,
-	590, 0, 0, 0, 941, 0, 0, 72, 0, 1330,
-	918, -2, -2, 0, 0, 953, 954, 927, -2, 961,
-	962, 963, 964, 965, 966, 967, 968, 969, 970, 971,
-	972, 973, 974, 975, 976, 977, 978, 979, 980, 981,
-	982, 983, 984, 985, 986, 987, 988, 989, 990, 991,
-	992, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
-	1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011,
-	1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021,
-	1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031,
-	1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041,
-	1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051,
-	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061,
-	1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071,
-	1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081,
-	1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091,
-	1092, 1093, 1094, 1095, 1096, 1097, -2, 0, 0, 128,
-	129, 0, 38, 250, 0, 124, 0, 244, 197, 858,
-	938, 948, 0, 0, 0, 938, 92, 116, 117, 224,
-	224, 0, 118, 118, 336, 337, 338, 0, 0, -2,
-	248, 0, 321, 0, 0, 238, 238, 242, 240, 241,
-	0, 0, 0, 0, 0, 0, 348, 0, 349, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 408, 0,
-	225, 0, 366, 367, 275, 0, 0, 0, 0, 346,
-	347, 0, 0, 943, 944, 0, 0, 224, 224, 0,
-	0, 0, 0, 224, 224, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	102, 849, 0, 0, 0, 0, 0, 0, 0, 0,
-	-2, 0, 416, 0, 936, 0, 0, 0, 936, 423,
-	0, 425, 426, 0, 0, 427, 0, 478, 478, 476,
-	477, 429, 430, 431, 432, 481, 0, 0, 233, 234,
-	235, 478, 481, 0, 481, 481, 481, 481, 478, 481,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1367,
-	1367, 1367, 484, 481, 462, 463, 466, 467, 1368, 1369,
-	972, 468, 469, 926, 498, 501, 518, 516, 517, 519,
-	511, 512, 513, 514, 0, 532, 533, 538, 0, 0,
-	0, 0, 544, 545, 546, 0, 0, 549, 550, 551,
-	0, 0, 0, 0, 0, 656, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 680, 681, 682, 683, 684,
-	685, 686, 659, 0, 673, 0, 0, 0, 715, 716,
-	717, 718, 719, 720, 721, 722, 723, 0, 570, 0,
-	0, 0, 821, 0, 0, 0, 0, 0, 0, 0,
-	567, 0, 795, 0, 746, 0, 747, 755, 0, 748,
-	756, 749, 757, 750, 751, 758, 752, 759, 753, 754,
-	760, 0, 0, 0, 570, 570, 0, 0, 41, 522,
-	523, 0, 625, 946, 531, 829, 0, 572, 867, 0,
-	0, 830, 822, 823, 826, 829, 0, 595, 584, 574,
-	577, 578, 560, 0, 587, 591, 0, 593, 594, 0,
-	0, 70, 0, 641, 0, 597, 599, 600, 601, 623,
-	0, 0, 0, 0, 66, 68, 642, 0, 1330, 924,
-	0, 74, 75, 0, 0, 0, 212, 929, 930, 931,
-	-2, 231, 0, 136, 204, 148, 149, 150, 197, 152,
-	197, 197, 197, 197, 208, 208, 208, 208, 180, 181,
-	182, 183, 184, 0, 0, 167, 197, 197, 197, 197,
-	187, 188, 189, 190, 191, 192, 193, 194, 153, 154,
-	155, 156, 157, 158, 159, 160, 161, 199, 199, 199,
-	201, 201, 0, 39, 0, 216, 0, 826, 0, 849,
-	0, 0, 0, 949, 0, 948, 948, 948, 0, 0,
-	0, 369, 330, 358, 370, 0, 333, 334, -2, 0,
-	0, 320, 0, 322, 0, 232, 0, -2, 0, 0,
-	0, 238, 242, 239, 242, 230, 243, 350, 803, 0,
-	351, 352, 0, 388, 611, 0, 0, 0, 0, 0,
-	394, 395, 396, 0, 398, 399, 400, 401, 402, 403,
-	404, 405, 406, 407, 359, 360, 361, 362, 363, 364,
-	365, 0, 0, 322, 0, 355, 0, 276, 277, 0,
-	0, 280, 281, 282, 283, 0, 0, 286, 287, 288,
-	289, 290, 314, 315, 316, 291, 292, 293, 294, 295,
-	296, 297, 308, 309, 310, 311, 312, 313, 298, 299,
-	300, 301, 302, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 383, 384, 385, 386, 846, 847,
-	848, 0, 0, 0, 0, 0, 263, 64, 937, 0,
-	643, 959, 960, 482, 483, 0, 236, 237, 481, 481,
-	433, 456, 0, 481, 437, 458, 438, 440, 439, 441,
-	481, 444, 479, 480, 445, 446, 447, 448, 449, 450,
-	451, 452, 453, 454, 460, 0, 461, 0, 0, 499,
-	503, 504, 505, 506, 0, 0, 535, 540, 541, 542,
-	543, 555, 548, 694, 653, 654, 655, 657, 674, 0,
-	676, 678, 664, 665, 689, 690, 691, 0, 0, 0,
-	0, 687, 669, 0, 700, 701, 702, 703, 704, 705,
-	706, 707, 708, 709, 710, 711, 714, 778, 779, 780,
-	0, 712, 713, 724, 0, 0, 0, 571, 804, 0,
-	-2, 0, 692, 913, 829, 0, 0, 0, 0, 697,
-	806, 0, 697, 806, 0, 0, 0, 568, 569, 801,
-	798, 0, 0, 764, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 525, 526, 528, 0, 645, 0, 626,
-	0, 628, 629, 0, 947, 864, 52, 42, 0, 865,
-	0, 0, 0, 0, 825, 827, 828, 864, 0, 814,
-	0, 0, 650, 0, 0, 575, 48, 592, 588, 0,
-	650, 0, 0, 640, 0, 0, 0, 0, 0, 0,
-	630, 0, 0, 633, 0, 0, 0, 0, 624, 0,
-	0, 0, -2, 0, 0, 0, 62, 63, 0, 0,
-	0, 919, 73, 0, 0, 78, 79, 920, 921, 922,
-	923, 0, 111, -2, 271, 130, 132, 133, 134, 125,
-	135, 206, 205, 151, 208, 208, 174, 175, 212, 0,
-	212, 212, 212, 0, 0, 168, 169, 170, 171, 162,
-	0, 163, 164, 165, 0, 166, 249, 0, 833, 217,
-	218, 220, 224, 0, 0, 245, 246, 0, 0, 101,
-	0, 0, 950, 0, 0, 0, 107, 120, 121, 122,
-	123, 118, 0, 0, 126, 324, 0, 0, 0, 247,
-	0, 0, 226, 242, 227, 228, 0, 353, 0, 0,
-	390, 391, 392, 393, 0, 0, 0, 322, 324, 212,
-	0, 278, 279, 284, 285, 303, 0, 0, 0, 0,
-	859, 860, 0, 863, 93, 376, 378, 377, 381, 0,
-	0, 0, 0, 417, 263, 833, 0, 421, 264, 265,
-	422, 478, 443, 459, 478, 435, 442, 485, 464, 495,
-	539, 0, 0, 0, 547, 0, 675, 677, 679, 666,
-	687, 670, 0, 667, 0, 0, 661, 729, 0, 0,
-	570, 0, 821, 864, 733, 734, 0, 0, 0, 0,
-	0, 771, 0, 0, 772, 0, 821, 0, 799, 0,
-	0, 745, 765, 0, 0, 766, 767, 768, 769, 770,
-	524, 527, 529, 605, 0, 0, 0, 0, 627, 945,
-	44, 0, 0, 0, 831, 832, 824, 43, 0, 932,
-	933, 815, 816, 817, 0, 585, 596, 576, 0, 829,
-	907, 0, 0, 899, 0, 0, 650, 915, 0, 598,
-	619, 621, 0, 616, 631, 632, 634, 0, 636, 0,
-	638, 639, 602, 603, 604, 0, 650, 0, 650, 67,
-	650, 69, 0, 644, 76, 77, 0, 0, 83, 213,
-	214, 118, 273, 131, 137, 0, 0, 0, 141, 0,
-	0, 144, 146, 147, 207, 212, 212, 176, 209, 210,
-	211, 177, 178, 179, 0, 195, 0, 0, 0, 266,
-	88, 837, 836, 224, 224, 219, 0, 222, 0, 198,
-	0, 939, 103, 0, 0, 0, 0, 328, 609, 0,
-	339, 340, 0, 323, 387, 0, 216, 0, 229, 804,
-	612, 0, 0, 341, 0, 324, 344, 345, 356, 306,
-	307, 304, 607, 850, 851, 852, 0, 862, 96, 0,
-	0, 0, 0, 374, 0, 419, 420, 65, 481, 481,
-	534, 0, 537, 0, 668, 0, 688, 671, 730, 731,
-	0, 805, 829, 46, 0, 197, 197, 784, 197, 201,
-	787, 197, 789, 197, 792, 0, 0, 0, 0, 0,
-	0, 0, 796, 744, 802, 0, 0, 0, 0, 0,
-	0, 0, 0, 208, 869, 866, 45, 819, 0, 651,
-	589, 49, 53, 0, 907, 898, 909, 911, 0, 0,
-	0, 903, 0, 821, 0, 0, 613, 620, 0, 0,
-	614, 0, 615, 635, 637, -2, 821, 650, 60, 61,
-	0, 80, 81, 82, 272, 138, 139, 0, 142, 143,
-	145, 172, 173, 208, 0, 208, 0, 202, 0, 255,
-	267, 0, 834, 835, 0, 0, 221, 223, 607, 104,
-	105, 106, 0, 0, 127, 325, 0, 215, 0, 0,
-	412, 409, 342, 343, 0, 0, 861, 375, 0, 94,
-	95, 0, 0, 380, 418, 428, 434, 536, 556, 672,
-	732, 864, 735, 781, 208, 785, 786, 788, 790, 791,
-	793, 737, 736, 0, 0, 0, 0, 0, 829, 0,
-	800, 0, 0, 0, 0, 0, 625, 208, 889, 50,
-	0, 0, 0, 54, 0, 912, 0, 0, 0, 0,
-	71, 829, 916, 917, 617, 0, 622, 829, 59, 140,
-	212, 196, 212, 0, 0, 268, 838, 839, 840, 841,
-	842, 843, 844, 0, 331, 610, 0, 0, 389, 0,
-	397, 0, 0, 0, 0, 97, 98, 0, 0, 0,
-	47, 782, 783, 0, 0, 0, 0, 773, 0, 797,
-	0, 0, 0, 647, 0, 0, 645, 871, 870, 883,
-	896, 820, 818, 0, 910, 0, 902, 905, 901, 904,
-	57, 0, 58, 185, 186, 200, 203, 0, 0, 0,
-	413, 410, 411, 853, 608, 0, 0, 0, 382, 738,
-	740, 739, 741, 0, 0, 0, 743, 761, 762, 646,
-	648, 649, 606, 889, 0, 882, 0, -2, 891, 0,
-	0, 0, 897, 0, 900, 0, 618, 853, 0, 0,
-	372, 855, 99, 100, 317, 318, 319, 93, 742, 0,
-	0, 0, 876, 874, 874, 884, 885, 0, 0, 892,
-	0, 0, 0, 908, 906, 89, 0, 0, 0, 0,
-	856, 857, 96, 774, 0, 777, 879, 0, 872, 875,
-	873, 886, 0, 0, 893, 0, 895, 414, 415, 251,
-	0, 379, 775, 868, 0, 877, 878, 887, 888, 894,
-	252, 253, 0, 854, 0, 880, 881, 254, 0, 0,
-	0, 0, 256, 258, 259, 0, 0, 257, 776, 260,
-	261, 262,
+	31, 32, 33, 34, 831, 0, 567, 567, 567, 567,
+	567, 567, 567, 0, 0, -2, -2, -2, 855, 967,
+	0, 944, 0, 0, -2, 496, 497, 0, 499, -2,
+	0, 0, 508, 1380, 1380, 562, 0, 0, 0, 0,
+	0, 0, 539, 1378, 57, 58, 514, 515, 516, 1,
+	3, 0, 571, 839, 0, 0, -2, 569, 0, 0,
+	950, 950, 950, 0, 88, 89, 0, 0, 0, 855,
+	0, 0, 0, 0, 0, 567, 0, 945, 111, 112,
+	92, -2, 116, 117, 0, 121, 370, 331, 373, 329,
+	359, -2, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 334, 226, 226, 0, 0,
+	-2, 322, 322, 322, 0, 0, 0, 356, 952, 276,
+	226, 226, 0, 226, 226, 226, 226, 0, 0, 226,
+	226, 226, 226, 226, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 226, 0, 110, 868, 0, 0, 0,
+	0, 120, 968, 965, 966, 36, 37, 38, 1108, 1109,
+	1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119,
+	1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129,
+	1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139,
+	1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149,
+	1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159,
+	1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169,
+	1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179,
+	1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189,
+	1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199,
+	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209,
+	1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219,
+	1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229,
+	1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239,
+	1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249,
+	1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259,
+	1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269,
+	1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279,
+	1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289,
+	1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299,
+	1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309,
+	1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319,
+	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329,
+	1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339,
+	1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349,
+	1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359,
+	1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369,
+	1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377, 0, 0,
+	0, 946, 567, 0, 428, 652, 0, 487, 487, 0,
+	487, 487, 487, 487, 0, 0, 0, 440, 0, 0,
+	0, 0, 484, 0, 0, 459, 461, 0, 484, 487,
+	0, 0, 471, 487, 1381, 1381, 1381, 935, 0, 481,
+	479, 493, 494, 476, 477, 495, 498, 0, 503, 506,
+	961, 962, 0, 521, 0, 1184, 513, 526, 527, 0,
+	563, 564, 41, 703, 662, 0, 668, 670, 0, 705,
+	706, 707, 708, 709, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 735, 736, 737, 738, 816, 817,
+	818, 819, 820, 821, 822, 823, 672, 673, 813, 0,
+	924, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	804, 0, 773, 773, 773, 773, 773, 773, 773, 773,
+	773, 0, 0, 0, 0, 0, 0, 0, -2, -2,
+	1380, 0, 536, 0, 0, 0, 540, 541, 831, 53,
+	0, 567, 572, 573, 874, 0, 0, 831, 1379, 0,
+	0, -2, -2, 583, 589, 590, 591, 592, 593, 568,
+	0, 596, 600, 0, 0, 0, 951, 0, 0, 74,
+	0, 1342, 928, -2, -2, 0, 0, 963, 964, 937,
+	-2, 971, 972, 973, 974, 975, 976, 977, 978, 979,
+	980, 981, 982, 983, 984, 985, 986, 987, 988, 989,
+	990, 991, 992, 993, 994, 995, 996, 997, 998, 999,
+	1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
+	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
+	1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
+	1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
+	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
+	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
+	1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
+	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079,
+	1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089,
+	1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099,
+	1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, -2, 0,
+	0, 130, 131, 0, 39, 252, 0, 126, 0, 246,
+	199, 868, 948, 958, 0, 0, 0, 948, 94, 118,
+	119, 226, 226, 0, 120, 120, 338, 339, 340, 0,
+	0, -2, 250, 0, 323, 0, 0, 240, 240, 244,
+	242, 243, 0, 0, 0, 0, 0, 0, 350, 0,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	412, 0, 227, 0, 368, 369, 277, 0, 0, 0,
+	0, 348, 349, 0, 0, 953, 954, 0, 0, 226,
+	226, 0, 0, 0, 0, 226, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 104, 859, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, -2, 0, 420, 0, 946, 0, 0,
+	0, 946, 427, 0, 429, 430, 0, 0, 431, 0,
+	484, 484, 482, 483, 433, 434, 435, 436, 487, 0,
+	0, 235, 236, 237, 484, 487, 0, 487, 487, 487,
+	487, 484, 487, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1381, 1381, 1381, 490, 487, 466, 0, 468,
+	469, 472, 473, 1382, 1383, 982, 474, 475, 936, 504,
+	507, 524, 522, 523, 525, 517, 518, 519, 520, 0,
+	542, 543, 548, 0, 0, 0, 0, 554, 555, 556,
+	0, 0, 559, 560, 561, 0, 0, 0, 0, 0,
+	666, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	690, 691, 692, 693, 694, 695, 696, 669, 0, 683,
+	0, 0, 0, 725, 726, 727, 728, 729, 730, 731,
+	732, 733, 0, 580, 0, 0, 0, 831, 0, 0,
+	0, 0, 0, 0, 0, 577, 0, 805, 0, 756,
+	0, 757, 765, 0, 758, 766, 759, 767, 760, 761,
+	768, 762, 769, 763, 764, 770, 0, 0, 0, 580,
+	580, 0, 0, 42, 528, 529, 0, 635, 956, 537,
+	538, 839, 0, 582, 877, 0, 0, 840, 832, 833,
+	836, 839, 0, 605, 594, 584, 587, 588, 570, 0,
+	597, 601, 0, 603, 604, 0, 0, 72, 0, 651,
+	0, 607, 609, 610, 611, 633, 0, 0, 0, 0,
+	68, 70, 652, 0, 1342, 934, 0, 76, 77, 0,
+	0, 0, 214, 939, 940, 941, -2, 233, 0, 138,
+	206, 150, 151, 152, 199, 154, 199, 199, 199, 199,
+	210, 210, 210, 210, 182, 183, 184, 185, 186, 0,
+	0, 169, 199, 199, 199, 199, 189, 190, 191, 192,
+	193, 194, 195, 196, 155, 156, 157, 158, 159, 160,
+	161, 162, 163, 201, 201, 201, 203, 203, 0, 40,
+	0, 218, 0, 836, 0, 859, 0, 0, 0, 959,
+	0, 958, 958, 958, 0, 0, 0, 371, 332, 360,
+	372, 0, 335, 336, -2, 0, 0, 322, 0, 324,
+	0, 234, 0, -2, 0, 0, 0, 240, 244, 241,
+	244, 232, 245, 352, 813, 0, 353, 354, 0, 392,
+	621, 0, 0, 0, 0, 0, 398, 399, 400, 0,
+	402, 403, 404, 405, 406, 407, 408, 409, 410, 411,
+	361, 362, 363, 364, 365, 366, 367, 0, 0, 324,
+	0, 357, 0, 278, 279, 0, 0, 282, 283, 284,
+	285, 0, 0, 288, 289, 290, 291, 292, 316, 317,
+	318, 293, 294, 295, 296, 297, 298, 299, 310, 311,
+	312, 313, 314, 315, 300, 301, 302, 303, 304, 307,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	385, 386, 387, 388, 660, 660, 856, 857, 858, 0,
+	0, 0, 0, 0, 265, 66, 947, 0, 653, 969,
+	970, 488, 489, 0, 238, 239, 487, 487, 437, 460,
+	0, 487, 441, 462, 442, 444, 443, 445, 487, 448,
+	485, 486, 449, 450, 451, 452, 453, 454, 455, 456,
+	457, 458, 464, 0, 465, 487, 0, 0, 505, 509,
+	510, 511, 512, 0, 0, 545, 550, 551, 552, 553,
+	565, 558, 704, 663, 664, 665, 667, 684, 0, 686,
+	688, 674, 675, 699, 700, 701, 0, 0, 0, 0,
+	697, 679, 0, 710, 711, 712, 713, 714, 715, 716,
+	717, 718, 719, 720, 721, 724, 788, 789, 790, 0,
+	722, 723, 734, 0, 0, 0, 581, 814, 0, -2,
+	0, 702, 923, 839, 0, 0, 0, 0, 707, 816,
+	0, 707, 816, 0, 0, 0, 578, 579, 811, 808,
+	0, 0, 774, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 531, 532, 534, 0, 655, 0, 636, 0,
+	638, 639, 0, 957, 874, 54, 43, 0, 875, 0,
+	0, 0, 0, 835, 837, 838, 874, 0, 824, 0,
+	0, 660, 0, 0, 585, 49, 602, 598, 0, 660,
+	0, 0, 650, 0, 0, 0, 0, 0, 0, 640,
+	0, 0, 643, 0, 0, 0, 0, 634, 0, 0,
+	0, -2, 0, 0, 0, 64, 65, 0, 0, 0,
+	929, 75, 0, 0, 80, 81, 930, 931, 932, 933,
+	0, 113, -2, 273, 132, 134, 135, 136, 127, 137,
+	208, 207, 153, 210, 210, 176, 177, 214, 0, 214,
+	214, 214, 0, 0, 170, 171, 172, 173, 164, 0,
+	165, 166, 167, 0, 168, 251, 0, 843, 219, 220,
+	222, 226, 0, 0, 247, 248, 0, 0, 103, 0,
+	0, 960, 0, 0, 0, 109, 122, 123, 124, 125,
+	120, 0, 0, 128, 326, 0, 0, 0, 249, 0,
+	0, 228, 244, 229, 230, 0, 355, 0, 0, 394,
+	395, 396, 397, 0, 0, 0, 324, 326, 214, 0,
+	280, 281, 286, 287, 305, 0, 0, 0, 0, 869,
+	870, 0, 873, 95, 378, 380, 379, 383, 0, 0,
+	389, 0, 390, 0, 0, 421, 265, 843, 0, 425,
+	266, 267, 426, 484, 447, 463, 484, 439, 446, 491,
+	467, 470, 501, 549, 0, 0, 0, 557, 0, 685,
+	687, 689, 676, 697, 680, 0, 677, 0, 0, 671,
+	739, 0, 0, 580, 0, 831, 874, 743, 744, 0,
+	0, 0, 0, 0, 781, 0, 0, 782, 0, 831,
+	0, 809, 0, 0, 755, 775, 0, 0, 776, 777,
+	778, 779, 780, 530, 533, 535, 615, 0, 0, 0,
+	0, 637, 955, 45, 0, 0, 0, 841, 842, 834,
+	44, 0, 942, 943, 825, 826, 827, 595, 606, 586,
+	0, 0, 839, 917, 0, 0, 909, 0, 0, 660,
+	925, 0, 608, 629, 631, 0, 626, 641, 642, 644,
+	0, 646, 0, 648, 649, 612, 613, 614, 0, 660,
+	0, 660, 69, 660, 71, 0, 654, 78, 79, 0,
+	0, 85, 215, 216, 120, 275, 133, 139, 0, 0,
+	0, 143, 0, 0, 146, 148, 149, 209, 214, 214,
+	178, 211, 212, 213, 179, 180, 181, 0, 197, 0,
+	0, 0, 268, 90, 847, 846, 226, 226, 221, 0,
+	224, 0, 200, 0, 949, 105, 0, 0, 0, 0,
+	330, 619, 0, 341, 342, 0, 325, 391, 0, 218,
+	0, 231, 814, 622, 0, 0, 343, 0, 326, 346,
+	347, 358, 308, 309, 306, 617, 860, 861, 862, 0,
+	872, 98, 0, 0, 0, 0, 661, 376, 0, 423,
+	424, 67, 487, 487, 544, 0, 547, 0, 678, 0,
+	698, 681, 740, 741, 0, 815, 839, 47, 0, 199,
+	199, 794, 199, 203, 797, 199, 799, 199, 802, 0,
+	0, 0, 0, 0, 0, 0, 806, 754, 812, 0,
+	0, 0, 0, 0, 0, 0, 0, 210, 879, 876,
+	46, 829, 0, 50, 599, 51, 55, 0, 917, 908,
+	919, 921, 0, 0, 0, 913, 0, 831, 0, 0,
+	623, 630, 0, 0, 624, 0, 625, 645, 647, -2,
+	831, 660, 62, 63, 0, 82, 83, 84, 274, 140,
+	141, 0, 144, 145, 147, 174, 175, 210, 0, 210,
+	0, 204, 0, 257, 269, 0, 844, 845, 0, 0,
+	223, 225, 617, 106, 107, 108, 0, 0, 129, 327,
+	0, 217, 0, 0, 416, 413, 344, 345, 0, 0,
+	871, 377, 0, 96, 97, 0, 0, 382, 422, 432,
+	438, 546, 566, 682, 742, 874, 745, 791, 210, 795,
+	796, 798, 800, 801, 803, 747, 746, 0, 0, 0,
+	0, 0, 839, 0, 810, 0, 0, 0, 0, 0,
+	635, 210, 899, 52, 0, 0, 0, 56, 0, 922,
+	0, 0, 0, 0, 73, 839, 926, 927, 627, 0,
+	632, 839, 61, 142, 214, 198, 214, 0, 0, 270,
+	848, 849, 850, 851, 852, 853, 854, 0, 333, 620,
+	0, 0, 393, 0, 401, 0, 0, 0, 0, 99,
+	100, 0, 0, 0, 48, 792, 793, 0, 0, 0,
+	0, 783, 0, 807, 0, 0, 0, 657, 0, 0,
+	655, 881, 880, 893, 906, 830, 828, 0, 920, 0,
+	912, 915, 911, 914, 59, 0, 60, 187, 188, 202,
+	205, 0, 0, 0, 417, 414, 415, 863, 618, 0,
+	0, 0, 384, 748, 750, 749, 751, 0, 0, 0,
+	753, 771, 772, 656, 658, 659, 616, 899, 0, 892,
+	0, -2, 901, 0, 0, 0, 907, 0, 910, 0,
+	628, 863, 0, 0, 374, 865, 101, 102, 319, 320,
+	321, 95, 752, 0, 0, 0, 886, 884, 884, 894,
+	895, 0, 0, 902, 0, 0, 0, 918, 916, 91,
+	0, 0, 0, 0, 866, 867, 98, 784, 0, 787,
+	889, 0, 882, 885, 883, 896, 0, 0, 903, 0,
+	905, 418, 419, 253, 0, 381, 785, 878, 0, 887,
+	888, 897, 898, 904, 254, 255, 0, 864, 0, 890,
+	891, 256, 0, 0, 0, 0, 258, 260, 261, 0,
+	0, 259, 786, 262, 263, 264,
 }
 
 var yyTok1 = [...]int{
@@ -5371,7 +5420,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 111, 3, 3, 3, 138, 130, 3,
 	81, 83, 135, 133, 82, 134, 150, 136, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 474,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 479,
 	119, 118, 120, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -5450,7 +5499,8 @@ var yyTok3 = [...]int{
 	57780, 455, 57781, 456, 57782, 457, 57783, 458, 57784, 459,
 	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
-	57795, 470, 57796, 471, 57797, 472, 57798, 473, 0,
+	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
+	57800, 475, 57801, 476, 57802, 477, 57803, 478, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5800,84 +5850,84 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:424
+//line sql.y:431
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:429
+//line sql.y:436
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:430
+//line sql.y:437
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:434
+//line sql.y:441
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:467
+//line sql.y:475
 		{
 			setParseTree(yylex, nil)
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:473
+//line sql.y:481
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:477
+//line sql.y:485
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:481
+//line sql.y:489
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:486
+//line sql.y:494
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:490
+//line sql.y:498
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:496
+//line sql.y:504
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:502
+//line sql.y:510
 		{
 			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:508
+//line sql.y:516
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -5887,34 +5937,34 @@ yydefault:
 			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:517
+//line sql.y:525
 		{
 			yyLOCAL = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmtUnion()}, OrderBy: yyDollar[4].orderByUnion(), Limit: yyDollar[5].limitUnion(), Lock: yyDollar[6].lockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:521
+//line sql.y:529
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:525
+//line sql.y:533
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:548
+//line sql.y:556
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -5923,58 +5973,66 @@ yydefault:
 			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:556
+//line sql.y:564
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:562
+//line sql.y:570
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 49:
+	case 50:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:574
+		{
+			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Group: yyDollar[7].colIdent}
+		}
+		yyVAL.union = yyLOCAL
+	case 51:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:568
+//line sql.y:580
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
+	case 52:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:576
+//line sql.y:588
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:582
+//line sql.y:594
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:586
+//line sql.y:598
 		{
 			yyLOCAL = &ParenSelect{Select: yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 55:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:593
+//line sql.y:605
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -5987,10 +6045,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 56:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:605
+//line sql.y:617
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -6001,258 +6059,258 @@ yydefault:
 			yyLOCAL = &Insert{Action: yyDollar[1].insertActionUnion(), Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitionsUnion(), Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprsUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:617
+//line sql.y:629
 		{
 			yyLOCAL = InsertAct
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:621
+//line sql.y:633
 		{
 			yyLOCAL = ReplaceAct
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 59:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:627
+//line sql.y:639
 		{
 			yyLOCAL = &Update{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: yyDollar[4].tableExprsUnion(), Exprs: yyDollar[6].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 60:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:633
+//line sql.y:645
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 61:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:637
+//line sql.y:649
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 62:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:641
+//line sql.y:653
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 63:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:645
+//line sql.y:657
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:650
+//line sql.y:662
 		{
 		}
-	case 63:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:651
+//line sql.y:663
 		{
 		}
-	case 64:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:655
+//line sql.y:667
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:659
+//line sql.y:671
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
 		}
-	case 66:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:665
+//line sql.y:677
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:669
+//line sql.y:681
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 68:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:675
+//line sql.y:687
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:679
+//line sql.y:691
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 70:
+	case 72:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:684
+//line sql.y:696
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 73:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:688
+//line sql.y:700
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:694
+//line sql.y:706
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 75:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:700
+//line sql.y:712
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 76:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:704
+//line sql.y:716
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:710
+//line sql.y:722
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:714
+//line sql.y:726
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
 		}
-	case 77:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:720
+//line sql.y:732
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:724
+//line sql.y:736
 		{
 			yyLOCAL = ReadWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:728
+//line sql.y:740
 		{
 			yyLOCAL = ReadOnly
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:734
+//line sql.y:746
 		{
 			yyLOCAL = RepeatableRead
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:738
+//line sql.y:750
 		{
 			yyLOCAL = ReadCommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:742
+//line sql.y:754
 		{
 			yyLOCAL = ReadUncommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:746
+//line sql.y:758
 		{
 			yyLOCAL = Serializable
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:752
+//line sql.y:764
 		{
 			yyLOCAL = SessionScope
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:756
+//line sql.y:768
 		{
 			yyLOCAL = GlobalScope
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:762
+//line sql.y:774
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:768
+//line sql.y:780
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -6260,10 +6318,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 90:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:775
+//line sql.y:787
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -6273,543 +6331,527 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 91:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:784
+//line sql.y:796
 		{
 			yyLOCAL = &CreateView{ViewName: yyDollar[7].tableName.ToViewName(), IsReplace: yyDollar[2].booleanUnion(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:788
+//line sql.y:800
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
 			yyLOCAL = yyDollar[1].createDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:795
+//line sql.y:807
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:799
+//line sql.y:811
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 95:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:804
+//line sql.y:816
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 94:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:820
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 95:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:814
+//line sql.y:826
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 96:
+	case 98:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:819
+//line sql.y:831
 		{
 			var v []VindexParam
 			yyLOCAL = v
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 99:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:824
+//line sql.y:836
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:830
+//line sql.y:842
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:835
+//line sql.y:847
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
-	case 100:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:841
+//line sql.y:853
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 101:
+	case 103:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:847
+//line sql.y:859
 		{
 			yyLOCAL = &CreateTable{Table: yyDollar[5].tableName, IfNotExists: yyDollar[4].booleanUnion(), Temp: yyDollar[2].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:854
+//line sql.y:866
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[3].tableName}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 105:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:861
+//line sql.y:873
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[6].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[3].colIdent, Type: string(yyDollar[2].str)}, Options: yyDollar[4].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 106:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:866
+//line sql.y:878
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Fulltext: true}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 107:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:871
+//line sql.y:883
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Spatial: true}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 108:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:876
+//line sql.y:888
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Unique: true}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 109:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:883
+//line sql.y:895
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[3].strs), DBName: yyDollar[5].tableIdent, IfNotExists: yyDollar[4].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:890
+//line sql.y:902
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 113:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:901
+//line sql.y:913
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 114:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:907
+//line sql.y:919
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:911
+//line sql.y:923
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:917
+//line sql.y:929
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:921
+//line sql.y:933
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:925
+//line sql.y:937
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 117:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:929
+//line sql.y:941
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 118:
+	case 120:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:934
+//line sql.y:946
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:938
+//line sql.y:950
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:944
+//line sql.y:956
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 121:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:948
+//line sql.y:960
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 122:
+	case 124:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:954
+//line sql.y:966
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 123:
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:958
+//line sql.y:970
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 124:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:965
+//line sql.y:977
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:969
+//line sql.y:981
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:975
+//line sql.y:987
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:979
+//line sql.y:991
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
 		}
-	case 128:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:985
+//line sql.y:997
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:990
+//line sql.y:1002
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:995
+//line sql.y:1007
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
-	case 131:
+	case 133:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:999
+//line sql.y:1011
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
-	case 132:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1004
+//line sql.y:1016
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
-	case 133:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1008
+//line sql.y:1020
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 134:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1012
+//line sql.y:1024
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 135:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1018
+//line sql.y:1030
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptionsUnion()
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 138:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1028
+//line sql.y:1040
 		{
 			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 139:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1032
+//line sql.y:1044
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1038
+//line sql.y:1050
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1044
+//line sql.y:1056
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1049
+//line sql.y:1061
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1054
+//line sql.y:1066
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1059
+//line sql.y:1071
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1064
+//line sql.y:1076
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKeyPrimary
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1069
+//line sql.y:1081
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKey
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1074
+//line sql.y:1086
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKeyUniqueKey
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 148:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1079
+//line sql.y:1091
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKeyUnique
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1086
+//line sql.y:1098
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
 			yyVAL.columnType.Zerofill = yyDollar[3].booleanUnion()
 		}
-	case 151:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1097
+//line sql.y:1109
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
-	case 152:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1102
-		{
-			yyVAL.columnType = yyDollar[1].columnType
-		}
-	case 153:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1108
-		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
-		}
 	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1112
+//line sql.y:1114
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1116
+//line sql.y:1120
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1120
+//line sql.y:1124
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1124
+//line sql.y:1128
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1128
+//line sql.y:1132
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1132
+//line sql.y:1136
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1136
+//line sql.y:1140
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1140
+//line sql.y:1144
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 162:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1146
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1148
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 163:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1152
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
@@ -6836,1413 +6878,1429 @@ yydefault:
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 167:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1178
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1176
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1182
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 169:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1186
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1190
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1190
+//line sql.y:1194
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1194
+//line sql.y:1198
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 172:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1202
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+		}
+	case 173:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1206
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+		}
+	case 174:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1200
+//line sql.y:1212
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 173:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1204
+//line sql.y:1216
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 174:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1208
+//line sql.y:1220
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 175:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1212
+//line sql.y:1224
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 176:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1216
+//line sql.y:1228
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 177:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1220
+//line sql.y:1232
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 178:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1224
+//line sql.y:1236
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 179:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1228
+//line sql.y:1240
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 180:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1232
+//line sql.y:1244
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 181:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1236
+//line sql.y:1248
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 182:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1240
+//line sql.y:1252
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 183:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1244
+//line sql.y:1256
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 184:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1248
+//line sql.y:1260
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 185:
+	case 187:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1252
+//line sql.y:1264
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 186:
+	case 188:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1257
+//line sql.y:1269
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 187:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1263
+//line sql.y:1275
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 188:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1267
+//line sql.y:1279
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 189:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1271
+//line sql.y:1283
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 190:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1275
+//line sql.y:1287
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 191:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1279
+//line sql.y:1291
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 192:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1283
+//line sql.y:1295
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 193:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1287
+//line sql.y:1299
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 194:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1291
+//line sql.y:1303
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 195:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1297
+//line sql.y:1309
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
-	case 196:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1302
+//line sql.y:1314
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
-	case 197:
+	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1307
+//line sql.y:1319
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1311
+//line sql.y:1323
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1316
+//line sql.y:1328
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 200:
+	case 202:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1320
+//line sql.y:1332
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 201:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1328
+//line sql.y:1340
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 202:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1332
+//line sql.y:1344
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 			}
 		}
-	case 203:
+	case 205:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1338
+//line sql.y:1350
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 204:
+	case 206:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1346
+//line sql.y:1358
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1350
+//line sql.y:1362
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1355
+//line sql.y:1367
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1359
+//line sql.y:1371
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1364
+//line sql.y:1376
 		{
 			yyVAL.str = ""
 		}
-	case 209:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1368
+//line sql.y:1380
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 210:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1372
+//line sql.y:1384
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 211:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1376
+//line sql.y:1388
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 212:
+	case 214:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1381
+//line sql.y:1393
 		{
 			yyVAL.str = ""
 		}
-	case 213:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1385
+//line sql.y:1397
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 214:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1389
+//line sql.y:1401
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 215:
+	case 217:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1396
+//line sql.y:1408
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1401
+//line sql.y:1413
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1405
+//line sql.y:1417
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1411
+//line sql.y:1423
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1415
+//line sql.y:1427
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
 		}
-	case 220:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1421
+//line sql.y:1433
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1425
+//line sql.y:1437
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1430
+//line sql.y:1442
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 225:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1434
+//line sql.y:1446
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 226:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1440
+//line sql.y:1452
 		{
 			yyVAL.str = ""
 		}
-	case 225:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1444
+//line sql.y:1456
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 226:
+	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1450
+//line sql.y:1462
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 227:
+	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1454
+//line sql.y:1466
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 228:
+	case 230:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1458
+//line sql.y:1470
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1462
+//line sql.y:1474
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1466
+//line sql.y:1478
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 233:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1471
+//line sql.y:1483
 		{
 			yyVAL.str = ""
 		}
-	case 232:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1475
+//line sql.y:1487
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 233:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1481
+//line sql.y:1493
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 234:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1485
+//line sql.y:1497
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 235:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1489
+//line sql.y:1501
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 236:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1496
+//line sql.y:1508
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 237:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1500
+//line sql.y:1512
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 238:
+	case 240:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1505
+//line sql.y:1517
 		{
 			yyVAL.str = "key"
 		}
-	case 239:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1509
+//line sql.y:1521
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 240:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1515
+//line sql.y:1527
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 241:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1519
+//line sql.y:1531
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 242:
+	case 244:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1524
+//line sql.y:1536
 		{
 			yyVAL.str = ""
 		}
-	case 243:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1528
+//line sql.y:1540
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 244:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:1534
+//line sql.y:1546
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1538
+//line sql.y:1550
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
 		}
-	case 246:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:1544
+//line sql.y:1556
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1550
+//line sql.y:1562
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1554
+//line sql.y:1566
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1560
+//line sql.y:1572
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1564
+//line sql.y:1576
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 253:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1570
+//line sql.y:1582
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 254:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1574
+//line sql.y:1586
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion(), OnDelete: yyDollar[11].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 255:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1578
+//line sql.y:1590
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion(), OnUpdate: yyDollar[11].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 256:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1582
+//line sql.y:1594
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion(), OnDelete: yyDollar[11].ReferenceActionUnion(), OnUpdate: yyDollar[12].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 257:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1588
+//line sql.y:1600
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1594
+//line sql.y:1606
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1600
+//line sql.y:1612
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1606
+//line sql.y:1618
 		{
 			yyLOCAL = Restrict
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1610
+//line sql.y:1622
 		{
 			yyLOCAL = Cascade
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1614
+//line sql.y:1626
 		{
 			yyLOCAL = NoAction
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 263:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1618
+//line sql.y:1630
 		{
 			yyLOCAL = SetDefault
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1622
+//line sql.y:1634
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 265:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1627
+//line sql.y:1639
 		{
 			yyVAL.str = ""
 		}
-	case 264:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1631
+//line sql.y:1643
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 265:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1635
+//line sql.y:1647
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 266:
+	case 268:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1640
+//line sql.y:1652
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1644
+//line sql.y:1656
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 270:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1648
+//line sql.y:1660
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 271:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1653
+//line sql.y:1665
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1657
+//line sql.y:1669
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1663
+//line sql.y:1675
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1667
+//line sql.y:1679
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
-	case 273:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1671
+//line sql.y:1683
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 274:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1677
+//line sql.y:1689
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1681
+//line sql.y:1693
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 276:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1687
+//line sql.y:1699
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1691
+//line sql.y:1703
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1695
+//line sql.y:1707
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1699
+//line sql.y:1711
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1703
+//line sql.y:1715
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1707
+//line sql.y:1719
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1711
+//line sql.y:1723
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1715
+//line sql.y:1727
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1719
+//line sql.y:1731
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1723
+//line sql.y:1735
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1727
+//line sql.y:1739
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1731
+//line sql.y:1743
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1735
+//line sql.y:1747
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1739
+//line sql.y:1751
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1743
+//line sql.y:1755
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1747
+//line sql.y:1759
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1751
+//line sql.y:1763
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1755
+//line sql.y:1767
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1759
+//line sql.y:1771
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1763
+//line sql.y:1775
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1767
+//line sql.y:1779
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1771
+//line sql.y:1783
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1775
+//line sql.y:1787
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1779
+//line sql.y:1791
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1783
+//line sql.y:1795
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1787
+//line sql.y:1799
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1791
+//line sql.y:1803
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1795
+//line sql.y:1807
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 306:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1799
+//line sql.y:1811
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 307:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1804
+//line sql.y:1816
 		{
 			yyVAL.str = ""
 		}
-	case 306:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1808
+//line sql.y:1820
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 307:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1812
+//line sql.y:1824
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 317:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1831
+//line sql.y:1843
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 318:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1835
+//line sql.y:1847
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 319:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1839
+//line sql.y:1851
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 320:
+	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1844
+//line sql.y:1856
 		{
 			yyVAL.str = ""
 		}
-	case 322:
+	case 324:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1850
+//line sql.y:1862
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1854
+//line sql.y:1866
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 326:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1859
+//line sql.y:1871
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1863
+//line sql.y:1875
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1868
+//line sql.y:1880
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1872
+//line sql.y:1884
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 330:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1876
+//line sql.y:1888
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 329:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1880
+//line sql.y:1892
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1884
+//line sql.y:1896
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 331:
+	case 333:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1888
+//line sql.y:1900
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1894
+//line sql.y:1906
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1898
+//line sql.y:1910
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 334:
+	case 336:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1902
+//line sql.y:1914
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 335:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1908
+//line sql.y:1920
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1912
+//line sql.y:1924
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1916
+//line sql.y:1928
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1920
+//line sql.y:1932
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 341:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1924
+//line sql.y:1936
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 342:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1928
+//line sql.y:1940
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 343:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1932
+//line sql.y:1944
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 344:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1936
+//line sql.y:1948
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 345:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1940
+//line sql.y:1952
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].colNameUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 346:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1944
+//line sql.y:1956
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 347:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1948
+//line sql.y:1960
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1952
+//line sql.y:1964
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1956
+//line sql.y:1968
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1960
+//line sql.y:1972
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1964
+//line sql.y:1976
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1968
+//line sql.y:1980
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1972
+//line sql.y:1984
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1976
+//line sql.y:1988
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 355:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1980
+//line sql.y:1992
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1984
+//line sql.y:1996
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1988
+//line sql.y:2000
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 358:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1992
+//line sql.y:2004
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1998
+//line sql.y:2010
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2002
+//line sql.y:2014
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 359:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2008
+//line sql.y:2020
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2012
+//line sql.y:2024
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2016
+//line sql.y:2028
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2020
+//line sql.y:2032
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2024
+//line sql.y:2036
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2028
+//line sql.y:2040
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2032
+//line sql.y:2044
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2036
+//line sql.y:2048
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2040
+//line sql.y:2052
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2046
+//line sql.y:2058
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 371:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2052
+//line sql.y:2064
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8250,10 +8308,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 372:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2059
+//line sql.y:2071
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8261,28 +8319,28 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2066
+//line sql.y:2078
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 374:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2072
+//line sql.y:2084
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[6].tableName.ToViewName(), Algorithm: yyDollar[2].str, Definer: yyDollar[3].str, Security: yyDollar[4].str, Columns: yyDollar[7].columnsUnion(), Select: yyDollar[9].selStmtUnion(), CheckOption: yyDollar[10].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2076
+//line sql.y:2088
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8290,10 +8348,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 376:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2083
+//line sql.y:2095
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8301,10 +8359,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 377:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2090
+//line sql.y:2102
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -8317,10 +8375,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 378:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2102
+//line sql.y:2114
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -8331,26 +8389,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 379:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2112
+//line sql.y:2124
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 380:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2116
+//line sql.y:2128
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 381:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2120
+//line sql.y:2132
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -8364,10 +8422,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 382:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2133
+//line sql.y:2145
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -8378,18 +8436,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 383:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2143
+//line sql.y:2155
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 384:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2147
+//line sql.y:2159
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -8401,10 +8459,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 385:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2158
+//line sql.y:2170
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -8412,10 +8470,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 386:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2165
+//line sql.y:2177
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -8423,10 +8481,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 387:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2172
+//line sql.y:2184
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -8434,282 +8492,306 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2179
+//line sql.y:2191
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 389:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2197
+		{
+			yyLOCAL = &AlterDeadLetters{
+				Type:  RequeueDeadLettersType,
+				Table: yyDollar[3].tableName,
+				Where: NewWhere(WhereClause, yyDollar[5].exprUnion()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 390:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2205
+		{
+			yyLOCAL = &AlterDeadLetters{
+				Type:  PurgeDeadLettersType,
+				Table: yyDollar[3].tableName,
+				Where: NewWhere(WhereClause, yyDollar[5].exprUnion()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 391:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2187
+//line sql.y:2215
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2191
+//line sql.y:2219
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 393:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2195
+//line sql.y:2223
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 394:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2199
+//line sql.y:2227
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 395:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2203
+//line sql.y:2231
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 396:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2207
+//line sql.y:2235
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2211
+//line sql.y:2239
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2215
+//line sql.y:2243
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2219
+//line sql.y:2247
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2223
+//line sql.y:2251
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 401:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2227
+//line sql.y:2255
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2231
+//line sql.y:2259
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2235
+//line sql.y:2263
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2239
+//line sql.y:2267
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2243
+//line sql.y:2271
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2247
+//line sql.y:2275
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2251
+//line sql.y:2279
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2255
+//line sql.y:2283
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2259
+//line sql.y:2287
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2263
+//line sql.y:2291
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2267
+//line sql.y:2295
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2271
+//line sql.y:2299
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 413:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2276
+//line sql.y:2304
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2280
+//line sql.y:2308
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2284
+//line sql.y:2312
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2291
+//line sql.y:2319
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2295
+//line sql.y:2323
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
-	case 414:
+	case 418:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2301
+//line sql.y:2329
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 419:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2305
+//line sql.y:2333
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2311
+//line sql.y:2339
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2317
+//line sql.y:2345
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2321
+//line sql.y:2349
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 419:
+	case 423:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2327
+//line sql.y:2355
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion(), Temp: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 424:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2331
+//line sql.y:2359
 		{
 			// Change this to an alter statement
 			if yyDollar[3].colIdent.Lowered() == "primary" {
@@ -8719,371 +8801,387 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 425:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2340
+//line sql.y:2368
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[4].tableNamesUnion(), IfExists: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 426:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2344
+//line sql.y:2372
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[3].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2350
+//line sql.y:2378
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2354
+//line sql.y:2382
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2359
+//line sql.y:2387
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2365
+//line sql.y:2393
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2369
+//line sql.y:2397
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 432:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2373
+//line sql.y:2401
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2377
+//line sql.y:2405
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2381
+//line sql.y:2409
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2385
+//line sql.y:2413
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2389
+//line sql.y:2417
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2393
+//line sql.y:2421
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 438:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2397
+//line sql.y:2425
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 439:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2401
+//line sql.y:2429
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2405
+//line sql.y:2433
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2409
+//line sql.y:2437
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2413
+//line sql.y:2441
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2417
+//line sql.y:2445
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 444:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2421
+//line sql.y:2449
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2425
+//line sql.y:2453
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 446:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2429
+//line sql.y:2457
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 447:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2433
+//line sql.y:2461
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 448:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2437
+//line sql.y:2465
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 449:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2441
+//line sql.y:2469
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2445
+//line sql.y:2473
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 451:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2449
+//line sql.y:2477
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2453
+//line sql.y:2481
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2457
+//line sql.y:2485
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2461
+//line sql.y:2489
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 455:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2465
+//line sql.y:2493
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 456:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2469
+//line sql.y:2497
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 457:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2473
+//line sql.y:2501
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 458:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2477
+//line sql.y:2505
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2481
+//line sql.y:2509
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 460:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2485
+//line sql.y:2513
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2489
+//line sql.y:2517
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 462:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2493
+//line sql.y:2521
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 463:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2497
+//line sql.y:2525
 		{
-			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
+			yyLOCAL = &Show{&ShowBasic{Command: Processlist, Full: yyDollar[2].booleanUnion(), Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 464:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2501
+//line sql.y:2529
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2506
+//line sql.y:2534
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 466:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2538
+		{
+			yyLOCAL = &Show{&ShowBasic{Command: VitessPlans, Filter: yyDollar[3].showFilterUnion()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 467:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2542
+		{
+			yyLOCAL = &Show{&ShowBasic{Command: VitessDeadLetters, Tbl: yyDollar[4].tableName, Filter: yyDollar[5].showFilterUnion()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2510
+//line sql.y:2546
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2514
+//line sql.y:2550
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 470:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2518
+//line sql.y:2554
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2522
+//line sql.y:2558
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2527
+//line sql.y:2563
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -9091,1204 +9189,1241 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2541
+//line sql.y:2577
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2545
+//line sql.y:2581
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2549
+//line sql.y:2585
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2555
+//line sql.y:2591
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 471:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2559
+//line sql.y:2595
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 472:
+	case 478:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2565
+//line sql.y:2601
 		{
 			yyVAL.str = ""
 		}
-	case 473:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2569
+//line sql.y:2605
 		{
 			yyVAL.str = "extended "
 		}
-	case 474:
+	case 480:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2575
+//line sql.y:2611
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2579
+//line sql.y:2615
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2585
+//line sql.y:2621
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 477:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2589
+//line sql.y:2625
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 478:
+	case 484:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2595
+//line sql.y:2631
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 479:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2599
+//line sql.y:2635
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 480:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2603
+//line sql.y:2639
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 481:
+	case 487:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2609
+//line sql.y:2645
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2613
+//line sql.y:2649
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2617
+//line sql.y:2653
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 490:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2623
+//line sql.y:2659
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2627
+//line sql.y:2663
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 492:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2633
+//line sql.y:2669
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 487:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2637
+//line sql.y:2673
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 488:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2641
+//line sql.y:2677
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 489:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2647
+//line sql.y:2683
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2651
+//line sql.y:2687
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2657
+//line sql.y:2693
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2661
+//line sql.y:2697
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2667
+//line sql.y:2703
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2673
+//line sql.y:2709
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 501:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2677
+//line sql.y:2713
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 502:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2682
+//line sql.y:2718
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 497:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2684
+//line sql.y:2720
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 498:
+	case 504:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2687
+//line sql.y:2723
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 499:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2689
+//line sql.y:2725
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 500:
+	case 506:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2694
+//line sql.y:2730
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2700
+//line sql.y:2736
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 508:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2705
+//line sql.y:2741
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2709
+//line sql.y:2745
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2713
+//line sql.y:2749
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 511:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2717
+//line sql.y:2753
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2721
+//line sql.y:2757
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2725
+//line sql.y:2761
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2731
+//line sql.y:2767
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 509:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2735
+//line sql.y:2771
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 510:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2739
+//line sql.y:2775
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 511:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2745
+//line sql.y:2781
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2749
+//line sql.y:2785
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2753
+//line sql.y:2789
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2757
+//line sql.y:2793
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2762
+//line sql.y:2798
 		{
 			yyVAL.str = ""
 		}
-	case 516:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2766
+//line sql.y:2802
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 517:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2770
+//line sql.y:2806
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 518:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2776
+//line sql.y:2812
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2780
+//line sql.y:2816
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2786
+//line sql.y:2822
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2790
+//line sql.y:2826
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 528:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2796
+//line sql.y:2832
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:2802
+//line sql.y:2838
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2806
+//line sql.y:2842
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 525:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:2812
+//line sql.y:2848
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2818
+//line sql.y:2854
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2822
+//line sql.y:2858
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2826
+//line sql.y:2862
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2830
+//line sql.y:2866
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 536:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2836
+//line sql.y:2872
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2842
+//line sql.y:2878
 		{
 			yyLOCAL = &RevertMigration{UUID: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 538:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2848
+//line sql.y:2884
+		{
+			id, err := strconv.ParseUint(yyDollar[3].str, 10, 64)
+			if err != nil {
+				yylex.Error("invalid processlist id")
+				return 1
+			}
+			yyLOCAL = &Kill{Type: yyDollar[2].killTypeUnion(), ProcesslistID: id}
+		}
+		yyVAL.union = yyLOCAL
+	case 539:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL KillType
+//line sql.y:2895
+		{
+			yyLOCAL = ConnectionType
+		}
+		yyVAL.union = yyLOCAL
+	case 540:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL KillType
+//line sql.y:2899
+		{
+			yyLOCAL = ConnectionType
+		}
+		yyVAL.union = yyLOCAL
+	case 541:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL KillType
+//line sql.y:2903
+		{
+			yyLOCAL = QueryType
+		}
+		yyVAL.union = yyLOCAL
+	case 542:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2909
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2852
+//line sql.y:2913
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 544:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2856
+//line sql.y:2917
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 545:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2860
+//line sql.y:2921
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 546:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2864
+//line sql.y:2925
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 547:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2868
+//line sql.y:2929
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2874
+//line sql.y:2935
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 539:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2878
+//line sql.y:2939
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 540:
+	case 550:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2884
+//line sql.y:2945
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 541:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2888
+//line sql.y:2949
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 542:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2892
+//line sql.y:2953
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 543:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2896
+//line sql.y:2957
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 544:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2900
+//line sql.y:2961
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 545:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2904
+//line sql.y:2965
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 546:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2908
+//line sql.y:2969
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 547:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2912
+//line sql.y:2973
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 548:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2916
+//line sql.y:2977
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 549:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2920
+//line sql.y:2981
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 550:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2924
+//line sql.y:2985
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 551:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2928
+//line sql.y:2989
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 552:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2933
+//line sql.y:2994
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2937
+//line sql.y:2998
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2941
+//line sql.y:3002
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 565:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2946
+//line sql.y:3007
 		{
 			yyVAL.str = ""
 		}
-	case 556:
+	case 566:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2950
+//line sql.y:3011
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 557:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2955
+//line sql.y:3016
 		{
 			setAllowComments(yylex, true)
 		}
-	case 558:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2959
+//line sql.y:3020
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 559:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2965
+//line sql.y:3026
 		{
 			yyVAL.strs = nil
 		}
-	case 560:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2969
+//line sql.y:3030
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 561:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2975
+//line sql.y:3036
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2979
+//line sql.y:3040
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2983
+//line sql.y:3044
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2988
+//line sql.y:3049
 		{
 			yyVAL.str = ""
 		}
-	case 565:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2992
+//line sql.y:3053
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 566:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2996
+//line sql.y:3057
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 567:
+	case 577:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3001
+//line sql.y:3062
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3005
+//line sql.y:3066
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3009
+//line sql.y:3070
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 580:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3014
+//line sql.y:3075
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3018
+//line sql.y:3079
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 582:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3023
+//line sql.y:3084
 		{
 			yyVAL.strs = nil
 		}
-	case 573:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3027
+//line sql.y:3088
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 574:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3031
+//line sql.y:3092
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 575:
+	case 585:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3035
+//line sql.y:3096
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 576:
+	case 586:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3039
+//line sql.y:3100
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 577:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3045
+//line sql.y:3106
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 578:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3049
+//line sql.y:3110
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 579:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3053
+//line sql.y:3114
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 580:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3057
+//line sql.y:3118
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 581:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3061
+//line sql.y:3122
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 582:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3065
+//line sql.y:3126
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 583:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3069
+//line sql.y:3130
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 584:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3075
+//line sql.y:3136
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3079
+//line sql.y:3140
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 586:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3085
+//line sql.y:3146
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 597:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3089
+//line sql.y:3150
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3093
+//line sql.y:3154
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 599:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3097
+//line sql.y:3158
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3102
+//line sql.y:3163
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 591:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3106
+//line sql.y:3167
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 592:
+	case 602:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3110
+//line sql.y:3171
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 594:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3117
+//line sql.y:3178
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 595:
+	case 605:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3122
+//line sql.y:3183
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 606:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3126
+//line sql.y:3187
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3132
+//line sql.y:3193
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3136
+//line sql.y:3197
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 601:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3146
+//line sql.y:3207
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3150
+//line sql.y:3211
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3154
+//line sql.y:3215
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3160
+//line sql.y:3221
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3166
+//line sql.y:3227
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 616:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3170
+//line sql.y:3231
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3175
+//line sql.y:3236
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3179
+//line sql.y:3240
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3185
+//line sql.y:3246
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3189
+//line sql.y:3250
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 611:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3195
+//line sql.y:3256
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3199
+//line sql.y:3260
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 613:
+	case 623:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3212
+//line sql.y:3273
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 624:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3216
+//line sql.y:3277
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 625:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3220
+//line sql.y:3281
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3224
+//line sql.y:3285
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3230
+//line sql.y:3291
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 618:
+	case 628:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3232
+//line sql.y:3293
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 619:
+	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3236
+//line sql.y:3297
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 620:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3238
+//line sql.y:3299
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 621:
+	case 631:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3242
+//line sql.y:3303
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 622:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3244
+//line sql.y:3305
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 623:
+	case 633:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3247
+//line sql.y:3308
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 624:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3249
+//line sql.y:3310
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 625:
+	case 635:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3252
+//line sql.y:3313
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 626:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3256
+//line sql.y:3317
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 627:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3260
+//line sql.y:3321
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 629:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3267
+//line sql.y:3328
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 630:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3273
+//line sql.y:3334
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3277
+//line sql.y:3338
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 642:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3281
+//line sql.y:3342
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3287
+//line sql.y:3348
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3293
+//line sql.y:3354
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3297
+//line sql.y:3358
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 646:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3301
+//line sql.y:3362
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3305
+//line sql.y:3366
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 648:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3311
+//line sql.y:3372
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 649:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3315
+//line sql.y:3376
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -10297,668 +10432,668 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 650:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3325
+//line sql.y:3386
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 641:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3329
+//line sql.y:3390
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 642:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3335
+//line sql.y:3396
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 643:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3339
+//line sql.y:3400
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 644:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3345
+//line sql.y:3406
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 645:
+	case 655:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3350
+//line sql.y:3411
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 656:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3354
+//line sql.y:3415
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 657:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3358
+//line sql.y:3419
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 658:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3362
+//line sql.y:3423
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 659:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3366
+//line sql.y:3427
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 660:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3371
+//line sql.y:3432
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3375
+//line sql.y:3436
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3381
+//line sql.y:3442
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3385
+//line sql.y:3446
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3389
+//line sql.y:3450
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3393
+//line sql.y:3454
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 666:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3397
+//line sql.y:3458
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 667:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3401
+//line sql.y:3462
 		{
 			yyLOCAL = &IsExpr{Operator: yyDollar[3].isExprOperatorUnion(), Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3405
+//line sql.y:3466
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3409
+//line sql.y:3470
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 670:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3415
+//line sql.y:3476
 		{
 			yyVAL.str = ""
 		}
-	case 661:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3419
+//line sql.y:3480
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 662:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3425
+//line sql.y:3486
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3429
+//line sql.y:3490
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3435
+//line sql.y:3496
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3439
+//line sql.y:3500
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 676:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3443
+//line sql.y:3504
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 677:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3447
+//line sql.y:3508
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 678:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3451
+//line sql.y:3512
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3455
+//line sql.y:3516
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 680:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3459
+//line sql.y:3520
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 681:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3463
+//line sql.y:3524
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: BetweenOp, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 682:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3467
+//line sql.y:3528
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: NotBetweenOp, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3471
+//line sql.y:3532
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3477
+//line sql.y:3538
 		{
 			yyLOCAL = IsNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3481
+//line sql.y:3542
 		{
 			yyLOCAL = IsNotNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3485
+//line sql.y:3546
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 687:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3489
+//line sql.y:3550
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3493
+//line sql.y:3554
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 689:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3497
+//line sql.y:3558
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3503
+//line sql.y:3564
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3507
+//line sql.y:3568
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3511
+//line sql.y:3572
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3515
+//line sql.y:3576
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3519
+//line sql.y:3580
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3523
+//line sql.y:3584
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3527
+//line sql.y:3588
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 697:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3532
+//line sql.y:3593
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3536
+//line sql.y:3597
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3542
+//line sql.y:3603
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3546
+//line sql.y:3607
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3550
+//line sql.y:3611
 		{
 			yyLOCAL = ListArg(yyDollar[1].str)
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 702:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:3557
+//line sql.y:3618
 		{
 			yyLOCAL = &Subquery{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:3563
+//line sql.y:3624
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3567
+//line sql.y:3628
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 695:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3573
+//line sql.y:3634
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3577
+//line sql.y:3638
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3581
+//line sql.y:3642
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3585
+//line sql.y:3646
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3589
+//line sql.y:3650
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3593
+//line sql.y:3654
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 711:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3597
+//line sql.y:3658
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3601
+//line sql.y:3662
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3605
+//line sql.y:3666
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3609
+//line sql.y:3670
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 715:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3613
+//line sql.y:3674
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3617
+//line sql.y:3678
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 717:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3621
+//line sql.y:3682
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3625
+//line sql.y:3686
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3629
+//line sql.y:3690
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 720:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3633
+//line sql.y:3694
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3637
+//line sql.y:3698
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 722:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3641
+//line sql.y:3702
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 723:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3645
+//line sql.y:3706
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 724:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3649
+//line sql.y:3710
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3653
+//line sql.y:3714
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3657
+//line sql.y:3718
 		{
 			yyLOCAL = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3661
+//line sql.y:3722
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3665
+//line sql.y:3726
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 729:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3669
+//line sql.y:3730
 		{
 			yyLOCAL = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3673
+//line sql.y:3734
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3677
+//line sql.y:3738
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3681
+//line sql.y:3742
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 733:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3685
+//line sql.y:3746
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3689
+//line sql.y:3750
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -10967,666 +11102,666 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 739:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3707
+//line sql.y:3768
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 740:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3711
+//line sql.y:3772
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 741:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3715
+//line sql.y:3776
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 742:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3719
+//line sql.y:3780
 		{
 			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 743:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3729
+//line sql.y:3790
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 744:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3733
+//line sql.y:3794
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 745:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3737
+//line sql.y:3798
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 746:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3741
+//line sql.y:3802
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 747:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3745
+//line sql.y:3806
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 748:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3749
+//line sql.y:3810
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 749:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3753
+//line sql.y:3814
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 750:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3757
+//line sql.y:3818
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 751:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3761
+//line sql.y:3822
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 752:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3765
+//line sql.y:3826
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 753:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3769
+//line sql.y:3830
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 754:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3773
+//line sql.y:3834
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 755:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3777
+//line sql.y:3838
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 756:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3781
+//line sql.y:3842
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 757:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3791
+//line sql.y:3852
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3795
+//line sql.y:3856
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3799
+//line sql.y:3860
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3804
+//line sql.y:3865
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 761:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3809
+//line sql.y:3870
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtime")}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3814
+//line sql.y:3875
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 763:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3820
+//line sql.y:3881
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3825
+//line sql.y:3886
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 765:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3830
+//line sql.y:3891
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 766:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3834
+//line sql.y:3895
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 767:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3838
+//line sql.y:3899
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 768:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3843
+//line sql.y:3904
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 769:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3848
+//line sql.y:3909
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 770:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3853
+//line sql.y:3914
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 771:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3857
+//line sql.y:3918
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 772:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3861
+//line sql.y:3922
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 775:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3871
+//line sql.y:3932
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 776:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3881
+//line sql.y:3942
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 777:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3885
+//line sql.y:3946
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 778:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3889
+//line sql.y:3950
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 779:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3893
+//line sql.y:3954
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 780:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3897
+//line sql.y:3958
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 781:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3901
+//line sql.y:3962
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 782:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3905
+//line sql.y:3966
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 783:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3911
+//line sql.y:3972
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 784:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3915
+//line sql.y:3976
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 785:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3919
+//line sql.y:3980
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 786:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3923
+//line sql.y:3984
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 787:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3927
+//line sql.y:3988
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3933
+//line sql.y:3994
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 779:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3937
+//line sql.y:3998
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 780:
+	case 790:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3941
+//line sql.y:4002
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 781:
+	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3947
+//line sql.y:4008
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 792:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3951
+//line sql.y:4012
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 793:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3955
+//line sql.y:4016
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 794:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3959
+//line sql.y:4020
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3963
+//line sql.y:4024
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3967
+//line sql.y:4028
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 797:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3973
+//line sql.y:4034
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3977
+//line sql.y:4038
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3981
+//line sql.y:4042
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 800:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3985
+//line sql.y:4046
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 801:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3989
+//line sql.y:4050
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 802:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3993
+//line sql.y:4054
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 803:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3997
+//line sql.y:4058
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 804:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4002
+//line sql.y:4063
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 805:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4006
+//line sql.y:4067
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 806:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4011
+//line sql.y:4072
 		{
 			yyVAL.str = string("")
 		}
-	case 797:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4015
+//line sql.y:4076
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 798:
+	case 808:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4021
+//line sql.y:4082
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 809:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4025
+//line sql.y:4086
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 800:
+	case 810:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4031
+//line sql.y:4092
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 811:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4036
+//line sql.y:4097
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4040
+//line sql.y:4101
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 813:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4046
+//line sql.y:4107
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 814:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4050
+//line sql.y:4111
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 815:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4054
+//line sql.y:4115
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 816:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4060
+//line sql.y:4121
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 817:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4064
+//line sql.y:4125
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 818:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4068
+//line sql.y:4129
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 819:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4072
+//line sql.y:4133
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 820:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4076
+//line sql.y:4137
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 821:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4080
+//line sql.y:4141
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 822:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4084
+//line sql.y:4145
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str)
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 823:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4089
+//line sql.y:4150
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 824:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4095
+//line sql.y:4156
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -11636,707 +11771,707 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 825:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4104
+//line sql.y:4165
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 826:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4108
+//line sql.y:4169
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str)
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 827:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4114
+//line sql.y:4175
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 828:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4118
+//line sql.y:4179
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 829:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4123
+//line sql.y:4184
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 830:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4127
+//line sql.y:4188
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 831:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4132
+//line sql.y:4193
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 832:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4136
+//line sql.y:4197
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 833:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4142
+//line sql.y:4203
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 834:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4146
+//line sql.y:4207
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 825:
+	case 835:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4152
+//line sql.y:4213
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 836:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4157
+//line sql.y:4218
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4161
+//line sql.y:4222
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 838:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4165
+//line sql.y:4226
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 839:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4170
+//line sql.y:4231
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 840:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4174
+//line sql.y:4235
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 841:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4178
+//line sql.y:4239
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 842:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4182
+//line sql.y:4243
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 843:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4187
+//line sql.y:4248
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 844:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4191
+//line sql.y:4252
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 845:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4195
+//line sql.y:4256
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4199
+//line sql.y:4260
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 847:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4203
+//line sql.y:4264
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 848:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4210
+//line sql.y:4271
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 849:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4214
+//line sql.y:4275
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 850:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4218
+//line sql.y:4279
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 851:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4222
+//line sql.y:4283
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 852:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4228
+//line sql.y:4289
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4232
+//line sql.y:4293
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 854:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4236
+//line sql.y:4297
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 855:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4241
+//line sql.y:4302
 		{
 			yyVAL.str = ""
 		}
-	case 846:
+	case 856:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4245
+//line sql.y:4306
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 847:
+	case 857:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4249
+//line sql.y:4310
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 848:
+	case 858:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4253
+//line sql.y:4314
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 849:
+	case 859:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4258
+//line sql.y:4319
 		{
 			yyVAL.str = ""
 		}
-	case 850:
+	case 860:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4262
+//line sql.y:4323
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 851:
+	case 861:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4268
+//line sql.y:4329
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 852:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4272
+//line sql.y:4333
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 853:
+	case 863:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4277
+//line sql.y:4338
 		{
 			yyVAL.str = ""
 		}
-	case 854:
+	case 864:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4281
+//line sql.y:4342
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 855:
+	case 865:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4286
+//line sql.y:4347
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 856:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4290
+//line sql.y:4351
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 857:
+	case 867:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4294
+//line sql.y:4355
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 858:
+	case 868:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4299
+//line sql.y:4360
 		{
 			yyVAL.str = ""
 		}
-	case 859:
+	case 869:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4303
+//line sql.y:4364
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 860:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4309
+//line sql.y:4370
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 861:
+	case 871:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4313
+//line sql.y:4374
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 862:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4317
+//line sql.y:4378
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 863:
+	case 873:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4321
+//line sql.y:4382
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 864:
+	case 874:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4326
+//line sql.y:4387
 		{
 			yyLOCAL = NoLock
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 875:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4330
+//line sql.y:4391
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 876:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4334
+//line sql.y:4395
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 877:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4339
+//line sql.y:4400
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 878:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4343
+//line sql.y:4404
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 879:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4347
+//line sql.y:4408
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 880:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4351
+//line sql.y:4412
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 881:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4356
+//line sql.y:4417
 		{
 			yyVAL.str = ""
 		}
-	case 872:
+	case 882:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4360
+//line sql.y:4421
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 873:
+	case 883:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4364
+//line sql.y:4425
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 874:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4369
+//line sql.y:4430
 		{
 			yyVAL.str = ""
 		}
-	case 875:
+	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4373
+//line sql.y:4434
 		{
 			yyVAL.str = " header"
 		}
-	case 876:
+	case 886:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4378
+//line sql.y:4439
 		{
 			yyVAL.str = ""
 		}
-	case 877:
+	case 887:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4382
+//line sql.y:4443
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 878:
+	case 888:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4386
+//line sql.y:4447
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 879:
+	case 889:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4391
+//line sql.y:4452
 		{
 			yyVAL.str = ""
 		}
-	case 880:
+	case 890:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4395
+//line sql.y:4456
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 881:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4399
+//line sql.y:4460
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 882:
+	case 892:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4405
+//line sql.y:4466
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 883:
+	case 893:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4410
+//line sql.y:4471
 		{
 			yyVAL.str = ""
 		}
-	case 884:
+	case 894:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4414
+//line sql.y:4475
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 885:
+	case 895:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4420
+//line sql.y:4481
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 886:
+	case 896:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4424
+//line sql.y:4485
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 887:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4430
+//line sql.y:4491
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 888:
+	case 898:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4434
+//line sql.y:4495
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 889:
+	case 899:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4439
+//line sql.y:4500
 		{
 			yyVAL.str = ""
 		}
-	case 890:
+	case 900:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4443
+//line sql.y:4504
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 891:
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4449
+//line sql.y:4510
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 892:
+	case 902:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4453
+//line sql.y:4514
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 893:
+	case 903:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4459
+//line sql.y:4520
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 894:
+	case 904:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4463
+//line sql.y:4524
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 895:
+	case 905:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4467
+//line sql.y:4528
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 896:
+	case 906:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4472
+//line sql.y:4533
 		{
 			yyVAL.str = ""
 		}
-	case 897:
+	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4476
+//line sql.y:4537
 		{
 			yyVAL.str = " optionally"
 		}
-	case 898:
+	case 908:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4489
+//line sql.y:4550
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 909:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4493
+//line sql.y:4554
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 910:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4497
+//line sql.y:4558
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 911:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4501
+//line sql.y:4562
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 912:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4505
+//line sql.y:4566
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 913:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4511
+//line sql.y:4572
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 914:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4515
+//line sql.y:4576
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4519
+//line sql.y:4580
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 906:
+	case 916:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4523
+//line sql.y:4584
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 907:
+	case 917:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4528
+//line sql.y:4589
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 918:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4532
+//line sql.y:4593
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 919:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:4538
+//line sql.y:4599
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 920:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4542
+//line sql.y:4603
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 911:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4548
+//line sql.y:4609
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 922:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4552
+//line sql.y:4613
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 923:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4558
+//line sql.y:4619
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 924:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4564
+//line sql.y:4625
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -12345,329 +12480,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 925:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4574
+//line sql.y:4635
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4578
+//line sql.y:4639
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 917:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:4584
+//line sql.y:4645
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 928:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:4590
+//line sql.y:4651
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4594
+//line sql.y:4655
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 920:
+	case 930:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4600
+//line sql.y:4661
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 931:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4604
+//line sql.y:4665
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 932:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4608
+//line sql.y:4669
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 933:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4612
+//line sql.y:4673
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 934:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4616
+//line sql.y:4677
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 936:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4624
+//line sql.y:4685
 		{
 			yyVAL.str = "charset"
 		}
-	case 929:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4634
+//line sql.y:4695
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4638
+//line sql.y:4699
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4642
+//line sql.y:4703
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 944:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4651
+//line sql.y:4712
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4653
+//line sql.y:4714
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 946:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4656
+//line sql.y:4717
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 947:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4658
+//line sql.y:4719
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 948:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4661
+//line sql.y:4722
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 949:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4663
+//line sql.y:4724
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 950:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4666
+//line sql.y:4727
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4668
+//line sql.y:4729
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 952:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4671
+//line sql.y:4732
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 943:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4673
+//line sql.y:4734
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 944:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4675
+//line sql.y:4736
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 945:
+	case 955:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:4679
+//line sql.y:4740
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 956:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4684
+//line sql.y:4745
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4688
+//line sql.y:4749
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 958:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4693
+//line sql.y:4754
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4695
+//line sql.y:4756
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 960:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:4699
+//line sql.y:4760
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4705
+//line sql.y:4766
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 952:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4709
+//line sql.y:4770
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 954:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4716
+//line sql.y:4777
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 955:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4722
+//line sql.y:4783
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 956:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4726
+//line sql.y:4787
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 957:
+	case 967:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4731
+//line sql.y:4792
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 958:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4735
+//line sql.y:4796
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 960:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4742
+//line sql.y:4803
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1364:
+	case 1378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5170
+//line sql.y:5235
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1365:
+	case 1379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5179
+//line sql.y:5244
 		{
 			decNesting(yylex)
 		}
-	case 1366:
+	case 1380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5184
+//line sql.y:5249
 		{
 			skipToEnd(yylex)
 		}
-	case 1367:
+	case 1381:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5189
+//line sql.y:5254
 		{
 			skipToEnd(yylex)
 		}
-	case 1368:
+	case 1382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5193
+//line sql.y:5258
 		{
 			skipToEnd(yylex)
 		}
-	case 1369:
+	case 1383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5197
+//line sql.y:5262
 		{
 			skipToEnd(yylex)
 		}
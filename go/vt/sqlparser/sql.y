@@ -17,6 +17,8 @@ limitations under the License.
 %{
 package sqlparser
 
+import "strconv"
+
 func setParseTree(yylex yyLexer, stmt Statement) {
   yylex.(*Tokenizer).ParseTree = stmt
 }
@@ -153,6 +155,7 @@ func bindVariable(yylex yyLexer, bvar string) {
   orderDirection  OrderDirection
   explainType 	  ExplainType
   lockType LockType
+  killType KillType
 
   boolean bool
   boolVal BoolVal
@@ -207,6 +210,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 // DDL Tokens
 %token <str> CREATE ALTER DROP RENAME ANALYZE ADD FLUSH CHANGE MODIFY
 %token <str> REVERT
+%token <str> KILL
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF UNIQUE PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK INDEXES
 %token <str> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE COALESCE EXCHANGE REBUILD PARTITIONING REMOVE
@@ -217,6 +221,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 
 // Migration tokens
 %token <str> VITESS_MIGRATION CANCEL RETRY COMPLETE
+%token <str> VITESS_DEAD_LETTERS REQUEUE PURGE
 
 // Transaction Tokens
 %token <str> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE WORK
@@ -235,7 +240,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 
 // SHOW tokens
 %token <str> COLLATION DATABASES SCHEMAS TABLES VITESS_METADATA VSCHEMA FULL PROCESSLIST COLUMNS FIELDS ENGINES PLUGINS EXTENDED
-%token <str> KEYSPACES VITESS_KEYSPACES VITESS_SHARDS VITESS_TABLETS VITESS_MIGRATIONS CODE PRIVILEGES FUNCTION OPEN TRIGGERS EVENT USER
+%token <str> KEYSPACES VITESS_KEYSPACES VITESS_SHARDS VITESS_TABLETS VITESS_MIGRATIONS VITESS_PLANS CODE PRIVILEGES FUNCTION OPEN TRIGGERS EVENT USER
 
 // SET tokens
 %token <str> NAMES CHARSET GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
@@ -295,6 +300,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
 %type <statement> lock_statement unlock_statement call_statement
 %type <statement> revert_statement
+%type <statement> kill_statement
 %type <strs> comment_opt comment_list
 %type <str> wild_opt check_option_opt cascade_or_local_opt restrict_or_cascade_opt
 %type <explainType> explain_format_opt
@@ -412,6 +418,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <tableAndLockTypes> lock_table_list
 %type <tableAndLockType> lock_table
 %type <lockType> lock_type
+%type <killType> kill_type_opt
 %type <empty> session_or_local_opt
 
 
@@ -463,6 +470,7 @@ command:
 | unlock_statement
 | call_statement
 | revert_statement
+| kill_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
@@ -562,6 +570,10 @@ stream_statement:
   {
     $$ = &Stream{Comments: Comments($2), SelectExpr: $3, Table: $5}
   }
+| STREAM comment_opt select_expression FROM table_name GROUP sql_id
+  {
+    $$ = &Stream{Comments: Comments($2), SelectExpr: $3, Table: $5, Group: $7}
+  }
 
 vstream_statement:
   VSTREAM comment_opt select_expression FROM table_name where_expression_opt limit_opt
@@ -2181,6 +2193,22 @@ alter_statement:
       Type: CancelAllMigrationType,
     }
   }
+| ALTER VITESS_DEAD_LETTERS table_name REQUEUE where_expression_opt
+  {
+    $$ = &AlterDeadLetters{
+      Type: RequeueDeadLettersType,
+      Table: $3,
+      Where: NewWhere(WhereClause, $5),
+    }
+  }
+| ALTER VITESS_DEAD_LETTERS table_name PURGE where_expression_opt
+  {
+    $$ = &AlterDeadLetters{
+      Type: PurgeDeadLettersType,
+      Table: $3,
+      Where: NewWhere(WhereClause, $5),
+    }
+  }
 
 partition_operation:
   ADD PARTITION '(' partition_definition ')'
@@ -2495,7 +2523,7 @@ show_statement:
   }
 | SHOW full_opt PROCESSLIST from_database_opt like_or_where_opt
   {
-      $$ = &Show{&ShowLegacy{Type: string($3), Scope: ImplicitScope}}
+    $$ = &Show{&ShowBasic{Command: Processlist, Full: $2, Filter: $5}}
   }
 | SHOW VITESS_METADATA VARIABLES like_opt
   {
@@ -2506,6 +2534,14 @@ show_statement:
   {
     $$ = &Show{&ShowBasic{Command: VitessMigrations, Filter: $4, DbName: $3}}
   }
+| SHOW VITESS_PLANS like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Command: VitessPlans, Filter: $3}}
+  }
+| SHOW VITESS_DEAD_LETTERS FROM table_name like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Command: VitessDeadLetters, Tbl: $4, Filter: $5}}
+  }
 | SHOW VSCHEMA TABLES
   {
     $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), Scope: ImplicitScope}}
@@ -2843,6 +2879,31 @@ revert_statement:
     $$ = &RevertMigration{UUID: string($3)}
   }
 
+kill_statement:
+  KILL kill_type_opt INTEGRAL
+  {
+    id, err := strconv.ParseUint($3, 10, 64)
+    if err != nil {
+      yylex.Error("invalid processlist id")
+      return 1
+    }
+    $$ = &Kill{Type: $2, ProcesslistID: id}
+  }
+
+kill_type_opt:
+  /* empty */
+  {
+    $$ = ConnectionType
+  }
+| CONNECTION
+  {
+    $$ = ConnectionType
+  }
+| QUERY
+  {
+    $$ = QueryType
+  }
+
 flush_statement:
   FLUSH local_opt flush_option_list
   {
@@ -5069,6 +5130,7 @@ non_reserved_keyword:
 | POLYGON
 | PROCEDURE
 | PROCESSLIST
+| PURGE
 | QUERY
 | RANDOM
 | REAL
@@ -5081,6 +5143,7 @@ non_reserved_keyword:
 | REORGANIZE
 | REPAIR
 | REPEATABLE
+| REQUEUE
 | RESTRICT
 | REQUIRE_ROW_FORMAT
 | RESOURCE
@@ -5159,6 +5222,8 @@ non_reserved_keyword:
 | VITESS_TABLETS
 | VITESS_MIGRATION
 | VITESS_MIGRATIONS
+| VITESS_PLANS
+| VITESS_DEAD_LETTERS
 | VSCHEMA
 | WARNINGS
 | WITHOUT
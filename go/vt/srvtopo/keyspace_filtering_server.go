@@ -115,3 +115,21 @@ func (ksf keyspaceFilteringServer) WatchSrvVSchema(
 
 	ksf.server.WatchSrvVSchema(ctx, cell, filteringCallback)
 }
+
+func (ksf keyspaceFilteringServer) WatchSrvTenantMappings(
+	ctx context.Context,
+	cell string,
+	callback func(topo.TenantMappings, error),
+) {
+	filteringCallback := func(mappings topo.TenantMappings, err error) {
+		for ks := range mappings {
+			if !ksf.selectKeyspaces[ks] {
+				delete(mappings, ks)
+			}
+		}
+
+		callback(mappings, err)
+	}
+
+	ksf.server.WatchSrvTenantMappings(ctx, cell, filteringCallback)
+}
@@ -583,6 +583,60 @@ func (server *ResilientServer) WatchSrvVSchema(ctx context.Context, cell string,
 	wg.Wait()
 }
 
+var watchSrvTenantMappingsSleepTime = 5 * time.Second
+
+// WatchSrvTenantMappings is part of the srvtopo.Server interface.
+func (server *ResilientServer) WatchSrvTenantMappings(ctx context.Context, cell string, callback func(topo.TenantMappings, error)) {
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				log.Errorf("WatchSrvTenantMappings uncaught panic, cell :%v, err :%v)", cell, err)
+			}
+		}()
+
+		foundFirstValue := false
+
+		for {
+			current, changes, _ := server.topoServer.WatchSrvTenantMappings(ctx, cell)
+			callback(current.Value, current.Err)
+			if !foundFirstValue {
+				foundFirstValue = true
+				wg.Done()
+			}
+			if current.Err != nil {
+				// Don't log if there are no tenant mappings to start with.
+				if !topo.IsErrType(current.Err, topo.NoNode) {
+					log.Warningf("Error watching tenant mappings for cell %s (will wait 5s before retrying): %v", cell, current.Err)
+				}
+			} else {
+				for c := range changes {
+					// Note we forward topo.ErrNoNode as is.
+					callback(c.Value, c.Err)
+					if c.Err != nil {
+						if !topo.IsErrType(c.Err, topo.Interrupted) {
+							log.Warningf("Error while watching tenant mappings for cell %s (will wait 5s before retrying): %v", cell, c.Err)
+						}
+						break
+					}
+				}
+			}
+
+			// Sleep a bit before trying again.
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchSrvTenantMappingsSleepTime):
+			}
+		}
+	}()
+
+	// Wait for the first value to have been processed.
+	wg.Wait()
+}
+
 // The next few structures and methods are used to get a displayable
 // version of the cache in a status page.
 
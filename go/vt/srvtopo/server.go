@@ -46,4 +46,9 @@ type Server interface {
 	// the provided cell.  It will call the callback when
 	// a new value or an error occurs.
 	WatchSrvVSchema(ctx context.Context, cell string, callback func(*vschemapb.SrvVSchema, error))
+
+	// WatchSrvTenantMappings starts watching the tenant mappings of
+	// the provided cell. It will call the callback when a new value
+	// or an error occurs, until ctx is done.
+	WatchSrvTenantMappings(ctx context.Context, cell string, callback func(topo.TenantMappings, error))
 }
@@ -37,6 +37,9 @@ type PassthroughSrvTopoServer struct {
 
 	WatchedSrvVSchema      *vschemapb.SrvVSchema
 	WatchedSrvVSchemaError error
+
+	WatchedSrvTenantMappings      topo.TenantMappings
+	WatchedSrvTenantMappingsError error
 }
 
 // NewPassthroughSrvTopoServer returns a new, unconfigured test PassthroughSrvTopoServer
@@ -63,3 +66,8 @@ func (srv *PassthroughSrvTopoServer) GetSrvKeyspace(ctx context.Context, cell, k
 func (srv *PassthroughSrvTopoServer) WatchSrvVSchema(ctx context.Context, cell string, callback func(*vschemapb.SrvVSchema, error)) {
 	callback(srv.WatchedSrvVSchema, srv.WatchedSrvVSchemaError)
 }
+
+// WatchSrvTenantMappings implements srvtopo.Server
+func (srv *PassthroughSrvTopoServer) WatchSrvTenantMappings(ctx context.Context, cell string, callback func(topo.TenantMappings, error)) {
+	callback(srv.WatchedSrvTenantMappings, srv.WatchedSrvTenantMappingsError)
+}
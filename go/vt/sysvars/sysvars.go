@@ -56,6 +56,7 @@ var (
 	TransactionReadOnly         = SystemVariable{Name: "transaction_read_only", IsBoolean: true, Default: off}
 	TxReadOnly                  = SystemVariable{Name: "tx_read_only", IsBoolean: true, Default: off}
 	Workload                    = SystemVariable{Name: "workload", IdentifierAsString: true}
+	Priority                    = SystemVariable{Name: "priority", IdentifierAsString: true}
 
 	// Online DDL
 	DDLStrategy    = SystemVariable{Name: "ddl_strategy", IdentifierAsString: true}
@@ -77,6 +78,7 @@ var (
 		TransactionMode,
 		DDLStrategy,
 		Workload,
+		Priority,
 		Charset,
 		Names,
 		SessionUUID,
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file contains the utility methods to manage the plan pins, which
+// force the plan vtgate uses for some queries.
+
+// PlanPin is what is pinned for a query: the planner version to plan it
+// with, a variant of the query to plan in its place, or both.
+type PlanPin struct {
+	// Planner is the planner version, empty to keep the one of the session.
+	Planner string `json:"planner,omitempty"`
+	// Variant is an equivalent query, with the same literals in the same
+	// order, planned in place of the pinned one. It is typically the same
+	// query with index hints or its joins reordered.
+	Variant string `json:"variant,omitempty"`
+	// Keyspace is the keyspace targeted by the sessions the query is
+	// pinned for, empty for the sessions that target no keyspace.
+	Keyspace string `json:"keyspace,omitempty"`
+}
+
+// PlanPinKey returns the key of the pin of a query for the sessions that
+// target a keyspace, in the map of the plan pins.
+func PlanPinKey(keyspace, query string) string {
+	if keyspace == "" {
+		return query
+	}
+	return keyspace + ":" + query
+}
+
+// PlanPinQuery returns the query of a pin from its key.
+func PlanPinQuery(key string, pin PlanPin) string {
+	if pin.Keyspace == "" {
+		return key
+	}
+	return strings.TrimPrefix(key, pin.Keyspace+":")
+}
+
+// WatchPlanPinsData is returned / streamed by WatchPlanPins.
+// The WatchPlanPins API guarantees exactly one of Value or Err will be set.
+type WatchPlanPinsData struct {
+	Value map[string]PlanPin
+	Err   error
+}
+
+// WatchPlanPins will set a watch on the plan pins.
+// It has the same contract as Conn.Watch, but it also unpacks the
+// contents into a map of query to pin.
+func (ts *Server) WatchPlanPins(ctx context.Context) (*WatchPlanPinsData, <-chan *WatchPlanPinsData, CancelFunc) {
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, PlanPinsFile)
+	if current.Err != nil {
+		return &WatchPlanPinsData{Err: current.Err}, nil, nil
+	}
+	value, err := parsePlanPins(current.Contents)
+	if err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchPlanPinsData{Err: err}, nil, nil
+	}
+
+	changes := make(chan *WatchPlanPinsData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				changes <- &WatchPlanPinsData{Err: wd.Err}
+				return
+			}
+
+			value, err := parsePlanPins(wd.Contents)
+			if err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchPlanPinsData{Err: err}
+				return
+			}
+			changes <- &WatchPlanPinsData{Value: value}
+		}
+	}()
+
+	return &WatchPlanPinsData{Value: value}, changes, cancel
+}
+
+// GetPlanPins returns the plans pinned for queries, by query.
+func (ts *Server) GetPlanPins(ctx context.Context) (map[string]PlanPin, error) {
+	data, _, err := ts.globalCell.Get(ctx, PlanPinsFile)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return map[string]PlanPin{}, nil
+		}
+		return nil, err
+	}
+	return parsePlanPins(data)
+}
+
+// SavePlanPins saves the plans pinned for queries into the topo. The
+// file is removed if there are no pins.
+func (ts *Server) SavePlanPins(ctx context.Context, pins map[string]PlanPin) error {
+	if len(pins) == 0 {
+		if err := ts.globalCell.Delete(ctx, PlanPinsFile, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(pins, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Update(ctx, PlanPinsFile, data, nil)
+	return err
+}
+
+func parsePlanPins(data []byte) (map[string]PlanPin, error) {
+	pins := map[string]PlanPin{}
+	if err := json.Unmarshal(data, &pins); err != nil {
+		return nil, vterrors.Wrapf(err, "bad plan pins data: %q", data)
+	}
+	return pins, nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"time"
+
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file contains the utility methods to manage the query quarantine
+// of a shard: the query fingerprints its tablets refuse to run for a while,
+// because they used too many resources.
+
+// QuarantinedQuery describes a quarantined query fingerprint.
+type QuarantinedQuery struct {
+	// Query is a sample of the queries with the fingerprint.
+	Query string `json:"query,omitempty"`
+	// Reason is the threshold the queries exceeded.
+	Reason string `json:"reason,omitempty"`
+	// ExpireTime is when the quarantine ends.
+	ExpireTime time.Time `json:"expire_time"`
+}
+
+// WatchQueryQuarantineData is returned / streamed by WatchQueryQuarantine.
+// The WatchQueryQuarantine API guarantees exactly one of Value or Err will be set.
+type WatchQueryQuarantineData struct {
+	Value map[string]*QuarantinedQuery
+	Err   error
+}
+
+// WatchQueryQuarantine will set a watch on the query quarantine of a shard.
+// It has the same contract as Conn.Watch, but it also unpacks the
+// contents into a map of fingerprint to QuarantinedQuery.
+func (ts *Server) WatchQueryQuarantine(ctx context.Context, keyspace, shard string) (*WatchQueryQuarantineData, <-chan *WatchQueryQuarantineData, CancelFunc) {
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, queryQuarantineFilePath(keyspace, shard))
+	if current.Err != nil {
+		return &WatchQueryQuarantineData{Err: current.Err}, nil, nil
+	}
+	value, err := parseQueryQuarantine(current.Contents)
+	if err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchQueryQuarantineData{Err: err}, nil, nil
+	}
+
+	changes := make(chan *WatchQueryQuarantineData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				changes <- &WatchQueryQuarantineData{Err: wd.Err}
+				return
+			}
+
+			value, err := parseQueryQuarantine(wd.Contents)
+			if err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchQueryQuarantineData{Err: err}
+				return
+			}
+			changes <- &WatchQueryQuarantineData{Value: value}
+		}
+	}()
+
+	return &WatchQueryQuarantineData{Value: value}, changes, cancel
+}
+
+// GetQueryQuarantine returns the quarantined query fingerprints of a shard.
+func (ts *Server) GetQueryQuarantine(ctx context.Context, keyspace, shard string) (map[string]*QuarantinedQuery, error) {
+	data, _, err := ts.globalCell.Get(ctx, queryQuarantineFilePath(keyspace, shard))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return map[string]*QuarantinedQuery{}, nil
+		}
+		return nil, err
+	}
+	return parseQueryQuarantine(data)
+}
+
+// UpdateQueryQuarantine applies update to the quarantined query
+// fingerprints of a shard, and saves them. It retries if they were
+// concurrently modified. The file is removed if no fingerprint is left.
+// If update returns ErrNoUpdateNeeded, nothing is written.
+func (ts *Server) UpdateQueryQuarantine(ctx context.Context, keyspace, shard string, update func(map[string]*QuarantinedQuery) error) error {
+	filePath := queryQuarantineFilePath(keyspace, shard)
+	for {
+		quarantine := map[string]*QuarantinedQuery{}
+		data, version, err := ts.globalCell.Get(ctx, filePath)
+		switch {
+		case err == nil:
+			if quarantine, err = parseQueryQuarantine(data); err != nil {
+				return err
+			}
+		case !IsErrType(err, NoNode):
+			return err
+		}
+
+		if err := update(quarantine); err != nil {
+			if IsErrType(err, NoUpdateNeeded) {
+				return nil
+			}
+			return err
+		}
+
+		switch {
+		case len(quarantine) == 0 && version == nil:
+			return nil
+		case len(quarantine) == 0:
+			err = ts.globalCell.Delete(ctx, filePath, version)
+		default:
+			data, err = json.MarshalIndent(quarantine, "", "  ")
+			if err != nil {
+				return err
+			}
+			if version == nil {
+				_, err = ts.globalCell.Create(ctx, filePath, data)
+			} else {
+				_, err = ts.globalCell.Update(ctx, filePath, data, version)
+			}
+		}
+		if !IsErrType(err, BadVersion) && !IsErrType(err, NodeExists) && !IsErrType(err, NoNode) {
+			return err
+		}
+	}
+}
+
+func queryQuarantineFilePath(keyspace, shard string) string {
+	return path.Join(KeyspacesPath, keyspace, ShardsPath, shard, QueryQuarantineFile)
+}
+
+func parseQueryQuarantine(data []byte) (map[string]*QuarantinedQuery, error) {
+	quarantine := map[string]*QuarantinedQuery{}
+	if err := json.Unmarshal(data, &quarantine); err != nil {
+		return nil, vterrors.Wrapf(err, "bad query quarantine data: %q", data)
+	}
+	return quarantine, nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file contains the utility methods to manage the tenant mappings
+// of the numeric_topo_map vindexes. They are stored in their own file in
+// the global topo, so that moving a tenant doesn't rewrite the VSchema,
+// and are copied to the SrvTenantMappings file of every cell, where the
+// vtgates watch them.
+
+// TenantMapping maps tenant ids to keyspace ids.
+type TenantMapping map[uint64]uint64
+
+// TenantMappings are the tenant mappings, by keyspace and mapping name.
+type TenantMappings map[string]map[string]TenantMapping
+
+// WatchSrvTenantMappingsData is returned / streamed by WatchSrvTenantMappings.
+// The WatchSrvTenantMappings API guarantees exactly one of Value or Err will be set.
+type WatchSrvTenantMappingsData struct {
+	Value TenantMappings
+	Err   error
+}
+
+// WatchSrvTenantMappings will set a watch on the tenant mappings of a cell.
+// It has the same contract as Conn.Watch, but it also unpacks the
+// contents into the tenant mappings.
+func (ts *Server) WatchSrvTenantMappings(ctx context.Context, cell string) (*WatchSrvTenantMappingsData, <-chan *WatchSrvTenantMappingsData, CancelFunc) {
+	conn, err := ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return &WatchSrvTenantMappingsData{Err: err}, nil, nil
+	}
+
+	current, wdChannel, cancel := conn.Watch(ctx, SrvTenantMappingsFile)
+	if current.Err != nil {
+		return &WatchSrvTenantMappingsData{Err: current.Err}, nil, nil
+	}
+	value, err := parseTenantMappings(current.Contents)
+	if err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchSrvTenantMappingsData{Err: err}, nil, nil
+	}
+
+	changes := make(chan *WatchSrvTenantMappingsData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				changes <- &WatchSrvTenantMappingsData{Err: wd.Err}
+				return
+			}
+
+			value, err := parseTenantMappings(wd.Contents)
+			if err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchSrvTenantMappingsData{Err: err}
+				return
+			}
+			changes <- &WatchSrvTenantMappingsData{Value: value}
+		}
+	}()
+
+	return &WatchSrvTenantMappingsData{Value: value}, changes, cancel
+}
+
+// GetTenantMappings returns the tenant mappings of the global topo.
+func (ts *Server) GetTenantMappings(ctx context.Context) (TenantMappings, error) {
+	data, _, err := ts.globalCell.Get(ctx, TenantMappingsFile)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return TenantMappings{}, nil
+		}
+		return nil, err
+	}
+	return parseTenantMappings(data)
+}
+
+// UpdateTenantMapping maps tenantID to keyspaceID in the named mapping
+// of the keyspace, in the global topo. The tenant mappings are updated
+// with a compare-and-set, so that concurrent updates of different tenants
+// are not lost. RebuildSrvTenantMappings must be called for the cells to
+// see the change.
+func (ts *Server) UpdateTenantMapping(ctx context.Context, keyspace, name string, tenantID, keyspaceID uint64) error {
+	for {
+		mappings := TenantMappings{}
+		data, version, err := ts.globalCell.Get(ctx, TenantMappingsFile)
+		switch {
+		case IsErrType(err, NoNode):
+			version = nil
+		case err != nil:
+			return err
+		default:
+			if mappings, err = parseTenantMappings(data); err != nil {
+				return err
+			}
+		}
+		if mappings[keyspace] == nil {
+			mappings[keyspace] = map[string]TenantMapping{}
+		}
+		if mappings[keyspace][name] == nil {
+			mappings[keyspace][name] = TenantMapping{}
+		}
+		mappings[keyspace][name][tenantID] = keyspaceID
+
+		data, err = json.MarshalIndent(mappings, "", "  ")
+		if err != nil {
+			return err
+		}
+		if version == nil {
+			_, err = ts.globalCell.Create(ctx, TenantMappingsFile, data)
+		} else {
+			_, err = ts.globalCell.Update(ctx, TenantMappingsFile, data, version)
+		}
+		if IsErrType(err, BadVersion) || IsErrType(err, NodeExists) {
+			// Someone else updated the mappings, try again.
+			continue
+		}
+		return err
+	}
+}
+
+// RebuildSrvTenantMappings copies the tenant mappings of the global topo
+// to the provided cell list (or all cells if cell list is empty).
+func (ts *Server) RebuildSrvTenantMappings(ctx context.Context, cells []string) error {
+	if len(cells) == 0 {
+		var err error
+		cells, err = ts.GetKnownCells(ctx)
+		if err != nil {
+			return fmt.Errorf("GetKnownCells failed: %v", err)
+		}
+	}
+
+	data, _, err := ts.globalCell.Get(ctx, TenantMappingsFile)
+	if err != nil {
+		return err
+	}
+	for _, cell := range cells {
+		conn, err := ts.ConnForCell(ctx, cell)
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Update(ctx, SrvTenantMappingsFile, data, nil); err != nil {
+			return vterrors.Wrapf(err, "cannot update the tenant mappings of cell %s", cell)
+		}
+	}
+	return nil
+}
+
+func parseTenantMappings(data []byte) (TenantMappings, error) {
+	mappings := TenantMappings{}
+	if err := json.Unmarshal(data, &mappings); err != nil {
+		return nil, vterrors.Wrapf(err, "bad tenant mappings data: %q", data)
+	}
+	return mappings, nil
+}
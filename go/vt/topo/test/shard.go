@@ -17,7 +17,9 @@ limitations under the License.
 package test
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"context"
 
@@ -94,3 +96,53 @@ func checkShard(t *testing.T, ts *topo.Server) {
 		t.Errorf("GetShardNames(666): %v", err)
 	}
 }
+
+// checkQueryQuarantine verifies the query quarantine operations work correctly
+func checkQueryQuarantine(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	if got, err := ts.GetQueryQuarantine(ctx, "test_keyspace", "b0-c0"); err != nil || len(got) != 0 {
+		t.Fatalf("GetQueryQuarantine: %v %v, want empty", got, err)
+	}
+
+	expireTime := time.Unix(1000, 0).UTC()
+	want := map[string]*topo.QuarantinedQuery{
+		"f1": {Query: "select * from t1", Reason: "too slow", ExpireTime: expireTime},
+	}
+	if err := ts.UpdateQueryQuarantine(ctx, "test_keyspace", "b0-c0", func(quarantine map[string]*topo.QuarantinedQuery) error {
+		quarantine["f1"] = want["f1"]
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateQueryQuarantine: %v", err)
+	}
+	if got, err := ts.GetQueryQuarantine(ctx, "test_keyspace", "b0-c0"); err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("GetQueryQuarantine: %v %v, want %v", got, err, want)
+	}
+
+	want["f2"] = &topo.QuarantinedQuery{Query: "select * from t2", ExpireTime: expireTime}
+	if err := ts.UpdateQueryQuarantine(ctx, "test_keyspace", "b0-c0", func(quarantine map[string]*topo.QuarantinedQuery) error {
+		quarantine["f2"] = want["f2"]
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateQueryQuarantine: %v", err)
+	}
+	if got, err := ts.GetQueryQuarantine(ctx, "test_keyspace", "b0-c0"); err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("GetQueryQuarantine: %v %v, want %v", got, err, want)
+	}
+
+	if err := ts.UpdateQueryQuarantine(ctx, "test_keyspace", "b0-c0", func(quarantine map[string]*topo.QuarantinedQuery) error {
+		return topo.NewError(topo.NoUpdateNeeded, "b0-c0")
+	}); err != nil {
+		t.Fatalf("UpdateQueryQuarantine: %v", err)
+	}
+
+	if err := ts.UpdateQueryQuarantine(ctx, "test_keyspace", "b0-c0", func(quarantine map[string]*topo.QuarantinedQuery) error {
+		delete(quarantine, "f1")
+		delete(quarantine, "f2")
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateQueryQuarantine: %v", err)
+	}
+	if got, err := ts.GetQueryQuarantine(ctx, "test_keyspace", "b0-c0"); err != nil || len(got) != 0 {
+		t.Fatalf("GetQueryQuarantine: %v %v, want empty", got, err)
+	}
+}
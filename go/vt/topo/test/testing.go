@@ -56,6 +56,11 @@ func TopoServerTestSuite(t *testing.T, factory func() *topo.Server) {
 	checkShard(t, ts)
 	ts.Close()
 
+	t.Log("=== checkQueryQuarantine")
+	ts = factory()
+	checkQueryQuarantine(t, ts)
+	ts.Close()
+
 	t.Log("=== checkTablet")
 	ts = factory()
 	checkTablet(t, ts)
@@ -91,6 +96,11 @@ func TopoServerTestSuite(t *testing.T, factory func() *topo.Server) {
 	checkRoutingRules(t, ts)
 	ts.Close()
 
+	t.Log("=== checkPlanPins")
+	ts = factory()
+	checkPlanPins(t, ts)
+	ts.Close()
+
 	t.Log("=== checkElection")
 	ts = factory()
 	checkElection(t, ts)
@@ -115,3 +115,27 @@ func checkRoutingRules(t *testing.T, ts *topo.Server) {
 		t.Errorf("GetRoutingRules: %v, want %v", got, want)
 	}
 }
+
+// checkPlanPins runs the tests on the plan pins part of the API
+func checkPlanPins(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	got, err := ts.GetPlanPins(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	want := map[string]topo.PlanPin{
+		"select * from t1":                        {Planner: "gen4"},
+		"select * from t1 join t2":                {Variant: "select * from t1 straight_join t2"},
+		topo.PlanPinKey("ks", "select * from t1"): {Planner: "v3", Keyspace: "ks"},
+	}
+	require.NoError(t, ts.SavePlanPins(ctx, want))
+	got, err = ts.GetPlanPins(ctx)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	require.NoError(t, ts.SavePlanPins(ctx, nil))
+	got, err = ts.GetPlanPins(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
@@ -242,3 +242,97 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected vindex ddl operation %s", alterVschema.Action.ToString())
 }
+
+// ApplyViewDDL applies the given CREATE VIEW, ALTER VIEW or DROP VIEW
+// statement to the views of the vschema keyspace definition and returns
+// the modified keyspace object.
+func ApplyViewDDL(ksName string, ks *vschemapb.Keyspace, ddl sqlparser.DDLStatement) (*vschemapb.Keyspace, error) {
+	if ks == nil {
+		ks = new(vschemapb.Keyspace)
+	}
+
+	if ks.Views == nil {
+		ks.Views = map[string]string{}
+	}
+
+	switch ddl := ddl.(type) {
+	case *sqlparser.CreateView:
+		name := ddl.ViewName.Name.String()
+		if _, ok := ks.Views[name]; ok && !ddl.IsReplace {
+			return nil, vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "view %s already exists in keyspace %s", name, ksName)
+		}
+		view, err := viewDefinition(name, ddl.Select, ddl.Columns)
+		if err != nil {
+			return nil, err
+		}
+		ks.Views[name] = view
+
+		return ks, nil
+
+	case *sqlparser.AlterView:
+		name := ddl.ViewName.Name.String()
+		if _, ok := ks.Views[name]; !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "view %s does not exist in keyspace %s", name, ksName)
+		}
+		view, err := viewDefinition(name, ddl.Select, ddl.Columns)
+		if err != nil {
+			return nil, err
+		}
+		ks.Views[name] = view
+
+		return ks, nil
+
+	case *sqlparser.DropView:
+		for _, view := range ddl.FromTables {
+			name := view.Name.String()
+			if _, ok := ks.Views[name]; !ok {
+				if ddl.IfExists {
+					continue
+				}
+				return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "view %s does not exist in keyspace %s", name, ksName)
+			}
+			delete(ks.Views, name)
+		}
+
+		return ks, nil
+	}
+
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected view ddl statement %s", sqlparser.String(ddl))
+}
+
+// viewDefinition returns the select statement stored for a view. The
+// column list of the view, if any, is applied as aliases of the select
+// expressions.
+func viewDefinition(name string, view sqlparser.SelectStatement, columns sqlparser.Columns) (string, error) {
+	if len(columns) == 0 {
+		return sqlparser.String(view), nil
+	}
+
+	first := view
+	for {
+		switch stmt := first.(type) {
+		case *sqlparser.Union:
+			first = stmt.FirstStatement
+			continue
+		case *sqlparser.ParenSelect:
+			first = stmt.Select
+			continue
+		}
+		break
+	}
+	sel, ok := first.(*sqlparser.Select)
+	if !ok {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported column list for view %s", name)
+	}
+	if len(sel.SelectExprs) != len(columns) {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "view %s: the select list and the column list have different column counts", name)
+	}
+	for i, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "view %s: the column list cannot be used with %s", name, sqlparser.String(expr))
+		}
+		aliased.As = columns[i]
+	}
+	return sqlparser.String(view), nil
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// maxMisplacedSamples is the number of misplaced rows reported per shard.
+const maxMisplacedSamples = 5
+
+// validateBatchRows is the number of rows read per query when validating
+// the rows of a shard.
+var validateBatchRows = 10000
+
+// VSchemaChangeReport describes the impact of a vschema change on
+// the data that already exists in a keyspace.
+type VSchemaChangeReport struct {
+	Keyspace string
+	Tables   []*TableVindexChange
+}
+
+// TableVindexChange describes a table whose primary vindex is changed
+// by a vschema change, and where its existing rows would be routed.
+type TableVindexChange struct {
+	Table     string
+	OldVindex string
+	NewVindex string
+	// Skipped is set if the rows could not be validated, along with the reason.
+	Skipped string
+	// ImpactedQueries describes the query shapes whose routing changes.
+	ImpactedQueries []string
+	Shards          []*ShardPlacement
+}
+
+// ShardPlacement reports the rows of a shard that would not be
+// on that shard under the new primary vindex.
+type ShardPlacement struct {
+	Shard         string
+	RowsScanned   int
+	MisplacedRows int
+	// Samples contains the vindex column values of a few misplaced rows.
+	Samples []string
+}
+
+// MisplacedRows returns the total number of misplaced rows in the report.
+func (r *VSchemaChangeReport) MisplacedRows() int {
+	total := 0
+	for _, t := range r.Tables {
+		for _, s := range t.Shards {
+			total += s.MisplacedRows
+		}
+	}
+	return total
+}
+
+// String returns a human readable version of the report.
+func (r *VSchemaChangeReport) String() string {
+	if len(r.Tables) == 0 {
+		return fmt.Sprintf("No primary vindex changes for keyspace %s\n", r.Keyspace)
+	}
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "Primary vindex changes for keyspace %s:\n", r.Keyspace)
+	for _, t := range r.Tables {
+		fmt.Fprintf(buf, "  table %s: %s -> %s\n", t.Table, t.OldVindex, t.NewVindex)
+		for _, q := range t.ImpactedQueries {
+			fmt.Fprintf(buf, "    impacted: %s\n", q)
+		}
+		if t.Skipped != "" {
+			fmt.Fprintf(buf, "    data not validated: %s\n", t.Skipped)
+			continue
+		}
+		for _, s := range t.Shards {
+			fmt.Fprintf(buf, "    shard %s: %d rows scanned, %d misplaced\n", s.Shard, s.RowsScanned, s.MisplacedRows)
+			for _, sample := range s.Samples {
+				fmt.Fprintf(buf, "      %s\n", sample)
+			}
+		}
+	}
+	return buf.String()
+}
+
+// ValidateVSchemaChange compares the primary vindexes of oldVS and newVS and, for
+// every table whose primary vindex changes, reads the existing rows from the master
+// of each shard and computes their keyspace ids with the new vindex. Rows that would
+// not belong to their current shard are reported as misplaced. If sampleRows is 0,
+// the whole table is read, in batches ordered by its primary key. Nothing is written
+// to the topo.
+func ValidateVSchemaChange(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerClient, keyspace string, oldVS, newVS *vschemapb.Keyspace, sampleRows int) (*VSchemaChangeReport, error) {
+	report := &VSchemaChangeReport{Keyspace: keyspace}
+	if !newVS.Sharded {
+		return report, nil
+	}
+	newKS, err := vindexes.BuildKeyspaceSchema(newVS, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	oldKS, err := vindexes.BuildKeyspaceSchema(oldVS, keyspace)
+	if err != nil {
+		// The old vschema may have been invalid. Treat all tables as changed.
+		oldKS = &vindexes.KeyspaceSchema{Tables: map[string]*vindexes.Table{}}
+	}
+	report.Tables = changedPrimaryVindexes(oldKS, newKS)
+	if len(report.Tables) == 0 {
+		return report, nil
+	}
+
+	shards, err := ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	for _, tc := range report.Tables {
+		cv := newKS.Tables[tc.Table].ColumnVindexes[0]
+		if cv.Vindex.NeedsVCursor() {
+			tc.Skipped = fmt.Sprintf("vindex %s needs to query other tables to compute keyspace ids", cv.Name)
+			continue
+		}
+		if err := validateTablePlacement(ctx, ts, tmc, shards, tc, cv, sampleRows); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+func validateTablePlacement(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerClient, shards map[string]*topo.ShardInfo, tc *TableVindexChange, cv *vindexes.ColumnVindex, sampleRows int) error {
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		rec concurrency.AllErrorRecorder
+	)
+	for name, si := range shards {
+		if !si.HasMaster() {
+			rec.RecordError(fmt.Errorf("shard %v/%v has no master", si.Keyspace(), name))
+			continue
+		}
+		wg.Add(1)
+		go func(name string, si *topo.ShardInfo) {
+			defer wg.Done()
+			ti, err := ts.GetTablet(ctx, si.MasterAlias)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+			placement, err := validateShardPlacement(ctx, tmc, ti.Tablet, si.KeyRange, tc.Table, cv, sampleRows)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("validating the rows of %v on %v failed: %v", tc.Table, si.MasterAlias, err))
+				return
+			}
+			placement.Shard = name
+			mu.Lock()
+			tc.Shards = append(tc.Shards, placement)
+			mu.Unlock()
+		}(name, si)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return rec.Error()
+	}
+	sort.Slice(tc.Shards, func(i, j int) bool {
+		return tc.Shards[i].Shard < tc.Shards[j].Shard
+	})
+	return nil
+}
+
+// validateShardPlacement reads the rows of the table on a shard, at most
+// sampleRows of them if sampleRows is not 0, and checks them against the
+// vindex. The rows are read in batches of validateBatchRows ordered by the
+// primary key of the table, so that only one batch is held in memory.
+func validateShardPlacement(ctx context.Context, tmc tmclient.TabletManagerClient, tablet *topodatapb.Tablet, keyRange *topodatapb.KeyRange, table string, cv *vindexes.ColumnVindex, sampleRows int) (*ShardPlacement, error) {
+	sd, err := tmc.GetSchema(ctx, tablet, []string{table}, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	var pkColumns []sqlparser.ColIdent
+	if len(sd.TableDefinitions) == 1 {
+		for _, pk := range sd.TableDefinitions[0].PrimaryKeyColumns {
+			pkColumns = append(pkColumns, sqlparser.NewColIdent(pk))
+		}
+	}
+	if len(pkColumns) == 0 && sampleRows == 0 {
+		return nil, fmt.Errorf("table %s has no primary key to read all its rows in batches, only a sample of its rows can be validated", table)
+	}
+
+	placement := &ShardPlacement{}
+	var lastPK []sqltypes.Value
+	for sampleRows == 0 || placement.RowsScanned < sampleRows {
+		limit := validateBatchRows
+		if sampleRows != 0 && sampleRows-placement.RowsScanned < limit {
+			limit = sampleRows - placement.RowsScanned
+		}
+		query := placementQuery(table, cv.Columns, pkColumns, lastPK, limit)
+		qr, err := tmc.ExecuteFetchAsDba(ctx, tablet, false, []byte(query), limit, false, false)
+		if err != nil {
+			return nil, fmt.Errorf("ExecuteFetchAsDba(%v) failed: %v", query, err)
+		}
+		rows := sqltypes.Proto3ToResult(qr).Rows
+		vindexRows := make([][]sqltypes.Value, 0, len(rows))
+		for _, row := range rows {
+			vindexRows = append(vindexRows, row[:len(cv.Columns)])
+		}
+		if err := placement.check(cv.Vindex, keyRange, vindexRows); err != nil {
+			return nil, err
+		}
+		if len(rows) < limit || len(pkColumns) == 0 {
+			break
+		}
+		lastPK = rows[len(rows)-1][len(cv.Columns):]
+	}
+	return placement, nil
+}
+
+// placementQuery returns the query that reads the vindex columns of the
+// batch of rows that follows lastPK.
+func placementQuery(table string, vindexColumns, pkColumns []sqlparser.ColIdent, lastPK []sqltypes.Value, limit int) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	writeColumns(buf, append(append([]sqlparser.ColIdent(nil), vindexColumns...), pkColumns...))
+	buf.Myprintf(" from %v", sqlparser.NewTableIdent(table))
+	if lastPK != nil {
+		buf.Myprintf(" where %v > (", sqlparser.Columns(pkColumns))
+		for i, val := range lastPK {
+			if i != 0 {
+				buf.Myprintf(", ")
+			}
+			val.EncodeSQL(buf)
+		}
+		buf.Myprintf(")")
+	}
+	if len(pkColumns) != 0 {
+		buf.Myprintf(" order by ")
+		writeColumns(buf, pkColumns)
+	}
+	fmt.Fprintf(buf, " limit %d", limit)
+	return buf.String()
+}
+
+func writeColumns(buf *sqlparser.TrackedBuffer, cols []sqlparser.ColIdent) {
+	for i, col := range cols {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", col)
+	}
+}
+
+// check maps the rows through the vindex and counts the ones whose
+// keyspace id falls outside of keyRange.
+func (placement *ShardPlacement) check(vindex vindexes.Vindex, keyRange *topodatapb.KeyRange, rows [][]sqltypes.Value) error {
+	placement.RowsScanned += len(rows)
+	if len(rows) == 0 {
+		return nil
+	}
+	dests, err := vindexes.Map(vindex, nil, rows)
+	if err != nil {
+		return err
+	}
+	for i, dest := range dests {
+		ksid, ok := dest.(key.DestinationKeyspaceID)
+		if ok && key.KeyRangeContains(keyRange, ksid) {
+			continue
+		}
+		placement.MisplacedRows++
+		if len(placement.Samples) < maxMisplacedSamples {
+			placement.Samples = append(placement.Samples, fmt.Sprintf("%v -> %v", rows[i], dest))
+		}
+	}
+	return nil
+}
+
+// changedPrimaryVindexes returns the tables of newKS whose primary vindex
+// differs from the one in oldKS.
+func changedPrimaryVindexes(oldKS, newKS *vindexes.KeyspaceSchema) []*TableVindexChange {
+	var changes []*TableVindexChange
+	for name, newTable := range newKS.Tables {
+		if newTable.Type != "" || len(newTable.Pinned) != 0 || len(newTable.ColumnVindexes) == 0 {
+			continue
+		}
+		newDesc := describeColumnVindex(newTable.ColumnVindexes[0])
+		oldDesc := ""
+		if oldTable := oldKS.Tables[name]; oldTable != nil && len(oldTable.ColumnVindexes) != 0 {
+			oldDesc = describeColumnVindex(oldTable.ColumnVindexes[0])
+		}
+		if oldDesc == newDesc {
+			continue
+		}
+		tc := &TableVindexChange{
+			Table:     name,
+			OldVindex: oldDesc,
+			NewVindex: newDesc,
+		}
+		if oldDesc != "" {
+			oldCols := oldKS.Tables[name].ColumnVindexes[0].Columns
+			tc.ImpactedQueries = append(tc.ImpactedQueries,
+				fmt.Sprintf("queries filtering on %s by equality will be routed using %s", formatColumns(newTable.ColumnVindexes[0].Columns), newTable.ColumnVindexes[0].Name))
+			if !sameColumns(oldCols, newTable.ColumnVindexes[0].Columns) && !hasVindexOn(newTable, oldCols) {
+				tc.ImpactedQueries = append(tc.ImpactedQueries,
+					fmt.Sprintf("queries filtering only on %s will scatter to all shards", formatColumns(oldCols)))
+			}
+		}
+		changes = append(changes, tc)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Table < changes[j].Table
+	})
+	return changes
+}
+
+func describeColumnVindex(cv *vindexes.ColumnVindex) string {
+	return fmt.Sprintf("%s(%s) on %s", cv.Name, cv.Type, formatColumns(cv.Columns))
+}
+
+func formatColumns(cols []sqlparser.ColIdent) string {
+	names := make([]string, 0, len(cols))
+	for _, col := range cols {
+		names = append(names, col.String())
+	}
+	return "(" + strings.Join(names, ", ") + ")"
+}
+
+func sameColumns(a, b []sqlparser.ColIdent) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasVindexOn(table *vindexes.Table, cols []sqlparser.ColIdent) bool {
+	for _, cv := range table.ColumnVindexes {
+		if sameColumns(cv.Columns, cols) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func vschemaWithPrimary(col, vindex string) *vschemapb.Keyspace {
+	return &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash":    {Type: "hash"},
+			"binary":  {Type: "binary_md5"},
+			"reverse": {Type: "reverse_bits"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: col, Name: vindex}},
+			},
+			"t2": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+			},
+		},
+	}
+}
+
+func TestChangedPrimaryVindexes(t *testing.T) {
+	oldKS, err := vindexes.BuildKeyspaceSchema(vschemaWithPrimary("id", "hash"), "ks")
+	require.NoError(t, err)
+
+	newKS, err := vindexes.BuildKeyspaceSchema(vschemaWithPrimary("id", "hash"), "ks")
+	require.NoError(t, err)
+	assert.Empty(t, changedPrimaryVindexes(oldKS, newKS))
+
+	newKS, err = vindexes.BuildKeyspaceSchema(vschemaWithPrimary("c1", "reverse"), "ks")
+	require.NoError(t, err)
+	changes := changedPrimaryVindexes(oldKS, newKS)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "t1", changes[0].Table)
+	assert.Equal(t, "hash(hash) on (id)", changes[0].OldVindex)
+	assert.Equal(t, "reverse(reverse_bits) on (c1)", changes[0].NewVindex)
+	assert.Equal(t, []string{
+		"queries filtering on (c1) by equality will be routed using reverse",
+		"queries filtering only on (id) will scatter to all shards",
+	}, changes[0].ImpactedQueries)
+}
+
+func TestCheckRowPlacement(t *testing.T) {
+	vindex, err := vindexes.CreateVindex("reverse_bits", "reverse", nil)
+	require.NoError(t, err)
+	keyRanges, err := key.ParseShardingSpec("-80")
+	require.NoError(t, err)
+
+	// reverse_bits maps small even numbers to the -80 range and
+	// odd numbers to the 80- range.
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1)},
+		{sqltypes.NewInt64(2)},
+		{sqltypes.NewInt64(3)},
+		{sqltypes.NewInt64(4)},
+	}
+	placement := &ShardPlacement{}
+	require.NoError(t, placement.check(vindex, keyRanges[0], rows))
+	assert.Equal(t, 4, placement.RowsScanned)
+	assert.Equal(t, 2, placement.MisplacedRows)
+	assert.Len(t, placement.Samples, 2)
+
+	placement = &ShardPlacement{}
+	require.NoError(t, placement.check(vindex, keyRanges[0], nil))
+	assert.Equal(t, &ShardPlacement{}, placement)
+}
+
+type validatorTMClient struct {
+	tmclient.TabletManagerClient
+	pkColumns []string
+	// results are the results of the queries, by tablet uid and query.
+	results map[uint32]map[string]*sqltypes.Result
+}
+
+func (tmc *validatorTMClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	return &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              tables[0],
+			PrimaryKeyColumns: tmc.pkColumns,
+		}},
+	}, nil
+}
+
+func (tmc *validatorTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	qr, ok := tmc.results[tablet.Alias.Uid][string(query)]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query on tablet %d: %s", tablet.Alias.Uid, query)
+	}
+	return sqltypes.ResultToProto3(qr), nil
+}
+
+func TestValidateVSchemaChange(t *testing.T) {
+	defer func(rows int) { validateBatchRows = rows }(validateBatchRows)
+	validateBatchRows = 2
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	for i, shard := range []string{"-80", "80-"} {
+		alias := &topodatapb.TabletAlias{Cell: "zone1", Uid: uint32(100 + i)}
+		require.NoError(t, ts.CreateShard(ctx, "ks", shard))
+		require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{Alias: alias, Keyspace: "ks", Shard: shard}))
+		_, err := ts.UpdateShardFields(ctx, "ks", shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = alias
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	fields := sqltypes.MakeTestFields("id|id", "int64|int64")
+	tmc := &validatorTMClient{
+		pkColumns: []string{"id"},
+		results: map[uint32]map[string]*sqltypes.Result{
+			// reverse_bits maps small even numbers to -80 and odd numbers to 80-.
+			100: {
+				"select id, id from t1 order by id limit 2":                  sqltypes.MakeTestResult(fields, "2|2", "4|4"),
+				"select id, id from t1 where (id) > (4) order by id limit 2": sqltypes.MakeTestResult(fields, "5|5", "6|6"),
+				"select id, id from t1 where (id) > (6) order by id limit 2": sqltypes.MakeTestResult(fields),
+				"select id, id from t1 order by id limit 1":                  sqltypes.MakeTestResult(fields, "2|2"),
+			},
+			101: {
+				"select id, id from t1 order by id limit 2": sqltypes.MakeTestResult(fields, "1|1"),
+				"select id, id from t1 order by id limit 1": sqltypes.MakeTestResult(fields, "1|1"),
+			},
+		},
+	}
+
+	oldVS := vschemaWithPrimary("id", "hash")
+	newVS := vschemaWithPrimary("id", "reverse")
+	report, err := ValidateVSchemaChange(ctx, ts, tmc, "ks", oldVS, newVS, 0)
+	require.NoError(t, err)
+	require.Len(t, report.Tables, 1)
+	require.Len(t, report.Tables[0].Shards, 2)
+	assert.Equal(t, &ShardPlacement{Shard: "-80", RowsScanned: 4, MisplacedRows: 1, Samples: []string{"[INT64(5)] -> DestinationKeyspaceID(a000000000000000)"}}, report.Tables[0].Shards[0])
+	assert.Equal(t, &ShardPlacement{Shard: "80-", RowsScanned: 1}, report.Tables[0].Shards[1])
+	assert.Equal(t, 1, report.MisplacedRows())
+
+	// A sample reads at most the number of rows asked for.
+	report, err = ValidateVSchemaChange(ctx, ts, tmc, "ks", oldVS, newVS, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.MisplacedRows())
+	assert.Equal(t, 1, report.Tables[0].Shards[0].RowsScanned)
+
+	// Without a primary key, the rows can only be sampled.
+	tmc.pkColumns = nil
+	_, err = ValidateVSchemaChange(ctx, ts, tmc, "ks", oldVS, newVS, 0)
+	assert.Contains(t, fmt.Sprint(err), "table t1 has no primary key")
+	tmc.results[100]["select id from t1 limit 1"] = sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "3")
+	tmc.results[101]["select id from t1 limit 1"] = sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	report, err = ValidateVSchemaChange(ctx, ts, tmc, "ks", oldVS, newVS, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.MisplacedRows())
+}
@@ -456,14 +456,14 @@ func (itc *internalTabletConn) BeginExecuteBatch(ctx context.Context, target *qu
 }
 
 // MessageStream is part of queryservice.QueryService
-func (itc *internalTabletConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
-	err := itc.tablet.qsc.QueryService().MessageStream(ctx, target, name, callback)
+func (itc *internalTabletConn) MessageStream(ctx context.Context, target *querypb.Target, name, group string, callback func(*sqltypes.Result) error) error {
+	err := itc.tablet.qsc.QueryService().MessageStream(ctx, target, name, group, callback)
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
 // MessageAck is part of queryservice.QueryService
-func (itc *internalTabletConn) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
-	count, err := itc.tablet.qsc.QueryService().MessageAck(ctx, target, name, ids)
+func (itc *internalTabletConn) MessageAck(ctx context.Context, target *querypb.Target, name, group string, ids []*querypb.Value) (int64, error) {
+	count, err := itc.tablet.qsc.QueryService().MessageAck(ctx, target, name, group, ids)
 	return count, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
@@ -565,6 +565,14 @@ func (itmc *internalTabletManagerClient) GetPermissions(ctx context.Context, tab
 	return t.tm.GetPermissions(ctx)
 }
 
+func (itmc *internalTabletManagerClient) GetHotRows(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.HotRow, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.GetHotRows(ctx)
+}
+
 func (itmc *internalTabletManagerClient) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -681,6 +689,10 @@ func (itmc *internalTabletManagerClient) VReplicationWaitForPos(ctx context.Cont
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ResetReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -115,8 +115,10 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -234,6 +236,12 @@ var commands = []commandGroup{
 			{"ListShardTablets", commandListShardTablets,
 				"<keyspace/shard>",
 				"Lists all tablets in the specified shard."},
+			{"GetQueryQuarantine", commandGetQueryQuarantine,
+				"<keyspace/shard>",
+				"Displays the query fingerprints quarantined in the specified shard by the tablets started with -enable_query_quarantine."},
+			{"UnquarantineQuery", commandUnquarantineQuery,
+				"<keyspace/shard> <fingerprint>",
+				"Lifts the quarantine of a query fingerprint in the specified shard."},
 			{"SetShardIsMasterServing", commandSetShardIsMasterServing,
 				"<keyspace/shard> <is_master_serving>",
 				"Add or remove a shard from serving. This is meant as an emergency function. It does not rebuild any serving graph i.e. does not run 'RebuildKeyspaceGraph'."},
@@ -329,8 +337,8 @@ var commands = []commandGroup{
 				"<from_keyspace> <to_keyspace> <tables>",
 				"Start the VerticalSplitClone process to perform vertical resharding. Example: SplitClone from_ks to_ks 'a,/b.*/'"},
 			{"VDiff", commandVDiff,
-				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
-				"Perform a diff of all tables in the workflow"},
+				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] [-v2] <keyspace.workflow> [start|stop|resume|show|delete] [<vdiff uuid>|last|all]",
+				"Perform a diff of all tables in the workflow. With -v2, the diff is run by the target masters, which persist its progress: the action starts a new vdiff, stops or resumes one, shows its report, or deletes it."},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-filtered_replication_wait_time=30s] [-reverse_replication=false] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -429,6 +437,12 @@ var commands = []commandGroup{
 			{"GetPermissions", commandGetPermissions,
 				"<tablet alias>",
 				"Displays the permissions for a tablet."},
+			{"GetHotRows", commandGetHotRows,
+				"<tablet alias>",
+				"Displays the rows contended by several transactions on a tablet."},
+			{"GetShardHotRows", commandGetShardHotRows,
+				"<keyspace/shard>",
+				"Displays the rows contended by several transactions on all the tablets of a shard."},
 			{"ValidatePermissionsShard", commandValidatePermissionsShard,
 				"<keyspace/shard>",
 				"Validates that the master permissions match all the replicas."},
@@ -440,14 +454,26 @@ var commands = []commandGroup{
 				"<keyspace>",
 				"Displays the VTGate routing schema."},
 			{"ApplyVSchema", commandApplyVSchema,
-				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace>",
-				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application."},
+				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] [-validate_data] [-validate_sample_rows=N] <keyspace>",
+				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application. With -validate_data, the existing rows of tables whose primary vindex changes are checked against the new vindex, and the change is refused if any row would be misplaced."},
+			{"UpdateTenantMapping", commandUpdateTenantMapping,
+				"[-skip_copy] [-timeout=10m] <keyspace> <vindex> <tenant id> <keyspace id>",
+				"Maps a tenant id to a keyspace id in the mapping of a numeric_topo_map vindex, stored in the global topo, copied to every cell and watched there by the vtgates started with -enable_tenant_mappings. If the tenant moves to another shard, its rows are copied there by vreplication before the mapping is switched."},
 			{"GetRoutingRules", commandGetRoutingRules,
 				"",
 				"Displays the VSchema routing rules."},
 			{"ApplyRoutingRules", commandApplyRoutingRules,
 				"{-rules=<rules> || -rules_file=<rules_file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run]",
 				"Applies the VSchema routing rules."},
+			{"GetPlanPins", commandGetPlanPins,
+				"",
+				"Displays the plans pinned for queries."},
+			{"PinPlan", commandPinPlan,
+				"{-planner=<planner version> || -variant=<query variant>} [-keyspace=<keyspace>] <query>",
+				"Pins the plan used by the vtgates started with -enable_plan_pins for a query, whatever its literal values are, in the sessions targeting the keyspace, or no keyspace if none is given. The planner version is one of V3, Gen4, Gen4Greedy, Left2Right and Gen4Fallback. The variant of a select is an equivalent select, with the same literals in the same order, planned in its place, typically with index hints or its joins reordered."},
+			{"UnpinPlan", commandUnpinPlan,
+				"[-keyspace=<keyspace>] <query>",
+				"Removes the plan pinned for a query in the sessions targeting the keyspace, or no keyspace if none is given."},
 			{"RebuildVSchemaGraph", commandRebuildVSchemaGraph,
 				"[-cells=c1,c2,...]",
 				"Rebuilds the cell-specific SrvVSchema from the global VSchema objects in the provided cells (or all cells if none provided)."},
@@ -1210,6 +1236,47 @@ func commandGetShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 	return printJSON(wr.Logger(), shardInfo.Shard)
 }
 
+func commandGetQueryQuarantine(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the GetQueryQuarantine command")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	quarantine, err := wr.TopoServer().GetQueryQuarantine(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), quarantine)
+}
+
+func commandUnquarantineQuery(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace/shard> and <fingerprint> arguments are required for the UnquarantineQuery command")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	fingerprint := subFlags.Arg(1)
+	return wr.TopoServer().UpdateQueryQuarantine(ctx, keyspace, shard, func(quarantine map[string]*topo.QuarantinedQuery) error {
+		if _, ok := quarantine[fingerprint]; !ok {
+			return fmt.Errorf("query fingerprint %s is not quarantined in %s/%s", fingerprint, keyspace, shard)
+		}
+		delete(quarantine, fingerprint)
+		return nil
+	})
+}
+
 func commandValidateShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	pingTablets := subFlags.Bool("ping-tablets", true, "Indicates whether all tablets should be pinged during the validation process")
 	if err := subFlags.Parse(args); err != nil {
@@ -2333,10 +2400,49 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	maxRows := subFlags.Int64("limit", math.MaxInt64, "Max rows to stop comparing after")
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
 	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
+	v2 := subFlags.Bool("v2", false, "Run the vdiff on the target masters, which persist its progress so that it can be stopped and resumed")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 
+	if *v2 {
+		if subFlags.NArg() < 2 || subFlags.NArg() > 3 {
+			return fmt.Errorf("<keyspace.workflow> and an action are required with -v2")
+		}
+		keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+		if err != nil {
+			return err
+		}
+		if *maxRows <= 0 {
+			return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
+		}
+		options := &tabletmanagerdatapb.VDiffOptions{
+			SourceCell:                  *sourceCell,
+			TabletTypes:                 *tabletTypes,
+			Tables:                      *tables,
+			FilteredReplicationWaitTime: int64(filteredReplicationWaitTime.Seconds()),
+		}
+		if *maxRows != math.MaxInt64 {
+			options.MaxRows = *maxRows
+		}
+		action := subFlags.Arg(1)
+		vdiffUUID, qr, err := wr.VDiff2(ctx, keyspace, workflow, action, subFlags.Arg(2), options)
+		if err != nil {
+			return err
+		}
+		if action == "start" {
+			wr.Logger().Printf("VDiff %s started on the targets of workflow %s\n", vdiffUUID, subFlags.Arg(0))
+		}
+		if qr == nil {
+			return nil
+		}
+		if *format == "json" {
+			return printJSON(wr.Logger(), qr)
+		}
+		printQueryResult(loggerWriter{wr.Logger()}, qr)
+		return nil
+	}
+
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("<keyspace.workflow> is required")
 	}
@@ -3044,6 +3150,42 @@ func commandGetPermissions(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	return err
 }
 
+func commandGetHotRows(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetHotRows command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	hotRows, err := wr.GetHotRows(ctx, tabletAlias)
+	if err == nil {
+		printJSON(wr.Logger(), hotRows)
+	}
+	return err
+}
+
+func commandGetShardHotRows(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the GetShardHotRows command")
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	hotRows, err := wr.GetShardHotRows(ctx, keyspace, shard)
+	if err == nil {
+		printJSON(wr.Logger(), hotRows)
+	}
+	return err
+}
+
 func commandValidatePermissionsShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -3106,6 +3248,86 @@ func commandGetRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	return nil
 }
 
+func commandGetPlanPins(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("GetPlanPins doesn't take any arguments")
+	}
+	pins, err := wr.TopoServer().GetPlanPins(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), pins)
+}
+
+func commandPinPlan(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	planner := subFlags.String("planner", "", "Specifies the planner version to use for the query")
+	variant := subFlags.String("variant", "", "Specifies an equivalent query, with the same literals in the same order, to plan in place of the query")
+	keyspace := subFlags.String("keyspace", "", "Specifies the keyspace targeted by the sessions the query is pinned for")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <query> argument is required for the PinPlan command")
+	}
+	if *planner == "" && *variant == "" {
+		return fmt.Errorf("-planner or -variant is required for the PinPlan command")
+	}
+	if *planner != "" {
+		if _, ok := planbuilder.PlannerNameToVersion(*planner); !ok {
+			return fmt.Errorf("invalid -planner %q", *planner)
+		}
+	}
+	query := subFlags.Arg(0)
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return err
+	}
+	if *variant != "" {
+		if _, ok := stmt.(*sqlparser.Select); !ok {
+			return fmt.Errorf("only the variants of selects can be pinned")
+		}
+		variantStmt, err := sqlparser.Parse(*variant)
+		if err != nil {
+			return fmt.Errorf("invalid -variant: %v", err)
+		}
+		if _, ok := variantStmt.(*sqlparser.Select); !ok {
+			return fmt.Errorf("invalid -variant %q: not a select", *variant)
+		}
+	}
+
+	pins, err := wr.TopoServer().GetPlanPins(ctx)
+	if err != nil {
+		return err
+	}
+	pins[topo.PlanPinKey(*keyspace, query)] = topo.PlanPin{Planner: *planner, Variant: *variant, Keyspace: *keyspace}
+	return wr.TopoServer().SavePlanPins(ctx, pins)
+}
+
+func commandUnpinPlan(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keyspace := subFlags.String("keyspace", "", "Specifies the keyspace targeted by the sessions the query is pinned for")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <query> argument is required for the UnpinPlan command")
+	}
+	query := subFlags.Arg(0)
+
+	pins, err := wr.TopoServer().GetPlanPins(ctx)
+	if err != nil {
+		return err
+	}
+	key := topo.PlanPinKey(*keyspace, query)
+	if _, ok := pins[key]; !ok {
+		return fmt.Errorf("no plan is pinned for query %q", key)
+	}
+	delete(pins, key)
+	return wr.TopoServer().SavePlanPins(ctx, pins)
+}
+
 func commandRebuildVSchemaGraph(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	var cells flagutil.StringListValue
 	subFlags.Var(&cells, "cells", "Specifies a comma-separated list of cells to look for tablets")
@@ -3120,6 +3342,26 @@ func commandRebuildVSchemaGraph(ctx context.Context, wr *wrangler.Wrangler, subF
 	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
 }
 
+func commandUpdateTenantMapping(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	skipCopy := subFlags.Bool("skip_copy", false, "If set, only update the mapping and do not copy the rows of the tenant.")
+	timeout := subFlags.Duration("timeout", 10*time.Minute, "Time to wait for the copy of the rows of the tenant and for its catch up after the switch.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 4 {
+		return fmt.Errorf("the <keyspace>, <vindex>, <tenant id> and <keyspace id> arguments are required for the UpdateTenantMapping command")
+	}
+	tenantID, err := strconv.ParseUint(subFlags.Arg(2), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid tenant id %v: %v", subFlags.Arg(2), err)
+	}
+	keyspaceID, err := strconv.ParseUint(subFlags.Arg(3), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid keyspace id %v: %v", subFlags.Arg(3), err)
+	}
+	return wr.UpdateTenantMapping(ctx, subFlags.Arg(0), subFlags.Arg(1), tenantID, keyspaceID, *skipCopy, *timeout)
+}
+
 func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	vschema := subFlags.String("vschema", "", "Identifies the VTGate routing schema")
 	vschemaFile := subFlags.String("vschema_file", "", "Identifies the VTGate routing schema file")
@@ -3127,6 +3369,8 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	sqlFile := subFlags.String("sql_file", "", "A vschema ddl SQL statement (e.g. `add vindex`, `alter table t add vindex hash(id)`, etc)")
 	dryRun := subFlags.Bool("dry-run", false, "If set, do not save the altered vschema, simply echo to console.")
 	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	validateData := subFlags.Bool("validate_data", false, "If set, check that the existing rows of tables whose primary vindex changes are on the shards the new vindex maps them to.")
+	validateSampleRows := subFlags.Int("validate_sample_rows", 10000, "Number of rows per shard and table read by -validate_data. 0 reads the whole table, in batches ordered by its primary key.")
 	var cells flagutil.StringListValue
 	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
 
@@ -3141,6 +3385,14 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	var vs *vschemapb.Keyspace
 	var err error
 
+	oldVS, err := wr.TopoServer().GetVSchema(ctx, keyspace)
+	if err != nil {
+		if !topo.IsErrType(err, topo.NoNode) {
+			return err
+		}
+		oldVS = &vschemapb.Keyspace{}
+	}
+
 	sqlMode := (*sql != "") != (*sqlFile != "")
 	jsonMode := (*vschema != "") != (*vschemaFile != "")
 
@@ -3170,16 +3422,7 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 			return fmt.Errorf("error parsing vschema statement `%s`: not a ddl statement", *sql)
 		}
 
-		vs, err = wr.TopoServer().GetVSchema(ctx, keyspace)
-		if err != nil {
-			if topo.IsErrType(err, topo.NoNode) {
-				vs = &vschemapb.Keyspace{}
-			} else {
-				return err
-			}
-		}
-
-		vs, err = topotools.ApplyVSchemaDDL(keyspace, vs, ddl)
+		vs, err = topotools.ApplyVSchemaDDL(keyspace, proto.Clone(oldVS).(*vschemapb.Keyspace), ddl)
 		if err != nil {
 			return err
 		}
@@ -3211,6 +3454,17 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		wr.Logger().Printf("New VSchema object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", b)
 	}
 
+	if *validateData {
+		report, err := topotools.ValidateVSchemaChange(ctx, wr.TopoServer(), wr.TabletManagerClient(), keyspace, oldVS, vs, *validateSampleRows)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("%s", report)
+		if misplaced := report.MisplacedRows(); misplaced > 0 && !*dryRun {
+			return fmt.Errorf("%d existing rows would be on the wrong shard with the new vschema, not applying it", misplaced)
+		}
+	}
+
 	if *dryRun {
 		wr.Logger().Printf("Dry run: Skipping update of VSchema\n")
 		return nil
@@ -49,7 +49,7 @@ func CodeToLegacyErrorCode(code vtrpcpb.Code) vtrpcpb.LegacyErrorCode {
 		return vtrpcpb.LegacyErrorCode_PERMISSION_DENIED_LEGACY
 	case vtrpcpb.Code_RESOURCE_EXHAUSTED:
 		return vtrpcpb.LegacyErrorCode_RESOURCE_EXHAUSTED_LEGACY
-	case vtrpcpb.Code_FAILED_PRECONDITION:
+	case vtrpcpb.Code_FAILED_PRECONDITION, vtrpcpb.Code_CLUSTER_EVENT:
 		return vtrpcpb.LegacyErrorCode_QUERY_NOT_SERVED_LEGACY
 	case vtrpcpb.Code_ABORTED:
 		return vtrpcpb.LegacyErrorCode_NOT_IN_TX_LEGACY
@@ -49,6 +49,7 @@ const (
 	SPDoesNotExist
 	UnknownSystemVariable
 	UnknownTable
+	NoSuchThread
 
 	// already exists
 	DbCreateExists
@@ -65,6 +66,10 @@ const (
 
 	// permission denied
 	AccessDeniedError
+	KillDeniedError
+
+	// resource exhausted
+	UserLimitReached
 
 	// No state should be added below NumOfStates
 	NumOfStates
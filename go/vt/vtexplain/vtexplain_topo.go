@@ -118,3 +118,8 @@ func (et *ExplainTopo) GetSrvKeyspace(ctx context.Context, cell, keyspace string
 func (et *ExplainTopo) WatchSrvVSchema(ctx context.Context, cell string, callback func(*vschemapb.SrvVSchema, error)) {
 	callback(et.getSrvVSchema(), nil)
 }
+
+// WatchSrvTenantMappings is part of the srvtopo.Server interface.
+func (et *ExplainTopo) WatchSrvTenantMappings(ctx context.Context, cell string, callback func(topo.TenantMappings, error)) {
+	callback(nil, topo.NewError(topo.NoNode, topo.SrvTenantMappingsFile))
+}
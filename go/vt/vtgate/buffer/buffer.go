@@ -14,12 +14,18 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package buffer provides a buffer for MASTER traffic during failovers.
+// Package buffer provides a buffer for MASTER traffic during failovers and
+// resharding or MoveTables cutovers.
 //
 // Instead of returning an error to the application (when the vttablet master
 // becomes unavailable), the buffer will automatically retry buffered requests
 // after the end of the failover was detected.
 //
+// During a cutover, the end of buffering is detected through the SrvKeyspace
+// and SrvVSchema watches (see keyspace_events.go): once the buffered shard is
+// no longer serving or the routing rules changed, the buffered requests are
+// returned an error which lets vtgate plan and route them again.
+//
 // Buffering (stalling) requests will increase the number of requests in flight
 // within vtgate and at upstream layers. Therefore, it is important to limit
 // the size of the buffer and the buffering duration (window) per request.
@@ -48,6 +54,13 @@ var (
 	bufferFullError      = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "master buffer is full")
 	entryEvictedError    = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "buffer full: request evicted for newer request")
 	contextCanceledError = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "context was canceled before failover finished")
+
+	// ShardMissingError is returned to the requests buffered for a shard which
+	// is no longer serving after a resharding cutover.
+	ShardMissingError = vterrors.New(vtrpcpb.Code_CLUSTER_EVENT, "destination shard is missing after a resharding operation")
+	// RoutingRulesChangedError is returned to the requests buffered for a
+	// keyspace whose routing rules changed e.g. after a MoveTables cutover.
+	RoutingRulesChangedError = vterrors.New(vtrpcpb.Code_CLUSTER_EVENT, "routing rules changed during a MoveTables cutover")
 )
 
 // bufferMode specifies how the buffer is configured for a given shard.
@@ -97,6 +110,10 @@ type Buffer struct {
 	buffers map[string]*shardBuffer
 	// stopped is true after Shutdown() was run.
 	stopped bool
+
+	// watcher follows the keyspace events which end a cutover. It is nil
+	// until WatchKeyspaceEvents() is called.
+	watcher *keyspaceEventWatcher
 }
 
 // New creates a new Buffer object.
@@ -195,7 +212,7 @@ type RetryDoneFunc context.CancelFunc
 func (b *Buffer) WaitForFailoverEnd(ctx context.Context, keyspace, shard string, err error) (RetryDoneFunc, error) {
 	// If an err is given, it must be related to a failover.
 	// We never buffer requests with other errors.
-	if err != nil && !causedByFailover(err) {
+	if err != nil && !causedByFailover(err) && !causedByCutover(err) {
 		return nil, nil
 	}
 
@@ -210,6 +227,16 @@ func (b *Buffer) WaitForFailoverEnd(ctx context.Context, keyspace, shard string,
 		return nil, nil
 	}
 
+	// Do not buffer if the cutover which caused the error is already over.
+	// Instead, let vtgate route the request again.
+	if err != nil {
+		if cutoverErr := b.keyspaceEventWatcher().cutoverEnded(keyspace, shard, err); cutoverErr != nil {
+			requestsSkipped.Add([]string{keyspace, shard, skippedCutoverEnded}, 1)
+			requestsRerouted.Add([]string{keyspace}, 1)
+			return nil, cutoverErr
+		}
+	}
+
 	return sb.waitForFailoverEnd(ctx, keyspace, shard, err)
 }
 
@@ -288,6 +315,21 @@ func causedByFailover(err error) bool {
 	return false
 }
 
+// causedByCutover returns true if "err" was caused by the cutover of a
+// MoveTables workflow: the tablets of the source keyspace deny the writes
+// to its tables with a CLUSTER_EVENT error until the routing rules point
+// to the target keyspace.
+func causedByCutover(err error) bool {
+	return vterrors.Code(err) == vtrpcpb.Code_CLUSTER_EVENT
+}
+
+// CausedByKeyspaceEvent returns true if "err" was returned because a cutover
+// changed, or is changing, the routing of the request: its code is
+// CLUSTER_EVENT. Such requests should be planned and routed again.
+func CausedByKeyspaceEvent(err error) bool {
+	return vterrors.Code(err) == vtrpcpb.Code_CLUSTER_EVENT
+}
+
 // getOrCreateBuffer returns the ShardBuffer for the given keyspace and shard.
 // It returns nil if Buffer is shut down and all calls should be ignored.
 func (b *Buffer) getOrCreateBuffer(keyspace, shard string) *shardBuffer {
@@ -311,10 +353,27 @@ func (b *Buffer) getOrCreateBuffer(keyspace, shard string) *shardBuffer {
 	if !ok {
 		sb = newShardBuffer(b.mode(keyspace, shard), keyspace, shard, b.now, b.bufferSizeSema)
 		b.buffers[key] = sb
+		if !sb.disabled() {
+			b.watcher.watchKeyspace(keyspace)
+		}
 	}
 	return sb
 }
 
+// shardBuffers returns the ShardBuffer objects of the given keyspace.
+func (b *Buffer) shardBuffers(keyspace string) []*shardBuffer {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var result []*shardBuffer
+	for _, sb := range b.buffers {
+		if sb.keyspace == keyspace {
+			result = append(result, sb)
+		}
+	}
+	return result
+}
+
 // Shutdown blocks until all pending ShardBuffer objects are shut down.
 // In particular, it guarantees that all launched Go routines are stopped after
 // it returns.
@@ -331,6 +390,7 @@ func (b *Buffer) shutdown() {
 		sb.shutdown()
 	}
 	b.stopped = true
+	b.watcher.stop()
 }
 
 func (b *Buffer) waitForShutdown() {
@@ -340,4 +400,5 @@ func (b *Buffer) waitForShutdown() {
 	for _, sb := range b.buffers {
 		sb.waitForShutdown()
 	}
+	b.watcher.waitForShutdown()
 }
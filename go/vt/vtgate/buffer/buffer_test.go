@@ -916,6 +916,9 @@ func resetVariables() {
 	requestsDrained.ResetAll()
 	requestsEvicted.ResetAll()
 	requestsSkipped.ResetAll()
+
+	keyspaceEvents.ResetAll()
+	requestsRerouted.ResetAll()
 }
 
 // checkVariables makes sure that the invariants described in variables.go
@@ -33,6 +33,7 @@ var (
 	window                  = flag.Duration("buffer_window", 10*time.Second, "Duration for how long a request should be buffered at most.")
 	size                    = flag.Int("buffer_size", 10, "Maximum number of buffered requests in flight (across all ongoing failovers).")
 	maxFailoverDuration     = flag.Duration("buffer_max_failover_duration", 20*time.Second, "Stop buffering completely if a failover takes longer than this duration.")
+	maxCutoverDuration      = flag.Duration("buffer_max_cutover_duration", 30*time.Second, "Stop buffering completely if a MoveTables cutover takes longer than this duration.")
 	minTimeBetweenFailovers = flag.Duration("buffer_min_time_between_failovers", 1*time.Minute, "Minimum time between the end of a failover and the start of the next one (tracked per shard). Faster consecutive failovers will not trigger buffering.")
 
 	drainConcurrency = flag.Int("buffer_drain_concurrency", 1, "Maximum number of requests retried simultaneously. More concurrency will increase the load on the MASTER vttablet when draining the buffer.")
@@ -48,6 +49,7 @@ func resetFlagsForTesting() {
 	flag.Set("buffer_window", "10s")
 	flag.Set("buffer_keyspace_shards", "")
 	flag.Set("buffer_max_failover_duration", "20s")
+	flag.Set("buffer_max_cutover_duration", "30s")
 	flag.Set("buffer_min_time_between_failovers", "1m")
 }
 
@@ -58,6 +60,9 @@ func verifyFlags() error {
 	if *window > *maxFailoverDuration {
 		return fmt.Errorf("-buffer_window must be <= -buffer_max_failover_duration: %v vs. %v", *window, *maxFailoverDuration)
 	}
+	if *window > *maxCutoverDuration {
+		return fmt.Errorf("-buffer_window must be <= -buffer_max_cutover_duration: %v vs. %v", *window, *maxCutoverDuration)
+	}
 	if *size < 1 {
 		return fmt.Errorf("-buffer_size must be >= 1 (specified value: %d)", *size)
 	}
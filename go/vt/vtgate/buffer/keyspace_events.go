@@ -0,0 +1,358 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buffer
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// keyspaceWatchRetryDelay is how long we wait before watching a SrvKeyspace
+// object again after the watch failed.
+const keyspaceWatchRetryDelay = 5 * time.Second
+
+// keyspaceEventWatcher follows the serving shards of the keyspaces (through
+// the SrvKeyspace watches) and the routing rules (through the SrvVSchema
+// watch) of the local cell.
+// When a resharding or MoveTables cutover changes the routing of a buffering
+// shard, it stops the buffering and the buffered requests are routed again.
+//
+// All methods can be called on a nil object, they do nothing then.
+type keyspaceEventWatcher struct {
+	// Immutable fields set at construction.
+	b *Buffer
+	// ts is used to watch the SrvKeyspace objects. It may be nil, then only
+	// the routing rules are watched.
+	ts     *topo.Server
+	cell   string
+	ctx    context.Context
+	cancel context.CancelFunc
+	// wg tracks the Go routines watching the SrvKeyspace objects.
+	wg sync.WaitGroup
+
+	// mu guards the fields below.
+	mu sync.Mutex
+	// keyspaces holds the state of each watched keyspace.
+	keyspaces map[string]*keyspaceState
+	// routingRules are the last seen routing rules, by "from" table.
+	// They are nil until the first SrvVSchema was seen.
+	routingRules map[string][]string
+}
+
+// keyspaceState is the routing state of a keyspace, as last seen by the
+// keyspaceEventWatcher.
+type keyspaceState struct {
+	// masterShards is the set of shards in the MASTER partition of the
+	// SrvKeyspace. It is nil until the SrvKeyspace was seen.
+	masterShards map[string]bool
+	// lastRoutingChange is the last time the routing rules from or to the
+	// tables of the keyspace changed.
+	lastRoutingChange time.Time
+}
+
+// WatchKeyspaceEvents starts watching the SrvKeyspace and SrvVSchema objects
+// of the given cell, to detect the end of resharding and MoveTables cutovers.
+// The watches stop when ctx is canceled or the buffer is shut down.
+// It does nothing if buffering is not enabled.
+func (b *Buffer) WatchKeyspaceEvents(ctx context.Context, serv srvtopo.Server, cell string) {
+	if !*enabled && !*enabledDryRun {
+		return
+	}
+
+	ts, err := serv.GetTopoServer()
+	if err != nil {
+		log.Errorf("Cannot watch the SrvKeyspace objects, the end of resharding cutovers will not be detected: %v", err)
+	}
+	w := newKeyspaceEventWatcher(ctx, b, ts, cell)
+
+	b.mu.Lock()
+	if b.stopped || b.watcher != nil {
+		b.mu.Unlock()
+		w.stop()
+		return
+	}
+	b.watcher = w
+	keyspaces := make(map[string]bool)
+	for _, sb := range b.buffers {
+		if !sb.disabled() {
+			keyspaces[sb.keyspace] = true
+		}
+	}
+	b.mu.Unlock()
+
+	for keyspace := range keyspaces {
+		w.watchKeyspace(keyspace)
+	}
+	serv.WatchSrvVSchema(w.ctx, cell, func(srvVSchema *vschemapb.SrvVSchema, err error) {
+		if err != nil || w.ctx.Err() != nil {
+			return
+		}
+		w.processRoutingRules(srvVSchema.GetRoutingRules())
+	})
+}
+
+// keyspaceEventWatcher returns the watcher, or nil if the keyspace events are
+// not watched.
+func (b *Buffer) keyspaceEventWatcher() *keyspaceEventWatcher {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.watcher
+}
+
+func newKeyspaceEventWatcher(ctx context.Context, b *Buffer, ts *topo.Server, cell string) *keyspaceEventWatcher {
+	ctx, cancel := context.WithCancel(ctx)
+	return &keyspaceEventWatcher{
+		b:         b,
+		ts:        ts,
+		cell:      cell,
+		ctx:       ctx,
+		cancel:    cancel,
+		keyspaces: make(map[string]*keyspaceState),
+	}
+}
+
+// watchKeyspace starts watching the SrvKeyspace of the keyspace, unless it is
+// already watched.
+func (w *keyspaceEventWatcher) watchKeyspace(keyspace string) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.keyspaces[keyspace]; ok {
+		return
+	}
+	w.keyspaces[keyspace] = &keyspaceState{}
+	initVariablesForKeyspace(keyspace)
+
+	if w.ts == nil {
+		return
+	}
+	w.wg.Add(1)
+	go w.watchSrvKeyspace(keyspace)
+}
+
+func (w *keyspaceEventWatcher) watchSrvKeyspace(keyspace string) {
+	defer w.wg.Done()
+
+	for {
+		current, changes, cancel := w.ts.WatchSrvKeyspace(w.ctx, w.cell, keyspace)
+		err := current.Err
+		if err == nil {
+			w.processSrvKeyspace(keyspace, current.Value)
+			err = w.processSrvKeyspaceChanges(keyspace, changes, cancel)
+		}
+
+		if w.ctx.Err() != nil {
+			return
+		}
+		if !topo.IsErrType(err, topo.NoNode) {
+			log.Warningf("WatchSrvKeyspace failed for %v/%v, retrying in %v: %v", w.cell, keyspace, keyspaceWatchRetryDelay, err)
+		}
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(keyspaceWatchRetryDelay):
+		}
+	}
+}
+
+// processSrvKeyspaceChanges processes the changes of the SrvKeyspace until
+// the watch fails or the watcher is stopped. It returns the watch error.
+func (w *keyspaceEventWatcher) processSrvKeyspaceChanges(keyspace string, changes <-chan *topo.WatchSrvKeyspaceData, cancel topo.CancelFunc) error {
+	defer func() {
+		cancel()
+		// Drain the channel, it is closed once the watch is canceled.
+		for range changes {
+		}
+	}()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return w.ctx.Err()
+		case c, ok := <-changes:
+			if !ok {
+				return nil
+			}
+			if c.Err != nil {
+				return c.Err
+			}
+			w.processSrvKeyspace(keyspace, c.Value)
+		}
+	}
+}
+
+// processSrvKeyspace records the serving shards of the keyspace and stops
+// buffering for the shards which are no longer serving.
+func (w *keyspaceEventWatcher) processSrvKeyspace(keyspace string, srvKeyspace *topodatapb.SrvKeyspace) {
+	var masterShards map[string]bool
+	for _, partition := range srvKeyspace.GetPartitions() {
+		if partition.ServedType != topodatapb.TabletType_MASTER {
+			continue
+		}
+		masterShards = make(map[string]bool)
+		for _, shardReference := range partition.ShardReferences {
+			masterShards[shardReference.Name] = true
+		}
+	}
+	if masterShards == nil {
+		// The keyspace is not serving yet. Nothing to do.
+		return
+	}
+
+	w.mu.Lock()
+	w.keyspaces[keyspace].masterShards = masterShards
+	w.mu.Unlock()
+
+	stopped := false
+	for _, sb := range w.b.shardBuffers(keyspace) {
+		if masterShards[sb.shard] {
+			continue
+		}
+		if sb.stopBufferingDueToKeyspaceEvent(stopShardMissing, "shard is no longer serving after a resharding cutover", ShardMissingError) {
+			stopped = true
+		}
+	}
+	if stopped {
+		keyspaceEvents.Add([]string{keyspace, string(stopShardMissing)}, 1)
+	}
+}
+
+// processRoutingRules records the routing rules and stops buffering for the
+// keyspaces whose routing rules changed.
+func (w *keyspaceEventWatcher) processRoutingRules(rules *vschemapb.RoutingRules) {
+	routingRules := make(map[string][]string)
+	for _, rule := range rules.GetRules() {
+		routingRules[rule.FromTable] = rule.ToTables
+	}
+
+	w.mu.Lock()
+	previous := w.routingRules
+	w.routingRules = routingRules
+	if previous == nil {
+		// First SrvVSchema, there is nothing to compare with.
+		w.mu.Unlock()
+		return
+	}
+	changed := make(map[string]bool)
+	for fromTable, toTables := range routingRules {
+		if !equalTables(previous[fromTable], toTables) {
+			addKeyspaces(changed, fromTable, previous[fromTable], toTables)
+		}
+	}
+	for fromTable, toTables := range previous {
+		if _, ok := routingRules[fromTable]; !ok {
+			addKeyspaces(changed, fromTable, toTables)
+		}
+	}
+	now := w.b.now()
+	for keyspace := range changed {
+		if state, ok := w.keyspaces[keyspace]; ok {
+			state.lastRoutingChange = now
+		}
+	}
+	w.mu.Unlock()
+
+	for keyspace := range changed {
+		stopped := false
+		for _, sb := range w.b.shardBuffers(keyspace) {
+			if sb.stopBufferingDueToKeyspaceEvent(stopRoutingRulesChanged, "routing rules changed", RoutingRulesChangedError) {
+				stopped = true
+			}
+		}
+		if stopped {
+			keyspaceEvents.Add([]string{keyspace, string(stopRoutingRulesChanged)}, 1)
+		}
+	}
+}
+
+// cutoverEnded returns an error if the request failed with "err" because of
+// a cutover which already changed the routing of keyspace/shard: either the
+// shard is no longer serving, or the routing rules of the keyspace changed
+// less than -buffer_window ago.
+func (w *keyspaceEventWatcher) cutoverEnded(keyspace, shard string, err error) error {
+	if w == nil {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	state, ok := w.keyspaces[keyspace]
+	if !ok {
+		return nil
+	}
+	if state.masterShards != nil && !state.masterShards[shard] {
+		return ShardMissingError
+	}
+	if causedByCutover(err) && !state.lastRoutingChange.IsZero() && w.b.now().Sub(state.lastRoutingChange) < *window {
+		return RoutingRulesChangedError
+	}
+	return nil
+}
+
+func (w *keyspaceEventWatcher) stop() {
+	if w == nil {
+		return
+	}
+	w.cancel()
+}
+
+func (w *keyspaceEventWatcher) waitForShutdown() {
+	if w == nil {
+		return
+	}
+	w.wg.Wait()
+}
+
+// equalTables returns true if both lists have the same tables in the same
+// order.
+func equalTables(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// addKeyspaces adds the keyspaces of the qualified tables to the set.
+// A table may also have a tablet type suffix e.g. "ks.t@replica".
+func addKeyspaces(keyspaces map[string]bool, fromTable string, toTables ...[]string) {
+	tables := []string{fromTable}
+	for _, t := range toTables {
+		tables = append(tables, t...)
+	}
+	for _, table := range tables {
+		if i := strings.Index(table, "."); i > 0 {
+			keyspaces[table[:i]] = true
+		}
+	}
+}
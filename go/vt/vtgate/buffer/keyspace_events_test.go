@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buffer
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var cutoverErr = vterrors.New(vtrpcpb.Code_CLUSTER_EVENT,
+	"vttablet: rpc error: code = Code(17) desc = disallowed due to rule: enforce blacklisted tables (CallerID: userData1)")
+
+func srvKeyspace(shards ...string) *topodatapb.SrvKeyspace {
+	partition := &topodatapb.SrvKeyspace_KeyspacePartition{ServedType: topodatapb.TabletType_MASTER}
+	for _, shard := range shards {
+		partition.ShardReferences = append(partition.ShardReferences, &topodatapb.ShardReference{Name: shard})
+	}
+	return &topodatapb.SrvKeyspace{Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{partition}}
+}
+
+func srvVSchema(toTable string) *vschemapb.SrvVSchema {
+	return &vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable: "t1",
+				ToTables:  []string{toTable},
+			}},
+		},
+	}
+}
+
+// newBufferWithKeyspaceEvents returns a buffer which watches the keyspace
+// events of "ts", once the first SrvKeyspace of "keyspace" and the first
+// SrvVSchema were seen.
+func newBufferWithKeyspaceEvents(ctx context.Context, t *testing.T, ts *topo.Server) *Buffer {
+	b := New()
+	b.WatchKeyspaceEvents(ctx, srvtopo.NewResilientServer(ts, t.Name()), "cell1")
+	// Start watching the keyspace.
+	b.getOrCreateBuffer(keyspace, shard)
+
+	w := b.keyspaceEventWatcher()
+	require.NotNil(t, w)
+	require.NoError(t, waitFor(func() bool {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		return w.keyspaces[keyspace].masterShards != nil && w.routingRules != nil
+	}))
+	return b
+}
+
+// waitFor polls "cond" for up to 10 seconds and returns an error if it is
+// not true by then.
+func waitFor(cond func() bool) error {
+	start := time.Now()
+	for !cond() {
+		if time.Since(start) > 10*time.Second {
+			return fmt.Errorf("condition not met after %v", time.Since(start))
+		}
+		time.Sleep(1 * time.Millisecond)
+	}
+	return nil
+}
+
+func TestKeyspaceEvents_ShardMissing(t *testing.T) {
+	resetVariables()
+	defer checkVariables(t)
+
+	flag.Set("enable_buffer", "true")
+	defer resetFlagsForTesting()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "cell1", keyspace, srvKeyspace(shard)))
+	require.NoError(t, ts.UpdateSrvVSchema(ctx, "cell1", srvVSchema(keyspace+".t1")))
+	b := newBufferWithKeyspaceEvents(ctx, t, ts)
+	defer b.Shutdown()
+
+	// A failover error during the resharding cutover starts buffering.
+	stopped := issueRequest(ctx, t, b, failoverErr)
+	require.NoError(t, waitForRequestsInFlight(b, 1))
+
+	// The shard is no longer serving once the cutover is over.
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "cell1", keyspace, srvKeyspace("-80", "80-")))
+	assert.Equal(t, ShardMissingError, <-stopped)
+	require.NoError(t, waitForState(b, stateIdle))
+	assert.EqualValues(t, 1, stops.Counts()[statsKeyJoined+"."+string(stopShardMissing)])
+	assert.EqualValues(t, 1, keyspaceEvents.Counts()[keyspace+"."+string(stopShardMissing)])
+	assert.EqualValues(t, 1, requestsRerouted.Counts()[keyspace])
+
+	// Later failover errors of the shard are not buffered.
+	retryDone, err := b.WaitForFailoverEnd(ctx, keyspace, shard, failoverErr)
+	assert.Nil(t, retryDone)
+	assert.Equal(t, ShardMissingError, err)
+	assert.EqualValues(t, 1, requestsSkipped.Counts()[statsKeyJoined+"."+skippedCutoverEnded])
+	assert.EqualValues(t, 2, requestsRerouted.Counts()[keyspace])
+
+	require.NoError(t, waitForPoolSlots(b, *size))
+}
+
+func TestKeyspaceEvents_RoutingRulesChanged(t *testing.T) {
+	resetVariables()
+	defer checkVariables(t)
+
+	flag.Set("enable_buffer", "true")
+	flag.Set("buffer_max_cutover_duration", "25s")
+	defer resetFlagsForTesting()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "cell1", keyspace, srvKeyspace(shard)))
+	require.NoError(t, ts.UpdateSrvVSchema(ctx, "cell1", srvVSchema(keyspace+".t1")))
+	b := newBufferWithKeyspaceEvents(ctx, t, ts)
+	defer b.Shutdown()
+
+	// The denied tables error of a MoveTables cutover starts buffering.
+	stopped := make(chan error)
+	go func() {
+		retryDone, err := b.WaitForFailoverEnd(ctx, keyspace, shard, cutoverErr)
+		if retryDone != nil {
+			retryDone()
+		}
+		stopped <- err
+	}()
+	require.NoError(t, waitForRequestsInFlight(b, 1))
+	sb := b.getOrCreateBuffer(keyspace, shard)
+	sb.mu.RLock()
+	assert.Equal(t, 25*time.Second, sb.maxDuration)
+	sb.mu.RUnlock()
+
+	// The routing rules point to the target keyspace once the cutover is over.
+	require.NoError(t, ts.UpdateSrvVSchema(ctx, "cell1", srvVSchema("ks2.t1")))
+	assert.Equal(t, RoutingRulesChangedError, <-stopped)
+	require.NoError(t, waitForState(b, stateIdle))
+	assert.EqualValues(t, 1, stops.Counts()[statsKeyJoined+"."+string(stopRoutingRulesChanged)])
+	assert.EqualValues(t, 1, keyspaceEvents.Counts()[keyspace+"."+string(stopRoutingRulesChanged)])
+
+	// Later denied tables errors are not buffered, the requests must be
+	// routed again.
+	retryDone, err := b.WaitForFailoverEnd(ctx, keyspace, shard, cutoverErr)
+	assert.Nil(t, retryDone)
+	assert.Equal(t, RoutingRulesChangedError, err)
+	assert.EqualValues(t, 2, requestsRerouted.Counts()[keyspace])
+
+	require.NoError(t, waitForPoolSlots(b, *size))
+}
+
+func TestCausedByKeyspaceEvent(t *testing.T) {
+	assert.False(t, CausedByKeyspaceEvent(nil))
+	assert.False(t, CausedByKeyspaceEvent(failoverErr))
+	assert.True(t, CausedByKeyspaceEvent(vterrors.Errorf(vterrors.Code(ShardMissingError),
+		"target: ks1.0.master: failed to automatically buffer and retry failed request during failover: %v", ShardMissingError)))
+	assert.True(t, CausedByKeyspaceEvent(RoutingRulesChangedError))
+	assert.True(t, CausedByKeyspaceEvent(vterrors.Wrap(cutoverErr, "target: ks1.0.master")))
+	// The message of an error is not enough: only its code is checked.
+	assert.False(t, CausedByKeyspaceEvent(vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION,
+		"target: ks1.0.master: %v", ShardMissingError.Error())))
+}
+
+func TestAddKeyspaces(t *testing.T) {
+	keyspaces := make(map[string]bool)
+	addKeyspaces(keyspaces, "t1", []string{"ks1.t1"}, []string{"ks2.t1"})
+	addKeyspaces(keyspaces, "ks3.t2@replica", nil)
+	assert.Equal(t, map[string]bool{"ks1": true, "ks2": true, "ks3": true}, keyspaces)
+}
@@ -83,6 +83,10 @@ type shardBuffer struct {
 	externallyReparented int64
 	// lastStart is the last time we saw the start of a failover.
 	lastStart time.Time
+	// maxDuration is how long the current buffering may last at most:
+	// -buffer_max_failover_duration for failovers and
+	// -buffer_max_cutover_duration for MoveTables cutovers.
+	maxDuration time.Duration
 	// lastEnd is the last time we saw the end of a failover.
 	lastEnd time.Time
 	// lastReparent is the last time we saw that the tablet alias of the MASTER
@@ -266,6 +270,10 @@ func (sb *shardBuffer) startBufferingLocked(err error) {
 	failoverDurationSumMs.Reset(sb.statsKey)
 
 	sb.lastStart = sb.now()
+	sb.maxDuration = *maxFailoverDuration
+	if causedByCutover(err) {
+		sb.maxDuration = *maxCutoverDuration
+	}
 	sb.logErrorIfStateNotLocked(stateIdle)
 	sb.state = stateBuffering
 	sb.queue = make([]*entry, 0)
@@ -278,7 +286,7 @@ func (sb *shardBuffer) startBufferingLocked(err error) {
 	}
 	starts.Add(sb.statsKey, 1)
 	log.Infof("%v for shard: %s (window: %v, size: %v, max failover duration: %v) (A failover was detected by this seen error: %v.)",
-		msg, topoproto.KeyspaceShardString(sb.keyspace, sb.shard), *window, *size, *maxFailoverDuration, err)
+		msg, topoproto.KeyspaceShardString(sb.keyspace, sb.shard), *window, *size, sb.maxDuration, err)
 }
 
 // logErrorIfStateNotLocked logs an error if the current state is not "state".
@@ -496,7 +504,7 @@ func (sb *shardBuffer) recordExternallyReparentedTimestamp(timestamp int64, alia
 		}
 		sb.currentMaster = alias
 	}
-	sb.stopBufferingLocked(stopFailoverEndDetected, "failover end detected")
+	sb.stopBufferingLocked(stopFailoverEndDetected, "failover end detected", nil /* err */)
 }
 
 func (sb *shardBuffer) stopBufferingDueToMaxDuration() {
@@ -504,10 +512,27 @@ func (sb *shardBuffer) stopBufferingDueToMaxDuration() {
 	defer sb.mu.Unlock()
 
 	sb.stopBufferingLocked(stopMaxFailoverDurationExceeded,
-		fmt.Sprintf("stopping buffering because failover did not finish in time (%v)", *maxFailoverDuration))
+		fmt.Sprintf("stopping buffering because failover did not finish in time (%v)", sb.maxDuration), nil /* err */)
 }
 
-func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
+// stopBufferingDueToKeyspaceEvent stops buffering because a cutover changed
+// the routing of the shard. The buffered requests will see "err" such that
+// vtgate routes them again. It returns true if buffering was in progress.
+func (sb *shardBuffer) stopBufferingDueToKeyspaceEvent(reason stopReason, details string, err error) bool {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	if sb.state != stateBuffering {
+		return false
+	}
+	sb.stopBufferingLocked(reason, details, err)
+	return true
+}
+
+// stopBufferingLocked stops buffering and drains the buffered requests.
+// If "err" is not nil, the drained requests will not be retried and will
+// return "err" instead.
+func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string, err error) {
 	if sb.state != stateBuffering {
 		return
 	}
@@ -546,10 +571,10 @@ func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
 
 	// Start the drain. (Use a new Go routine to release the lock.)
 	sb.wg.Add(1)
-	go sb.drain(q)
+	go sb.drain(q, err)
 }
 
-func (sb *shardBuffer) drain(q []*entry) {
+func (sb *shardBuffer) drain(q []*entry, err error) {
 	defer sb.wg.Done()
 
 	// stop must be called outside of the lock because the thread may access
@@ -559,11 +584,14 @@ func (sb *shardBuffer) drain(q []*entry) {
 	start := sb.now()
 	// TODO(mberlin): Parallelize the drain by pumping the data through a channel.
 	for _, e := range q {
-		sb.unblockAndWait(e, nil /* err */, true /* releaseSlot */, true /* blockingWait */)
+		sb.unblockAndWait(e, err, true /* releaseSlot */, true /* blockingWait */)
 	}
 	d := sb.now().Sub(start)
 	log.Infof("Draining finished for shard: %s Took: %v for: %d requests.", topoproto.KeyspaceShardString(sb.keyspace, sb.shard), d, len(q))
 	requestsDrained.Add(sb.statsKey, int64(len(q)))
+	if err != nil {
+		requestsRerouted.Add([]string{sb.keyspace}, int64(len(q)))
+	}
 
 	// Draining is done. Change state from "draining" to "idle".
 	sb.mu.Lock()
@@ -575,7 +603,7 @@ func (sb *shardBuffer) drain(q []*entry) {
 
 func (sb *shardBuffer) shutdown() {
 	sb.mu.Lock()
-	sb.stopBufferingLocked(stopShutdown, "shutdown")
+	sb.stopBufferingLocked(stopShutdown, "shutdown", nil /* err */)
 	sb.mu.Unlock()
 }
 
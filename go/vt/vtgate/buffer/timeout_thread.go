@@ -28,7 +28,7 @@ import (
 type timeoutThread struct {
 	sb *shardBuffer
 	// maxDuration enforces that a failover stops after
-	// -buffer_max_failover_duration at most.
+	// -buffer_max_failover_duration (or -buffer_max_cutover_duration) at most.
 	maxDuration *time.Timer
 	// stopChan will be closed when the thread should stop e.g. before the drain.
 	stopChan chan struct{}
@@ -46,7 +46,7 @@ type timeoutThread struct {
 func newTimeoutThread(sb *shardBuffer) *timeoutThread {
 	return &timeoutThread{
 		sb:            sb,
-		maxDuration:   time.NewTimer(*maxFailoverDuration),
+		maxDuration:   time.NewTimer(sb.maxDuration),
 		stopChan:      make(chan struct{}),
 		queueNotEmpty: make(chan struct{}),
 	}
@@ -100,17 +100,37 @@ var (
 		"BufferRequestsSkipped",
 		"Skipped buffering requests (incl. dry-run)",
 		[]string{"Keyspace", "ShardName", "Reason"})
+
+	// keyspaceEvents counts the keyspace events which ended a cutover, per
+	// keyspace.
+	// See the type "stopReason" below for all possible values of "Reason".
+	keyspaceEvents = stats.NewCountersWithMultiLabels(
+		"BufferKeyspaceEvents",
+		"Keyspace events which ended a cutover",
+		[]string{"Keyspace", "Reason"})
+	// requestsRerouted counts the requests which were returned to vtgate to be
+	// routed again because a cutover changed their routing, per keyspace.
+	requestsRerouted = stats.NewCountersWithMultiLabels(
+		"BufferRequestsRerouted",
+		"Requests routed again after a cutover",
+		[]string{"Keyspace"})
 )
 
 // stopReason is used in "stopsByReason" as "Reason" label.
 type stopReason string
 
-var stopReasons = []stopReason{stopFailoverEndDetected, stopMaxFailoverDurationExceeded, stopShutdown}
+var stopReasons = []stopReason{stopFailoverEndDetected, stopMaxFailoverDurationExceeded, stopShutdown, stopShardMissing, stopRoutingRulesChanged}
 
 const (
 	stopFailoverEndDetected         stopReason = "NewMasterSeen"
 	stopMaxFailoverDurationExceeded stopReason = "MaxDurationExceeded"
 	stopShutdown                    stopReason = "Shutdown"
+	// stopShardMissing is used when the shard is no longer serving after a
+	// resharding cutover.
+	stopShardMissing stopReason = "ShardMissing"
+	// stopRoutingRulesChanged is used when the routing rules of the keyspace
+	// changed e.g. after a MoveTables cutover.
+	stopRoutingRulesChanged stopReason = "RoutingRulesChanged"
 )
 
 // evictedReason is used in "requestsEvicted" as "Reason" label.
@@ -128,7 +148,7 @@ const (
 // skippedReason is used in "requestsSkipped" as "Reason" label.
 type skippedReason string
 
-var skippedReasons = []skippedReason{skippedBufferFull, skippedDisabled, skippedShutdown, skippedLastReparentTooRecent, skippedLastFailoverTooRecent}
+var skippedReasons = []skippedReason{skippedBufferFull, skippedDisabled, skippedShutdown, skippedLastReparentTooRecent, skippedLastFailoverTooRecent, skippedCutoverEnded}
 
 const (
 	// skippedBufferFull occurs when all slots in the buffer are occupied by one
@@ -141,6 +161,9 @@ const (
 	skippedShutdown              = "Shutdown"
 	skippedLastReparentTooRecent = "LastReparentTooRecent"
 	skippedLastFailoverTooRecent = "LastFailoverTooRecent"
+	// skippedCutoverEnded is used when the request failed because of a cutover
+	// which already changed the routing. The request is routed again instead.
+	skippedCutoverEnded = "CutoverEnded"
 )
 
 // initVariablesForShard is used to initialize all shard variables to 0.
@@ -173,6 +196,15 @@ func initVariablesForShard(statsKey []string) {
 	}
 }
 
+// initVariablesForKeyspace is the same as initVariablesForShard for the
+// variables which are tracked per keyspace.
+func initVariablesForKeyspace(keyspace string) {
+	for _, reason := range []stopReason{stopShardMissing, stopRoutingRulesChanged} {
+		keyspaceEvents.Reset([]string{keyspace, string(reason)})
+	}
+	requestsRerouted.Reset([]string{keyspace})
+}
+
 // TODO(mberlin): Remove the gauge values below once we store them
 // internally and have a /bufferz page where we can show this.
 var (
@@ -118,6 +118,11 @@ func NewDiscoveryGateway(ctx context.Context, hc discovery.LegacyHealthCheck, se
 	// We set sendDownEvents=true because it's required by LegacyTabletStatsCache.
 	hc.SetListener(dg, true /* sendDownEvents */)
 
+	// Watch the keyspace events which end resharding and MoveTables cutovers.
+	if serv != nil {
+		dg.buffer.WatchKeyspaceEvents(ctx, serv, cell)
+	}
+
 	cells := *CellsToWatch
 	log.Infof("loading tablets for cells: %v", cells)
 	for _, c := range strings.Split(cells, ",") {
@@ -266,22 +271,24 @@ func (dg *DiscoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 		if !bufferedOnce && !inTransaction && target.TabletType == topodatapb.TabletType_MASTER {
 			// The next call blocks if we should buffer during a failover.
 			retryDone, bufferErr := dg.buffer.WaitForFailoverEnd(ctx, target.Keyspace, target.Shard, err)
+			// Request may have been buffered.
+			if retryDone != nil {
+				// We're going to retry this request as part of a buffer drain.
+				// Notify the buffer after we retried (or failed because the
+				// cutover changed the routing of the request).
+				defer retryDone()
+				bufferedOnce = true
+			}
+
 			if bufferErr != nil {
-				// Buffering failed e.g. buffer is already full. Do not retry.
+				// Buffering failed e.g. buffer is already full or a cutover
+				// changed the routing of the request. Do not retry.
 				err = vterrors.Errorf(
 					vterrors.Code(bufferErr),
 					"failed to automatically buffer and retry failed request during failover: %v original err (type=%T): %v",
 					bufferErr, err, err)
 				break
 			}
-
-			// Request may have been buffered.
-			if retryDone != nil {
-				// We're going to retry this request as part of a buffer drain.
-				// Notify the buffer after we retried.
-				defer retryDone()
-				bufferedOnce = true
-			}
 		}
 
 		tablets := dg.tsc.GetHealthyTabletStats(target.Keyspace, target.Shard, target.TabletType)
@@ -185,6 +185,69 @@ func (cached *Generate) CachedSize(alloc bool) int64 {
 	size += cached.Values.CachedSize(false)
 	return size
 }
+func (cached *InformationSchema) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Table string
+	size += int64(len(cached.Table))
+	// field Keyspaces []string
+	{
+		size += int64(cap(cached.Keyspaces)) * int64(16)
+		for _, elem := range cached.Keyspaces {
+			size += int64(len(elem))
+		}
+	}
+	// field Columns []vitess.io/vitess/go/vt/vtgate/engine.InformationSchemaColumn
+	{
+		size += int64(cap(cached.Columns)) * int64(24)
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Filters []vitess.io/vitess/go/vt/vtgate/engine.InformationSchemaFilter
+	{
+		size += int64(cap(cached.Filters)) * int64(24)
+		for _, elem := range cached.Filters {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field OrderBy []vitess.io/vitess/go/vt/vtgate/engine.InformationSchemaOrder
+	{
+		size += int64(cap(cached.OrderBy)) * int64(16)
+	}
+	return size
+}
+func (cached *InformationSchemaColumn) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	return size
+}
+func (cached *InformationSchemaFilter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Value vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Value.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Insert) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -259,6 +322,16 @@ func (cached *Join) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Kill) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	return size
+}
 func (cached *Limit) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -391,7 +464,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(120)
+		size += int64(224)
 	}
 	// field Original string
 	size += int64(len(cached.Original))
@@ -408,6 +481,63 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field CacheTables []string
+	{
+		size += int64(cap(cached.CacheTables)) * int64(16)
+		for _, elem := range cached.CacheTables {
+			size += int64(len(elem))
+		}
+	}
+	return size
+}
+func (cached *Processlist) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Columns []vitess.io/vitess/go/vt/vtgate/engine.ProcesslistColumn
+	{
+		size += int64(cap(cached.Columns)) * int64(24)
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Filters []vitess.io/vitess/go/vt/vtgate/engine.ProcesslistFilter
+	{
+		size += int64(cap(cached.Filters)) * int64(24)
+		for _, elem := range cached.Filters {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
+func (cached *ProcesslistColumn) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	return size
+}
+func (cached *ProcesslistFilter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Value vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Value.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
 	return size
 }
 func (cached *Projection) CachedSize(alloc bool) int64 {
@@ -795,6 +925,22 @@ func (cached *UserDefinedVariable) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ViewDDL) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
+	size += cached.Keyspace.CachedSize(true)
+	// field DDL vitess.io/vitess/go/vt/sqlparser.DDLStatement
+	if cc, ok := cached.DDL.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *VindexFunc) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -851,6 +997,18 @@ func (cached *VindexValues) CachedSize(alloc bool) int64 {
 }
 
 //go:nocheckptr
+func (cached *VitessPlans) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Like string
+	size += int64(len(cached.Like))
+	return size
+}
 func (cached *shardRoute) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
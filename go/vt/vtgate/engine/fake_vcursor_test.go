@@ -61,6 +61,10 @@ func (t *noopVCursor) KeyspaceAvailable(ks string) bool {
 	panic("implement me")
 }
 
+func (t *noopVCursor) TrackedTables(ks string) map[string][]vindexes.Column {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetDDLStrategy(strategy string) {
 	panic("implement me")
 }
@@ -97,6 +101,18 @@ func (t *noopVCursor) HasCreatedTempTable() {
 	panic("implement me")
 }
 
+func (t *noopVCursor) Processlist() ([]*ProcessInfo, error) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) Kill(id uint64, queryOnly bool) error {
+	panic("implement me")
+}
+
+func (t *noopVCursor) CachedPlans() []*Plan {
+	panic("implement me")
+}
+
 func (t *noopVCursor) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	panic("implement me")
 }
@@ -140,6 +156,10 @@ func (t *noopVCursor) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.Alte
 	panic("implement me")
 }
 
+func (t *noopVCursor) ExecuteViewDDL(keyspace string, ddl sqlparser.DDLStatement) error {
+	panic("implement me")
+}
+
 func (t *noopVCursor) Session() SessionActions {
 	return t
 }
@@ -168,6 +188,10 @@ func (t *noopVCursor) SetWorkload(querypb.ExecuteOptions_Workload) {
 	panic("implement me")
 }
 
+func (t *noopVCursor) SetPriority(querypb.ExecuteOptions_Priority) {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion) {
 	panic("implement me")
 }
@@ -275,6 +299,11 @@ type loggingVCursor struct {
 	tableRoutes tableRoutes
 	dbDDLPlugin string
 	ksAvailable bool
+	// trackedTables are the tables known by the schema tracker, by keyspace.
+	trackedTables map[string]map[string][]vindexes.Column
+
+	processes []*ProcessInfo
+	plans     []*Plan
 }
 
 type tableRoutes struct {
@@ -285,6 +314,10 @@ func (f *loggingVCursor) KeyspaceAvailable(ks string) bool {
 	return f.ksAvailable
 }
 
+func (f *loggingVCursor) TrackedTables(ks string) map[string][]vindexes.Column {
+	return f.trackedTables[ks]
+}
+
 func (f *loggingVCursor) SetFoundRows(u uint64) {
 	panic("implement me")
 }
@@ -321,10 +354,27 @@ func (f *loggingVCursor) ExecuteVSchema(string, *sqlparser.AlterVschema) error {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) ExecuteViewDDL(string, sqlparser.DDLStatement) error {
+	panic("implement me")
+}
+
 func (f *loggingVCursor) Session() SessionActions {
 	return f
 }
 
+func (f *loggingVCursor) Processlist() ([]*ProcessInfo, error) {
+	return f.processes, nil
+}
+
+func (f *loggingVCursor) CachedPlans() []*Plan {
+	return append([]*Plan(nil), f.plans...)
+}
+
+func (f *loggingVCursor) Kill(id uint64, queryOnly bool) error {
+	f.log = append(f.log, fmt.Sprintf("Kill %d queryOnly: %v", id, queryOnly))
+	return nil
+}
+
 func (f *loggingVCursor) SetTarget(target string) error {
 	f.log = append(f.log, fmt.Sprintf("Target set to %s", target))
 	return nil
@@ -520,6 +570,10 @@ func (f *loggingVCursor) SetWorkload(querypb.ExecuteOptions_Workload) {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) SetPriority(querypb.ExecuteOptions_Priority) {
+	panic("implement me")
+}
+
 func (f *loggingVCursor) SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion) {
 	panic("implement me")
 }
@@ -0,0 +1,588 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*InformationSchema)(nil)
+
+// informationSchemaMerge is how the values of a column are merged across
+// the shards of a keyspace.
+type informationSchemaMerge int
+
+const (
+	// mergeFirst keeps the value of the first shard.
+	mergeFirst = informationSchemaMerge(iota)
+	// mergeKeyspace replaces the value with the name of the keyspace.
+	mergeKeyspace
+	// mergeSum sums the values of all the shards.
+	mergeSum
+	// mergeMax keeps the highest value of all the shards.
+	mergeMax
+	// mergeDerived is computed from the merged values of the other
+	// columns, by the finish function of the table.
+	mergeDerived
+)
+
+// passThrough returns true if the values of the column are the same on
+// all the shards, so that a filter on the column can be evaluated by the
+// shards.
+func (m informationSchemaMerge) passThrough() bool {
+	return m == mergeFirst
+}
+
+type informationSchemaColumn struct {
+	name  string
+	typ   querypb.Type
+	merge informationSchemaMerge
+}
+
+type informationSchemaTable struct {
+	columns []informationSchemaColumn
+	// key are the columns which identify a row in a keyspace.
+	key []int
+	// finish completes a row once the rows of all the shards are merged.
+	finish func(row []sqltypes.Value)
+}
+
+// informationSchemaTables are the tables of information_schema which are
+// emulated from the schemas of all the shards of all the keyspaces.
+var informationSchemaTables = map[string]*informationSchemaTable{
+	"tables": {
+		columns: []informationSchemaColumn{
+			{"TABLE_CATALOG", sqltypes.VarChar, mergeFirst},
+			{"TABLE_SCHEMA", sqltypes.VarChar, mergeKeyspace},
+			{"TABLE_NAME", sqltypes.VarChar, mergeFirst},
+			{"TABLE_TYPE", sqltypes.VarChar, mergeFirst},
+			{"ENGINE", sqltypes.VarChar, mergeFirst},
+			{"VERSION", sqltypes.Uint64, mergeFirst},
+			{"ROW_FORMAT", sqltypes.VarChar, mergeFirst},
+			{"TABLE_ROWS", sqltypes.Uint64, mergeSum},
+			{"AVG_ROW_LENGTH", sqltypes.Uint64, mergeDerived},
+			{"DATA_LENGTH", sqltypes.Uint64, mergeSum},
+			{"MAX_DATA_LENGTH", sqltypes.Uint64, mergeFirst},
+			{"INDEX_LENGTH", sqltypes.Uint64, mergeSum},
+			{"DATA_FREE", sqltypes.Uint64, mergeSum},
+			{"AUTO_INCREMENT", sqltypes.Uint64, mergeMax},
+			{"CREATE_TIME", sqltypes.Datetime, mergeFirst},
+			{"UPDATE_TIME", sqltypes.Datetime, mergeMax},
+			{"CHECK_TIME", sqltypes.Datetime, mergeMax},
+			{"TABLE_COLLATION", sqltypes.VarChar, mergeFirst},
+			{"CHECKSUM", sqltypes.Uint64, mergeFirst},
+			{"CREATE_OPTIONS", sqltypes.VarChar, mergeFirst},
+			{"TABLE_COMMENT", sqltypes.VarChar, mergeFirst},
+		},
+		key:    []int{2},
+		finish: averageRowLength,
+	},
+	"columns": {
+		columns: []informationSchemaColumn{
+			{"TABLE_CATALOG", sqltypes.VarChar, mergeFirst},
+			{"TABLE_SCHEMA", sqltypes.VarChar, mergeKeyspace},
+			{"TABLE_NAME", sqltypes.VarChar, mergeFirst},
+			{"COLUMN_NAME", sqltypes.VarChar, mergeFirst},
+			{"ORDINAL_POSITION", sqltypes.Uint64, mergeFirst},
+			{"COLUMN_DEFAULT", sqltypes.Text, mergeFirst},
+			{"IS_NULLABLE", sqltypes.VarChar, mergeFirst},
+			{"DATA_TYPE", sqltypes.VarChar, mergeFirst},
+			{"CHARACTER_MAXIMUM_LENGTH", sqltypes.Int64, mergeFirst},
+			{"CHARACTER_OCTET_LENGTH", sqltypes.Int64, mergeFirst},
+			{"NUMERIC_PRECISION", sqltypes.Uint64, mergeFirst},
+			{"NUMERIC_SCALE", sqltypes.Uint64, mergeFirst},
+			{"DATETIME_PRECISION", sqltypes.Uint64, mergeFirst},
+			{"CHARACTER_SET_NAME", sqltypes.VarChar, mergeFirst},
+			{"COLLATION_NAME", sqltypes.VarChar, mergeFirst},
+			{"COLUMN_TYPE", sqltypes.Text, mergeFirst},
+			{"COLUMN_KEY", sqltypes.VarChar, mergeFirst},
+			{"EXTRA", sqltypes.VarChar, mergeFirst},
+			{"PRIVILEGES", sqltypes.VarChar, mergeFirst},
+			{"COLUMN_COMMENT", sqltypes.VarChar, mergeFirst},
+			{"GENERATION_EXPRESSION", sqltypes.Text, mergeFirst},
+		},
+		key: []int{2, 3},
+	},
+	"statistics": {
+		columns: []informationSchemaColumn{
+			{"TABLE_CATALOG", sqltypes.VarChar, mergeFirst},
+			{"TABLE_SCHEMA", sqltypes.VarChar, mergeKeyspace},
+			{"TABLE_NAME", sqltypes.VarChar, mergeFirst},
+			{"NON_UNIQUE", sqltypes.Int64, mergeFirst},
+			{"INDEX_SCHEMA", sqltypes.VarChar, mergeKeyspace},
+			{"INDEX_NAME", sqltypes.VarChar, mergeFirst},
+			{"SEQ_IN_INDEX", sqltypes.Uint64, mergeFirst},
+			{"COLUMN_NAME", sqltypes.VarChar, mergeFirst},
+			{"COLLATION", sqltypes.VarChar, mergeFirst},
+			{"CARDINALITY", sqltypes.Int64, mergeSum},
+			{"SUB_PART", sqltypes.Int64, mergeFirst},
+			{"PACKED", sqltypes.VarChar, mergeFirst},
+			{"NULLABLE", sqltypes.VarChar, mergeFirst},
+			{"INDEX_TYPE", sqltypes.VarChar, mergeFirst},
+			{"COMMENT", sqltypes.VarChar, mergeFirst},
+			{"INDEX_COMMENT", sqltypes.VarChar, mergeFirst},
+		},
+		key: []int{2, 5, 6},
+	},
+}
+
+// InformationSchemaColumns returns the columns of an emulated table of
+// information_schema, in order, or nil if the table is not emulated.
+func InformationSchemaColumns(table string) []string {
+	t, ok := informationSchemaTables[strings.ToLower(table)]
+	if !ok {
+		return nil
+	}
+	columns := make([]string, 0, len(t.columns))
+	for _, col := range t.columns {
+		columns = append(columns, col.name)
+	}
+	return columns
+}
+
+// InformationSchemaColumn is a column returned by an InformationSchema.
+type InformationSchemaColumn struct {
+	// Name is the name of the field.
+	Name string
+	// Index is the position of the column in InformationSchemaColumns.
+	Index int
+}
+
+// InformationSchemaFilter only lets the rows whose column equals the value through.
+type InformationSchemaFilter struct {
+	Index int
+	Value evalengine.Expr
+}
+
+// InformationSchemaOrder sorts the rows by a column.
+type InformationSchemaOrder struct {
+	Index int
+	Desc  bool
+}
+
+// InformationSchema answers the queries on the tables, columns and
+// statistics tables of information_schema from the schemas of all the
+// shards of the keyspaces. The rows of the shards of a keyspace are merged,
+// the sizes of the tables are summed, and the schema names are replaced
+// with the names of the keyspaces.
+type InformationSchema struct {
+	// Table is the name of the table of information_schema, in lower case.
+	Table string
+	// Keyspaces are the keyspaces whose schemas are listed.
+	Keyspaces []string
+	// Columns are the columns to return.
+	Columns []InformationSchemaColumn
+	// Filters must all match for a row to be returned. The filters on
+	// the columns whose values are the same on all the shards are sent to
+	// the shards, and the filters on the schema names and on the values
+	// merged across the shards are evaluated by vtgate.
+	Filters []InformationSchemaFilter
+	// OrderBy sorts the rows.
+	OrderBy []InformationSchemaOrder
+
+	noTxNeeded
+
+	noInputs
+}
+
+func (is *InformationSchema) description() PrimitiveDescription {
+	table := informationSchemaTables[is.Table]
+	other := map[string]interface{}{
+		"Table":     is.Table,
+		"Keyspaces": strings.Join(is.Keyspaces, ", "),
+	}
+	columns := make([]string, 0, len(is.Columns))
+	for _, col := range is.Columns {
+		columns = append(columns, col.Name)
+	}
+	other["Columns"] = strings.Join(columns, ", ")
+	if len(is.Filters) > 0 {
+		filters := make([]string, 0, len(is.Filters))
+		for _, filter := range is.Filters {
+			filters = append(filters, table.columns[filter.Index].name+" = "+filter.Value.String())
+		}
+		other["Filters"] = strings.Join(filters, " and ")
+	}
+	if len(is.OrderBy) > 0 {
+		orderBy := make([]string, 0, len(is.OrderBy))
+		for _, order := range is.OrderBy {
+			direction := "ASC"
+			if order.Desc {
+				direction = "DESC"
+			}
+			orderBy = append(orderBy, table.columns[order.Index].name+" "+direction)
+		}
+		other["OrderBy"] = strings.Join(orderBy, ", ")
+	}
+	return PrimitiveDescription{
+		OperatorType: "InformationSchema",
+		Other:        other,
+	}
+}
+
+// RouteType implements the Primitive interface
+func (is *InformationSchema) RouteType() string {
+	return "InformationSchema"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (is *InformationSchema) GetKeyspaceName() string {
+	return ""
+}
+
+// GetTableName implements the Primitive interface
+func (is *InformationSchema) GetTableName() string {
+	return is.Table
+}
+
+// Execute implements the Primitive interface
+func (is *InformationSchema) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	table, ok := informationSchemaTables[is.Table]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected information_schema table: %s", is.Table)
+	}
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+		Row:      []sqltypes.Value{},
+	}
+
+	// The filters on the schema names select the keyspaces, the filters on
+	// the values merged across the shards are evaluated once the rows are
+	// merged, and the other filters are sent to the shards.
+	var keyspaceValues, tableValues []sqltypes.Value
+	var conditions []string
+	var mergedFilters []informationSchemaValueFilter
+	shardVars := map[string]*querypb.BindVariable{}
+	for i, filter := range is.Filters {
+		value, err := filter.Value.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		if value.Value().IsNull() {
+			return &sqltypes.Result{Fields: is.fields()}, nil
+		}
+		col := table.columns[filter.Index]
+		switch {
+		case col.merge == mergeKeyspace:
+			keyspaceValues = append(keyspaceValues, value.Value())
+		case col.merge.passThrough():
+			if col.name == "TABLE_NAME" {
+				tableValues = append(tableValues, value.Value())
+			}
+			name := fmt.Sprintf("vtg_is%d", i)
+			conditions = append(conditions, fmt.Sprintf(" and %s = :%s", col.name, name))
+			shardVars[name] = sqltypes.ValueBindVariable(value.Value())
+		default:
+			mergedFilters = append(mergedFilters, informationSchemaValueFilter{index: filter.Index, value: value.Value()})
+		}
+	}
+	columns := make([]string, 0, len(table.columns))
+	for _, col := range table.columns {
+		columns = append(columns, col.name)
+	}
+	query := fmt.Sprintf("select %s from information_schema.%s where table_schema = database()%s", strings.Join(columns, ", "), is.Table, strings.Join(conditions, ""))
+
+	// The schemas are the same on all the shards of a keyspace: unless
+	// values merged across the shards are needed, one shard is enough.
+	destination := key.Destination(key.DestinationAnyShard{})
+	if is.needsAllShards() {
+		destination = key.DestinationAllShards{}
+	}
+
+	var rows [][]sqltypes.Value
+	for _, keyspace := range is.Keyspaces {
+		if !keyspaceMatches(keyspace, keyspaceValues) || !vcursor.KeyspaceAvailable(keyspace) {
+			continue
+		}
+		if !tablesTracked(vcursor.TrackedTables(keyspace), tableValues) {
+			continue
+		}
+		rss, _, err := vcursor.ResolveDestinations(keyspace, nil, []key.Destination{destination})
+		if err != nil {
+			return nil, err
+		}
+		// The shards are read outside of the transaction of the session, if
+		// any: the reads must not start it on more shards, nor fail it.
+		var shardRows [][]sqltypes.Value
+		for _, rs := range rss {
+			result, err := vcursor.ExecuteStandalone(query, shardVars, rs)
+			if err != nil {
+				return nil, err
+			}
+			shardRows = append(shardRows, result.Rows...)
+		}
+		merged, err := table.merge(keyspace, shardRows)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range merged {
+			match, err := matchesAll(row, mergedFilters)
+			if err != nil {
+				return nil, err
+			}
+			if match {
+				rows = append(rows, row)
+			}
+		}
+	}
+	if err := is.sort(rows); err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{Fields: is.fields()}
+	for _, row := range rows {
+		out := make([]sqltypes.Value, 0, len(is.Columns))
+		for _, col := range is.Columns {
+			out = append(out, row[col.Index])
+		}
+		result.Rows = append(result.Rows, out)
+	}
+	return result, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (is *InformationSchema) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := is.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (is *InformationSchema) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: is.fields()}, nil
+}
+
+func (is *InformationSchema) fields() []*querypb.Field {
+	table := informationSchemaTables[is.Table]
+	fields := make([]*querypb.Field, 0, len(is.Columns))
+	for _, col := range is.Columns {
+		fields = append(fields, &querypb.Field{Name: col.Name, Type: table.columns[col.Index].typ})
+	}
+	return fields
+}
+
+func (is *InformationSchema) sort(rows [][]sqltypes.Value) error {
+	if len(is.OrderBy) == 0 {
+		return nil
+	}
+	var err error
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, order := range is.OrderBy {
+			cmp, cmpErr := compareInformationSchemaValues(rows[i][order.Index], rows[j][order.Index])
+			if cmpErr != nil {
+				err = cmpErr
+				return false
+			}
+			if cmp == 0 {
+				continue
+			}
+			if order.Desc {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+		return false
+	})
+	return err
+}
+
+// needsAllShards returns true if the values merged across the shards are
+// returned, filtered or sorted on.
+func (is *InformationSchema) needsAllShards() bool {
+	table := informationSchemaTables[is.Table]
+	merged := func(idx int) bool {
+		m := table.columns[idx].merge
+		return !m.passThrough() && m != mergeKeyspace
+	}
+	for _, col := range is.Columns {
+		if merged(col.Index) {
+			return true
+		}
+	}
+	for _, filter := range is.Filters {
+		if merged(filter.Index) {
+			return true
+		}
+	}
+	for _, order := range is.OrderBy {
+		if merged(order.Index) {
+			return true
+		}
+	}
+	return false
+}
+
+// keyspaceMatches returns true if the keyspace equals all the values. Like
+// the schema names of MySQL, the names of the keyspaces are compared case
+// insensitively.
+func keyspaceMatches(keyspace string, values []sqltypes.Value) bool {
+	for _, value := range values {
+		if !strings.EqualFold(value.ToString(), keyspace) {
+			return false
+		}
+	}
+	return true
+}
+
+// tablesTracked returns false if the tracked schema of a keyspace does not
+// have the tables, so that its shards aren't asked. A keyspace whose
+// schema is not tracked may have any table.
+func tablesTracked(tracked map[string][]vindexes.Column, tables []sqltypes.Value) bool {
+	if len(tracked) == 0 {
+		return true
+	}
+	for _, table := range tables {
+		if _, ok := tracked[table.ToString()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// informationSchemaValueFilter only lets the merged rows whose column equals
+// the value through.
+type informationSchemaValueFilter struct {
+	index int
+	value sqltypes.Value
+}
+
+func matchesAll(row []sqltypes.Value, filters []informationSchemaValueFilter) (bool, error) {
+	for _, filter := range filters {
+		if row[filter.index].IsNull() {
+			return false, nil
+		}
+		cmp, err := compareInformationSchemaValues(row[filter.index], filter.value)
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// merge merges the rows of all the shards of a keyspace. The rows are
+// returned in the order of their keys.
+func (t *informationSchemaTable) merge(keyspace string, shardRows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	byKey := map[string][]sqltypes.Value{}
+	for _, shardRow := range shardRows {
+		if len(shardRow) != len(t.columns) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected number of columns in information_schema row: %d", len(shardRow))
+		}
+		var keyParts []string
+		for _, idx := range t.key {
+			keyParts = append(keyParts, shardRow[idx].ToString())
+		}
+		rowKey := strings.Join(keyParts, "\x00")
+
+		row, ok := byKey[rowKey]
+		if !ok {
+			row = make([]sqltypes.Value, len(t.columns))
+			for i, col := range t.columns {
+				switch {
+				case col.merge == mergeKeyspace:
+					row[i] = sqltypes.NewVarChar(keyspace)
+				case shardRow[i].IsNull():
+					row[i] = sqltypes.NULL
+				default:
+					row[i] = sqltypes.MakeTrusted(col.typ, shardRow[i].Raw())
+				}
+			}
+			byKey[rowKey] = row
+			rows = append(rows, row)
+			continue
+		}
+		for i, col := range t.columns {
+			value := shardRow[i]
+			if value.IsNull() {
+				continue
+			}
+			value = sqltypes.MakeTrusted(col.typ, value.Raw())
+			switch col.merge {
+			case mergeSum:
+				if row[i].IsNull() {
+					row[i] = value
+				} else {
+					row[i] = evalengine.NullsafeAdd(row[i], value, col.typ)
+				}
+			case mergeMax:
+				cmp, err := compareInformationSchemaValues(row[i], value)
+				if err != nil {
+					return nil, err
+				}
+				if cmp < 0 {
+					row[i] = value
+				}
+			}
+		}
+	}
+	if t.finish != nil {
+		for _, row := range rows {
+			t.finish(row)
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, idx := range t.key {
+			cmp, _ := compareInformationSchemaValues(rows[i][idx], rows[j][idx])
+			if cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	return rows, nil
+}
+
+// averageRowLength computes the average row length of a table of
+// information_schema.tables from its summed data length and row count.
+func averageRowLength(row []sqltypes.Value) {
+	const tableRows, avgRowLength, dataLength = 7, 8, 9
+	rowCount, err := evalengine.ToUint64(row[tableRows])
+	if err != nil || rowCount == 0 {
+		return
+	}
+	length, err := evalengine.ToUint64(row[dataLength])
+	if err != nil {
+		return
+	}
+	row[avgRowLength] = sqltypes.NewUint64(length / rowCount)
+}
+
+// compareInformationSchemaValues compares numbers numerically, and other
+// values as case insensitive strings, like with the default collation.
+func compareInformationSchemaValues(v1, v2 sqltypes.Value) (int, error) {
+	if v1.IsNull() || v2.IsNull() || sqltypes.IsNumber(v1.Type()) || sqltypes.IsNumber(v2.Type()) {
+		return evalengine.NullsafeCompare(v1, v2)
+	}
+	return strings.Compare(strings.ToLower(v1.ToString()), strings.ToLower(v2.ToString())), nil
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// informationSchemaTablesResult builds the rows of information_schema.tables
+// as returned by the shards, from TABLE_SCHEMA|TABLE_NAME|TABLE_ROWS|DATA_LENGTH|UPDATE_TIME.
+func informationSchemaTablesResult(rows ...string) *sqltypes.Result {
+	columns := InformationSchemaColumns("tables")
+	types := strings.TrimSuffix(strings.Repeat("varchar|", len(columns)), "|")
+	var full []string
+	for _, row := range rows {
+		parts := strings.Split(row, "|")
+		full = append(full, strings.Join([]string{
+			"def", parts[0], parts[1], "BASE TABLE", "InnoDB", "10", "Dynamic", parts[2], "0", parts[3],
+			"0", "16384", "0", "null", "2021-01-01 00:00:00", parts[4], "null", "utf8mb4_general_ci", "null", "", "",
+		}, "|"))
+	}
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields(strings.Join(columns, "|"), types), full...)
+}
+
+func TestInformationSchemaMergesShards(t *testing.T) {
+	vc := &loggingVCursor{
+		shards:      []string{"-80", "80-"},
+		ksAvailable: true,
+		results: []*sqltypes.Result{
+			informationSchemaTablesResult(
+				"vt_ks_0|user|10|1000|2021-01-02 00:00:00",
+				"vt_ks_0|music|5|500|null",
+			),
+			informationSchemaTablesResult(
+				"vt_ks_1|user|30|2000|2021-01-03 00:00:00",
+				"vt_ks_1|music|0|0|null",
+			),
+			informationSchemaTablesResult(
+				"vt_main|seq|1|16384|null",
+			),
+			nil,
+		},
+	}
+	is := &InformationSchema{
+		Table:     "tables",
+		Keyspaces: []string{"ks", "main"},
+		Columns: []InformationSchemaColumn{
+			{Name: "TABLE_SCHEMA", Index: 1},
+			{Name: "name", Index: 2},
+			{Name: "TABLE_ROWS", Index: 7},
+			{Name: "AVG_ROW_LENGTH", Index: 8},
+			{Name: "DATA_LENGTH", Index: 9},
+			{Name: "UPDATE_TIME", Index: 15},
+		},
+		OrderBy: []InformationSchemaOrder{{Index: 7, Desc: true}},
+	}
+
+	result, err := is.Execute(vc, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("TABLE_SCHEMA|name|TABLE_ROWS|AVG_ROW_LENGTH|DATA_LENGTH|UPDATE_TIME", "varchar|varchar|uint64|uint64|uint64|datetime"),
+		"ks|user|40|75|3000|2021-01-03 00:00:00",
+		"ks|music|5|100|500|null",
+		"main|seq|1|16384|16384|null",
+	)
+	assert.Equal(t, want, result)
+	vc.ExpectLog(t, []string{
+		"ResolveDestinations ks [] Destinations:DestinationAllShards()",
+		"ExecuteStandalone " + isTablesQuery + "  ks -80",
+		"ExecuteStandalone " + isTablesQuery + "  ks 80-",
+		"ResolveDestinations main [] Destinations:DestinationAllShards()",
+		"ExecuteStandalone " + isTablesQuery + "  main -80",
+		"ExecuteStandalone " + isTablesQuery + "  main 80-",
+	})
+}
+
+const isTablesQuery = "select TABLE_CATALOG, TABLE_SCHEMA, TABLE_NAME, TABLE_TYPE, ENGINE, VERSION, ROW_FORMAT, TABLE_ROWS, " +
+	"AVG_ROW_LENGTH, DATA_LENGTH, MAX_DATA_LENGTH, INDEX_LENGTH, DATA_FREE, AUTO_INCREMENT, CREATE_TIME, UPDATE_TIME, " +
+	"CHECK_TIME, TABLE_COLLATION, CHECKSUM, CREATE_OPTIONS, TABLE_COMMENT from information_schema.tables where table_schema = database()"
+
+func TestInformationSchemaFilters(t *testing.T) {
+	vc := &loggingVCursor{
+		shards:      []string{"0"},
+		ksAvailable: true,
+		results: []*sqltypes.Result{
+			informationSchemaTablesResult("vt_main|seq|1|16384|null"),
+		},
+	}
+	is := &InformationSchema{
+		Table:     "tables",
+		Keyspaces: []string{"ks", "main"},
+		Columns:   []InformationSchemaColumn{{Name: "TABLE_NAME", Index: 2}},
+		Filters: []InformationSchemaFilter{
+			{Index: 1, Value: evalengine.NewLiteralString([]byte("MAIN"))},
+			{Index: 2, Value: evalengine.NewBindVar("name")},
+		},
+	}
+
+	// The keyspace names are compared case insensitively, and one shard
+	// is enough when no value merged across the shards is needed.
+	result, err := is.Execute(vc, map[string]*querypb.BindVariable{"name": sqltypes.StringBindVariable("seq")}, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("TABLE_NAME", "varchar"), "seq")
+	assert.Equal(t, want, result)
+	vc.ExpectLog(t, []string{
+		"ResolveDestinations main [] Destinations:DestinationAnyShard()",
+		`ExecuteStandalone ` + isTablesQuery + ` and TABLE_NAME = :vtg_is1 vtg_is1: type:VARBINARY value:"seq"  main 0`,
+	})
+
+	// A null value matches no row.
+	vc.Rewind()
+	result, err = is.Execute(vc, map[string]*querypb.BindVariable{"name": sqltypes.NullBindVariable}, true)
+	require.NoError(t, err)
+	assert.Empty(t, result.Rows)
+	vc.ExpectLog(t, nil)
+}
+
+func TestInformationSchemaMergedFilters(t *testing.T) {
+	vc := &loggingVCursor{
+		shards:      []string{"-80", "80-"},
+		ksAvailable: true,
+		results: []*sqltypes.Result{
+			informationSchemaTablesResult(
+				"vt_ks_0|user|10|1000|null",
+				"vt_ks_0|music|30|500|null",
+			),
+			informationSchemaTablesResult(
+				"vt_ks_1|user|30|2000|null",
+				"vt_ks_1|music|5|0|null",
+			),
+		},
+	}
+	// The filters on the summed row counts are evaluated once the rows of
+	// all the shards are merged, and are not sent to the shards.
+	is := &InformationSchema{
+		Table:     "tables",
+		Keyspaces: []string{"ks"},
+		Columns:   []InformationSchemaColumn{{Name: "TABLE_NAME", Index: 2}},
+		Filters:   []InformationSchemaFilter{{Index: 7, Value: evalengine.NewLiteralInt(40)}},
+	}
+
+	result, err := is.Execute(vc, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("TABLE_NAME", "varchar"), "user")
+	assert.Equal(t, want, result)
+	vc.ExpectLog(t, []string{
+		"ResolveDestinations ks [] Destinations:DestinationAllShards()",
+		"ExecuteStandalone " + isTablesQuery + "  ks -80",
+		"ExecuteStandalone " + isTablesQuery + "  ks 80-",
+	})
+}
+
+func TestInformationSchemaTrackedTables(t *testing.T) {
+	vc := &loggingVCursor{
+		shards:      []string{"0"},
+		ksAvailable: true,
+		results: []*sqltypes.Result{
+			informationSchemaTablesResult("vt_main|seq|1|16384|null"),
+		},
+		trackedTables: map[string]map[string][]vindexes.Column{
+			"ks":   {"user": nil},
+			"main": {"seq": nil},
+		},
+	}
+	is := &InformationSchema{
+		Table:     "tables",
+		Keyspaces: []string{"ks", "main", "other"},
+		Columns:   []InformationSchemaColumn{{Name: "TABLE_NAME", Index: 2}},
+		Filters:   []InformationSchemaFilter{{Index: 2, Value: evalengine.NewLiteralString([]byte("seq"))}},
+	}
+
+	// The keyspaces whose tracked schema does not have the table are not
+	// asked, and the keyspaces whose schema is not tracked are.
+	result, err := is.Execute(vc, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("TABLE_NAME", "varchar"), "seq")
+	assert.Equal(t, want, result)
+	vc.ExpectLog(t, []string{
+		"ResolveDestinations main [] Destinations:DestinationAnyShard()",
+		`ExecuteStandalone ` + isTablesQuery + ` and TABLE_NAME = :vtg_is0 vtg_is0: type:VARBINARY value:"seq"  main 0`,
+		"ResolveDestinations other [] Destinations:DestinationAnyShard()",
+		`ExecuteStandalone ` + isTablesQuery + ` and TABLE_NAME = :vtg_is0 vtg_is0: type:VARBINARY value:"seq"  other 0`,
+	})
+}
+
+func TestInformationSchemaGetFields(t *testing.T) {
+	is := &InformationSchema{
+		Table:   "statistics",
+		Columns: []InformationSchemaColumn{{Name: "TABLE_SCHEMA", Index: 1}, {Name: "CARDINALITY", Index: 9}},
+	}
+	result, err := is.GetFields(&noopVCursor{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestFields("TABLE_SCHEMA|CARDINALITY", "varchar|int64"), result.Fields)
+}
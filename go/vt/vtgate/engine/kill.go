@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Kill)(nil)
+
+// Kill cancels the query running on a client connection of the vtgate
+// and, unless QueryOnly is set, closes the connection.
+type Kill struct {
+	ProcesslistID uint64
+	QueryOnly     bool
+
+	noTxNeeded
+
+	noInputs
+}
+
+func (k *Kill) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"ProcesslistID": k.ProcesslistID,
+	}
+	if k.QueryOnly {
+		other["QueryOnly"] = true
+	}
+	return PrimitiveDescription{
+		OperatorType: "Kill",
+		Other:        other,
+	}
+}
+
+// RouteType implements the Primitive interface
+func (k *Kill) RouteType() string {
+	return "Kill"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (k *Kill) GetKeyspaceName() string {
+	return ""
+}
+
+// GetTableName implements the Primitive interface
+func (k *Kill) GetTableName() string {
+	return ""
+}
+
+// Execute implements the Primitive interface
+func (k *Kill) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if err := vcursor.Session().Kill(k.ProcesslistID, k.QueryOnly); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (k *Kill) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := k.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (k *Kill) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] GetFields is not reachable")
+}
@@ -18,6 +18,8 @@ package engine
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -87,6 +89,9 @@ type (
 
 		ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) error
 
+		// ExecuteViewDDL creates, alters or drops a view of the VSchema.
+		ExecuteViewDDL(keyspace string, ddl sqlparser.DDLStatement) error
+
 		SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error
 
 		Session() SessionActions
@@ -104,6 +109,10 @@ type (
 
 		// KeyspaceAvailable returns true when a keyspace is visible from vtgate
 		KeyspaceAvailable(ks string) bool
+
+		// TrackedTables returns the columns of the tables of a keyspace
+		// known by the schema tracker, or nil if its schema is not tracked.
+		TrackedTables(ks string) map[string][]vindexes.Column
 	}
 
 	//SessionActions gives primitives ability to interact with the session state
@@ -132,6 +141,7 @@ type (
 		SetSQLSelectLimit(int64) error
 		SetTransactionMode(vtgatepb.TransactionMode)
 		SetWorkload(querypb.ExecuteOptions_Workload)
+		SetPriority(querypb.ExecuteOptions_Priority)
 		SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion)
 		SetFoundRows(uint64)
 
@@ -150,6 +160,16 @@ type (
 
 		// HasCreatedTempTable will mark the session as having created temp tables
 		HasCreatedTempTable()
+
+		// Processlist returns the client connections the current user is allowed to see
+		Processlist() ([]*ProcessInfo, error)
+
+		// Kill cancels the query running on a client connection and,
+		// unless queryOnly is set, closes the connection
+		Kill(id uint64, queryOnly bool) error
+
+		// CachedPlans returns the plans in the plan cache of the vtgate
+		CachedPlans() []*Plan
 	}
 
 	// Plan represents the execution strategy for a given query.
@@ -158,18 +178,22 @@ type (
 	// each node does its part by combining the results of the
 	// sub-nodes.
 	Plan struct {
-		Type         sqlparser.StatementType // The type of query we have
-		Original     string                  // Original is the original query.
-		Instructions Primitive               // Instructions contains the instructions needed to fulfil the query.
-		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
-		Warnings     []*querypb.QueryWarning // Warnings that need to be yielded every time this query runs
-
-		ExecCount    uint64 // Count of times this plan was executed
-		ExecTime     uint64 // Total execution time
-		ShardQueries uint64 // Total number of shard queries
-		RowsReturned uint64 // Total number of rows
-		RowsAffected uint64 // Total number of rows
-		Errors       uint64 // Total number of errors
+		Type         sqlparser.StatementType               // The type of query we have
+		Original     string                                // Original is the original query.
+		Instructions Primitive                             // Instructions contains the instructions needed to fulfil the query.
+		BindVarNeeds *sqlparser.BindVarNeeds               // Stores BindVars needed to be provided as part of expression rewriting
+		Warnings     []*querypb.QueryWarning               // Warnings that need to be yielded every time this query runs
+		CacheTables  []string                              // Qualified tables read by the query, set if its results may be cached
+		Planner      querypb.ExecuteOptions_PlannerVersion // Planner used to build the plan, if known
+		Pinned       bool                                  // Pinned is true if the planner was pinned for the query
+
+		ExecCount    uint64                         // Count of times this plan was executed
+		ExecTime     uint64                         // Total execution time
+		ShardQueries uint64                         // Total number of shard queries
+		RowsReturned uint64                         // Total number of rows
+		RowsAffected uint64                         // Total number of rows
+		Errors       uint64                         // Total number of errors
+		ExecTimes    [planLatencyBucketCount]uint64 // Count of executions by latency bucket, see PlanLatencyCutoffs
 	}
 
 	// Match is used to check if a Primitive matches
@@ -214,6 +238,48 @@ func (p *Plan) AddStats(execCount uint64, execTime time.Duration, shardQueries,
 	atomic.AddUint64(&p.RowsAffected, rowsAffected)
 	atomic.AddUint64(&p.RowsReturned, rowsReturned)
 	atomic.AddUint64(&p.Errors, errors)
+	if execCount > 0 {
+		atomic.AddUint64(&p.ExecTimes[planLatencyBucket(execTime/time.Duration(execCount))], execCount)
+	}
+}
+
+// PlanLatencyCutoffs are the upper bounds of the buckets of the latency
+// histogram of the plans. The last bucket counts the slower executions.
+// It is an array so that the number of buckets is known at compile time.
+var PlanLatencyCutoffs = [...]time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+}
+
+const planLatencyBucketCount = len(PlanLatencyCutoffs) + 1
+
+func planLatencyBucket(d time.Duration) int {
+	for i, cutoff := range PlanLatencyCutoffs {
+		if d <= cutoff {
+			return i
+		}
+	}
+	return len(PlanLatencyCutoffs)
+}
+
+// LatencyHistogram returns the number of executions of the plan by
+// latency bucket, as "cutoff:count" pairs separated by spaces.
+func (p *Plan) LatencyHistogram() string {
+	buckets := make([]string, 0, planLatencyBucketCount)
+	for i := 0; i < planLatencyBucketCount; i++ {
+		name := "inf"
+		if i < len(PlanLatencyCutoffs) {
+			name = PlanLatencyCutoffs[i].String()
+		}
+		buckets = append(buckets, fmt.Sprintf("%s:%d", name, atomic.LoadUint64(&p.ExecTimes[i])))
+	}
+	return strings.Join(buckets, " ")
 }
 
 // Stats returns a copy of the plan execution statistics
@@ -257,6 +323,8 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 	marshalPlan := struct {
 		QueryType    string
 		Original     string                `json:",omitempty"`
+		Planner      string                `json:",omitempty"`
+		Pinned       bool                  `json:",omitempty"`
 		Instructions *PrimitiveDescription `json:",omitempty"`
 		ExecCount    uint64                `json:",omitempty"`
 		ExecTime     time.Duration         `json:",omitempty"`
@@ -264,9 +332,11 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		RowsAffected uint64                `json:",omitempty"`
 		RowsReturned uint64                `json:",omitempty"`
 		Errors       uint64                `json:",omitempty"`
+		Latency      string                `json:",omitempty"`
 	}{
 		QueryType:    p.Type.String(),
 		Original:     p.Original,
+		Pinned:       p.Pinned,
 		Instructions: instructions,
 		ExecCount:    atomic.LoadUint64(&p.ExecCount),
 		ExecTime:     time.Duration(atomic.LoadUint64(&p.ExecTime)),
@@ -275,6 +345,12 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		RowsReturned: atomic.LoadUint64(&p.RowsReturned),
 		Errors:       atomic.LoadUint64(&p.Errors),
 	}
+	if p.Planner != querypb.ExecuteOptions_DEFAULT_PLANNER {
+		marshalPlan.Planner = p.Planner.String()
+	}
+	if marshalPlan.ExecCount > 0 {
+		marshalPlan.Latency = p.LatencyHistogram()
+	}
 	return json.Marshal(marshalPlan)
 }
 
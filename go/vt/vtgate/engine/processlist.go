@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Processlist)(nil)
+
+// ProcessInfo describes a client connection of the vtgate.
+type ProcessInfo struct {
+	ID      uint64
+	User    string
+	Host    string
+	DB      string
+	Command string
+	Time    int64
+	State   string
+	Info    string
+}
+
+// ProcesslistColumns are the columns of SHOW PROCESSLIST, in order.
+var ProcesslistColumns = []string{"Id", "User", "Host", "db", "Command", "Time", "State", "Info"}
+
+// maxProcesslistInfo is the number of characters of the Info
+// column returned by SHOW PROCESSLIST without FULL.
+const maxProcesslistInfo = 100
+
+// ProcesslistColumn is a column returned by a Processlist.
+type ProcesslistColumn struct {
+	// Name is the name of the field.
+	Name string
+	// Index is the position of the column in ProcesslistColumns.
+	Index int
+}
+
+// ProcesslistFilter only lets the rows whose column equals the value through.
+type ProcesslistFilter struct {
+	Index int
+	Value evalengine.Expr
+}
+
+// Processlist lists the client connections of the vtgate.
+// It is used for SHOW PROCESSLIST and for queries on
+// information_schema.processlist.
+type Processlist struct {
+	// Full returns the whole Info column instead of its first 100 characters.
+	Full bool
+	// Columns are the columns to return.
+	Columns []ProcesslistColumn
+	// Filters must all match for a row to be returned.
+	Filters []ProcesslistFilter
+
+	noTxNeeded
+
+	noInputs
+}
+
+// NewShowProcesslist returns the primitive for SHOW [FULL] PROCESSLIST.
+func NewShowProcesslist(full bool) *Processlist {
+	columns := make([]ProcesslistColumn, 0, len(ProcesslistColumns))
+	for i, name := range ProcesslistColumns {
+		columns = append(columns, ProcesslistColumn{Name: name, Index: i})
+	}
+	return &Processlist{Full: full, Columns: columns}
+}
+
+func (p *Processlist) description() PrimitiveDescription {
+	other := map[string]interface{}{}
+	if p.Full {
+		other["Full"] = true
+	}
+	columns := make([]string, 0, len(p.Columns))
+	for _, col := range p.Columns {
+		columns = append(columns, col.Name)
+	}
+	other["Columns"] = strings.Join(columns, ", ")
+	if len(p.Filters) > 0 {
+		filters := make([]string, 0, len(p.Filters))
+		for _, filter := range p.Filters {
+			filters = append(filters, ProcesslistColumns[filter.Index]+" = "+filter.Value.String())
+		}
+		other["Filters"] = strings.Join(filters, " and ")
+	}
+	return PrimitiveDescription{
+		OperatorType: "Processlist",
+		Other:        other,
+	}
+}
+
+// RouteType implements the Primitive interface
+func (p *Processlist) RouteType() string {
+	return "Processlist"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (p *Processlist) GetKeyspaceName() string {
+	return ""
+}
+
+// GetTableName implements the Primitive interface
+func (p *Processlist) GetTableName() string {
+	return "processlist"
+}
+
+// Execute implements the Primitive interface
+func (p *Processlist) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	processes, err := vcursor.Session().Processlist()
+	if err != nil {
+		return nil, err
+	}
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+		Row:      []sqltypes.Value{},
+	}
+	values := make([]sqltypes.Value, 0, len(p.Filters))
+	for _, filter := range p.Filters {
+		value, err := filter.Value.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value.Value())
+	}
+
+	result := &sqltypes.Result{Fields: p.fields()}
+	for _, process := range processes {
+		row := p.processRow(process)
+		match, err := p.matches(row, values)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			continue
+		}
+		out := make([]sqltypes.Value, 0, len(p.Columns))
+		for _, col := range p.Columns {
+			out = append(out, row[col.Index])
+		}
+		result.Rows = append(result.Rows, out)
+	}
+	return result, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (p *Processlist) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := p.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (p *Processlist) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: p.fields()}, nil
+}
+
+func (p *Processlist) fields() []*querypb.Field {
+	fields := make([]*querypb.Field, 0, len(p.Columns))
+	for _, col := range p.Columns {
+		typ := sqltypes.VarChar
+		switch col.Index {
+		case 0:
+			typ = sqltypes.Uint64
+		case 5:
+			typ = sqltypes.Int64
+		}
+		fields = append(fields, &querypb.Field{Name: col.Name, Type: typ})
+	}
+	return fields
+}
+
+func (p *Processlist) processRow(process *ProcessInfo) []sqltypes.Value {
+	db := sqltypes.NULL
+	if process.DB != "" {
+		db = sqltypes.NewVarChar(process.DB)
+	}
+	info := sqltypes.NULL
+	if process.Info != "" {
+		text := process.Info
+		if !p.Full && len(text) > maxProcesslistInfo {
+			text = text[:maxProcesslistInfo]
+		}
+		info = sqltypes.NewVarChar(text)
+	}
+	return []sqltypes.Value{
+		sqltypes.NewUint64(process.ID),
+		sqltypes.NewVarChar(process.User),
+		sqltypes.NewVarChar(process.Host),
+		db,
+		sqltypes.NewVarChar(process.Command),
+		sqltypes.NewInt64(process.Time),
+		sqltypes.NewVarChar(process.State),
+		info,
+	}
+}
+
+func (p *Processlist) matches(row, values []sqltypes.Value) (bool, error) {
+	for i, filter := range p.Filters {
+		v := row[filter.Index]
+		if v.IsNull() || values[i].IsNull() {
+			return false, nil
+		}
+		if !sqltypes.IsNumber(v.Type()) && !sqltypes.IsNumber(values[i].Type()) {
+			// Text columns compare case insensitively, like with the default collation.
+			if !strings.EqualFold(v.ToString(), values[i].ToString()) {
+				return false, nil
+			}
+			continue
+		}
+		cmp, err := evalengine.NullsafeCompare(v, values[i])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func testProcesses() []*ProcessInfo {
+	return []*ProcessInfo{{
+		ID:      1,
+		User:    "alice",
+		Host:    "127.0.0.1:5000",
+		Command: "Sleep",
+		Time:    10,
+	}, {
+		ID:      2,
+		User:    "bob",
+		Host:    "127.0.0.1:5001",
+		DB:      "ks@replica",
+		Command: "Query",
+		Time:    3,
+		State:   "executing",
+		Info:    "select " + strings.Repeat("a", 200) + " from t",
+	}}
+}
+
+func TestShowProcesslist(t *testing.T) {
+	vc := &loggingVCursor{processes: testProcesses()}
+
+	result, err := NewShowProcesslist(false).Execute(vc, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Id|User|Host|db|Command|Time|State|Info", "uint64|varchar|varchar|varchar|varchar|int64|varchar|varchar"),
+		"1|alice|127.0.0.1:5000|null|Sleep|10||null",
+		"2|bob|127.0.0.1:5001|ks@replica|Query|3|executing|select "+strings.Repeat("a", 93),
+	)
+	assert.Equal(t, want, result)
+
+	result, err = NewShowProcesslist(true).Execute(vc, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, "select "+strings.Repeat("a", 200)+" from t", result.Rows[1][7].ToString())
+}
+
+func TestProcesslistFilters(t *testing.T) {
+	vc := &loggingVCursor{processes: testProcesses()}
+	p := &Processlist{
+		Full:    true,
+		Columns: []ProcesslistColumn{{Name: "ID", Index: 0}, {Name: "COMMAND", Index: 4}},
+		Filters: []ProcesslistFilter{{Index: 1, Value: evalengine.NewLiteralString([]byte("BOB"))}},
+	}
+	result, err := p.Execute(vc, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("ID|COMMAND", "uint64|varchar"),
+		"2|Query",
+	)
+	assert.Equal(t, want, result)
+
+	p.Filters = []ProcesslistFilter{{Index: 0, Value: &evalengine.BindVariable{Key: "vtg1"}}}
+	result, err = p.Execute(vc, map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(1)}, true)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewUint64(1), sqltypes.NewVarChar("Sleep")}}, result.Rows)
+
+	p.Filters = []ProcesslistFilter{{Index: 3, Value: evalengine.NewLiteralString([]byte("ks"))}}
+	result, err = p.Execute(vc, nil, true)
+	require.NoError(t, err)
+	assert.Empty(t, result.Rows)
+}
+
+func TestKill(t *testing.T) {
+	vc := &loggingVCursor{}
+	_, err := (&Kill{ProcesslistID: 12}).Execute(vc, nil, false)
+	require.NoError(t, err)
+	_, err = (&Kill{ProcesslistID: 13, QueryOnly: true}).Execute(vc, nil, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		"Kill 12 queryOnly: false",
+		"Kill 13 queryOnly: true",
+	})
+}
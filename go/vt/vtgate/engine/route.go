@@ -73,8 +73,9 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a multi-column vindex, there is one value per column.
 	Values []sqltypes.PlanValue
 
 	// OrderBy specifies the key order for merge sorting. This will be
@@ -515,11 +516,18 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
-	key, err := route.Values[0].ResolveValue(bindVars)
-	if err != nil {
-		return nil, nil, err
+	var rss []*srvtopo.ResolvedShard
+	var err error
+	if _, ok := route.Vindex.(vindexes.MultiColumn); ok {
+		rss, err = route.resolveMultiColumnShards(vcursor, bindVars)
+	} else {
+		var key sqltypes.Value
+		key, err = route.Values[0].ResolveValue(bindVars)
+		if err != nil {
+			return nil, nil, err
+		}
+		rss, _, err = resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -558,15 +566,39 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
-func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+// resolveMultiColumnShards resolves the shards for a multi-column vindex,
+// using one value per vindex column.
+func (route *Route) resolveMultiColumnShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, error) {
+	row := make([]sqltypes.Value, 0, len(route.Values))
+	for _, pv := range route.Values {
+		val, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, val)
+	}
+	destinations, err := vindexes.Map(route.Vindex, vcursor, [][]sqltypes.Value{row})
+	if err != nil {
+		return nil, err
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, destinations)
+	return rss, err
+}
+
+func resolveShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
 	for i, vik := range vindexKeys {
 		ids[i] = sqltypes.ValueToProto(vik)
 	}
 
+	single, ok := vindex.(vindexes.SingleColumn)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] vindex %s cannot route on a single column", vindex)
+	}
+
 	// Map using the Vindex
-	destinations, err := vindex.Map(vcursor, vindexKeys)
+	destinations, err := single.Map(vcursor, vindexKeys)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -360,6 +360,51 @@ func TestSelectEqual(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectEqualMultiColumn(t *testing.T) {
+	vindex, _ := vindexes.NewLookupMultiColumn("", map[string]string{
+		"table": "lkp",
+		"from":  "froma, fromb",
+		"to":    "toc",
+	})
+	sel := NewRoute(
+		SelectEqual,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex
+	sel.Values = []sqltypes.PlanValue{
+		{Value: sqltypes.NewInt64(1)},
+		{Key: "b"},
+	}
+
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"froma|fromb|toc",
+					"int64|int64|varbinary",
+				),
+				"1|2|\x00",
+				"1|2|\x80",
+			),
+			defaultSelectResult,
+		},
+	}
+	result, err := sel.Execute(vc, map[string]*querypb.BindVariable{"b": sqltypes.Int64BindVariable(2)}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`Execute select froma, fromb, toc from lkp where (froma, fromb) in ((:froma_0, :fromb_0)) froma_0: type:INT64 value:"1" fromb_0: type:INT64 value:"2"  false`,
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceIDs(00,80)`,
+		`ExecuteMultiShard ks.-20: dummy_select {b: type:INT64 value:"2" } ks.20-: dummy_select {b: type:INT64 value:"2" } false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+}
+
 func TestSelectEqualNoRoute(t *testing.T) {
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
 		"table": "lkp",
@@ -399,6 +399,16 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid workload: %s", str)
 		}
 		vcursor.Session().SetWorkload(querypb.ExecuteOptions_Workload(out))
+	case sysvars.Priority.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		out, ok := querypb.ExecuteOptions_Priority_value[strings.ToUpper(str)]
+		if !ok {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid priority: %s", str)
+		}
+		vcursor.Session().SetPriority(querypb.ExecuteOptions_Priority(out))
 	case sysvars.DDLStrategy.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
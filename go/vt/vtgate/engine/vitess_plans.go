@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*VitessPlans)(nil)
+
+// vitessPlansColumns are the columns of SHOW VITESS_PLANS, in the order
+// vitessPlansRow builds the values of a row.
+var vitessPlansColumns = []struct {
+	name string
+	typ  querypb.Type
+}{
+	{"Query", sqltypes.VarChar},
+	{"Type", sqltypes.VarChar},
+	{"Planner", sqltypes.VarChar},
+	{"Pinned", sqltypes.Int8},
+	{"Count", sqltypes.Uint64},
+	{"Time", sqltypes.Float64},
+	{"Shard_queries", sqltypes.Uint64},
+	{"Rows_affected", sqltypes.Uint64},
+	{"Rows_returned", sqltypes.Uint64},
+	{"Errors", sqltypes.Uint64},
+	{"Latency", sqltypes.VarChar},
+	{"Plan", sqltypes.VarChar},
+}
+
+// VitessPlans lists the plans in the plan cache of the vtgate, with
+// their execution statistics. It is used for SHOW VITESS_PLANS.
+type VitessPlans struct {
+	// Like only lets the plans whose query matches the pattern through.
+	Like string
+
+	noTxNeeded
+
+	noInputs
+}
+
+func (v *VitessPlans) description() PrimitiveDescription {
+	other := map[string]interface{}{}
+	if v.Like != "" {
+		other["Like"] = v.Like
+	}
+	return PrimitiveDescription{
+		OperatorType: "VitessPlans",
+		Other:        other,
+	}
+}
+
+// RouteType implements the Primitive interface
+func (v *VitessPlans) RouteType() string {
+	return "VitessPlans"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (v *VitessPlans) GetKeyspaceName() string {
+	return ""
+}
+
+// GetTableName implements the Primitive interface
+func (v *VitessPlans) GetTableName() string {
+	return ""
+}
+
+// Execute implements the Primitive interface
+func (v *VitessPlans) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	plans := vcursor.Session().CachedPlans()
+	if v.Like != "" {
+		re := sqlparser.LikeToRegexp(v.Like)
+		filtered := plans[:0]
+		for _, plan := range plans {
+			if re.MatchString(plan.Original) {
+				filtered = append(filtered, plan)
+			}
+		}
+		plans = filtered
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].Original < plans[j].Original
+	})
+
+	result := &sqltypes.Result{Fields: vitessPlansFields()}
+	for _, plan := range plans {
+		row, err := vitessPlansRow(plan)
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (v *VitessPlans) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := v.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (v *VitessPlans) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: vitessPlansFields()}, nil
+}
+
+func vitessPlansFields() []*querypb.Field {
+	fields := make([]*querypb.Field, 0, len(vitessPlansColumns))
+	for _, col := range vitessPlansColumns {
+		fields = append(fields, &querypb.Field{Name: col.name, Type: col.typ})
+	}
+	return fields
+}
+
+func vitessPlansRow(plan *Plan) ([]sqltypes.Value, error) {
+	planner := sqltypes.NULL
+	if plan.Planner != querypb.ExecuteOptions_DEFAULT_PLANNER {
+		planner = sqltypes.NewVarChar(plan.Planner.String())
+	}
+	var pinned int64
+	if plan.Pinned {
+		pinned = 1
+	}
+	instructions := sqltypes.NULL
+	if plan.Instructions != nil {
+		buf, err := json.Marshal(PrimitiveToPlanDescription(plan.Instructions))
+		if err != nil {
+			return nil, err
+		}
+		instructions = sqltypes.NewVarChar(string(buf))
+	}
+	execCount, execTime, shardQueries, rowsAffected, rowsReturned, errors := plan.Stats()
+	return []sqltypes.Value{
+		sqltypes.NewVarChar(plan.Original),
+		sqltypes.NewVarChar(plan.Type.String()),
+		planner,
+		sqltypes.NewInt8(int8(pinned)),
+		sqltypes.NewUint64(execCount),
+		sqltypes.NewFloat64(execTime.Seconds()),
+		sqltypes.NewUint64(shardQueries),
+		sqltypes.NewUint64(rowsAffected),
+		sqltypes.NewUint64(rowsReturned),
+		sqltypes.NewUint64(errors),
+		sqltypes.NewVarChar(plan.LatencyHistogram()),
+		instructions,
+	}, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestPlanLatencyHistogram(t *testing.T) {
+	plan := &Plan{}
+	plan.AddStats(1, 500*time.Microsecond, 1, 0, 1, 0)
+	plan.AddStats(1, 2*time.Millisecond, 1, 0, 1, 0)
+	plan.AddStats(1, 5*time.Millisecond, 1, 0, 1, 0)
+	plan.AddStats(1, time.Minute, 1, 0, 1, 1)
+	assert.Equal(t, "1ms:1 5ms:2 10ms:0 50ms:0 100ms:0 500ms:0 1s:0 5s:0 inf:1", plan.LatencyHistogram())
+}
+
+func TestVitessPlans(t *testing.T) {
+	plan1 := &Plan{
+		Type:         sqlparser.StmtSelect,
+		Original:     "select id from user where id = :vtg1",
+		Instructions: &Rows{},
+		Planner:      querypb.ExecuteOptions_Gen4,
+		Pinned:       true,
+	}
+	plan1.AddStats(1, 3*time.Millisecond, 2, 0, 5, 0)
+	plan2 := &Plan{
+		Type:     sqlparser.StmtInsert,
+		Original: "insert into user(id) values (:vtg1)",
+	}
+	vc := &loggingVCursor{plans: []*Plan{plan1, plan2}}
+
+	result, err := (&VitessPlans{}).Execute(vc, nil, true)
+	require.NoError(t, err)
+	wantFields := sqltypes.MakeTestFields(
+		"Query|Type|Planner|Pinned|Count|Time|Shard_queries|Rows_affected|Rows_returned|Errors|Latency|Plan",
+		"varchar|varchar|varchar|int8|uint64|float64|uint64|uint64|uint64|uint64|varchar|varchar")
+	assert.Equal(t, wantFields, result.Fields)
+	require.Len(t, result.Rows, 2)
+	assert.Equal(t, "insert into user(id) values (:vtg1)", result.Rows[0][0].ToString())
+	assert.True(t, result.Rows[0][2].IsNull())
+	assert.True(t, result.Rows[0][11].IsNull())
+	assert.Equal(t, `[VARCHAR("select id from user where id = :vtg1") VARCHAR("SELECT") VARCHAR("Gen4") INT8(1) UINT64(1) FLOAT64(0.003) UINT64(2) UINT64(0) UINT64(5) UINT64(0)]`,
+		fmt.Sprintf("%v", result.Rows[1][:10]))
+	assert.Equal(t, "1ms:0 5ms:1 10ms:0 50ms:0 100ms:0 500ms:0 1s:0 5s:0 inf:0", result.Rows[1][10].ToString())
+	assert.Equal(t, `{"OperatorType":"Rows"}`, result.Rows[1][11].ToString())
+
+	result, err = (&VitessPlans{Like: "select%"}).Execute(vc, nil, true)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 1)
+	assert.Equal(t, "select id from user where id = :vtg1", result.Rows[0][0].ToString())
+}
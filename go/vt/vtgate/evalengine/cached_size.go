@@ -77,6 +77,29 @@ func (cached *EvalResult) CachedSize(alloc bool) int64 {
 	size += int64(cap(cached.bytes))
 	return size
 }
+func (cached *Function) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	// field Args []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += int64(cap(cached.Args)) * int64(16)
+		for _, elem := range cached.Args {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field builtin *vitess.io/vitess/go/vt/vtgate/evalengine.builtin
+	size += cached.builtin.CachedSize(true)
+	return size
+}
 func (cached *Literal) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -89,3 +112,13 @@ func (cached *Literal) CachedSize(alloc bool) int64 {
 	size += cached.Val.CachedSize(false)
 	return size
 }
+func (cached *builtin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	return size
+}
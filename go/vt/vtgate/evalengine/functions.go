@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"math"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// Function is a call to a builtin function. All the builtin
+	// functions return NULL if one of their arguments is NULL.
+	Function struct {
+		Name    string
+		Args    []Expr
+		builtin *builtin
+	}
+
+	builtin struct {
+		nargs    int
+		evaluate func(args []EvalResult) (EvalResult, error)
+		// typ is the type of the result. If it's NULL_TYPE, the
+		// result has the type of the first argument.
+		typ querypb.Type
+	}
+)
+
+var _ Expr = (*Function)(nil)
+
+var builtins = map[string]*builtin{
+	"abs":    {nargs: 1, evaluate: abs, typ: querypb.Type_NULL_TYPE},
+	"mod":    {nargs: 2, evaluate: mod, typ: querypb.Type_NULL_TYPE},
+	"lower":  {nargs: 1, evaluate: lower, typ: sqltypes.VarBinary},
+	"upper":  {nargs: 1, evaluate: upper, typ: sqltypes.VarBinary},
+	"length": {nargs: 1, evaluate: length, typ: sqltypes.Int64},
+}
+
+// NewFunction returns a call to the builtin function name.
+func NewFunction(name string, args []Expr) (Expr, error) {
+	name = strings.ToLower(name)
+	b, ok := builtins[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported function: %s", name)
+	}
+	if len(args) != b.nargs {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect number of arguments for %s: %d, want %d", name, len(args), b.nargs)
+	}
+	return &Function{
+		Name:    name,
+		Args:    args,
+		builtin: b,
+	}, nil
+}
+
+// Evaluate implements the Expr interface
+func (f *Function) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	args := make([]EvalResult, len(f.Args))
+	for i, arg := range f.Args {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if val.typ == sqltypes.Null {
+			return EvalResult{typ: sqltypes.Null}, nil
+		}
+		args[i] = val
+	}
+	return f.builtin.evaluate(args)
+}
+
+// Type implements the Expr interface
+func (f *Function) Type(env ExpressionEnv) (querypb.Type, error) {
+	if f.builtin.typ != querypb.Type_NULL_TYPE {
+		return f.builtin.typ, nil
+	}
+	return f.Args[0].Type(env)
+}
+
+// String implements the Expr interface
+func (f *Function) String() string {
+	args := make([]string, len(f.Args))
+	for i, arg := range f.Args {
+		args[i] = arg.String()
+	}
+	return f.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func abs(args []EvalResult) (EvalResult, error) {
+	v := makeNumeric(args[0])
+	switch v.typ {
+	case sqltypes.Int64:
+		if v.ival >= 0 {
+			return v, nil
+		}
+		if v.ival == math.MinInt64 {
+			return EvalResult{typ: sqltypes.Uint64, uval: uint64(math.MaxInt64) + 1}, nil
+		}
+		return EvalResult{typ: sqltypes.Int64, ival: -v.ival}, nil
+	case sqltypes.Float64:
+		return EvalResult{typ: sqltypes.Float64, fval: math.Abs(v.fval)}, nil
+	}
+	return v, nil
+}
+
+// mod returns the remainder of the division of the first argument by the
+// second one, with the sign of the first argument. It returns NULL if the
+// second argument is zero.
+func mod(args []EvalResult) (EvalResult, error) {
+	v1, v2 := makeNumeric(args[0]), makeNumeric(args[1])
+	switch {
+	case v1.typ == sqltypes.Int64 && v2.typ == sqltypes.Int64:
+		if v2.ival == 0 {
+			return EvalResult{typ: sqltypes.Null}, nil
+		}
+		if v2.ival == -1 {
+			// Avoids the overflow of math.MinInt64 % -1.
+			return EvalResult{typ: sqltypes.Int64}, nil
+		}
+		return EvalResult{typ: sqltypes.Int64, ival: v1.ival % v2.ival}, nil
+	case v1.typ == sqltypes.Uint64 && v2.typ == sqltypes.Uint64:
+		if v2.uval == 0 {
+			return EvalResult{typ: sqltypes.Null}, nil
+		}
+		return EvalResult{typ: sqltypes.Uint64, uval: v1.uval % v2.uval}, nil
+	}
+	f2 := toFloat(v2)
+	if f2 == 0 {
+		return EvalResult{typ: sqltypes.Null}, nil
+	}
+	return EvalResult{typ: sqltypes.Float64, fval: math.Mod(toFloat(v1), f2)}, nil
+}
+
+func toFloat(v EvalResult) float64 {
+	switch v.typ {
+	case sqltypes.Int64:
+		return float64(v.ival)
+	case sqltypes.Uint64:
+		return float64(v.uval)
+	}
+	return v.fval
+}
+
+func lower(args []EvalResult) (EvalResult, error) {
+	return EvalResult{typ: sqltypes.VarBinary, bytes: bytes.ToLower(toBytes(args[0]))}, nil
+}
+
+func upper(args []EvalResult) (EvalResult, error) {
+	return EvalResult{typ: sqltypes.VarBinary, bytes: bytes.ToUpper(toBytes(args[0]))}, nil
+}
+
+// length returns the length of the argument in bytes.
+func length(args []EvalResult) (EvalResult, error) {
+	return EvalResult{typ: sqltypes.Int64, ival: int64(len(toBytes(args[0])))}, nil
+}
+
+func toBytes(v EvalResult) []byte {
+	if sqltypes.IsNumber(v.typ) {
+		return v.Value().ToBytes()
+	}
+	return v.bytes
+}
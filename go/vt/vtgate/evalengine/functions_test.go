@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestFunctions(t *testing.T) {
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(-7),
+		sqltypes.NewVarChar("AbC"),
+		sqltypes.NULL,
+		sqltypes.NewInt64(math.MinInt64),
+		sqltypes.NewFloat64(-2.5),
+	}
+	tests := []struct {
+		name string
+		args []Expr
+		want sqltypes.Value
+	}{{
+		name: "abs",
+		args: []Expr{NewColumn(0)},
+		want: sqltypes.NewInt64(7),
+	}, {
+		name: "abs",
+		args: []Expr{NewColumn(3)},
+		want: sqltypes.NewUint64(uint64(math.MaxInt64) + 1),
+	}, {
+		name: "abs",
+		args: []Expr{NewColumn(4)},
+		want: sqltypes.NewFloat64(2.5),
+	}, {
+		name: "abs",
+		args: []Expr{NewColumn(2)},
+		want: sqltypes.NULL,
+	}, {
+		name: "mod",
+		args: []Expr{NewColumn(0), NewLiteralInt(3)},
+		want: sqltypes.NewInt64(-1),
+	}, {
+		name: "mod",
+		args: []Expr{NewColumn(3), NewLiteralInt(-1)},
+		want: sqltypes.NewInt64(0),
+	}, {
+		name: "mod",
+		args: []Expr{NewColumn(0), NewLiteralInt(0)},
+		want: sqltypes.NULL,
+	}, {
+		name: "mod",
+		args: []Expr{NewColumn(4), NewLiteralInt(2)},
+		want: sqltypes.NewFloat64(-0.5),
+	}, {
+		name: "MOD",
+		args: []Expr{NewColumn(0), NewColumn(2)},
+		want: sqltypes.NULL,
+	}, {
+		name: "lower",
+		args: []Expr{NewColumn(1)},
+		want: sqltypes.NewVarBinary("abc"),
+	}, {
+		name: "upper",
+		args: []Expr{NewColumn(1)},
+		want: sqltypes.NewVarBinary("ABC"),
+	}, {
+		name: "upper",
+		args: []Expr{NewColumn(0)},
+		want: sqltypes.NewVarBinary("-7"),
+	}, {
+		name: "length",
+		args: []Expr{NewColumn(1)},
+		want: sqltypes.NewInt64(3),
+	}, {
+		name: "length",
+		args: []Expr{NewColumn(3)},
+		want: sqltypes.NewInt64(20),
+	}}
+	env := ExpressionEnv{Row: row}
+	for _, tcase := range tests {
+		f, err := NewFunction(tcase.name, tcase.args)
+		require.NoError(t, err)
+		t.Run(f.String(), func(t *testing.T) {
+			got, err := f.Evaluate(env)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want.String(), got.Value().String())
+		})
+	}
+}
+
+func TestNewFunctionErrors(t *testing.T) {
+	_, err := NewFunction("foo", nil)
+	assert.EqualError(t, err, "unsupported function: foo")
+
+	_, err = NewFunction("abs", []Expr{NewLiteralInt(1), NewLiteralInt(2)})
+	assert.EqualError(t, err, "incorrect number of arguments for abs: 2, want 1")
+}
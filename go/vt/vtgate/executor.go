@@ -50,8 +50,10 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
 
@@ -107,6 +109,14 @@ type Executor struct {
 	warnShardedOnly bool
 
 	vm *VSchemaManager
+
+	processList *ProcessList
+
+	quotas *QueryQuotas
+
+	resultCache *ResultCache
+
+	planPins PlanPins
 }
 
 var executorOnce sync.Once
@@ -127,11 +137,16 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		normalize:       normalize,
 		warnShardedOnly: warnOnShardedOnly,
 		streamSize:      streamSize,
+		processList:     NewProcessList(),
+		quotas:          newQueryQuotasFromFlags(ctx, serv),
 	}
+	e.resultCache = newResultCacheFromFlags(ctx, newVStreamManager(resolver.resolver, serv, cell))
 
 	vschemaacl.Init()
 	e.vm = &VSchemaManager{e: e}
 	e.vm.watchSrvVSchema(ctx, cell)
+	e.startPlanPins(ctx, serv)
+	startTenantMappings(ctx, serv, cell)
 
 	executorOnce.Do(func() {
 		stats.NewGaugeFunc("QueryPlanCacheLength", "Query plan cache length", func() int64 {
@@ -140,6 +155,9 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		stats.NewGaugeFunc("QueryPlanCacheSize", "Query plan cache size", e.plans.UsedCapacity)
 		stats.NewGaugeFunc("QueryPlanCacheCapacity", "Query plan cache capacity", e.plans.MaxCapacity)
 		stats.NewCounterFunc("QueryPlanCacheEvictions", "Query plan cache evictions", e.plans.Evictions)
+		stats.NewGaugeFunc("QueryPlanPins", "Number of queries whose plan is pinned", func() int64 {
+			return int64(e.planPins.Len())
+		})
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
@@ -155,7 +173,14 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 	defer span.Finish()
 
 	logStats := NewLogStats(ctx, method, sql, bindVars)
+	queryDone, err := e.quotas.Admit(ctx)
+	if err != nil {
+		logStats.Error = err
+		logStats.Send()
+		return nil, err
+	}
 	stmtType, result, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
+	queryDone(logStats.RowsReturned)
 	logStats.Error = err
 	saveSessionStats(safeSession, stmtType, result, err)
 	if result != nil && len(result.Rows) > *warnMemoryRows {
@@ -299,6 +324,12 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 				v = options.GetWorkload().String()
 			})
 			bindVars[key] = sqltypes.StringBindVariable(v)
+		case sysvars.Priority.Name:
+			v := querypb.ExecuteOptions_NORMAL.String()
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = options.GetPriority().String()
+			})
+			bindVars[key] = sqltypes.StringBindVariable(v)
 		case sysvars.DDLStrategy.Name:
 			bindVars[key] = sqltypes.StringBindVariable(session.DDLStrategy)
 		case sysvars.SessionUUID.Name:
@@ -1050,7 +1081,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		// TODO: support keyRange syntax
 		return e.handleMessageStream(ctx, sql, target, callback, vcursor, logStats)
 	case sqlparser.StmtSelect, sqlparser.StmtDDL, sqlparser.StmtSet, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete,
-		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtFlush:
+		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtFlush, sqlparser.StmtKill:
 		// These may or may not all work, but getPlan() should either return a plan with instructions
 		// or an error, so it's safe to try.
 		break
@@ -1061,6 +1092,39 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "OLAP does not supported statement type: %s", stmtType)
 	}
 
+	queryDone, err := e.quotas.Admit(ctx)
+	if err != nil {
+		logStats.Error = err
+		return err
+	}
+	defer func() {
+		queryDone(logStats.RowsReturned)
+	}()
+
+	for try := 0; ; try++ {
+		if try > 0 {
+			vcursor, _ = newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv, e.warnShardedOnly)
+			vcursor.SetIgnoreMaxMemoryRows(true)
+		}
+		plan, sent, err := e.streamPlanAndExecute(ctx, vcursor, safeSession, query, comments, bindVars, callback, logStats)
+		if sent || try == maxCutoverRetries || safeSession.InTransaction() || !buffer.CausedByKeyspaceEvent(err) || !retryableAfterCutover(plan) {
+			return err
+		}
+
+		// Like in newExecute, a cutover changed the routing of the query
+		// before anything was sent to the client. Plan and route it again.
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(cutoverRetryDelay):
+		}
+	}
+}
+
+// streamPlanAndExecute plans and streams the query. It also returns the
+// plan, if the query could be planned, and whether a result was sent to the
+// callback.
+func (e *Executor) streamPlanAndExecute(ctx context.Context, vcursor *vcursorImpl, safeSession *SafeSession, query string, comments sqlparser.MarginComments, bindVars map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error, logStats *LogStats) (*engine.Plan, bool, error) {
 	plan, err := e.getPlan(
 		vcursor,
 		query,
@@ -1071,12 +1135,19 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	)
 	if err != nil {
 		logStats.Error = err
-		return err
+		return nil, false, err
+	}
+
+	if isScatterPlan(plan.Instructions) {
+		if err := e.quotas.AdmitScatter(ctx); err != nil {
+			logStats.Error = err
+			return plan, false, err
+		}
 	}
 
 	err = e.addNeededBindVars(plan.BindVarNeeds, bindVars, safeSession)
 	if err != nil {
-		return err
+		return plan, false, err
 	}
 
 	// add any warnings that the planner wants to add
@@ -1132,7 +1203,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	if err == nil {
 		if len(result.Rows) > 0 || !seenResults {
 			if err := callback(result); err != nil {
-				return err
+				return plan, true, err
 			}
 		}
 		// save session stats for future queries
@@ -1143,12 +1214,16 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	}
 
 	logStats.ExecuteTime = time.Since(execStart)
+	logStats.RowsReturned = foundRows
 	e.updateQueryCounts(plan.Instructions.RouteType(), plan.Instructions.GetKeyspaceName(), plan.Instructions.GetTableName(), int64(logStats.ShardQueries))
 
-	return err
+	return plan, seenResults, err
 }
 
-// handleMessageStream executes queries of the form 'stream * from t'
+// handleMessageStream executes queries of the form 'stream * from t [group g]'.
+// vtgate has no ack call: the messages of a group g are acked with an update
+// of its columns, the way the tablets ack them:
+// 'update t set time_acked_g = <now in ns>, time_next_g = null where id in (...) and time_acked_g is null'.
 func (e *Executor) handleMessageStream(ctx context.Context, sql string, target querypb.Target, callback func(*sqltypes.Result) error, vcursor *vcursorImpl, logStats *LogStats) error {
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
@@ -1172,7 +1247,7 @@ func (e *Executor) handleMessageStream(ctx context.Context, sql string, target q
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 
-	err = e.MessageStream(ctx, table.Keyspace.Name, target.Shard, nil, table.Name.CompliantName(), callback)
+	err = e.MessageStream(ctx, table.Keyspace.Name, target.Shard, nil, table.Name.CompliantName(), streamStmt.Group.Lowered(), callback)
 	logStats.Error = err
 	logStats.ExecuteTime = time.Since(execStart)
 	return err
@@ -1180,13 +1255,14 @@ func (e *Executor) handleMessageStream(ctx context.Context, sql string, target q
 
 // MessageStream is part of the vtgate service API. This is a V2 level API that's sent
 // to the Resolver.
-func (e *Executor) MessageStream(ctx context.Context, keyspace string, shard string, keyRange *topodatapb.KeyRange, name string, callback func(*sqltypes.Result) error) error {
+func (e *Executor) MessageStream(ctx context.Context, keyspace string, shard string, keyRange *topodatapb.KeyRange, name, group string, callback func(*sqltypes.Result) error) error {
 	err := e.resolver.MessageStream(
 		ctx,
 		keyspace,
 		shard,
 		keyRange,
 		name,
+		group,
 		callback,
 	)
 	return formatError(err)
@@ -1199,6 +1275,11 @@ func (e *Executor) VSchema() *vindexes.VSchema {
 	return e.vschema
 }
 
+// ProcessList returns the client connections of the vtgate.
+func (e *Executor) ProcessList() *ProcessList {
+	return e.processList
+}
+
 // SaveVSchema updates the vschema and stats
 func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 	e.mu.Lock()
@@ -1213,6 +1294,14 @@ func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 
 }
 
+// startSchemaTracking builds the VSchema with the table schemas of the
+// tracker, and rebuilds it every time they change.
+func (e *Executor) startSchemaTracking(st *vtschema.Tracker) {
+	e.vm.setSchema(st)
+	st.RegisterSignalReceiver(e.vm.Rebuild)
+	st.Start()
+}
+
 // ParseDestinationTarget parses destination target string and sets default keyspace if possible.
 func (e *Executor) ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error) {
 	destKeyspace, destTabletType, dest, err := topoproto.ParseDestination(targetString, defaultTabletType)
@@ -1272,13 +1361,32 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return plan.(*engine.Plan), nil
 	}
 
-	plan, err := planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds)
+	pin, pinned := e.planPins.get(vcursor.keyspace, statement)
+	planStatement, planReservedVars, planBindVarNeeds := statement, reservedVars, bindVarNeeds
+	if pin.variant != "" {
+		planStatement, planReservedVars, planBindVarNeeds, err = e.prepareVariant(vcursor, pin.variant)
+		if err != nil {
+			return nil, err
+		}
+	}
+	vcursor.pinnedPlanner = pin.planner
+	plan, err := planbuilder.BuildFromStmt(query, planStatement, planReservedVars, vcursor, planBindVarNeeds)
+	planner := vcursor.Planner()
+	vcursor.pinnedPlanner = querypb.ExecuteOptions_DEFAULT_PLANNER
 	if err != nil {
 		return nil, err
 	}
+	if _, ok := statement.(*sqlparser.Select); ok {
+		// The planner version only matters for selects.
+		plan.Planner = planner
+		plan.Pinned = pinned
+	}
 
 	plan.Warnings = vcursor.warnings
 	vcursor.warnings = nil
+	if e.resultCache != nil {
+		plan.CacheTables = resultCacheTables(vcursor, statement, bindVarNeeds)
+	}
 
 	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) && sqlparser.CachePlan(statement) {
 		e.plans.Set(planKey, plan)
@@ -1549,7 +1657,7 @@ func (e *Executor) prepare(ctx context.Context, safeSession *SafeSession, sql st
 	case sqlparser.StmtSelect:
 		return e.handlePrepare(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtDDL, sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSet, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete,
-		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtExplain, sqlparser.StmtFlush:
+		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtExplain, sqlparser.StmtFlush, sqlparser.StmtKill:
 		return nil, nil
 	case sqlparser.StmtShow:
 		res, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
@@ -1608,8 +1716,8 @@ func (e *Executor) ExecuteMultiShard(ctx context.Context, rss []*srvtopo.Resolve
 }
 
 // StreamExecuteMulti implements the IExecutor interface
-func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error {
-	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, options, callback)
+func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, callback func(reply *sqltypes.Result) error) error {
+	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, session, callback)
 }
 
 //ExecuteLock implments the IExecutor interface
@@ -153,6 +153,18 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set workload = 1",
 		err: "Incorrect argument type to variable 'workload': INT64",
+	}, {
+		in:  "set priority = 'low'",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{Priority: querypb.ExecuteOptions_LOW}},
+	}, {
+		in:  "set priority = 'high'",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{Priority: querypb.ExecuteOptions_HIGH}},
+	}, {
+		in:  "set priority = 'normal'",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{Priority: querypb.ExecuteOptions_NORMAL}},
+	}, {
+		in:  "set priority = 'urgent'",
+		err: "invalid priority: urgent",
 	}, {
 		in:  "set transaction_mode = 'twopc', autocommit=1",
 		out: &vtgatepb.Session{Autocommit: true, TransactionMode: vtgatepb.TransactionMode_TWOPC},
@@ -475,6 +487,24 @@ func TestSetUDVFromTabletInput(t *testing.T) {
 	utils.MustMatch(t, want, masterSession.UserDefinedVariables, "")
 }
 
+func TestExecutorSetPriority(t *testing.T) {
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{})
+
+	_, err := executor.Execute(ctx, "TestExecute", session, "set priority = 'low'", nil)
+	require.NoError(t, err)
+	result, err := executor.Execute(ctx, "TestExecute", session, "select @@priority", nil)
+	require.NoError(t, err)
+	assert.Equal(t, `[[VARBINARY("LOW")]]`, fmt.Sprintf("%v", result.Rows))
+
+	// The priority is passed to the tablets with the options.
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, sbc1.Options)
+	assert.Equal(t, querypb.ExecuteOptions_LOW, sbc1.Options[len(sbc1.Options)-1].Priority)
+}
+
 func createMap(keys []string, values []interface{}) map[string]*querypb.BindVariable {
 	result := make(map[string]*querypb.BindVariable)
 	for i, key := range keys {
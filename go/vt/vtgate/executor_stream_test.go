@@ -22,6 +22,7 @@ import (
 
 	"context"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/cache"
@@ -47,6 +48,19 @@ func TestStreamSQLUnsharded(t *testing.T) {
 	}
 }
 
+func TestStreamSQLGroup(t *testing.T) {
+	executor, sbc1, _, sbclookup := createLegacyExecutorEnv()
+
+	_, err := executorStreamMessages(executor, "stream * from user_msgs group G1")
+	require.NoError(t, err)
+	assert.Equal(t, "g1", sbclookup.MessageGroup)
+
+	_, err = executorStreamMessages(executor, "stream * from user_msgs")
+	require.NoError(t, err)
+	assert.Equal(t, "", sbclookup.MessageGroup)
+	assert.Equal(t, "", sbc1.MessageGroup)
+}
+
 func TestStreamSQLSharded(t *testing.T) {
 	// Special setup: Don't use createLegacyExecutorEnv.
 	cell := "aa"
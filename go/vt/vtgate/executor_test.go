@@ -949,6 +949,41 @@ func TestExecutorUse(t *testing.T) {
 	}
 }
 
+func TestExecutorProcesslistAndKill(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true, TargetString: "@master"})
+
+	// The ids of the connections are in namespace 1.
+	executor.ProcessList().namespace = 1
+	killed := false
+	executor.ProcessList().Add(5, "user1", "", "127.0.0.1:5000", func() { killed = true })
+	queryCtx, done := executor.ProcessList().BeginCommand(ctx, 5, "Query", "TestExecutor", "select sleep(10) from dual")
+	defer done()
+
+	result, err := executor.Execute(ctx, "TestExecute", session, "show full processlist", nil)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 1)
+	assert.Equal(t, "[UINT64(4294967301) VARCHAR(\"user1\") VARCHAR(\"127.0.0.1:5000\") VARCHAR(\"TestExecutor\") VARCHAR(\"Query\") INT64(0) VARCHAR(\"executing\") VARCHAR(\"select sleep(10) from dual\")]", fmt.Sprintf("%v", result.Rows[0]))
+
+	result, err = executor.Execute(ctx, "TestExecute", session, "select id, command from information_schema.processlist where user = 'user1'", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewUint64(4294967301), sqltypes.NewVarChar("Query")}}, result.Rows)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "kill query 4294967301", nil)
+	require.NoError(t, err)
+	assert.Error(t, queryCtx.Err())
+	assert.False(t, killed)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "kill 4294967301", nil)
+	require.NoError(t, err)
+	assert.True(t, killed)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "kill 4294967302", nil)
+	assert.EqualError(t, err, "Unknown thread id: 4294967302 (KILL only applies to the connections of this vtgate)")
+	_, err = executor.Execute(ctx, "TestExecute", session, "kill 5", nil)
+	assert.EqualError(t, err, "Unknown thread id: 5 (the connection is not on this vtgate, whose connection ids are in namespace 1, but on the vtgate of namespace 0)")
+}
+
 func TestExecutorComment(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 
@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
 	"testing"
@@ -24,7 +25,11 @@ import (
 
 	"vitess.io/vitess/go/vt/callerid"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	"context"
 
@@ -759,3 +764,148 @@ func TestPlanExecutorVindexDDLACL(t *testing.T) {
 	// restore the disallowed state
 	*vschemaacl.AuthorizedDDLUsers = ""
 }
+
+func waitForView(t *testing.T, ks, name string, exists bool, executor *Executor) {
+	t.Helper()
+
+	// Wait up to 100ms until the vschema manager gets notified of the update
+	for i := 0; i < 10; i++ {
+		if (executor.VSchema().FindView(ks, name) != nil) == exists {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("view %s.%s exists: %v, want %v", ks, name, !exists, exists)
+}
+
+func TestExecutorViewDDL(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	vschemaacl.Init()
+	*enableViews = true
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		vschemaacl.Init()
+		*enableViews = false
+	}()
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	execute := func(stmt string) error {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+		return err
+	}
+
+	require.NoError(t, execute("create view user_ids as select id from user"))
+	waitForView(t, ks, "user_ids", true, executor)
+	require.NoError(t, execute("create or replace view user_names(n) as select name from user"))
+	waitForView(t, ks, "user_names", true, executor)
+	assert.Equal(t, "select `name` as n from `user`", executor.vm.GetCurrentSrvVschema().Keyspaces[ks].Views["user_names"])
+
+	// The views are not sent to the shards.
+	assert.Zero(t, sbc1.ExecCount.Get()+sbc2.ExecCount.Get()+sbclookup.ExecCount.Get())
+
+	// The views are expanded and return the rows of all the shards.
+	require.NoError(t, execute("select id from user_ids"))
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id from (select id from `user`) as user_ids",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	assert.Equal(t, wantQueries, sbc1.Queries)
+	assert.Equal(t, wantQueries, sbc2.Queries)
+
+	assert.EqualError(t, execute("create view user_ids as select id from user"), "view user_ids already exists in keyspace TestExecutor")
+	assert.EqualError(t, execute("create view user as select 1 from dual"), "view user has the same name as a table")
+	assert.EqualError(t, execute("create view user_cols(a, b) as select id from user"), "view user_cols: the select list and the column list have different column counts")
+	assert.EqualError(t, execute("alter view unknown as select id from user"), "view unknown does not exist in keyspace TestExecutor")
+
+	require.NoError(t, execute("alter view user_ids as select id from user where id > 1"))
+	require.NoError(t, execute("drop view user_ids, user_names"))
+	waitForView(t, ks, "user_ids", false, executor)
+	waitForView(t, ks, "user_names", false, executor)
+	require.NoError(t, execute("drop view if exists user_ids"))
+	assert.EqualError(t, execute("drop view user_ids"), "view user_ids does not exist in keyspace TestExecutor")
+}
+
+// validatorTMClient answers the queries of the vschema DDL validation with
+// the given rows, by tablet uid.
+type validatorTMClient struct {
+	tmclient.TabletManagerClient
+	rows map[uint32][]string
+}
+
+func (tmc *validatorTMClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	return &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              tables[0],
+			PrimaryKeyColumns: []string{"id"},
+		}},
+	}, nil
+}
+
+func (tmc *validatorTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	if q := string(query); q != "select id, id from vt_validate order by id limit 10" && q != "select id, id from vt_report order by id limit 10" {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	qr := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|id", "int64|int64"), tmc.rows[tablet.Alias.Uid]...)
+	return sqltypes.ResultToProto3(qr), nil
+}
+
+func TestExecutorVSchemaDDLValidation(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+
+	ctx := context.Background()
+	ts, err := executor.serv.GetTopoServer()
+	require.NoError(t, err)
+	require.NoError(t, ts.CreateKeyspace(ctx, ks, &topodatapb.Keyspace{}))
+	for i, shard := range []string{"-80", "80-"} {
+		alias := &topodatapb.TabletAlias{Cell: "aa", Uid: uint32(100 + i)}
+		require.NoError(t, ts.CreateShard(ctx, ks, shard))
+		require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{Alias: alias, Keyspace: ks, Shard: shard}))
+		_, err := ts.UpdateShardFields(ctx, ks, shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = alias
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(ctx, "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	stmt := "alter vschema on vt_validate add vindex hash(id) using hash"
+
+	// hash maps 1 to the -80 shard.
+	tmc := &validatorTMClient{rows: map[uint32][]string{101: {"1|1"}}}
+	executor.vm.validator = newVSchemaValidator(tmc, 10, false)
+	_, err = executor.Execute(ctx, "TestExecute", session, stmt, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 existing rows would be on the wrong shard with the new vschema")
+	select {
+	case <-vschemaUpdates:
+		t.Error("vschema should not be updated on error")
+	default:
+	}
+
+	tmc.rows = map[uint32][]string{100: {"1|1"}}
+	_, err = executor.Execute(ctx, "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	waitForColVindexes(t, ks, "vt_validate", []string{"hash"}, executor)
+	<-vschemaUpdates
+
+	// In report-only mode, the change is applied with a warning.
+	tmc.rows = map[uint32][]string{101: {"1|1"}}
+	executor.vm.validator = newVSchemaValidator(tmc, 10, true)
+	_, err = executor.Execute(ctx, "TestExecute", session, "alter vschema on vt_report add vindex hash(id) using hash", nil)
+	require.NoError(t, err)
+	require.Len(t, session.Warnings, 1)
+	assert.Contains(t, session.Warnings[0].Message, "1 existing rows are on the wrong shard with the new vschema")
+	waitForColVindexes(t, ks, "vt_report", []string{"hash"}, executor)
+}
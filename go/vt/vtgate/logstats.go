@@ -138,13 +138,14 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		}
 	}()
 
+	format := streamlog.LogFormat(params)
 	formattedBindVars := "\"[REDACTED]\""
 	if !*streamlog.RedactDebugUIQueries {
 		_, fullBindParams := params["full"]
 		formattedBindVars = sqltypes.FormatBindVariables(
 			stats.BindVariables,
 			fullBindParams,
-			*streamlog.QueryLogFormat == streamlog.QueryLogFormatJSON,
+			format == streamlog.QueryLogFormatJSON,
 		)
 	}
 
@@ -152,7 +153,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	remoteAddr, username := stats.RemoteAddrUsername()
 
 	var fmtString string
-	switch *streamlog.QueryLogFormat {
+	switch format {
 	case streamlog.QueryLogFormatText:
 		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%.6f\t%.6f\t%.6f\t%v\t%q\t%v\t%v\t%v\t%q\t%q\t%q\t%q\t\n"
 	case streamlog.QueryLogFormatJSON:
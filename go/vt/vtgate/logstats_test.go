@@ -187,6 +187,23 @@ func TestLogStatsRowThreshold(t *testing.T) {
 	}
 }
 
+func TestLogStatsFormatParam(t *testing.T) {
+	*streamlog.QueryLogFormat = "text"
+	logStats := NewLogStats(context.Background(), "test", "sql1", map[string]*querypb.BindVariable{"intVal": sqltypes.Int64BindVariable(1)})
+	logStats.StartTime = time.Date(2017, time.January, 1, 1, 2, 3, 0, time.UTC)
+	logStats.EndTime = time.Date(2017, time.January, 1, 1, 2, 4, 1234, time.UTC)
+	params := map[string][]string{"full": {}, "format": {"json"}}
+
+	got := testFormat(logStats, url.Values(params))
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(got), &parsed); err != nil {
+		t.Fatalf("logstats format: error unmarshaling json: %v -- got:\n%v", err, got)
+	}
+	if parsed["SQL"] != "sql1" {
+		t.Errorf("logstats format: got SQL %v, want sql1", parsed["SQL"])
+	}
+}
+
 func TestLogStatsContextHTML(t *testing.T) {
 	html := "HtmlContext"
 	callInfo := &fakecallinfo.FakeCallInfo{
@@ -22,20 +22,73 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 )
 
+const (
+	// maxCutoverRetries is how many times a query is planned and executed
+	// again after a resharding or MoveTables cutover changed its routing.
+	maxCutoverRetries = 3
+	// cutoverRetryDelay is the delay before planning the query again, for the
+	// new VSchema and SrvKeyspace to be in place.
+	cutoverRetryDelay = 100 * time.Millisecond
+)
+
 func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
+	for try := 0; ; try++ {
+		stmtType, qr, plan, err := e.planAndExecute(ctx, safeSession, sql, bindVars, logStats)
+		if try == maxCutoverRetries || safeSession.InTransaction() || !buffer.CausedByKeyspaceEvent(err) || !retryableAfterCutover(plan) {
+			return stmtType, qr, err
+		}
+
+		// The buffer stopped buffering the query because a cutover changed
+		// its routing, and nothing was written yet. Plan and route it again.
+		select {
+		case <-ctx.Done():
+			return stmtType, qr, err
+		case <-time.After(cutoverRetryDelay):
+		}
+	}
+}
+
+// retryableAfterCutover returns true if the plan can be executed again after
+// it failed because of a cutover: it only reads, or it writes to a single
+// shard, which the buffer failed the query for before sending it. Writes to
+// several shards, or to lookup vindexes before the table, may have been
+// partially applied and are not executed again.
+func retryableAfterCutover(plan *engine.Plan) bool {
+	if plan == nil {
+		return false
+	}
+	if plan.Type == sqlparser.StmtSelect {
+		return true
+	}
+	switch p := plan.Instructions.(type) {
+	case *engine.Update:
+		return (p.Opcode == engine.Unsharded || p.Opcode == engine.Equal) && len(p.ChangedVindexValues) == 0
+	case *engine.Delete:
+		return (p.Opcode == engine.Unsharded || p.Opcode == engine.Equal) && p.OwnedVindexQuery == ""
+	case *engine.Insert:
+		return p.Opcode == engine.InsertUnsharded
+	}
+	return false
+}
+
+// planAndExecute plans and executes the query. It also returns the plan, if
+// the query could be planned.
+func (e *Executor) planAndExecute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, *engine.Plan, error) {
 	// 1: Prepare before planning and execution
 
 	// Start an implicit transaction if necessary.
 	err := e.startTxIfNecessary(ctx, safeSession)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 
 	if bindVars == nil {
@@ -45,7 +98,7 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 	query, comments := sqlparser.SplitMarginComments(sql)
 	vcursor, err := newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv, e.warnShardedOnly)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 
 	// 2: Create a plan for the query
@@ -58,19 +111,25 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 		logStats,
 	)
 	if err == planbuilder.ErrPlanNotSupported {
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 	execStart := e.logPlanningFinished(logStats, plan)
 
 	if err != nil {
 		safeSession.ClearWarnings()
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 
 	if plan.Type != sqlparser.StmtShow {
 		safeSession.ClearWarnings()
 	}
 
+	if isScatterPlan(plan.Instructions) {
+		if err := e.quotas.AdmitScatter(ctx); err != nil {
+			return 0, nil, plan, err
+		}
+	}
+
 	// add any warnings that the planner wants to add
 	for _, warning := range plan.Warnings {
 		safeSession.RecordWarning(warning)
@@ -81,46 +140,76 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 	switch plan.Type {
 	case sqlparser.StmtBegin:
 		qr, err := e.handleBegin(ctx, safeSession, logStats)
-		return sqlparser.StmtBegin, qr, err
+		return sqlparser.StmtBegin, qr, plan, err
 	case sqlparser.StmtCommit:
 		qr, err := e.handleCommit(ctx, safeSession, logStats)
-		return sqlparser.StmtCommit, qr, err
+		return sqlparser.StmtCommit, qr, plan, err
 	case sqlparser.StmtRollback:
 		qr, err := e.handleRollback(ctx, safeSession, logStats)
-		return sqlparser.StmtRollback, qr, err
+		return sqlparser.StmtRollback, qr, plan, err
 	case sqlparser.StmtSavepoint:
 		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, "Savepoint", logStats, func(_ string) (*sqltypes.Result, error) {
 			// Safely to ignore as there is no transaction.
 			return &sqltypes.Result{}, nil
 		}, vcursor.ignoreMaxMemoryRows)
-		return sqlparser.StmtSavepoint, qr, err
+		return sqlparser.StmtSavepoint, qr, plan, err
 	case sqlparser.StmtSRollback:
 		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, "Rollback Savepoint", logStats, func(query string) (*sqltypes.Result, error) {
 			// Error as there is no transaction, so there is no savepoint that exists.
 			return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.SPDoesNotExist, "SAVEPOINT does not exist: %s", query)
 		}, vcursor.ignoreMaxMemoryRows)
-		return sqlparser.StmtSRollback, qr, err
+		return sqlparser.StmtSRollback, qr, plan, err
 	case sqlparser.StmtRelease:
 		qr, err := e.handleSavepoint(ctx, safeSession, plan.Original, "Release Savepoint", logStats, func(query string) (*sqltypes.Result, error) {
 			// Error as there is no transaction, so there is no savepoint that exists.
 			return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.SPDoesNotExist, "SAVEPOINT does not exist: %s", query)
 		}, vcursor.ignoreMaxMemoryRows)
-		return sqlparser.StmtRelease, qr, err
+		return sqlparser.StmtRelease, qr, plan, err
 	}
 
 	// 3: Prepare for execution
 	err = e.addNeededBindVars(plan.BindVarNeeds, bindVars, safeSession)
 	if err != nil {
 		logStats.Error = err
-		return 0, nil, err
+		return 0, nil, plan, err
 	}
 
 	if plan.Instructions.NeedsTransaction() {
-		return e.insideTransaction(ctx, safeSession, logStats,
+		stmtType, qr, err := e.insideTransaction(ctx, safeSession, logStats,
 			e.executePlan(ctx, plan, vcursor, bindVars, execStart))
+		return stmtType, qr, plan, err
+	}
+
+	// The changes of the tables are streamed from the master tablets: a
+	// replica may still return the rows of a change after its results were
+	// invalidated, so only the results read from the masters are cached.
+	if e.resultCache != nil && len(plan.CacheTables) > 0 && vcursor.TabletType() == topodatapb.TabletType_MASTER && !safeSession.InTransaction() && !safeSession.InReservedConn() && len(safeSession.GetSystemVariables()) == 0 {
+		stmtType, qr, err := e.executeCachedPlan(ctx, plan, vcursor, bindVars, execStart, logStats, safeSession)
+		return stmtType, qr, plan, err
 	}
 
-	return e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+	stmtType, qr, err := e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+	return stmtType, qr, plan, err
+}
+
+// executeCachedPlan returns the result of the plan from the result cache,
+// or executes the plan and caches its result.
+func (e *Executor) executeCachedPlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time, logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
+	key := resultCacheKey(ctx, vcursor, plan, bindVars, safeSession)
+	if qr := e.resultCache.Get(key); qr != nil {
+		logStats.ExecuteTime = time.Since(execStart)
+		logStats.RowsReturned = uint64(len(qr.Rows))
+		return plan.Type, qr, nil
+	}
+
+	generations := e.resultCache.Generations(plan.CacheTables)
+	warnings := len(safeSession.GetWarnings())
+	stmtType, qr, err := e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+	// A result with warnings, like a partial scatter result, is not cached.
+	if err == nil && len(safeSession.GetWarnings()) == warnings {
+		e.resultCache.Set(key, plan.CacheTables, generations, qr)
+	}
+	return stmtType, qr, err
 }
 
 func (e *Executor) startTxIfNecessary(ctx context.Context, safeSession *SafeSession) error {
@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestRetryableAfterCutover(t *testing.T) {
+	assert.False(t, retryableAfterCutover(nil))
+	assert.True(t, retryableAfterCutover(&engine.Plan{Type: sqlparser.StmtSelect, Instructions: &engine.Route{Opcode: engine.SelectScatter}}))
+	assert.True(t, retryableAfterCutover(&engine.Plan{Type: sqlparser.StmtUpdate, Instructions: &engine.Update{DML: engine.DML{Opcode: engine.Equal}}}))
+	assert.False(t, retryableAfterCutover(&engine.Plan{Type: sqlparser.StmtUpdate, Instructions: &engine.Update{DML: engine.DML{Opcode: engine.Scatter}}}))
+	assert.False(t, retryableAfterCutover(&engine.Plan{Type: sqlparser.StmtUpdate, Instructions: &engine.Update{DML: engine.DML{Opcode: engine.Equal}, ChangedVindexValues: map[string]*engine.VindexValues{"name_user_map": nil}}}))
+	assert.True(t, retryableAfterCutover(&engine.Plan{Type: sqlparser.StmtDelete, Instructions: &engine.Delete{DML: engine.DML{Opcode: engine.Unsharded}}}))
+	assert.False(t, retryableAfterCutover(&engine.Plan{Type: sqlparser.StmtDelete, Instructions: &engine.Delete{DML: engine.DML{Opcode: engine.Equal, OwnedVindexQuery: "select id, name from user where id = 1 for update"}}}))
+	assert.True(t, retryableAfterCutover(&engine.Plan{Type: sqlparser.StmtInsert, Instructions: &engine.Insert{Opcode: engine.InsertUnsharded}}))
+	assert.False(t, retryableAfterCutover(&engine.Plan{Type: sqlparser.StmtInsert, Instructions: &engine.Insert{Opcode: engine.InsertSharded}}))
+}
+
+func TestExecuteRetriesAfterCutover(t *testing.T) {
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	session := &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+
+	// A read is planned and executed again.
+	sbc1.EphemeralShardErr = buffer.RoutingRulesChangedError
+	_, err := executor.Execute(context.Background(), "TestExecute", NewSafeSession(session), "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+
+	// A write to several shards may have been partially applied: it fails
+	// instead of succeeding on a second try.
+	sbc1.EphemeralShardErr = buffer.RoutingRulesChangedError
+	_, err = executor.Execute(context.Background(), "TestExecute", NewSafeSession(session), "update user_extra set extra = 1", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), buffer.RoutingRulesChangedError.Error())
+}
+
+func TestStreamExecuteRetriesAfterCutover(t *testing.T) {
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	session := &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+	stream := func() (*sqltypes.Result, error) {
+		var qr *sqltypes.Result
+		err := executor.StreamExecute(context.Background(), "TestExecuteStream", NewSafeSession(session), "select id from user where id = 1", nil, querypb.Target{TabletType: topodatapb.TabletType_MASTER}, func(r *sqltypes.Result) error {
+			qr = r
+			return nil
+		})
+		return qr, err
+	}
+
+	// Nothing was streamed to the client yet: the read is planned and
+	// streamed again.
+	sbc1.EphemeralShardErr = buffer.RoutingRulesChangedError
+	qr, err := stream()
+	require.NoError(t, err)
+	assert.NotNil(t, qr)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+
+	// Other errors are not retried.
+	sbc1.EphemeralShardErr = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, buffer.RoutingRulesChangedError.Error())
+	_, err = stream()
+	require.Error(t, err)
+	assert.EqualValues(t, 3, sbc1.ExecCount.Get())
+}
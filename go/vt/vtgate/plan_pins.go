@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	enablePlanPins = flag.Bool("enable_plan_pins", false, "Watch the plans pinned for queries in the global topo, with the PinPlan vtctl command, and plan these queries with them.")
+
+	sleepDuringPlanPinsWatchFailure = 30 * time.Second
+)
+
+// PlanPins are the plans pinned for queries. A pin overrides the planner
+// version of the session and the -planner_version flag, and can replace
+// the query with a variant to plan, so that a plan regression can be
+// rolled back for some queries without a redeploy.
+type PlanPins struct {
+	mu   sync.RWMutex
+	pins map[planPinKey]planPin
+}
+
+// planPinKey is the key of a pin: the keyspace targeted by the sessions
+// the query is pinned for, and the query formatted the way getPlan
+// formats the statements it plans for these sessions.
+type planPinKey struct {
+	keyspace string
+	query    string
+}
+
+// planPin is what is pinned for a query.
+type planPin struct {
+	// planner is the pinned planner version, or DEFAULT_PLANNER to keep
+	// the one of the session.
+	planner planbuilder.PlannerVersion
+	// variant is the query planned in place of the pinned one, if any.
+	variant string
+}
+
+// get returns the pin of a statement planned for a session targeting a
+// keyspace, if any.
+func (pp *PlanPins) get(keyspace string, stmt sqlparser.Statement) (planPin, bool) {
+	pp.mu.RLock()
+	defer pp.mu.RUnlock()
+	if len(pp.pins) == 0 {
+		return planPin{}, false
+	}
+	pin, ok := pp.pins[planPinKey{keyspace: keyspace, query: sqlparser.String(stmt)}]
+	return pin, ok
+}
+
+// set replaces the pins, and returns true if they changed.
+func (pp *PlanPins) set(pins map[planPinKey]planPin) bool {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	changed := len(pins) != len(pp.pins)
+	for key, pin := range pins {
+		if old, ok := pp.pins[key]; !ok || old != pin {
+			changed = true
+		}
+	}
+	pp.pins = pins
+	return changed
+}
+
+// Len returns the number of pinned queries.
+func (pp *PlanPins) Len() int {
+	pp.mu.RLock()
+	defer pp.mu.RUnlock()
+	return len(pp.pins)
+}
+
+// startPlanPins starts watching the plan pins in the global topo, if
+// -enable_plan_pins is set.
+func (e *Executor) startPlanPins(ctx context.Context, serv srvtopo.Server) {
+	if !*enablePlanPins {
+		return
+	}
+	ts, err := serv.GetTopoServer()
+	if err != nil {
+		log.Exitf("cannot watch the plan pins: %v", err)
+	}
+	go e.watchPlanPins(ctx, ts)
+}
+
+// watchPlanPins watches the plan pins in the global topo until ctx is done.
+func (e *Executor) watchPlanPins(ctx context.Context, ts *topo.Server) {
+	for {
+		current, changes, cancel := ts.WatchPlanPins(ctx)
+		if current.Err != nil {
+			if topo.IsErrType(current.Err, topo.NoNode) {
+				// No query is pinned.
+				e.setPlanPins(nil)
+			} else {
+				log.Warningf("Watch of the plan pins in the topo failed: %v", current.Err)
+			}
+		} else {
+			e.setPlanPins(current.Value)
+			for wd := range changes {
+				if wd.Err != nil {
+					if topo.IsErrType(wd.Err, topo.NoNode) {
+						e.setPlanPins(nil)
+					} else if !topo.IsErrType(wd.Err, topo.Interrupted) {
+						log.Warningf("Watch of the plan pins in the topo failed: %v", wd.Err)
+					}
+					break
+				}
+				e.setPlanPins(wd.Value)
+			}
+			cancel()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(sleepDuringPlanPinsWatchFailure):
+		}
+	}
+}
+
+// setPlanPins replaces the plan pins with the ones read from the topo,
+// and clears the plan cache if they changed. The invalid pins are
+// ignored.
+func (e *Executor) setPlanPins(pins map[string]topo.PlanPin) {
+	parsed := make(map[planPinKey]planPin, len(pins))
+	for name, pin := range pins {
+		query := topo.PlanPinQuery(name, pin)
+		parsedPin, key, err := e.parsePlanPin(query, pin)
+		if err != nil {
+			log.Warningf("Ignoring the pin of query %q: %v", query, err)
+			continue
+		}
+		parsed[key] = parsedPin
+	}
+	if e.planPins.set(parsed) {
+		log.Infof("Loaded %d plan pins, clearing the plan cache", len(parsed))
+		e.plans.Clear()
+	}
+}
+
+// parsePlanPin checks a pin read from the topo, and returns it with its
+// key.
+func (e *Executor) parsePlanPin(query string, pin topo.PlanPin) (planPin, planPinKey, error) {
+	if pin.Planner == "" && pin.Variant == "" {
+		return planPin{}, planPinKey{}, fmt.Errorf("neither a planner version nor a variant is pinned")
+	}
+	parsed := planPin{planner: querypb.ExecuteOptions_DEFAULT_PLANNER, variant: pin.Variant}
+	if pin.Planner != "" {
+		version, ok := planbuilder.PlannerNameToVersion(pin.Planner)
+		if !ok {
+			return planPin{}, planPinKey{}, fmt.Errorf("unknown planner version %q", pin.Planner)
+		}
+		parsed.planner = version
+	}
+	stmt, bindVars, err := e.normalizePinnedQuery(query, pin.Keyspace)
+	if err != nil {
+		return planPin{}, planPinKey{}, err
+	}
+	if pin.Variant != "" {
+		// The variant is planned with the bind variables of the query.
+		variantStmt, variantBindVars, err := e.normalizePinnedQuery(pin.Variant, pin.Keyspace)
+		if err != nil {
+			return planPin{}, planPinKey{}, vterrors.Wrapf(err, "variant %q", pin.Variant)
+		}
+		if _, ok := stmt.(*sqlparser.Select); !ok {
+			return planPin{}, planPinKey{}, fmt.Errorf("only the variants of selects can be pinned")
+		}
+		if _, ok := variantStmt.(*sqlparser.Select); !ok {
+			return planPin{}, planPinKey{}, fmt.Errorf("variant %q is not a select", pin.Variant)
+		}
+		if !sameBindVars(bindVars, variantBindVars) {
+			// The variant is executed with the bind variables of the
+			// query, which must be the ones of the variant, in the same
+			// order and with the same types.
+			return planPin{}, planPinKey{}, fmt.Errorf("variant %q does not have the same literals in the same order as the query", pin.Variant)
+		}
+	}
+	return parsed, planPinKey{keyspace: pin.Keyspace, query: sqlparser.String(stmt)}, nil
+}
+
+// normalizePinnedQuery formats a pinned query the way getPlan formats
+// the statements it plans for the sessions targeting a keyspace, and
+// returns the bind variables of its literals.
+func (e *Executor) normalizePinnedQuery(query, keyspace string) (sqlparser.Statement, map[string]*querypb.BindVariable, error) {
+	stmt, reservedVars, err := sqlparser.Parse2(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	bindVars := map[string]*querypb.BindVariable{}
+	if (e.normalize && sqlparser.CanNormalize(stmt)) || sqlparser.MustRewriteAST(stmt) {
+		result, err := sqlparser.PrepareAST(stmt, reservedVars, bindVars, "vtg", e.normalize, keyspace)
+		if err != nil {
+			return nil, nil, err
+		}
+		stmt = result.AST
+	}
+	return stmt, bindVars, nil
+}
+
+// prepareVariant parses and normalizes the variant pinned for a query,
+// the way getPlan does the query. The values of the literals of the
+// variant are thrown away: it is executed with the ones of the query.
+func (e *Executor) prepareVariant(vcursor *vcursorImpl, variant string) (sqlparser.Statement, sqlparser.BindVars, *sqlparser.BindVarNeeds, error) {
+	stmt, reservedVars, err := sqlparser.Parse2(variant)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	bindVarNeeds := &sqlparser.BindVarNeeds{}
+	if (e.normalize && sqlparser.CanNormalize(stmt)) || sqlparser.MustRewriteAST(stmt) {
+		result, err := sqlparser.PrepareAST(stmt, reservedVars, map[string]*querypb.BindVariable{}, "vtg", e.normalize, vcursor.keyspace)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		stmt = result.AST
+		bindVarNeeds = result.BindVarNeeds
+	}
+	return stmt, reservedVars, bindVarNeeds, nil
+}
+
+// sameBindVars returns true if the bind variables of the literals of two
+// queries are the same. As the normalizer numbers them in the order of the
+// literals, this means both queries have the same literals in the same
+// order.
+func sameBindVars(a, b map[string]*querypb.BindVariable) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, bv := range a {
+		other, ok := b[name]
+		if !ok || !proto.Equal(bv, other) {
+			return false
+		}
+	}
+	return true
+}
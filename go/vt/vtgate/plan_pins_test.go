@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestPlanPins(t *testing.T) {
+	r, _, _, _ := createLegacyExecutorEnv()
+	r.normalize = true
+	vc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+
+	r.setPlanPins(map[string]topo.PlanPin{
+		"select id from user where id = 5": {Planner: "Gen4"},
+		"select id from":                   {Planner: "gen4"},
+		"select 1 from dual":               {Planner: "unknown"},
+		"select 2 from dual":               {},
+	})
+	assert.Equal(t, 1, r.planPins.Len())
+
+	// The pinned queries match whatever their literals are.
+	plan, _ := getPlanCached(t, r, vc, "select id from user where id = 1", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.Equal(t, querypb.ExecuteOptions_Gen4, plan.Planner)
+	assert.True(t, plan.Pinned)
+	assert.Equal(t, querypb.ExecuteOptions_DEFAULT_PLANNER, vc.pinnedPlanner)
+
+	plan, _ = getPlanCached(t, r, vc, "select id from user where id = 1 and name = 'foo'", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.Equal(t, querypb.ExecuteOptions_V3, plan.Planner)
+	assert.False(t, plan.Pinned)
+	assert.Equal(t, 2, r.plans.Len())
+
+	// Changing the pins clears the plan cache.
+	r.setPlanPins(map[string]topo.PlanPin{"select id from user where id = 5": {Planner: "Gen4"}})
+	assert.Equal(t, 2, r.plans.Len())
+	r.setPlanPins(nil)
+	assert.Equal(t, 0, r.planPins.Len())
+	assert.Equal(t, 0, r.plans.Len())
+}
+
+func TestPlanPinsVariant(t *testing.T) {
+	r, _, _, _ := createLegacyExecutorEnv()
+	r.normalize = true
+	vc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+
+	r.setPlanPins(map[string]topo.PlanPin{
+		"select id from user where id = 5":                  {Variant: "select user.id from user where id = 5"},
+		"select id from music where id = 5":                 {Variant: "select id from music where id = 5 and id = 6"},
+		"delete from user where id = 5":                     {Variant: "delete from user where 5 = id"},
+		"select id from music where id = 10":                {Variant: "delete from music where id = 10"},
+		"select id from music where id = 1 and user_id = 2": {Variant: "select id from music where user_id = 2 and id = 1"},
+		"select id from music where id = 1 and user_id = 3": {Variant: "select id from music where id = '1' and user_id = 3"},
+	})
+	assert.Equal(t, 1, r.planPins.Len())
+
+	// The variant is planned in place of the query, with its bind variables.
+	bindVars := map[string]*querypb.BindVariable{}
+	plan, _ := getPlanCached(t, r, vc, "select id from user where id = 1", makeComments(""), bindVars, false)
+	assert.True(t, plan.Pinned)
+	assert.Equal(t, "select id from `user` where id = :vtg1", plan.Original)
+	route, ok := plan.Instructions.(*engine.Route)
+	require.True(t, ok, "%T", plan.Instructions)
+	assert.Equal(t, "select `user`.id from `user` where id = :vtg1", route.Query)
+	assert.Equal(t, sqltypes.Int64BindVariable(1), bindVars["vtg1"])
+}
+
+func TestPlanPinsKeyspace(t *testing.T) {
+	r, _, _, _ := createLegacyExecutorEnv()
+	r.normalize = true
+	vc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+	ksVc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: KsTestSharded + "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil, false)
+
+	r.setPlanPins(map[string]topo.PlanPin{
+		topo.PlanPinKey(KsTestSharded, "select id from user where id = 5"): {Planner: "Gen4", Keyspace: KsTestSharded},
+	})
+	assert.Equal(t, 1, r.planPins.Len())
+
+	// The query is pinned for the sessions targeting the keyspace only.
+	plan, _ := getPlanCached(t, r, ksVc, "select id from user where id = 1", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.Equal(t, querypb.ExecuteOptions_Gen4, plan.Planner)
+	assert.True(t, plan.Pinned)
+
+	plan, _ = getPlanCached(t, r, vc, "select id from user where id = 1", makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.Equal(t, querypb.ExecuteOptions_V3, plan.Planner)
+	assert.False(t, plan.Pinned)
+}
+
+func TestWatchPlanPins(t *testing.T) {
+	defer func(d time.Duration) { sleepDuringPlanPinsWatchFailure = d }(sleepDuringPlanPinsWatchFailure)
+	sleepDuringPlanPinsWatchFailure = 10 * time.Millisecond
+
+	r, _, _, _ := createLegacyExecutorEnv()
+	ts := memorytopo.NewServer("aa")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go r.watchPlanPins(ctx, ts)
+
+	require.NoError(t, ts.SavePlanPins(ctx, map[string]topo.PlanPin{"select id from user": {Planner: "gen4"}}))
+	waitForPlanPins(t, r, 1)
+	require.NoError(t, ts.SavePlanPins(ctx, map[string]topo.PlanPin{"select id from user": {Planner: "gen4"}, "select id from music": {Planner: "v3"}}))
+	waitForPlanPins(t, r, 2)
+	require.NoError(t, ts.SavePlanPins(ctx, nil))
+	waitForPlanPins(t, r, 0)
+}
+
+func waitForPlanPins(t *testing.T, e *Executor, want int) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if e.planPins.Len() == want {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("got %d plan pins, want %d", e.planPins.Len(), want)
+}
+
+func TestShowVitessPlans(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	executor.normalize = true
+
+	_, err := executorExec(executor, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	executor.plans.Wait()
+
+	qr, err := executorExec(executor, "show vitess_plans like 'select id from %'", nil)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 1)
+	assert.Equal(t, "select id from `user` where id = :vtg1", qr.Rows[0][0].ToString())
+	assert.Equal(t, "SELECT", qr.Rows[0][1].ToString())
+	assert.Equal(t, "V3", qr.Rows[0][2].ToString())
+	assert.Equal(t, "1", qr.Rows[0][4].ToString())
+	assert.Equal(t, "1", qr.Rows[0][6].ToString())
+}
@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"errors"
 	"sort"
+	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -53,6 +54,19 @@ type ContextVSchema interface {
 	GetSemTable() *semantics.SemTable
 	Planner() PlannerVersion
 
+	// FindView returns the select statement of a view of the VSchema, or
+	// nil if the table is not a view.
+	FindView(name sqlparser.TableName) sqlparser.SelectStatement
+
+	// IsViewsEnabled returns true if the views are stored in the VSchema
+	// and expanded by vtgate.
+	IsViewsEnabled() bool
+
+	// IsInformationSchemaEmulated returns true if vtgate answers the
+	// queries on the tables, columns and statistics tables of
+	// information_schema from the schemas of all the keyspaces.
+	IsInformationSchemaEmulated() bool
+
 	// ErrorIfShardedF will return an error if the keyspace is sharded,
 	// and produce a warning if the vtgate if configured to do so
 	ErrorIfShardedF(keyspace *vindexes.Keyspace, warn, errFmt string, params ...interface{}) error
@@ -79,6 +93,24 @@ const (
 	Gen4WithFallback = querypb.ExecuteOptions_Gen4WithFallback
 )
 
+// PlannerNameToVersion returns the planner version of a planner name,
+// as used by the planner_version flag.
+func PlannerNameToVersion(s string) (PlannerVersion, bool) {
+	switch strings.ToLower(s) {
+	case "v3":
+		return V3, true
+	case "gen4":
+		return Gen4, true
+	case "gen4greedy", "greedy":
+		return Gen4GreedyOnly, true
+	case "left2right":
+		return Gen4Left2Right, true
+	case "gen4fallback":
+		return Gen4WithFallback, true
+	}
+	return V3, false
+}
+
 type truncater interface {
 	SetTruncateColumnCount(int)
 }
@@ -144,6 +176,15 @@ type selectPlanner func(query string) func(sqlparser.Statement, sqlparser.BindVa
 func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars sqlparser.BindVars, vschema ContextVSchema) (engine.Primitive, error) {
 	switch stmt := stmt.(type) {
 	case *sqlparser.Select:
+		if plan := buildProcesslistSelectPlan(stmt); plan != nil {
+			return plan, nil
+		}
+		if plan := buildInformationSchemaPlan(stmt, vschema); plan != nil {
+			return plan, nil
+		}
+		if err := expandViews(stmt, vschema); err != nil {
+			return nil, err
+		}
 		configuredPlanner, err := getConfiguredPlanner(vschema)
 		if err != nil {
 			return nil, err
@@ -156,6 +197,9 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars s
 	case *sqlparser.Delete:
 		return buildRoutePlan(stmt, reservedVars, vschema, buildDeletePlan)
 	case *sqlparser.Union:
+		if err := expandViews(stmt, vschema); err != nil {
+			return nil, err
+		}
 		return buildRoutePlan(stmt, reservedVars, vschema, buildUnionPlan)
 	case sqlparser.DDLStatement:
 		return buildGeneralDDLPlan(query, stmt, reservedVars, vschema)
@@ -163,6 +207,8 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars s
 		return buildAlterMigrationPlan(query, vschema)
 	case *sqlparser.RevertMigration:
 		return buildRevertMigrationPlan(query, stmt, vschema)
+	case *sqlparser.AlterDeadLetters:
+		return buildAlterDeadLettersPlan(stmt, vschema)
 	case *sqlparser.AlterVschema:
 		return buildVSchemaDDLPlan(stmt, vschema)
 	case *sqlparser.Use:
@@ -192,6 +238,8 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars s
 		return buildFlushPlan(stmt, vschema)
 	case *sqlparser.CallProc:
 		return buildCallProcPlan(stmt, vschema)
+	case *sqlparser.Kill:
+		return buildKillPlan(stmt)
 	}
 
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement type: %T", stmt)
@@ -25,6 +25,13 @@ const (
 // This is why we return a compound primitive (DDL) which contains fully populated primitives (Send & OnlineDDL),
 // and which chooses which of the two to invoke at runtime.
 func buildGeneralDDLPlan(sql string, ddlStatement sqlparser.DDLStatement, reservedVars sqlparser.BindVars, vschema ContextVSchema) (engine.Primitive, error) {
+	if vschema.IsViewsEnabled() {
+		switch ddlStatement.(type) {
+		case *sqlparser.CreateView, *sqlparser.AlterView, *sqlparser.DropView:
+			return buildViewDDLPlan(ddlStatement, reservedVars, vschema)
+		}
+	}
+
 	normalDDLPlan, onlineDDLPlan, err := buildDDLPlans(sql, ddlStatement, reservedVars, vschema)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/key"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// buildAlterDeadLettersPlan sends `ALTER VITESS_DEAD_LETTERS` to all the shards of
+// the message table. The tablets requeue or purge their own dead letters.
+func buildAlterDeadLettersPlan(stmt *sqlparser.AlterDeadLetters, vschema ContextVSchema) (engine.Primitive, error) {
+	table, dest, tabletType, err := findMessageTable(stmt.Table, vschema)
+	if err != nil {
+		return nil, err
+	}
+	if tabletType != topodatapb.TabletType_MASTER {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "ALTER VITESS_DEAD_LETTERS works only on primary tablet")
+	}
+	stmt.Table = sqlparser.TableName{Name: table.Name}
+	return &engine.Send{
+		Keyspace:          table.Keyspace,
+		TargetDestination: dest,
+		Query:             sqlparser.String(stmt),
+		IsDML:             true,
+	}, nil
+}
+
+// buildShowDeadLettersPlan serves `SHOW VITESS_DEAD_LETTERS FROM ...` queries
+// from the dead-letter tables of all the shards of the message table.
+func buildShowDeadLettersPlan(show *sqlparser.ShowBasic, vschema ContextVSchema) (engine.Primitive, error) {
+	if show.Filter != nil && show.Filter.Filter == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: like clause in show vitess_dead_letters, use a where clause")
+	}
+	table, dest, _, err := findMessageTable(show.Tbl, vschema)
+	if err != nil {
+		return nil, err
+	}
+	show.Tbl = sqlparser.TableName{Name: table.Name}
+	return &engine.Send{
+		Keyspace:          table.Keyspace,
+		TargetDestination: dest,
+		Query:             sqlparser.String(show),
+		IsDML:             false,
+	}, nil
+}
+
+func findMessageTable(tableName sqlparser.TableName, vschema ContextVSchema) (*vindexes.Table, key.Destination, topodatapb.TabletType, error) {
+	table, _, tabletType, dest, err := vschema.FindTable(tableName)
+	if err != nil {
+		return nil, nil, tabletType, err
+	}
+	if dest == nil {
+		dest = key.DestinationAllShards{}
+	}
+	return table, dest, tabletType, nil
+}
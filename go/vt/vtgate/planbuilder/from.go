@@ -327,6 +327,7 @@ func (pb *primitiveBuilder) join(rpb *primitiveBuilder, ajoin *sqlparser.JoinTab
 		lRoute.eroute, rRoute.eroute = rRoute.eroute, lRoute.eroute
 	}
 	lRoute.substitutions = append(lRoute.substitutions, rRoute.substitutions...)
+	lRoute.mergeMultiColValues(rRoute)
 	rRoute.Redirect = lRoute
 
 	// Merge the AST.
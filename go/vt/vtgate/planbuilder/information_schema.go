@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// buildInformationSchemaPlan builds the plan of a select on the tables,
+// columns or statistics tables of information_schema, which is then
+// answered by the vtgate from the schemas of all the shards of all the
+// keyspaces. Only column lists, conjunctions of equality filters and
+// orderings by columns are supported; for any other select, nil is
+// returned and the query is routed like other information_schema queries.
+func buildInformationSchemaPlan(sel *sqlparser.Select, vschema ContextVSchema) engine.Primitive {
+	if !vschema.IsInformationSchemaEmulated() || vschema.Destination() != nil {
+		return nil
+	}
+	table, alias, ok := informationSchemaTable(sel.From)
+	if !ok {
+		return nil
+	}
+	if sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil || sel.Lock != sqlparser.NoLock || sel.Into != nil {
+		return nil
+	}
+	keyspaces, err := vschema.AllKeyspace()
+	if err != nil {
+		return nil
+	}
+	columns := engine.InformationSchemaColumns(table)
+	columnIndex := func(expr sqlparser.Expr) (int, bool) {
+		col, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			return 0, false
+		}
+		if !col.Qualifier.IsEmpty() && !strings.EqualFold(col.Qualifier.Name.String(), alias) {
+			return 0, false
+		}
+		for i, name := range columns {
+			if col.Name.EqualString(name) {
+				return i, true
+			}
+		}
+		return 0, false
+	}
+
+	plan := &engine.InformationSchema{Table: table}
+	for _, ks := range keyspaces {
+		plan.Keyspaces = append(plan.Keyspaces, ks.Name)
+	}
+	sort.Strings(plan.Keyspaces)
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.StarExpr:
+			if !expr.TableName.IsEmpty() && !strings.EqualFold(expr.TableName.Name.String(), alias) {
+				return nil
+			}
+			for i, name := range columns {
+				plan.Columns = append(plan.Columns, engine.InformationSchemaColumn{Name: name, Index: i})
+			}
+		case *sqlparser.AliasedExpr:
+			idx, ok := columnIndex(expr.Expr)
+			if !ok {
+				return nil
+			}
+			name := columns[idx]
+			if !expr.As.IsEmpty() {
+				name = expr.As.String()
+			}
+			plan.Columns = append(plan.Columns, engine.InformationSchemaColumn{Name: name, Index: idx})
+		default:
+			return nil
+		}
+	}
+	if sel.Where != nil {
+		for _, filter := range sqlparser.SplitAndExpression(nil, sel.Where.Expr) {
+			cmp, ok := filter.(*sqlparser.ComparisonExpr)
+			if !ok || cmp.Operator != sqlparser.EqualOp {
+				return nil
+			}
+			col, value := cmp.Left, cmp.Right
+			if _, ok := col.(*sqlparser.ColName); !ok {
+				col, value = value, col
+			}
+			idx, ok := columnIndex(col)
+			if !ok {
+				return nil
+			}
+			expr, ok := informationSchemaValue(value, vschema)
+			if !ok {
+				return nil
+			}
+			plan.Filters = append(plan.Filters, engine.InformationSchemaFilter{Index: idx, Value: expr})
+		}
+	}
+	for _, order := range sel.OrderBy {
+		idx, ok := columnIndex(order.Expr)
+		if !ok {
+			return nil
+		}
+		plan.OrderBy = append(plan.OrderBy, engine.InformationSchemaOrder{Index: idx, Desc: order.Direction == sqlparser.DescOrder})
+	}
+	return plan
+}
+
+// informationSchemaTable returns the name and the alias of the table of
+// information_schema selected from, if it is emulated by the vtgate.
+func informationSchemaTable(from sqlparser.TableExprs) (string, string, bool) {
+	if len(from) != 1 {
+		return "", "", false
+	}
+	tbl, ok := from[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return "", "", false
+	}
+	name, ok := tbl.Expr.(sqlparser.TableName)
+	if !ok || !strings.EqualFold(name.Qualifier.String(), "information_schema") {
+		return "", "", false
+	}
+	table := strings.ToLower(name.Name.String())
+	if engine.InformationSchemaColumns(table) == nil {
+		return "", "", false
+	}
+	alias := name.Name.String()
+	if !tbl.As.IsEmpty() {
+		alias = tbl.As.String()
+	}
+	return table, alias, true
+}
+
+// informationSchemaValue converts the value a column is compared with.
+// database() is the keyspace the session targets.
+func informationSchemaValue(value sqlparser.Expr, vschema ContextVSchema) (evalengine.Expr, bool) {
+	if fn, ok := value.(*sqlparser.FuncExpr); ok && len(fn.Exprs) == 0 && (fn.Name.EqualString("database") || fn.Name.EqualString("schema")) {
+		ks, err := vschema.DefaultKeyspace()
+		if err != nil {
+			return nil, false
+		}
+		return evalengine.NewLiteralString([]byte(ks.Name)), true
+	}
+	expr, err := sqlparser.Convert(value)
+	if err != nil {
+		return nil, false
+	}
+	return expr, true
+}
@@ -24,7 +24,6 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
-	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -82,11 +81,6 @@ func transformRoutePlan(n *routePlan) (*route, error) {
 		where = &sqlparser.Where{Expr: predicates, Type: sqlparser.WhereClause}
 	}
 
-	var singleColumn vindexes.SingleColumn
-	if n.vindex != nil {
-		singleColumn = n.vindex.(vindexes.SingleColumn)
-	}
-
 	var expressions sqlparser.SelectExprs
 	for _, col := range n.columns {
 		expressions = append(expressions, &sqlparser.AliasedExpr{Expr: col})
@@ -103,7 +97,7 @@ func transformRoutePlan(n *routePlan) (*route, error) {
 			Opcode:    n.routeOpCode,
 			TableName: strings.Join(tableNames, ", "),
 			Keyspace:  n.keyspace,
-			Vindex:    singleColumn,
+			Vindex:    n.vindex,
 			Values:    n.vindexValues,
 		},
 		Select: &sqlparser.Select{
@@ -143,8 +143,37 @@ func newCostlyIndex(name string, _ map[string]string) (vindexes.Vindex, error) {
 var _ vindexes.Vindex = (*costlyIndex)(nil)
 var _ vindexes.Lookup = (*costlyIndex)(nil)
 
+// multiColLookupIndex satisfies MultiColumn, Lookup, Unique.
+type multiColLookupIndex struct{ name string }
+
+func (v *multiColLookupIndex) String() string   { return v.name }
+func (*multiColLookupIndex) Cost() int          { return 2 }
+func (*multiColLookupIndex) IsUnique() bool     { return true }
+func (*multiColLookupIndex) NeedsVCursor() bool { return false }
+func (*multiColLookupIndex) Verify(vindexes.VCursor, [][]sqltypes.Value, [][]byte) ([]bool, error) {
+	return []bool{}, nil
+}
+func (*multiColLookupIndex) Map(vindexes.VCursor, [][]sqltypes.Value) ([]key.Destination, error) {
+	return nil, nil
+}
+func (*multiColLookupIndex) Create(vindexes.VCursor, [][]sqltypes.Value, [][]byte, bool) error {
+	return nil
+}
+func (*multiColLookupIndex) Delete(vindexes.VCursor, [][]sqltypes.Value, []byte) error { return nil }
+func (*multiColLookupIndex) Update(vindexes.VCursor, []sqltypes.Value, []byte, []sqltypes.Value) error {
+	return nil
+}
+
+func newMultiColLookupIndex(name string, _ map[string]string) (vindexes.Vindex, error) {
+	return &multiColLookupIndex{name: name}, nil
+}
+
+var _ vindexes.MultiColumn = (*multiColLookupIndex)(nil)
+var _ vindexes.Lookup = (*multiColLookupIndex)(nil)
+
 func init() {
 	vindexes.Register("hash_test", newHashIndex)
+	vindexes.Register("multicol_lookup_test", newMultiColLookupIndex)
 	vindexes.Register("lookup_test", newLookupIndex)
 	vindexes.Register("multi", newMultiIndex)
 	vindexes.Register("costly", newCostlyIndex)
@@ -247,11 +276,46 @@ func TestWithDefaultKeyspaceFromFile(t *testing.T) {
 	testFile(t, "alterVschema_cases.txt", testOutputTempDir, vschema, false)
 	testFile(t, "ddl_cases.txt", testOutputTempDir, vschema, false)
 	testFile(t, "migration_cases.txt", testOutputTempDir, vschema, false)
+	testFile(t, "dead_letter_cases.txt", testOutputTempDir, vschema, false)
 	testFile(t, "flush_cases.txt", testOutputTempDir, vschema, false)
 	testFile(t, "show_cases.txt", testOutputTempDir, vschema, false)
 	testFile(t, "call_cases.txt", testOutputTempDir, vschema, false)
 }
 
+func TestViewsFromFile(t *testing.T) {
+	testOutputTempDir, err := ioutil.TempDir("", "plan_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(testOutputTempDir)
+	vschema := &vschemaWrapper{
+		v: loadSchema(t, "schema_test.json"),
+		keyspace: &vindexes.Keyspace{
+			Name:    "user",
+			Sharded: true,
+		},
+		tabletType:   topodatapb.TabletType_MASTER,
+		viewsEnabled: true,
+	}
+
+	testFile(t, "view_cases.txt", testOutputTempDir, vschema, false)
+}
+
+func TestInformationSchemaEmulationFromFile(t *testing.T) {
+	testOutputTempDir, err := ioutil.TempDir("", "plan_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(testOutputTempDir)
+	vschema := &vschemaWrapper{
+		v: loadSchema(t, "schema_test.json"),
+		keyspace: &vindexes.Keyspace{
+			Name:    "user",
+			Sharded: true,
+		},
+		tabletType: topodatapb.TabletType_MASTER,
+		emulateIS:  true,
+	}
+
+	testFile(t, "info_schema_emulation_cases.txt", testOutputTempDir, vschema, false)
+}
+
 func TestWithSystemSchemaAsDefaultKeyspace(t *testing.T) {
 	// We are testing this separately so we can set a default keyspace
 	testOutputTempDir, err := ioutil.TempDir("", "plan_test")
@@ -309,6 +373,8 @@ type vschemaWrapper struct {
 	tabletType    topodatapb.TabletType
 	dest          key.Destination
 	sysVarEnabled bool
+	viewsEnabled  bool
+	emulateIS     bool
 	version       PlannerVersion
 }
 
@@ -337,6 +403,25 @@ func (vw *vschemaWrapper) SysVarSetEnabled() bool {
 	return vw.sysVarEnabled
 }
 
+func (vw *vschemaWrapper) IsViewsEnabled() bool {
+	return vw.viewsEnabled
+}
+
+func (vw *vschemaWrapper) IsInformationSchemaEmulated() bool {
+	return vw.emulateIS
+}
+
+func (vw *vschemaWrapper) FindView(tab sqlparser.TableName) sqlparser.SelectStatement {
+	destKeyspace, _, _, err := topoproto.ParseDestination(tab.Qualifier.String(), topodatapb.TabletType_MASTER)
+	if err != nil {
+		return nil
+	}
+	if destKeyspace == "" {
+		destKeyspace = vw.getActualKeyspace()
+	}
+	return vw.v.FindView(destKeyspace, tab.Name.String())
+}
+
 func (vw *vschemaWrapper) TargetDestination(qualifier string) (key.Destination, *vindexes.Keyspace, topodatapb.TabletType, error) {
 	var keyspaceName string
 	if vw.keyspace != nil {
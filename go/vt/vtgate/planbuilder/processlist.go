@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strings"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+func buildKillPlan(stmt *sqlparser.Kill) (engine.Primitive, error) {
+	return &engine.Kill{
+		ProcesslistID: stmt.ProcesslistID,
+		QueryOnly:     stmt.Type == sqlparser.QueryType,
+	}, nil
+}
+
+func buildShowProcesslistPlan(show *sqlparser.ShowBasic) (engine.Primitive, error) {
+	if show.Filter != nil {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: filter on show processlist, query information_schema.processlist instead")
+	}
+	return engine.NewShowProcesslist(show.Full), nil
+}
+
+// buildProcesslistSelectPlan builds the plan of a select on
+// information_schema.processlist, which is then served by the vtgate
+// itself. Only column lists and conjunctions of equality filters are
+// supported; for any other select, nil is returned and the query is
+// sent to MySQL like other information_schema queries.
+func buildProcesslistSelectPlan(sel *sqlparser.Select) engine.Primitive {
+	if !isProcesslistTable(sel.From) {
+		return nil
+	}
+	if sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Limit != nil {
+		return nil
+	}
+	plan := &engine.Processlist{Full: true}
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.StarExpr:
+			for i, name := range engine.ProcesslistColumns {
+				plan.Columns = append(plan.Columns, engine.ProcesslistColumn{Name: strings.ToUpper(name), Index: i})
+			}
+		case *sqlparser.AliasedExpr:
+			col, ok := expr.Expr.(*sqlparser.ColName)
+			if !ok {
+				return nil
+			}
+			idx, ok := processlistColumnIndex(col)
+			if !ok {
+				return nil
+			}
+			name := strings.ToUpper(engine.ProcesslistColumns[idx])
+			if !expr.As.IsEmpty() {
+				name = expr.As.String()
+			}
+			plan.Columns = append(plan.Columns, engine.ProcesslistColumn{Name: name, Index: idx})
+		default:
+			return nil
+		}
+	}
+	if sel.Where == nil {
+		return plan
+	}
+	for _, filter := range sqlparser.SplitAndExpression(nil, sel.Where.Expr) {
+		cmp, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualOp {
+			return nil
+		}
+		col, value := cmp.Left, cmp.Right
+		if _, ok := col.(*sqlparser.ColName); !ok {
+			col, value = value, col
+		}
+		colName, ok := col.(*sqlparser.ColName)
+		if !ok {
+			return nil
+		}
+		idx, ok := processlistColumnIndex(colName)
+		if !ok {
+			return nil
+		}
+		expr, err := sqlparser.Convert(value)
+		if err != nil {
+			return nil
+		}
+		plan.Filters = append(plan.Filters, engine.ProcesslistFilter{Index: idx, Value: expr})
+	}
+	return plan
+}
+
+func isProcesslistTable(from sqlparser.TableExprs) bool {
+	if len(from) != 1 {
+		return false
+	}
+	tbl, ok := from[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return false
+	}
+	name, ok := tbl.Expr.(sqlparser.TableName)
+	if !ok {
+		return false
+	}
+	return strings.EqualFold(name.Qualifier.String(), "information_schema") &&
+		strings.EqualFold(name.Name.String(), "processlist")
+}
+
+func processlistColumnIndex(col *sqlparser.ColName) (int, bool) {
+	for i, name := range engine.ProcesslistColumns {
+		if col.Name.EqualString(name) {
+			return i, true
+		}
+	}
+	return 0, false
+}
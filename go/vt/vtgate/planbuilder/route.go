@@ -62,6 +62,10 @@ type route struct {
 	// to resolve the ERoute Values field.
 	condition sqlparser.Expr
 
+	// multiColValues stores the values that equality filters
+	// assign to columns of multi-column vindexes.
+	multiColValues map[*column]sqlparser.Expr
+
 	// eroute is the primitive being built.
 	eroute *engine.Route
 
@@ -155,6 +159,23 @@ func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 	if rb.eroute.Values == nil {
 		// Resolve values stored in the logical plan.
 		switch vals := rb.condition.(type) {
+		case sqlparser.ValTuple:
+			if _, ok := rb.eroute.Vindex.(vindexes.MultiColumn); !ok {
+				pv, err := rb.procureValues(plan, jt, vals)
+				if err != nil {
+					return err
+				}
+				rb.eroute.Values = []sqltypes.PlanValue{pv}
+				break
+			}
+			// A multi-column vindex has one value per column.
+			for _, val := range vals {
+				pv, err := rb.procureValues(plan, jt, val)
+				if err != nil {
+					return err
+				}
+				rb.eroute.Values = append(rb.eroute.Values, pv)
+			}
 		case *sqlparser.ComparisonExpr:
 			pv, err := rb.procureValues(plan, jt, vals.Right)
 			if err != nil {
@@ -557,6 +578,19 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 		return
 	}
 	opcode, vindex, values := rb.computePlan(pb, filter)
+	rb.choosePlan(opcode, vindex, values)
+	if multiVindex, multiValues := rb.computeMultiColumnPlan(pb, filter); multiVindex != nil {
+		opcode := engine.SelectEqual
+		if multiVindex.IsUnique() {
+			opcode = engine.SelectEqualUnique
+		}
+		rb.choosePlan(opcode, multiVindex, multiValues)
+	}
+}
+
+// choosePlan updates the route if the specified plan
+// is better than the current one.
+func (rb *route) choosePlan(opcode engine.RouteOpcode, vindex vindexes.Vindex, values sqlparser.Expr) {
 	if opcode == engine.SelectScatter {
 		return
 	}
@@ -605,12 +639,85 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	}
 }
 
-func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	rb.eroute.Opcode = opcode
 	rb.eroute.Vindex = vindex
 	rb.condition = condition
 }
 
+// computeMultiColumnPlan records the value of an equality filter on a column
+// of a multi-column vindex. If the filter completes the values of such a vindex,
+// the vindex is returned along with the values ordered by the vindex columns.
+func (rb *route) computeMultiColumnPlan(pb *primitiveBuilder, filter sqlparser.Expr) (vindexes.MultiColumn, sqlparser.ValTuple) {
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualOp {
+		return nil, nil
+	}
+	col, value := rb.multiColumnOperand(pb, comparison.Left, comparison.Right)
+	if col == nil {
+		col, value = rb.multiColumnOperand(pb, comparison.Right, comparison.Left)
+		if col == nil {
+			return nil, nil
+		}
+	}
+	if rb.multiColValues == nil {
+		rb.multiColValues = make(map[*column]sqlparser.Expr)
+	}
+	rb.multiColValues[col] = value
+
+	var best *multiColumnVindex
+	var bestValues sqlparser.ValTuple
+outer:
+	for _, mcv := range col.multiColVindexes {
+		values := make(sqlparser.ValTuple, 0, len(mcv.columns))
+		for _, mcol := range mcv.columns {
+			val, ok := rb.multiColValues[mcol]
+			if !ok {
+				continue outer
+			}
+			values = append(values, val)
+		}
+		if best == nil || mcv.vindex.Cost() < best.vindex.Cost() {
+			best, bestValues = mcv, values
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+	return best.vindex, bestValues
+}
+
+// mergeMultiColValues keeps the values that the filters of a route
+// joined into this one assigned to columns of multi-column vindexes,
+// so that the filters pushed after the merge can complete them.
+func (rb *route) mergeMultiColValues(other *route) {
+	for col, value := range other.multiColValues {
+		if rb.multiColValues == nil {
+			rb.multiColValues = make(map[*column]sqlparser.Expr)
+		}
+		rb.multiColValues[col] = value
+	}
+}
+
+// multiColumnOperand returns the column if expr is a column of this route
+// that belongs to a multi-column vindex, and value can be used for routing.
+func (rb *route) multiColumnOperand(pb *primitiveBuilder, expr, value sqlparser.Expr) (*column, sqlparser.Expr) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok || sqlparser.IsNull(value) || !rb.exprIsValue(value) {
+		return nil, nil
+	}
+	if colName.Metadata == nil {
+		if _, _, err := pb.st.Find(colName); err != nil {
+			return nil, nil
+		}
+	}
+	col := colName.Metadata.(*column)
+	if col.Origin() != rb || len(col.multiColVindexes) == 0 {
+		return nil, nil
+	}
+	return col, value
+}
+
 // computePlan computes the plan for the specified filter.
 func (rb *route) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
 	switch node := filter.(type) {
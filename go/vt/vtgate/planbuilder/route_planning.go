@@ -46,7 +46,7 @@ func gen4Planner(_ string) func(sqlparser.Statement, sqlparser.BindVars, Context
 }
 
 func newBuildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
-	semTable, err := semantics.Analyse(sel) // TODO no nil no
+	semTable, err := semantics.Analyse(sel, vschema)
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +210,7 @@ func (rp *routePlan) clone() joinTree {
 	for i, pred := range rp.vindexPreds {
 		// we do this to create a copy of the struct
 		p := *pred
+		p.values = append([]*sqltypes.PlanValue(nil), pred.values...)
 		result.vindexPreds[i] = &p
 	}
 	return &result
@@ -248,11 +249,41 @@ func (rp *routePlan) cost() int {
 // vindexPlusPredicates is a struct used to store all the predicates that the vindex can be used to query
 type vindexPlusPredicates struct {
 	vindex *vindexes.ColumnVindex
-	values []sqltypes.PlanValue
+	// values has the value of each column of the vindex, in the order of the
+	// columns. The value of a column is nil until a predicate provides it.
+	values []*sqltypes.PlanValue
 	// Vindex is covered if all the columns in the vindex have an associated predicate
 	covered bool
 }
 
+// setValue records the value of a column of the vindex, and returns true if
+// it was the last column missing a value.
+func (v *vindexPlusPredicates) setValue(colIdx int, value sqltypes.PlanValue) bool {
+	if v.values == nil {
+		v.values = make([]*sqltypes.PlanValue, len(v.vindex.Columns))
+	}
+	if v.values[colIdx] != nil {
+		return false
+	}
+	v.values[colIdx] = &value
+	for _, val := range v.values {
+		if val == nil {
+			return false
+		}
+	}
+	v.covered = true
+	return true
+}
+
+// planValues returns the values of the columns of a covered vindex.
+func (v *vindexPlusPredicates) planValues() []sqltypes.PlanValue {
+	values := make([]sqltypes.PlanValue, 0, len(v.values))
+	for _, val := range v.values {
+		values = append(values, *val)
+	}
+	return values
+}
+
 // addPredicate clones this routePlan and returns a new one with these predicates added to it. if the predicates can help,
 // they will improve the routeOpCode
 func (rp *routePlan) addPredicate(predicates ...sqlparser.Expr) error {
@@ -309,13 +340,10 @@ func (rp *routePlan) searchForNewVindexes(predicates []sqlparser.Expr) (bool, er
 						return false, err
 					}
 					if ok {
-						for _, col := range v.vindex.Columns {
-							// If the column for the predicate matches any column in the vindex add it to the list
-							if column.Name.Equal(col) {
-								v.values = append(v.values, value)
-								// Vindex is covered if all the columns in the vindex have a associated predicate
-								v.covered = len(v.values) == len(v.vindex.Columns)
-								newVindexFound = newVindexFound || v.covered
+						for colIdx, col := range v.vindex.Columns {
+							// If the column for the predicate matches any column in the vindex, it provides the value of the column
+							if column.Name.Equal(col) && v.setValue(colIdx, value) {
+								newVindexFound = true
 							}
 						}
 					}
@@ -337,7 +365,7 @@ func (rp *routePlan) pickBestAvailableVindex() {
 		// Choose the minimum cost vindex from the ones which are covered
 		if rp.vindex == nil || v.vindex.Vindex.Cost() < rp.vindex.Cost() {
 			rp.vindex = v.vindex.Vindex
-			rp.vindexValues = v.values
+			rp.vindexValues = v.planValues()
 		}
 	}
 
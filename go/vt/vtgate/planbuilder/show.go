@@ -70,6 +70,12 @@ func buildShowBasicPlan(show *sqlparser.ShowBasic, vschema ContextVSchema) (engi
 		return engine.NewRowsPrimitive(make([][]sqltypes.Value, 0, 2), buildVarCharFields("Variable_name", "Value")), nil
 	case sqlparser.VitessMigrations:
 		return buildShowVMigrationsPlan(show, vschema)
+	case sqlparser.Processlist:
+		return buildShowProcesslistPlan(show)
+	case sqlparser.VitessPlans:
+		return buildShowVitessPlansPlan(show)
+	case sqlparser.VitessDeadLetters:
+		return buildShowDeadLettersPlan(show, vschema)
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unknown show query type %s", show.Command.ToString())
 
@@ -190,6 +196,18 @@ func buildDBPlan(show *sqlparser.ShowBasic, vschema ContextVSchema) (engine.Prim
 	return engine.NewRowsPrimitive(rows, buildVarCharFields("Database")), nil
 }
 
+// buildShowVitessPlansPlan serves `SHOW VITESS_PLANS [LIKE ...]` queries from the plan cache of the vtgate.
+func buildShowVitessPlansPlan(show *sqlparser.ShowBasic) (engine.Primitive, error) {
+	plan := &engine.VitessPlans{}
+	if show.Filter != nil {
+		if show.Filter.Filter != nil {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: where clause on show vitess_plans, use like instead")
+		}
+		plan.Like = show.Filter.Like
+	}
+	return plan, nil
+}
+
 // buildShowVMigrationsPlan serves `SHOW VITESS_MIGRATIONS ...` queries. It invokes queries on _vt.schema_migrations on all MASTER tablets on keyspace's shards.
 func buildShowVMigrationsPlan(show *sqlparser.ShowBasic, vschema ContextVSchema) (engine.Primitive, error) {
 	dest, ks, tabletType, err := vschema.TargetDestination(show.DbName.String())
@@ -110,6 +110,12 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTable *vinde
 	}
 
 	for _, cv := range vschemaTable.ColumnVindexes {
+		if multi, ok := cv.Vindex.(vindexes.MultiColumn); ok {
+			if err := t.addMultiColumnVindex(multi, cv.Columns, rb, st); err != nil {
+				return err
+			}
+			continue
+		}
 		single, ok := cv.Vindex.(vindexes.SingleColumn)
 		if !ok {
 			continue
@@ -465,6 +471,26 @@ type table struct {
 	vschemaTable    *vindexes.Table
 }
 
+// addMultiColumnVindex adds the columns of a multi-column vindex
+// and links each of them to the vindex.
+func (t *table) addMultiColumnVindex(vindex vindexes.MultiColumn, cols []sqlparser.ColIdent, rb *route, st *symtab) error {
+	mcv := &multiColumnVindex{vindex: vindex}
+	for _, cvcol := range cols {
+		col, err := t.mergeColumn(cvcol, &column{
+			origin: rb,
+			st:     st,
+		})
+		if err != nil {
+			return err
+		}
+		mcv.columns = append(mcv.columns, col)
+	}
+	for _, col := range mcv.columns {
+		col.multiColVindexes = append(col.multiColVindexes, mcv)
+	}
+	return nil
+}
+
 func (t *table) addColumn(alias sqlparser.ColIdent, c *column) {
 	if t.columns == nil {
 		t.columns = make(map[string]*column)
@@ -524,6 +550,17 @@ type column struct {
 	vindex    vindexes.SingleColumn
 	typ       querypb.Type
 	colNumber int
+
+	// multiColVindexes lists the multi-column vindexes
+	// this column is part of.
+	multiColVindexes []*multiColumnVindex
+}
+
+// multiColumnVindex is a vindex that can only be used for
+// routing if all of its columns have a value.
+type multiColumnVindex struct {
+	vindex  vindexes.MultiColumn
+	columns []*column
 }
 
 // Origin returns the route that originates the column.
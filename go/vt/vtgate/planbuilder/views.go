@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// expandViews replaces the references to the views of the VSchema with
+// derived tables of the select statements of the views.
+func expandViews(stmt sqlparser.SelectStatement, vschema ContextVSchema) error {
+	if !vschema.IsViewsEnabled() {
+		return nil
+	}
+	return expandViewsOf(stmt, vschema, nil)
+}
+
+// expandViewsOf expands the views referenced by node. The views being
+// expanded are used to detect views which reference themselves.
+func expandViewsOf(node sqlparser.SQLNode, vschema ContextVSchema, expanding []string) error {
+	var err error
+	_ = sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		aliased, ok := cursor.Node().(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true
+		}
+		tableName, ok := aliased.Expr.(sqlparser.TableName)
+		if !ok {
+			return true
+		}
+		view := vschema.FindView(tableName)
+		if view == nil {
+			return false
+		}
+		// The select statement identifies the view, since the name used
+		// to reference a view may or may not be qualified.
+		definition := sqlparser.String(view)
+		for _, expanded := range expanding {
+			if expanded == definition {
+				err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "view %s references itself", sqlparser.String(tableName))
+				return false
+			}
+		}
+		if err = expandViewsOf(view, vschema, append(expanding[:len(expanding):len(expanding)], definition)); err != nil {
+			return false
+		}
+		aliased.Expr = &sqlparser.DerivedTable{Select: view}
+		if aliased.As.IsEmpty() {
+			aliased.As = tableName.Name
+		}
+		return false
+	}, nil)
+	return err
+}
+
+// buildViewDDLPlan builds a plan which stores a CREATE VIEW, ALTER VIEW or
+// DROP VIEW statement in the VSchema, instead of sending it to the shards.
+func buildViewDDLPlan(ddlStatement sqlparser.DDLStatement, reservedVars sqlparser.BindVars, vschema ContextVSchema) (engine.Primitive, error) {
+	var keyspace *vindexes.Keyspace
+	var err error
+	switch ddl := ddlStatement.(type) {
+	case *sqlparser.CreateView:
+		keyspace, err = buildViewSelect(ddl.ViewName, ddl.Select, reservedVars, vschema)
+	case *sqlparser.AlterView:
+		keyspace, err = buildViewSelect(ddl.ViewName, ddl.Select, reservedVars, vschema)
+	case *sqlparser.DropView:
+		for _, view := range ddl.FromTables {
+			var viewKeyspace *vindexes.Keyspace
+			_, viewKeyspace, _, err = vschema.TargetDestination(view.Qualifier.String())
+			if err != nil {
+				return nil, err
+			}
+			if keyspace != nil && keyspace.Name != viewKeyspace.Name {
+				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, DifferentDestinations)
+			}
+			keyspace = viewKeyspace
+		}
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected view ddl statement type: %T", ddlStatement)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &engine.ViewDDL{
+		Keyspace: keyspace,
+		DDL:      ddlStatement,
+	}, nil
+}
+
+// buildViewSelect returns the keyspace of a view, after checking that the
+// select statement of the view can be planned once expanded.
+func buildViewSelect(viewName sqlparser.TableName, sel sqlparser.SelectStatement, reservedVars sqlparser.BindVars, vschema ContextVSchema) (*vindexes.Keyspace, error) {
+	_, keyspace, _, err := vschema.TargetDestination(viewName.Qualifier.String())
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := sqlparser.Parse(sqlparser.String(sel))
+	if err != nil {
+		return nil, err
+	}
+	view := stmt.(sqlparser.SelectStatement)
+	vindexes.QualifyViewTables(view, keyspace.Name)
+	if _, err := createInstructionFor(sqlparser.String(view), view, reservedVars, vschema); err != nil {
+		return nil, err
+	}
+	return keyspace, nil
+}
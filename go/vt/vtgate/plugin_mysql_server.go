@@ -46,6 +46,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/google/uuid"
 )
 
@@ -68,6 +69,8 @@ var (
 
 	mysqlSlowConnectWarnThreshold = flag.Duration("mysql_slow_connect_warn_threshold", 0, "Warn if it takes more than the given threshold for a mysql connection to establish")
 
+	mysqlServerCompression = flag.String("mysql_server_compression", "", "Comma separated list of the compression algorithms (zlib, zstd) the server accepts for the compressed protocol. If empty, the protocol is not compressed.")
+
 	mysqlConnReadTimeout  = flag.Duration("mysql_server_read_timeout", 0, "connection read timeout")
 	mysqlConnWriteTimeout = flag.Duration("mysql_server_write_timeout", 0, "connection write timeout")
 	mysqlQueryTimeout     = flag.Duration("mysql_server_query_timeout", 0, "mysql query timeout")
@@ -100,6 +103,13 @@ func (vh *vtgateHandler) NewConnection(c *mysql.Conn) {
 	vh.connections[c] = true
 }
 
+// ConnectionReady is part of the mysql.ConnectionReadyHandler interface.
+// It registers the connection in the process list, once the client is
+// authenticated.
+func (vh *vtgateHandler) ConnectionReady(c *mysql.Conn) {
+	vh.vtg.executor.ProcessList().Add(uint64(c.ConnectionID), c.User, c.UserData.Get().GetUsername(), c.RemoteAddr().String(), c.Close)
+}
+
 func (vh *vtgateHandler) numConnections() int {
 	vh.mu.Lock()
 	defer vh.mu.Unlock()
@@ -125,6 +135,7 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 		defer vh.mu.Unlock()
 		delete(vh.connections, c)
 	}()
+	defer vh.vtg.executor.ProcessList().Remove(uint64(c.ConnectionID))
 
 	var ctx context.Context
 	var cancel context.CancelFunc
@@ -204,6 +215,8 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 	ctx = callerid.NewContext(ctx, ef, im)
 
 	session := vh.session(c)
+	ctx, done := vh.startCommand(ctx, c, session.TargetString, "Query", query)
+	defer done()
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
 	}
@@ -226,6 +239,18 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 	return callback(result)
 }
 
+// startCommand marks the connection as running the query in the process
+// list until the returned function is called.
+func (vh *vtgateHandler) startCommand(ctx context.Context, c *mysql.Conn, db, command, query string) (context.Context, func()) {
+	return vh.vtg.executor.ProcessList().BeginCommand(ctx, uint64(c.ConnectionID), command, db, query)
+}
+
+// startCursor registers the cursor of the connection in the process
+// list until the returned function is called.
+func (vh *vtgateHandler) startCursor(ctx context.Context, c *mysql.Conn) (context.Context, func()) {
+	return vh.vtg.executor.ProcessList().BeginCursor(ctx, uint64(c.ConnectionID))
+}
+
 func fillInTxStatusFlags(c *mysql.Conn, session *vtgatepb.Session) {
 	if session.InTransaction {
 		c.StatusFlags |= mysql.ServerStatusInTrans
@@ -265,6 +290,8 @@ func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[st
 	ctx = callerid.NewContext(ctx, ef, im)
 
 	session := vh.session(c)
+	ctx, done := vh.startCommand(ctx, c, session.TargetString, "Prepare", query)
+	defer done()
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
 	}
@@ -283,6 +310,10 @@ func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[st
 }
 
 func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	if prepare.CursorType != mysql.CursorTypeNoCursor {
+		return vh.executeCursor(c, prepare, callback)
+	}
+
 	var ctx context.Context
 	var cancel context.CancelFunc
 	if *mysqlQueryTimeout != 0 {
@@ -307,6 +338,8 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 	ctx = callerid.NewContext(ctx, ef, im)
 
 	session := vh.session(c)
+	ctx, done := vh.startCommand(ctx, c, session.TargetString, "Execute", prepare.PrepareStmt)
+	defer done()
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
 	}
@@ -330,6 +363,71 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 	return callback(qr)
 }
 
+// executeCursor executes a statement for which the client opened a cursor.
+// The mysql server runs it in its own goroutine, and handles the next
+// commands of the connection as soon as the first result is sent. So the
+// statement streams its rows with its own copy of the session, is tracked
+// as a cursor in the process list, and stops counting as a busy connection
+// and for the query timeout once the rows are fetched by the client.
+func (vh *vtgateHandler) executeCursor(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var timer *time.Timer
+	if *mysqlQueryTimeout != 0 {
+		timer = time.AfterFunc(*mysqlQueryTimeout, cancel)
+	}
+
+	ctx = callinfo.MysqlCallInfo(ctx, c)
+
+	im := c.UserData.Get()
+	ef := callerid.NewEffectiveCallerID(
+		c.User,                  /* principal: who */
+		c.RemoteAddr().String(), /* component: running client process */
+		"VTGate MySQL Connector" /* subcomponent: part of the client */)
+	ctx = callerid.NewContext(ctx, ef, im)
+
+	session := vh.session(c)
+	ctx, done := vh.startCursor(ctx, c)
+	defer done()
+	busy := !session.InTransaction
+	if busy {
+		atomic.AddInt32(&busyConnections, 1)
+	}
+	// opened is called once the first result is sent.
+	opened := func() {
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		if busy {
+			atomic.AddInt32(&busyConnections, -1)
+			busy = false
+		}
+	}
+	defer opened()
+
+	if session.InTransaction {
+		// The rows of a cursor opened in a transaction are buffered before
+		// the cursor is opened: streaming them would share the transaction
+		// connections of the session with the next commands of the client,
+		// which run while the cursor is fetched.
+		_, qr, err := vh.vtg.Execute(ctx, session, prepare.PrepareStmt, prepare.BindVars)
+		if err != nil {
+			return mysql.NewSQLErrorFromError(err)
+		}
+		fillInTxStatusFlags(c, session)
+		opened()
+		return callback(qr)
+	}
+
+	cursorSession := proto.Clone(session).(*vtgatepb.Session)
+	err := vh.vtg.StreamExecute(ctx, cursorSession, prepare.PrepareStmt, prepare.BindVars, func(qr *sqltypes.Result) error {
+		opened()
+		return callback(qr)
+	})
+	return mysql.NewSQLErrorFromError(err)
+}
+
 func (vh *vtgateHandler) WarningCount(c *mysql.Conn) uint16 {
 	return uint16(len(vh.session(c).GetWarnings()))
 }
@@ -418,8 +516,12 @@ func initMySQLProtocol() {
 		log.Exitf("-mysql_tcp_version must be one of [tcp, tcp4, tcp6]")
 	}
 
+	compressionAlgorithms, err := mysql.ParseCompressionAlgorithms(*mysqlServerCompression)
+	if err != nil {
+		log.Exitf("-mysql_server_compression: %v", err)
+	}
+
 	// Create a Listener.
-	var err error
 	vtgateHandle = newVtgateHandler(rpcVTGate)
 	if *mysqlServerPort >= 0 {
 		mysqlListener, err = mysql.NewListener(*mysqlTCPVersion, net.JoinHostPort(*mysqlServerBindAddress, fmt.Sprintf("%v", *mysqlServerPort)), authServer, vtgateHandle, *mysqlConnReadTimeout, *mysqlConnWriteTimeout, *mysqlProxyProtocol)
@@ -433,6 +535,7 @@ func initMySQLProtocol() {
 			initTLSConfig(mysqlListener, *mysqlSslCert, *mysqlSslKey, *mysqlSslCa, *mysqlSslServerCA, *mysqlServerRequireSecureTransport)
 		}
 		mysqlListener.AllowClearTextWithoutTLS.Set(*mysqlAllowClearTextWithoutTLS)
+		mysqlListener.CompressionAlgorithms = compressionAlgorithms
 		// Check for the connection threshold
 		if *mysqlSlowConnectWarnThreshold != 0 {
 			log.Infof("setting mysql slow connection threshold to %v", mysqlSlowConnectWarnThreshold)
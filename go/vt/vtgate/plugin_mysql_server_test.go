@@ -19,14 +19,18 @@ package vtgate
 import (
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/trace"
 
@@ -35,6 +39,8 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	"vitess.io/vitess/go/vt/tlstest"
 )
 
@@ -68,6 +74,156 @@ func (th *testHandler) WarningCount(c *mysql.Conn) uint16 {
 	return 0
 }
 
+// connHandler hands over the server side of the connections.
+type connHandler struct {
+	testHandler
+	conns chan *mysql.Conn
+}
+
+func (h *connHandler) NewConnection(c *mysql.Conn) {
+	h.conns <- c
+}
+
+// newServerConn returns the server side of a connection to a listener
+// with a user1 user.
+func newServerConn(t *testing.T) (*mysql.Conn, func()) {
+	t.Helper()
+	handler := &connHandler{conns: make(chan *mysql.Conn, 1)}
+	authServer := mysql.NewAuthServerStatic("", `{"user1":{"Password":"password1", "UserData":"userData1"}}`, 0)
+	l, err := mysql.NewListener("tcp", "127.0.0.1:", authServer, handler, 0, 0, false)
+	require.NoError(t, err)
+	go l.Accept()
+	client, err := mysql.Connect(context.Background(), &mysql.ConnParams{
+		Host:  "127.0.0.1",
+		Port:  l.Addr().(*net.TCPAddr).Port,
+		Uname: "user1",
+		Pass:  "password1",
+	})
+	require.NoError(t, err)
+	return <-handler.conns, func() {
+		client.Close()
+		l.Close()
+	}
+}
+
+func TestCursorRunsWithOwnSession(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	c, closeConn := newServerConn(t)
+	defer closeConn()
+
+	vh := newVtgateHandler(rpcVTGate)
+	vh.ConnectionReady(c)
+	session := vh.session(c)
+	session.TargetString = KsTestUnsharded
+	session.Options.Workload = querypb.ExecuteOptions_OLAP
+	busy := atomic.LoadInt32(&busyConnections)
+
+	// The mysql server runs the statement of a cursor in its own goroutine,
+	// and handles the next commands once the first result is sent.
+	opened := make(chan struct{})
+	var once sync.Once
+	release := make(chan struct{})
+	cursorDone := make(chan error)
+	prepare := &mysql.PrepareData{
+		PrepareStmt: "select id from t1",
+		BindVars:    map[string]*querypb.BindVariable{},
+		CursorType:  mysql.CursorTypeReadOnly,
+	}
+	go func() {
+		cursorDone <- vh.ComStmtExecute(c, prepare, func(*sqltypes.Result) error {
+			once.Do(func() { close(opened) })
+			<-release
+			return nil
+		})
+	}()
+	<-opened
+	assert.Equal(t, busy, atomic.LoadInt32(&busyConnections))
+
+	// The session of the connection is not shared with the cursor.
+	err := vh.ComQuery(c, "set autocommit = 0", func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+	pl := rpcVTGate.executor.ProcessList()
+	ctx, done := pl.BeginCommand(context.Background(), uint64(c.ConnectionID), "Query", "", "select 1 from dual")
+	defer done()
+
+	close(release)
+	require.NoError(t, <-cursorDone)
+	assert.False(t, vh.session(c).Autocommit)
+
+	// The end of the cursor doesn't end the running command, which can
+	// still be killed.
+	require.NoError(t, pl.Kill("userData1", pl.processID(uint64(c.ConnectionID)), true))
+	assert.Error(t, ctx.Err())
+}
+
+func TestCursorBufferedInTransaction(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	c, closeConn := newServerConn(t)
+	defer closeConn()
+
+	vh := newVtgateHandler(rpcVTGate)
+	vh.ConnectionReady(c)
+	session := vh.session(c)
+	session.TargetString = KsTestUnsharded
+	session.InTransaction = true
+	session.Autocommit = false
+	session.ShardSessions = []*vtgatepb.Session_ShardSession{{
+		Target:        &querypb.Target{Keyspace: KsTestUnsharded, Shard: "0", TabletType: topodatapb.TabletType_MASTER},
+		TransactionId: 123,
+		TabletAlias:   sbc.Tablet().Alias,
+	}}
+
+	// The statement runs in the session, and its result is buffered: the
+	// transaction connection is not used by the cursor once it is opened.
+	prepare := &mysql.PrepareData{
+		PrepareStmt: "select id from t1",
+		BindVars:    map[string]*querypb.BindVariable{},
+		CursorType:  mysql.CursorTypeReadOnly,
+	}
+	err := vh.ComStmtExecute(c, prepare, func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+	assert.Empty(t, sbc.StreamTransactionIDs)
+	assert.EqualValues(t, 1, sbc.ExecCount.Get())
+
+	prepare.PrepareStmt = "select id from t1 for update"
+	err = vh.ComStmtExecute(c, prepare, func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+	assert.Empty(t, sbc.StreamTransactionIDs)
+	assert.EqualValues(t, 2, sbc.ExecCount.Get())
+	assert.True(t, vh.session(c).InTransaction)
+}
+
+func TestConnectionListedAtHandshake(t *testing.T) {
+	vh := newVtgateHandler(rpcVTGate)
+	authServer := mysql.NewAuthServerStatic("", `{"user1":{"Password":"password1", "UserData":"userData1"}}`, 0)
+	l, err := mysql.NewListener("tcp", "127.0.0.1:", authServer, vh, 0, 0, false)
+	require.NoError(t, err)
+	defer l.Close()
+	go l.Accept()
+	client, err := mysql.Connect(context.Background(), &mysql.ConnParams{
+		Host:  "127.0.0.1",
+		Port:  l.Addr().(*net.TCPAddr).Port,
+		Uname: "user1",
+		Pass:  "password1",
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// The connection is listed before it runs any command.
+	pl := rpcVTGate.executor.ProcessList()
+	var ids []uint64
+	for _, p := range pl.List("userData1") {
+		ids = append(ids, p.ID)
+	}
+	assert.Contains(t, ids, pl.processID(uint64(client.ConnectionID)))
+}
+
 func TestConnectionUnixSocket(t *testing.T) {
 	th := &testHandler{}
 
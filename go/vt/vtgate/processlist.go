@@ -0,0 +1,274 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var (
+	processlistAdminUsers  = flag.String("processlist_admin_users", "", "comma separated list of users that can see and kill the connections of all users with SHOW PROCESSLIST and KILL, '%' means all users. Other users only see and kill their own connections. Only the connections of the local vtgate are listed and can be killed.")
+	processlistIDNamespace = flag.Uint("processlist_id_namespace", 0, "namespace of the connection ids listed by SHOW PROCESSLIST and killed with KILL, between 1 and 65535. Give every vtgate of the cluster a different namespace, so that their connection ids don't collide. If 0, a random namespace is picked at startup.")
+)
+
+// maxProcesslistIDNamespace is the largest namespace of the connection ids.
+const maxProcesslistIDNamespace = 1<<16 - 1
+
+const (
+	processSleep   = "Sleep"
+	processRunning = "executing"
+)
+
+// process is a client connection of the vtgate.
+type process struct {
+	id uint64
+	// user is the name the client authenticated with.
+	user string
+	// owner is the immediate caller of the connection. Only the owner
+	// and the admin users can see and kill the connection.
+	owner     string
+	host      string
+	db        string
+	command   string
+	start     time.Time
+	info      string
+	cancel    context.CancelFunc
+	closeConn func()
+	// cursors cancel the statements of the open cursors of the
+	// connection, which keep running between commands.
+	cursors    map[int]context.CancelFunc
+	nextCursor int
+}
+
+// ProcessList tracks the client connections of the vtgate for
+// SHOW PROCESSLIST and KILL. SHOW PROCESSLIST only lists the connections
+// of the vtgate it runs on, and KILL only finds them: a client must
+// connect to the same vtgate as the connection it kills, e.g. through a
+// load balancer address that resolves to a single vtgate.
+// The ids of the connections are namespaced by vtgate: the namespace is
+// in the upper 32 bits and the MySQL connection id in the lower ones, so
+// that an id listed by another vtgate is never mistaken for a local one.
+type ProcessList struct {
+	mu         sync.Mutex
+	processes  map[uint64]*process
+	adminUsers map[string]bool
+	allAdmins  bool
+	namespace  uint64
+	now        func() time.Time
+}
+
+// NewProcessList creates a ProcessList whose admin users and id namespace
+// are taken from the -processlist_admin_users and -processlist_id_namespace
+// flags.
+func NewProcessList() *ProcessList {
+	namespace := uint64(*processlistIDNamespace)
+	if namespace == 0 || namespace > maxProcesslistIDNamespace {
+		if namespace != 0 {
+			log.Warningf("-processlist_id_namespace %d is larger than %d, picking a random namespace", namespace, maxProcesslistIDNamespace)
+		}
+		namespace = uint64(rand.New(rand.NewSource(time.Now().UnixNano())).Intn(maxProcesslistIDNamespace)) + 1
+	}
+	pl := &ProcessList{
+		processes:  make(map[uint64]*process),
+		adminUsers: make(map[string]bool),
+		namespace:  namespace,
+		now:        time.Now,
+	}
+	for _, user := range strings.Split(*processlistAdminUsers, ",") {
+		user = strings.TrimSpace(user)
+		switch user {
+		case "":
+		case "%":
+			pl.allAdmins = true
+		default:
+			pl.adminUsers[user] = true
+		}
+	}
+	return pl
+}
+
+// processID returns the id of the connection in the process list.
+func (pl *ProcessList) processID(connID uint64) uint64 {
+	return pl.namespace<<32 | connID
+}
+
+// Add registers a connection, unless it is already registered.
+// closeConn is called when the connection is killed.
+func (pl *ProcessList) Add(id uint64, user, owner, host string, closeConn func()) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	if _, ok := pl.processes[id]; ok {
+		return
+	}
+	pl.processes[id] = &process{
+		id:        id,
+		user:      user,
+		owner:     owner,
+		host:      host,
+		command:   processSleep,
+		start:     pl.now(),
+		closeConn: closeConn,
+	}
+}
+
+// Remove unregisters a connection.
+func (pl *ProcessList) Remove(id uint64) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	delete(pl.processes, id)
+}
+
+// BeginCommand marks the connection as running the query and returns
+// a context that is cancelled if the query is killed. The returned
+// function must be called once the command is done.
+func (pl *ProcessList) BeginCommand(ctx context.Context, id uint64, command, db, query string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	pl.mu.Lock()
+	p, ok := pl.processes[id]
+	if ok {
+		p.command = command
+		p.db = db
+		p.info = query
+		p.start = pl.now()
+		p.cancel = cancel
+	}
+	pl.mu.Unlock()
+	if !ok {
+		return ctx, cancel
+	}
+	return ctx, func() {
+		cancel()
+		pl.mu.Lock()
+		defer pl.mu.Unlock()
+		p.command = processSleep
+		p.info = ""
+		p.start = pl.now()
+		p.cancel = nil
+	}
+}
+
+// BeginCursor returns a context for the statement of a cursor opened on
+// the connection, which is cancelled if the queries of the connection are
+// killed. The statement keeps running while the connection handles other
+// commands, so it doesn't change the command of the connection. The
+// returned function must be called once the statement is done.
+func (pl *ProcessList) BeginCursor(ctx context.Context, id uint64) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	pl.mu.Lock()
+	p, ok := pl.processes[id]
+	var cursorID int
+	if ok {
+		if p.cursors == nil {
+			p.cursors = make(map[int]context.CancelFunc)
+		}
+		p.nextCursor++
+		cursorID = p.nextCursor
+		p.cursors[cursorID] = cancel
+	}
+	pl.mu.Unlock()
+	if !ok {
+		return ctx, cancel
+	}
+	return ctx, func() {
+		cancel()
+		pl.mu.Lock()
+		defer pl.mu.Unlock()
+		delete(p.cursors, cursorID)
+	}
+}
+
+// List returns the connections visible to the given user.
+func (pl *ProcessList) List(user string) []*engine.ProcessInfo {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	admin := pl.isAdmin(user)
+	now := pl.now()
+	var list []*engine.ProcessInfo
+	for _, p := range pl.processes {
+		if !admin && p.owner != user {
+			continue
+		}
+		info := &engine.ProcessInfo{
+			ID:      pl.processID(p.id),
+			User:    p.user,
+			Host:    p.host,
+			DB:      p.db,
+			Command: p.command,
+			Time:    int64(now.Sub(p.start) / time.Second),
+			Info:    p.info,
+		}
+		if p.command != processSleep {
+			info.State = processRunning
+		}
+		list = append(list, info)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
+
+// Kill cancels the query and the cursors running on the connection and,
+// unless queryOnly is set, closes the connection. id is the namespaced
+// id listed by SHOW PROCESSLIST.
+func (pl *ProcessList) Kill(user string, id uint64, queryOnly bool) error {
+	if namespace := id >> 32; namespace != pl.namespace {
+		return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.NoSuchThread, "Unknown thread id: %d (the connection is not on this vtgate, whose connection ids are in namespace %d, but on the vtgate of namespace %d)", id, pl.namespace, namespace)
+	}
+	pl.mu.Lock()
+	p, ok := pl.processes[id&(1<<32-1)]
+	if !ok {
+		pl.mu.Unlock()
+		return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.NoSuchThread, "Unknown thread id: %d (KILL only applies to the connections of this vtgate)", id)
+	}
+	if !pl.isAdmin(user) && p.owner != user {
+		pl.mu.Unlock()
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.KillDeniedError, "You are not owner of thread %d", id)
+	}
+	cancel, closeConn := p.cancel, p.closeConn
+	cursors := make([]context.CancelFunc, 0, len(p.cursors))
+	for _, cancelCursor := range p.cursors {
+		cursors = append(cursors, cancelCursor)
+	}
+	pl.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	for _, cancelCursor := range cursors {
+		cancelCursor()
+	}
+	if !queryOnly && closeConn != nil {
+		closeConn()
+	}
+	return nil
+}
+
+func (pl *ProcessList) isAdmin(user string) bool {
+	return pl.allAdmins || pl.adminUsers[user]
+}
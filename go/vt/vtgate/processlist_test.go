@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+func TestProcessList(t *testing.T) {
+	defer func(saved string) { *processlistAdminUsers = saved }(*processlistAdminUsers)
+	*processlistAdminUsers = "admin"
+
+	now := time.Unix(1000, 0)
+	pl := NewProcessList()
+	// Without a namespace, the listed ids are the connection ids.
+	pl.namespace = 0
+	pl.now = func() time.Time { return now }
+
+	closed := map[uint64]bool{}
+	pl.Add(1, "alice", "alice", "127.0.0.1:5000", func() { closed[1] = true })
+	pl.Add(2, "bob", "bob", "127.0.0.1:5001", func() { closed[2] = true })
+	// Adding a registered connection again is a no-op.
+	pl.Add(1, "other", "other", "", nil)
+
+	ctx, done := pl.BeginCommand(context.Background(), 2, "Query", "ks@replica", "select 1 from dual")
+	now = now.Add(3 * time.Second)
+
+	assert.Equal(t, []*engine.ProcessInfo{{
+		ID:      1,
+		User:    "alice",
+		Host:    "127.0.0.1:5000",
+		Command: "Sleep",
+		Time:    3,
+	}, {
+		ID:      2,
+		User:    "bob",
+		Host:    "127.0.0.1:5001",
+		DB:      "ks@replica",
+		Command: "Query",
+		Time:    3,
+		State:   "executing",
+		Info:    "select 1 from dual",
+	}}, pl.List("admin"))
+
+	// Other users only see their own connections.
+	list := pl.List("alice")
+	require.Len(t, list, 1)
+	assert.EqualValues(t, 1, list[0].ID)
+
+	err := pl.Kill("alice", 2, true)
+	assert.EqualError(t, err, "You are not owner of thread 2")
+	err = pl.Kill("alice", 3, true)
+	assert.EqualError(t, err, "Unknown thread id: 3 (KILL only applies to the connections of this vtgate)")
+
+	require.NoError(t, pl.Kill("bob", 2, true))
+	assert.Error(t, ctx.Err())
+	assert.False(t, closed[2])
+
+	done()
+	list = pl.List("bob")
+	require.Len(t, list, 1)
+	assert.Equal(t, "Sleep", list[0].Command)
+	assert.Empty(t, list[0].Info)
+
+	require.NoError(t, pl.Kill("admin", 1, false))
+	assert.True(t, closed[1])
+
+	pl.Remove(1)
+	assert.Len(t, pl.List("admin"), 1)
+}
+
+func TestProcessListCursor(t *testing.T) {
+	pl := NewProcessList()
+	// Without a namespace, the listed ids are the connection ids.
+	pl.namespace = 0
+	pl.Add(1, "alice", "alice", "127.0.0.1:5000", nil)
+
+	cursorCtx, cursorDone := pl.BeginCursor(context.Background(), 1)
+	ctx, done := pl.BeginCommand(context.Background(), 1, "Query", "", "select 1 from dual")
+
+	// The end of the cursor doesn't change the command of the connection.
+	cursorDone()
+	assert.Error(t, cursorCtx.Err())
+	list := pl.List("alice")
+	require.Len(t, list, 1)
+	assert.Equal(t, "Query", list[0].Command)
+	done()
+
+	// Killing the queries of the connection cancels its cursors.
+	cursorCtx, cursorDone = pl.BeginCursor(context.Background(), 1)
+	defer cursorDone()
+	ctx, done = pl.BeginCommand(context.Background(), 1, "Query", "", "select 1 from dual")
+	defer done()
+	require.NoError(t, pl.Kill("alice", 1, true))
+	assert.Error(t, ctx.Err())
+	assert.Error(t, cursorCtx.Err())
+}
+
+func TestProcessListNamespace(t *testing.T) {
+	defer func(saved uint) { *processlistIDNamespace = saved }(*processlistIDNamespace)
+	*processlistIDNamespace = 7
+
+	pl := NewProcessList()
+	pl.Add(1, "alice", "alice", "127.0.0.1:5000", nil)
+	ctx, done := pl.BeginCommand(context.Background(), 1, "Query", "", "select 1 from dual")
+	defer done()
+
+	list := pl.List("alice")
+	require.Len(t, list, 1)
+	assert.Equal(t, uint64(7<<32|1), list[0].ID)
+
+	// The same connection id on another vtgate is not killed here.
+	err := pl.Kill("alice", 3<<32|1, true)
+	assert.EqualError(t, err, "Unknown thread id: 12884901889 (the connection is not on this vtgate, whose connection ids are in namespace 7, but on the vtgate of namespace 3)")
+	assert.NoError(t, ctx.Err())
+	require.NoError(t, pl.Kill("alice", 7<<32|1, true))
+	assert.Error(t, ctx.Err())
+
+	// Without a namespace, a random one is picked.
+	*processlistIDNamespace = 0
+	pl = NewProcessList()
+	assert.True(t, pl.namespace >= 1 && pl.namespace <= maxProcesslistIDNamespace, pl.namespace)
+}
@@ -0,0 +1,470 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	queryQuotaConfigFile     = flag.String("query_quota_config", "", "JSON file with the query quotas by MySQL user and by workload (effective caller id principal). Disabled if empty.")
+	queryQuotaReloadInterval = flag.Duration("query_quota_config_reload_interval", 0, "Interval to reload the -query_quota_config file, which is also reloaded on SIGHUP.")
+	queryQuotaTopoPath       = flag.String("query_quota_topo_path", "", "Path of the query quotas config in the global topo, watched for changes. Disabled if empty.")
+	queryQuotaDryRun         = flag.Bool("query_quota_dry_run", false, "Only count the queries exceeding their quota in the stats, do not reject them.")
+
+	queryQuotaRejections = stats.NewCountersWithMultiLabels(
+		"QueryQuotaRejections",
+		"Queries rejected because they exceeded a quota",
+		[]string{"User", "Quota"})
+	queryQuotaRejectionsDryRun = stats.NewCountersWithMultiLabels(
+		"QueryQuotaRejectionsDryRun",
+		"Queries which exceeded a quota in dry-run mode",
+		[]string{"User", "Quota"})
+	queryQuotaConcurrentQueries = stats.NewGaugesWithSingleLabel(
+		"QueryQuotaConcurrentQueries",
+		"Concurrent queries of the users with a quota",
+		"User")
+	queryQuotaRowsReturned = stats.NewCountersWithSingleLabel(
+		"QueryQuotaRowsReturned",
+		"Rows returned to the users with a quota",
+		"User")
+)
+
+// sleepDuringQueryQuotaTopoFailure is how long to sleep before watching
+// the quotas config in the topo again after an error.
+const sleepDuringQueryQuotaTopoFailure = 30 * time.Second
+
+// quotaUsageSweepInterval is how often the usages of the idle users and
+// workloads are dropped.
+const quotaUsageSweepInterval = time.Minute
+
+// Names of the quotas, as reported in the errors and the stats.
+const (
+	quotaQPS               = "max_qps"
+	quotaConcurrentQueries = "max_concurrent_queries"
+	quotaScatterQPS        = "max_scatter_qps"
+	quotaRowsPerSecond     = "max_rows_per_second"
+)
+
+// QueryQuota are the limits of a user or a workload.
+// A limit of 0 means unlimited.
+type QueryQuota struct {
+	MaxQPS               int `json:"max_qps,omitempty"`
+	MaxConcurrentQueries int `json:"max_concurrent_queries,omitempty"`
+	MaxScatterQPS        int `json:"max_scatter_qps,omitempty"`
+	MaxRowsPerSecond     int `json:"max_rows_per_second,omitempty"`
+}
+
+// QueryQuotaConfig is the configuration of the query quotas.
+type QueryQuotaConfig struct {
+	// Default is the quota of each user without a quota of its own.
+	Default *QueryQuota `json:"default,omitempty"`
+	// Users are the quotas by MySQL user i.e. immediate caller id.
+	Users map[string]*QueryQuota `json:"users,omitempty"`
+	// Workloads are the quotas by effective caller id principal. They
+	// take precedence over the quotas of the users.
+	Workloads map[string]*QueryQuota `json:"workloads,omitempty"`
+}
+
+// ParseQueryQuotaConfig parses a JSON query quotas configuration.
+func ParseQueryQuotaConfig(data []byte) (*QueryQuotaConfig, error) {
+	config := &QueryQuotaConfig{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(config); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot parse query quotas config")
+	}
+	for name, quota := range config.Users {
+		if err := quota.validate(); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid quota of user %v", name)
+		}
+	}
+	for name, quota := range config.Workloads {
+		if err := quota.validate(); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid quota of workload %v", name)
+		}
+	}
+	if err := config.Default.validate(); err != nil {
+		return nil, vterrors.Wrapf(err, "invalid default quota")
+	}
+	return config, nil
+}
+
+func (q *QueryQuota) validate() error {
+	if q == nil {
+		return nil
+	}
+	if q.MaxQPS < 0 || q.MaxConcurrentQueries < 0 || q.MaxScatterQPS < 0 || q.MaxRowsPerSecond < 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "limits must not be negative: %+v", *q)
+	}
+	return nil
+}
+
+// quotaWindow counts the events of a one second window.
+type quotaWindow struct {
+	start time.Time
+	count int
+}
+
+// get returns the count of the current window, starting a new window
+// if the previous one is over.
+func (w *quotaWindow) get(now time.Time) int {
+	if now.Sub(w.start) >= time.Second {
+		w.start = now
+		w.count = 0
+	}
+	return w.count
+}
+
+// quotaUsage is the usage of a quota by a user or a workload.
+type quotaUsage struct {
+	concurrent     int
+	queries        quotaWindow
+	scatterQueries quotaWindow
+	rows           quotaWindow
+}
+
+// idle returns true if no query of the usage is running and all its
+// windows are over, in which case it's the same as a new usage.
+func (u *quotaUsage) idle(now time.Time) bool {
+	return u.concurrent == 0 &&
+		now.Sub(u.queries.start) >= time.Second &&
+		now.Sub(u.scatterQueries.start) >= time.Second &&
+		now.Sub(u.rows.start) >= time.Second
+}
+
+// QueryQuotas enforces the query quotas of the users and workloads
+// (see QueryQuotaConfig).
+//
+// The queries per second, the scatter queries per second and the rows
+// returned per second are counted over one second windows. The rows
+// are only known once a query is done, so once the rows of a window
+// exceed the quota, the following queries are rejected until the
+// window is over.
+type QueryQuotas struct {
+	dryRun bool
+	now    func() time.Time
+
+	mu     sync.Mutex
+	config *QueryQuotaConfig
+	// usage is the usage of the quotas, by user or workload. The idle
+	// usages are dropped every quotaUsageSweepInterval.
+	usage     map[string]*quotaUsage
+	lastSweep time.Time
+}
+
+// NewQueryQuotas creates a QueryQuotas without any quota.
+func NewQueryQuotas(dryRun bool) *QueryQuotas {
+	return &QueryQuotas{
+		dryRun: dryRun,
+		now:    time.Now,
+		usage:  make(map[string]*quotaUsage),
+	}
+}
+
+// newQueryQuotasFromFlags creates the QueryQuotas of the executor and
+// starts loading their configuration, from the -query_quota_config file
+// or the -query_quota_topo_path topo file.
+func newQueryQuotasFromFlags(ctx context.Context, serv srvtopo.Server) *QueryQuotas {
+	q := NewQueryQuotas(*queryQuotaDryRun)
+	switch {
+	case *queryQuotaConfigFile != "" && *queryQuotaTopoPath != "":
+		log.Exitf("only one of -query_quota_config and -query_quota_topo_path can be specified")
+	case *queryQuotaConfigFile != "":
+		q.loadFile(*queryQuotaConfigFile)
+		q.reloadFile(ctx, *queryQuotaConfigFile, *queryQuotaReloadInterval)
+	case *queryQuotaTopoPath != "":
+		ts, err := serv.GetTopoServer()
+		if err != nil {
+			log.Exitf("cannot watch the query quotas config: %v", err)
+		}
+		go q.watchTopo(ctx, ts, *queryQuotaTopoPath)
+	}
+	return q
+}
+
+// SetConfig replaces the configuration of the quotas.
+func (q *QueryQuotas) SetConfig(config *QueryQuotaConfig) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.config = config
+}
+
+func (q *QueryQuotas) loadFile(file string) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		log.Errorf("Failed to read -query_quota_config file: %v", err)
+		return
+	}
+	config, err := ParseQueryQuotaConfig(data)
+	if err != nil {
+		log.Errorf("Failed to load -query_quota_config file: %v", err)
+		return
+	}
+	q.SetConfig(config)
+	log.Infof("Loaded query quotas from %v", file)
+}
+
+// reloadFile reloads the config file on SIGHUP and every interval,
+// if not 0, until ctx is done.
+func (q *QueryQuotas) reloadFile(ctx context.Context, file string, interval time.Duration) {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGHUP)
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		tick = ticker.C
+		go func() {
+			<-ctx.Done()
+			ticker.Stop()
+		}()
+	}
+	go func() {
+		defer signal.Stop(sigChan)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sigChan:
+			case <-tick:
+			}
+			q.loadFile(file)
+		}
+	}()
+}
+
+// watchTopo watches the config file in the global topo until ctx is done.
+func (q *QueryQuotas) watchTopo(ctx context.Context, ts *topo.Server, filePath string) {
+	for {
+		if err := q.oneTopoWatch(ctx, ts, filePath); err != nil {
+			log.Warningf("Watch of the query quotas config in the topo failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(sleepDuringQueryQuotaTopoFailure):
+		}
+	}
+}
+
+func (q *QueryQuotas) oneTopoWatch(ctx context.Context, ts *topo.Server, filePath string) error {
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return err
+	}
+	current, wdChannel, cancel := conn.Watch(ctx, filePath)
+	if current.Err != nil {
+		return current.Err
+	}
+	defer func() {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+	}()
+
+	wd := current
+	for {
+		config, err := ParseQueryQuotaConfig(wd.Contents)
+		if err != nil {
+			log.Errorf("Failed to load the query quotas config version %v from the topo: %v", wd.Version, err)
+		} else {
+			q.SetConfig(config)
+			log.Infof("Loaded query quotas config version %v from the topo", wd.Version)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case wd = <-wdChannel:
+			if wd == nil {
+				return fmt.Errorf("watch terminated with no error")
+			}
+			if wd.Err != nil {
+				return wd.Err
+			}
+		}
+	}
+}
+
+// Admit checks the quotas of the caller of ctx before a query is
+// planned. If the query is admitted, the returned function must be
+// called with the number of rows returned once the query is done.
+func (q *QueryQuotas) Admit(ctx context.Context) (func(rowsReturned uint64), error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	key, quota := q.quotaLocked(ctx)
+	if quota == nil {
+		return func(uint64) {}, nil
+	}
+	now := q.now()
+	q.sweepLocked(now)
+	usage := q.usageLocked(key)
+
+	if quota.MaxConcurrentQueries > 0 && usage.concurrent >= quota.MaxConcurrentQueries {
+		if err := q.rejectLocked(key, quotaConcurrentQueries, usage.concurrent); err != nil {
+			return nil, err
+		}
+	}
+	if quota.MaxQPS > 0 && usage.queries.get(now) >= quota.MaxQPS {
+		if err := q.rejectLocked(key, quotaQPS, usage.queries.count); err != nil {
+			return nil, err
+		}
+	}
+	if quota.MaxRowsPerSecond > 0 && usage.rows.get(now) >= quota.MaxRowsPerSecond {
+		if err := q.rejectLocked(key, quotaRowsPerSecond, usage.rows.count); err != nil {
+			return nil, err
+		}
+	}
+
+	usage.concurrent++
+	usage.queries.get(now)
+	usage.queries.count++
+	queryQuotaConcurrentQueries.Set(key, int64(usage.concurrent))
+
+	return func(rowsReturned uint64) {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		usage.concurrent--
+		usage.rows.get(q.now())
+		usage.rows.count += int(rowsReturned)
+		queryQuotaConcurrentQueries.Set(key, int64(usage.concurrent))
+		queryQuotaRowsReturned.Add(key, int64(rowsReturned))
+	}, nil
+}
+
+// AdmitScatter checks the scatter queries quota of the caller of ctx,
+// once a query was planned as a scatter query.
+func (q *QueryQuotas) AdmitScatter(ctx context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	key, quota := q.quotaLocked(ctx)
+	if quota == nil || quota.MaxScatterQPS == 0 {
+		return nil
+	}
+	usage := q.usageLocked(key)
+	if usage.scatterQueries.get(q.now()) >= quota.MaxScatterQPS {
+		if err := q.rejectLocked(key, quotaScatterQPS, usage.scatterQueries.count); err != nil {
+			return err
+		}
+	}
+	usage.scatterQueries.count++
+	return nil
+}
+
+// quotaLocked returns the quota of the caller of ctx and the key its
+// usage is tracked by: the workload if it has a quota, the user
+// otherwise. It returns a nil quota if the caller is not limited.
+func (q *QueryQuotas) quotaLocked(ctx context.Context) (string, *QueryQuota) {
+	if q.config == nil {
+		return "", nil
+	}
+	if principal := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)); principal != "" {
+		if quota, ok := q.config.Workloads[principal]; ok {
+			return principal, quota
+		}
+	}
+	user := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+	if quota, ok := q.config.Users[user]; ok {
+		return user, quota
+	}
+	return user, q.config.Default
+}
+
+func (q *QueryQuotas) usageLocked(key string) *quotaUsage {
+	usage, ok := q.usage[key]
+	if !ok {
+		usage = &quotaUsage{}
+		q.usage[key] = usage
+	}
+	return usage
+}
+
+// sweepLocked drops the usages of the idle users and workloads, so that
+// the usages don't grow with every user ever seen.
+func (q *QueryQuotas) sweepLocked(now time.Time) {
+	if now.Sub(q.lastSweep) < quotaUsageSweepInterval {
+		return
+	}
+	q.lastSweep = now
+	for key, usage := range q.usage {
+		if usage.idle(now) {
+			delete(q.usage, key)
+		}
+	}
+}
+
+// rejectLocked records that the query exceeded the quota. It returns
+// the error to reject the query with, or nil in dry-run mode.
+func (q *QueryQuotas) rejectLocked(key, quota string, current int) error {
+	if q.dryRun {
+		queryQuotaRejectionsDryRun.Add([]string{key, quota}, 1)
+		return nil
+	}
+	queryQuotaRejections.Add([]string{key, quota}, 1)
+	return vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.UserLimitReached, "User '%s' has exceeded the '%s' resource (current value: %d)", key, quota, current)
+}
+
+// isScatterPlan returns true if the plan sends a query to all the
+// shards of a keyspace.
+func isScatterPlan(primitive engine.Primitive) bool {
+	if primitive == nil {
+		return false
+	}
+	switch p := primitive.(type) {
+	case *engine.Route:
+		if p.Opcode == engine.SelectScatter {
+			return true
+		}
+	case *engine.Update:
+		if p.Opcode == engine.Scatter {
+			return true
+		}
+	case *engine.Delete:
+		if p.Opcode == engine.Scatter {
+			return true
+		}
+	}
+	for _, input := range primitive.Inputs() {
+		if isScatterPlan(input) {
+			return true
+		}
+	}
+	return false
+}
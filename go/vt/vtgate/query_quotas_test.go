@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestParseQueryQuotaConfig(t *testing.T) {
+	config, err := ParseQueryQuotaConfig([]byte(`{
+		"default": {"max_qps": 100},
+		"users": {"app": {"max_concurrent_queries": 5, "max_rows_per_second": 1000}},
+		"workloads": {"batch": {"max_scatter_qps": 1}}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, &QueryQuotaConfig{
+		Default:   &QueryQuota{MaxQPS: 100},
+		Users:     map[string]*QueryQuota{"app": {MaxConcurrentQueries: 5, MaxRowsPerSecond: 1000}},
+		Workloads: map[string]*QueryQuota{"batch": {MaxScatterQPS: 1}},
+	}, config)
+
+	_, err = ParseQueryQuotaConfig([]byte(`{"users": {"app": {"max_qbs": 5}}}`))
+	assert.Contains(t, err.Error(), `unknown field "max_qbs"`)
+
+	_, err = ParseQueryQuotaConfig([]byte(`{"workloads": {"batch": {"max_qps": -1}}}`))
+	assert.Contains(t, err.Error(), "invalid quota of workload batch")
+}
+
+func TestQueryQuotas(t *testing.T) {
+	now := time.Unix(1000, 0)
+	q := NewQueryQuotas(false /* dryRun */)
+	q.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	appCtx := callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("app"))
+	batchCtx := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("batch", "", ""), callerid.NewImmediateCallerID("app"))
+
+	// Without configuration, nothing is limited.
+	done, err := q.Admit(appCtx)
+	require.NoError(t, err)
+	done(1000)
+
+	q.SetConfig(&QueryQuotaConfig{
+		Users:     map[string]*QueryQuota{"app": {MaxQPS: 3, MaxConcurrentQueries: 2, MaxRowsPerSecond: 100}},
+		Workloads: map[string]*QueryQuota{"batch": {MaxScatterQPS: 1}},
+	})
+
+	// Concurrent queries.
+	done1, err := q.Admit(appCtx)
+	require.NoError(t, err)
+	done2, err := q.Admit(appCtx)
+	require.NoError(t, err)
+	_, err = q.Admit(appCtx)
+	assert.EqualError(t, err, "User 'app' has exceeded the 'max_concurrent_queries' resource (current value: 2)")
+	sqlErr := mysql.NewSQLErrorFromError(err).(*mysql.SQLError)
+	assert.Equal(t, mysql.ERUserLimitReached, sqlErr.Number())
+	assert.Equal(t, mysql.SSClientError, sqlErr.SQLState())
+	assert.EqualValues(t, 2, queryQuotaConcurrentQueries.Counts()["app"])
+	done1(10)
+	done2(10)
+
+	// Queries per second.
+	done3, err := q.Admit(appCtx)
+	require.NoError(t, err)
+	done3(10)
+	_, err = q.Admit(appCtx)
+	assert.EqualError(t, err, "User 'app' has exceeded the 'max_qps' resource (current value: 3)")
+
+	// Rows per second, in the next window.
+	now = now.Add(time.Second)
+	done4, err := q.Admit(appCtx)
+	require.NoError(t, err)
+	done4(100)
+	_, err = q.Admit(appCtx)
+	assert.EqualError(t, err, "User 'app' has exceeded the 'max_rows_per_second' resource (current value: 100)")
+
+	// The workload quota takes precedence over the user quota.
+	done5, err := q.Admit(batchCtx)
+	require.NoError(t, err)
+	require.NoError(t, q.AdmitScatter(batchCtx))
+	assert.EqualError(t, q.AdmitScatter(batchCtx), "User 'batch' has exceeded the 'max_scatter_qps' resource (current value: 1)")
+	done5(0)
+	now = now.Add(time.Second)
+	require.NoError(t, q.AdmitScatter(batchCtx))
+
+	// Other users are not limited without a default quota.
+	otherCtx := callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("other"))
+	for i := 0; i < 10; i++ {
+		done, err := q.Admit(otherCtx)
+		require.NoError(t, err)
+		done(1000)
+	}
+	// The default quota applies to each user separately.
+	q.SetConfig(&QueryQuotaConfig{Default: &QueryQuota{MaxConcurrentQueries: 1}})
+	done6, err := q.Admit(otherCtx)
+	require.NoError(t, err)
+	_, err = q.Admit(appCtx)
+	require.NoError(t, err)
+	_, err = q.Admit(otherCtx)
+	assert.EqualError(t, err, "User 'other' has exceeded the 'max_concurrent_queries' resource (current value: 1)")
+	done6(0)
+
+	assert.EqualValues(t, 1, queryQuotaRejections.Counts()["app.max_concurrent_queries"])
+	assert.EqualValues(t, 1, queryQuotaRejections.Counts()["batch.max_scatter_qps"])
+}
+
+func TestQueryQuotasDryRun(t *testing.T) {
+	q := NewQueryQuotas(true /* dryRun */)
+	q.SetConfig(&QueryQuotaConfig{Users: map[string]*QueryQuota{"dryrun": {MaxConcurrentQueries: 1}}})
+
+	ctx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("dryrun"))
+	for i := 0; i < 3; i++ {
+		_, err := q.Admit(ctx)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 2, queryQuotaRejectionsDryRun.Counts()["dryrun.max_concurrent_queries"])
+	assert.Zero(t, queryQuotaRejections.Counts()["dryrun.max_concurrent_queries"])
+}
+
+func TestQueryQuotasTopo(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := memorytopo.NewServer("cell1")
+	conn, err := ts.ConnForCell(ctx, "global")
+	require.NoError(t, err)
+	_, err = conn.Create(ctx, "query_quotas", []byte(`{"default": {"max_qps": 1}}`))
+	require.NoError(t, err)
+
+	q := NewQueryQuotas(false /* dryRun */)
+	go q.watchTopo(ctx, ts, "query_quotas")
+	waitForQuotaConfig := func(want *QueryQuotaConfig) {
+		t.Helper()
+		for start := time.Now(); time.Since(start) < 10*time.Second; time.Sleep(time.Millisecond) {
+			q.mu.Lock()
+			config := q.config
+			q.mu.Unlock()
+			if assert.ObjectsAreEqual(want, config) {
+				return
+			}
+		}
+		t.Fatalf("query quotas config was not loaded: %+v", want)
+	}
+	waitForQuotaConfig(&QueryQuotaConfig{Default: &QueryQuota{MaxQPS: 1}})
+
+	_, err = conn.Update(ctx, "query_quotas", []byte(`{"default": {"max_qps": 2}}`), nil)
+	require.NoError(t, err)
+	waitForQuotaConfig(&QueryQuotaConfig{Default: &QueryQuota{MaxQPS: 2}})
+}
+
+func TestIsScatterPlan(t *testing.T) {
+	scatter := &engine.Route{Opcode: engine.SelectScatter}
+	unsharded := &engine.Route{Opcode: engine.SelectUnsharded}
+	assert.True(t, isScatterPlan(scatter))
+	assert.False(t, isScatterPlan(unsharded))
+	assert.True(t, isScatterPlan(&engine.Join{Left: unsharded, Right: scatter}))
+	assert.True(t, isScatterPlan(&engine.Delete{DML: engine.DML{Opcode: engine.Scatter}}))
+	assert.False(t, isScatterPlan(&engine.Update{DML: engine.DML{Opcode: engine.Equal}}))
+}
+
+func TestExecutorQueryQuotas(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	executor.quotas.SetConfig(&QueryQuotaConfig{Default: &QueryQuota{MaxScatterQPS: 1}})
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	// Queries routed to a single shard are not scatter queries.
+	for i := 0; i < 2; i++ {
+		_, err := executor.Execute(ctx, "TestExecute", session, "select id from user where id = 1", nil)
+		require.NoError(t, err)
+	}
+	_, err := executor.Execute(ctx, "TestExecute", session, "select id from user", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user", nil)
+	assert.EqualError(t, err, "User '' has exceeded the 'max_scatter_qps' resource (current value: 1)")
+}
+
+func TestQueryQuotasDropIdleUsages(t *testing.T) {
+	now := time.Unix(1000, 0)
+	q := NewQueryQuotas(false /* dryRun */)
+	q.now = func() time.Time { return now }
+	q.SetConfig(&QueryQuotaConfig{Default: &QueryQuota{MaxConcurrentQueries: 1}})
+
+	ctx := context.Background()
+	running, err := q.Admit(callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("running")))
+	require.NoError(t, err)
+	done, err := q.Admit(callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("idle")))
+	require.NoError(t, err)
+	done(0)
+	assert.Len(t, q.usage, 2)
+
+	// The usages are kept until the next sweep, and then only the ones of
+	// the users with a running query.
+	now = now.Add(quotaUsageSweepInterval / 2)
+	_, err = q.Admit(callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("running")))
+	require.Error(t, err)
+	assert.Len(t, q.usage, 2)
+	now = now.Add(quotaUsageSweepInterval)
+	_, err = q.Admit(callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("running")))
+	require.Error(t, err)
+	assert.Len(t, q.usage, 1)
+	assert.Contains(t, q.usage, "running")
+	running(0)
+}
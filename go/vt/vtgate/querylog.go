@@ -60,5 +60,5 @@ func initQueryLogger(vtg *VTGate) error {
 		}
 	}
 
-	return nil
+	return QueryLogger.LogToSinks(streamlog.GetFormatter(QueryLogger))
 }
@@ -0,0 +1,548 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"container/list"
+	"context"
+	"flag"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var (
+	enableResultCache    = flag.Bool("enable_result_cache", false, "Cache the results of the read-only queries on the tables with result_cache set in the VSchema, or with the RESULT_CACHE query comment directive. The cached results are invalidated by the changes of the tables, streamed from the master tablets, so only the results of the queries sent to the master tablets are cached.")
+	resultCacheTTL       = flag.Duration("result_cache_ttl", 10*time.Second, "Maximum time a result is served from the result cache.")
+	resultCacheMemory    = flag.Int64("result_cache_memory", 64*1024*1024, "Maximum memory used by the results in the result cache, in bytes.")
+	resultCacheMaxRows   = flag.Int("result_cache_max_rows", 10000, "Results with more rows than this are not cached.")
+	resultCacheStatsOnce sync.Once
+
+	resultCacheHits          = stats.NewCounter("ResultCacheHits", "Queries served from the result cache")
+	resultCacheMisses        = stats.NewCounter("ResultCacheMisses", "Cacheable queries not found in the result cache")
+	resultCacheInvalidations = stats.NewCountersWithSingleLabel(
+		"ResultCacheInvalidations",
+		"Invalidations of the cached results by table, because of a change streamed from the table",
+		"Table")
+)
+
+// resultCacheStreamRetryDelay is how long we wait before streaming the
+// changes of a keyspace again after the stream failed.
+const resultCacheStreamRetryDelay = 5 * time.Second
+
+// nonDeterministicFunctions are the functions whose result changes between
+// two executions of a query. The queries calling them are not cached.
+var nonDeterministicFunctions = map[string]bool{
+	"now":               true,
+	"sysdate":           true,
+	"current_timestamp": true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"curdate":           true,
+	"current_date":      true,
+	"curtime":           true,
+	"current_time":      true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"unix_timestamp":    true,
+	"rand":              true,
+	"uuid":              true,
+	"uuid_short":        true,
+	"connection_id":     true,
+	"user":              true,
+	"current_user":      true,
+	"session_user":      true,
+	"system_user":       true,
+	"sleep":             true,
+	"get_lock":          true,
+	"release_lock":      true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+}
+
+// vstreamer streams the changes of keyspaces, it is implemented by
+// vstreamManager.
+type vstreamer interface {
+	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error
+}
+
+// ResultCache caches the results of read-only queries for up to a TTL.
+// The results of a table are invalidated when a change of the table is
+// streamed from its keyspace, and they are only cached while the changes
+// of the table are streamed.
+// The least recently used results are evicted when the cache uses more than
+// its memory limit.
+type ResultCache struct {
+	// Immutable fields set at construction.
+	ctx     context.Context
+	vs      vstreamer
+	ttl     time.Duration
+	memory  int64
+	maxRows int
+	now     func() time.Time
+
+	// mu guards the fields below.
+	mu sync.Mutex
+	// results are the cached results by key. The list is in LRU order, it
+	// holds the *cachedResult values.
+	results map[string]*list.Element
+	lru     *list.List
+	size    int64
+	// tables are the keys of the cached results of each qualified table.
+	tables map[string]map[string]bool
+	// generations are incremented each time the results of a qualified
+	// table are invalidated. A result is only cached if the generations of
+	// its tables did not change while the query was executed.
+	generations map[string]uint64
+	// streams are the streams of changes by keyspace.
+	streams map[string]*resultCacheStream
+}
+
+type cachedResult struct {
+	key     string
+	tables  []string
+	result  *sqltypes.Result
+	size    int64
+	expires time.Time
+}
+
+// resultCacheStream is the stream of changes of the cached tables of a
+// keyspace.
+type resultCacheStream struct {
+	keyspace string
+	// tables are the unqualified names of the streamed tables.
+	tables map[string]bool
+	// ready is set once every shard of the keyspace streams from a known
+	// position, i.e. once a VGTID event has the position of all of them.
+	// Until then, the changes of a shard whose stream is not started yet
+	// may be missed, so the results are only cached when the stream is
+	// ready.
+	ready  bool
+	cancel context.CancelFunc
+}
+
+// NewResultCache creates a result cache which streams the changes of the
+// cached tables with "vs", until ctx is canceled.
+func NewResultCache(ctx context.Context, vs vstreamer, ttl time.Duration, memory int64, maxRows int) *ResultCache {
+	return &ResultCache{
+		ctx:         ctx,
+		vs:          vs,
+		ttl:         ttl,
+		memory:      memory,
+		maxRows:     maxRows,
+		now:         time.Now,
+		results:     make(map[string]*list.Element),
+		lru:         list.New(),
+		tables:      make(map[string]map[string]bool),
+		generations: make(map[string]uint64),
+		streams:     make(map[string]*resultCacheStream),
+	}
+}
+
+// newResultCacheFromFlags returns the result cache configured by the flags,
+// or nil if the result cache is not enabled.
+func newResultCacheFromFlags(ctx context.Context, vs vstreamer) *ResultCache {
+	if !*enableResultCache {
+		return nil
+	}
+	rc := NewResultCache(ctx, vs, *resultCacheTTL, *resultCacheMemory, *resultCacheMaxRows)
+	resultCacheStatsOnce.Do(func() {
+		stats.NewGaugeFunc("ResultCacheLength", "Result cache length", rc.Len)
+		stats.NewGaugeFunc("ResultCacheSize", "Result cache size in bytes", rc.UsedCapacity)
+	})
+	return rc
+}
+
+// Get returns a copy of the cached result of the key, or nil.
+func (rc *ResultCache) Get(key string) *sqltypes.Result {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	element, ok := rc.results[key]
+	if !ok {
+		resultCacheMisses.Add(1)
+		return nil
+	}
+	cr := element.Value.(*cachedResult)
+	if rc.now().After(cr.expires) {
+		rc.deleteLocked(element)
+		resultCacheMisses.Add(1)
+		return nil
+	}
+	rc.lru.MoveToFront(element)
+	resultCacheHits.Add(1)
+	return cr.result.Copy()
+}
+
+// Generations returns the current generations of the qualified tables, to be
+// passed to Set once the query was executed. It starts streaming the changes
+// of the tables which are not streamed yet.
+func (rc *ResultCache) Generations(tables []string) []uint64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	generations := make([]uint64, len(tables))
+	for i, table := range tables {
+		rc.watchLocked(table)
+		generations[i] = rc.generations[table]
+	}
+	return generations
+}
+
+// Set caches the result of the key, unless the results of its tables were
+// invalidated since "generations" were returned by Generations, or the
+// changes of its tables are not streamed yet.
+func (rc *ResultCache) Set(key string, tables []string, generations []uint64, result *sqltypes.Result) {
+	if len(result.Rows) > rc.maxRows {
+		return
+	}
+	size := resultSize(key, result)
+	if size > rc.memory {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for i, table := range tables {
+		keyspace, _ := splitQualifiedTable(table)
+		if s := rc.streams[keyspace]; s == nil || !s.ready || rc.generations[table] != generations[i] {
+			return
+		}
+	}
+	if element, ok := rc.results[key]; ok {
+		rc.deleteLocked(element)
+	}
+	cr := &cachedResult{
+		key:     key,
+		tables:  tables,
+		result:  result.Copy(),
+		size:    size,
+		expires: rc.now().Add(rc.ttl),
+	}
+	rc.results[key] = rc.lru.PushFront(cr)
+	rc.size += size
+	for _, table := range tables {
+		keys, ok := rc.tables[table]
+		if !ok {
+			keys = make(map[string]bool)
+			rc.tables[table] = keys
+		}
+		keys[key] = true
+	}
+	for rc.size > rc.memory {
+		rc.deleteLocked(rc.lru.Back())
+	}
+}
+
+// Len returns the number of cached results.
+func (rc *ResultCache) Len() int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return int64(len(rc.results))
+}
+
+// UsedCapacity returns the memory used by the cached results, in bytes.
+func (rc *ResultCache) UsedCapacity() int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.size
+}
+
+func (rc *ResultCache) deleteLocked(element *list.Element) {
+	cr := rc.lru.Remove(element).(*cachedResult)
+	delete(rc.results, cr.key)
+	rc.size -= cr.size
+	for _, table := range cr.tables {
+		if keys, ok := rc.tables[table]; ok {
+			delete(keys, cr.key)
+			if len(keys) == 0 {
+				delete(rc.tables, table)
+			}
+		}
+	}
+}
+
+// invalidateLocked deletes the cached results of the qualified table.
+func (rc *ResultCache) invalidateLocked(table string) {
+	rc.generations[table]++
+	for key := range rc.tables[table] {
+		rc.deleteLocked(rc.results[key])
+	}
+}
+
+// watchLocked starts streaming the changes of the qualified table, unless
+// they are already streamed. The stream of the keyspace is started again if
+// it streams other tables.
+func (rc *ResultCache) watchLocked(table string) {
+	keyspace, name := splitQualifiedTable(table)
+	s := rc.streams[keyspace]
+	if s != nil {
+		if s.tables[name] {
+			return
+		}
+		s.cancel()
+		rc.stopStreamLocked(s)
+	}
+
+	ns := &resultCacheStream{
+		keyspace: keyspace,
+		tables:   map[string]bool{name: true},
+	}
+	if s != nil {
+		for t := range s.tables {
+			ns.tables[t] = true
+		}
+	}
+	ctx, cancel := context.WithCancel(rc.ctx)
+	ns.cancel = cancel
+	rc.streams[keyspace] = ns
+	go rc.stream(ctx, ns)
+}
+
+// stopStreamLocked marks the stream as not ready and invalidates the results
+// of its tables, since their changes may be missed from now on.
+func (rc *ResultCache) stopStreamLocked(s *resultCacheStream) {
+	s.ready = false
+	for name := range s.tables {
+		rc.invalidateLocked(s.keyspace + "." + name)
+	}
+}
+
+func (rc *ResultCache) stream(ctx context.Context, s *resultCacheStream) {
+	var rules []*binlogdatapb.Rule
+	for name := range s.tables {
+		rules = append(rules, &binlogdatapb.Rule{Match: name})
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Match < rules[j].Match })
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: s.keyspace,
+			Gtid:     "current",
+		}},
+	}
+
+	for {
+		err := rc.vs.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, &binlogdatapb.Filter{Rules: rules}, &vtgatepb.VStreamFlags{}, func(events []*binlogdatapb.VEvent) error {
+			rc.processEvents(s, events)
+			return ctx.Err()
+		})
+
+		rc.mu.Lock()
+		if rc.streams[s.keyspace] == s {
+			rc.stopStreamLocked(s)
+		}
+		rc.mu.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warningf("Result cache stream of keyspace %v failed, retrying in %v: %v", s.keyspace, resultCacheStreamRetryDelay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resultCacheStreamRetryDelay):
+		}
+	}
+}
+
+// processEvents invalidates the results of the tables changed by the events.
+func (rc *ResultCache) processEvents(s *resultCacheStream, events []*binlogdatapb.VEvent) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if rc.streams[s.keyspace] != s {
+		// The stream was replaced.
+		return
+	}
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_VGTID:
+			if !s.ready && allShardsPositioned(event.Vgtid) {
+				// The results of the queries which started before the
+				// stream was ready must not be cached.
+				s.ready = true
+				for name := range s.tables {
+					rc.generations[s.keyspace+"."+name]++
+				}
+			}
+		case binlogdatapb.VEventType_ROW:
+			// The table name is qualified by the vstream manager.
+			table := event.RowEvent.TableName
+			if _, ok := rc.tables[table]; ok {
+				resultCacheInvalidations.Add(table, 1)
+			}
+			rc.invalidateLocked(table)
+		case binlogdatapb.VEventType_DDL:
+			for name := range s.tables {
+				rc.invalidateLocked(s.keyspace + "." + name)
+			}
+		}
+	}
+}
+
+// allShardsPositioned returns true if the VGTID has the position of all its
+// shards: the position of a shard stays "current" until its stream sent its
+// first transaction.
+func allShardsPositioned(vgtid *binlogdatapb.VGtid) bool {
+	if vgtid == nil || len(vgtid.ShardGtids) == 0 {
+		return false
+	}
+	for _, sgtid := range vgtid.ShardGtids {
+		if sgtid.Gtid == "" || sgtid.Gtid == "current" {
+			return false
+		}
+	}
+	return true
+}
+
+// resultCacheTables returns the qualified tables read by the query if its
+// results may be cached, or nil.
+// The results may be cached if the query is a deterministic select on
+// tables of the VSchema, which all have result_cache set, or if the query
+// has the RESULT_CACHE directive.
+func resultCacheTables(vcursor *vcursorImpl, stmt sqlparser.Statement, bindVarNeeds *sqlparser.BindVarNeeds) []string {
+	if _, ok := stmt.(sqlparser.SelectStatement); !ok {
+		return nil
+	}
+	if bindVarNeeds != nil && (len(bindVarNeeds.NeedFunctionResult) > 0 || len(bindVarNeeds.NeedSystemVariable) > 0 || len(bindVarNeeds.NeedUserDefinedVariables) > 0) {
+		return nil
+	}
+	directive := sqlparser.ResultCacheDirective(stmt)
+
+	cacheable := true
+	tables := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if node.Lock != sqlparser.NoLock || node.SQLCalcFoundRows || (node.Cache != nil && !*node.Cache) {
+				cacheable = false
+			}
+		case *sqlparser.Union:
+			if node.Lock != sqlparser.NoLock {
+				cacheable = false
+			}
+		case *sqlparser.FuncExpr:
+			if nonDeterministicFunctions[node.Name.Lowered()] {
+				cacheable = false
+			}
+		case *sqlparser.CurTimeFuncExpr:
+			cacheable = false
+		case *sqlparser.ColName:
+			// User defined and system variables.
+			if node.Name.AtCount() != sqlparser.NoAt {
+				cacheable = false
+			}
+		case *sqlparser.AliasedTableExpr:
+			name, ok := node.Expr.(sqlparser.TableName)
+			if !ok {
+				// Derived tables are walked as subqueries.
+				return true, nil
+			}
+			table, _, _, _, err := vcursor.FindTable(name)
+			if err != nil || table == nil || table.Keyspace == nil || table.Type == vindexes.TypeSequence || (!directive && !table.ResultCache) {
+				cacheable = false
+			} else if table.Name.String() != "dual" {
+				tables[table.Keyspace.Name+"."+table.Name.String()] = true
+			}
+		}
+		return cacheable, nil
+	}, stmt)
+	if !cacheable || len(tables) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(tables))
+	for table := range tables {
+		result = append(result, table)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// resultCacheKey returns the key of the results of the plan for the given
+// bind variables and session. The results are cached by user, to respect
+// the table ACLs.
+func resultCacheKey(ctx context.Context, vcursor *vcursorImpl, plan *engine.Plan, bindVars map[string]*querypb.BindVariable, safeSession *SafeSession) string {
+	var b strings.Builder
+	b.WriteString(strconv.Quote(callerid.ImmediateCallerIDFromContext(ctx).GetUsername()))
+	b.WriteString(" ")
+	b.WriteString(vcursor.planPrefixKey())
+	options := safeSession.GetOptions()
+	b.WriteString(" ")
+	b.WriteString(options.GetIncludedFields().String())
+	b.WriteString(" ")
+	b.WriteString(strconv.FormatInt(options.GetSqlSelectLimit(), 10))
+	b.WriteString(" ")
+	b.WriteString(strconv.Quote(plan.Original))
+
+	names := make([]string, 0, len(bindVars))
+	for name := range bindVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		bv := bindVars[name]
+		b.WriteString(" ")
+		b.WriteString(name)
+		b.WriteString("=")
+		b.WriteString(bv.Type.String())
+		b.WriteString(strconv.Quote(string(bv.Value)))
+		for _, value := range bv.Values {
+			b.WriteString(",")
+			b.WriteString(value.Type.String())
+			b.WriteString(strconv.Quote(string(value.Value)))
+		}
+	}
+	return b.String()
+}
+
+// resultSize returns an estimate of the memory used by a cached result.
+func resultSize(key string, result *sqltypes.Result) int64 {
+	size := int64(len(key)) + 256
+	for _, field := range result.Fields {
+		size += int64(len(field.Name)+len(field.Table)+len(field.OrgTable)+len(field.Database)+len(field.OrgName)+len(field.ColumnType)) + 128
+	}
+	for _, row := range result.Rows {
+		size += 24
+		for _, value := range row {
+			size += int64(value.Len()) + 32
+		}
+	}
+	return size
+}
+
+// splitQualifiedTable splits a "keyspace.table" name.
+func splitQualifiedTable(table string) (keyspace, name string) {
+	i := strings.Index(table, ".")
+	return table[:i], table[i+1:]
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// fakeVStreamer sends the events of its channel to the last started stream.
+type fakeVStreamer struct {
+	events chan []*binlogdatapb.VEvent
+
+	mu      sync.Mutex
+	filters []*binlogdatapb.Filter
+}
+
+func newFakeVStreamer() *fakeVStreamer {
+	return &fakeVStreamer{events: make(chan []*binlogdatapb.VEvent)}
+}
+
+func (f *fakeVStreamer) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
+	f.mu.Lock()
+	f.filters = append(f.filters, filter)
+	f.mu.Unlock()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case events := <-f.events:
+			if err := send(events); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (f *fakeVStreamer) lastFilter() *binlogdatapb.Filter {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.filters) == 0 {
+		return nil
+	}
+	return f.filters[len(f.filters)-1]
+}
+
+// waitForStream waits for the stream of the filter to be started and
+// makes it ready: all its shards sent their position.
+func (f *fakeVStreamer) waitForStream(t *testing.T, filter *binlogdatapb.Filter) {
+	t.Helper()
+	for start := time.Now(); !assert.ObjectsAreEqual(filter, f.lastFilter()); time.Sleep(time.Millisecond) {
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("stream not started: %v", filter)
+		}
+	}
+	f.send(vgtidEvent("-80", "pos1", "80-", "pos2"))
+}
+
+// vgtidEvent returns a VGTID event with the positions of the shards, given
+// as shard and position pairs.
+func vgtidEvent(shardPositions ...string) []*binlogdatapb.VEvent {
+	vgtid := &binlogdatapb.VGtid{}
+	for i := 0; i < len(shardPositions); i += 2 {
+		vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{Keyspace: "ks", Shard: shardPositions[i], Gtid: shardPositions[i+1]})
+	}
+	return []*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid}}
+}
+
+// send sends the events to the stream, and returns once they are processed.
+func (f *fakeVStreamer) send(events []*binlogdatapb.VEvent) {
+	f.events <- events
+	// The stream processes the events before receiving the next ones.
+	f.events <- nil
+}
+
+func rowEvent(table string) []*binlogdatapb.VEvent {
+	return []*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: table},
+	}, {
+		Type: binlogdatapb.VEventType_COMMIT,
+	}}
+}
+
+func streamFilter(tables ...string) *binlogdatapb.Filter {
+	filter := &binlogdatapb.Filter{}
+	for _, table := range tables {
+		filter.Rules = append(filter.Rules, &binlogdatapb.Rule{Match: table})
+	}
+	return filter
+}
+
+func TestResultCache(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vs := newFakeVStreamer()
+	rc := NewResultCache(ctx, vs, time.Minute, 1<<20, 10)
+	now := time.Now()
+	rc.now = func() time.Time { return now }
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")
+	tables := []string{"ks.t1"}
+
+	// The results are not cached until the changes of the tables are streamed.
+	generations := rc.Generations(tables)
+	rc.Set("q1", tables, generations, result)
+	assert.Nil(t, rc.Get("q1"))
+
+	vs.waitForStream(t, streamFilter("t1"))
+	rc.Set("q1", tables, generations, result)
+	assert.Nil(t, rc.Get("q1"), "the stream was not ready when the query started")
+
+	generations = rc.Generations(tables)
+	rc.Set("q1", tables, generations, result)
+	assert.Equal(t, result, rc.Get("q1"))
+	assert.EqualValues(t, 1, rc.Len())
+
+	// Changes of other tables don't invalidate the results.
+	vs.send(rowEvent("ks.t2"))
+	assert.Equal(t, result, rc.Get("q1"))
+
+	// A change of the table invalidates its results, and the results of the
+	// queries which started before the change are not cached.
+	vs.send(rowEvent("ks.t1"))
+	assert.Nil(t, rc.Get("q1"))
+	rc.Set("q1", tables, generations, result)
+	assert.Nil(t, rc.Get("q1"))
+	assert.EqualValues(t, 0, rc.UsedCapacity())
+
+	// The results expire after the TTL.
+	generations = rc.Generations(tables)
+	rc.Set("q1", tables, generations, result)
+	now = now.Add(time.Minute + time.Second)
+	assert.Nil(t, rc.Get("q1"))
+
+	// Results with too many rows are not cached.
+	large := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11")
+	rc.Set("q2", tables, generations, large)
+	assert.Nil(t, rc.Get("q2"))
+
+	// Watching another table of the keyspace streams both tables again,
+	// and the results of the first table are invalidated.
+	rc.Set("q1", tables, generations, result)
+	generations = rc.Generations([]string{"ks.t1", "ks.t2"})
+	assert.Nil(t, rc.Get("q1"))
+	vs.waitForStream(t, streamFilter("t1", "t2"))
+	rc.Set("q3", []string{"ks.t1", "ks.t2"}, generations, result)
+	assert.Nil(t, rc.Get("q3"))
+	generations = rc.Generations([]string{"ks.t1", "ks.t2"})
+	rc.Set("q3", []string{"ks.t1", "ks.t2"}, generations, result)
+	assert.Equal(t, result, rc.Get("q3"))
+	invalidations := resultCacheInvalidations.Counts()["ks.t2"]
+	vs.send(rowEvent("ks.t2"))
+	assert.Nil(t, rc.Get("q3"))
+	assert.EqualValues(t, invalidations+1, resultCacheInvalidations.Counts()["ks.t2"])
+}
+
+func TestResultCacheReadyOnAllShards(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vs := newFakeVStreamer()
+	rc := NewResultCache(ctx, vs, time.Minute, 1<<20, 10)
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	tables := []string{"ks.t1"}
+	rc.Generations(tables)
+	for start := time.Now(); vs.lastFilter() == nil; time.Sleep(time.Millisecond) {
+		require.Less(t, int64(time.Since(start)), int64(10*time.Second), "stream not started")
+	}
+
+	// The stream of a shard sent a transaction, but the other shard did not
+	// send its position yet: its changes may be missed.
+	vs.send(rowEvent("ks.t1"))
+	vs.send(vgtidEvent("-80", "pos1", "80-", "current"))
+	generations := rc.Generations(tables)
+	rc.Set("q1", tables, generations, result)
+	assert.Nil(t, rc.Get("q1"))
+
+	// Once all the shards sent their position, the results are cached.
+	vs.send(vgtidEvent("-80", "pos1", "80-", "pos2"))
+	rc.Set("q1", tables, generations, result)
+	assert.Nil(t, rc.Get("q1"), "the stream was not ready when the query started")
+	generations = rc.Generations(tables)
+	rc.Set("q1", tables, generations, result)
+	assert.Equal(t, result, rc.Get("q1"))
+}
+
+func TestResultCacheMemory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vs := newFakeVStreamer()
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	size := resultSize("q1", result)
+	rc := NewResultCache(ctx, vs, time.Minute, 2*size, 10)
+
+	tables := []string{"ks.t1"}
+	rc.Generations(tables)
+	vs.waitForStream(t, streamFilter("t1"))
+	generations := rc.Generations(tables)
+
+	// The least recently used result is evicted.
+	rc.Set("q1", tables, generations, result)
+	rc.Set("q2", tables, generations, result)
+	assert.NotNil(t, rc.Get("q1"))
+	rc.Set("q3", tables, generations, result)
+	assert.NotNil(t, rc.Get("q1"))
+	assert.Nil(t, rc.Get("q2"))
+	assert.NotNil(t, rc.Get("q3"))
+	assert.EqualValues(t, 2, rc.Len())
+	assert.EqualValues(t, 2*size, rc.UsedCapacity())
+}
+
+func TestExecutorResultCache(t *testing.T) {
+	executor, sbc1, _, sbclookup := createLegacyExecutorEnv()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vs := newFakeVStreamer()
+	executor.resultCache = NewResultCache(ctx, vs, time.Minute, 1<<20, 10)
+	executor.plans.Clear()
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")})
+	query := "select /*vt+ RESULT_CACHE */ id from user where id = 1"
+	execute := func(query string) {
+		t.Helper()
+		_, err := executor.Execute(ctx, "TestExecutorResultCache", session, query, nil)
+		require.NoError(t, err)
+	}
+
+	execute(query)
+	vs.waitForStream(t, streamFilter("user"))
+	for i := 0; i < 3; i++ {
+		execute(query)
+	}
+	// The first query executed after the stream was ready was cached.
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+
+	// A change of the table invalidates the result.
+	vs.send(rowEvent("TestExecutor.user"))
+	execute(query)
+	assert.EqualValues(t, 3, sbc1.ExecCount.Get())
+
+	// Queries without the directive on tables without result_cache, queries
+	// with non deterministic functions and queries in a transaction are not
+	// cached.
+	sbclookup.ExecCount.Set(0)
+	for i := 0; i < 2; i++ {
+		execute("select id from main1")
+	}
+	assert.EqualValues(t, 2, sbclookup.ExecCount.Get())
+	for _, query := range []string{
+		"select /*vt+ RESULT_CACHE */ id, now() from user where id = 1",
+		"select /*vt+ RESULT_CACHE */ id from user where id = 1 for update",
+		"select /*vt+ RESULT_CACHE */ SQL_NO_CACHE id from user where id = 1",
+	} {
+		sbc1.ExecCount.Set(0)
+		execute(query)
+		execute(query)
+		assert.EqualValues(t, 2, sbc1.ExecCount.Get(), query)
+	}
+	execute("begin")
+	sbc1.ExecCount.Set(0)
+	execute(query)
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get())
+	execute("rollback")
+
+	// The results read from the replicas are not cached: the changes are
+	// streamed from the masters, which the replicas may lag behind.
+	session.TargetString = "@replica"
+	misses := resultCacheMisses.Get()
+	_, err := executor.Execute(ctx, "TestExecutorResultCache", session, query, nil)
+	require.Error(t, err, "the test has no replica tablets")
+	assert.Equal(t, misses, resultCacheMisses.Get(), "the result cache was looked up")
+}
+
+func TestResultCacheTables(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	executor.vschema.Keyspaces[KsTestUnsharded].Tables["main1"].ResultCache = true
+	defer func() {
+		executor.vschema.Keyspaces[KsTestUnsharded].Tables["main1"].ResultCache = false
+	}()
+
+	testCases := []struct {
+		query  string
+		tables []string
+	}{
+		{"select id from main1", []string{"TestUnsharded.main1"}},
+		{"select id from main1 where id in (select id from main1 where id > 1)", []string{"TestUnsharded.main1"}},
+		{"select id from music_user_map", nil},
+		{"select id from main1 join music_user_map", nil},
+		{"select /*vt+ RESULT_CACHE */ id from main1 m join music_user_map mu on m.id = mu.id", []string{"TestUnsharded.main1", "TestUnsharded.music_user_map"}},
+		{"select /*vt+ RESULT_CACHE */ u.id from user u join user_extra ue on u.id = ue.user_id", []string{"TestExecutor.user", "TestExecutor.user_extra"}},
+		{"select /*vt+ RESULT_CACHE */ 1 from dual", nil},
+		{"select /*vt+ RESULT_CACHE */ @@autocommit from user", nil},
+		{"select /*vt+ RESULT_CACHE */ rand() from user", nil},
+		{"select /*vt+ RESULT_CACHE */ * from information_schema.tables", nil},
+		{"update /*vt+ RESULT_CACHE */ user set name = 'a'", nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			executor.resultCache = NewResultCache(context.Background(), newFakeVStreamer(), time.Minute, 1<<20, 10)
+			executor.plans.Clear()
+			vcursor, err := newVCursorImpl(context.Background(), NewSafeSession(&vtgatepb.Session{TargetString: "@master"}), makeComments(""), executor, nil, executor.vm, executor.VSchema(), executor.resolver.resolver, nil, false)
+			require.NoError(t, err)
+			plan, err := executor.getPlan(vcursor, tc.query, makeComments(""), map[string]*querypb.BindVariable{}, false, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.tables, plan.CacheTables)
+		})
+	}
+}
@@ -308,6 +308,28 @@ func (sct *sandboxTopo) WatchSrvVSchema(ctx context.Context, cell string, callba
 	}()
 }
 
+// WatchSrvTenantMappings is part of the srvtopo.Server interface.
+//
+// If the sandbox was created with a backing topo service, piggy back on it
+// to properly simulate watches, otherwise there are no tenant mappings.
+func (sct *sandboxTopo) WatchSrvTenantMappings(ctx context.Context, cell string, callback func(topo.TenantMappings, error)) {
+	if sct.topoServer == nil {
+		callback(nil, topo.NewError(topo.NoNode, topo.SrvTenantMappingsFile))
+		return
+	}
+
+	current, updateChan, _ := sct.topoServer.WatchSrvTenantMappings(ctx, cell)
+	callback(current.Value, current.Err)
+	if current.Err != nil {
+		return
+	}
+	go func() {
+		for update := range updateChan {
+			callback(update.Value, update.Err)
+		}
+	}()
+}
+
 func sandboxDialer(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
 	sand := getSandbox(tablet.Keyspace)
 	sand.sandmu.Lock()
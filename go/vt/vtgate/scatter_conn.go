@@ -350,20 +350,43 @@ func (stc *ScatterConn) StreamExecute(
 // len(bindVars), the function panics.
 // Note we guarantee the callback will not be called concurrently
 // by multiple go routines, through processOneStreamingResult.
+// If the session is in a transaction, the shards that are already part of
+// it are read in the transaction. The other shards are read outside of it:
+// no transaction is started on them.
 func (stc *ScatterConn) StreamExecuteMulti(
 	ctx context.Context,
 	query string,
 	rss []*srvtopo.ResolvedShard,
 	bindVars []map[string]*querypb.BindVariable,
-	options *querypb.ExecuteOptions,
+	session *SafeSession,
 	callback func(reply *sqltypes.Result) error,
 ) error {
 	// mu protects fieldSent, callback and replyErr
 	var mu sync.Mutex
 	fieldSent := false
 
+	var options *querypb.ExecuteOptions
+	inTransaction := false
+	if session != nil && session.Session != nil {
+		options = session.Options
+		inTransaction = session.InTransaction()
+	}
+
 	allErrors := stc.multiGo("StreamExecute", rss, func(rs *srvtopo.ResolvedShard, i int) error {
-		return rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, options, func(qr *sqltypes.Result) error {
+		var qs queryservice.QueryService = rs.Gateway
+		var transactionID int64
+		if inTransaction {
+			var alias *topodatapb.TabletAlias
+			transactionID, _, alias = session.Find(rs.Target.Keyspace, rs.Target.Shard, rs.Target.TabletType)
+			if transactionID != 0 {
+				var err error
+				qs, err = getQueryService(rs, &shardActionInfo{transactionID: transactionID, alias: alias})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return qs.StreamExecute(ctx, rs.Target, query, bindVars[i], transactionID, options, func(qr *sqltypes.Result) error {
 			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
 		})
 	})
@@ -403,10 +426,10 @@ func (tt *timeTracker) Record(target *querypb.Target) time.Time {
 	return last
 }
 
-// MessageStream streams messages from the specified shards.
+// MessageStream streams messages of the consumer group from the specified shards.
 // Note we guarantee the callback will not be called concurrently
 // by multiple go routines, through processOneStreamingResult.
-func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error {
+func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name, group string, callback func(*sqltypes.Result) error) error {
 	// The cancelable context is used for handling errors
 	// from individual streams.
 	ctx, cancel := context.WithCancel(ctx)
@@ -421,7 +444,7 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 		// an individual stream to end. If we don't succeed on the retries for
 		// messageStreamGracePeriod, we abort and return an error.
 		for {
-			err := rs.Gateway.MessageStream(ctx, rs.Target, name, func(qr *sqltypes.Result) error {
+			err := rs.Gateway.MessageStream(ctx, rs.Target, name, group, func(qr *sqltypes.Result) error {
 				lastErrors.Reset(rs.Target)
 				return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
 			})
@@ -102,6 +102,44 @@ func TestExecuteFailOnAutocommit(t *testing.T) {
 	utils.MustMatch(t, []*querypb.BoundQuery{queries[1]}, sbc1.Queries, "")
 }
 
+func TestStreamExecuteMultiInTransaction(t *testing.T) {
+	keyspace := "TestStreamExecuteMultiInTransaction"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1", 1, keyspace, "1", topodatapb.TabletType_MASTER, true, 1, nil)
+	rss := []*srvtopo.ResolvedShard{
+		{Target: &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_MASTER}, Gateway: sbc0},
+		{Target: &querypb.Target{Keyspace: keyspace, Shard: "1", TabletType: topodatapb.TabletType_MASTER}, Gateway: sbc1},
+	}
+	bvs := make([]map[string]*querypb.BindVariable, len(rss))
+	// shard 0 - has transaction
+	// shard 1 - does not have transaction.
+	session := NewSafeSession(&vtgatepb.Session{
+		InTransaction: true,
+		ShardSessions: []*vtgatepb.Session_ShardSession{{
+			Target:        &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_MASTER, Cell: "aa"},
+			TransactionId: 123,
+		}},
+	})
+
+	err := sc.StreamExecuteMulti(ctx, "query", rss, bvs, session, func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+	// The shard in the transaction is read in it, the other one outside
+	// of it, without starting a transaction.
+	assert.Equal(t, []int64{123}, sbc0.StreamTransactionIDs)
+	assert.Equal(t, []int64{0}, sbc1.StreamTransactionIDs)
+	assert.Zero(t, sbc1.BeginCount.Get())
+	assert.Len(t, session.ShardSessions, 1)
+
+	// Out of a transaction, the transaction ids are ignored.
+	session.Session.InTransaction = false
+	err = sc.StreamExecuteMulti(ctx, "query", rss, bvs, session, func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, []int64{123, 0}, sbc0.StreamTransactionIDs)
+}
+
 func TestReservedOnMultiReplica(t *testing.T) {
 	keyspace := "keyspace"
 	createSandbox(keyspace)
@@ -0,0 +1,263 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// loadTimeout is the timeout of the schema fetches.
+const loadTimeout = 30 * time.Second
+
+var schemaLoads = stats.NewCountersWithMultiLabels(
+	"VtgateSchemaTrackerLoads",
+	"Loads of the table schemas by the schema tracker, by keyspace and result",
+	[]string{"Keyspace", "Result"})
+
+// Tracker keeps the columns of the tables of the keyspaces up to date.
+// The columns of a keyspace are fetched from the first serving master
+// tablet of the keyspace with the GetSchema RPC, and the tables whose
+// schema changed are fetched again when a master tablet signals them in
+// its health stream.
+type Tracker struct {
+	ch     chan *discovery.TabletHealth
+	tmc    tmclient.TabletManagerClient
+	cancel context.CancelFunc
+
+	// mu guards the fields below.
+	mu sync.Mutex
+	// tables are the columns of the tables by keyspace.
+	tables map[string]map[string][]vindexes.Column
+	// pending are the loads waiting to be done, by keyspace.
+	pending map[string]*pendingLoad
+	// work is signalled when a load is added to pending.
+	work   chan struct{}
+	signal func()
+}
+
+// pendingLoad is a load of the columns of a keyspace.
+type pendingLoad struct {
+	th *discovery.TabletHealth
+	// all is true if the columns of all the tables are loaded, and
+	// tables are the tables to load otherwise.
+	all    bool
+	tables map[string]bool
+}
+
+// NewTracker creates a tracker of the schemas from the health updates
+// of the tablets sent to ch, which fetches the schemas with tmc.
+func NewTracker(ch chan *discovery.TabletHealth, tmc tmclient.TabletManagerClient) *Tracker {
+	return &Tracker{
+		ch:      ch,
+		tmc:     tmc,
+		tables:  map[string]map[string][]vindexes.Column{},
+		pending: map[string]*pendingLoad{},
+		work:    make(chan struct{}, 1),
+	}
+}
+
+// RegisterSignalReceiver registers the function called after the columns
+// of some tables changed.
+func (t *Tracker) RegisterSignalReceiver(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.signal = f
+}
+
+// Start starts tracking the schemas.
+func (t *Tracker) Start() {
+	log.Info("Starting schema tracking")
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	go func() {
+		for {
+			select {
+			case th := <-t.ch:
+				t.healthUpdate(th)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		for {
+			select {
+			case <-t.work:
+				t.loadPending(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops tracking the schemas.
+func (t *Tracker) Stop() {
+	log.Info("Stopping schema tracking")
+	if t.cancel != nil {
+		t.cancel()
+	}
+}
+
+// Tables returns the columns of the tables of a keyspace. The returned
+// map must not be modified.
+func (t *Tracker) Tables(keyspace string) map[string][]vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[keyspace]
+}
+
+// GetColumns returns the columns of a table, or nil if the table is not
+// known.
+func (t *Tracker) GetColumns(keyspace, table string) []vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[keyspace][table]
+}
+
+// healthUpdate schedules the load of the columns changed on a tablet. It
+// does not block, so that the health updates are not dropped.
+func (t *Tracker) healthUpdate(th *discovery.TabletHealth) {
+	if th.Target == nil || th.Target.TabletType != topodatapb.TabletType_MASTER || !th.Serving || th.Tablet == nil {
+		return
+	}
+	keyspace := th.Target.Keyspace
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	load, ok := t.pending[keyspace]
+	_, loaded := t.tables[keyspace]
+	switch {
+	case !loaded:
+		// The keyspace was never loaded, or its last load failed.
+		if !ok {
+			load = &pendingLoad{}
+			t.pending[keyspace] = load
+		}
+		load.all = true
+	case th.Stats != nil && len(th.Stats.TableSchemaChanged) > 0:
+		if !ok {
+			load = &pendingLoad{tables: map[string]bool{}}
+			t.pending[keyspace] = load
+		}
+		if !load.all {
+			for _, table := range th.Stats.TableSchemaChanged {
+				load.tables[table] = true
+			}
+		}
+	default:
+		return
+	}
+	load.th = th
+	select {
+	case t.work <- struct{}{}:
+	default:
+	}
+}
+
+// loadPending does the pending loads.
+func (t *Tracker) loadPending(ctx context.Context) {
+	t.mu.Lock()
+	pending := t.pending
+	t.pending = map[string]*pendingLoad{}
+	t.mu.Unlock()
+
+	changed := false
+	for keyspace, load := range pending {
+		tables, err := load.load(ctx, t.tmc)
+		if err != nil {
+			log.Warningf("Error loading the schema of keyspace %s from tablet %v: %v", keyspace, load.th.Tablet.GetAlias(), err)
+			schemaLoads.Add([]string{keyspace, "Error"}, 1)
+			t.mu.Lock()
+			if !load.all {
+				// Load the whole keyspace on the next health update, since
+				// we do not know which tables are up to date.
+				delete(t.tables, keyspace)
+			}
+			t.mu.Unlock()
+			continue
+		}
+		schemaLoads.Add([]string{keyspace, "Success"}, 1)
+
+		t.mu.Lock()
+		// The maps are replaced rather than modified, since they are
+		// returned by Tables.
+		updated := map[string][]vindexes.Column{}
+		if !load.all {
+			for table, columns := range t.tables[keyspace] {
+				if !load.tables[table] {
+					updated[table] = columns
+				}
+			}
+		}
+		for table, columns := range tables {
+			updated[table] = columns
+		}
+		t.tables[keyspace] = updated
+		t.mu.Unlock()
+		changed = true
+	}
+
+	t.mu.Lock()
+	signal := t.signal
+	t.mu.Unlock()
+	if changed && signal != nil {
+		signal()
+	}
+}
+
+// load fetches the columns of the tables from the tablet. The tables
+// which are not returned were dropped.
+func (load *pendingLoad) load(ctx context.Context, tmc tmclient.TabletManagerClient) (map[string][]vindexes.Column, error) {
+	ctx, cancel := context.WithTimeout(ctx, loadTimeout)
+	defer cancel()
+
+	var names []string
+	if !load.all {
+		for table := range load.tables {
+			names = append(names, table)
+		}
+	}
+	sd, err := tmc.GetSchema(ctx, load.th.Tablet, names, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := map[string][]vindexes.Column{}
+	for _, td := range sd.TableDefinitions {
+		columns := make([]vindexes.Column, 0, len(td.Fields))
+		for _, field := range td.Fields {
+			columns = append(columns, vindexes.Column{
+				Name: sqlparser.NewColIdent(field.Name),
+				Type: field.Type,
+			})
+		}
+		tables[td.Name] = columns
+	}
+	return tables, nil
+}
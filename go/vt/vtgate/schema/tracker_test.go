@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeTMC returns the schemas set in results, and records the tables
+// requested.
+type fakeTMC struct {
+	tmclient.TabletManagerClient
+	results  []*tabletmanagerdatapb.SchemaDefinition
+	err      error
+	requests [][]string
+}
+
+func (tmc *fakeTMC) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	sort.Strings(tables)
+	tmc.requests = append(tmc.requests, tables)
+	if tmc.err != nil {
+		err := tmc.err
+		tmc.err = nil
+		return nil, err
+	}
+	sd := tmc.results[0]
+	tmc.results = tmc.results[1:]
+	return sd, nil
+}
+
+// newSchema returns a schema with the given tables, defined as
+// "table:column type,column type".
+func newSchema(tables ...string) *tabletmanagerdatapb.SchemaDefinition {
+	sd := &tabletmanagerdatapb.SchemaDefinition{}
+	for _, table := range tables {
+		parts := strings.SplitN(table, ":", 2)
+		td := &tabletmanagerdatapb.TableDefinition{Name: parts[0]}
+		for _, column := range strings.Split(parts[1], ",") {
+			fields := strings.Split(column, " ")
+			td.Fields = append(td.Fields, &querypb.Field{Name: fields[0], Type: querypb.Type(querypb.Type_value[fields[1]])})
+		}
+		sd.TableDefinitions = append(sd.TableDefinitions, td)
+	}
+	return sd
+}
+
+func newTabletHealth(changed ...string) *discovery.TabletHealth {
+	return &discovery.TabletHealth{
+		Tablet:  &topodatapb.Tablet{Keyspace: "ks", Shard: "-80", Type: topodatapb.TabletType_MASTER},
+		Target:  &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_MASTER},
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{TableSchemaChanged: changed},
+	}
+}
+
+func TestTrackerLoads(t *testing.T) {
+	tmc := &fakeTMC{}
+	tracker := NewTracker(nil, tmc)
+	signals := 0
+	tracker.RegisterSignalReceiver(func() { signals++ })
+
+	// The first update of a keyspace loads all its tables.
+	tmc.results = append(tmc.results, newSchema("t1:id UINT64,name VARCHAR", "t2:id INT32"))
+	tracker.healthUpdate(newTabletHealth())
+	tracker.loadPending(context.Background())
+	require.Len(t, tmc.requests, 1)
+	assert.Empty(t, tmc.requests[0])
+	assert.Equal(t, 1, signals)
+	assert.Equal(t, []vindexes.Column{
+		{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_UINT64},
+		{Name: sqlparser.NewColIdent("name"), Type: querypb.Type_VARCHAR},
+	}, tracker.GetColumns("ks", "t1"))
+	assert.Len(t, tracker.Tables("ks"), 2)
+
+	// Updates without schema changes do not load anything.
+	tracker.healthUpdate(newTabletHealth())
+	tracker.loadPending(context.Background())
+	assert.Len(t, tmc.requests, 1)
+	assert.Equal(t, 1, signals)
+
+	// The changed tables are loaded again, and the ones not returned
+	// were dropped.
+	tmc.results = append(tmc.results, newSchema("t3:id INT32"))
+	tracker.healthUpdate(newTabletHealth("t2", "t3"))
+	tracker.loadPending(context.Background())
+	require.Len(t, tmc.requests, 2)
+	assert.Equal(t, []string{"t2", "t3"}, tmc.requests[1])
+	assert.Equal(t, 2, signals)
+	tables := tracker.Tables("ks")
+	assert.Len(t, tables, 2)
+	assert.NotNil(t, tables["t1"])
+	assert.Nil(t, tables["t2"])
+	assert.Equal(t, []vindexes.Column{{Name: sqlparser.NewColIdent("id"), Type: querypb.Type_INT32}}, tables["t3"])
+}
+
+func TestTrackerIgnoresReplicas(t *testing.T) {
+	tmc := &fakeTMC{}
+	tracker := NewTracker(nil, tmc)
+	th := newTabletHealth()
+	th.Target.TabletType = topodatapb.TabletType_REPLICA
+	tracker.healthUpdate(th)
+	tracker.loadPending(context.Background())
+	assert.Empty(t, tmc.requests)
+	assert.Nil(t, tracker.Tables("ks"))
+}
+
+func TestTrackerFailedLoad(t *testing.T) {
+	tmc := &fakeTMC{}
+	tracker := NewTracker(nil, tmc)
+	tmc.results = append(tmc.results, newSchema("t1:id INT32"))
+	tracker.healthUpdate(newTabletHealth())
+	tracker.loadPending(context.Background())
+	require.NotNil(t, tracker.Tables("ks"))
+
+	// A failed load of some tables drops the keyspace, so that it is
+	// loaded again entirely.
+	tmc.err = assert.AnError
+	tracker.healthUpdate(newTabletHealth("t1"))
+	tracker.loadPending(context.Background())
+	assert.Nil(t, tracker.Tables("ks"))
+
+	tmc.results = append(tmc.results, newSchema("t1:id INT32"))
+	tracker.healthUpdate(newTabletHealth())
+	tracker.loadPending(context.Background())
+	require.Len(t, tmc.requests, 3)
+	assert.Empty(t, tmc.requests[2])
+	assert.NotNil(t, tracker.GetColumns("ks", "t1"))
+}
+
+func TestTrackerStartStop(t *testing.T) {
+	tmc := &fakeTMC{results: []*tabletmanagerdatapb.SchemaDefinition{newSchema("t1:id INT32")}}
+	ch := make(chan *discovery.TabletHealth)
+	tracker := NewTracker(ch, tmc)
+	signal := make(chan struct{}, 1)
+	tracker.RegisterSignalReceiver(func() { signal <- struct{}{} })
+	tracker.Start()
+	defer tracker.Stop()
+
+	ch <- newTabletHealth()
+	select {
+	case <-signal:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the schema to load")
+	}
+	assert.NotNil(t, tracker.GetColumns("ks", "t1"))
+}
@@ -20,6 +20,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 type (
@@ -30,13 +31,30 @@ type (
 		scopes   []*scope
 		exprDeps map[sqlparser.Expr]TableSet
 		err      error
+
+		si SchemaInformation
+		// authoritative are the columns of the tables whose column list is
+		// authoritative in the VSchema. A column missing from it is an error.
+		authoritative map[table][]vindexes.Column
+		// known are the columns of the tables whose column list is either
+		// authoritative or tracked from the tablets. The tracked columns are
+		// only hints, used to bind columns and expand stars: they may miss
+		// the columns added since they were tracked.
+		known map[table][]vindexes.Column
+		// singleRoute is set if all the tables of the statement are in the
+		// same unsharded keyspace: the whole statement is sent as is to a
+		// single route, and its stars are not expanded.
+		singleRoute bool
 	}
 )
 
 // newAnalyzer create the semantic analyzer
-func newAnalyzer() *analyzer {
+func newAnalyzer(si SchemaInformation) *analyzer {
 	return &analyzer{
-		exprDeps: map[sqlparser.Expr]TableSet{},
+		exprDeps:      map[sqlparser.Expr]TableSet{},
+		si:            si,
+		authoritative: map[table][]vindexes.Column{},
+		known:         map[table][]vindexes.Column{},
 	}
 }
 
@@ -52,6 +70,7 @@ func (a *analyzer) analyzeDown(cursor *sqlparser.Cursor) bool {
 			a.err = err
 			return false
 		}
+		a.expandStars(node)
 	case *sqlparser.DerivedTable:
 		a.err = Gen4NotSupportedF("derived tables")
 	case *sqlparser.TableExprs:
@@ -125,6 +144,9 @@ func (a *analyzer) resolveQualifiedColumn(current *scope, expr *sqlparser.ColNam
 	for current != nil {
 		tableExpr, found := current.tables[qualifier]
 		if found {
+			if columns, ok := a.authoritative[tableExpr]; ok && !hasColumn(columns, expr.Name) {
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'field list'", sqlparser.String(expr))
+			}
 			return tableExpr, nil
 		}
 		current = current.parent
@@ -140,9 +162,97 @@ func (a *analyzer) resolveUnQualifiedColumn(current *scope, expr *sqlparser.ColN
 			return tableExpr, nil
 		}
 	}
+
+	// The column can be bound if the column lists of all the tables are known.
+	var found table
+	for _, tableExpr := range current.tables {
+		columns, ok := a.known[tableExpr]
+		if !ok {
+			found = nil
+			break
+		}
+		if !hasColumn(columns, expr.Name) {
+			continue
+		}
+		if found != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Column '%s' in field list is ambiguous", sqlparser.String(expr))
+		}
+		found = tableExpr
+	}
+	if found != nil {
+		return found, nil
+	}
 	return nil, Gen4NotSupportedF("unable to map column to a table: %s", sqlparser.String(expr))
 }
 
+// expandStars replaces the stars of a select with the columns of the
+// tables, if their column lists are known and the statement is not sent as
+// is to a single route.
+func (a *analyzer) expandStars(sel *sqlparser.Select) {
+	if a.singleRoute {
+		return
+	}
+	current := a.currentScope()
+	var tables []table
+	for _, t := range a.Tables {
+		for _, t2 := range current.tables {
+			if t == t2 {
+				tables = append(tables, t)
+			}
+		}
+	}
+
+	var exprs sqlparser.SelectExprs
+	for _, expr := range sel.SelectExprs {
+		star, ok := expr.(*sqlparser.StarExpr)
+		if !ok {
+			exprs = append(exprs, expr)
+			continue
+		}
+		expanded, ok := a.expandStar(star, tables)
+		if !ok {
+			return
+		}
+		exprs = append(exprs, expanded...)
+	}
+	sel.SelectExprs = exprs
+}
+
+func (a *analyzer) expandStar(star *sqlparser.StarExpr, tables []table) (sqlparser.SelectExprs, bool) {
+	var exprs sqlparser.SelectExprs
+	for _, t := range tables {
+		name, err := t.TableName()
+		if err != nil {
+			return nil, false
+		}
+		if !star.TableName.IsEmpty() && star.TableName.Name.String() != name.Name.String() {
+			continue
+		}
+		columns, ok := a.known[t]
+		if !ok {
+			return nil, false
+		}
+		var qualifier sqlparser.TableName
+		if len(tables) > 1 || !star.TableName.IsEmpty() {
+			qualifier = sqlparser.TableName{Name: name.Name}
+		}
+		for _, col := range columns {
+			exprs = append(exprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: col.Name, Qualifier: qualifier}})
+		}
+	}
+	return exprs, len(exprs) > 0
+}
+
+
+func hasColumn(columns []vindexes.Column, name sqlparser.ColIdent) bool {
+	for _, col := range columns {
+		if col.Name.Equal(name) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *analyzer) tableSetFor(t table) TableSet {
 	for i, t2 := range a.Tables {
 		if t == t2 {
@@ -165,6 +275,18 @@ func (a *analyzer) bindTable(alias *sqlparser.AliasedTableExpr, expr sqlparser.S
 	case sqlparser.TableName:
 		scope := a.currentScope()
 		a.Tables = append(a.Tables, alias)
+		if a.si != nil {
+			vtbl, _, _, _, _, err := a.si.FindTableOrVindex(t)
+			if err == nil && vtbl != nil {
+				switch {
+				case vtbl.ColumnListAuthoritative:
+					a.authoritative[alias] = vtbl.Columns
+					a.known[alias] = vtbl.Columns
+				case vtbl.TrackedColumns != nil:
+					a.known[alias] = vtbl.TrackedColumns
+				}
+			}
+		}
 		if alias.As.IsEmpty() {
 			return scope.addTable(t.Name.String(), alias)
 		}
@@ -174,10 +296,39 @@ func (a *analyzer) bindTable(alias *sqlparser.AliasedTableExpr, expr sqlparser.S
 }
 
 func (a *analyzer) analyze(statement sqlparser.Statement) error {
+	a.singleRoute = a.isSingleRoute(statement)
 	_ = sqlparser.Rewrite(statement, a.analyzeDown, a.analyzeUp)
 	return a.err
 }
 
+// isSingleRoute returns true if all the tables of the statement are in the
+// same unsharded keyspace.
+func (a *analyzer) isSingleRoute(statement sqlparser.Statement) bool {
+	if a.si == nil {
+		return false
+	}
+	keyspace := ""
+	single := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		alias, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return single, nil
+		}
+		name, ok := alias.Expr.(sqlparser.TableName)
+		if !ok {
+			return true, nil
+		}
+		vtbl, _, _, _, _, err := a.si.FindTableOrVindex(name)
+		if err != nil || vtbl == nil || vtbl.Keyspace == nil || vtbl.Keyspace.Sharded || (keyspace != "" && vtbl.Keyspace.Name != keyspace) {
+			single = false
+			return false, nil
+		}
+		keyspace = vtbl.Keyspace.Name
+		return true, nil
+	}, statement)
+	return single && keyspace != ""
+}
+
 func (a *analyzer) analyzeUp(cursor *sqlparser.Cursor) bool {
 	switch cursor.Node().(type) {
 	case *sqlparser.Union, *sqlparser.Select:
@@ -24,7 +24,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 const (
@@ -163,7 +167,7 @@ func TestNotUniqueTableName(t *testing.T) {
 				t.Skip("table alias not implemented")
 			}
 			parse, _ := sqlparser.Parse(query)
-			_, err := Analyse(parse)
+			_, err := Analyse(parse, nil)
 			require.Error(t, err)
 			require.Contains(t, err.Error(), "Not unique table/alias")
 		})
@@ -178,7 +182,7 @@ func TestMissingTable(t *testing.T) {
 	for _, query := range queries {
 		t.Run(query, func(t *testing.T) {
 			parse, _ := sqlparser.Parse(query)
-			_, err := Analyse(parse)
+			_, err := Analyse(parse, nil)
 			require.Error(t, err)
 			require.Contains(t, err.Error(), "Unknown table")
 		})
@@ -188,7 +192,131 @@ func TestMissingTable(t *testing.T) {
 func parseAndAnalyze(t *testing.T, query string) (sqlparser.Statement, *SemTable) {
 	parse, err := sqlparser.Parse(query)
 	require.NoError(t, err)
-	semTable, err := Analyse(parse)
+	semTable, err := Analyse(parse, nil)
 	require.NoError(t, err)
 	return parse, semTable
 }
+
+type fakeSchemaInfo struct {
+	tables map[string]*vindexes.Table
+}
+
+func (f *fakeSchemaInfo) FindTableOrVindex(tablename sqlparser.TableName) (*vindexes.Table, vindexes.Vindex, string, topodatapb.TabletType, key.Destination, error) {
+	return f.tables[tablename.Name.String()], nil, "", topodatapb.TabletType_MASTER, nil, nil
+}
+
+func newFakeSchemaInfo() *fakeSchemaInfo {
+	unsharded := &vindexes.Keyspace{Name: "uks"}
+	cols := func(names ...string) []vindexes.Column {
+		var columns []vindexes.Column
+		for _, name := range names {
+			columns = append(columns, vindexes.Column{Name: sqlparser.NewColIdent(name)})
+		}
+		return columns
+	}
+	return &fakeSchemaInfo{tables: map[string]*vindexes.Table{
+		"t1": {Name: sqlparser.NewTableIdent("t1"), Columns: cols("a", "b"), ColumnListAuthoritative: true},
+		"t2": {Name: sqlparser.NewTableIdent("t2"), Columns: cols("b", "c"), ColumnListAuthoritative: true},
+		"t3": {Name: sqlparser.NewTableIdent("t3"), Columns: cols("d"), ColumnListAuthoritative: false},
+		"t4": {Name: sqlparser.NewTableIdent("t4"), TrackedColumns: cols("e", "f")},
+		"u1": {Name: sqlparser.NewTableIdent("u1"), Keyspace: unsharded, Columns: cols("a", "b"), ColumnListAuthoritative: true},
+		"u2": {Name: sqlparser.NewTableIdent("u2"), Keyspace: unsharded, TrackedColumns: cols("c")},
+	}}
+}
+
+func TestExpandStar(t *testing.T) {
+	tcases := []struct {
+		query, expected string
+	}{{
+		query:    "select * from t1",
+		expected: "select a, b from t1",
+	}, {
+		query:    "select * from t1 join t2",
+		expected: "select t1.a, t1.b, t2.b, t2.c from t1 join t2",
+	}, {
+		query:    "select t2.*, 1 from t1 join t2",
+		expected: "select t2.b, t2.c, 1 from t1 join t2",
+	}, {
+		query:    "select * from t1 join t3",
+		expected: "select * from t1 join t3",
+	}, {
+		query:    "select * from t1 join t4",
+		expected: "select t1.a, t1.b, t4.e, t4.f from t1 join t4",
+	}, {
+		// The statement is sent as is to the unsharded keyspace.
+		query:    "select * from u1 join u2",
+		expected: "select * from u1 join u2",
+	}, {
+		query:    "select * from u1 where a in (select c from u2)",
+		expected: "select * from u1 where a in (select c from u2)",
+	}, {
+		query:    "select * from u1 join t1",
+		expected: "select u1.a, u1.b, t1.a, t1.b from u1 join t1",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			parse, err := sqlparser.Parse(tcase.query)
+			require.NoError(t, err)
+			_, err = Analyse(parse, newFakeSchemaInfo())
+			require.NoError(t, err)
+			assert.Equal(t, tcase.expected, sqlparser.String(parse))
+		})
+	}
+}
+
+func TestBindingAuthoritativeColumns(t *testing.T) {
+	parse, err := sqlparser.Parse("select a, c from t1 join t2")
+	require.NoError(t, err)
+	semTable, err := Analyse(parse, newFakeSchemaInfo())
+	require.NoError(t, err)
+	sel := parse.(*sqlparser.Select)
+	assert.Equal(t, T0, semTable.Dependencies(extract(sel, 0)))
+	assert.Equal(t, T1, semTable.Dependencies(extract(sel, 1)))
+}
+
+func TestTrackedColumnsAreHints(t *testing.T) {
+	// A column missing from the tracked columns may have been added since
+	// they were tracked: it is not an error.
+	parse, err := sqlparser.Parse("select t4.g, t1.a from t1 join t4")
+	require.NoError(t, err)
+	semTable, err := Analyse(parse, newFakeSchemaInfo())
+	require.NoError(t, err)
+	sel := parse.(*sqlparser.Select)
+	assert.Equal(t, T1, semTable.Dependencies(extract(sel, 0)))
+
+	// The tracked columns bind the unqualified columns.
+	parse, err = sqlparser.Parse("select e, a from t1 join t4")
+	require.NoError(t, err)
+	semTable, err = Analyse(parse, newFakeSchemaInfo())
+	require.NoError(t, err)
+	sel = parse.(*sqlparser.Select)
+	assert.Equal(t, T1, semTable.Dependencies(extract(sel, 0)))
+	assert.Equal(t, T0, semTable.Dependencies(extract(sel, 1)))
+}
+
+func TestAuthoritativeColumnErrors(t *testing.T) {
+	tcases := []struct {
+		query, err string
+	}{{
+		query: "select b from t1 join t2",
+		err:   "Column 'b' in field list is ambiguous",
+	}, {
+		query: "select t1.c from t1",
+		err:   "Unknown column 't1.c' in 'field list'",
+	}, {
+		query: "select d from t1 join t3",
+		err:   "unable to map column to a table: d",
+	}, {
+		query: "select t1.e from t1 join t4",
+		err:   "Unknown column 't1.e' in 'field list'",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			parse, err := sqlparser.Parse(tcase.query)
+			require.NoError(t, err)
+			_, err = Analyse(parse, newFakeSchemaInfo())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tcase.err)
+		})
+	}
+}
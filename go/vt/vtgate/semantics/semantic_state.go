@@ -17,8 +17,11 @@ limitations under the License.
 package semantics
 
 import (
+	"vitess.io/vitess/go/vt/key"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"vitess.io/vitess/go/vt/sqlparser"
 )
@@ -41,6 +44,11 @@ type (
 		parent *scope
 		tables map[string]*sqlparser.AliasedTableExpr
 	}
+
+	// SchemaInformation is used to provide the table information from the VSchema.
+	SchemaInformation interface {
+		FindTableOrVindex(tablename sqlparser.TableName) (*vindexes.Table, vindexes.Vindex, string, topodatapb.TabletType, key.Destination, error)
+	}
 )
 
 // NewSemTable creates a new empty SemTable
@@ -92,9 +100,12 @@ func (s *scope) addTable(name string, table *sqlparser.AliasedTableExpr) error {
 	return nil
 }
 
-// Analyse analyzes the parsed query.
-func Analyse(statement sqlparser.Statement) (*SemTable, error) {
-	analyzer := newAnalyzer()
+// Analyse analyzes the parsed query. If si is not nil, the authoritative
+// column lists of the tables are used to expand the stars, to bind the
+// unqualified columns of joins to their tables, and to check the
+// qualified columns exist.
+func Analyse(statement sqlparser.Statement, si SchemaInformation) (*SemTable, error) {
+	analyzer := newAnalyzer(si)
 	// Initial scope
 	err := analyzer.analyze(statement)
 	if err != nil {
@@ -127,6 +127,11 @@ func NewTabletGateway(ctx context.Context, hc discovery.HealthCheck, serv srvtop
 			}
 		}
 	}(bufferCtx, hcChan, gw.buffer)
+	// watch the keyspace events so that the buffer can detect the end of
+	// resharding and MoveTables cutovers
+	if serv != nil {
+		gw.buffer.WatchKeyspaceEvents(ctx, serv, localCell)
+	}
 	gw.QueryService = queryservice.Wrap(nil, gw.withRetry)
 	return gw
 }
@@ -215,21 +220,23 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 		if !bufferedOnce && !inTransaction && target.TabletType == topodatapb.TabletType_MASTER {
 			// The next call blocks if we should buffer during a failover.
 			retryDone, bufferErr := gw.buffer.WaitForFailoverEnd(ctx, target.Keyspace, target.Shard, err)
-			if bufferErr != nil {
-				// Buffering failed e.g. buffer is already full. Do not retry.
-				err = vterrors.Errorf(vterrors.Code(bufferErr),
-					"failed to automatically buffer and retry failed request during failover: %v original err (type=%T): %v",
-					bufferErr, err, err)
-				break
-			}
-
 			// Request may have been buffered.
 			if retryDone != nil {
 				// We're going to retry this request as part of a buffer drain.
-				// Notify the buffer after we retried.
+				// Notify the buffer after we retried (or failed because the
+				// cutover changed the routing of the request).
 				defer retryDone()
 				bufferedOnce = true
 			}
+
+			if bufferErr != nil {
+				// Buffering failed e.g. buffer is already full or a cutover
+				// changed the routing of the request. Do not retry.
+				err = vterrors.Errorf(vterrors.Code(bufferErr),
+					"failed to automatically buffer and retry failed request during failover: %v original err (type=%T): %v",
+					bufferErr, err, err)
+				break
+			}
 		}
 
 		tablets := gw.hc.GetHealthyTabletStats(target)
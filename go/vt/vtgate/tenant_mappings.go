@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+var enableTenantMappings = flag.Bool("enable_tenant_mappings", false, "Watch the tenant mappings of the cell, set with the UpdateTenantMapping vtctl command, and map the ids of the numeric_topo_map vindexes with them.")
+
+// startTenantMappings starts watching the tenant mappings of the
+// numeric_topo_map vindexes in the topo of the cell, if
+// -enable_tenant_mappings is set.
+func startTenantMappings(ctx context.Context, serv srvtopo.Server, cell string) {
+	if !*enableTenantMappings {
+		return
+	}
+	serv.WatchSrvTenantMappings(ctx, cell, func(mappings topo.TenantMappings, err error) {
+		switch {
+		case err == nil:
+			setTenantMappings(mappings)
+		case topo.IsErrType(err, topo.NoNode):
+			// No tenant is mapped.
+			setTenantMappings(nil)
+		case !topo.IsErrType(err, topo.Interrupted):
+			// Keep the mappings we had before.
+			log.Warningf("Watch of the tenant mappings in the topo failed: %v", err)
+		}
+	})
+}
+
+// setTenantMappings hands the tenant mappings read from the topo to the
+// numeric_topo_map vindexes. The plans don't need to be cleared since
+// the vindexes map the ids when the plans are executed.
+func setTenantMappings(mappings topo.TenantMappings) {
+	lookups := make(map[string]map[string]vindexes.NumericLookupTable, len(mappings))
+	for keyspace, ksMappings := range mappings {
+		lookups[keyspace] = make(map[string]vindexes.NumericLookupTable, len(ksMappings))
+		for name, mapping := range ksMappings {
+			lookups[keyspace][name] = vindexes.NumericLookupTable(mapping)
+		}
+	}
+	vindexes.SetNumericTopoMappings(lookups)
+}
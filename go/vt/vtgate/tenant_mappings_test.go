@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestWatchTenantMappings(t *testing.T) {
+	defer func(enabled bool) { *enableTenantMappings = enabled }(*enableTenantMappings)
+	*enableTenantMappings = true
+	defer vindexes.SetNumericTopoMappings(nil)
+
+	vindex, err := vindexes.CreateVindex("numeric_topo_map", "tenants", nil)
+	require.NoError(t, err)
+	vindex.(vindexes.WantKeyspace).SetKeyspace("ks")
+	ts := memorytopo.NewServer("aa")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, ts.UpdateTenantMapping(ctx, "ks", "tenants", 8, 5))
+	require.NoError(t, ts.RebuildSrvTenantMappings(ctx, nil))
+	startTenantMappings(ctx, srvtopo.NewResilientServer(ts, "TestWatchTenantMappings"), "aa")
+
+	// Unmapped tenants use the numeric fallback.
+	waitForTenantMapping(t, vindex.(vindexes.SingleColumn), 8, 5)
+	waitForTenantMapping(t, vindex.(vindexes.SingleColumn), 7, 7)
+
+	// The mappings are only seen once they are copied to the cell.
+	require.NoError(t, ts.UpdateTenantMapping(ctx, "ks", "tenants", 7, 2))
+	require.NoError(t, ts.UpdateTenantMapping(ctx, "other", "tenants", 7, 4))
+	waitForTenantMapping(t, vindex.(vindexes.SingleColumn), 7, 7)
+	require.NoError(t, ts.RebuildSrvTenantMappings(ctx, nil))
+	waitForTenantMapping(t, vindex.(vindexes.SingleColumn), 7, 2)
+
+	require.NoError(t, ts.UpdateTenantMapping(ctx, "ks", "tenants", 7, 3))
+	require.NoError(t, ts.RebuildSrvTenantMappings(ctx, []string{"aa"}))
+	waitForTenantMapping(t, vindex.(vindexes.SingleColumn), 7, 3)
+}
+
+func waitForTenantMapping(t *testing.T, vindex vindexes.SingleColumn, tenantID, want uint64) {
+	t.Helper()
+	var got []key.Destination
+	for i := 0; i < 100; i++ {
+		var err error
+		got, err = vindex.Map(nil, []sqltypes.Value{sqltypes.NewUint64(tenantID)})
+		require.NoError(t, err)
+		if ksid, ok := got[0].(key.DestinationKeyspaceID); ok && len(ksid) == 8 && ksid[7] == byte(want) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("tenant %d is mapped to %v, want keyspace id %d", tenantID, got, want)
+}
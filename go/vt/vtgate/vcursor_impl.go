@@ -23,12 +23,14 @@ import (
 	"sync/atomic"
 	"time"
 
+	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/mysql"
 
 	"github.com/prometheus/common/log"
 
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/sync/errgroup"
 
 	"vitess.io/vitess/go/vt/callerid"
@@ -66,13 +68,15 @@ var _ vindexes.VCursor = (*vcursorImpl)(nil)
 type iExecute interface {
 	Execute(ctx context.Context, method string, session *SafeSession, s string, vars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
 	ExecuteMultiShard(ctx context.Context, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, session *SafeSession, autocommit bool, ignoreMaxMemoryRows bool) (qr *sqltypes.Result, errs []error)
-	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error
+	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, callback func(reply *sqltypes.Result) error) error
 	ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error)
 	Commit(ctx context.Context, safeSession *SafeSession) error
 
 	// TODO: remove when resolver is gone
 	ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error)
 	VSchema() *vindexes.VSchema
+	ProcessList() *ProcessList
+	Plans() cache.Cache
 }
 
 //VSchemaOperator is an interface to Vschema Operations
@@ -80,6 +84,8 @@ type VSchemaOperator interface {
 	GetCurrentSrvVschema() *vschemapb.SrvVSchema
 	GetCurrentVschema() (*vindexes.VSchema, error)
 	UpdateVSchema(ctx context.Context, ksName string, vschema *vschemapb.SrvVSchema) error
+	ValidateVSchemaDDL(ctx context.Context, ksName string, oldKS, newKS *vschemapb.Keyspace) (string, error)
+	TrackedTables(ks string) map[string][]vindexes.Column
 }
 
 // vcursorImpl implements the VCursor functionality used by dependent
@@ -104,6 +110,8 @@ type vcursorImpl struct {
 	vm                    VSchemaOperator
 	semTable              *semantics.SemTable
 	warnShardedOnly       bool // when using sharded only features, a warning will be warnings field
+	// pinnedPlanner is the planner pinned for the query being planned, if any.
+	pinnedPlanner planbuilder.PlannerVersion
 
 	warnings []*querypb.QueryWarning // any warnings that are accumulated during the planning phase are stored here
 }
@@ -136,12 +144,25 @@ func (vc *vcursorImpl) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.Alt
 		return errNoKeyspace
 	}
 
-	ks := srvVschema.Keyspaces[ksName]
+	// ApplyVSchemaDDL changes the keyspace in place, keep the original
+	// one to validate the change.
+	oldKS := srvVschema.Keyspaces[ksName]
+	var ks *vschemapb.Keyspace
+	if oldKS != nil {
+		ks = proto.Clone(oldKS).(*vschemapb.Keyspace)
+	}
 	ks, err := topotools.ApplyVSchemaDDL(ksName, ks, vschemaDDL)
 
 	if err != nil {
 		return err
 	}
+	warning, err := vc.vm.ValidateVSchemaDDL(vc.ctx, ksName, oldKS, ks)
+	if err != nil {
+		return err
+	}
+	if warning != "" {
+		vc.RecordWarning(&querypb.QueryWarning{Code: mysql.ERUnknownError, Message: warning})
+	}
 
 	srvVschema.Keyspaces[ksName] = ks
 
@@ -149,6 +170,38 @@ func (vc *vcursorImpl) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.Alt
 
 }
 
+// ExecuteViewDDL implements the VCursor interface
+func (vc *vcursorImpl) ExecuteViewDDL(keyspace string, ddl sqlparser.DDLStatement) error {
+	srvVschema := vc.vm.GetCurrentSrvVschema()
+	if srvVschema == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+	}
+
+	user := callerid.ImmediateCallerIDFromContext(vc.ctx)
+	allowed := vschemaacl.Authorized(user)
+	if !allowed {
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.AccessDeniedError, "User '%s' is not allowed to perform vschema operations", user.GetUsername())
+	}
+
+	// The keyspace is copied, so that an invalid statement does not modify
+	// the current vschema.
+	ks := srvVschema.Keyspaces[keyspace]
+	if ks != nil {
+		ks = proto.Clone(ks).(*vschemapb.Keyspace)
+	}
+	ks, err := topotools.ApplyViewDDL(keyspace, ks, ddl)
+	if err != nil {
+		return err
+	}
+	if _, err := vindexes.BuildKeyspaceSchema(ks, keyspace); err != nil {
+		return err
+	}
+
+	srvVschema.Keyspaces[keyspace] = ks
+
+	return vc.vm.UpdateVSchema(vc.ctx, keyspace, srvVschema)
+}
+
 // newVcursorImpl creates a vcursorImpl. Before creating this object, you have to separate out any marginComments that came with
 // the query and supply it here. Trailing comments are typically sent by the application for various reasons,
 // including as identifying markers. So, they have to be added back to all queries that are executed
@@ -355,6 +408,28 @@ func (vc *vcursorImpl) FirstSortedKeyspace() (*vindexes.Keyspace, error) {
 	return kss[keys[0]].Keyspace, nil
 }
 
+// FindView implements the ContextVSchema interface
+func (vc *vcursorImpl) FindView(name sqlparser.TableName) sqlparser.SelectStatement {
+	destKeyspace, _, _, err := vc.executor.ParseDestinationTarget(name.Qualifier.String())
+	if err != nil {
+		return nil
+	}
+	if destKeyspace == "" {
+		destKeyspace = vc.keyspace
+	}
+	return vc.vschema.FindView(destKeyspace, name.Name.String())
+}
+
+// IsViewsEnabled implements the ContextVSchema interface
+func (vc *vcursorImpl) IsViewsEnabled() bool {
+	return *enableViews
+}
+
+// IsInformationSchemaEmulated implements the ContextVSchema interface
+func (vc *vcursorImpl) IsInformationSchemaEmulated() bool {
+	return *emulateInformationSchema
+}
+
 // SysVarSetEnabled implements the ContextVSchema interface
 func (vc *vcursorImpl) SysVarSetEnabled() bool {
 	return vc.GetSessionEnableSystemSettings()
@@ -379,21 +454,15 @@ func (vc *vcursorImpl) AllKeyspace() ([]*vindexes.Keyspace, error) {
 
 // Planner implements the ContextVSchema interface
 func (vc *vcursorImpl) Planner() planbuilder.PlannerVersion {
+	if vc.pinnedPlanner != querypb.ExecuteOptions_DEFAULT_PLANNER {
+		return vc.pinnedPlanner
+	}
 	if vc.safeSession.Options != nil &&
 		vc.safeSession.Options.PlannerVersion != querypb.ExecuteOptions_DEFAULT_PLANNER {
 		return vc.safeSession.Options.PlannerVersion
 	}
-	switch strings.ToLower(*plannerVersion) {
-	case "v3":
-		return planbuilder.V3
-	case "gen4":
-		return planbuilder.Gen4
-	case "gen4greedy", "greedy":
-		return planbuilder.Gen4GreedyOnly
-	case "left2right":
-		return planbuilder.Gen4Left2Right
-	case "gen4fallback":
-		return planbuilder.Gen4WithFallback
+	if version, ok := planbuilder.PlannerNameToVersion(*plannerVersion); ok {
+		return version
 	}
 
 	log.Warn("unknown planner version configured. using the default")
@@ -486,7 +555,7 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(len(rss)))
-	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession.Options, callback)
+	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession, callback)
 }
 
 // ExecuteKeyspaceID is part of the engine.VCursor interface.
@@ -535,6 +604,28 @@ func (vc *vcursorImpl) SetTarget(target string) error {
 	return nil
 }
 
+// Processlist implements the SessionActions interface
+func (vc *vcursorImpl) Processlist() ([]*engine.ProcessInfo, error) {
+	user := callerid.ImmediateCallerIDFromContext(vc.ctx).GetUsername()
+	return vc.executor.ProcessList().List(user), nil
+}
+
+// CachedPlans implements the SessionActions interface
+func (vc *vcursorImpl) CachedPlans() []*engine.Plan {
+	var plans []*engine.Plan
+	vc.executor.Plans().ForEach(func(value interface{}) bool {
+		plans = append(plans, value.(*engine.Plan))
+		return true
+	})
+	return plans
+}
+
+// Kill implements the SessionActions interface
+func (vc *vcursorImpl) Kill(id uint64, queryOnly bool) error {
+	user := callerid.ImmediateCallerIDFromContext(vc.ctx).GetUsername()
+	return vc.executor.ProcessList().Kill(user, id, queryOnly)
+}
+
 func ignoreKeyspace(keyspace string) bool {
 	return keyspace == "" || sqlparser.SystemSchema(keyspace)
 }
@@ -665,6 +756,11 @@ func (vc *vcursorImpl) SetWorkload(workload querypb.ExecuteOptions_Workload) {
 	vc.safeSession.GetOrCreateOptions().Workload = workload
 }
 
+// SetPriority implements the SessionActions interface
+func (vc *vcursorImpl) SetPriority(priority querypb.ExecuteOptions_Priority) {
+	vc.safeSession.GetOrCreateOptions().Priority = priority
+}
+
 // SetPlannerVersion implements the SessionActions interface
 func (vc *vcursorImpl) SetPlannerVersion(v planbuilder.PlannerVersion) {
 	vc.safeSession.GetOrCreateOptions().PlannerVersion = v
@@ -733,6 +829,11 @@ func (vc *vcursorImpl) KeyspaceAvailable(ks string) bool {
 	return exists
 }
 
+// TrackedTables implements the VCursor interface
+func (vc *vcursorImpl) TrackedTables(ks string) map[string][]vindexes.Column {
+	return vc.vm.TrackedTables(ks)
+}
+
 // ErrorIfShardedF implements the VCursor interface
 func (vc *vcursorImpl) ErrorIfShardedF(ks *vindexes.Keyspace, warn, errFormat string, params ...interface{}) error {
 	if ks.Sharded {
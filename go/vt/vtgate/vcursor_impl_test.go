@@ -40,6 +40,14 @@ func (f fakeVSchemaOperator) UpdateVSchema(ctx context.Context, ksName string, v
 	panic("implement me")
 }
 
+func (f fakeVSchemaOperator) ValidateVSchemaDDL(ctx context.Context, ksName string, oldKS, newKS *vschema.Keyspace) (string, error) {
+	panic("implement me")
+}
+
+func (f fakeVSchemaOperator) TrackedTables(ks string) map[string][]vindexes.Column {
+	panic("implement me")
+}
+
 type fakeTopoServer struct {
 }
 
@@ -79,6 +87,12 @@ func (f *fakeTopoServer) WatchSrvVSchema(ctx context.Context, cell string, callb
 
 }
 
+// WatchSrvTenantMappings starts watching the tenant mappings of
+// the provided cell.
+func (f *fakeTopoServer) WatchSrvTenantMappings(ctx context.Context, cell string, callback func(topo.TenantMappings, error)) {
+
+}
+
 func TestDestinationKeyspace(t *testing.T) {
 	ks1 := &vindexes.Keyspace{
 		Name:    "ks1",
@@ -114,6 +114,18 @@ func (cached *ConsistentLookup) CachedSize(alloc bool) int64 {
 	size += cached.clCommon.CachedSize(true)
 	return size
 }
+func (cached *ConsistentLookupMultiColumn) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field clCommon *vitess.io/vitess/go/vt/vtgate/vindexes.clCommon
+	size += cached.clCommon.CachedSize(true)
+	return size
+}
 func (cached *ConsistentLookupUnique) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -126,6 +138,18 @@ func (cached *ConsistentLookupUnique) CachedSize(alloc bool) int64 {
 	size += cached.clCommon.CachedSize(true)
 	return size
 }
+func (cached *ConsistentLookupUniqueMultiColumn) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field clCommon *vitess.io/vitess/go/vt/vtgate/vindexes.clCommon
+	size += cached.clCommon.CachedSize(true)
+	return size
+}
 func (cached *Hash) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -156,7 +180,7 @@ func (cached *LookupHash) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(168)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -170,7 +194,21 @@ func (cached *LookupHashUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(168)
+	}
+	// field name string
+	size += int64(len(cached.name))
+	// field lkp vitess.io/vitess/go/vt/vtgate/vindexes.lookupInternal
+	size += cached.lkp.CachedSize(false)
+	return size
+}
+func (cached *LookupMultiColumn) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(168)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -184,7 +222,7 @@ func (cached *LookupNonUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(168)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -198,7 +236,7 @@ func (cached *LookupUnicodeLooseMD5Hash) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(168)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -212,7 +250,7 @@ func (cached *LookupUnicodeLooseMD5HashUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(168)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -226,7 +264,21 @@ func (cached *LookupUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(168)
+	}
+	// field name string
+	size += int64(len(cached.name))
+	// field lkp vitess.io/vitess/go/vt/vtgate/vindexes.lookupInternal
+	size += cached.lkp.CachedSize(false)
+	return size
+}
+func (cached *LookupUniqueMultiColumn) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(168)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -283,6 +335,28 @@ func (cached *NumericStaticMap) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+
+//go:nocheckptr
+func (cached *NumericTopoMap) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field name string
+	size += int64(len(cached.name))
+	// field keyspace string
+	size += int64(len(cached.keyspace))
+	// field mappingName string
+	size += int64(len(cached.mappingName))
+	// field fallback vitess.io/vitess/go/vt/vtgate/vindexes.SingleColumn
+	if cc, ok := cached.fallback.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *RegionExperimental) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -341,7 +415,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(169)
+		size += int64(194)
 	}
 	// field Type string
 	size += int64(len(cached.Type))
@@ -379,6 +453,13 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
+	// field TrackedColumns []vitess.io/vitess/go/vt/vtgate/vindexes.Column
+	{
+		size += int64(cap(cached.TrackedColumns)) * int64(44)
+		for _, elem := range cached.TrackedColumns {
+			size += elem.CachedSize(false)
+		}
+	}
 	// field Pinned []byte
 	size += int64(cap(cached.Pinned))
 	return size
@@ -425,7 +506,7 @@ func (cached *clCommon) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(256)
+		size += int64(288)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -458,7 +539,7 @@ func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(144)
 	}
 	// field Table string
 	size += int64(len(cached.Table))
@@ -477,5 +558,9 @@ func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	size += int64(len(cached.ver))
 	// field del string
 	size += int64(len(cached.del))
+	// field multiSel string
+	size += int64(len(cached.multiSel))
+	// field multiVer string
+	size += int64(len(cached.multiVer))
 	return size
 }
@@ -40,11 +40,19 @@ var (
 	_ SingleColumn  = (*ConsistentLookup)(nil)
 	_ Lookup        = (*ConsistentLookup)(nil)
 	_ WantOwnerInfo = (*ConsistentLookup)(nil)
+	_ MultiColumn   = (*ConsistentLookupMultiColumn)(nil)
+	_ Lookup        = (*ConsistentLookupMultiColumn)(nil)
+	_ WantOwnerInfo = (*ConsistentLookupMultiColumn)(nil)
+	_ MultiColumn   = (*ConsistentLookupUniqueMultiColumn)(nil)
+	_ Lookup        = (*ConsistentLookupUniqueMultiColumn)(nil)
+	_ WantOwnerInfo = (*ConsistentLookupUniqueMultiColumn)(nil)
 )
 
 func init() {
 	Register("consistent_lookup", NewConsistentLookup)
 	Register("consistent_lookup_unique", NewConsistentLookupUnique)
+	Register("consistent_lookup_multicolumn", NewConsistentLookupMultiColumn)
+	Register("consistent_lookup_unique_multicolumn", NewConsistentLookupUniqueMultiColumn)
 }
 
 // ConsistentLookup is a non-unique lookup vindex that can stay
@@ -183,6 +191,152 @@ func (lu *ConsistentLookupUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]
 
 //====================================================================
 
+// ConsistentLookupMultiColumn is a non-unique lookup vindex keyed on
+// more than one column that can stay consistent with respect to its
+// owner table. Queries are routed through it only when they supply
+// values for all of its columns.
+type ConsistentLookupMultiColumn struct {
+	*clCommon
+}
+
+// NewConsistentLookupMultiColumn creates a ConsistentLookupMultiColumn vindex.
+// The supplied map has the following required fields:
+//   table: name of the backing table. It can be qualified by the keyspace.
+//   from: list of columns in the table that have the 'from' values of the lookup vindex.
+//   to: The 'to' column name of the table.
+func NewConsistentLookupMultiColumn(name string, m map[string]string) (Vindex, error) {
+	clc, err := newCLCommon(name, m)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMultiColumnLookup(name, &clc.lkp); err != nil {
+		return nil, err
+	}
+	return &ConsistentLookupMultiColumn{clCommon: clc}, nil
+}
+
+// Cost returns the cost of this vindex as 20.
+func (lu *ConsistentLookupMultiColumn) Cost() int {
+	return 20
+}
+
+// IsUnique returns false since the Vindex is non unique.
+func (lu *ConsistentLookupMultiColumn) IsUnique() bool {
+	return false
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (lu *ConsistentLookupMultiColumn) NeedsVCursor() bool {
+	return true
+}
+
+// Map can map rows of column values to key.Destination objects.
+func (lu *ConsistentLookupMultiColumn) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(rowsColValues))
+	if lu.writeOnly {
+		for range rowsColValues {
+			out = append(out, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}})
+		}
+		return out, nil
+	}
+
+	results, err := lu.lkp.LookupMultiColumn(vcursor, rowsColValues, vtgatepb.CommitOrder_PRE)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		if len(result.Rows) == 0 {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		ksids := make([][]byte, 0, len(result.Rows))
+		for _, row := range result.Rows {
+			ksids = append(ksids, row[0].ToBytes())
+		}
+		out = append(out, key.DestinationKeyspaceIDs(ksids))
+	}
+	return out, nil
+}
+
+// Verify returns true if the rows of column values map to ksids.
+func (lu *ConsistentLookupMultiColumn) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	return lu.verifyMultiColumn(vcursor, rowsColValues, ksids)
+}
+
+//====================================================================
+
+// ConsistentLookupUniqueMultiColumn defines a vindex that uses a lookup
+// table keyed on more than one column. The table is expected to define
+// the from columns as a unique key. It's Unique and a Lookup.
+type ConsistentLookupUniqueMultiColumn struct {
+	*clCommon
+}
+
+// NewConsistentLookupUniqueMultiColumn creates a ConsistentLookupUniqueMultiColumn vindex.
+// The supplied map has the following required fields:
+//   table: name of the backing table. It can be qualified by the keyspace.
+//   from: list of columns in the table that have the 'from' values of the lookup vindex.
+//   to: The 'to' column name of the table.
+func NewConsistentLookupUniqueMultiColumn(name string, m map[string]string) (Vindex, error) {
+	clc, err := newCLCommon(name, m)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMultiColumnLookup(name, &clc.lkp); err != nil {
+		return nil, err
+	}
+	return &ConsistentLookupUniqueMultiColumn{clCommon: clc}, nil
+}
+
+// Cost returns the cost of this vindex as 10.
+func (lu *ConsistentLookupUniqueMultiColumn) Cost() int {
+	return 10
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (lu *ConsistentLookupUniqueMultiColumn) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (lu *ConsistentLookupUniqueMultiColumn) NeedsVCursor() bool {
+	return true
+}
+
+// Map can map rows of column values to key.Destination objects.
+func (lu *ConsistentLookupUniqueMultiColumn) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(rowsColValues))
+	if lu.writeOnly {
+		for range rowsColValues {
+			out = append(out, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}})
+		}
+		return out, nil
+	}
+
+	results, err := lu.lkp.LookupMultiColumn(vcursor, rowsColValues, vcursor.LookupRowLockShardSession())
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		switch len(result.Rows) {
+		case 0:
+			out = append(out, key.DestinationNone{})
+		case 1:
+			out = append(out, key.DestinationKeyspaceID(result.Rows[0][0].ToBytes()))
+		default:
+			return nil, fmt.Errorf("Lookup.Map: unexpected multiple results from vindex %s: %v", lu.lkp.Table, rowsColValues[i])
+		}
+	}
+	return out, nil
+}
+
+// Verify returns true if the rows of column values map to ksids.
+func (lu *ConsistentLookupUniqueMultiColumn) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	return lu.verifyMultiColumn(vcursor, rowsColValues, ksids)
+}
+
+//====================================================================
+
 // clCommon defines a vindex that uses a lookup table.
 // The table is expected to define the id column as unique. It's
 // Unique and a Lookup.
@@ -249,6 +403,18 @@ func (lu *clCommon) Verify(vcursor VCursor, ids []sqltypes.Value, ksids [][]byte
 	return lu.lkp.VerifyCustom(vcursor, ids, ksidsToValues(ksids), vtgate.CommitOrder_PRE)
 }
 
+// verifyMultiColumn returns true if the rows of column values map to ksids.
+func (lu *clCommon) verifyMultiColumn(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	if lu.writeOnly {
+		out := make([]bool, len(rowsColValues))
+		for i := range rowsColValues {
+			out[i] = true
+		}
+		return out, nil
+	}
+	return lu.lkp.VerifyMultiColumnCustom(vcursor, rowsColValues, ksidsToValues(ksids), vtgate.CommitOrder_PRE)
+}
+
 // Create reserves the id by inserting it into the vindex table.
 func (lu *clCommon) Create(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte, ignoreMode bool) error {
 	origErr := lu.lkp.createCustom(vcursor, rowsColValues, ksidsToValues(ksids), ignoreMode, vtgatepb.CommitOrder_PRE)
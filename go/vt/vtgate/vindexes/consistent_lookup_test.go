@@ -471,6 +471,16 @@ func TestConsistentLookupUpdateBecauseUncomparableTypes(t *testing.T) {
 }
 
 func createConsistentLookup(t *testing.T, name string, writeOnly bool) SingleColumn {
+	t.Helper()
+	return createConsistentLookupVindex(t, name, writeOnly).(SingleColumn)
+}
+
+func createConsistentLookupMultiColumn(t *testing.T, name string, writeOnly bool) MultiColumn {
+	t.Helper()
+	return createConsistentLookupVindex(t, name, writeOnly).(MultiColumn)
+}
+
+func createConsistentLookupVindex(t *testing.T, name string, writeOnly bool) Vindex {
 	t.Helper()
 	write := "false"
 	if writeOnly {
@@ -492,7 +502,7 @@ func createConsistentLookup(t *testing.T, name string, writeOnly bool) SingleCol
 	if err := l.(WantOwnerInfo).SetOwnerInfo("ks", "dot.t1", cols); err != nil {
 		t.Fatal(err)
 	}
-	return l.(SingleColumn)
+	return l
 }
 
 var _ VCursor = (*loggingVCursor)(nil)
@@ -609,3 +619,76 @@ func makeTestResultLookup(numRows []int) *sqltypes.Result {
 	}
 	return result
 }
+
+func TestConsistentLookupMultiColumnMap(t *testing.T) {
+	_, err := CreateVindex("consistent_lookup_multicolumn", "lkp", map[string]string{
+		"table": "t",
+		"from":  "fromc",
+		"to":    "toc",
+	})
+	assert.EqualError(t, err, "multi-column lookup vindex lkp needs at least two from columns: [fromc]")
+
+	lookup := createConsistentLookupMultiColumn(t, "consistent_lookup_multicolumn", false)
+	assert.Equal(t, 20, lookup.Cost())
+	assert.False(t, lookup.IsUnique())
+	vc := &loggingVCursor{}
+	vc.AddResult(sqltypes.MakeTestResult(sqltypes.MakeTestFields("fromc1|fromc2|toc", "int64|int64|varbinary"), "1|2|1", "1|2|2"), nil)
+
+	got, err := lookup.Map(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceIDs([][]byte{
+			[]byte("1"),
+			[]byte("2"),
+		}),
+	}
+	assert.Equal(t, want, got)
+	vc.verifyLog(t, []string{
+		"ExecutePre select fromc1, fromc2, toc from t where (fromc1, fromc2) in ((:fromc1_0, :fromc2_0)) [{fromc1_0 1} {fromc2_0 2}] false",
+	})
+
+	// Test write_only.
+	lookup = createConsistentLookupMultiColumn(t, "consistent_lookup_multicolumn", true)
+	got, err = lookup.Map(nil, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}}}, got)
+}
+
+func TestConsistentLookupUniqueMultiColumnMap(t *testing.T) {
+	lookup := createConsistentLookupMultiColumn(t, "consistent_lookup_unique_multicolumn", false)
+	assert.Equal(t, 10, lookup.Cost())
+	assert.True(t, lookup.IsUnique())
+	vc := &loggingVCursor{}
+	vc.AddResult(sqltypes.MakeTestResult(sqltypes.MakeTestFields("fromc1|fromc2|toc", "int64|int64|varbinary"), "1|2|1"), nil)
+
+	got, err := lookup.Map(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
+		{sqltypes.NewInt64(3), sqltypes.NewInt64(4)},
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("1")),
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+	vc.verifyLog(t, []string{
+		"ExecutePre select fromc1, fromc2, toc from t where (fromc1, fromc2) in ((:fromc1_0, :fromc2_0), (:fromc1_1, :fromc2_1)) [{fromc1_0 1} {fromc1_1 3} {fromc2_0 2} {fromc2_1 4}] false",
+	})
+
+	vc.AddResult(sqltypes.MakeTestResult(sqltypes.MakeTestFields("fromc1|fromc2|toc", "int64|int64|varbinary"), "1|2|1", "1|2|2"), nil)
+	_, err = lookup.Map(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}})
+	assert.EqualError(t, err, "Lookup.Map: unexpected multiple results from vindex t: [INT64(1) INT64(2)]")
+}
+
+func TestConsistentLookupMultiColumnVerify(t *testing.T) {
+	lookup := createConsistentLookupMultiColumn(t, "consistent_lookup_multicolumn", false)
+	vc := &loggingVCursor{}
+	vc.AddResult(makeTestResult(1), nil)
+
+	got, err := lookup.Verify(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}}, [][]byte{[]byte("test1")})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true}, got)
+	vc.verifyLog(t, []string{
+		"ExecutePre select toc from t where fromc1 = :fromc1 and fromc2 = :fromc2 and toc = :toc [{fromc1 1} {fromc2 2} {toc test1}] false",
+	})
+}
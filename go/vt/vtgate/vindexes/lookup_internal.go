@@ -38,6 +38,9 @@ type lookupInternal struct {
 	Upsert        bool     `json:"upsert,omitempty"`
 	IgnoreNulls   bool     `json:"ignore_nulls,omitempty"`
 	sel, ver, del string
+	// multiSel and multiVer match on all the from columns.
+	// They are used by the multi-column lookup vindexes.
+	multiSel, multiVer string
 }
 
 func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit, upsert bool) error {
@@ -64,6 +67,8 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 	lkp.sel = fmt.Sprintf("select %s, %s from %s where %s in ::%s", lkp.FromColumns[0], lkp.To, lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0])
 	lkp.ver = fmt.Sprintf("select %s from %s where %s = :%s and %s = :%s", lkp.FromColumns[0], lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0], lkp.To, lkp.To)
 	lkp.del = lkp.initDelStmt()
+	lkp.multiSel = fmt.Sprintf("select %s from %s where %s", lkp.To, lkp.Table, lkp.fromColumnsCondition())
+	lkp.multiVer = fmt.Sprintf("select %s from %s where %s and %s = :%s", lkp.To, lkp.Table, lkp.fromColumnsCondition(), lkp.To, lkp.To)
 	return nil
 }
 
@@ -130,6 +135,157 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value, co vtga
 	return results, nil
 }
 
+// LookupMultiColumn performs a lookup for each row of rowsColValues.
+// Every row must contain a value for each of the from columns.
+func (lkp *lookupInternal) LookupMultiColumn(vcursor VCursor, rowsColValues [][]sqltypes.Value, co vtgatepb.CommitOrder) ([]*sqltypes.Result, error) {
+	if vcursor == nil {
+		return nil, fmt.Errorf("cannot perform lookup: no vcursor provided")
+	}
+	if lkp.Autocommit {
+		co = vtgatepb.CommitOrder_AUTOCOMMIT
+	}
+	forUpdate := ""
+	if vcursor.InTransactionAndIsDML() {
+		forUpdate = " for update"
+	}
+	results := make([]*sqltypes.Result, len(rowsColValues))
+
+	// The rows of integral and binary values are batch queried, and the
+	// results are mapped back to the input order with their from columns.
+	var batch []int
+	for i, row := range rowsColValues {
+		if len(row) != len(lkp.FromColumns) {
+			return nil, fmt.Errorf("lookup.Map: column vindex count does not match the columns in the lookup: %d vs %v", len(row), lkp.FromColumns)
+		}
+		if multiColumnBatchable(row) {
+			batch = append(batch, i)
+		}
+	}
+	if len(batch) != 0 {
+		sel, bindVars := lkp.multiSelBatch(rowsColValues, batch)
+		result, err := vcursor.Execute("VindexLookup", sel+forUpdate, bindVars, false /* rollbackOnError */, co)
+		if err != nil {
+			return nil, fmt.Errorf("lookup.Map: %v", err)
+		}
+		resultMap := make(map[string][][]sqltypes.Value)
+		for _, row := range result.Rows {
+			key := multiColumnKey(row[:len(lkp.FromColumns)])
+			resultMap[key] = append(resultMap[key], []sqltypes.Value{row[len(lkp.FromColumns)]})
+		}
+		for _, i := range batch {
+			results[i] = &sqltypes.Result{Rows: resultMap[multiColumnKey(rowsColValues[i])]}
+		}
+	}
+
+	// The other rows may match values that differ with the collation of
+	// their columns, and are sent one query per row.
+	for i, row := range rowsColValues {
+		if results[i] != nil {
+			continue
+		}
+		bindVars, err := lkp.fromColumnsBindVars(row)
+		if err != nil {
+			return nil, fmt.Errorf("lookup.Map: %v", err)
+		}
+		result, err := vcursor.Execute("VindexLookup", lkp.multiSel+forUpdate, bindVars, false /* rollbackOnError */, co)
+		if err != nil {
+			return nil, fmt.Errorf("lookup.Map: %v", err)
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// multiSelBatch returns the query that looks up the rows of rowsColValues
+// at the batch indexes, with a tuple of bind variables per row.
+func (lkp *lookupInternal) multiSelBatch(rowsColValues [][]sqltypes.Value, batch []int) (string, map[string]*querypb.BindVariable) {
+	bindVars := make(map[string]*querypb.BindVariable, len(batch)*len(lkp.FromColumns))
+	var buf bytes.Buffer
+	buf.WriteString("select ")
+	for _, column := range lkp.FromColumns {
+		buf.WriteString(column + ", ")
+	}
+	fmt.Fprintf(&buf, "%s from %s where (%s) in (", lkp.To, lkp.Table, strings.Join(lkp.FromColumns, ", "))
+	for rowIdx, i := range batch {
+		if rowIdx != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("(")
+		for colIdx, column := range lkp.FromColumns {
+			if colIdx != 0 {
+				buf.WriteString(", ")
+			}
+			name := fmt.Sprintf("%s_%d", column, rowIdx)
+			buf.WriteString(":" + name)
+			bindVars[name] = sqltypes.ValueBindVariable(rowsColValues[i][colIdx])
+		}
+		buf.WriteString(")")
+	}
+	buf.WriteString(")")
+	return buf.String(), bindVars
+}
+
+// multiColumnBatchable returns true if the values of row compare the same
+// in MySQL and in their string form.
+func multiColumnBatchable(row []sqltypes.Value) bool {
+	for _, val := range row {
+		if !val.IsIntegral() && !val.IsBinary() {
+			return false
+		}
+	}
+	return true
+}
+
+// multiColumnKey returns the key of the values of row in the batch
+// lookup results.
+func multiColumnKey(row []sqltypes.Value) string {
+	var buf bytes.Buffer
+	for _, val := range row {
+		s := val.ToString()
+		fmt.Fprintf(&buf, "%d:%s", len(s), s)
+	}
+	return buf.String()
+}
+
+// VerifyMultiColumn returns true for every row of rowsColValues that maps to
+// the corresponding value.
+func (lkp *lookupInternal) VerifyMultiColumn(vcursor VCursor, rowsColValues [][]sqltypes.Value, values []sqltypes.Value) ([]bool, error) {
+	co := vtgatepb.CommitOrder_NORMAL
+	if lkp.Autocommit {
+		co = vtgatepb.CommitOrder_AUTOCOMMIT
+	}
+	return lkp.VerifyMultiColumnCustom(vcursor, rowsColValues, values, co)
+}
+
+// VerifyMultiColumnCustom is VerifyMultiColumn with the specified commit order.
+func (lkp *lookupInternal) VerifyMultiColumnCustom(vcursor VCursor, rowsColValues [][]sqltypes.Value, values []sqltypes.Value, co vtgatepb.CommitOrder) ([]bool, error) {
+	out := make([]bool, len(rowsColValues))
+	for i, row := range rowsColValues {
+		bindVars, err := lkp.fromColumnsBindVars(row)
+		if err != nil {
+			return nil, fmt.Errorf("lookup.Verify: %v", err)
+		}
+		bindVars[lkp.To] = sqltypes.ValueBindVariable(values[i])
+		result, err := vcursor.Execute("VindexVerify", lkp.multiVer, bindVars, false /* rollbackOnError */, co)
+		if err != nil {
+			return nil, fmt.Errorf("lookup.Verify: %v", err)
+		}
+		out[i] = (len(result.Rows) != 0)
+	}
+	return out, nil
+}
+
+func (lkp *lookupInternal) fromColumnsBindVars(row []sqltypes.Value) (map[string]*querypb.BindVariable, error) {
+	if len(row) != len(lkp.FromColumns) {
+		return nil, fmt.Errorf("column vindex count does not match the columns in the lookup: %d vs %v", len(row), lkp.FromColumns)
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(row)+1)
+	for i, val := range row {
+		bindVars[lkp.FromColumns[i]] = sqltypes.ValueBindVariable(val)
+	}
+	return bindVars, nil
+}
+
 // Verify returns true if ids map to values.
 func (lkp *lookupInternal) Verify(vcursor VCursor, ids, values []sqltypes.Value) ([]bool, error) {
 	co := vtgatepb.CommitOrder_NORMAL
@@ -322,16 +478,20 @@ func (lkp *lookupInternal) Update(vcursor VCursor, oldValues []sqltypes.Value, k
 }
 
 func (lkp *lookupInternal) initDelStmt() string {
-	var delBuffer bytes.Buffer
-	fmt.Fprintf(&delBuffer, "delete from %s where ", lkp.Table)
+	return fmt.Sprintf("delete from %s where %s and %s = :%s", lkp.Table, lkp.fromColumnsCondition(), lkp.To, lkp.To)
+}
+
+// fromColumnsCondition returns a condition that matches
+// all the from columns against their bind variables.
+func (lkp *lookupInternal) fromColumnsCondition() string {
+	var buf bytes.Buffer
 	for colIdx, column := range lkp.FromColumns {
 		if colIdx != 0 {
-			delBuffer.WriteString(" and ")
+			buf.WriteString(" and ")
 		}
-		delBuffer.WriteString(column + " = :" + column)
+		buf.WriteString(column + " = :" + column)
 	}
-	delBuffer.WriteString(" and " + lkp.To + " = :" + lkp.To)
-	return delBuffer.String()
+	return buf.String()
 }
 
 func boolFromMap(m map[string]string, key string) (bool, error) {
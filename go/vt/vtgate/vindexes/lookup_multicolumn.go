@@ -0,0 +1,287 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var (
+	_ MultiColumn = (*LookupMultiColumn)(nil)
+	_ Lookup      = (*LookupMultiColumn)(nil)
+	_ MultiColumn = (*LookupUniqueMultiColumn)(nil)
+	_ Lookup      = (*LookupUniqueMultiColumn)(nil)
+)
+
+func init() {
+	Register("lookup_multicolumn", NewLookupMultiColumn)
+	Register("lookup_unique_multicolumn", NewLookupUniqueMultiColumn)
+}
+
+// LookupMultiColumn defines a vindex that uses a lookup table keyed
+// on more than one column. Queries are routed through it only when
+// they supply values for all of its columns.
+// It's NonUnique and a Lookup.
+type LookupMultiColumn struct {
+	name      string
+	writeOnly bool
+	lkp       lookupInternal
+}
+
+// NewLookupMultiColumn creates a LookupMultiColumn vindex.
+// The supplied map has the following required fields:
+//   table: name of the backing table. It can be qualified by the keyspace.
+//   from: list of columns in the table that have the 'from' values of the lookup vindex.
+//   to: The 'to' column name of the table.
+//
+// The following fields are optional:
+//   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
+//   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+func NewLookupMultiColumn(name string, m map[string]string) (Vindex, error) {
+	lookup := &LookupMultiColumn{name: name}
+
+	autocommit, err := boolFromMap(m, "autocommit")
+	if err != nil {
+		return nil, err
+	}
+	lookup.writeOnly, err = boolFromMap(m, "write_only")
+	if err != nil {
+		return nil, err
+	}
+
+	// if autocommit is on for non-unique lookup, upsert should also be on.
+	if err := lookup.lkp.Init(m, autocommit, autocommit /* upsert */); err != nil {
+		return nil, err
+	}
+	if err := validateMultiColumnLookup(name, &lookup.lkp); err != nil {
+		return nil, err
+	}
+	return lookup, nil
+}
+
+// String returns the name of the vindex.
+func (lm *LookupMultiColumn) String() string {
+	return lm.name
+}
+
+// Cost returns the cost of this vindex as 20.
+func (lm *LookupMultiColumn) Cost() int {
+	return 20
+}
+
+// IsUnique returns false since the Vindex is non unique.
+func (lm *LookupMultiColumn) IsUnique() bool {
+	return false
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (lm *LookupMultiColumn) NeedsVCursor() bool {
+	return true
+}
+
+// Map can map rows of column values to key.Destination objects.
+func (lm *LookupMultiColumn) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(rowsColValues))
+	if lm.writeOnly {
+		for range rowsColValues {
+			out = append(out, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}})
+		}
+		return out, nil
+	}
+	results, err := lm.lkp.LookupMultiColumn(vcursor, rowsColValues, vtgatepb.CommitOrder_NORMAL)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		if len(result.Rows) == 0 {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		ksids := make([][]byte, 0, len(result.Rows))
+		for _, row := range result.Rows {
+			ksids = append(ksids, row[0].ToBytes())
+		}
+		out = append(out, key.DestinationKeyspaceIDs(ksids))
+	}
+	return out, nil
+}
+
+// Verify returns true if the rows of column values map to ksids.
+func (lm *LookupMultiColumn) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	if lm.writeOnly {
+		out := make([]bool, len(rowsColValues))
+		for i := range rowsColValues {
+			out[i] = true
+		}
+		return out, nil
+	}
+	return lm.lkp.VerifyMultiColumn(vcursor, rowsColValues, ksidsToValues(ksids))
+}
+
+// Create reserves the id by inserting it into the vindex table.
+func (lm *LookupMultiColumn) Create(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte, ignoreMode bool) error {
+	return lm.lkp.Create(vcursor, rowsColValues, ksidsToValues(ksids), ignoreMode)
+}
+
+// Delete deletes the entry from the vindex table.
+func (lm *LookupMultiColumn) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksid []byte) error {
+	return lm.lkp.Delete(vcursor, rowsColValues, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), vtgatepb.CommitOrder_NORMAL)
+}
+
+// Update updates the entry in the vindex table.
+func (lm *LookupMultiColumn) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, newValues []sqltypes.Value) error {
+	return lm.lkp.Update(vcursor, oldValues, ksid, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), newValues)
+}
+
+// MarshalJSON returns a JSON representation of LookupMultiColumn.
+func (lm *LookupMultiColumn) MarshalJSON() ([]byte, error) {
+	return json.Marshal(lm.lkp)
+}
+
+//====================================================================
+
+// LookupUniqueMultiColumn defines a vindex that uses a lookup table
+// keyed on more than one column. The table is expected to define the
+// from columns as a unique key. It's Unique and a Lookup.
+type LookupUniqueMultiColumn struct {
+	name      string
+	writeOnly bool
+	lkp       lookupInternal
+}
+
+// NewLookupUniqueMultiColumn creates a LookupUniqueMultiColumn vindex.
+// The supplied map has the following required fields:
+//   table: name of the backing table. It can be qualified by the keyspace.
+//   from: list of columns in the table that have the 'from' values of the lookup vindex.
+//   to: The 'to' column name of the table.
+//
+// The following fields are optional:
+//   autocommit: setting this to "true" will cause deletes to be ignored.
+//   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+func NewLookupUniqueMultiColumn(name string, m map[string]string) (Vindex, error) {
+	lu := &LookupUniqueMultiColumn{name: name}
+
+	autocommit, err := boolFromMap(m, "autocommit")
+	if err != nil {
+		return nil, err
+	}
+	lu.writeOnly, err = boolFromMap(m, "write_only")
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't allow upserts for unique vindexes.
+	if err := lu.lkp.Init(m, autocommit, false /* upsert */); err != nil {
+		return nil, err
+	}
+	if err := validateMultiColumnLookup(name, &lu.lkp); err != nil {
+		return nil, err
+	}
+	return lu, nil
+}
+
+// String returns the name of the vindex.
+func (lu *LookupUniqueMultiColumn) String() string {
+	return lu.name
+}
+
+// Cost returns the cost of this vindex as 10.
+func (lu *LookupUniqueMultiColumn) Cost() int {
+	return 10
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (lu *LookupUniqueMultiColumn) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (lu *LookupUniqueMultiColumn) NeedsVCursor() bool {
+	return true
+}
+
+// Map can map rows of column values to key.Destination objects.
+func (lu *LookupUniqueMultiColumn) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(rowsColValues))
+	if lu.writeOnly {
+		for range rowsColValues {
+			out = append(out, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}})
+		}
+		return out, nil
+	}
+	results, err := lu.lkp.LookupMultiColumn(vcursor, rowsColValues, vtgatepb.CommitOrder_NORMAL)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		switch len(result.Rows) {
+		case 0:
+			out = append(out, key.DestinationNone{})
+		case 1:
+			out = append(out, key.DestinationKeyspaceID(result.Rows[0][0].ToBytes()))
+		default:
+			return nil, fmt.Errorf("Lookup.Map: unexpected multiple results from vindex %s: %v", lu.lkp.Table, rowsColValues[i])
+		}
+	}
+	return out, nil
+}
+
+// Verify returns true if the rows of column values map to ksids.
+func (lu *LookupUniqueMultiColumn) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	if lu.writeOnly {
+		out := make([]bool, len(rowsColValues))
+		for i := range rowsColValues {
+			out[i] = true
+		}
+		return out, nil
+	}
+	return lu.lkp.VerifyMultiColumn(vcursor, rowsColValues, ksidsToValues(ksids))
+}
+
+// Create reserves the id by inserting it into the vindex table.
+func (lu *LookupUniqueMultiColumn) Create(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte, ignoreMode bool) error {
+	return lu.lkp.Create(vcursor, rowsColValues, ksidsToValues(ksids), ignoreMode)
+}
+
+// Delete deletes the entry from the vindex table.
+func (lu *LookupUniqueMultiColumn) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksid []byte) error {
+	return lu.lkp.Delete(vcursor, rowsColValues, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), vtgatepb.CommitOrder_NORMAL)
+}
+
+// Update updates the entry in the vindex table.
+func (lu *LookupUniqueMultiColumn) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, newValues []sqltypes.Value) error {
+	return lu.lkp.Update(vcursor, oldValues, ksid, sqltypes.MakeTrusted(sqltypes.VarBinary, ksid), newValues)
+}
+
+// MarshalJSON returns a JSON representation of LookupUniqueMultiColumn.
+func (lu *LookupUniqueMultiColumn) MarshalJSON() ([]byte, error) {
+	return json.Marshal(lu.lkp)
+}
+
+func validateMultiColumnLookup(name string, lkp *lookupInternal) error {
+	if len(lkp.FromColumns) < 2 {
+		return fmt.Errorf("multi-column lookup vindex %s needs at least two from columns: %v", name, lkp.FromColumns)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/key"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func createMultiColumnLookup(t *testing.T, name string) Vindex {
+	t.Helper()
+	l, err := CreateVindex(name, name, map[string]string{
+		"table": "t",
+		"from":  "fromc1, fromc2",
+		"to":    "toc",
+	})
+	require.NoError(t, err)
+	return l
+}
+
+func TestLookupMultiColumnNew(t *testing.T) {
+	_, err := CreateVindex("lookup_multicolumn", "lkp", map[string]string{
+		"table": "t",
+		"from":  "fromc",
+		"to":    "toc",
+	})
+	assert.EqualError(t, err, "multi-column lookup vindex lkp needs at least two from columns: [fromc]")
+
+	l := createMultiColumnLookup(t, "lookup_multicolumn")
+	assert.Equal(t, 20, l.Cost())
+	assert.False(t, l.IsUnique())
+	assert.True(t, l.NeedsVCursor())
+
+	lu := createMultiColumnLookup(t, "lookup_unique_multicolumn")
+	assert.Equal(t, 10, lu.Cost())
+	assert.True(t, lu.IsUnique())
+}
+
+func TestLookupMultiColumnMap(t *testing.T) {
+	l := createMultiColumnLookup(t, "lookup_multicolumn")
+	vc := &vcursor{
+		result: sqltypes.MakeTestResult(sqltypes.MakeTestFields("toc", "varbinary"), "1", "2"),
+	}
+
+	got, err := l.(MultiColumn).Map(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")},
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceIDs([][]byte{[]byte("1"), []byte("2")}),
+	}
+	assert.Equal(t, want, got)
+
+	wantqueries := []*querypb.BoundQuery{{
+		Sql: "select toc from t where fromc1 = :fromc1 and fromc2 = :fromc2",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc1": sqltypes.Int64BindVariable(1),
+			"fromc2": sqltypes.ValueBindVariable(sqltypes.NewVarChar("a")),
+		},
+	}}
+	utils.MustMatch(t, wantqueries, vc.queries)
+
+	_, err = l.(MultiColumn).Map(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}})
+	assert.EqualError(t, err, "lookup.Map: column vindex count does not match the columns in the lookup: 1 vs [fromc1 fromc2]")
+}
+
+func TestLookupMultiColumnMapBatch(t *testing.T) {
+	l := createMultiColumnLookup(t, "lookup_multicolumn")
+	vc := &vcursor{
+		result: sqltypes.MakeTestResult(sqltypes.MakeTestFields("fromc1|fromc2|toc", "int64|varbinary|varbinary"), "1|a|1", "3|b|3", "1|a|2"),
+	}
+
+	got, err := l.(MultiColumn).Map(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarBinary("a")},
+		{sqltypes.NewInt64(2), sqltypes.NewVarBinary("a")},
+		{sqltypes.NewInt64(3), sqltypes.NewVarBinary("b")},
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceIDs([][]byte{[]byte("1"), []byte("2")}),
+		key.DestinationNone{},
+		key.DestinationKeyspaceIDs([][]byte{[]byte("3")}),
+	}
+	assert.Equal(t, want, got)
+
+	wantqueries := []*querypb.BoundQuery{{
+		Sql: "select fromc1, fromc2, toc from t where (fromc1, fromc2) in ((:fromc1_0, :fromc2_0), (:fromc1_1, :fromc2_1), (:fromc1_2, :fromc2_2))",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc1_0": sqltypes.Int64BindVariable(1),
+			"fromc2_0": sqltypes.BytesBindVariable([]byte("a")),
+			"fromc1_1": sqltypes.Int64BindVariable(2),
+			"fromc2_1": sqltypes.BytesBindVariable([]byte("a")),
+			"fromc1_2": sqltypes.Int64BindVariable(3),
+			"fromc2_2": sqltypes.BytesBindVariable([]byte("b")),
+		},
+	}}
+	utils.MustMatch(t, wantqueries, vc.queries)
+}
+
+func TestLookupUniqueMultiColumnMap(t *testing.T) {
+	lu := createMultiColumnLookup(t, "lookup_unique_multicolumn")
+	vc := &vcursor{
+		result: sqltypes.MakeTestResult(sqltypes.MakeTestFields("fromc1|fromc2|toc", "int64|int64|varbinary"), "1|2|1"),
+	}
+	got, err := lu.(MultiColumn).Map(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceID([]byte("1"))}, got)
+
+	vc.result = sqltypes.MakeTestResult(sqltypes.MakeTestFields("fromc1|fromc2|toc", "int64|int64|varbinary"))
+	got, err = lu.(MultiColumn).Map(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationNone{}}, got)
+
+	vc.result = sqltypes.MakeTestResult(sqltypes.MakeTestFields("fromc1|fromc2|toc", "int64|int64|varbinary"), "1|2|1", "1|2|2")
+	_, err = lu.(MultiColumn).Map(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
+	})
+	assert.EqualError(t, err, "Lookup.Map: unexpected multiple results from vindex t: [INT64(1) INT64(2)]")
+}
+
+func TestLookupMultiColumnVerify(t *testing.T) {
+	l := createMultiColumnLookup(t, "lookup_multicolumn")
+	vc := &vcursor{numRows: 1}
+
+	got, err := l.(MultiColumn).Verify(vc,
+		[][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}},
+		[][]byte{[]byte("test")})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true}, got)
+
+	wantqueries := []*querypb.BoundQuery{{
+		Sql: "select toc from t where fromc1 = :fromc1 and fromc2 = :fromc2 and toc = :toc",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc1": sqltypes.Int64BindVariable(1),
+			"fromc2": sqltypes.Int64BindVariable(2),
+			"toc":    sqltypes.BytesBindVariable([]byte("test")),
+		},
+	}}
+	utils.MustMatch(t, wantqueries, vc.queries)
+}
+
+func TestLookupMultiColumnCreateDelete(t *testing.T) {
+	l := createMultiColumnLookup(t, "lookup_multicolumn")
+	vc := &vcursor{}
+
+	err := l.(Lookup).Create(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
+	}, [][]byte{[]byte("test")}, false /* ignoreMode */)
+	require.NoError(t, err)
+
+	err = l.(Lookup).Delete(vc, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
+	}, []byte("test"))
+	require.NoError(t, err)
+
+	wantqueries := []*querypb.BoundQuery{{
+		Sql: "insert into t(fromc1, fromc2, toc) values(:fromc1_0, :fromc2_0, :toc_0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc1_0": sqltypes.Int64BindVariable(1),
+			"fromc2_0": sqltypes.Int64BindVariable(2),
+			"toc_0":    sqltypes.BytesBindVariable([]byte("test")),
+		},
+	}, {
+		Sql: "delete from t where fromc1 = :fromc1 and fromc2 = :fromc2 and toc = :toc",
+		BindVariables: map[string]*querypb.BindVariable{
+			"fromc1": sqltypes.Int64BindVariable(1),
+			"fromc2": sqltypes.Int64BindVariable(2),
+			"toc":    sqltypes.BytesBindVariable([]byte("test")),
+		},
+	}}
+	utils.MustMatch(t, wantqueries, vc.queries)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var (
+	_ SingleColumn = (*NumericTopoMap)(nil)
+	_ WantKeyspace = (*NumericTopoMap)(nil)
+)
+
+const (
+	// NumericTopoMapNameParam is the vindex param that names the mapping
+	// of a NumericTopoMap vindex in the tenant mappings of the topo.
+	// It defaults to the name of the vindex.
+	NumericTopoMapNameParam = "mapping_name"
+
+	// NumericTopoMapFallbackParam is the vindex param that names the
+	// vindex type used for ids that are not in the mapping.
+	NumericTopoMapFallbackParam = "fallback_type"
+)
+
+func init() {
+	Register("numeric_topo_map", NewNumericTopoMap)
+}
+
+// numericTopoMappings are the mappings of the NumericTopoMap vindexes,
+// by keyspace and mapping name, as last read from the topo.
+var numericTopoMappings struct {
+	mu       sync.RWMutex
+	mappings map[string]map[string]NumericLookupTable
+}
+
+// SetNumericTopoMappings replaces the mappings used by the NumericTopoMap
+// vindexes, by keyspace and mapping name. vtgate calls it every time the
+// tenant mappings change in the topo.
+func SetNumericTopoMappings(mappings map[string]map[string]NumericLookupTable) {
+	numericTopoMappings.mu.Lock()
+	defer numericTopoMappings.mu.Unlock()
+	numericTopoMappings.mappings = mappings
+}
+
+func numericTopoMapping(keyspace, name string) NumericLookupTable {
+	numericTopoMappings.mu.RLock()
+	defer numericTopoMappings.mu.RUnlock()
+	return numericTopoMappings.mappings[keyspace][name]
+}
+
+// NumericTopoMap is similar to NumericStaticMap, but its mapping is
+// stored in the tenant mappings of the topo instead of a local file.
+// vtgates watch the tenant mappings of their cell, so the mapping can be
+// changed at runtime without rebuilding the VSchema. The mappings are
+// scoped by the keyspace of the vindex.
+// Ids that are not in the mapping are mapped by the fallback vindex,
+// which is numeric by default.
+type NumericTopoMap struct {
+	name        string
+	keyspace    string
+	mappingName string
+	fallback    SingleColumn
+}
+
+// NewNumericTopoMap creates a NumericTopoMap vindex.
+// The supplied map has the following optional fields:
+//   mapping_name: name of the mapping in the topo. Defaults to the name of the vindex.
+//   fallback_type: type of the vindex used for unmapped ids. Defaults to numeric.
+func NewNumericTopoMap(name string, params map[string]string) (Vindex, error) {
+	mappingName := params[NumericTopoMapNameParam]
+	if mappingName == "" {
+		mappingName = name
+	}
+	fallbackType := params[NumericTopoMapFallbackParam]
+	if fallbackType == "" {
+		fallbackType = "numeric"
+	}
+	fallback, err := CreateVindex(fallbackType, name+"_fallback", nil)
+	if err != nil {
+		return nil, fmt.Errorf("NumericTopoMap: %v", err)
+	}
+	single, ok := fallback.(SingleColumn)
+	if !ok || fallback.NeedsVCursor() || !fallback.IsUnique() {
+		return nil, fmt.Errorf("NumericTopoMap: fallback vindex type %s must be a unique single column functional vindex", fallbackType)
+	}
+	return &NumericTopoMap{
+		name:        name,
+		mappingName: mappingName,
+		fallback:    single,
+	}, nil
+}
+
+// SetKeyspace implements the WantKeyspace interface.
+func (vind *NumericTopoMap) SetKeyspace(keyspace string) {
+	vind.keyspace = keyspace
+}
+
+// MappingName returns the name of the mapping of the vindex in the topo.
+func (vind *NumericTopoMap) MappingName() string {
+	return vind.mappingName
+}
+
+// String returns the name of the vindex.
+func (vind *NumericTopoMap) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (*NumericTopoMap) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (vind *NumericTopoMap) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (vind *NumericTopoMap) NeedsVCursor() bool {
+	return false
+}
+
+// Verify returns true if ids and ksids match.
+func (vind *NumericTopoMap) Verify(vcursor VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	destinations, err := vind.Map(vcursor, ids)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]bool, len(ids))
+	for i, dest := range destinations {
+		ksid, ok := dest.(key.DestinationKeyspaceID)
+		out[i] = ok && bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *NumericTopoMap) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	return vind.MapWithMapping(vcursor, numericTopoMapping(vind.keyspace, vind.mappingName), ids)
+}
+
+// MapWithMapping maps ids to key.Destination objects with the provided
+// mapping instead of the one read by vtgate.
+func (vind *NumericTopoMap) MapWithMapping(vcursor VCursor, lookup NumericLookupTable, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, len(ids))
+	var unmapped []sqltypes.Value
+	var unmappedIndexes []int
+	for i, id := range ids {
+		num, err := evalengine.ToUint64(id)
+		if err != nil {
+			out[i] = key.DestinationNone{}
+			continue
+		}
+		lookupNum, ok := lookup[num]
+		if !ok {
+			unmapped = append(unmapped, id)
+			unmappedIndexes = append(unmappedIndexes, i)
+			continue
+		}
+		var keybytes [8]byte
+		binary.BigEndian.PutUint64(keybytes[:], lookupNum)
+		out[i] = key.DestinationKeyspaceID(keybytes[:])
+	}
+	if len(unmapped) == 0 {
+		return out, nil
+	}
+	fallback, err := vind.fallback.Map(vcursor, unmapped)
+	if err != nil {
+		return nil, err
+	}
+	for i, dest := range fallback {
+		out[unmappedIndexes[i]] = dest
+	}
+	return out, nil
+}
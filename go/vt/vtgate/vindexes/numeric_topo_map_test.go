@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func createNumericTopoMap(t *testing.T, params map[string]string) SingleColumn {
+	t.Helper()
+	vindex, err := CreateVindex("numeric_topo_map", "numericTopoMap", params)
+	require.NoError(t, err)
+	vindex.(WantKeyspace).SetKeyspace("ks")
+	return vindex.(SingleColumn)
+}
+
+func TestNumericTopoMapInfo(t *testing.T) {
+	numericTopoMap := createNumericTopoMap(t, nil)
+	assert.Equal(t, 1, numericTopoMap.Cost())
+	assert.Equal(t, "numericTopoMap", numericTopoMap.String())
+	assert.True(t, numericTopoMap.IsUnique())
+	assert.False(t, numericTopoMap.NeedsVCursor())
+}
+
+// setNumericTopoMappings sets the mappings of the ks keyspace.
+func setNumericTopoMappings(t *testing.T, mappings map[string]NumericLookupTable) {
+	t.Helper()
+	SetNumericTopoMappings(map[string]map[string]NumericLookupTable{"ks": mappings})
+	t.Cleanup(func() { SetNumericTopoMappings(nil) })
+}
+
+func TestNumericTopoMapNew(t *testing.T) {
+	vindex, err := CreateVindex("numeric_topo_map", "numericTopoMap", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "numericTopoMap", vindex.(*NumericTopoMap).MappingName())
+
+	vindex, err = CreateVindex("numeric_topo_map", "numericTopoMap", map[string]string{
+		"mapping_name": "tenants",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tenants", vindex.(*NumericTopoMap).MappingName())
+
+	_, err = CreateVindex("numeric_topo_map", "numericTopoMap", map[string]string{
+		"fallback_type": "lookup",
+	})
+	assert.EqualError(t, err, "NumericTopoMap: fallback vindex type lookup must be a unique single column functional vindex")
+}
+
+func TestNumericTopoMapMap(t *testing.T) {
+	setNumericTopoMappings(t, map[string]NumericLookupTable{
+		"numericTopoMap": {3: 2, 5: 9223372036854775808},
+	})
+	numericTopoMap := createNumericTopoMap(t, nil)
+	got, err := numericTopoMap.Map(nil, []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(3),
+		sqltypes.NewFloat64(1.1),
+		sqltypes.NewInt64(5),
+		sqltypes.NULL,
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x01")),
+		key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x02")),
+		key.DestinationNone{},
+		key.DestinationKeyspaceID([]byte("\x80\x00\x00\x00\x00\x00\x00\x00")),
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestNumericTopoMapFallback(t *testing.T) {
+	setNumericTopoMappings(t, map[string]NumericLookupTable{
+		"numericTopoMap": {1: 1},
+	})
+	numericTopoMap := createNumericTopoMap(t, map[string]string{
+		"fallback_type": "hash",
+	})
+	got, err := numericTopoMap.Map(nil, []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(2),
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x01")),
+		key.DestinationKeyspaceID([]byte("\x06\xe7\xea\"Βp\x8f")),
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestNumericTopoMapVerify(t *testing.T) {
+	setNumericTopoMappings(t, map[string]NumericLookupTable{
+		"numericTopoMap": {1: 2},
+	})
+	numericTopoMap := createNumericTopoMap(t, nil)
+	got, err := numericTopoMap.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(1)},
+		[][]byte{
+			[]byte("\x00\x00\x00\x00\x00\x00\x00\x02"),
+			[]byte("\x00\x00\x00\x00\x00\x00\x00\x02"),
+			[]byte("\x00\x00\x00\x00\x00\x00\x00\x01"),
+		})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true, false}, got)
+}
+
+func TestNumericTopoMapMappingChange(t *testing.T) {
+	numericTopoMap := createNumericTopoMap(t, map[string]string{
+		"mapping_name": "tenants",
+	})
+	ids := []sqltypes.Value{sqltypes.NewInt64(1)}
+
+	// Without a mapping, the fallback vindex is used.
+	got, err := numericTopoMap.Map(nil, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x01"))}, got)
+
+	// The vindex picks up the mapping when it changes.
+	setNumericTopoMappings(t, map[string]NumericLookupTable{
+		"numericTopoMap": {1: 3},
+		"tenants":        {1: 2},
+	})
+	got, err = numericTopoMap.Map(nil, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x02"))}, got)
+
+	got, err = numericTopoMap.(*NumericTopoMap).MapWithMapping(nil, NumericLookupTable{1: 4}, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x04"))}, got)
+}
+
+func TestNumericTopoMapKeyspaces(t *testing.T) {
+	vschema, err := BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {Sharded: true, Vindexes: map[string]*vschemapb.Vindex{"tenants": {Type: "numeric_topo_map"}}},
+			"ks2": {Sharded: true, Vindexes: map[string]*vschemapb.Vindex{"tenants": {Type: "numeric_topo_map"}}},
+		},
+	})
+	require.NoError(t, err)
+	SetNumericTopoMappings(map[string]map[string]NumericLookupTable{
+		"ks1": {"tenants": {1: 2}},
+		"ks2": {"tenants": {1: 3}},
+	})
+	defer SetNumericTopoMappings(nil)
+
+	ids := []sqltypes.Value{sqltypes.NewInt64(1)}
+	got, err := vschema.Keyspaces["ks1"].Vindexes["tenants"].(SingleColumn).Map(nil, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x02"))}, got)
+	got, err = vschema.Keyspaces["ks2"].Vindexes["tenants"].(SingleColumn).Map(nil, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x03"))}, got)
+}
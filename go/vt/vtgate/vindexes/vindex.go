@@ -127,6 +127,12 @@ type WantOwnerInfo interface {
 	SetOwnerInfo(keyspace, table string, cols []sqlparser.ColIdent) error
 }
 
+// WantKeyspace defines the interface that a vindex must satisfy
+// to be told the keyspace it is defined in.
+type WantKeyspace interface {
+	SetKeyspace(keyspace string)
+}
+
 // A NewVindexFunc is a function that creates a Vindex based on the
 // properties specified in the input map. Every vindex must
 // register a NewVindexFunc under a unique vindexType.
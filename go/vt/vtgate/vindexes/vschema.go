@@ -57,6 +57,7 @@ type VSchema struct {
 	RoutingRules   map[string]*RoutingRule `json:"routing_rules"`
 	uniqueTables   map[string]*Table
 	uniqueVindexes map[string]Vindex
+	uniqueViews    map[string]sqlparser.SelectStatement
 	Keyspaces      map[string]*KeyspaceSchema `json:"keyspaces"`
 }
 
@@ -88,8 +89,10 @@ type Table struct {
 	Owned                   []*ColumnVindex      `json:"owned,omitempty"`
 	AutoIncrement           *AutoIncrement       `json:"auto_increment,omitempty"`
 	Columns                 []Column             `json:"columns,omitempty"`
+	TrackedColumns          []Column             `json:"tracked_columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	ResultCache             bool                 `json:"result_cache,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -129,6 +132,7 @@ type KeyspaceSchema struct {
 	Keyspace *Keyspace
 	Tables   map[string]*Table
 	Vindexes map[string]Vindex
+	Views    map[string]sqlparser.SelectStatement
 	Error    error
 }
 
@@ -138,11 +142,22 @@ func (ks *KeyspaceSchema) MarshalJSON() ([]byte, error) {
 		Sharded  bool              `json:"sharded,omitempty"`
 		Tables   map[string]*Table `json:"tables,omitempty"`
 		Vindexes map[string]Vindex `json:"vindexes,omitempty"`
+		Views    map[string]string `json:"views,omitempty"`
 		Error    string            `json:"error,omitempty"`
 	}{
 		Sharded:  ks.Keyspace.Sharded,
 		Tables:   ks.Tables,
 		Vindexes: ks.Vindexes,
+		Views: func(ks *KeyspaceSchema) map[string]string {
+			if len(ks.Views) == 0 {
+				return nil
+			}
+			views := make(map[string]string, len(ks.Views))
+			for name, view := range ks.Views {
+				views[name] = sqlparser.String(view)
+			}
+			return views
+		}(ks),
 		Error: func(ks *KeyspaceSchema) string {
 			if ks.Error == nil {
 				return ""
@@ -214,6 +229,9 @@ func buildKeyspaces(source *vschemapb.SrvVSchema, vschema *VSchema) {
 		}
 		vschema.Keyspaces[ksname] = ksvschema
 		ksvschema.Error = buildTables(ks, vschema, ksvschema)
+		if ksvschema.Error == nil {
+			ksvschema.Error = buildViews(ks, vschema, ksvschema)
+		}
 	}
 }
 
@@ -224,6 +242,9 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 		if err != nil {
 			return err
 		}
+		if setter, ok := vindex.(WantKeyspace); ok {
+			setter.SetKeyspace(keyspace.Name)
+		}
 
 		// If the keyspace requires explicit routing, don't include it in global routing
 		if !ks.RequireExplicitRouting {
@@ -240,6 +261,7 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			Name:                    sqlparser.NewTableIdent(tname),
 			Keyspace:                keyspace,
 			ColumnListAuthoritative: table.ColumnListAuthoritative,
+			ResultCache:             table.ResultCache,
 		}
 		switch table.Type {
 		case "", TypeReference:
@@ -343,6 +365,60 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 	return nil
 }
 
+// buildViews parses the views of the keyspace.
+func buildViews(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSchema) error {
+	for vname, query := range ks.Views {
+		if _, ok := ksvschema.Tables[vname]; ok {
+			return fmt.Errorf("view %s has the same name as a table", vname)
+		}
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return fmt.Errorf("cannot parse view %s: %s", vname, err.Error())
+		}
+		view, ok := stmt.(sqlparser.SelectStatement)
+		if !ok {
+			return fmt.Errorf("view %s is not a select statement: %s", vname, query)
+		}
+		QualifyViewTables(view, ksvschema.Keyspace.Name)
+		if ksvschema.Views == nil {
+			ksvschema.Views = make(map[string]sqlparser.SelectStatement)
+		}
+		ksvschema.Views[vname] = view
+
+		// If the keyspace requires explicit routing, don't include it in global routing
+		if ks.RequireExplicitRouting {
+			continue
+		}
+		if vschema.uniqueViews == nil {
+			vschema.uniqueViews = make(map[string]sqlparser.SelectStatement)
+		}
+		if _, ok := vschema.uniqueViews[vname]; ok {
+			vschema.uniqueViews[vname] = nil
+		} else {
+			vschema.uniqueViews[vname] = view
+		}
+	}
+	return nil
+}
+
+// QualifyViewTables qualifies the tables referenced by a view without a
+// keyspace qualifier with the keyspace of the view, so that the view does
+// not depend on the keyspace targeted by the session which uses it.
+func QualifyViewTables(view sqlparser.SelectStatement, keyspace string) {
+	_ = sqlparser.Rewrite(view, func(cursor *sqlparser.Cursor) bool {
+		node, ok := cursor.Node().(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true
+		}
+		tableName, ok := node.Expr.(sqlparser.TableName)
+		if ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() != "dual" {
+			tableName.Qualifier = sqlparser.NewTableIdent(keyspace)
+			node.Expr = tableName
+		}
+		return true
+	}, nil)
+}
+
 func resolveAutoIncrement(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	for ksname, ks := range source.Keyspaces {
 		ksvschema := vschema.Keyspaces[ksname]
@@ -536,6 +612,28 @@ func (vschema *VSchema) FindTableOrVindex(keyspace, name string, tabletType topo
 	return nil, nil, NotFoundError{TableName: name}
 }
 
+// FindView returns a copy of the select statement of a view, or nil if the
+// view does not exist. If the keyspace is empty, the view must be unique
+// across all keyspaces.
+func (vschema *VSchema) FindView(keyspace, viewName string) sqlparser.SelectStatement {
+	var view sqlparser.SelectStatement
+	if keyspace == "" {
+		view = vschema.uniqueViews[viewName]
+	} else if ks, ok := vschema.Keyspaces[keyspace]; ok {
+		view = ks.Views[viewName]
+	}
+	if view == nil {
+		return nil
+	}
+	// The view is parsed again rather than cloned, because the clone of a
+	// statement is not always formatted like the original statement.
+	stmt, err := sqlparser.Parse(sqlparser.String(view))
+	if err != nil {
+		return nil
+	}
+	return stmt.(sqlparser.SelectStatement)
+}
+
 // NotFoundError represents the error where the table name was not found
 type NotFoundError struct {
 	TableName string
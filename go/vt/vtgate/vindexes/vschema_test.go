@@ -2205,6 +2205,74 @@ func TestFindTable(t *testing.T) {
 	}
 }
 
+func TestFindView(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ksa": {
+				Tables: map[string]*vschemapb.Table{
+					"ta": {},
+				},
+				Views: map[string]string{
+					"va": "select id from ta join ksb.tb on ta.id = tb.id",
+					"v1": "select 1 from dual",
+				},
+			},
+			"ksb": {
+				Views: map[string]string{
+					"v1": "select 2 from dual",
+				},
+			},
+			"ksc": {
+				RequireExplicitRouting: true,
+				Views: map[string]string{
+					"vc": "select 3 from dual",
+				},
+			},
+		},
+	}
+	vschema, err := BuildVSchema(&input)
+	require.NoError(t, err)
+	for _, ks := range vschema.Keyspaces {
+		require.NoError(t, ks.Error)
+	}
+
+	// The tables of the views are qualified with the keyspace of the view.
+	assert.Equal(t, "select id from ksa.ta join ksb.tb on ta.id = tb.id", sqlparser.String(vschema.FindView("", "va")))
+	assert.Equal(t, "select id from ksa.ta join ksb.tb on ta.id = tb.id", sqlparser.String(vschema.FindView("ksa", "va")))
+	assert.Nil(t, vschema.FindView("ksb", "va"))
+	assert.Nil(t, vschema.FindView("none", "va"))
+
+	// Ambiguous views and views of keyspaces which require explicit
+	// routing must be qualified.
+	assert.Nil(t, vschema.FindView("", "v1"))
+	assert.Equal(t, "select 2 from dual", sqlparser.String(vschema.FindView("ksb", "v1")))
+	assert.Nil(t, vschema.FindView("", "vc"))
+	assert.Equal(t, "select 3 from dual", sqlparser.String(vschema.FindView("ksc", "vc")))
+
+	// The returned views can be modified.
+	vschema.FindView("ksb", "v1").(*sqlparser.Select).From = nil
+	assert.Equal(t, "select 2 from dual", sqlparser.String(vschema.FindView("ksb", "v1")))
+}
+
+func TestBuildVSchemaViewErrors(t *testing.T) {
+	testCases := []struct {
+		views map[string]string
+		err   string
+	}{
+		{map[string]string{"t1": "select 1 from dual"}, "view t1 has the same name as a table"},
+		{map[string]string{"v1": "select from"}, "cannot parse view v1: syntax error at position 12 near 'from'"},
+		{map[string]string{"v1": "delete from t1"}, "view v1 is not a select statement: delete from t1"},
+	}
+	for _, tc := range testCases {
+		ks, err := BuildKeyspaceSchema(&vschemapb.Keyspace{
+			Tables: map[string]*vschemapb.Table{"t1": {}},
+			Views:  tc.views,
+		}, "ks")
+		assert.EqualError(t, err, tc.err)
+		assert.EqualError(t, ks.Error, tc.err)
+	}
+}
+
 func TestFindTableOrVindex(t *testing.T) {
 	input := vschemapb.SrvVSchema{
 		RoutingRules: &vschemapb.RoutingRules{
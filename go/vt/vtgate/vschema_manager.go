@@ -25,9 +25,13 @@ import (
 
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var _ VSchemaOperator = (*VSchemaManager)(nil)
@@ -38,6 +42,61 @@ type VSchemaManager struct {
 	e                 *Executor
 	mu                sync.Mutex
 	currentSrvVschema *vschemapb.SrvVSchema
+	schema            SchemaInfo
+
+	// validator checks the existing rows against the primary vindexes
+	// changed by the vschema DDLs, if set.
+	validator *vschemaValidator
+}
+
+// vschemaValidator reads the rows of the shards to validate the primary
+// vindex changes of the vschema DDLs.
+type vschemaValidator struct {
+	tmc        tmclient.TabletManagerClient
+	sampleRows int
+	// reportOnly returns the misplaced rows as a warning instead of
+	// refusing the change.
+	reportOnly bool
+}
+
+func newVSchemaValidator(tmc tmclient.TabletManagerClient, sampleRows int, reportOnly bool) *vschemaValidator {
+	return &vschemaValidator{tmc: tmc, sampleRows: sampleRows, reportOnly: reportOnly}
+}
+
+// ValidateVSchemaDDL refuses the change of oldKS into newKS if existing
+// rows would be on the wrong shard with the primary vindexes of newKS.
+// In report-only mode, the change is not refused and the report of the
+// misplaced rows is returned as a warning instead.
+func (vm *VSchemaManager) ValidateVSchemaDDL(ctx context.Context, ksName string, oldKS, newKS *vschemapb.Keyspace) (string, error) {
+	if vm.validator == nil {
+		return "", nil
+	}
+	ts, err := vm.e.serv.GetTopoServer()
+	if err != nil {
+		return "", err
+	}
+	if oldKS == nil {
+		oldKS = &vschemapb.Keyspace{}
+	}
+	report, err := topotools.ValidateVSchemaChange(ctx, ts, vm.validator.tmc, ksName, oldKS, newKS, vm.validator.sampleRows)
+	if err != nil {
+		return "", vterrors.Wrapf(err, "cannot validate the existing rows against the new vschema")
+	}
+	misplaced := report.MisplacedRows()
+	if misplaced == 0 {
+		return "", nil
+	}
+	if vm.validator.reportOnly {
+		log.Infof("Applying vschema change with misplaced rows: %s", report)
+		return fmt.Sprintf("%d existing rows are on the wrong shard with the new vschema: %s", misplaced, report), nil
+	}
+	log.Infof("Refusing vschema change: %s", report)
+	return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%d existing rows would be on the wrong shard with the new vschema, not applying it: %s", misplaced, report)
+}
+
+// SchemaInfo is the interface to the tracked table schemas.
+type SchemaInfo interface {
+	Tables(ks string) map[string][]vindexes.Column
 }
 
 //GetCurrentVschema return the denormalized VSchema from SrvVSchema
@@ -46,7 +105,12 @@ func (vm *VSchemaManager) GetCurrentVschema() (*vindexes.VSchema, error) {
 	if srvVschema == nil {
 		return nil, nil
 	}
-	return vindexes.BuildVSchema(srvVschema)
+	vschema, err := vindexes.BuildVSchema(srvVschema)
+	if err != nil {
+		return nil, err
+	}
+	vm.updateFromSchema(vschema)
+	return vschema, nil
 }
 
 // GetCurrentSrvVschema returns a copy of the latest SrvVschema from the
@@ -102,6 +166,8 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 				if vschemaCounters != nil {
 					vschemaCounters.Add("Parsing", 1)
 				}
+			} else {
+				vm.updateFromSchema(vschema)
 			}
 		}
 		if v == nil {
@@ -131,6 +197,71 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 	})
 }
 
+// setSchema sets the tracked table schemas used to build the VSchema.
+func (vm *VSchemaManager) setSchema(schema SchemaInfo) {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	vm.schema = schema
+}
+
+// TrackedTables returns the columns of the tables of a keyspace known by
+// the schema tracker, or nil if the schemas are not tracked.
+func (vm *VSchemaManager) TrackedTables(ks string) map[string][]vindexes.Column {
+	vm.mu.Lock()
+	schema := vm.schema
+	vm.mu.Unlock()
+	if schema == nil {
+		return nil
+	}
+	return schema.Tables(ks)
+}
+
+// Rebuild rebuilds the VSchema from the latest SrvVSchema and the tracked
+// table schemas. It is called when the schema of some tables changed.
+func (vm *VSchemaManager) Rebuild() {
+	v := vm.GetCurrentSrvVschema()
+	if v == nil {
+		return
+	}
+	vschema, err := vindexes.BuildVSchema(v)
+	if err != nil {
+		log.Warningf("Error creating VSchema after a schema change: %v", err)
+		return
+	}
+	vm.updateFromSchema(vschema)
+	vm.e.SaveVSchema(vschema, NewVSchemaStats(vschema, ""))
+	log.Infof("Rebuilt the VSchema after a schema change")
+}
+
+// updateFromSchema sets the tracked columns of the tables of the VSchema
+// whose column list is not authoritative, so that the gen4 planner can
+// expand the stars and resolve the columns of these tables. The column
+// lists of the VSchema are left untouched.
+func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
+	vm.mu.Lock()
+	schema := vm.schema
+	vm.mu.Unlock()
+	if schema == nil {
+		return
+	}
+	for ksName, ks := range vschema.Keyspaces {
+		tables := schema.Tables(ksName)
+		if len(tables) == 0 {
+			continue
+		}
+		for name, table := range ks.Tables {
+			if table.ColumnListAuthoritative || table.Type == vindexes.TypeSequence {
+				continue
+			}
+			columns, ok := tables[name]
+			if !ok {
+				continue
+			}
+			table.TrackedColumns = columns
+		}
+	}
+}
+
 // UpdateVSchema propagates the updated vschema to the topo. The entry for
 // the given keyspace is updated in the global topo, and the full SrvVSchema
 // is updated in all known cells.
@@ -42,6 +42,8 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
@@ -82,6 +84,20 @@ var (
 	// lockHeartbeatTime is used to set the next heartbeat time.
 	lockHeartbeatTime = flag.Duration("lock_heartbeat_time", 5*time.Second, "If there is lock function used. This will keep the lock connection active by using this heartbeat")
 	warnShardedOnly   = flag.Bool("warn_sharded_only", false, "If any features that are only available in unsharded mode are used, query execution warnings will be added to the session")
+
+	// enableViews stores the views in the VSchema and expands them in vtgate.
+	enableViews = flag.Bool("enable_views", false, "Store the views created through vtgate in the VSchema instead of the shards, and expand them in vtgate when planning queries, so that views over sharded tables return the rows of all the shards")
+
+	// emulateInformationSchema answers information_schema queries from the schemas of all the keyspaces.
+	emulateInformationSchema = flag.Bool("emulate_information_schema", false, "Answer simple selects on information_schema.tables, columns and statistics from the schemas of all the shards of all the keyspaces, with the table statistics summed over the shards and the schema names rewritten to the keyspace names")
+
+	// enableSchemaChangeSignal tracks the table schemas from the schema changes signalled by the tablets.
+	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Track the columns of the tables from the master tablets, which signal the tables whose schema changed when they run with -queryserver-config-schema-change-signal, and use them to plan the queries on the tables without an authoritative column list in the VSchema")
+
+	// vschemaDDLValidateData validates the existing rows against the primary vindexes changed by vschema DDLs.
+	vschemaDDLValidateData       = flag.Bool("vschema_ddl_validate_data", false, "Check that the existing rows of the tables whose primary vindex is changed by an ALTER VSCHEMA are on the shards the new vindex maps them to, and refuse the change otherwise")
+	vschemaDDLValidateSampleRows = flag.Int("vschema_ddl_validate_sample_rows", 10000, "Number of rows per shard and table read by -vschema_ddl_validate_data. 0 reads the whole table, in batches ordered by its primary key.")
+	vschemaDDLValidateReportOnly = flag.Bool("vschema_ddl_validate_report_only", false, "With -vschema_ddl_validate_data, apply the vschema change even if existing rows would be on the wrong shard, and return the validation report as a warning instead.")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -243,6 +259,16 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 
 	initAPI(gw.hc)
 
+	if *vschemaDDLValidateData {
+		rpcVTGate.executor.vm.validator = newVSchemaValidator(tmclient.NewTabletManagerClient(), *vschemaDDLValidateSampleRows, *vschemaDDLValidateReportOnly)
+	}
+
+	if *enableSchemaChangeSignal {
+		st := vtschema.NewTracker(gw.hc.Subscribe(), tmclient.NewTabletManagerClient())
+		rpcVTGate.executor.startSchemaTracking(st)
+		servenv.OnTerm(st.Stop)
+	}
+
 	return rpcVTGate
 }
 
@@ -281,7 +281,7 @@ func (client *QueryClient) ExecuteBatch(queries []*querypb.BoundQuery, asTransac
 
 // MessageStream streams messages from the message table.
 func (client *QueryClient) MessageStream(name string, callback func(*sqltypes.Result) error) (err error) {
-	return client.server.MessageStream(client.ctx, &client.target, name, callback)
+	return client.server.MessageStream(client.ctx, &client.target, name, "", callback)
 }
 
 // MessageAck acks messages
@@ -293,7 +293,7 @@ func (client *QueryClient) MessageAck(name string, ids []string) (int64, error)
 			Value: []byte(id),
 		})
 	}
-	return client.server.MessageAck(client.ctx, &client.target, name, bids)
+	return client.server.MessageAck(client.ctx, &client.target, name, "", bids)
 }
 
 // ReserveExecute performs a ReserveExecute.
@@ -93,6 +93,11 @@ func (client *FakeTabletManagerClient) GetPermissions(ctx context.Context, table
 	return &tabletmanagerdatapb.Permissions{}, nil
 }
 
+// GetHotRows is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetHotRows(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.HotRow, error) {
+	return nil, nil
+}
+
 // LockTables is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) LockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
@@ -256,6 +261,11 @@ func (client *FakeTabletManagerClient) VReplicationWaitForPos(ctx context.Contex
 	return nil
 }
 
+// VDiff is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	return &tabletmanagerdatapb.VDiffResponse{}, nil
+}
+
 //
 // Reparenting related functions
 //
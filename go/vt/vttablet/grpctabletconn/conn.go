@@ -502,7 +502,7 @@ func (conn *gRPCQueryClient) BeginExecuteBatch(ctx context.Context, target *quer
 }
 
 // MessageStream streams messages.
-func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.Target, name, group string, callback func(*sqltypes.Result) error) error {
 	// Please see comments in StreamExecute to see how this works.
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -519,6 +519,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 			EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
 			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 			Name:              name,
+			Group:             group,
 		}
 		stream, err := conn.c.MessageStream(ctx, req)
 		if err != nil {
@@ -548,7 +549,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 }
 
 // MessageAck acks messages.
-func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
+func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Target, name, group string, ids []*querypb.Value) (int64, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
@@ -560,6 +561,7 @@ func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Tar
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		Name:              name,
 		Ids:               ids,
+		Group:             group,
 	}
 	reply, err := conn.c.MessageAck(ctx, req)
 	if err != nil {
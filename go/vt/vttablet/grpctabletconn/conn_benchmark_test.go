@@ -89,11 +89,11 @@ func (b *BenchmarkService) BeginExecuteBatch(ctx context.Context, target *queryp
 	panic("should not be called")
 }
 
-func (b *BenchmarkService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+func (b *BenchmarkService) MessageStream(ctx context.Context, target *querypb.Target, name, group string, callback func(*sqltypes.Result) error) error {
 	panic("should not be called")
 }
 
-func (b *BenchmarkService) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (b *BenchmarkService) MessageAck(ctx context.Context, target *querypb.Target, name, group string, ids []*querypb.Value) (count int64, err error) {
 	panic("should not be called")
 }
 
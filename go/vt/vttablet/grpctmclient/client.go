@@ -217,6 +217,20 @@ func (client *Client) GetPermissions(ctx context.Context, tablet *topodatapb.Tab
 	return response.Permissions, nil
 }
 
+// GetHotRows is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetHotRows(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.HotRow, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetHotRows(ctx, &tabletmanagerdatapb.GetHotRowsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.HotRows, nil
+}
+
 //
 // Various read-write methods
 //
@@ -616,6 +630,16 @@ func (client *Client) VReplicationWaitForPos(ctx context.Context, tablet *topoda
 	return nil
 }
 
+// VDiff is part of the tmclient.TabletManagerClient interface.
+func (client *Client) VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	return c.VDiff(ctx, req)
+}
+
 //
 // Reparenting related functions
 //
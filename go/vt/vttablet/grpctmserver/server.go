@@ -96,6 +96,17 @@ func (s *server) GetPermissions(ctx context.Context, request *tabletmanagerdatap
 	return response, err
 }
 
+func (s *server) GetHotRows(ctx context.Context, request *tabletmanagerdatapb.GetHotRowsRequest) (response *tabletmanagerdatapb.GetHotRowsResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetHotRows", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetHotRowsResponse{}
+	hotRows, err := s.tm.GetHotRows(ctx)
+	if err == nil {
+		response.HotRows = hotRows
+	}
+	return response, err
+}
+
 //
 // Various read-write methods
 //
@@ -334,6 +345,12 @@ func (s *server) VReplicationExec(ctx context.Context, request *tabletmanagerdat
 	return response, err
 }
 
+func (s *server) VDiff(ctx context.Context, request *tabletmanagerdatapb.VDiffRequest) (response *tabletmanagerdatapb.VDiffResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "VDiff", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.VDiff(ctx, request)
+}
+
 func (s *server) VReplicationWaitForPos(ctx context.Context, request *tabletmanagerdatapb.VReplicationWaitForPosRequest) (response *tabletmanagerdatapb.VReplicationWaitForPosResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "VReplicationWaitForPos", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -93,8 +93,8 @@ type QueryService interface {
 	BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, *topodatapb.TabletAlias, error)
 
 	// Messaging methods.
-	MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error
-	MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error)
+	MessageStream(ctx context.Context, target *querypb.Target, name, group string, callback func(*sqltypes.Result) error) error
+	MessageAck(ctx context.Context, target *querypb.Target, name, group string, ids []*querypb.Value) (count int64, err error)
 
 	// VStream streams VReplication events based on the specified filter.
 	VStream(ctx context.Context, target *querypb.Target, startPos string, tableLastPKs []*binlogdatapb.TableLastPK, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error
@@ -234,17 +234,17 @@ func (ws *wrappedService) BeginExecuteBatch(ctx context.Context, target *querypb
 	return qrs, transactionID, alias, err
 }
 
-func (ws *wrappedService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+func (ws *wrappedService) MessageStream(ctx context.Context, target *querypb.Target, name, group string, callback func(*sqltypes.Result) error) error {
 	return ws.wrapper(ctx, target, ws.impl, "MessageStream", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
-		innerErr := conn.MessageStream(ctx, target, name, callback)
+		innerErr := conn.MessageStream(ctx, target, name, group, callback)
 		return canRetry(ctx, innerErr), innerErr
 	})
 }
 
-func (ws *wrappedService) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (ws *wrappedService) MessageAck(ctx context.Context, target *querypb.Target, name, group string, ids []*querypb.Value) (count int64, err error) {
 	err = ws.wrapper(ctx, target, ws.impl, "MessageAck", false, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		var innerErr error
-		count, innerErr = conn.MessageAck(ctx, target, name, ids)
+		count, innerErr = conn.MessageAck(ctx, target, name, group, ids)
 		return canRetry(ctx, innerErr), innerErr
 	})
 	return count, err
@@ -94,12 +94,20 @@ type SandboxConn struct {
 
 	MessageIDs []*querypb.Value
 
+	// MessageGroup is the consumer group of the last MessageStream
+	// or MessageAck.
+	MessageGroup string
+
 	// vstream expectations.
 	StartPos      string
 	VStreamEvents [][]*binlogdatapb.VEvent
 	VStreamErrors []error
 	VStreamCh     chan *binlogdatapb.VEvent
 
+	// StreamTransactionIDs are the transaction ids of the StreamExecute
+	// calls.
+	StreamTransactionIDs []int64
+
 	// transaction id generator
 	TransactionID sync2.AtomicInt64
 
@@ -201,6 +209,7 @@ func (sbc *SandboxConn) StreamExecute(ctx context.Context, target *querypb.Targe
 		BindVariables: bv,
 	})
 	sbc.Options = append(sbc.Options, options)
+	sbc.StreamTransactionIDs = append(sbc.StreamTransactionIDs, transactionID)
 	err := sbc.getError()
 	if err != nil {
 		sbc.sExecMu.Unlock()
@@ -369,10 +378,11 @@ func (sbc *SandboxConn) BeginExecuteBatch(ctx context.Context, target *querypb.T
 }
 
 // MessageStream is part of the QueryService interface.
-func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name, group string, callback func(*sqltypes.Result) error) (err error) {
 	if err := sbc.getError(); err != nil {
 		return err
 	}
+	sbc.MessageGroup = group
 	r := sbc.getNextResult(nil)
 	if r == nil {
 		return nil
@@ -382,8 +392,9 @@ func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Targe
 }
 
 // MessageAck is part of the QueryService interface.
-func (sbc *SandboxConn) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (sbc *SandboxConn) MessageAck(ctx context.Context, target *querypb.Target, name, group string, ids []*querypb.Value) (count int64, err error) {
 	sbc.MessageIDs = ids
+	sbc.MessageGroup = group
 	return int64(len(ids)), nil
 }
 
@@ -608,6 +608,9 @@ var (
 	// MessageName is a test message name.
 	MessageName = "vitess_message"
 
+	// MessageGroup is a test consumer group.
+	MessageGroup = "vitess_group"
+
 	// MessageStreamResult is a test stream result.
 	MessageStreamResult = &sqltypes.Result{
 		Fields: []*querypb.Field{{
@@ -634,7 +637,7 @@ var (
 )
 
 // MessageStream is part of the queryservice.QueryService interface
-func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Target, name, group string, callback func(*sqltypes.Result) error) (err error) {
 	if f.HasError {
 		return f.TabletError
 	}
@@ -644,12 +647,15 @@ func (f *FakeQueryService) MessageStream(ctx context.Context, target *querypb.Ta
 	if name != MessageName {
 		f.t.Errorf("name: %s, want %s", name, MessageName)
 	}
+	if group != MessageGroup {
+		f.t.Errorf("group: %s, want %s", group, MessageGroup)
+	}
 	callback(MessageStreamResult)
 	return nil
 }
 
 // MessageAck is part of the queryservice.QueryService interface
-func (f *FakeQueryService) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (f *FakeQueryService) MessageAck(ctx context.Context, target *querypb.Target, name, group string, ids []*querypb.Value) (count int64, err error) {
 	if f.HasError {
 		return 0, f.TabletError
 	}
@@ -659,6 +665,9 @@ func (f *FakeQueryService) MessageAck(ctx context.Context, target *querypb.Targe
 	if name != MessageName {
 		f.t.Errorf("name: %s, want %s", name, MessageName)
 	}
+	if group != MessageGroup {
+		f.t.Errorf("group: %s, want %s", group, MessageGroup)
+	}
 	if !sqltypes.Proto3ValuesEqual(ids, MessageIDs) {
 		f.t.Errorf("ids: %v, want %v", ids, MessageIDs)
 	}
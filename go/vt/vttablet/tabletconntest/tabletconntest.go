@@ -673,7 +673,7 @@ func testMessageStream(t *testing.T, conn queryservice.QueryService, f *FakeQuer
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
 	var got *sqltypes.Result
-	err := conn.MessageStream(ctx, TestTarget, MessageName, func(qr *sqltypes.Result) error {
+	err := conn.MessageStream(ctx, TestTarget, MessageName, MessageGroup, func(qr *sqltypes.Result) error {
 		got = qr
 		return nil
 	})
@@ -690,7 +690,7 @@ func testMessageStreamError(t *testing.T, conn queryservice.QueryService, f *Fak
 	f.HasError = true
 	testErrorHelper(t, f, "MessageStream", func(ctx context.Context) error {
 		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-		return conn.MessageStream(ctx, TestTarget, MessageName, func(qr *sqltypes.Result) error { return nil })
+		return conn.MessageStream(ctx, TestTarget, MessageName, MessageGroup, func(qr *sqltypes.Result) error { return nil })
 	})
 	f.HasError = false
 }
@@ -698,7 +698,7 @@ func testMessageStreamError(t *testing.T, conn queryservice.QueryService, f *Fak
 func testMessageStreamPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testMessageStreamPanics")
 	testPanicHelper(t, f, "MessageStream", func(ctx context.Context) error {
-		err := conn.MessageStream(ctx, TestTarget, MessageName, func(qr *sqltypes.Result) error { return nil })
+		err := conn.MessageStream(ctx, TestTarget, MessageName, MessageGroup, func(qr *sqltypes.Result) error { return nil })
 		return err
 	})
 }
@@ -707,7 +707,7 @@ func testMessageAck(t *testing.T, conn queryservice.QueryService, f *FakeQuerySe
 	t.Log("testMessageAck")
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-	count, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageIDs)
+	count, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageGroup, MessageIDs)
 	if err != nil {
 		t.Fatalf("MessageAck failed: %v", err)
 	}
@@ -721,7 +721,7 @@ func testMessageAckError(t *testing.T, conn queryservice.QueryService, f *FakeQu
 	f.HasError = true
 	testErrorHelper(t, f, "MessageAck", func(ctx context.Context) error {
 		ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-		_, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageIDs)
+		_, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageGroup, MessageIDs)
 		return err
 	})
 	f.HasError = false
@@ -730,7 +730,7 @@ func testMessageAckError(t *testing.T, conn queryservice.QueryService, f *FakeQu
 func testMessageAckPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testMessageAckPanics")
 	testPanicHelper(t, f, "MessageAck", func(ctx context.Context) error {
-		_, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageIDs)
+		_, err := conn.MessageAck(ctx, TestTarget, MessageName, MessageGroup, MessageIDs)
 		return err
 	})
 }
@@ -56,6 +56,22 @@ func (tm *TabletManager) GetPermissions(ctx context.Context) (*tabletmanagerdata
 	return mysqlctl.GetPermissions(tm.MysqlDaemon)
 }
 
+// GetHotRows returns the rows contended by several transactions, as seen
+// by the hot row protection of the query service.
+func (tm *TabletManager) GetHotRows(ctx context.Context) ([]*tabletmanagerdatapb.HotRow, error) {
+	var hotRows []*tabletmanagerdatapb.HotRow
+	for _, hotRow := range tm.QueryServiceControl.HotRows() {
+		hotRows = append(hotRows, &tabletmanagerdatapb.HotRow{
+			Key:     hotRow.Key,
+			Table:   hotRow.Table,
+			Pending: int64(hotRow.Pending),
+			Max:     int64(hotRow.Max),
+			Count:   int64(hotRow.Count),
+		})
+	}
+	return hotRows, nil
+}
+
 // SetReadOnly makes the mysql instance read-only or read-write.
 func (tm *TabletManager) SetReadOnly(ctx context.Context, rdonly bool) error {
 	if err := tm.lock(ctx); err != nil {
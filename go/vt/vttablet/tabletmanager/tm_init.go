@@ -61,6 +61,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 
@@ -128,6 +129,7 @@ type TabletManager struct {
 	QueryServiceControl tabletserver.Controller
 	UpdateStream        binlog.UpdateStreamControl
 	VREngine            *vreplication.Engine
+	VDiffEngine         *vdiff.Engine
 
 	// tmState manages the TabletManager state.
 	tmState *tmState
@@ -282,6 +284,11 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 		servenv.OnTerm(tm.VREngine.Close)
 	}
 
+	if tm.VDiffEngine != nil {
+		tm.VDiffEngine.InitDBConfig(tm.DBConfigs)
+		servenv.OnTerm(tm.VDiffEngine.Close)
+	}
+
 	// The following initializations don't need to be done
 	// in any specific order.
 	tm.startShardSync()
@@ -353,6 +360,10 @@ func (tm *TabletManager) Stop() {
 		tm.UpdateStream.Disable()
 	}
 
+	if tm.VDiffEngine != nil {
+		tm.VDiffEngine.Close()
+	}
+
 	if tm.VREngine != nil {
 		tm.VREngine.Close()
 	}
@@ -252,6 +252,14 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	if ts.tm.VDiffEngine != nil {
+		if ts.tablet.Type == topodatapb.TabletType_MASTER {
+			ts.tm.VDiffEngine.Open(ts.tm.BatchCtx, ts.tablet)
+		} else {
+			ts.tm.VDiffEngine.Close()
+		}
+	}
+
 	// Open TabletServer last so that it advertises serving after all other services are up.
 	if reason == "" {
 		if err := ts.tm.QueryServiceControl.SetServingType(ts.tablet.Type, terTime, true, ""); err != nil {
@@ -286,7 +294,7 @@ func (ts *tmState) applyBlacklist(ctx context.Context) (err error) {
 		// that we don't add a rule to blacklist all tables
 		if len(tables) > 0 {
 			log.Infof("Blacklisting tables %v", strings.Join(tables, ", "))
-			qr := rules.NewQueryRule("enforce blacklisted tables", "blacklisted_table", rules.QRFailRetry)
+			qr := rules.NewQueryRule("enforce blacklisted tables", "blacklisted_table", rules.QRFailClusterEvent)
 			for _, t := range tables {
 				qr.AddTableCond(t)
 			}
@@ -123,7 +123,7 @@ func TestStateBlacklist(t *testing.T) {
 
 	qsc := tm.QueryServiceControl.(*tabletservermock.Controller)
 	b, _ := json.Marshal(qsc.GetQueryRules(blacklistQueryRules))
-	assert.Equal(t, `[{"Description":"enforce blacklisted tables","Name":"blacklisted_table","TableNames":["t1"],"Action":"FAIL_RETRY"}]`, string(b))
+	assert.Equal(t, `[{"Description":"enforce blacklisted tables","Name":"blacklisted_table","TableNames":["t1"],"Action":"FAIL_CLUSTER_EVENT"}]`, string(b))
 }
 
 func TestStateTabletControls(t *testing.T) {
@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// controller runs one vdiff. It is created by the Engine, and its
+// members are read-only once it's started.
+type controller struct {
+	vde *Engine
+
+	id       int64
+	uuid     string
+	workflow string
+	options  *tabletmanagerdatapb.VDiffOptions
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// tableState is the persisted progress of a table.
+type tableState struct {
+	state  string
+	lastpk *querypb.QueryResult
+	report *DiffReport
+}
+
+func newController(row sqltypes.RowNamedValues, vde *Engine) (*controller, error) {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	options := &tabletmanagerdatapb.VDiffOptions{}
+	if optionsJSON := row.AsString("options", ""); optionsJSON != "" {
+		if err := json.Unmarshal([]byte(optionsJSON), options); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid options of vdiff %d", id)
+		}
+	}
+	return &controller{
+		vde:      vde,
+		id:       id,
+		uuid:     row.AsString("vdiff_uuid", ""),
+		workflow: row.AsString("workflow", ""),
+		options:  options,
+		done:     make(chan struct{}),
+	}, nil
+}
+
+func (ct *controller) start(ctx context.Context) {
+	ctx, ct.cancel = context.WithCancel(ctx)
+	go ct.run(ctx)
+}
+
+// Stop stops the vdiff and waits for it to exit.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+func (ct *controller) run(ctx context.Context) {
+	defer func() {
+		close(ct.done)
+		ct.vde.controllerDone(ct)
+	}()
+
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("vdiff %s: could not connect to the database: %v", ct.uuid, err)
+		return
+	}
+	defer dbClient.Close()
+
+	log.Infof("Starting vdiff %s of workflow %s", ct.uuid, ct.workflow)
+	err := ct.diff(ctx, dbClient)
+	switch {
+	case err == nil:
+		if _, err := withDDL.Exec(ctx, fmt.Sprintf(sqlCompleteVDiff, ct.id), dbClient.ExecuteFetch); err != nil {
+			log.Errorf("vdiff %s: could not record its completion: %v", ct.uuid, err)
+			return
+		}
+		log.Infof("vdiff %s of workflow %s completed", ct.uuid, ct.workflow)
+	case ctx.Err() != nil:
+		// The vdiff was stopped, or the engine is closing. Its state
+		// is left as is, so that it can be resumed.
+		log.Infof("vdiff %s of workflow %s was canceled: %v", ct.uuid, ct.workflow, err)
+	default:
+		log.Errorf("vdiff %s of workflow %s failed: %v", ct.uuid, ct.workflow, err)
+		query := fmt.Sprintf(sqlUpdateVDiffState, encodeString(StateError), encodeString(binlogplayer.MessageTruncate(err.Error())), ct.id)
+		if _, err := withDDL.Exec(context.Background(), query, dbClient.ExecuteFetch); err != nil {
+			log.Errorf("vdiff %s: could not record its error: %v", ct.uuid, err)
+		}
+	}
+}
+
+// diff diffs the tables that are not completed yet, one at a time.
+func (ct *controller) diff(ctx context.Context, dbClient binlogplayer.DBClient) error {
+	if _, err := withDDL.Exec(ctx, fmt.Sprintf(sqlStartVDiff, ct.id), dbClient.ExecuteFetch); err != nil {
+		return err
+	}
+	filter, err := ct.workflowFilter()
+	if err != nil {
+		return err
+	}
+	schm, err := ct.vde.mysqld.GetSchema(ctx, ct.vde.dbName, nil, nil, false)
+	if err != nil {
+		return vterrors.Wrap(err, "GetSchema")
+	}
+	var tables []string
+	for _, table := range strings.Split(ct.options.Tables, ",") {
+		if table = strings.TrimSpace(table); table != "" {
+			tables = append(tables, table)
+		}
+	}
+	plans, err := buildTablePlans(schm, filter, tables)
+	if err != nil {
+		return err
+	}
+	states, err := ct.readTableStates(ctx, dbClient)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(plans))
+	for name := range plans {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		tstate, ok := states[name]
+		if !ok {
+			if _, err := withDDL.Exec(ctx, fmt.Sprintf(sqlNewVDiffTable, ct.id, encodeString(name)), dbClient.ExecuteFetch); err != nil {
+				return err
+			}
+			tstate = &tableState{state: StatePending, report: &DiffReport{TableName: name}}
+		}
+		if tstate.state == StateCompleted {
+			continue
+		}
+		td := newTableDiffer(ct, plans[name], tstate)
+		if err := td.diff(ctx, dbClient); err != nil {
+			return vterrors.Wrapf(err, "table %s", name)
+		}
+	}
+	return nil
+}
+
+// workflowFilter returns the filter of the workflow. All the streams of
+// a workflow have the same filter.
+func (ct *controller) workflowFilter() (*binlogdatapb.Filter, error) {
+	qr, err := ct.vde.vre.Exec(fmt.Sprintf("select source from _vt.vreplication where db_name = %s and workflow = %s", encodeString(ct.vde.dbName), encodeString(ct.workflow)))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("workflow %s not found in %s", ct.workflow, ct.vde.dbName)
+	}
+	var bls binlogdatapb.BinlogSource
+	if err := proto.UnmarshalText(qr.Rows[0][0].ToString(), &bls); err != nil {
+		return nil, err
+	}
+	return bls.Filter, nil
+}
+
+func (ct *controller) readTableStates(ctx context.Context, dbClient binlogplayer.DBClient) (map[string]*tableState, error) {
+	qr, err := withDDL.Exec(ctx, fmt.Sprintf(sqlGetVDiffTables, ct.id), dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	states := make(map[string]*tableState)
+	for _, row := range qr.Named().Rows {
+		name := row.AsString("table_name", "")
+		tstate := &tableState{
+			state:  row.AsString("state", ""),
+			report: &DiffReport{TableName: name},
+		}
+		if lastpk := row.AsString("lastpk", ""); lastpk != "" {
+			tstate.lastpk = &querypb.QueryResult{}
+			if err := proto.UnmarshalText(lastpk, tstate.lastpk); err != nil {
+				return nil, vterrors.Wrapf(err, "invalid lastpk of table %s", name)
+			}
+		}
+		if report := row.AsString("report", ""); report != "" {
+			if err := json.Unmarshal([]byte(report), tstate.report); err != nil {
+				return nil, vterrors.Wrapf(err, "invalid report of table %s", name)
+			}
+		}
+		states[name] = tstate
+	}
+	return states, nil
+}
@@ -0,0 +1,541 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package vdiff runs the diffs of the vreplication workflows of a target
+tablet. The progress of every table is persisted in the _vt.vdiff and
+_vt.vdiff_table tables, which lets a vdiff be stopped and resumed, and
+survive the restart or reparent of the tablet.
+*/
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+)
+
+// The actions of a VDiffRequest.
+const (
+	StartAction  = "start"
+	StopAction   = "stop"
+	ResumeAction = "resume"
+	ShowAction   = "show"
+	DeleteAction = "delete"
+)
+
+// The special arguments of the show and delete actions.
+const (
+	LastActionArg = "last"
+	AllActionArg  = "all"
+)
+
+// openRetryInterval is the interval at which Open retries to read the
+// vdiffs to resume. It can be changed for tests.
+var openRetryInterval = 1 * time.Second
+
+// vrEngine is the part of the vreplication engine that the vdiffs use to
+// stop, synchronize and restart the streams of their workflow.
+type vrEngine interface {
+	Exec(query string) (*sqltypes.Result, error)
+	WaitForPos(ctx context.Context, id int, pos string) error
+}
+
+// Engine runs the vdiffs of the workflows that replicate into this tablet,
+// at most one per workflow. It is open only while the tablet is a master.
+type Engine struct {
+	// actionMu serializes the actions that start a vdiff, so that a
+	// workflow never has two active vdiffs.
+	actionMu sync.Mutex
+
+	// mu synchronizes isOpen, ctx, cancel and controllers.
+	mu     sync.Mutex
+	isOpen bool
+	// ctx is the root context of all the controllers.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// controllers contains the running vdiffs by id.
+	controllers map[int64]*controller
+	// wg tracks the goroutines that are stopped by Close.
+	wg sync.WaitGroup
+
+	ts              *topo.Server
+	mysqld          mysqlctl.MysqlDaemon
+	vre             vrEngine
+	tmc             tmclient.TabletManagerClient
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+	thisTablet      *topodatapb.Tablet
+}
+
+// NewEngine creates a new Engine.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, mysqld mysqlctl.MysqlDaemon, vre *vreplication.Engine) *Engine {
+	return &Engine{
+		controllers: make(map[int64]*controller),
+		ts:          ts,
+		mysqld:      mysqld,
+		vre:         vre,
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initilized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.FilteredWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, mysqld mysqlctl.MysqlDaemon, vre vrEngine, tmc tmclient.TabletManagerClient, dbClientFactory func() binlogplayer.DBClient, dbName string) *Engine {
+	return &Engine{
+		controllers:     make(map[int64]*controller),
+		ts:              ts,
+		mysqld:          mysqld,
+		vre:             vre,
+		tmc:             tmc,
+		dbClientFactory: dbClientFactory,
+		dbName:          dbName,
+	}
+}
+
+// Open starts the Engine, and resumes the vdiffs that were running
+// when it was last closed.
+func (vde *Engine) Open(ctx context.Context, tablet *topodatapb.Tablet) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+	if vde.tmc == nil {
+		vde.tmc = tmclient.NewTabletManagerClient()
+	}
+	vde.thisTablet = tablet
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.isOpen = true
+
+	vde.wg.Add(1)
+	go vde.resume(vde.ctx)
+}
+
+// resume restarts the pending and started vdiffs. The database may not
+// be reachable yet, so it retries until it succeeds or the Engine is
+// closed.
+func (vde *Engine) resume(ctx context.Context) {
+	defer vde.wg.Done()
+	for {
+		err := vde.resumeOnce()
+		if err == nil {
+			return
+		}
+		log.Errorf("Error resuming vdiffs: %v, will keep retrying.", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(openRetryInterval):
+		}
+	}
+}
+
+// resumeOnce restarts the oldest pending or started vdiff of every
+// workflow. The others, which a workflow can only have if they were
+// started by an older version, are put in error.
+func (vde *Engine) resumeOnce() error {
+	vde.actionMu.Lock()
+	defer vde.actionMu.Unlock()
+
+	qr, err := vde.execWithDDL(fmt.Sprintf(sqlGetVDiffsToRun, encodeString(vde.dbName)))
+	if err != nil {
+		return err
+	}
+	var extra []sqltypes.RowNamedValues
+	vde.mu.Lock()
+	if !vde.isOpen {
+		vde.mu.Unlock()
+		return nil
+	}
+	resumed := make(map[string]bool)
+	for _, row := range qr.Named().Rows {
+		workflow := row.AsString("workflow", "")
+		if resumed[workflow] {
+			extra = append(extra, row)
+			continue
+		}
+		resumed[workflow] = true
+		if err := vde.startControllerLocked(row); err != nil {
+			log.Errorf("Could not resume vdiff %s: %v", row.AsString("vdiff_uuid", ""), err)
+		}
+	}
+	vde.mu.Unlock()
+
+	for _, row := range extra {
+		vdiffUUID, workflow := row.AsString("vdiff_uuid", ""), row.AsString("workflow", "")
+		log.Errorf("Not resuming vdiff %s: another vdiff of workflow %s is active", vdiffUUID, workflow)
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return err
+		}
+		query := fmt.Sprintf(sqlUpdateVDiffState, encodeString(StateError), encodeString(fmt.Sprintf("another vdiff of workflow %s was active", workflow)), id)
+		if _, err := vde.execWithDDL(query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsOpen returns true if Engine is open.
+func (vde *Engine) IsOpen() bool {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.isOpen
+}
+
+// Close stops the running vdiffs, and closes the Engine. The vdiffs
+// remain in the started state, and are resumed by the next Open.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	if !vde.isOpen {
+		vde.mu.Unlock()
+		return
+	}
+	vde.cancel()
+	controllers := vde.controllers
+	vde.controllers = make(map[int64]*controller)
+	vde.isOpen = false
+	// The controllers remove themselves from the engine when they
+	// exit, so they must be waited for without holding the lock.
+	vde.mu.Unlock()
+
+	for _, ct := range controllers {
+		ct.Stop()
+	}
+	vde.wg.Wait()
+	log.Infof("VDiff Engine: closed")
+}
+
+// PerformAction starts, stops, resumes, shows or deletes the vdiffs of
+// a workflow.
+func (vde *Engine) PerformAction(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if !vde.IsOpen() {
+		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vdiff engine is closed")
+	}
+	if req.Workflow == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "workflow is required")
+	}
+
+	switch req.Action {
+	case StartAction:
+		return vde.start(req)
+	case StopAction:
+		return vde.stop(req)
+	case ResumeAction:
+		return vde.resumeVDiff(req)
+	case ShowAction:
+		return vde.show(req)
+	case DeleteAction:
+		return vde.delete(req)
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid vdiff action %q: must be one of %s, %s, %s, %s or %s",
+		req.Action, StartAction, StopAction, ResumeAction, ShowAction, DeleteAction)
+}
+
+func (vde *Engine) start(req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	vde.actionMu.Lock()
+	defer vde.actionMu.Unlock()
+
+	qr, err := vde.vre.Exec(fmt.Sprintf("select id from _vt.vreplication where db_name = %s and workflow = %s", encodeString(vde.dbName), encodeString(req.Workflow)))
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %s not found in %s", req.Workflow, vde.dbName)
+	}
+	if err := vde.checkNoActiveVDiff(req.Workflow); err != nil {
+		return nil, err
+	}
+
+	vdiffUUID := req.VdiffUuid
+	if vdiffUUID == "" {
+		vdiffUUID = uuid.New().String()
+	}
+	options := req.Options
+	if options == nil {
+		options = &tabletmanagerdatapb.VDiffOptions{}
+	}
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(sqlNewVDiff, encodeString(vdiffUUID), encodeString(req.Workflow), encodeString(vde.thisTablet.Keyspace),
+		encodeString(vde.thisTablet.Shard), encodeString(vde.dbName), encodeString(StatePending), encodeString(string(optionsJSON)))
+	qr, err = vde.execWithDDL(query)
+	if err != nil {
+		return nil, err
+	}
+	id := int64(qr.InsertID)
+
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if err := vde.startControllerLocked(vde.newControllerRow(id, vdiffUUID, req.Workflow, string(optionsJSON))); err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.VDiffResponse{Id: id, VdiffUuid: vdiffUUID}, nil
+}
+
+func (vde *Engine) stop(req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	row, err := vde.getVDiff(req.Workflow, req.VdiffUuid)
+	if err != nil {
+		return nil, err
+	}
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	if state := row.AsString("state", ""); state == StateCompleted {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s is already completed", req.VdiffUuid)
+	}
+	vde.stopController(id)
+	if _, err := vde.execWithDDL(fmt.Sprintf(sqlUpdateVDiffState, encodeString(StateStopped), encodeString(""), id)); err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.VDiffResponse{Id: id, VdiffUuid: req.VdiffUuid}, nil
+}
+
+func (vde *Engine) resumeVDiff(req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	vde.actionMu.Lock()
+	defer vde.actionMu.Unlock()
+
+	row, err := vde.getVDiff(req.Workflow, req.VdiffUuid)
+	if err != nil {
+		return nil, err
+	}
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	switch state := row.AsString("state", ""); state {
+	case StateStopped, StateError:
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s is %s: only a %s vdiff or one in %s can be resumed", req.VdiffUuid, state, StateStopped, StateError)
+	}
+	if err := vde.checkNoActiveVDiff(req.Workflow); err != nil {
+		return nil, err
+	}
+	if _, err := vde.execWithDDL(fmt.Sprintf(sqlUpdateVDiffState, encodeString(StatePending), encodeString(""), id)); err != nil {
+		return nil, err
+	}
+
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if err := vde.startControllerLocked(row); err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.VDiffResponse{Id: id, VdiffUuid: req.VdiffUuid}, nil
+}
+
+func (vde *Engine) show(req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if req.ActionArg == AllActionArg {
+		qr, err := vde.execWithDDL(fmt.Sprintf(sqlGetAllVDiffs, encodeString(vde.dbName), encodeString(req.Workflow)))
+		if err != nil {
+			return nil, err
+		}
+		return &tabletmanagerdatapb.VDiffResponse{Output: sqltypes.ResultToProto3(qr)}, nil
+	}
+
+	row, err := vde.getVDiff(req.Workflow, req.ActionArg)
+	if err != nil {
+		return nil, err
+	}
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	qr, err := vde.execWithDDL(fmt.Sprintf(sqlGetVDiffReport, id))
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.VDiffResponse{Id: id, VdiffUuid: row.AsString("vdiff_uuid", ""), Output: sqltypes.ResultToProto3(qr)}, nil
+}
+
+func (vde *Engine) delete(req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	var ids []int64
+	if req.ActionArg == AllActionArg {
+		qr, err := vde.execWithDDL(fmt.Sprintf(sqlGetAllVDiffs, encodeString(vde.dbName), encodeString(req.Workflow)))
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range qr.Named().Rows {
+			id, err := row.ToInt64("id")
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+	} else {
+		row, err := vde.getVDiff(req.Workflow, req.ActionArg)
+		if err != nil {
+			return nil, err
+		}
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	for _, id := range ids {
+		vde.stopController(id)
+		if _, err := vde.execWithDDL(fmt.Sprintf(sqlDeleteVDiffTables, id)); err != nil {
+			return nil, err
+		}
+		if _, err := vde.execWithDDL(fmt.Sprintf(sqlDeleteVDiff, id)); err != nil {
+			return nil, err
+		}
+	}
+	return &tabletmanagerdatapb.VDiffResponse{}, nil
+}
+
+// checkNoActiveVDiff returns an error if the workflow has a pending or
+// started vdiff. It must be called with actionMu held.
+func (vde *Engine) checkNoActiveVDiff(workflow string) error {
+	qr, err := vde.execWithDDL(fmt.Sprintf(sqlGetActiveVDiff, encodeString(vde.dbName), encodeString(workflow)))
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 0 {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s of workflow %s is active: stop it first", qr.Named().Rows[0].AsString("vdiff_uuid", ""), workflow)
+	}
+	return nil
+}
+
+// getVDiff returns the vdiff of the workflow with the given uuid, or its
+// most recent one if vdiffUUID is "last".
+func (vde *Engine) getVDiff(workflow, vdiffUUID string) (sqltypes.RowNamedValues, error) {
+	var query string
+	switch vdiffUUID {
+	case "":
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vdiff uuid is required")
+	case LastActionArg:
+		query = fmt.Sprintf(sqlGetLastVDiff, encodeString(vde.dbName), encodeString(workflow))
+	default:
+		query = fmt.Sprintf(sqlGetVDiffByUUID, encodeString(vdiffUUID), encodeString(vde.dbName))
+	}
+	qr, err := vde.execWithDDL(query)
+	if err != nil {
+		return nil, err
+	}
+	named := qr.Named()
+	if len(named.Rows) == 0 || named.Rows[0].AsString("workflow", "") != workflow {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vdiff %s not found for workflow %s", vdiffUUID, workflow)
+	}
+	return named.Rows[0], nil
+}
+
+// newControllerRow returns the row of a vdiff that was just created.
+func (vde *Engine) newControllerRow(id int64, vdiffUUID, workflow, options string) sqltypes.RowNamedValues {
+	return sqltypes.RowNamedValues{
+		"id":         sqltypes.NewInt64(id),
+		"vdiff_uuid": sqltypes.NewVarChar(vdiffUUID),
+		"workflow":   sqltypes.NewVarBinary(workflow),
+		"keyspace":   sqltypes.NewVarBinary(vde.thisTablet.Keyspace),
+		"shard":      sqltypes.NewVarChar(vde.thisTablet.Shard),
+		"options":    sqltypes.NewVarBinary(options),
+	}
+}
+
+// startControllerLocked starts running the vdiff of the row. It must be
+// called with mu held.
+func (vde *Engine) startControllerLocked(row sqltypes.RowNamedValues) error {
+	if !vde.isOpen {
+		return vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vdiff engine is closed")
+	}
+	ct, err := newController(row, vde)
+	if err != nil {
+		return err
+	}
+	for _, running := range vde.controllers {
+		if running.id == ct.id {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s is already running", ct.uuid)
+		}
+		if running.workflow == ct.workflow {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s of workflow %s is already running", running.uuid, ct.workflow)
+		}
+	}
+	vde.controllers[ct.id] = ct
+	ct.start(vde.ctx)
+	return nil
+}
+
+// stopController stops the vdiff if it's running, and waits for it to
+// exit.
+func (vde *Engine) stopController(id int64) {
+	vde.mu.Lock()
+	ct, ok := vde.controllers[id]
+	delete(vde.controllers, id)
+	vde.mu.Unlock()
+	if ok {
+		ct.Stop()
+	}
+}
+
+// controllerDone removes a controller that exited by itself.
+func (vde *Engine) controllerDone(ct *controller) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if vde.controllers[ct.id] == ct {
+		delete(vde.controllers, ct.id)
+	}
+}
+
+func (vde *Engine) execWithDDL(query string) (*sqltypes.Result, error) {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+	return withDDL.Exec(context.Background(), query, dbClient.ExecuteFetch)
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/faketmclient"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice/fakes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var vdiffFields = sqltypes.MakeTestFields(
+	"id|vdiff_uuid|workflow|keyspace|shard|db_name|state|options|last_error",
+	"int64|varchar|varbinary|varbinary|varchar|varbinary|varbinary|varbinary|varbinary",
+)
+
+func vdiffResult(state string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(vdiffFields, fmt.Sprintf("1|u1|wf|ks|0|db|%s|{}|", state))
+}
+
+// newTestEngine returns an open Engine that has no vdiff to resume.
+func newTestEngine(t *testing.T) (*Engine, *binlogplayer.MockDBClient) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where db_name = 'db' and state in ('pending', 'started') order by id", &sqltypes.Result{}, nil)
+
+	vde := NewTestEngine(memorytopo.NewServer("cell1"), nil, &fakeVREngine{}, &faketmclient.FakeTabletManagerClient{},
+		func() binlogplayer.DBClient { return dbClient }, "db")
+	vde.Open(context.Background(), &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+	})
+	dbClient.Wait()
+	return vde, dbClient
+}
+
+func TestEnginePerformActionErrors(t *testing.T) {
+	vde, _ := newTestEngine(t)
+
+	_, err := vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Action: ShowAction})
+	assert.EqualError(t, err, "workflow is required")
+
+	_, err = vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: "badaction"})
+	assert.EqualError(t, err, `invalid vdiff action "badaction": must be one of start, stop, resume, show or delete`)
+
+	_, err = vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: StopAction})
+	assert.EqualError(t, err, "vdiff uuid is required")
+
+	vde.Close()
+	_, err = vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: ShowAction, ActionArg: LastActionArg})
+	assert.EqualError(t, err, "vdiff engine is closed")
+}
+
+func TestEngineShow(t *testing.T) {
+	vde, dbClient := newTestEngine(t)
+	defer vde.Close()
+
+	report := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"vdiff_uuid|state|table_name|table_state|rows_compared|mismatch",
+		"varchar|varbinary|varbinary|varbinary|int64|int64"),
+		"u1|started|t1|completed|10|0",
+		"u1|started|t2|started|5|1",
+	)
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where db_name = 'db' and workflow = 'wf' order by id desc limit 1", vdiffResult(StateStarted), nil)
+	dbClient.ExpectRequest("select v.vdiff_uuid, v.state, v.started_at, v.completed_at, v.last_error, vt.table_name, vt.state as table_state, vt.rows_compared, vt.mismatch, vt.report from _vt.vdiff as v left join _vt.vdiff_table as vt on v.id = vt.vdiff_id where v.id = 1 order by vt.table_name", report, nil)
+
+	resp, err := vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: ShowAction, ActionArg: LastActionArg})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Id)
+	assert.Equal(t, "u1", resp.VdiffUuid)
+	assert.Equal(t, sqltypes.ResultToProto3(report), resp.Output)
+	dbClient.Wait()
+}
+
+func TestEngineOtherWorkflow(t *testing.T) {
+	vde, dbClient := newTestEngine(t)
+	defer vde.Close()
+
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where vdiff_uuid = 'u1' and db_name = 'db'", vdiffResult(StateStarted), nil)
+	_, err := vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf2", Action: ShowAction, ActionArg: "u1"})
+	assert.EqualError(t, err, "vdiff u1 not found for workflow wf2")
+	dbClient.Wait()
+}
+
+func TestEngineStop(t *testing.T) {
+	vde, dbClient := newTestEngine(t)
+	defer vde.Close()
+
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where vdiff_uuid = 'u1' and db_name = 'db'", vdiffResult(StateStarted), nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'stopped', last_error = '' where id = 1", &sqltypes.Result{RowsAffected: 1}, nil)
+	resp, err := vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: StopAction, VdiffUuid: "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, &tabletmanagerdatapb.VDiffResponse{Id: 1, VdiffUuid: "u1"}, resp)
+	dbClient.Wait()
+
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where vdiff_uuid = 'u1' and db_name = 'db'", vdiffResult(StateCompleted), nil)
+	_, err = vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: StopAction, VdiffUuid: "u1"})
+	assert.EqualError(t, err, "vdiff u1 is already completed")
+	dbClient.Wait()
+}
+
+func TestEngineResumeNotStopped(t *testing.T) {
+	vde, dbClient := newTestEngine(t)
+	defer vde.Close()
+
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where vdiff_uuid = 'u1' and db_name = 'db'", vdiffResult(StateCompleted), nil)
+	_, err := vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: ResumeAction, VdiffUuid: "u1"})
+	assert.EqualError(t, err, "vdiff u1 is completed: only a stopped vdiff or one in error can be resumed")
+	dbClient.Wait()
+}
+
+func TestEngineDeleteAll(t *testing.T) {
+	vde, dbClient := newTestEngine(t)
+	defer vde.Close()
+
+	all := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|vdiff_uuid|workflow|state", "int64|varchar|varbinary|varbinary"),
+		"1|u1|wf|completed",
+		"2|u2|wf|stopped",
+	)
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, state, created_at, started_at, completed_at, last_error from _vt.vdiff where db_name = 'db' and workflow = 'wf' order by id", all, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff_table where vdiff_id = 1", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff where id = 1", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff_table where vdiff_id = 2", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff where id = 2", &sqltypes.Result{}, nil)
+	_, err := vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: DeleteAction, ActionArg: AllActionArg})
+	require.NoError(t, err)
+	dbClient.Wait()
+}
+
+func TestEngineStartActive(t *testing.T) {
+	vde, dbClient := newTestEngine(t)
+	defer vde.Close()
+
+	dbClient.ExpectRequest("select vdiff_uuid from _vt.vdiff where db_name = 'db' and workflow = 'wf' and state in ('pending', 'started') limit 1",
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("vdiff_uuid", "varchar"), "u0"), nil)
+	_, err := vde.PerformAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: StartAction, VdiffUuid: "u1"})
+	assert.EqualError(t, err, "vdiff u0 of workflow wf is active: stop it first")
+	dbClient.Wait()
+}
+
+// fakeVREngine answers the queries that a vdiff sends to the
+// vreplication engine, for a workflow wf that has one stream from
+// source/0.
+type fakeVREngine struct {
+	mu      sync.Mutex
+	queries []string
+}
+
+func (vre *fakeVREngine) Exec(query string) (*sqltypes.Result, error) {
+	vre.mu.Lock()
+	defer vre.mu.Unlock()
+	vre.queries = append(vre.queries, query)
+
+	source := proto.CompactTextString(&binlogdatapb.BinlogSource{
+		Keyspace: "source",
+		Shard:    "0",
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select * from t1"}},
+		},
+	})
+	switch {
+	case strings.HasPrefix(query, "select id from _vt.vreplication"):
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1"), nil
+	case strings.HasPrefix(query, "select source from _vt.vreplication"):
+		return &sqltypes.Result{
+			Fields: sqltypes.MakeTestFields("source", "varbinary"),
+			Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary(source)}},
+		}, nil
+	case strings.HasPrefix(query, "select id, source, pos from _vt.vreplication"):
+		return &sqltypes.Result{
+			Fields: sqltypes.MakeTestFields("id|source|pos", "int64|varbinary|varbinary"),
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewInt64(1),
+				sqltypes.NewVarBinary(source),
+				sqltypes.NewVarBinary("MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"),
+			}},
+		}, nil
+	case strings.HasPrefix(query, "update _vt.vreplication"):
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	}
+	return nil, fmt.Errorf("unexpected query: %s", query)
+}
+
+func (vre *fakeVREngine) WaitForPos(ctx context.Context, id int, pos string) error {
+	return nil
+}
+
+// fakeTablet streams the rows 1 to 5 of t1 from the lastpk it receives.
+// If block is set, the next stream blocks after its third row until it's
+// canceled.
+type fakeTablet struct {
+	queryservice.QueryService
+
+	mu      sync.Mutex
+	block   bool
+	lastpks []*querypb.QueryResult
+}
+
+func (ft *fakeTablet) VStreamRows(ctx context.Context, target *querypb.Target, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	ft.mu.Lock()
+	ft.lastpks = append(ft.lastpks, lastpk)
+	block := ft.block
+	ft.block = false
+	ft.mu.Unlock()
+
+	var from int64
+	if lastpk != nil {
+		var err error
+		if from, err = evalengine.ToInt64(sqltypes.Proto3ToResult(lastpk).Rows[0][0]); err != nil {
+			return err
+		}
+	}
+	if err := send(&binlogdatapb.VStreamRowsResponse{
+		Fields:   testFields,
+		Pkfields: testFields[:1],
+		Gtid:     "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10",
+	}); err != nil {
+		return err
+	}
+	var rows []*querypb.Row
+	for id := from + 1; id <= 5; id++ {
+		rows = append(rows, sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewVarChar(fmt.Sprintf("v%d", id))}))
+		if block && len(rows) == 3 {
+			if err := send(&binlogdatapb.VStreamRowsResponse{Rows: rows}); err != nil {
+				return err
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		}
+	}
+	return send(&binlogdatapb.VStreamRowsResponse{Rows: rows})
+}
+
+func (ft *fakeTablet) receivedLastPKs() []*querypb.QueryResult {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+	return ft.lastpks
+}
+
+// testTablets are the tablets returned by the VDiffEngineTest dialer, by
+// uid. It's set by the tests that use the dialer.
+var testTablets map[uint32]*fakeTablet
+
+func init() {
+	tabletconn.RegisterDialer("VDiffEngineTest", func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		if ft, ok := testTablets[tablet.Alias.Uid]; ok {
+			return ft, nil
+		}
+		return nil, fmt.Errorf("tablet %d not found", tablet.Alias.Uid)
+	})
+}
+
+func progressQuery(state string, lastpk *querypb.QueryResult, report *DiffReport) string {
+	lastpkText := ""
+	if lastpk != nil {
+		lastpkText = proto.CompactTextString(lastpk)
+	}
+	reportJSON, _ := json.Marshal(report)
+	mismatch := 0
+	if report.MismatchedRows > 0 || report.ExtraRowsSource > 0 || report.ExtraRowsTarget > 0 {
+		mismatch = 1
+	}
+	return fmt.Sprintf(sqlUpdateTableProgress, encodeString(state), encodeString(lastpkText), report.ProcessedRows, mismatch,
+		encodeString(string(reportJSON)), 1, encodeString("t1"))
+}
+
+func TestEngineStopResumeMidTable(t *testing.T) {
+	defer func(saved int64) { progressInterval = saved }(progressInterval)
+	progressInterval = 2
+	defer func(saved string) { *tabletconn.TabletProtocol = saved }(*tabletconn.TabletProtocol)
+	*tabletconn.TabletProtocol = "VDiffEngineTest"
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	sourceTablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 200},
+		Keyspace: "source",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_REPLICA,
+	}
+	require.NoError(t, ts.CreateTablet(ctx, sourceTablet))
+	source := &fakeTablet{QueryService: fakes.ErrorQueryService, block: true}
+	target := &fakeTablet{QueryService: fakes.ErrorQueryService}
+	testTablets = map[uint32]*fakeTablet{200: source, 100: target}
+	defer func() { testTablets = nil }()
+
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	mysqld.Schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{Name: "t1", Columns: []string{"id", "val"}, PrimaryKeyColumns: []string{"id"}}},
+	}
+	dbClient := binlogplayer.NewMockDBClient(t)
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where db_name = 'db' and state in ('pending', 'started') order by id", &sqltypes.Result{}, nil)
+	vde := NewTestEngine(ts, mysqld, &fakeVREngine{}, &faketmclient.FakeTabletManagerClient{},
+		func() binlogplayer.DBClient { return dbClient }, "db")
+	vde.Open(ctx, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+	})
+	defer vde.Close()
+	dbClient.Wait()
+
+	// The vdiff stops after comparing two rows, while the source stream
+	// is blocked.
+	lastpk := &querypb.QueryResult{
+		Fields: testFields[:1],
+		Rows:   []*querypb.Row{sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(2)})},
+	}
+	twoRows := &DiffReport{TableName: "t1", ProcessedRows: 2, MatchingRows: 2}
+	dbClient.ExpectRequest("select vdiff_uuid from _vt.vdiff where db_name = 'db' and workflow = 'wf' and state in ('pending', 'started') limit 1", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values('u1', 'wf', 'ks', '0', 'db', 'pending', '{}')", &sqltypes.Result{InsertID: 1}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'started', last_error = '', started_at = ifnull(started_at, now()) where id = 1", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select table_name, state, lastpk, report from _vt.vdiff_table where vdiff_id = 1", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("insert into _vt.vdiff_table(vdiff_id, table_name, state) values(1, 't1', 'pending')", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(progressQuery(StateStarted, nil, &DiffReport{TableName: "t1"}), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(progressQuery(StateStarted, lastpk, twoRows), &sqltypes.Result{}, nil)
+	resp, err := vde.PerformAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: StartAction, VdiffUuid: "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, &tabletmanagerdatapb.VDiffResponse{Id: 1, VdiffUuid: "u1"}, resp)
+	dbClient.Wait()
+
+	// Stopping it saves its progress.
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where vdiff_uuid = 'u1' and db_name = 'db'", vdiffResult(StateStarted), nil)
+	dbClient.ExpectRequest(progressQuery(StateStarted, lastpk, twoRows), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'stopped', last_error = '' where id = 1", &sqltypes.Result{RowsAffected: 1}, nil)
+	_, err = vde.PerformAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: StopAction, VdiffUuid: "u1"})
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	// Resuming it compares the rows after the lastpk.
+	reportJSON, _ := json.Marshal(twoRows)
+	tables := &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("table_name|state|lastpk|report", "varbinary|varbinary|varbinary|varbinary"),
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("t1"),
+			sqltypes.NewVarBinary(StateStarted),
+			sqltypes.NewVarBinary(proto.CompactTextString(lastpk)),
+			sqltypes.NewVarBinary(string(reportJSON)),
+		}},
+	}
+	lastpk5 := &querypb.QueryResult{
+		Fields: testFields[:1],
+		Rows:   []*querypb.Row{sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(5)})},
+	}
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where vdiff_uuid = 'u1' and db_name = 'db'", vdiffResult(StateStopped), nil)
+	dbClient.ExpectRequest("select vdiff_uuid from _vt.vdiff where db_name = 'db' and workflow = 'wf' and state in ('pending', 'started') limit 1", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'pending', last_error = '' where id = 1", &sqltypes.Result{RowsAffected: 1}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'started', last_error = '', started_at = ifnull(started_at, now()) where id = 1", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select table_name, state, lastpk, report from _vt.vdiff_table where vdiff_id = 1", tables, nil)
+	dbClient.ExpectRequest(progressQuery(StateStarted, lastpk, twoRows), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(progressQuery(StateStarted, lastpk, twoRows), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(progressQuery(StateStarted, &querypb.QueryResult{
+		Fields: testFields[:1],
+		Rows:   []*querypb.Row{sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(4)})},
+	}, &DiffReport{TableName: "t1", ProcessedRows: 4, MatchingRows: 4}), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(progressQuery(StateCompleted, lastpk5, &DiffReport{TableName: "t1", ProcessedRows: 5, MatchingRows: 5}), &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state = 'completed', completed_at = now() where id = 1", &sqltypes.Result{}, nil)
+	_, err = vde.PerformAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: "wf", Action: ResumeAction, VdiffUuid: "u1"})
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	for _, ft := range []*fakeTablet{source, target} {
+		lastpks := ft.receivedLastPKs()
+		require.Len(t, lastpks, 2)
+		assert.Nil(t, lastpks[0])
+		assert.True(t, proto.Equal(lastpk, lastpks[1]), "lastpk: %v, want %v", lastpks[1], lastpk)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import "vitess.io/vitess/go/vt/withddl"
+
+const (
+	// _vt.vdiff contains one row per vdiff, and _vt.vdiff_table the
+	// progress and report of each of its tables.
+	createVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint(20) auto_increment,
+  vdiff_uuid varchar(64) not null,
+  workflow varbinary(1000),
+  keyspace varbinary(256),
+  shard varchar(255) not null,
+  db_name varbinary(255),
+  state varbinary(64),
+  options json,
+  created_at timestamp default current_timestamp,
+  started_at timestamp null default null,
+  completed_at timestamp null default null,
+  last_error varbinary(512),
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key workflow_idx (db_name, workflow))`
+
+	createVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id bigint(20) not null,
+  table_name varbinary(128) not null,
+  state varbinary(64),
+  lastpk varbinary(2000),
+  rows_compared bigint not null default 0,
+  mismatch tinyint(1) not null default 0,
+  report json,
+  updated_at timestamp default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name))`
+)
+
+// The states of a vdiff and of its tables.
+const (
+	// StatePending is the state of a vdiff that is waiting for the
+	// engine to run it.
+	StatePending = "pending"
+	// StateStarted is the state of a running vdiff.
+	StateStarted = "started"
+	// StateStopped is the state of a vdiff that was stopped by a user.
+	StateStopped = "stopped"
+	// StateCompleted is the state of a finished vdiff.
+	StateCompleted = "completed"
+	// StateError is the state of a vdiff that failed. It can be resumed.
+	StateError = "error"
+)
+
+const (
+	sqlNewVDiff       = "insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values(%s, %s, %s, %s, %s, %s, %s)"
+	sqlGetVDiffByUUID = "select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where vdiff_uuid = %s and db_name = %s"
+	sqlGetLastVDiff   = "select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where db_name = %s and workflow = %s order by id desc limit 1"
+	sqlGetActiveVDiff = "select vdiff_uuid from _vt.vdiff where db_name = %s and workflow = %s and state in ('pending', 'started') limit 1"
+	sqlGetVDiffsToRun = "select id, vdiff_uuid, workflow, keyspace, shard, db_name, state, options, last_error from _vt.vdiff where db_name = %s and state in ('pending', 'started') order by id"
+	sqlGetAllVDiffs   = "select id, vdiff_uuid, workflow, state, created_at, started_at, completed_at, last_error from _vt.vdiff where db_name = %s and workflow = %s order by id"
+	sqlGetVDiffReport = "select v.vdiff_uuid, v.state, v.started_at, v.completed_at, v.last_error, vt.table_name, vt.state as table_state, vt.rows_compared, vt.mismatch, vt.report from _vt.vdiff as v left join _vt.vdiff_table as vt on v.id = vt.vdiff_id where v.id = %d order by vt.table_name"
+
+	sqlUpdateVDiffState    = "update _vt.vdiff set state = %s, last_error = %s where id = %d"
+	sqlStartVDiff          = "update _vt.vdiff set state = 'started', last_error = '', started_at = ifnull(started_at, now()) where id = %d"
+	sqlCompleteVDiff       = "update _vt.vdiff set state = 'completed', completed_at = now() where id = %d"
+	sqlDeleteVDiff         = "delete from _vt.vdiff where id = %d"
+	sqlDeleteVDiffTables   = "delete from _vt.vdiff_table where vdiff_id = %d"
+	sqlGetVDiffTables      = "select table_name, state, lastpk, report from _vt.vdiff_table where vdiff_id = %d"
+	sqlNewVDiffTable       = "insert into _vt.vdiff_table(vdiff_id, table_name, state) values(%d, %s, 'pending')"
+	sqlUpdateTableProgress = "update _vt.vdiff_table set state = %s, lastpk = %s, rows_compared = %d, mismatch = %d, report = %s where vdiff_id = %d and table_name = %s"
+)
+
+var withDDL = withddl.New([]string{createVDiffTable, createVDiffTableTable})
@@ -0,0 +1,601 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+)
+
+const (
+	// defaultTabletTypes are the source tablet types used when the
+	// options of the vdiff don't specify them.
+	defaultTabletTypes = "master,replica,rdonly"
+	// defaultWaitTime is the time to wait for the sources and the
+	// workflow to reach a position, when the options don't specify it.
+	defaultWaitTime = 30 * time.Second
+)
+
+// progressInterval is the number of rows after which the progress of a
+// table is persisted. It can be changed for tests.
+var progressInterval int64 = 10000
+
+// DiffReport is the summary of the differences found in one table.
+type DiffReport struct {
+	TableName       string
+	ProcessedRows   int64
+	MatchingRows    int64
+	MismatchedRows  int64
+	ExtraRowsSource int64
+	ExtraRowsTarget int64
+}
+
+// tableDiffer diffs one table, from the persisted lastpk if the table was
+// partially diffed.
+type tableDiffer struct {
+	ct     *controller
+	plan   *tablePlan
+	state  *tableState
+	lastpk []sqltypes.Value
+
+	// cp is set once the streams are started.
+	cp *comparePlan
+}
+
+// stream is a vreplication stream of the workflow.
+type stream struct {
+	id  int
+	bls binlogdatapb.BinlogSource
+	pos string
+}
+
+func newTableDiffer(ct *controller, plan *tablePlan, state *tableState) *tableDiffer {
+	return &tableDiffer{
+		ct:    ct,
+		plan:  plan,
+		state: state,
+	}
+}
+
+// diff takes a consistent snapshot of the sources and the target, and
+// compares their rows from the lastpk. The progress is persisted
+// periodically, and when the diff ends, whether it succeeded or not.
+func (td *tableDiffer) diff(ctx context.Context, dbClient binlogplayer.DBClient) error {
+	log.Infof("Starting vdiff of table %s", td.plan.table)
+	// The streams must be aborted if one of them fails.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sources, target, err := td.startStreams(ctx)
+	if err != nil {
+		return err
+	}
+	if td.cp, err = newComparePlan(td.plan.table, sources[0].fields, sources[0].pkFields, target.fields, target.pkFields); err != nil {
+		return err
+	}
+	if err := td.updateProgress(ctx, dbClient, StateStarted); err != nil {
+		return err
+	}
+	err = td.compare(newMergeIterator(sources, td.cp.sourcePKs), target, func() error {
+		return td.updateProgress(ctx, dbClient, StateStarted)
+	})
+	if err != nil {
+		// Save what was compared so far, so that a resume doesn't
+		// start over.
+		if perr := td.updateProgress(context.Background(), dbClient, StateStarted); perr != nil {
+			log.Errorf("Could not save the progress of table %s: %v", td.plan.table, perr)
+		}
+		return err
+	}
+	log.Infof("Completed vdiff of table %s: %+v", td.plan.table, *td.state.report)
+	return td.updateProgress(ctx, dbClient, StateCompleted)
+}
+
+// startStreams stops the streams of the workflow, and starts streaming the
+// rows of the sources and of the target as of the same position:
+// 1. The streams are stopped, and their positions recorded.
+// 2. The source tablets catch up to those positions, and start streaming
+// from a snapshot, which yields their new positions.
+// 3. The streams catch up to the new positions, and stop there.
+// 4. This tablet starts streaming from a snapshot.
+// The streams of the workflow are restarted before returning.
+func (td *tableDiffer) startStreams(ctx context.Context) ([]*shardStreamer, *shardStreamer, error) {
+	vde := td.ct.vde
+	defer td.restartStreams()
+
+	streams, err := td.stopStreams()
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "stopStreams")
+	}
+
+	waitTime := defaultWaitTime
+	if td.ct.options.FilteredReplicationWaitTime > 0 {
+		waitTime = time.Duration(td.ct.options.FilteredReplicationWaitTime) * time.Second
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, waitTime)
+	defer cancel()
+
+	sources := make([]*shardStreamer, len(streams))
+	if err := forAll(len(streams), func(i int) error {
+		source, err := td.startSourceStream(ctx, waitCtx, streams[i])
+		sources[i] = source
+		return err
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	if err := forAll(len(streams), func(i int) error {
+		return td.syncStream(waitCtx, streams[i].id, sources[i].gtid)
+	}); err != nil {
+		return nil, nil, vterrors.Wrap(err, "syncStreams")
+	}
+
+	target := &shardStreamer{
+		tablet: vde.thisTablet,
+		target: &querypb.Target{
+			Keyspace:   vde.thisTablet.Keyspace,
+			Shard:      vde.thisTablet.Shard,
+			TabletType: topodatapb.TabletType_MASTER,
+		},
+	}
+	if err := target.start(ctx, td.plan.targetQuery, td.state.lastpk); err != nil {
+		return nil, nil, vterrors.Wrap(err, "target stream")
+	}
+	return sources, target, nil
+}
+
+// stopStreams stops the streams of the workflow, and returns their
+// positions.
+func (td *tableDiffer) stopStreams() ([]*stream, error) {
+	vde := td.ct.vde
+	where := fmt.Sprintf("db_name = %s and workflow = %s", encodeString(vde.dbName), encodeString(td.ct.workflow))
+	if _, err := vde.vre.Exec("update _vt.vreplication set state = 'Stopped', message = 'for vdiff' where " + where); err != nil {
+		return nil, err
+	}
+	qr, err := vde.vre.Exec("select id, source, pos from _vt.vreplication where " + where)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("workflow %s not found in %s", td.ct.workflow, vde.dbName)
+	}
+	var streams []*stream
+	for _, row := range qr.Rows {
+		id, err := evalengine.ToInt64(row[0])
+		if err != nil {
+			return nil, err
+		}
+		st := &stream{id: int(id), pos: row[2].ToString()}
+		if err := proto.UnmarshalText(row[1].ToString(), &st.bls); err != nil {
+			return nil, err
+		}
+		if st.bls.ExternalCluster != "" {
+			return nil, fmt.Errorf("vdiff does not support the external cluster %s of workflow %s", st.bls.ExternalCluster, td.ct.workflow)
+		}
+		if st.pos == "" {
+			return nil, fmt.Errorf("workflow %s: stream %d has not started", td.ct.workflow, st.id)
+		}
+		streams = append(streams, st)
+	}
+	return streams, nil
+}
+
+// startSourceStream picks a tablet of the source shard of the stream,
+// waits for it to reach the position of the stream, and starts
+// streaming from it.
+func (td *tableDiffer) startSourceStream(ctx, waitCtx context.Context, st *stream) (*shardStreamer, error) {
+	vde := td.ct.vde
+	sourceCell := td.ct.options.SourceCell
+	if sourceCell == "" {
+		sourceCell = vde.thisTablet.Alias.Cell
+	}
+	tabletTypes := td.ct.options.TabletTypes
+	if tabletTypes == "" {
+		tabletTypes = defaultTabletTypes
+	}
+	tp, err := discovery.NewTabletPicker(vde.ts, []string{sourceCell}, st.bls.Keyspace, st.bls.Shard, tabletTypes)
+	if err != nil {
+		return nil, err
+	}
+	tablet, err := tp.PickForStreaming(waitCtx)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("WaitForPosition: tablet %s should reach position %s", topoproto.TabletAliasString(tablet.Alias), st.pos)
+	if err := vde.tmc.WaitForPosition(waitCtx, tablet, st.pos); err != nil {
+		return nil, vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(tablet.Alias))
+	}
+	source := &shardStreamer{
+		tablet: tablet,
+		target: &querypb.Target{
+			Keyspace:   st.bls.Keyspace,
+			Shard:      st.bls.Shard,
+			TabletType: tablet.Type,
+		},
+	}
+	if err := source.start(ctx, td.plan.sourceQuery, td.state.lastpk); err != nil {
+		return nil, vterrors.Wrapf(err, "source stream from tablet %v", topoproto.TabletAliasString(tablet.Alias))
+	}
+	return source, nil
+}
+
+// syncStream runs the stream until it reaches the snapshot position of
+// its source.
+func (td *tableDiffer) syncStream(ctx context.Context, id int, pos string) error {
+	vde := td.ct.vde
+	query := fmt.Sprintf("update _vt.vreplication set state = 'Running', stop_pos = %s, message = 'synchronizing for vdiff' where id = %d", encodeString(pos), id)
+	if _, err := vde.vre.Exec(query); err != nil {
+		return err
+	}
+	return vde.vre.WaitForPos(ctx, id, pos)
+}
+
+// restartStreams restarts the streams of the workflow.
+func (td *tableDiffer) restartStreams() {
+	vde := td.ct.vde
+	query := fmt.Sprintf("update _vt.vreplication set state = 'Running', message = '', stop_pos = '' where db_name = %s and workflow = %s", encodeString(vde.dbName), encodeString(td.ct.workflow))
+	if _, err := vde.vre.Exec(query); err != nil {
+		log.Errorf("Could not restart workflow %s: %v, please restart it manually", td.ct.workflow, err)
+	}
+}
+
+// compare merges the source rows and compares them with the target rows.
+// progress is called every progressInterval rows.
+func (td *tableDiffer) compare(source, target rowIterator, progress func() error) error {
+	dr := td.state.report
+	maxRows := td.ct.options.MaxRows
+	var sourceRow, targetRow []sqltypes.Value
+	var err error
+	advanceSource := true
+	advanceTarget := true
+	for {
+		if maxRows > 0 && dr.ProcessedRows >= maxRows {
+			log.Infof("Stopping vdiff of table %s, specified limit reached", td.plan.table)
+			return nil
+		}
+		if dr.ProcessedRows > 0 && dr.ProcessedRows%progressInterval == 0 {
+			if err := progress(); err != nil {
+				return err
+			}
+		}
+		if advanceSource {
+			if sourceRow, err = source.next(); err != nil {
+				return err
+			}
+		}
+		if advanceTarget {
+			if targetRow, err = target.next(); err != nil {
+				return err
+			}
+		}
+		if sourceRow == nil && targetRow == nil {
+			return nil
+		}
+		advanceSource = true
+		advanceTarget = true
+
+		dr.ProcessedRows++
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			if c, err = compareRows(sourceRow, td.cp.sourcePKs, targetRow, td.cp.targetPKs); err != nil {
+				return err
+			}
+		}
+		switch {
+		case c < 0:
+			if dr.ExtraRowsSource < 10 {
+				log.Errorf("[table=%v] Extra row %v on source: %v", td.plan.table, dr.ExtraRowsSource, sourceRow)
+			}
+			dr.ExtraRowsSource++
+			td.lastpk = pkValues(sourceRow, td.cp.sourcePKs)
+			advanceTarget = false
+			continue
+		case c > 0:
+			if dr.ExtraRowsTarget < 10 {
+				log.Errorf("[table=%v] Extra row %v on target: %v", td.plan.table, dr.ExtraRowsTarget, targetRow)
+			}
+			dr.ExtraRowsTarget++
+			td.lastpk = pkValues(targetRow, td.cp.targetPKs)
+			advanceSource = false
+			continue
+		}
+
+		td.lastpk = pkValues(targetRow, td.cp.targetPKs)
+		c, err = compareRows(sourceRow, td.cp.sourceCols, targetRow, td.cp.targetCols)
+		switch {
+		case err != nil:
+			return err
+		case c != 0:
+			if dr.MismatchedRows < 10 {
+				log.Errorf("[table=%v] Different content %v in same PK: %v != %v", td.plan.table, dr.MismatchedRows, sourceRow, targetRow)
+			}
+			dr.MismatchedRows++
+		default:
+			dr.MatchingRows++
+		}
+	}
+}
+
+// updateProgress persists the report of the table, and the primary key
+// of the last row that was compared.
+func (td *tableDiffer) updateProgress(ctx context.Context, dbClient binlogplayer.DBClient, state string) error {
+	if td.lastpk != nil {
+		td.state.lastpk = &querypb.QueryResult{
+			Fields: td.cp.pkFields,
+			Rows:   []*querypb.Row{sqltypes.RowToProto3(td.lastpk)},
+		}
+	}
+	lastpk := ""
+	if td.state.lastpk != nil {
+		lastpk = proto.CompactTextString(td.state.lastpk)
+	}
+	dr := td.state.report
+	report, err := json.Marshal(dr)
+	if err != nil {
+		return err
+	}
+	mismatch := 0
+	if dr.MismatchedRows > 0 || dr.ExtraRowsSource > 0 || dr.ExtraRowsTarget > 0 {
+		mismatch = 1
+	}
+	query := fmt.Sprintf(sqlUpdateTableProgress, encodeString(state), encodeString(lastpk), dr.ProcessedRows, mismatch,
+		encodeString(string(report)), td.ct.id, encodeString(td.plan.table))
+	if _, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch); err != nil {
+		return err
+	}
+	td.state.state = state
+	return nil
+}
+
+// compareRows compares the columns cols1 of row1 with the columns cols2
+// of row2.
+func compareRows(row1 []sqltypes.Value, cols1 []int, row2 []sqltypes.Value, cols2 []int) (int, error) {
+	for i := range cols1 {
+		c, err := compareValues(row1[cols1[i]], row2[cols2[i]])
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// compareValues compares numbers numerically, and all the other values
+// by their bytes. The rows are streamed with the binary character set,
+// so the bytes of text values are compared as is.
+func compareValues(v1, v2 sqltypes.Value) (int, error) {
+	if !v1.IsNull() && !v2.IsNull() && !sqltypes.IsNumber(v1.Type()) && !sqltypes.IsNumber(v2.Type()) {
+		return bytes.Compare(v1.Raw(), v2.Raw()), nil
+	}
+	return evalengine.NullsafeCompare(v1, v2)
+}
+
+func pkValues(row []sqltypes.Value, pks []int) []sqltypes.Value {
+	values := make([]sqltypes.Value, len(pks))
+	for i, pk := range pks {
+		values[i] = row[pk]
+	}
+	return values
+}
+
+func forAll(n int, f func(int) error) error {
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := f(i); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+//-----------------------------------------------------------------
+// rowIterator
+
+// rowIterator returns the rows of a stream, in the order of the primary
+// key. next returns a nil row at the end of the stream.
+type rowIterator interface {
+	next() ([]sqltypes.Value, error)
+}
+
+// mergeIterator merges the rows of the source shards.
+type mergeIterator struct {
+	inputs []rowIterator
+	pks    []int
+	// heads contains the next row of every input, nil once an input
+	// is exhausted.
+	heads   [][]sqltypes.Value
+	started bool
+}
+
+func newMergeIterator(sources []*shardStreamer, pks []int) *mergeIterator {
+	inputs := make([]rowIterator, len(sources))
+	for i, source := range sources {
+		inputs[i] = source
+	}
+	return &mergeIterator{
+		inputs: inputs,
+		pks:    pks,
+		heads:  make([][]sqltypes.Value, len(sources)),
+	}
+}
+
+func (mi *mergeIterator) next() ([]sqltypes.Value, error) {
+	if !mi.started {
+		mi.started = true
+		for i, input := range mi.inputs {
+			row, err := input.next()
+			if err != nil {
+				return nil, err
+			}
+			mi.heads[i] = row
+		}
+	}
+	min := -1
+	for i, head := range mi.heads {
+		if head == nil {
+			continue
+		}
+		if min != -1 {
+			c, err := compareRows(head, mi.pks, mi.heads[min], mi.pks)
+			if err != nil {
+				return nil, err
+			}
+			if c >= 0 {
+				continue
+			}
+		}
+		min = i
+	}
+	if min == -1 {
+		return nil, nil
+	}
+	row := mi.heads[min]
+	next, err := mi.inputs[min].next()
+	if err != nil {
+		return nil, err
+	}
+	mi.heads[min] = next
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+// shardStreamer streams the rows of a table from one tablet with
+// VStreamRows.
+type shardStreamer struct {
+	tablet *topodatapb.Tablet
+	target *querypb.Target
+
+	// The following fields are set by start.
+	fields   []*querypb.Field
+	pkFields []*querypb.Field
+	gtid     string
+	results  chan [][]sqltypes.Value
+	err      error
+
+	rows [][]sqltypes.Value
+}
+
+// start starts the stream, and waits for its first response, which
+// contains the fields and the position of the snapshot.
+func (ss *shardStreamer) start(ctx context.Context, query string, lastpk *querypb.QueryResult) error {
+	ss.results = make(chan [][]sqltypes.Value, 1)
+	ready := make(chan struct{})
+	go func() {
+		defer close(ss.results)
+		// The error is set before the channels are closed.
+		ss.err = func() error {
+			defer func() {
+				select {
+				case <-ready:
+				default:
+					close(ready)
+				}
+			}()
+			conn, err := tabletconn.GetDialer()(ss.tablet, grpcclient.FailFast(false))
+			if err != nil {
+				return err
+			}
+			defer conn.Close(ctx)
+
+			return conn.VStreamRows(ctx, ss.target, query, lastpk, func(vrs *binlogdatapb.VStreamRowsResponse) error {
+				if vrs.Fields != nil {
+					ss.fields = vrs.Fields
+					ss.pkFields = vrs.Pkfields
+					ss.gtid = vrs.Gtid
+					close(ready)
+				}
+				if len(vrs.Rows) == 0 {
+					return nil
+				}
+				rows := make([][]sqltypes.Value, len(vrs.Rows))
+				for i, row := range vrs.Rows {
+					rows[i] = sqltypes.MakeRowTrusted(ss.fields, row)
+				}
+				select {
+				case ss.results <- rows:
+				case <-ctx.Done():
+					return vterrors.Wrap(ctx.Err(), "VStreamRows")
+				}
+				return nil
+			})
+		}()
+	}()
+
+	<-ready
+	if ss.fields == nil {
+		// The stream ended before its first response.
+		for range ss.results {
+		}
+		if ss.err != nil {
+			return ss.err
+		}
+		return fmt.Errorf("stream from tablet %v ended without fields", topoproto.TabletAliasString(ss.tablet.Alias))
+	}
+	if _, err := mysql.DecodePosition(ss.gtid); err != nil {
+		return vterrors.Wrapf(err, "invalid snapshot position of tablet %v", topoproto.TabletAliasString(ss.tablet.Alias))
+	}
+	return nil
+}
+
+func (ss *shardStreamer) next() ([]sqltypes.Value, error) {
+	for len(ss.rows) == 0 {
+		rows, ok := <-ss.results
+		if !ok {
+			return nil, ss.err
+		}
+		ss.rows = rows
+	}
+	row := ss.rows[0]
+	ss.rows = ss.rows[1:]
+	return row, nil
+}
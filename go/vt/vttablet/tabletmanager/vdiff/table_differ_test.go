@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var testFields = sqltypes.MakeTestFields("id|val", "int64|varchar")
+
+// newTestStreamer returns a shardStreamer that streams the rows, in
+// batches of two.
+func newTestStreamer(rows ...string) *shardStreamer {
+	result := sqltypes.MakeTestResult(testFields, rows...)
+	ss := &shardStreamer{results: make(chan [][]sqltypes.Value, len(rows))}
+	for i := 0; i < len(result.Rows); i += 2 {
+		end := i + 2
+		if end > len(result.Rows) {
+			end = len(result.Rows)
+		}
+		ss.results <- result.Rows[i:end]
+	}
+	close(ss.results)
+	return ss
+}
+
+func newTestTableDiffer(maxRows int64) *tableDiffer {
+	return &tableDiffer{
+		ct:    &controller{options: &tabletmanagerdatapb.VDiffOptions{MaxRows: maxRows}},
+		plan:  &tablePlan{table: "t1"},
+		state: &tableState{report: &DiffReport{TableName: "t1"}},
+		cp: &comparePlan{
+			pkFields:   testFields[:1],
+			sourcePKs:  []int{0},
+			targetPKs:  []int{0},
+			sourceCols: []int{1},
+			targetCols: []int{1},
+		},
+	}
+}
+
+func readAll(t *testing.T, it rowIterator) []string {
+	t.Helper()
+	var rows []string
+	for {
+		row, err := it.next()
+		require.NoError(t, err)
+		if row == nil {
+			return rows
+		}
+		rows = append(rows, row[0].ToString()+"|"+row[1].ToString())
+	}
+}
+
+func TestMergeIterator(t *testing.T) {
+	sources := []*shardStreamer{
+		newTestStreamer("1|a", "4|d", "5|e"),
+		newTestStreamer(),
+		newTestStreamer("2|b", "3|c", "10|j"),
+	}
+	rows := readAll(t, newMergeIterator(sources, []int{0}))
+	assert.Equal(t, []string{"1|a", "2|b", "3|c", "4|d", "5|e", "10|j"}, rows)
+}
+
+func TestTableDifferCompare(t *testing.T) {
+	td := newTestTableDiffer(0)
+	source := newMergeIterator([]*shardStreamer{
+		newTestStreamer("1|a", "3|c", "7|g"),
+		newTestStreamer("2|b", "4|d", "6|f"),
+	}, []int{0})
+	target := newTestStreamer("1|a", "2|x", "4|d", "5|e", "6|f")
+
+	require.NoError(t, td.compare(source, target, func() error { return nil }))
+	assert.Equal(t, &DiffReport{
+		TableName:       "t1",
+		ProcessedRows:   7,
+		MatchingRows:    3,
+		MismatchedRows:  1,
+		ExtraRowsSource: 2,
+		ExtraRowsTarget: 1,
+	}, td.state.report)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(7)}, td.lastpk)
+}
+
+func TestTableDifferCompareMaxRows(t *testing.T) {
+	defer func(saved int64) { progressInterval = saved }(progressInterval)
+	progressInterval = 2
+
+	td := newTestTableDiffer(5)
+	// The table was partially diffed before.
+	td.state.report.ProcessedRows = 1
+	td.state.report.MatchingRows = 1
+	source := newTestStreamer("2|b", "3|c", "4|d", "5|e", "6|f")
+	target := newTestStreamer("2|b", "3|c", "4|d", "5|e", "6|f")
+
+	var progress []int64
+	require.NoError(t, td.compare(source, target, func() error {
+		progress = append(progress, td.state.report.ProcessedRows)
+		return nil
+	}))
+	assert.Equal(t, int64(5), td.state.report.ProcessedRows)
+	assert.Equal(t, int64(5), td.state.report.MatchingRows)
+	assert.Equal(t, []int64{2, 4}, progress)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(5)}, td.lastpk)
+}
+
+func TestCompareValues(t *testing.T) {
+	testcases := []struct {
+		v1, v2 sqltypes.Value
+		want   int
+	}{
+		{sqltypes.NewInt64(2), sqltypes.NewInt64(10), -1},
+		{sqltypes.NewVarChar("b"), sqltypes.NewVarChar("a"), 1},
+		{sqltypes.NewVarChar("a"), sqltypes.NewVarBinary("a"), 0},
+		// Text is compared by bytes, so case matters.
+		{sqltypes.NewVarChar("A"), sqltypes.NewVarChar("a"), -1},
+		{sqltypes.NULL, sqltypes.NewVarChar("a"), -1},
+		{sqltypes.NULL, sqltypes.NULL, 0},
+	}
+	for _, tcase := range testcases {
+		got, err := compareValues(tcase.v1, tcase.v2)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got, "compareValues(%v, %v)", tcase.v1, tcase.v2)
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/key"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+)
+
+// tablePlan contains the queries that stream the rows of one table
+// from the sources and from the target.
+type tablePlan struct {
+	table string
+	// sourceQuery is the filter of the workflow rule that matched the
+	// table. The source tablets apply the in_keyrange clauses, if any.
+	sourceQuery string
+	// targetQuery streams all the columns of the target table.
+	targetQuery string
+}
+
+// buildTablePlans builds the plans of the tables of the workflow. If
+// tables is not empty, only those tables are included.
+func buildTablePlans(schm *tabletmanagerdatapb.SchemaDefinition, filter *binlogdatapb.Filter, tables []string) (map[string]*tablePlan, error) {
+	plans := make(map[string]*tablePlan)
+	for _, table := range schm.TableDefinitions {
+		if len(tables) > 0 && !containsTable(tables, table.Name) {
+			continue
+		}
+		rule, err := vreplication.MatchTable(table.Name, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == vreplication.ExcludeStr {
+			continue
+		}
+		plan, err := buildTablePlan(table.Name, rule.Filter)
+		if err != nil {
+			return nil, err
+		}
+		plans[table.Name] = plan
+	}
+	for _, table := range tables {
+		if _, ok := plans[table]; !ok {
+			return nil, fmt.Errorf("table %s is not present in the workflow", table)
+		}
+	}
+	return plans, nil
+}
+
+func buildTablePlan(table, filter string) (*tablePlan, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+	plan := &tablePlan{
+		table:       table,
+		sourceQuery: filter,
+		targetQuery: buf.String(),
+	}
+	switch {
+	case filter == "":
+		plan.sourceQuery = plan.targetQuery
+		return plan, nil
+	case key.IsKeyRange(filter):
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(table), sqlparser.NewStrLiteral(filter))
+		plan.sourceQuery = buf.String()
+		return plan, nil
+	}
+
+	statement, err := sqlparser.Parse(filter)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected filter for table %s: %v", table, sqlparser.String(statement))
+	}
+	// The rows are compared in the order of their primary key, so
+	// aggregations of the source rows are not supported.
+	if len(sel.GroupBy) > 0 {
+		return nil, fmt.Errorf("vdiff does not support the group by of the filter of table %s: %v", table, filter)
+	}
+	for _, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+		if funcExpr, ok := aliased.Expr.(*sqlparser.FuncExpr); ok && funcExpr.IsAggregate() {
+			return nil, fmt.Errorf("vdiff does not support the aggregate %v of the filter of table %s", sqlparser.String(funcExpr), table)
+		}
+	}
+	return plan, nil
+}
+
+func containsTable(tables []string, table string) bool {
+	for _, t := range tables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
+
+// comparePlan maps the columns of the source rows to the columns of the
+// target rows. It's built from the fields returned by the streams.
+type comparePlan struct {
+	// pkFields are the primary key fields of the target table. They
+	// are the fields of the persisted lastpk.
+	pkFields []*querypb.Field
+	// sourcePKs and targetPKs are the indexes of the primary key
+	// columns in the source and target rows.
+	sourcePKs []int
+	targetPKs []int
+	// sourceCols and targetCols are the indexes of the other columns
+	// that are present in both the source and target rows.
+	sourceCols []int
+	targetCols []int
+}
+
+// newComparePlan builds a comparePlan. The rows of the source and target
+// are streamed in the order of their primary key, which must therefore
+// be the same.
+func newComparePlan(table string, sourceFields, sourcePKFields, targetFields, targetPKFields []*querypb.Field) (*comparePlan, error) {
+	if !sameFieldNames(sourcePKFields, targetPKFields) {
+		return nil, fmt.Errorf("the primary key of table %s differs between the source %v and the target %v", table, fieldNames(sourcePKFields), fieldNames(targetPKFields))
+	}
+	cp := &comparePlan{pkFields: targetPKFields}
+	isPK := make(map[string]bool)
+	for _, pkField := range targetPKFields {
+		name := strings.ToLower(pkField.Name)
+		isPK[name] = true
+		sourceIndex := fieldIndex(sourceFields, name)
+		if sourceIndex == -1 {
+			return nil, fmt.Errorf("primary key column %s of table %s is not selected by the source query", pkField.Name, table)
+		}
+		targetIndex := fieldIndex(targetFields, name)
+		if targetIndex == -1 {
+			// Unreachable.
+			return nil, fmt.Errorf("primary key column %s not found in table %s", pkField.Name, table)
+		}
+		cp.sourcePKs = append(cp.sourcePKs, sourceIndex)
+		cp.targetPKs = append(cp.targetPKs, targetIndex)
+	}
+	for i, field := range targetFields {
+		name := strings.ToLower(field.Name)
+		if isPK[name] {
+			continue
+		}
+		// Columns of the target that are not populated from the
+		// source cannot be compared.
+		sourceIndex := fieldIndex(sourceFields, name)
+		if sourceIndex == -1 {
+			continue
+		}
+		cp.sourceCols = append(cp.sourceCols, sourceIndex)
+		cp.targetCols = append(cp.targetCols, i)
+	}
+	return cp, nil
+}
+
+func fieldIndex(fields []*querypb.Field, lowerName string) int {
+	for i, field := range fields {
+		if strings.ToLower(field.Name) == lowerName {
+			return i
+		}
+	}
+	return -1
+}
+
+func sameFieldNames(fields1, fields2 []*querypb.Field) bool {
+	if len(fields1) != len(fields2) {
+		return false
+	}
+	for i := range fields1 {
+		if !strings.EqualFold(fields1[i].Name, fields2[i].Name) {
+			return false
+		}
+	}
+	return true
+}
+
+func fieldNames(fields []*querypb.Field) []string {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name
+	}
+	return names
+}
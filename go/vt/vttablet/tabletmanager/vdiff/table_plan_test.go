@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestBuildTablePlans(t *testing.T) {
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1"},
+			{Name: "t2"},
+			{Name: "t3"},
+		},
+	}
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{
+			{Match: "t1", Filter: "select id, val from t1 where in_keyrange('-80')"},
+			{Match: "t2", Filter: "-80"},
+			{Match: "t3", Filter: "exclude"},
+		},
+	}
+
+	plans, err := buildTablePlans(schm, filter, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*tablePlan{
+		"t1": {
+			table:       "t1",
+			sourceQuery: "select id, val from t1 where in_keyrange('-80')",
+			targetQuery: "select * from t1",
+		},
+		"t2": {
+			table:       "t2",
+			sourceQuery: "select * from t2 where in_keyrange('-80')",
+			targetQuery: "select * from t2",
+		},
+	}, plans)
+
+	plans, err = buildTablePlans(schm, filter, []string{"t2"})
+	require.NoError(t, err)
+	assert.Len(t, plans, 1)
+	assert.Contains(t, plans, "t2")
+
+	_, err = buildTablePlans(schm, filter, []string{"t3"})
+	assert.EqualError(t, err, "table t3 is not present in the workflow")
+}
+
+func TestBuildTablePlanUnsupported(t *testing.T) {
+	testcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select id, count(*) as c from t1 group by id",
+		err:    "vdiff does not support the group by of the filter of table t1: select id, count(*) as c from t1 group by id",
+	}, {
+		filter: "select id, sum(val) from t1",
+		err:    "vdiff does not support the aggregate sum(val) of the filter of table t1",
+	}, {
+		filter: "delete from t1",
+		err:    "unexpected filter for table t1: delete from t1",
+	}}
+	for _, tcase := range testcases {
+		_, err := buildTablePlan("t1", tcase.filter)
+		assert.EqualError(t, err, tcase.err, tcase.filter)
+	}
+}
+
+func TestNewComparePlan(t *testing.T) {
+	sourceFields := sqltypes.MakeTestFields("val|ID|extra", "varchar|int64|int64")
+	targetFields := sqltypes.MakeTestFields("id|val|updated", "int64|varchar|int64")
+	pkFields := sqltypes.MakeTestFields("id", "int64")
+
+	cp, err := newComparePlan("t1", sourceFields, sourceFields[1:2], targetFields, pkFields)
+	require.NoError(t, err)
+	assert.Equal(t, &comparePlan{
+		pkFields:   pkFields,
+		sourcePKs:  []int{1},
+		targetPKs:  []int{0},
+		sourceCols: []int{0},
+		targetCols: []int{1},
+	}, cp)
+
+	_, err = newComparePlan("t1", sourceFields, sourceFields[:1], targetFields, pkFields)
+	assert.EqualError(t, err, "the primary key of table t1 differs between the source [val] and the target [id]")
+}
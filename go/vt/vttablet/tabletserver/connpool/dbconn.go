@@ -105,11 +105,21 @@ func (dbc *DBConn) Err() error {
 // Exec executes the specified query. If there is a connection error, it will reconnect
 // and retry. A failed reconnect will trigger a CheckMySQL.
 func (dbc *DBConn) Exec(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+	return dbc.exec(ctx, query, maxrows, wantfields, nil)
+}
+
+// ExecWithRowCheck is like Exec, but calls check with every row as it is
+// read, and fails the query with the first error it returns.
+func (dbc *DBConn) ExecWithRowCheck(ctx context.Context, query string, maxrows int, wantfields bool, check func(row []sqltypes.Value) error) (*sqltypes.Result, error) {
+	return dbc.exec(ctx, query, maxrows, wantfields, check)
+}
+
+func (dbc *DBConn) exec(ctx context.Context, query string, maxrows int, wantfields bool, check func(row []sqltypes.Value) error) (*sqltypes.Result, error) {
 	span, ctx := trace.NewSpan(ctx, "DBConn.Exec")
 	defer span.Finish()
 
 	for attempt := 1; attempt <= 2; attempt++ {
-		r, err := dbc.execOnce(ctx, query, maxrows, wantfields)
+		r, err := dbc.execOnce(ctx, query, maxrows, wantfields, check)
 		switch {
 		case err == nil:
 			// Success.
@@ -140,7 +150,7 @@ func (dbc *DBConn) Exec(ctx context.Context, query string, maxrows int, wantfiel
 	panic("unreachable")
 }
 
-func (dbc *DBConn) execOnce(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+func (dbc *DBConn) execOnce(ctx context.Context, query string, maxrows int, wantfields bool, check func(row []sqltypes.Value) error) (*sqltypes.Result, error) {
 	dbc.current.Set(query)
 	defer dbc.current.Set("")
 
@@ -155,7 +165,13 @@ func (dbc *DBConn) execOnce(ctx context.Context, query string, maxrows int, want
 	defer dbc.stats.MySQLTimings.Record("Exec", time.Now())
 
 	done, wg := dbc.setDeadline(ctx)
-	qr, err := dbc.conn.ExecuteFetch(query, maxrows, wantfields)
+	var qr *sqltypes.Result
+	var err error
+	if check != nil {
+		qr, err = dbc.conn.ExecuteFetchWithRowCheck(query, maxrows, wantfields, check)
+	} else {
+		qr, err = dbc.conn.ExecuteFetch(query, maxrows, wantfields)
+	}
 
 	if done != nil {
 		close(done)
@@ -169,7 +185,13 @@ func (dbc *DBConn) execOnce(ctx context.Context, query string, maxrows int, want
 
 // ExecOnce executes the specified query, but does not retry on connection errors.
 func (dbc *DBConn) ExecOnce(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
-	return dbc.execOnce(ctx, query, maxrows, wantfields)
+	return dbc.execOnce(ctx, query, maxrows, wantfields, nil)
+}
+
+// ExecOnceWithRowCheck is like ExecOnce, but calls check with every row as
+// it is read, and fails the query with the first error it returns.
+func (dbc *DBConn) ExecOnceWithRowCheck(ctx context.Context, query string, maxrows int, wantfields bool, check func(row []sqltypes.Value) error) (*sqltypes.Result, error) {
+	return dbc.execOnce(ctx, query, maxrows, wantfields, check)
 }
 
 // FetchNext returns the next result set.
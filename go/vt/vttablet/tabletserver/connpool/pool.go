@@ -23,6 +23,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
@@ -57,6 +58,13 @@ type Pool struct {
 	waiterCount        sync2.AtomicInt64
 	dbaPool            *dbconnpool.ConnectionPool
 	appDebugParams     dbconfigs.Connector
+	// sizer adapts the capacity of the pool to the load,
+	// if the pool is adaptive.
+	sizer *poolSizer
+	// waiters orders the requests waiting for a connection by priority.
+	waiters               *priorityWaiters
+	lowPriorityMaxWaiters int
+	shed                  *stats.Counter
 }
 
 // NewPool creates a new Pool. The name is used
@@ -72,6 +80,12 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 		idleTimeout:        idleTimeout,
 		waiterCap:          int64(cfg.MaxWaiters),
 		dbaPool:            dbconnpool.NewConnectionPool("", 1, idleTimeout, 0),
+
+		waiters:               newPriorityWaiters(),
+		lowPriorityMaxWaiters: cfg.LowPriorityMaxWaiters,
+	}
+	if cfg.MaxSize > 0 {
+		cp.sizer = newPoolSizer(cp, cfg)
 	}
 	if name == "" {
 		return cp
@@ -86,6 +100,8 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 	env.Exporter().NewGaugeDurationFunc(name+"IdleTimeout", "Tablet server idle timeout", cp.IdleTimeout)
 	env.Exporter().NewCounterFunc(name+"IdleClosed", "Tablet server conn pool idle closed", cp.IdleClosed)
 	env.Exporter().NewCounterFunc(name+"Exhausted", "Number of times pool had zero available slots", cp.Exhausted)
+	env.Exporter().NewGaugesFuncWithMultiLabels(name+"PriorityWaiters", "Tablet server conn pool waiters by priority", []string{"Priority"}, cp.WaitersByPriority)
+	cp.shed = env.Exporter().NewCounter(name+"LowPriorityShed", "Tablet server conn pool low priority requests rejected because too many requests were waiting")
 	return cp
 }
 
@@ -109,10 +125,17 @@ func (cp *Pool) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector) {
 	f := func(ctx context.Context) (pools.Resource, error) {
 		return NewDBConn(ctx, cp, appParams)
 	}
-	cp.connections = pools.NewResourcePool(f, cp.capacity, cp.capacity, cp.idleTimeout, cp.prefillParallelism, cp.getLogWaitCallback())
+	maxCap := cp.capacity
+	if cp.sizer != nil {
+		maxCap = cp.sizer.maxSize
+	}
+	cp.connections = pools.NewResourcePool(f, cp.capacity, maxCap, cp.idleTimeout, cp.prefillParallelism, cp.getLogWaitCallback())
 	cp.appDebugParams = appDebugParams
 
 	cp.dbaPool.Open(dbaParams)
+	if cp.sizer != nil {
+		cp.sizer.Open(cp.connections)
+	}
 }
 
 func (cp *Pool) getLogWaitCallback() func(time.Time) {
@@ -131,6 +154,9 @@ func (cp *Pool) Close() {
 	if p == nil {
 		return
 	}
+	if cp.sizer != nil {
+		cp.sizer.Close()
+	}
 	// We should not hold the lock while calling Close
 	// because it waits for connections to be returned.
 	p.Close()
@@ -140,6 +166,12 @@ func (cp *Pool) Close() {
 	cp.dbaPool.Close()
 }
 
+// WaitersByPriority returns the number of the requests waiting for
+// a connection, by priority.
+func (cp *Pool) WaitersByPriority() map[string]int64 {
+	return cp.waiters.Waiting()
+}
+
 // Get returns a connection.
 // You must call Recycle on DBConn once done.
 func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
@@ -171,6 +203,20 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 		ctx, cancel = context.WithTimeout(ctx, cp.timeout)
 		defer cancel()
 	}
+	if p.Available() <= 0 {
+		waiterRank := rank(PriorityFromContext(ctx))
+		ok, err := cp.waiters.enter(ctx, waiterRank, cp.lowPriorityMaxWaiters)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			if cp.shed != nil {
+				cp.shed.Add(1)
+			}
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "pool %s is overloaded: low priority request rejected", cp.name)
+		}
+		defer cp.waiters.leave(waiterRank)
+	}
 	r, err := p.Get(ctx)
 	if err != nil {
 		return nil, err
@@ -205,6 +251,20 @@ func (cp *Pool) SetCapacity(capacity int) (err error) {
 	return nil
 }
 
+// SizingDecisions returns the latest changes of the capacity of the pool,
+// oldest first, if the pool is adaptive.
+func (cp *Pool) SizingDecisions() []SizingDecision {
+	if cp.sizer == nil {
+		return nil
+	}
+	return cp.sizer.Decisions()
+}
+
+// IsAdaptive returns true if the capacity of the pool adapts to the load.
+func (cp *Pool) IsAdaptive() bool {
+	return cp.sizer != nil
+}
+
 // SetIdleTimeout sets the idleTimeout on the pool.
 func (cp *Pool) SetIdleTimeout(idleTimeout time.Duration) {
 	cp.mu.Lock()
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"context"
+	"sync"
+
+	"vitess.io/vitess/go/pools"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type priorityKey struct{}
+
+// NewPriorityContext returns a context carrying the priority class of
+// the request, used to order the requests waiting for a connection.
+func NewPriorityContext(ctx context.Context, priority querypb.ExecuteOptions_Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the priority class of the request, or
+// NORMAL if the context has none.
+func PriorityFromContext(ctx context.Context) querypb.ExecuteOptions_Priority {
+	priority, _ := ctx.Value(priorityKey{}).(querypb.ExecuteOptions_Priority)
+	return priority
+}
+
+// rank returns the rank of a priority, the lowest rank being served first.
+func rank(priority querypb.ExecuteOptions_Priority) int {
+	switch priority {
+	case querypb.ExecuteOptions_HIGH:
+		return 0
+	case querypb.ExecuteOptions_LOW:
+		return 2
+	default:
+		return 1
+	}
+}
+
+const numRanks = 3
+
+// priorityWaiters orders the requests waiting for a connection by
+// priority. A request only starts waiting on the pool once no request
+// of a higher priority is waiting, so that the connections returned to
+// the pool go to the higher priorities first.
+type priorityWaiters struct {
+	mu sync.Mutex
+	// waiting is the number of the requests waiting on the pool, by rank.
+	waiting [numRanks]int
+	// changed is closed and replaced when a request stops waiting.
+	changed chan struct{}
+}
+
+func newPriorityWaiters() *priorityWaiters {
+	return &priorityWaiters{changed: make(chan struct{})}
+}
+
+// enter blocks until no request of a higher priority than rank waits on
+// the pool, and then counts the request as waiting. It returns false if
+// the request must be shed instead: maxLowWaiters is the number of the
+// waiting requests at which the low priority requests are rejected.
+func (pw *priorityWaiters) enter(ctx context.Context, rank, maxLowWaiters int) (bool, error) {
+	for {
+		pw.mu.Lock()
+		if rank == numRanks-1 && maxLowWaiters > 0 && pw.total() >= maxLowWaiters {
+			pw.mu.Unlock()
+			return false, nil
+		}
+		if !pw.higherWaiting(rank) {
+			pw.waiting[rank]++
+			pw.mu.Unlock()
+			return true, nil
+		}
+		changed := pw.changed
+		pw.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return false, pools.ErrTimeout
+		}
+	}
+}
+
+// leave stops counting a request as waiting, and wakes up the requests
+// of the lower priorities.
+func (pw *priorityWaiters) leave(rank int) {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+	pw.waiting[rank]--
+	close(pw.changed)
+	pw.changed = make(chan struct{})
+}
+
+func (pw *priorityWaiters) higherWaiting(rank int) bool {
+	for r := 0; r < rank; r++ {
+		if pw.waiting[r] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (pw *priorityWaiters) total() (n int) {
+	for _, waiting := range pw.waiting {
+		n += waiting
+	}
+	return n
+}
+
+// Waiting returns the number of the requests waiting on the pool
+// for each priority.
+func (pw *priorityWaiters) Waiting() map[string]int64 {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+	return map[string]int64{
+		querypb.ExecuteOptions_HIGH.String():   int64(pw.waiting[rank(querypb.ExecuteOptions_HIGH)]),
+		querypb.ExecuteOptions_NORMAL.String(): int64(pw.waiting[rank(querypb.ExecuteOptions_NORMAL)]),
+		querypb.ExecuteOptions_LOW.String():    int64(pw.waiting[rank(querypb.ExecuteOptions_LOW)]),
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestPriorityContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, querypb.ExecuteOptions_NORMAL, PriorityFromContext(ctx))
+	ctx = NewPriorityContext(ctx, querypb.ExecuteOptions_LOW)
+	assert.Equal(t, querypb.ExecuteOptions_LOW, PriorityFromContext(ctx))
+}
+
+// waitForWaiters waits until n requests of the priority wait on the pool.
+func waitForWaiters(connPool *Pool, priority querypb.ExecuteOptions_Priority, n int64) {
+	for connPool.WaitersByPriority()[priority.String()] != n {
+		runtime.Gosched()
+	}
+}
+
+func TestConnPoolPriorityOrder(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := NewPool(tabletenv.NewEnv(nil, "PoolTest"), "TestPool", tabletenv.ConnPoolConfig{
+		Size:               1,
+		IdleTimeoutSeconds: 10,
+	})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+
+	served := make(chan querypb.ExecuteOptions_Priority, 2)
+	get := func(priority querypb.ExecuteOptions_Priority) {
+		conn, err := connPool.Get(NewPriorityContext(context.Background(), priority))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		served <- priority
+		// Let the other waiter try to get the connection.
+		time.Sleep(10 * time.Millisecond)
+		conn.Recycle()
+	}
+	go get(querypb.ExecuteOptions_HIGH)
+	waitForWaiters(connPool, querypb.ExecuteOptions_HIGH, 1)
+	go get(querypb.ExecuteOptions_LOW)
+	// The low priority request does not wait on the pool
+	// while a high priority one does.
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, int64(0), connPool.WaitersByPriority()["LOW"])
+
+	dbConn.Recycle()
+	assert.Equal(t, querypb.ExecuteOptions_HIGH, <-served)
+	assert.Equal(t, querypb.ExecuteOptions_LOW, <-served)
+}
+
+func TestConnPoolLowPriorityShed(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := NewPool(tabletenv.NewEnv(nil, "PoolTest"), "TestPool", tabletenv.ConnPoolConfig{
+		Size:                  1,
+		IdleTimeoutSeconds:    10,
+		LowPriorityMaxWaiters: 1,
+	})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	shed := connPool.shed.Get()
+	dbConn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		conn, err := connPool.Get(context.Background())
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		conn.Recycle()
+	}()
+	waitForWaiters(connPool, querypb.ExecuteOptions_NORMAL, 1)
+
+	_, err = connPool.Get(NewPriorityContext(context.Background(), querypb.ExecuteOptions_LOW))
+	assert.EqualError(t, err, "pool TestPool is overloaded: low priority request rejected")
+	assert.Equal(t, int64(1), connPool.shed.Get()-shed)
+
+	dbConn.Recycle()
+	<-done
+	// Without waiters, the low priority requests are served.
+	conn, err := connPool.Get(NewPriorityContext(context.Background(), querypb.ExecuteOptions_LOW))
+	require.NoError(t, err)
+	conn.Recycle()
+}
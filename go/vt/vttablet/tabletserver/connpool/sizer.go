@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// maxSizingDecisions is the number of the latest sizing decisions
+// kept for the status page.
+const maxSizingDecisions = 20
+
+// SizingDecision is a change of the capacity of an adaptive pool.
+type SizingDecision struct {
+	Time   time.Time
+	From   int
+	To     int
+	Reason string
+}
+
+// poolSizer adapts the capacity of a pool between its min and max sizes.
+// At every interval, it grows the pool if the requests waited too long
+// for a connection, unless MySQL already runs too many threads, in which
+// case it shrinks the pool instead. It also shrinks the pool to its peak
+// usage when part of the capacity stayed unused for the idle timeout.
+type poolSizer struct {
+	cp                *Pool
+	minSize           int
+	maxSize           int
+	waitTime          time.Duration
+	maxThreadsRunning int64
+	idleTimeout       time.Duration
+	ticks             *timer.Timer
+	resizes           *stats.CountersWithSingleLabel
+
+	// mu protects the following fields.
+	mu            sync.Mutex
+	lastWaitCount int64
+	lastWaitTime  time.Duration
+	// peakInUse is the peak number of connections in use seen
+	// since idleSince.
+	peakInUse int64
+	idleSince time.Time
+	// decisions are the latest sizing decisions, oldest first.
+	decisions []SizingDecision
+}
+
+func newPoolSizer(cp *Pool, cfg tabletenv.ConnPoolConfig) *poolSizer {
+	config := cp.env.Config().AdaptivePools
+	ps := &poolSizer{
+		cp:                cp,
+		minSize:           cfg.MinSize,
+		maxSize:           cfg.MaxSize,
+		waitTime:          config.WaitTimeSeconds.Get(),
+		maxThreadsRunning: int64(config.MaxThreadsRunning),
+		idleTimeout:       config.IdleTimeoutSeconds.Get(),
+		ticks:             timer.NewTimer(config.IntervalSeconds.Get()),
+	}
+	if ps.minSize <= 0 {
+		ps.minSize = 1
+	}
+	if cp.name != "" {
+		ps.resizes = cp.env.Exporter().NewCountersWithSingleLabel(cp.name+"Resizes", "Tablet server adaptive conn pool resizes", "Direction", "Grow", "Shrink")
+	}
+	return ps
+}
+
+// Open starts the sizing of the pool.
+func (ps *poolSizer) Open(p *pools.ResourcePool) {
+	ps.mu.Lock()
+	ps.lastWaitCount = p.WaitCount()
+	ps.lastWaitTime = p.WaitTime()
+	ps.peakInUse = p.InUse()
+	ps.idleSince = time.Now()
+	ps.mu.Unlock()
+	ps.ticks.Start(ps.check)
+}
+
+// Close stops the sizing of the pool, after the check in progress if any.
+func (ps *poolSizer) Close() {
+	ps.ticks.Stop()
+}
+
+// check adjusts the capacity of the pool to the load of the last interval.
+func (ps *poolSizer) check() {
+	p := ps.cp.pool()
+	if p == nil {
+		return
+	}
+	capacity := int(p.Capacity())
+	threadsRunning := ps.threadsRunning()
+	to, reason := ps.decide(p.WaitCount(), p.WaitTime(), p.InUse(), capacity, threadsRunning)
+	if to == capacity {
+		return
+	}
+	// Shrinking the pool waits for the connections in excess to be
+	// returned, so it's done without holding mu.
+	if err := p.SetCapacity(to); err != nil {
+		log.Warningf("Could not resize pool %s from %d to %d: %v", ps.cp.name, capacity, to, err)
+		return
+	}
+	ps.cp.mu.Lock()
+	ps.cp.capacity = to
+	ps.cp.mu.Unlock()
+	log.Infof("Resized pool %s from %d to %d: %s", ps.cp.name, capacity, to, reason)
+
+	direction := "Grow"
+	if to < capacity {
+		direction = "Shrink"
+	}
+	if ps.resizes != nil {
+		ps.resizes.Add(direction, 1)
+	}
+	now := time.Now()
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if len(ps.decisions) == maxSizingDecisions {
+		ps.decisions = ps.decisions[1:]
+	}
+	ps.decisions = append(ps.decisions, SizingDecision{
+		Time:   now,
+		From:   capacity,
+		To:     to,
+		Reason: reason,
+	})
+	ps.resetIdle(now, p.InUse())
+}
+
+// decide returns the capacity the pool should have, and why.
+func (ps *poolSizer) decide(waitCount int64, waitTime time.Duration, inUse int64, capacity int, threadsRunning int64) (int, string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	now := time.Now()
+	var avgWait time.Duration
+	if n := waitCount - ps.lastWaitCount; n > 0 {
+		avgWait = (waitTime - ps.lastWaitTime) / time.Duration(n)
+	}
+	ps.lastWaitCount, ps.lastWaitTime = waitCount, waitTime
+	if inUse > ps.peakInUse {
+		ps.peakInUse = inUse
+	}
+	step := capacity / 10
+	if step < 1 {
+		step = 1
+	}
+
+	overloaded := ps.maxThreadsRunning > 0 && threadsRunning >= ps.maxThreadsRunning
+	switch {
+	case overloaded && capacity > ps.minSize:
+		return max(capacity-step, ps.minSize), fmt.Sprintf("threads running %d >= %d", threadsRunning, ps.maxThreadsRunning)
+	case !overloaded && avgWait > ps.waitTime && capacity < ps.maxSize:
+		return min(capacity+step, ps.maxSize), fmt.Sprintf("average wait time %v > %v", avgWait, ps.waitTime)
+	case now.Sub(ps.idleSince) >= ps.idleTimeout:
+		if peak := int(ps.peakInUse); peak < capacity && capacity > ps.minSize {
+			return max(peak, ps.minSize), fmt.Sprintf("peak in use %d for %v", peak, ps.idleTimeout)
+		}
+		ps.resetIdle(now, inUse)
+	}
+	return capacity, ""
+}
+
+// resetIdle starts a new window for the idle timeout.
+func (ps *poolSizer) resetIdle(now time.Time, inUse int64) {
+	ps.idleSince = now
+	ps.peakInUse = inUse
+}
+
+// threadsRunning returns the Threads_running status of MySQL, or 0
+// if the check is disabled or failed.
+func (ps *poolSizer) threadsRunning() int64 {
+	if ps.maxThreadsRunning <= 0 {
+		return 0
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ps.ticks.Interval())
+	defer cancel()
+	conn, err := ps.cp.dbaPool.Get(ctx)
+	if err != nil {
+		log.Warningf("Could not get Threads_running for pool %s: %v", ps.cp.name, err)
+		return 0
+	}
+	defer conn.Recycle()
+	qr, err := conn.ExecuteFetch("show global status like 'Threads_running'", 1, false)
+	if err != nil || len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		log.Warningf("Could not get Threads_running for pool %s: %v", ps.cp.name, err)
+		return 0
+	}
+	threadsRunning, err := strconv.ParseInt(qr.Rows[0][1].ToString(), 10, 64)
+	if err != nil {
+		log.Warningf("Could not get Threads_running for pool %s: %v", ps.cp.name, err)
+		return 0
+	}
+	return threadsRunning
+}
+
+// Decisions returns the latest sizing decisions, oldest first.
+func (ps *poolSizer) Decisions() []SizingDecision {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return append([]SizingDecision(nil), ps.decisions...)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func newAdaptivePool(maxThreadsRunning int, idleTimeout tabletenv.Seconds) *Pool {
+	config := tabletenv.NewDefaultConfig()
+	// The checks are triggered by the tests.
+	config.AdaptivePools.IntervalSeconds = 3600
+	config.AdaptivePools.MaxThreadsRunning = maxThreadsRunning
+	config.AdaptivePools.IdleTimeoutSeconds = idleTimeout
+	return NewPool(tabletenv.NewEnv(config, "PoolTest"), "", tabletenv.ConnPoolConfig{
+		Size:               4,
+		MinSize:            2,
+		MaxSize:            8,
+		IdleTimeoutSeconds: 10,
+	})
+}
+
+func TestPoolSizerGrow(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := newAdaptivePool(0, 3600)
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	assert.True(t, connPool.IsAdaptive())
+	assert.Equal(t, int64(8), connPool.MaxCap())
+
+	ctx := context.Background()
+	var conns []*DBConn
+	for i := 0; i < 4; i++ {
+		conn, err := connPool.Get(ctx)
+		require.NoError(t, err)
+		conns = append(conns, conn)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		conns[0].Recycle()
+	}()
+	conn, err := connPool.Get(ctx)
+	require.NoError(t, err)
+	conn.Recycle()
+	for _, conn := range conns[1:] {
+		conn.Recycle()
+	}
+
+	connPool.sizer.check()
+	assert.Equal(t, int64(5), connPool.Capacity())
+	decisions := connPool.SizingDecisions()
+	require.Len(t, decisions, 1)
+	assert.Equal(t, 4, decisions[0].From)
+	assert.Equal(t, 5, decisions[0].To)
+	assert.Contains(t, decisions[0].Reason, "average wait time")
+
+	// No request waited since the last check.
+	connPool.sizer.check()
+	assert.Equal(t, int64(5), connPool.Capacity())
+}
+
+func TestPoolSizerThreadsRunning(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("show global status like 'Threads_running'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+		"Threads_running|50",
+	))
+	connPool := newAdaptivePool(40, 3600)
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+
+	connPool.sizer.check()
+	assert.Equal(t, int64(3), connPool.Capacity())
+	connPool.sizer.check()
+	assert.Equal(t, int64(2), connPool.Capacity())
+	// The pool does not shrink below its min size.
+	connPool.sizer.check()
+	assert.Equal(t, int64(2), connPool.Capacity())
+
+	decisions := connPool.SizingDecisions()
+	require.Len(t, decisions, 2)
+	assert.Equal(t, "threads running 50 >= 40", decisions[1].Reason)
+}
+
+func TestPoolSizerIdle(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := newAdaptivePool(0, 0.001)
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+
+	conn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	connPool.sizer.check()
+	conn.Recycle()
+	// The pool shrinks to its peak usage, bounded by its min size.
+	assert.Equal(t, int64(2), connPool.Capacity())
+	decisions := connPool.SizingDecisions()
+	require.Len(t, decisions, 1)
+	assert.Equal(t, "peak in use 1 for 1ms", decisions[0].Reason)
+}
+
+func TestPoolNotAdaptive(t *testing.T) {
+	connPool := newPool()
+	assert.False(t, connPool.IsAdaptive())
+	assert.Nil(t, connPool.SizingDecisions())
+}
@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	"time"
@@ -93,6 +94,9 @@ type Controller interface {
 
 	// TopoServer returns the topo server.
 	TopoServer() *topo.Server
+
+	// HotRows returns the rows currently contended by several transactions.
+	HotRows() []txserializer.HotRow
 }
 
 // Ensure TabletServer satisfies Controller interface.
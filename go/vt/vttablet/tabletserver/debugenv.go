@@ -85,6 +85,12 @@ func debugEnvHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request)
 			setIntVal(tsv.SetMaxResultSize)
 		case "WarnResultSize":
 			setIntVal(tsv.SetWarnResultSize)
+		case "MaxResultBytes":
+			setIntVal(tsv.SetMaxResultBytes)
+		case "WarnResultBytes":
+			setIntVal(tsv.SetWarnResultBytes)
+		case "MaxResultMemory":
+			setIntVal(tsv.SetMaxResultMemory)
 		case "Consolidator":
 			tsv.SetConsolidatorMode(value)
 			msg = fmt.Sprintf("Setting %v to: %v", varname, value)
@@ -104,6 +110,9 @@ func debugEnvHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request)
 	addIntVar("QueryCacheCapacity", tsv.QueryPlanCacheCap)
 	addIntVar("MaxResultSize", tsv.MaxResultSize)
 	addIntVar("WarnResultSize", tsv.WarnResultSize)
+	addIntVar("MaxResultBytes", tsv.MaxResultBytes)
+	addIntVar("WarnResultBytes", tsv.WarnResultBytes)
+	addIntVar("MaxResultMemory", tsv.MaxResultMemory)
 	vars = append(vars, envValue{
 		VarName: "Consolidator",
 		Value:   tsv.ConsolidatorMode(),
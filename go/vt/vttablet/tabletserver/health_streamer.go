@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
@@ -53,6 +55,11 @@ type healthStreamer struct {
 	degradedThreshold  time.Duration
 	unhealthyThreshold time.Duration
 
+	// se and signalWhenSchemaChange are used to send the tables whose
+	// schema changed to the clients.
+	se                     *schema.Engine
+	signalWhenSchemaChange bool
+
 	mu      sync.Mutex
 	ctx     context.Context
 	cancel  context.CancelFunc
@@ -62,12 +69,14 @@ type healthStreamer struct {
 	history *history.History
 }
 
-func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias) *healthStreamer {
+func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias, se *schema.Engine) *healthStreamer {
 	return &healthStreamer{
-		stats:              env.Stats(),
-		degradedThreshold:  env.Config().Healthcheck.DegradedThresholdSeconds.Get(),
-		unhealthyThreshold: env.Config().Healthcheck.UnhealthyThresholdSeconds.Get(),
-		clients:            make(map[chan *querypb.StreamHealthResponse]struct{}),
+		stats:                  env.Stats(),
+		degradedThreshold:      env.Config().Healthcheck.DegradedThresholdSeconds.Get(),
+		unhealthyThreshold:     env.Config().Healthcheck.UnhealthyThresholdSeconds.Get(),
+		se:                     se,
+		signalWhenSchemaChange: env.Config().SignalWhenSchemaChange,
+		clients:                make(map[chan *querypb.StreamHealthResponse]struct{}),
 
 		state: &querypb.StreamHealthResponse{
 			Target:      &querypb.Target{},
@@ -180,7 +189,48 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 	hs.state.RealtimeStats.Qps = hs.stats.QPSRates.TotalRate()
 
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.broadcastLocked(shr)
+	hs.history.Add(&historyRecord{
+		Time:       time.Now(),
+		serving:    shr.Serving,
+		tabletType: shr.Target.TabletType,
+		lag:        lag,
+		err:        err,
+	})
+}
 
+// RegisterSchemaNotifier registers the health streamer for the schema
+// changes detected by the schema engine, if the tablet signals them.
+// It must be called every time the schema engine is opened.
+func (hs *healthStreamer) RegisterSchemaNotifier() {
+	if hs.se == nil || !hs.signalWhenSchemaChange {
+		return
+	}
+	hs.se.RegisterNotifier("healthStreamer", hs.schemaChanged)
+}
+
+// schemaChanged sends the tables which were created, altered or dropped
+// to the clients, so that they can refresh their schemas.
+func (hs *healthStreamer) schemaChanged(_ map[string]*schema.Table, created, altered, dropped []string) {
+	var tables []string
+	tables = append(tables, created...)
+	tables = append(tables, altered...)
+	tables = append(tables, dropped...)
+	if len(tables) == 0 {
+		return
+	}
+	sort.Strings(tables)
+
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	hs.state.RealtimeStats.TableSchemaChanged = tables
+	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.state.RealtimeStats.TableSchemaChanged = nil
+	hs.broadcastLocked(shr)
+}
+
+func (hs *healthStreamer) broadcastLocked(shr *querypb.StreamHealthResponse) {
 	for ch := range hs.clients {
 		select {
 		case ch <- shr:
@@ -202,13 +252,6 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 			delete(hs.clients, ch)
 		}
 	}
-	hs.history.Add(&historyRecord{
-		Time:       time.Now(),
-		serving:    shr.Serving,
-		tabletType: shr.Target.TabletType,
-		lag:        lag,
-		err:        err,
-	})
 }
 
 func (hs *healthStreamer) AppendDetails(details []*kv) []*kv {
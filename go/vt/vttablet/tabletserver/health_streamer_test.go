@@ -37,7 +37,7 @@ func TestHealthStreamerClosed(t *testing.T) {
 		Uid:  1,
 	}
 	blpFunc = testBlpFunc
-	hs := newHealthStreamer(env, alias)
+	hs := newHealthStreamer(env, alias, nil)
 	err := hs.Stream(context.Background(), func(shr *querypb.StreamHealthResponse) error {
 		return nil
 	})
@@ -52,7 +52,7 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 		Uid:  1,
 	}
 	blpFunc = testBlpFunc
-	hs := newHealthStreamer(env, alias)
+	hs := newHealthStreamer(env, alias, nil)
 	hs.Open()
 	defer hs.Close()
 	target := querypb.Target{}
@@ -136,6 +136,37 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 	assert.Equal(t, want, shr)
 }
 
+func TestHealthStreamerSchemaChange(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	env := tabletenv.NewEnv(config, "ReplTrackerTest")
+	alias := topodatapb.TabletAlias{
+		Cell: "cell",
+		Uid:  1,
+	}
+	blpFunc = testBlpFunc
+	hs := newHealthStreamer(env, alias, nil)
+	hs.Open()
+	defer hs.Close()
+	hs.InitDBConfig(querypb.Target{})
+
+	ch, cancel := testStream(hs)
+	defer cancel()
+	<-ch
+
+	hs.schemaChanged(nil, []string{"t3"}, []string{"t1"}, []string{"t2"})
+	shr := <-ch
+	assert.Equal(t, []string{"t1", "t2", "t3"}, shr.RealtimeStats.TableSchemaChanged)
+
+	// No message is sent when nothing changed.
+	hs.schemaChanged(nil, nil, nil, nil)
+
+	// The tables are only sent once.
+	hs.ChangeState(topodatapb.TabletType_REPLICA, time.Time{}, 0, nil, true)
+	shr = <-ch
+	assert.Empty(t, shr.RealtimeStats.TableSchemaChanged)
+	assert.True(t, shr.Serving)
+}
+
 func testStream(hs *healthStreamer) (<-chan *querypb.StreamHealthResponse, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := make(chan *querypb.StreamHealthResponse)
@@ -166,6 +166,20 @@ func (mc *cache) Pop() *MessageRow {
 	}
 }
 
+// Requeue puts a popped MessageRow back in the send queue.
+// It's a no-op if the message was discarded since.
+func (mc *cache) Requeue(mr *MessageRow) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	id := mr.Row[0].ToString()
+	if !mc.inFlight[id] {
+		return
+	}
+	delete(mc.inFlight, id)
+	heap.Push(&mc.sendQueue, mr)
+	mc.inQueue[id] = mr
+}
+
 // Discard forgets the specified id.
 func (mc *cache) Discard(ids []string) {
 	mc.mu.Lock()
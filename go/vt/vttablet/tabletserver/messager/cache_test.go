@@ -214,3 +214,33 @@ func TestMessagerCacheEmpty(t *testing.T) {
 		t.Errorf("Pop(non-empty): nil, want %v", row)
 	}
 }
+
+func TestMessagerCacheRequeue(t *testing.T) {
+	mc := newCache(10)
+	mc.Add(&MessageRow{
+		TimeNext: 1,
+		Row:      []sqltypes.Value{sqltypes.NewVarBinary("row01")},
+	})
+	mc.Add(&MessageRow{
+		TimeNext: 2,
+		Row:      []sqltypes.Value{sqltypes.NewVarBinary("row02")},
+	})
+	mr := mc.Pop()
+	if got := mr.Row[0].ToString(); got != "row02" {
+		t.Fatalf("Pop: %s, want row02", got)
+	}
+	mc.Requeue(mr)
+	if got := mc.Pop().Row[0].ToString(); got != "row02" {
+		t.Errorf("Pop after Requeue: %s, want row02", got)
+	}
+
+	// A discarded message is not requeued.
+	mc.Discard([]string{"row02"})
+	mc.Requeue(mr)
+	if got := mc.Pop().Row[0].ToString(); got != "row01" {
+		t.Errorf("Pop after Discard: %s, want row01", got)
+	}
+	if got := mc.Pop(); got != nil {
+		t.Errorf("Pop(empty): %v, want nil", got)
+	}
+}
@@ -37,8 +37,9 @@ import (
 // that the messager needs for callback.
 type TabletService interface {
 	tabletenv.Env
-	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
+	PostponeMessages(ctx context.Context, target *querypb.Target, name, group string, ids []string) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, name, group string, ids []string) (count int64, err error)
 }
 
 // VStreamer defines  the functions of VStreamer
@@ -53,6 +54,9 @@ type Engine struct {
 	mu       sync.Mutex
 	isOpen   bool
 	managers map[string]*messageManager
+	// groups contains the managers of the consumer groups
+	// by table and group name.
+	groups map[string]map[string]*messageManager
 
 	tsv          TabletService
 	se           *schema.Engine
@@ -68,6 +72,7 @@ func NewEngine(tsv TabletService, se *schema.Engine, vs VStreamer) *Engine {
 		vs:           vs,
 		postponeSema: sync2.NewSemaphore(tsv.Config().MessagePostponeParallelism, 0),
 		managers:     make(map[string]*messageManager),
+		groups:       make(map[string]map[string]*messageManager),
 	}
 }
 
@@ -98,50 +103,77 @@ func (me *Engine) Close() {
 	for _, mm := range me.managers {
 		mm.Close()
 	}
+	for _, groups := range me.groups {
+		for _, mm := range groups {
+			mm.Close()
+		}
+	}
 	me.managers = make(map[string]*messageManager)
+	me.groups = make(map[string]map[string]*messageManager)
 	log.Info("Messager: closed")
 }
 
-// Subscribe subscribes to messages from the requested table.
+// Subscribe subscribes to messages from the requested table
+// for the consumer group. An empty group is the default group.
 // The function returns a done channel that will be closed when
 // the subscription ends, which can be initiated by the send function
 // returning io.EOF. The engine can also end a subscription which is
 // usually triggered by Close. It's the responsibility of the send
 // function to promptly return if the done channel is closed. Otherwise,
 // the engine's Close function will hang indefinitely.
-func (me *Engine) Subscribe(ctx context.Context, name string, send func(*sqltypes.Result) error) (done <-chan struct{}, err error) {
+func (me *Engine) Subscribe(ctx context.Context, name, group string, send func(*sqltypes.Result) error) (done <-chan struct{}, err error) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
 	if !me.isOpen {
 		return nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "messager engine is closed, probably because this is not a master any more")
 	}
-	mm := me.managers[name]
-	if mm == nil {
+	if me.managers[name] == nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found", name)
 	}
+	mm := me.manager(name, group)
+	if mm == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer group %s not found for message table %s", group, name)
+	}
 	return mm.Subscribe(ctx, send), nil
 }
 
-// GenerateAckQuery returns the query and bind vars for acking a message.
-func (me *Engine) GenerateAckQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
+// manager returns the manager of the consumer group of the table.
+// The caller must hold mu.
+func (me *Engine) manager(name, group string) *messageManager {
+	if group == "" {
+		return me.managers[name]
+	}
+	return me.groups[name][group]
+}
+
+// GenerateAckQuery returns the query and bind vars for acking
+// a message of the consumer group.
+func (me *Engine) GenerateAckQuery(name, group string, ids []string) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
-	mm := me.managers[name]
-	if mm == nil {
+	if me.managers[name] == nil {
 		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
 	}
+	mm := me.manager(name, group)
+	if mm == nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer group %s not found for message table %s", group, name)
+	}
 	query, bv := mm.GenerateAckQuery(ids)
 	return query, bv, nil
 }
 
-// GeneratePostponeQuery returns the query and bind vars for postponing a message.
-func (me *Engine) GeneratePostponeQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
+// GeneratePostponeQuery returns the query and bind vars for postponing
+// a message of the consumer group.
+func (me *Engine) GeneratePostponeQuery(name, group string, ids []string) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
-	mm := me.managers[name]
-	if mm == nil {
+	if me.managers[name] == nil {
 		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
 	}
+	mm := me.manager(name, group)
+	if mm == nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer group %s not found for message table %s", group, name)
+	}
 	query, bv := mm.GeneratePostponeQuery(ids)
 	return query, bv, nil
 }
@@ -158,6 +190,25 @@ func (me *Engine) GeneratePurgeQuery(name string, timeCutoff int64) (string, map
 	return query, bv, nil
 }
 
+// GenerateDeadLetterQueries returns the queries and bind vars for moving
+// messages of the consumer group to the dead-letter table.
+func (me *Engine) GenerateDeadLetterQueries(name, group string, ids []string) ([]string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	if me.managers[name] == nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	mm := me.manager(name, group)
+	if mm == nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "consumer group %s not found for message table %s", group, name)
+	}
+	if mm.maxRetries == 0 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "message table %s has no max retries", name)
+	}
+	queries, bv := mm.GenerateDeadLetterQueries(ids)
+	return queries, bv, nil
+}
+
 func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altered, dropped []string) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
@@ -168,7 +219,11 @@ func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altere
 		}
 		log.Infof("Stopping messager for dropped/updated table: %v", name)
 		mm.Close()
+		for _, gmm := range me.groups[name] {
+			gmm.Close()
+		}
 		delete(me.managers, name)
+		delete(me.groups, name)
 	}
 
 	for _, name := range append(created, altered...) {
@@ -181,9 +236,20 @@ func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altere
 			log.Errorf("Newly created table already exists in messages: %s", name)
 			continue
 		}
-		mm := newMessageManager(me.tsv, me.vs, t, me.postponeSema)
+		mm := newMessageManager(me.tsv, me.vs, t, "", me.postponeSema)
 		me.managers[name] = mm
 		log.Infof("Starting messager for table: %v", name)
 		mm.Open()
+		if len(t.MessageInfo.ConsumerGroups) == 0 {
+			continue
+		}
+		groups := make(map[string]*messageManager)
+		for _, group := range t.MessageInfo.ConsumerGroups {
+			gmm := newMessageManager(me.tsv, me.vs, t, group, me.postponeSema)
+			groups[group] = gmm
+			log.Infof("Starting messager for table: %v, consumer group: %v", name, group)
+			gmm.Open()
+		}
+		me.groups[name] = groups
 	}
 }
@@ -22,9 +22,13 @@ import (
 
 	"context"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -114,9 +118,9 @@ func TestSubscribe(t *testing.T) {
 	f1, ch1 := newEngineReceiver()
 	f2, ch2 := newEngineReceiver()
 	// Each receiver is subscribed to different managers.
-	engine.Subscribe(context.Background(), "t1", f1)
+	engine.Subscribe(context.Background(), "t1", "", f1)
 	<-ch1
-	engine.Subscribe(context.Background(), "t2", f2)
+	engine.Subscribe(context.Background(), "t2", "", f2)
 	<-ch2
 	engine.managers["t1"].Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
 	engine.managers["t2"].Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
@@ -125,14 +129,14 @@ func TestSubscribe(t *testing.T) {
 
 	// Error case.
 	want := "message table t3 not found"
-	_, err := engine.Subscribe(context.Background(), "t3", f1)
+	_, err := engine.Subscribe(context.Background(), "t3", "", f1)
 	if err == nil || err.Error() != want {
 		t.Errorf("Subscribe: %v, want %s", err, want)
 	}
 
 	// After close, Subscribe should return a closed channel.
 	engine.Close()
-	_, err = engine.Subscribe(context.Background(), "t1", nil)
+	_, err = engine.Subscribe(context.Background(), "t1", "", nil)
 	if got, want := vterrors.Code(err), vtrpcpb.Code_UNAVAILABLE; got != want {
 		t.Errorf("Subscribed on closed engine error code: %v, want %v", got, want)
 	}
@@ -146,18 +150,18 @@ func TestEngineGenerate(t *testing.T) {
 	engine.schemaChanged(map[string]*schema.Table{
 		"t1": meTable,
 	}, []string{"t1"}, nil, nil)
-	if _, _, err := engine.GenerateAckQuery("t1", []string{"1"}); err != nil {
+	if _, _, err := engine.GenerateAckQuery("t1", "", []string{"1"}); err != nil {
 		t.Error(err)
 	}
 	want := "message table t2 not found in schema"
-	if _, _, err := engine.GenerateAckQuery("t2", []string{"1"}); err == nil || err.Error() != want {
+	if _, _, err := engine.GenerateAckQuery("t2", "", []string{"1"}); err == nil || err.Error() != want {
 		t.Errorf("engine.GenerateAckQuery(invalid): %v, want %s", err, want)
 	}
 
-	if _, _, err := engine.GeneratePostponeQuery("t1", []string{"1"}); err != nil {
+	if _, _, err := engine.GeneratePostponeQuery("t1", "", []string{"1"}); err != nil {
 		t.Error(err)
 	}
-	if _, _, err := engine.GeneratePostponeQuery("t2", []string{"1"}); err == nil || err.Error() != want {
+	if _, _, err := engine.GeneratePostponeQuery("t2", "", []string{"1"}); err == nil || err.Error() != want {
 		t.Errorf("engine.GeneratePostponeQuery(invalid): %v, want %s", err, want)
 	}
 
@@ -167,6 +171,62 @@ func TestEngineGenerate(t *testing.T) {
 	if _, _, err := engine.GeneratePurgeQuery("t2", 0); err == nil || err.Error() != want {
 		t.Errorf("engine.GeneratePurgeQuery(invalid): %v, want %s", err, want)
 	}
+
+	if _, _, err := engine.GenerateDeadLetterQueries("t2", "", []string{"1"}); err == nil || err.Error() != want {
+		t.Errorf("engine.GenerateDeadLetterQueries(invalid): %v, want %s", err, want)
+	}
+	want = "message table t1 has no max retries"
+	if _, _, err := engine.GenerateDeadLetterQueries("t1", "", []string{"1"}); err == nil || err.Error() != want {
+		t.Errorf("engine.GenerateDeadLetterQueries(no max retries): %v, want %s", err, want)
+	}
+}
+
+func TestEngineConsumerGroups(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	engine := newTestEngine(db)
+	defer engine.Close()
+	table := &schema.Table{
+		Name:        sqlparser.NewTableIdent("t1"),
+		Type:        schema.Message,
+		MessageInfo: newMMTable().MessageInfo,
+	}
+	table.MessageInfo.ConsumerGroups = []string{"g1"}
+	engine.schemaChanged(map[string]*schema.Table{
+		"t1": table,
+	}, []string{"t1"}, nil, nil)
+	assert.Equal(t, map[string]bool{"g1": true}, extractManagerNames(engine.groups["t1"]))
+
+	f1, ch1 := newEngineReceiver()
+	_, err := engine.Subscribe(context.Background(), "t1", "g1", f1)
+	require.NoError(t, err)
+	<-ch1
+	engine.groups["t1"]["g1"].Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
+	<-ch1
+
+	_, err = engine.Subscribe(context.Background(), "t1", "g2", f1)
+	assert.EqualError(t, err, "consumer group g2 not found for message table t1")
+
+	query, _, err := engine.GenerateAckQuery("t1", "g1", []string{"1"})
+	require.NoError(t, err)
+	assert.Contains(t, query, "time_acked_g1")
+	_, _, err = engine.GenerateAckQuery("t1", "g2", []string{"1"})
+	assert.EqualError(t, err, "consumer group g2 not found for message table t1")
+
+	query, _, err = engine.GeneratePostponeQuery("t1", "g1", []string{"1"})
+	require.NoError(t, err)
+	assert.Contains(t, query, "time_next_g1")
+	_, _, err = engine.GeneratePostponeQuery("t1", "g2", []string{"1"})
+	assert.EqualError(t, err, "consumer group g2 not found for message table t1")
+
+	_, _, err = engine.GenerateDeadLetterQueries("t1", "g2", []string{"1"})
+	assert.EqualError(t, err, "consumer group g2 not found for message table t1")
+	_, _, err = engine.GenerateDeadLetterQueries("t1", "g1", []string{"1"})
+	assert.EqualError(t, err, "message table t1 has no max retries")
+
+	// Dropping the table stops the group managers.
+	engine.schemaChanged(nil, nil, nil, []string{"t1"})
+	assert.Empty(t, engine.groups)
 }
 
 func newTestEngine(db *fakesqldb.DB) *Engine {
@@ -19,8 +19,10 @@ package messager
 import (
 	"bytes"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
@@ -157,29 +159,47 @@ type receiverWithStatus struct {
 // The messages are sent to the clients in a round-robin fashion.
 // If, for some reason, a client is closed, the load balancer resets
 // by starting with the first non-busy client.
+// If the table has a partition column, every message is instead sent
+// to the client selected by the hash of its partition value. Messages
+// whose client is busy are put back in the cache. The assignment changes
+// when clients subscribe or unsubscribe.
+//
+// Consumer groups
+// Every consumer group of a table has its own messageManager that
+// tracks its delivery state in the columns suffixed by the group name.
+// The default group uses the unsuffixed columns.
 //
 // The Purge thread
 // This thread is mostly independent. It wakes up periodically
-// to delete old rows that were successfully acked.
+// to delete old rows that were successfully acked. Only the manager
+// of the default group purges, and only rows that were acked by all
+// the groups.
 type messageManager struct {
 	tsv TabletService
 	vs  VStreamer
 
-	name         sqlparser.TableIdent
-	fieldResult  *sqltypes.Result
-	ackWaitTime  time.Duration
-	purgeAfter   time.Duration
-	minBackoff   time.Duration
-	maxBackoff   time.Duration
-	batchSize    int
-	pollerTicks  *timer.Timer
-	purgeTicks   *timer.Timer
-	postponeSema *sync2.Semaphore
+	name  sqlparser.TableIdent
+	group string
+	// statsName is the TableName label of the stats.
+	statsName      string
+	partitionIndex int
+	fieldResult    *sqltypes.Result
+	ackWaitTime    time.Duration
+	purgeAfter     time.Duration
+	minBackoff     time.Duration
+	maxBackoff     time.Duration
+	batchSize      int
+	maxRetries     int64
+	pollerTicks    *timer.Timer
+	purgeTicks     *timer.Timer
+	postponeSema   *sync2.Semaphore
 
 	mu     sync.Mutex
 	isOpen bool
 	// cond waits on curReceiver == -1 || cache.IsEmpty():
 	// No current receivers available or cache is empty.
+	// For partitioned tables, it also waits on the receivers
+	// of all the cached messages being busy.
 	cond            sync.Cond
 	cache           *cache
 	receivers       []*receiverWithStatus
@@ -214,16 +234,25 @@ type messageManager struct {
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+	deadLetterInsertQuery     *sqlparser.ParsedQuery
+	// deadLetterAckQuery acks the dead letters for the consumer group.
+	// It is only set for tables with consumer groups.
+	deadLetterAckQuery    *sqlparser.ParsedQuery
+	deadLetterDeleteQuery *sqlparser.ParsedQuery
 }
 
-// newMessageManager creates a new message manager.
+// newMessageManager creates a new message manager for the consumer
+// group. An empty group is the default group.
 // Calls into tsv have to be made asynchronously. Otherwise,
 // it can lead to deadlocks.
-func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, postponeSema *sync2.Semaphore) *messageManager {
+func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, group string, postponeSema *sync2.Semaphore) *messageManager {
 	mm := &messageManager{
-		tsv:  tsv,
-		vs:   vs,
-		name: table.Name,
+		tsv:            tsv,
+		vs:             vs,
+		name:           table.Name,
+		group:          group,
+		statsName:      table.Name.String(),
+		partitionIndex: -1,
 		fieldResult: &sqltypes.Result{
 			Fields: table.MessageInfo.Fields,
 		},
@@ -232,6 +261,7 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		minBackoff:      table.MessageInfo.MinBackoff,
 		maxBackoff:      table.MessageInfo.MaxBackoff,
 		batchSize:       table.MessageInfo.BatchSize,
+		maxRetries:      int64(table.MessageInfo.MaxRetries),
 		cache:           newCache(table.MessageInfo.CacheSize),
 		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
 		purgeTicks:      timer.NewTimer(table.MessageInfo.PollInterval),
@@ -239,9 +269,23 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		messagesPending: true,
 	}
 	mm.cond.L = &mm.mu
+	if group != "" {
+		mm.statsName = fmt.Sprintf("%s.%s", table.Name.String(), group)
+	}
+	if col := table.MessageInfo.PartitionColumn; col != "" {
+		for i, field := range table.MessageInfo.Fields {
+			if strings.EqualFold(field.Name, col) {
+				mm.partitionIndex = i
+				break
+			}
+		}
+	}
 
+	timeNext := sqlparser.NewColIdent(schema.GroupColumn("time_next", group))
+	epoch := sqlparser.NewColIdent(schema.GroupColumn("epoch", group))
+	timeAcked := sqlparser.NewColIdent(schema.GroupColumn("time_acked", group))
 	columnList := buildSelectColumnList(table)
-	vsQuery := fmt.Sprintf("select priority, time_next, epoch, time_acked, %s from %v", columnList, mm.name)
+	vsQuery := sqlparser.BuildParsedQuery("select priority, %v, %v, %v, %s from %v", timeNext, epoch, timeAcked, columnList, mm.name).Query
 	mm.vsFilter = &binlogdatapb.Filter{
 		Rules: []*binlogdatapb.Rule{{
 			Match:  table.Name.String(),
@@ -249,29 +293,69 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		}},
 	}
 	mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
-		"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
+		"select priority, %v, %v, %v, %s from %v where %v < %a order by priority, %v desc limit %a",
+		timeNext, epoch, timeAcked, columnList, mm.name, timeNext, ":time_next", timeNext, ":max")
 	mm.ackQuery = sqlparser.BuildParsedQuery(
-		"update %v set time_acked = %a, time_next = null where id in %a and time_acked is null",
-		mm.name, ":time_acked", "::ids")
-	mm.purgeQuery = sqlparser.BuildParsedQuery(
-		"delete from %v where time_acked < %a limit 500", mm.name, ":time_acked")
+		"update %v set %v = %a, %v = null where id in %a and %v is null",
+		mm.name, timeAcked, ":time_acked", timeNext, "::ids", timeAcked)
+	if group == "" {
+		// A row can only be purged after all the groups acked it.
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("delete from %v where time_acked < %a", mm.name, ":time_acked")
+		for _, g := range table.MessageInfo.ConsumerGroups {
+			buf.Myprintf(" and %v < %a", sqlparser.NewColIdent(schema.GroupColumn("time_acked", g)), ":time_acked")
+		}
+		buf.Myprintf(" limit 500")
+		mm.purgeQuery = buf.ParsedQuery()
+	}
 
-	mm.postponeQuery = buildPostponeQuery(mm.name, mm.minBackoff, mm.maxBackoff)
+	mm.postponeQuery = buildPostponeQuery(mm.name, group, mm.minBackoff, mm.maxBackoff)
+
+	if mm.maxRetries > 0 && len(table.MessageInfo.ConsumerGroups) == 0 {
+		// The insert and the delete are executed in the same transaction.
+		deadLetterTable := sqlparser.NewTableIdent(table.MessageInfo.DeadLetterTable)
+		mm.deadLetterInsertQuery = sqlparser.BuildParsedQuery(
+			"insert into %v(priority, epoch, time_dead, %s) select priority, epoch, %a, %s from %v where id in %a and time_acked is null and epoch > %a",
+			deadLetterTable, columnList, ":time_now", columnList, mm.name, "::ids", ":max_retries")
+		mm.deadLetterDeleteQuery = sqlparser.BuildParsedQuery(
+			"delete from %v where id in %a and time_acked is null and epoch > %a",
+			mm.name, "::ids", ":max_retries")
+	} else if mm.maxRetries > 0 {
+		// The rows are shared by the consumer groups: the dead letters
+		// record their group, the messages are only acked for the group,
+		// and a row is deleted once no group has it pending anymore.
+		// The queries are executed in the same transaction.
+		deadLetterTable := sqlparser.NewTableIdent(table.MessageInfo.DeadLetterTable)
+		mm.deadLetterInsertQuery = sqlparser.BuildParsedQuery(
+			"insert into %v(consumer_group, priority, epoch, time_dead, %s) select %a, priority, %v, %a, %s from %v where id in %a and %v is null and %v > %a",
+			deadLetterTable, columnList, ":consumer_group", epoch, ":time_now", columnList, mm.name, "::ids", timeAcked, epoch, ":max_retries")
+		mm.deadLetterAckQuery = sqlparser.BuildParsedQuery(
+			"update %v set %v = %a, %v = null where id in %a and %v is null and %v > %a",
+			mm.name, timeAcked, ":time_now", timeNext, "::ids", timeAcked, epoch, ":max_retries")
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("delete from %v where id in %a and time_acked is not null", mm.name, "::ids")
+		for _, g := range table.MessageInfo.ConsumerGroups {
+			buf.Myprintf(" and %v is not null", sqlparser.NewColIdent(schema.GroupColumn("time_acked", g)))
+		}
+		mm.deadLetterDeleteQuery = buf.ParsedQuery()
+	}
 
 	return mm
 }
 
-func buildPostponeQuery(name sqlparser.TableIdent, minBackoff, maxBackoff time.Duration) *sqlparser.ParsedQuery {
+func buildPostponeQuery(name sqlparser.TableIdent, group string, minBackoff, maxBackoff time.Duration) *sqlparser.ParsedQuery {
 	var args []interface{}
+	timeNext := sqlparser.String(sqlparser.NewColIdent(schema.GroupColumn("time_next", group)))
+	epoch := sqlparser.String(sqlparser.NewColIdent(schema.GroupColumn("epoch", group)))
+	timeAcked := sqlparser.String(sqlparser.NewColIdent(schema.GroupColumn("time_acked", group)))
 
 	// since messages are immediately postponed upon sending, we need to add exponential backoff on top
 	// of the ackWaitTime, otherwise messages will be resent too quickly.
-	buf := bytes.NewBufferString("update %v set time_next = %a + %a + ")
+	buf := bytes.NewBufferString(fmt.Sprintf("update %%v set %s = %%a + %%a + ", timeNext))
 	args = append(args, name, ":time_now", ":wait_time")
 
 	// have backoff be +/- 33%, whenever this is injected, append (:min_backoff, :jitter)
-	jitteredBackoff := "FLOOR((%a<<ifnull(epoch, 0)) * %a)"
+	jitteredBackoff := fmt.Sprintf("FLOOR((%%a<<ifnull(%s, 0)) * %%a)", epoch)
 
 	//
 	// if the jittered backoff is less than min_backoff, just set it to :min_backoff
@@ -302,7 +386,7 @@ func buildPostponeQuery(name sqlparser.TableIdent, minBackoff, maxBackoff time.D
 	buf.WriteString(")")
 
 	// now that we've identified time_next, finish the statement
-	buf.WriteString(", epoch = ifnull(epoch, 0)+1 where id in %a and time_acked is null")
+	buf.WriteString(fmt.Sprintf(", %s = ifnull(%s, 0)+1 where id in %%a and %s is null", epoch, epoch, timeAcked))
 	args = append(args, "::ids")
 
 	return sqlparser.BuildParsedQuery(buf.String(), args...)
@@ -337,7 +421,9 @@ func (mm *messageManager) Open() {
 	go mm.runSend()
 	// TODO(sougou): improve ticks to add randomness.
 	mm.pollerTicks.Start(mm.runPoller)
-	mm.purgeTicks.Start(mm.runPurge)
+	if mm.purgeQuery != nil {
+		mm.purgeTicks.Start(mm.runPurge)
+	}
 }
 
 // Close stops the messageManager service.
@@ -355,7 +441,7 @@ func (mm *messageManager) Close() {
 		rcvr.receiver.cancel()
 	}
 	mm.receivers = nil
-	MessageStats.Set([]string{mm.name.String(), "ClientCount"}, 0)
+	MessageStats.Set([]string{mm.statsName, "ClientCount"}, 0)
 	mm.cache.Clear()
 	// This broadcast will cause runSend to exit.
 	mm.cond.Broadcast()
@@ -393,10 +479,14 @@ func (mm *messageManager) Subscribe(ctx context.Context, send func(*sqltypes.Res
 		mm.startVStream()
 	}
 	mm.receivers = append(mm.receivers, withStatus)
-	MessageStats.Set([]string{mm.name.String(), "ClientCount"}, int64(len(mm.receivers)))
+	MessageStats.Set([]string{mm.statsName, "ClientCount"}, int64(len(mm.receivers)))
 	if mm.curReceiver == -1 {
 		mm.rescanReceivers(-1)
 	}
+	if mm.partitionIndex >= 0 {
+		// The partitions were reassigned.
+		mm.cond.Broadcast()
+	}
 
 	// Track the context and unsubscribe if it gets cancelled.
 	go func() {
@@ -417,11 +507,15 @@ func (mm *messageManager) unsubscribe(receiver *messageReceiver) {
 		n := len(mm.receivers)
 		copy(mm.receivers[i:n-1], mm.receivers[i+1:n])
 		mm.receivers = mm.receivers[0 : n-1]
-		MessageStats.Set([]string{mm.name.String(), "ClientCount"}, int64(len(mm.receivers)))
+		MessageStats.Set([]string{mm.statsName, "ClientCount"}, int64(len(mm.receivers)))
 		break
 	}
 	// curReceiver is obsolete. Recompute.
 	mm.rescanReceivers(-1)
+	if mm.partitionIndex >= 0 {
+		// The partitions were reassigned.
+		mm.cond.Broadcast()
+	}
 	// If there are no receivers. Shut down the cache.
 	if len(mm.receivers) == 0 {
 		mm.stopVStream()
@@ -491,6 +585,7 @@ func (mm *messageManager) runSend() {
 		mm.mu.Lock()
 
 		var rows [][]sqltypes.Value
+		var cur int
 		for {
 			if !mm.isOpen {
 				return
@@ -516,29 +611,62 @@ func (mm *messageManager) runSend() {
 
 			// Fetch rows from cache.
 			lateCount := int64(0)
-			for i := 0; i < mm.batchSize; i++ {
+			var deadIDs []string
+			var skipped []*MessageRow
+			cur = mm.curReceiver
+			for i := 0; i < mm.batchSize; {
 				mr := mm.cache.Pop()
 				if mr == nil {
 					break
 				}
+				// Messages that were retried too many times are not sent
+				// any more. They're moved to the dead-letter table instead.
+				if mm.maxRetries > 0 && mr.Epoch > mm.maxRetries {
+					deadIDs = append(deadIDs, mr.Row[0].ToString())
+					i++
+					continue
+				}
+				// A partitioned message can only be sent to its receiver,
+				// and a batch can only go to one receiver.
+				if mm.partitionIndex >= 0 {
+					target := mm.partitionReceiver(mr)
+					if mm.receivers[target].busy || (rows != nil && target != cur) {
+						skipped = append(skipped, mr)
+						continue
+					}
+					cur = target
+				}
 				if mr.Epoch >= 1 {
 					lateCount++
 				}
 				rows = append(rows, mr.Row)
+				i++
+			}
+			for _, mr := range skipped {
+				mm.cache.Requeue(mr)
+			}
+			MessageStats.Add([]string{mm.statsName, "Delayed"}, lateCount)
+			if deadIDs != nil {
+				mm.wg.Add(1)
+				go mm.deadLetter(deadIDs)
 			}
-			MessageStats.Add([]string{mm.name.String(), "Delayed"}, lateCount)
 
 			// If we have rows to send, break out of this loop.
 			if rows != nil {
 				break
 			}
+			// If the receivers of the remaining messages are busy,
+			// wait for one of them to become available.
+			if skipped != nil {
+				mm.cond.Wait()
+			}
 		}
-		MessageStats.Add([]string{mm.name.String(), "Sent"}, int64(len(rows)))
-		// If we're here, there is a current receiver, and messages
+		MessageStats.Add([]string{mm.statsName, "Sent"}, int64(len(rows)))
+		// If we're here, there is a receiver, and messages
 		// to send. Reserve the receiver and find the next one.
-		receiver := mm.receivers[mm.curReceiver]
+		receiver := mm.receivers[cur]
 		receiver.busy = true
-		mm.rescanReceivers(mm.curReceiver)
+		mm.rescanReceivers(cur)
 
 		// Send the message asynchronously.
 		mm.wg.Add(1)
@@ -577,6 +705,10 @@ func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result
 		if mm.curReceiver == -1 {
 			mm.rescanReceivers(-1)
 		}
+		if mm.partitionIndex >= 0 {
+			// The messages of this receiver can be sent again.
+			mm.cond.Broadcast()
+		}
 	}()
 
 	if err := receiver.receiver.Send(qr); err != nil {
@@ -597,10 +729,51 @@ func (mm *messageManager) postpone(tsv TabletService, name string, ackWaitTime t
 	defer mm.postponeSema.Release()
 	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), ackWaitTime)
 	defer cancel()
-	if _, err := tsv.PostponeMessages(ctx, nil, name, ids); err != nil {
+	if _, err := tsv.PostponeMessages(ctx, nil, name, mm.group, ids); err != nil {
 		// This can happen during spikes. Record the incident for monitoring.
-		MessageStats.Add([]string{mm.name.String(), "PostponeFailed"}, 1)
+		MessageStats.Add([]string{mm.statsName, "PostponeFailed"}, 1)
+	}
+}
+
+// deadLetter moves the messages that exceeded the max retries
+// to the dead-letter table.
+func (mm *messageManager) deadLetter(ids []string) {
+	defer func() {
+		mm.tsv.LogError()
+		mm.wg.Done()
+	}()
+
+	defer func() {
+		// Hold streamMu for the same reason as send.
+		mm.streamMu.Lock()
+		defer mm.streamMu.Unlock()
+		mm.cache.Discard(ids)
+	}()
+
+	// Dead-lettering shares the semaphore of postpone.
+	if !mm.postponeSema.Acquire() {
+		// Unreachable.
+		return
 	}
+	defer mm.postponeSema.Release()
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.ackWaitTime)
+	defer cancel()
+	count, err := mm.tsv.DeadLetterMessages(ctx, nil, mm.name.String(), mm.group, ids)
+	if err != nil {
+		// The messages will be retried by the next poll.
+		MessageStats.Add([]string{mm.statsName, "DeadLetterFailed"}, 1)
+		log.Errorf("Unable to move messages to the dead-letter table: %v", err)
+		return
+	}
+	MessageStats.Add([]string{mm.statsName, "DeadLettered"}, count)
+}
+
+// partitionReceiver returns the index of the receiver
+// of a message of a partitioned table.
+func (mm *messageManager) partitionReceiver(mr *MessageRow) int {
+	h := fnv.New32a()
+	_, _ = h.Write(mr.Row[mm.partitionIndex].Raw())
+	return int(h.Sum32() % uint32(len(mm.receivers)))
 }
 
 func (mm *messageManager) startVStream() {
@@ -632,7 +805,7 @@ func (mm *messageManager) runVStream(ctx context.Context) {
 			return
 		default:
 		}
-		MessageStats.Add([]string{mm.name.String(), "VStreamFailed"}, 1)
+		MessageStats.Add([]string{mm.statsName, "VStreamFailed"}, 1)
 		log.Infof("VStream ended: %v, retrying in 5 seconds", err)
 		time.Sleep(5 * time.Second)
 	}
@@ -869,6 +1042,33 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
+// GenerateDeadLetterQueries returns the queries and bind vars for moving
+// messages of the consumer group to the dead-letter table. The queries
+// must be executed in the same transaction. The rows of a table with
+// consumer groups are only deleted once no group has them pending.
+func (mm *messageManager) GenerateDeadLetterQueries(ids []string) ([]string, map[string]*querypb.BindVariable) {
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for _, id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARBINARY,
+			Value: []byte(id),
+		})
+	}
+	bvs := map[string]*querypb.BindVariable{
+		"time_now":    sqltypes.Int64BindVariable(time.Now().UnixNano()),
+		"max_retries": sqltypes.Int64BindVariable(mm.maxRetries),
+		"ids":         idbvs,
+	}
+	if mm.deadLetterAckQuery == nil {
+		return []string{mm.deadLetterInsertQuery.Query, mm.deadLetterDeleteQuery.Query}, bvs
+	}
+	bvs["consumer_group"] = sqltypes.StringBindVariable(mm.group)
+	return []string{mm.deadLetterInsertQuery.Query, mm.deadLetterAckQuery.Query, mm.deadLetterDeleteQuery.Query}, bvs
+}
+
 // BuildMessageRow builds a MessageRow for a db row.
 func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	mr := &MessageRow{Row: row[4:]}
@@ -136,7 +136,7 @@ func (tr *testReceiver) WaitForCount(n int) {
 }
 
 func TestReceiverCancel(t *testing.T) {
-	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -158,7 +158,7 @@ func TestReceiverCancel(t *testing.T) {
 }
 
 func TestMessageManagerState(t *testing.T) {
-	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), "", sync2.NewSemaphore(1, 0))
 	// Do it twice
 	for i := 0; i < 2; i++ {
 		mm.Open()
@@ -176,7 +176,7 @@ func TestMessageManagerState(t *testing.T) {
 func TestMessageManagerAdd(t *testing.T) {
 	ti := newMMTable()
 	ti.MessageInfo.CacheSize = 1
-	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -207,7 +207,7 @@ func TestMessageManagerAdd(t *testing.T) {
 
 func TestMessageManagerSend(t *testing.T) {
 	tsv := newFakeTabletServer()
-	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -299,9 +299,92 @@ func TestMessageManagerSend(t *testing.T) {
 	<-r1.ch
 }
 
+func TestMessageManagerDeadLetter(t *testing.T) {
+	tsv := newFakeTabletServer()
+	ti := newMMTable()
+	ti.MessageInfo.MaxRetries = 2
+	ti.MessageInfo.DeadLetterTable = "foo_dead"
+	mm := newMessageManager(tsv, newFakeVStreamer(), ti, "", sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	ch := make(chan string, 20)
+	tsv.SetChannel(ch)
+	// The message that exceeded the max retries is not sent.
+	mm.Add(&MessageRow{Epoch: 3, Row: []sqltypes.Value{sqltypes.NewVarBinary("1")}})
+	assert.Equal(t, "deadletter", <-ch)
+	mm.Add(&MessageRow{Epoch: 2, Row: []sqltypes.Value{sqltypes.NewVarBinary("2")}})
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{sqltypes.NewVarBinary("2")}},
+	}
+	utils.MustMatch(t, want, <-r1.ch, "did not match")
+	assert.Equal(t, "postpone", <-ch)
+
+	tsv.mu.Lock()
+	assert.Equal(t, []string{"1"}, tsv.deadIDs)
+	assert.Equal(t, "", tsv.deadGroup)
+	tsv.mu.Unlock()
+	for i := 0; ; i++ {
+		mm.cache.mu.Lock()
+		_, inFlight := mm.cache.inFlight["1"]
+		mm.cache.mu.Unlock()
+		if !inFlight {
+			break
+		}
+		if i == 100 {
+			t.Fatal("dead message was not discarded from the cache")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestMessageManagerPartition(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.PartitionColumn = "message"
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, "", sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(10)
+	r2 := newTestReceiver(10)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+	mm.Subscribe(context.Background(), r2.rcv)
+	<-r2.ch
+
+	for i := 1; i <= 6; i++ {
+		key := "a"
+		if i > 3 {
+			key = "b"
+		}
+		mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary(fmt.Sprint(i)), sqltypes.NewVarBinary(key)}})
+	}
+	for r1.count.Get()+r2.count.Get() != 8 {
+		runtime.Gosched()
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// All the messages of a partition must go to the same receiver.
+	receivers := make(map[string]*testReceiver)
+	for _, r := range []*testReceiver{r1, r2} {
+		for len(r.ch) != 0 {
+			key := (<-r.ch).Rows[0][1].ToString()
+			if receivers[key] == nil {
+				receivers[key] = r
+			}
+			assert.True(t, receivers[key] == r, "messages of partition %s were sent to different receivers", key)
+		}
+	}
+	assert.Len(t, receivers, 2)
+}
+
 func TestMessageManagerPostponeThrottle(t *testing.T) {
 	tsv := newFakeTabletServer()
-	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -349,7 +432,7 @@ func TestMessageManagerPostponeThrottle(t *testing.T) {
 
 func TestMessageManagerSendError(t *testing.T) {
 	tsv := newFakeTabletServer()
-	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 	ctx := context.Background()
@@ -378,7 +461,7 @@ func TestMessageManagerSendError(t *testing.T) {
 }
 
 func TestMessageManagerFieldSendError(t *testing.T) {
-	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 	ctx := context.Background()
@@ -398,7 +481,7 @@ func TestMessageManagerFieldSendError(t *testing.T) {
 func TestMessageManagerBatchSend(t *testing.T) {
 	ti := newMMTable()
 	ti.MessageInfo.BatchSize = 2
-	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -468,7 +551,7 @@ func TestMessageManagerStreamerSimple(t *testing.T) {
 	}, {
 		Type: binlogdatapb.VEventType_COMMIT,
 	}}})
-	mm := newMessageManager(newFakeTabletServer(), fvs, newMMTable(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), fvs, newMMTable(), "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -493,7 +576,7 @@ func TestMessageManagerStreamerAndPoller(t *testing.T) {
 		Fields: testDBFields,
 		Gtid:   "MySQL56/33333333-3333-3333-3333-333333333333:1-100",
 	}})
-	mm := newMessageManager(newFakeTabletServer(), fvs, newMMTable(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), fvs, newMMTable(), "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -592,7 +675,7 @@ func TestMessageManagerPoller(t *testing.T) {
 			newMMRow(3),
 		},
 	}})
-	mm := newMessageManager(newFakeTabletServer(), fvs, ti, sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), fvs, ti, "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -648,7 +731,7 @@ func TestMessagesPending1(t *testing.T) {
 	ti.MessageInfo.CacheSize = 2
 	ti.MessageInfo.PollInterval = 30 * time.Second
 	fvs := newFakeVStreamer()
-	mm := newMessageManager(newFakeTabletServer(), fvs, ti, sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), fvs, ti, "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -697,7 +780,7 @@ func TestMessagesPending2(t *testing.T) {
 	}, {
 		Rows: []*querypb.Row{newMMRow(1)},
 	}})
-	mm := newMessageManager(newFakeTabletServer(), fvs, ti, sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), fvs, ti, "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -725,7 +808,7 @@ func TestMessageManagerPurge(t *testing.T) {
 
 	ti := newMMTable()
 	ti.MessageInfo.PollInterval = 1 * time.Millisecond
-	mm := newMessageManager(tsv, newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(tsv, newFakeVStreamer(), ti, "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 	// Ensure Purge got called.
@@ -735,7 +818,7 @@ func TestMessageManagerPurge(t *testing.T) {
 }
 
 func TestMMGenerate(t *testing.T) {
-	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 	query, bv := mm.GenerateAckQuery([]string{"1", "2"})
@@ -790,8 +873,90 @@ func TestMMGenerate(t *testing.T) {
 	}
 }
 
+func TestMMGenerateDeadLetter(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.MaxRetries = 5
+	ti.MessageInfo.DeadLetterTable = "foo_dead"
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, "", sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	queries, bv := mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	wantQueries := []string{
+		"insert into foo_dead(priority, epoch, time_dead, id, message) select priority, epoch, :time_now, id, message from foo where id in ::ids and time_acked is null and epoch > :max_retries",
+		"delete from foo where id in ::ids and time_acked is null and epoch > :max_retries",
+	}
+	assert.Equal(t, wantQueries, queries)
+	if _, ok := bv["time_now"]; !ok {
+		t.Errorf("time_now is absent in %v", bv)
+	} else {
+		// time_now cannot be compared.
+		delete(bv, "time_now")
+	}
+	wantbv := map[string]*querypb.BindVariable{
+		"max_retries": sqltypes.Int64BindVariable(5),
+		"ids":         sqltypes.TestBindVariable([]interface{}{"1", "2"}),
+	}
+	utils.MustMatch(t, wantbv, bv, "did not match")
+}
+
+func TestMMGenerateGroup(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.ConsumerGroups = []string{"g1", "g2"}
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, "g1", sync2.NewSemaphore(1, 0))
+
+	assert.Equal(t, "foo.g1", mm.statsName)
+	assert.Equal(t, "select priority, time_next_g1, epoch_g1, time_acked_g1, id, message from foo", mm.vsFilter.Rules[0].Filter)
+	assert.Equal(t, "select priority, time_next_g1, epoch_g1, time_acked_g1, id, message from foo where time_next_g1 < :time_next order by priority, time_next_g1 desc limit :max", mm.readByPriorityAndTimeNext.Query)
+	query, _ := mm.GenerateAckQuery([]string{"1"})
+	assert.Equal(t, "update foo set time_acked_g1 = :time_acked, time_next_g1 = null where id in ::ids and time_acked_g1 is null", query)
+	query, _ = mm.GeneratePostponeQuery([]string{"1"})
+	assert.Equal(t, "update foo set time_next_g1 = :time_now + :wait_time + IF(FLOOR((:min_backoff<<ifnull(epoch_g1, 0)) * :jitter) < :min_backoff, :min_backoff, FLOOR((:min_backoff<<ifnull(epoch_g1, 0)) * :jitter)), epoch_g1 = ifnull(epoch_g1, 0)+1 where id in ::ids and time_acked_g1 is null", query)
+	// Only the default group purges.
+	assert.Nil(t, mm.purgeQuery)
+
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, "", sync2.NewSemaphore(1, 0))
+	query, _ = mm.GeneratePurgeQuery(3)
+	assert.Equal(t, "delete from foo where time_acked < :time_acked and time_acked_g1 < :time_acked and time_acked_g2 < :time_acked limit 500", query)
+}
+
+func TestMMGenerateDeadLetterGroup(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.MaxRetries = 5
+	ti.MessageInfo.DeadLetterTable = "foo_dead"
+	ti.MessageInfo.ConsumerGroups = []string{"g1", "g2"}
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, "g1", sync2.NewSemaphore(1, 0))
+
+	// The row is only deleted once no group has it pending anymore.
+	queries, bv := mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	wantQueries := []string{
+		"insert into foo_dead(consumer_group, priority, epoch, time_dead, id, message) select :consumer_group, priority, epoch_g1, :time_now, id, message from foo where id in ::ids and time_acked_g1 is null and epoch_g1 > :max_retries",
+		"update foo set time_acked_g1 = :time_now, time_next_g1 = null where id in ::ids and time_acked_g1 is null and epoch_g1 > :max_retries",
+		"delete from foo where id in ::ids and time_acked is not null and time_acked_g1 is not null and time_acked_g2 is not null",
+	}
+	assert.Equal(t, wantQueries, queries)
+	delete(bv, "time_now")
+	wantbv := map[string]*querypb.BindVariable{
+		"consumer_group": sqltypes.StringBindVariable("g1"),
+		"max_retries":    sqltypes.Int64BindVariable(5),
+		"ids":            sqltypes.TestBindVariable([]interface{}{"1", "2"}),
+	}
+	utils.MustMatch(t, wantbv, bv, "did not match")
+
+	// The default group of a table with consumer groups uses the same queries.
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, "", sync2.NewSemaphore(1, 0))
+	queries, bv = mm.GenerateDeadLetterQueries([]string{"1"})
+	wantQueries = []string{
+		"insert into foo_dead(consumer_group, priority, epoch, time_dead, id, message) select :consumer_group, priority, epoch, :time_now, id, message from foo where id in ::ids and time_acked is null and epoch > :max_retries",
+		"update foo set time_acked = :time_now, time_next = null where id in ::ids and time_acked is null and epoch > :max_retries",
+		"delete from foo where id in ::ids and time_acked is not null and time_acked_g1 is not null and time_acked_g2 is not null",
+	}
+	assert.Equal(t, wantQueries, queries)
+	utils.MustMatch(t, sqltypes.StringBindVariable(""), bv["consumer_group"], "did not match")
+}
+
 func TestMMGenerateWithBackoff(t *testing.T) {
-	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTableWithBackoff(), sync2.NewSemaphore(1, 0))
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTableWithBackoff(), "", sync2.NewSemaphore(1, 0))
 	mm.Open()
 	defer mm.Close()
 
@@ -829,6 +994,8 @@ type fakeTabletServer struct {
 	tabletenv.Env
 	postponeCount sync2.AtomicInt64
 	purgeCount    sync2.AtomicInt64
+	deadIDs       []string
+	deadGroup     string
 
 	mu sync.Mutex
 	ch chan string
@@ -849,7 +1016,7 @@ func (fts *fakeTabletServer) SetChannel(ch chan string) {
 	fts.mu.Unlock()
 }
 
-func (fts *fakeTabletServer) PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+func (fts *fakeTabletServer) PostponeMessages(ctx context.Context, target *querypb.Target, name, group string, ids []string) (count int64, err error) {
 	fts.postponeCount.Add(1)
 	fts.mu.Lock()
 	ch := fts.ch
@@ -871,6 +1038,18 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name, group string, ids []string) (count int64, err error) {
+	fts.mu.Lock()
+	fts.deadIDs = append(fts.deadIDs, ids...)
+	fts.deadGroup = group
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		ch <- "deadletter"
+	}
+	return int64(len(ids)), nil
+}
+
 type fakeVStreamer struct {
 	streamInvocations sync2.AtomicInt64
 	mu                sync.Mutex
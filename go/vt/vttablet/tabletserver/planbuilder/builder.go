@@ -71,6 +71,7 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 		buf := sqlparser.NewTrackedBuffer(nil)
 		buf.Myprintf("%v", upd.Where)
 		plan.WhereClause = buf.ParsedQuery()
+		plan.WhereEqualities = analyzeWhereEqualities(upd.Where)
 	}
 
 	// Situations when we pass-through:
@@ -100,6 +101,7 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 		buf := sqlparser.NewTrackedBuffer(nil)
 		buf.Myprintf("%v", del.Where)
 		plan.WhereClause = buf.ParsedQuery()
+		plan.WhereEqualities = analyzeWhereEqualities(del.Where)
 	}
 
 	if PassthroughDMLs || plan.Table == nil || del.Limit != nil {
@@ -113,6 +115,32 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
+// analyzeWhereEqualities returns the columns compared to a value by the
+// top level AND conditions of the WHERE clause.
+func analyzeWhereEqualities(where *sqlparser.Where) []WhereEquality {
+	var equalities []WhereEquality
+	for _, expr := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		cmp, ok := expr.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualOp {
+			continue
+		}
+		left, right := cmp.Left, cmp.Right
+		if !sqlparser.IsColName(left) {
+			left, right = right, left
+		}
+		col, ok := left.(*sqlparser.ColName)
+		if !ok || !sqlparser.IsValue(right) {
+			continue
+		}
+		value, err := sqlparser.NewPlanValue(right)
+		if err != nil {
+			continue
+		}
+		equalities = append(equalities, WhereEquality{Column: col.Name, Value: value})
+	}
+	return equalities
+}
+
 func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
 		PlanID:    PlanInsert,
@@ -124,9 +152,12 @@ func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
-func analyzeShow(show *sqlparser.Show, dbName string) (plan *Plan, err error) {
+func analyzeShow(show *sqlparser.Show, tables map[string]*schema.Table, dbName string) (plan *Plan, err error) {
 	switch showInternal := show.Internal.(type) {
 	case *sqlparser.ShowBasic:
+		if showInternal.Command == sqlparser.VitessDeadLetters {
+			return analyzeShowDeadLetters(showInternal, tables)
+		}
 		if showInternal.Command == sqlparser.Table {
 			// rewrite WHERE clause if it exists
 			// `where Tables_in_Keyspace` => `where Tables_in_DbName`
@@ -150,6 +181,124 @@ func analyzeShow(show *sqlparser.Show, dbName string) (plan *Plan, err error) {
 	return &Plan{PlanID: PlanOtherRead}, nil
 }
 
+// analyzeShowDeadLetters builds a select on the dead-letter table
+// of a message table.
+func analyzeShowDeadLetters(show *sqlparser.ShowBasic, tables map[string]*schema.Table) (plan *Plan, err error) {
+	table, deadLetterTable, err := lookupDeadLetterTable(show.Tbl, tables)
+	if err != nil {
+		return nil, err
+	}
+	sel := &sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+		From:        sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: deadLetterTable}},
+	}
+	if show.Filter != nil {
+		if show.Filter.Filter == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "like is not supported for dead letters, use a where clause")
+		}
+		sel.Where = sqlparser.NewWhere(sqlparser.WhereClause, show.Filter.Filter)
+	}
+	return &Plan{
+		PlanID:     PlanSelect,
+		Table:      table,
+		FieldQuery: GenerateFieldQuery(sel),
+		FullQuery:  GenerateLimitQuery(sel),
+	}, nil
+}
+
+// analyzeAlterDeadLetters builds the plans that requeue or purge the
+// dead letters of a message table.
+func analyzeAlterDeadLetters(alter *sqlparser.AlterDeadLetters, tables map[string]*schema.Table) (plan *Plan, err error) {
+	table, deadLetterTable, err := lookupDeadLetterTable(alter.Table, tables)
+	if err != nil {
+		return nil, err
+	}
+	del := &sqlparser.Delete{
+		TableExprs: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: deadLetterTable}},
+		Where:      alter.Where,
+	}
+	if alter.Type == sqlparser.PurgeDeadLettersType {
+		return &Plan{
+			PlanID:    PlanDelete,
+			Table:     table,
+			FullQuery: GenerateFullQuery(del),
+		}, nil
+	}
+
+	// The requeued messages are sent right away, and with a fresh epoch.
+	buf := sqlparser.NewTrackedBuffer(nil)
+	for _, field := range table.MessageInfo.Fields {
+		buf.Myprintf(", %v", sqlparser.NewColIdent(field.Name))
+	}
+	columns := buf.String()
+	if len(table.MessageInfo.ConsumerGroups) == 0 {
+		return &Plan{
+			PlanID: PlanRequeueMessages,
+			Table:  table,
+			FullQuery: sqlparser.BuildParsedQuery(
+				"insert into %v(priority, time_next%s) select priority, %a%s from %v%v",
+				table.Name, columns, ":#time_now", columns, deadLetterTable, alter.Where),
+			DeleteQuery: GenerateFullQuery(del),
+		}, nil
+	}
+
+	// The rows of a table with consumer groups are shared by the groups,
+	// and the dead letters record their group. The rows deleted since are
+	// inserted back acked for every group, and the dead letters are then
+	// requeued for their group only.
+	groups := append([]string{""}, table.MessageInfo.ConsumerGroups...)
+	insertColumns := sqlparser.NewTrackedBuffer(nil)
+	insertValues := sqlparser.NewTrackedBuffer(nil)
+	for _, group := range groups {
+		insertColumns.Myprintf(", %v, %v, %v",
+			sqlparser.NewColIdent(schema.GroupColumn("time_next", group)),
+			sqlparser.NewColIdent(schema.GroupColumn("epoch", group)),
+			sqlparser.NewColIdent(schema.GroupColumn("time_acked", group)))
+		insertValues.Myprintf(", null, 0, %a", ":#time_now")
+	}
+	plan = &Plan{
+		PlanID: PlanRequeueMessages,
+		Table:  table,
+		FullQuery: sqlparser.BuildParsedQuery(
+			"insert into %v(priority%s%s) select priority%s%s from %v%v on duplicate key update id = id",
+			table.Name, insertColumns.String(), columns, insertValues.String(), columns, deadLetterTable, alter.Where),
+		DeleteQuery: GenerateFullQuery(del),
+	}
+	for _, group := range groups {
+		var filter sqlparser.Expr = &sqlparser.ComparisonExpr{
+			Operator: sqlparser.EqualOp,
+			Left:     sqlparser.NewColName("consumer_group"),
+			Right:    sqlparser.NewStrLiteral(group),
+		}
+		if alter.Where != nil {
+			filter = &sqlparser.AndExpr{Left: filter, Right: alter.Where.Expr}
+		}
+		plan.RequeueQueries = append(plan.RequeueQueries, sqlparser.BuildParsedQuery(
+			"update %v set %v = %a, %v = 0, %v = null where id in (select id from %v%v)",
+			table.Name,
+			sqlparser.NewColIdent(schema.GroupColumn("time_next", group)), ":#time_now",
+			sqlparser.NewColIdent(schema.GroupColumn("epoch", group)),
+			sqlparser.NewColIdent(schema.GroupColumn("time_acked", group)),
+			deadLetterTable, sqlparser.NewWhere(sqlparser.WhereClause, filter)))
+	}
+	return plan, nil
+}
+
+// lookupDeadLetterTable returns the message table and its dead-letter table.
+func lookupDeadLetterTable(name sqlparser.TableName, tables map[string]*schema.Table) (*schema.Table, sqlparser.TableName, error) {
+	table := tables[name.Name.String()]
+	if table == nil {
+		return nil, sqlparser.TableName{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s not found in schema", name.Name.String())
+	}
+	if table.Type != schema.Message {
+		return nil, sqlparser.TableName{}, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "'%s' is not a message table", name.Name.String())
+	}
+	if table.MessageInfo.DeadLetterTable == "" {
+		return nil, sqlparser.TableName{}, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "message table %s has no dead-letter table", name.Name.String())
+	}
+	return table, sqlparser.TableName{Name: sqlparser.NewTableIdent(table.MessageInfo.DeadLetterTable)}, nil
+}
+
 func showTableRewrite(show *sqlparser.ShowBasic, dbName string) {
 	filter := show.Filter.Filter
 	if filter == nil {
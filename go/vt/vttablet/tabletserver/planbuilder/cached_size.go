@@ -39,7 +39,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(168)
+		size += int64(232)
 	}
 	// field Table *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.Table
 	size += cached.Table.CachedSize(true)
@@ -58,9 +58,39 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	size += cached.NextCount.CachedSize(false)
 	// field WhereClause *vitess.io/vitess/go/vt/sqlparser.ParsedQuery
 	size += cached.WhereClause.CachedSize(true)
+	// field WhereEqualities []vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder.WhereEquality
+	{
+		size += int64(cap(cached.WhereEqualities)) * int64(128)
+		for _, elem := range cached.WhereEqualities {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field DeleteQuery *vitess.io/vitess/go/vt/sqlparser.ParsedQuery
+	size += cached.DeleteQuery.CachedSize(true)
+	// field RequeueQueries []*vitess.io/vitess/go/vt/sqlparser.ParsedQuery
+	{
+		size += int64(cap(cached.RequeueQueries)) * int64(8)
+		for _, elem := range cached.RequeueQueries {
+			size += elem.CachedSize(true)
+		}
+	}
 	// field FullStmt vitess.io/vitess/go/vt/sqlparser.Statement
 	if cc, ok := cached.FullStmt.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
 	return size
 }
+func (cached *WhereEquality) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field Column vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Column.CachedSize(false)
+	// field Value vitess.io/vitess/go/sqltypes.PlanValue
+	size += cached.Value.CachedSize(false)
+	return size
+}
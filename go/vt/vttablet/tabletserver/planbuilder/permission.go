@@ -53,12 +53,18 @@ func BuildPermissions(stmt sqlparser.Statement) []Permission {
 		}
 	case *sqlparser.AlterMigration:
 		permissions = []Permission{} // TODO(shlomi) what are the correct permissions here? Table is unknown
+	case *sqlparser.AlterDeadLetters:
+		permissions = buildTableNamePermissions(node.Table, tableacl.WRITER, permissions)
+	case *sqlparser.Show:
+		if show, ok := node.Internal.(*sqlparser.ShowBasic); ok && show.Command == sqlparser.VitessDeadLetters {
+			permissions = buildTableNamePermissions(show.Tbl, tableacl.READER, permissions)
+		}
 	case *sqlparser.Flush:
 		for _, t := range node.TableNames {
 			permissions = buildTableNamePermissions(t, tableacl.ADMIN, permissions)
 		}
 	case *sqlparser.OtherAdmin, *sqlparser.CallProc, *sqlparser.Begin, *sqlparser.Commit, *sqlparser.Rollback,
-		*sqlparser.Load, *sqlparser.Savepoint, *sqlparser.Release, *sqlparser.SRollback, *sqlparser.Set, *sqlparser.Show,
+		*sqlparser.Load, *sqlparser.Savepoint, *sqlparser.Release, *sqlparser.SRollback, *sqlparser.Set,
 		*sqlparser.OtherRead, sqlparser.Explain:
 		// no op
 	default:
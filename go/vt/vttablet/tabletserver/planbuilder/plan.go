@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -73,6 +74,8 @@ const (
 	PlanUnlockTables
 	PlanCallProc
 	PlanAlterMigration
+	// PlanRequeueMessages is for "alter vitess_dead_letters ... requeue" statements.
+	PlanRequeueMessages
 	NumPlans
 )
 
@@ -103,6 +106,7 @@ var planName = []string{
 	"UnlockTables",
 	"CallProcedure",
 	"AlterMigration",
+	"RequeueMessages",
 }
 
 func (pt PlanType) String() string {
@@ -165,8 +169,35 @@ type Plan struct {
 	// to serialize e.g. UPDATEs going to the same row.
 	WhereClause *sqlparser.ParsedQuery
 
+	// WhereEqualities is set for DMLs. It lists the columns that the WHERE
+	// clause compares to a value, and lets the hot row protection key the
+	// rows by the configured columns of the table.
+	WhereEqualities []WhereEquality
+
+	// DeleteQuery is set for RequeueMessages. It deletes the
+	// requeued messages from the dead-letter table.
+	DeleteQuery *sqlparser.ParsedQuery
+
+	// RequeueQueries are set for RequeueMessages on a table with consumer
+	// groups. Executed after FullQuery, they requeue the messages for the
+	// groups they were dead-lettered for.
+	RequeueQueries []*sqlparser.ParsedQuery
+
 	// FullStmt can be used when the query does not operate on tables
 	FullStmt sqlparser.Statement
+
+	// Priority is the priority class set by the priority directive of
+	// the query, if HasPriority is true. It overrides the priority of
+	// the ExecuteOptions.
+	Priority    querypb.ExecuteOptions_Priority
+	HasPriority bool
+}
+
+// WhereEquality is a column compared to a value by the top level
+// conditions of a WHERE clause.
+type WhereEquality struct {
+	Column sqlparser.ColIdent
+	Value  sqltypes.PlanValue
 }
 
 // TableName returns the table name for the plan.
@@ -216,8 +247,10 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		plan = &Plan{PlanID: PlanDDL, FullQuery: fullQuery}
 	case *sqlparser.AlterMigration:
 		plan, err = &Plan{PlanID: PlanAlterMigration, FullStmt: stmt}, nil
+	case *sqlparser.AlterDeadLetters:
+		plan, err = analyzeAlterDeadLetters(stmt, tables)
 	case *sqlparser.Show:
-		plan, err = analyzeShow(stmt, dbName)
+		plan, err = analyzeShow(stmt, tables, dbName)
 	case *sqlparser.OtherRead, sqlparser.Explain:
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
@@ -241,6 +274,7 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.Priority, plan.HasPriority = sqlparser.PriorityDirective(statement)
 	return plan, nil
 }
 
@@ -263,6 +297,7 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 		FullQuery:   GenerateFullQuery(statement),
 		Permissions: BuildPermissions(statement),
 	}
+	plan.Priority, plan.HasPriority = sqlparser.PriorityDirective(statement)
 
 	switch stmt := statement.(type) {
 	case *sqlparser.Select:
@@ -40,20 +40,24 @@ import (
 // This is only for testing.
 func (p *Plan) MarshalJSON() ([]byte, error) {
 	mplan := struct {
-		PlanID      PlanType
-		TableName   sqlparser.TableIdent   `json:",omitempty"`
-		Permissions []Permission           `json:",omitempty"`
-		FieldQuery  *sqlparser.ParsedQuery `json:",omitempty"`
-		FullQuery   *sqlparser.ParsedQuery `json:",omitempty"`
-		NextCount   string                 `json:",omitempty"`
-		WhereClause *sqlparser.ParsedQuery `json:",omitempty"`
+		PlanID         PlanType
+		TableName      sqlparser.TableIdent     `json:",omitempty"`
+		Permissions    []Permission             `json:",omitempty"`
+		FieldQuery     *sqlparser.ParsedQuery   `json:",omitempty"`
+		FullQuery      *sqlparser.ParsedQuery   `json:",omitempty"`
+		NextCount      string                   `json:",omitempty"`
+		WhereClause    *sqlparser.ParsedQuery   `json:",omitempty"`
+		DeleteQuery    *sqlparser.ParsedQuery   `json:",omitempty"`
+		RequeueQueries []*sqlparser.ParsedQuery `json:",omitempty"`
 	}{
-		PlanID:      p.PlanID,
-		TableName:   p.TableName(),
-		Permissions: p.Permissions,
-		FieldQuery:  p.FieldQuery,
-		FullQuery:   p.FullQuery,
-		WhereClause: p.WhereClause,
+		PlanID:         p.PlanID,
+		TableName:      p.TableName(),
+		Permissions:    p.Permissions,
+		FieldQuery:     p.FieldQuery,
+		FullQuery:      p.FullQuery,
+		WhereClause:    p.WhereClause,
+		DeleteQuery:    p.DeleteQuery,
+		RequeueQueries: p.RequeueQueries,
 	}
 	if !p.NextCount.IsNull() {
 		b, _ := p.NextCount.MarshalJSON()
@@ -268,6 +272,38 @@ func TestMessageStreamingPlan(t *testing.T) {
 	}
 }
 
+func TestWhereEqualities(t *testing.T) {
+	testSchema := loadSchema("schema_test.json")
+	testcases := []struct {
+		sql  string
+		want map[string]string
+	}{{
+		sql:  "update a set name = 2 where eid = :eid and 1 = id and name > 3",
+		want: map[string]string{"eid": ":eid", "id": "1"},
+	}, {
+		sql:  "delete from a where eid = 'x' and (id = 1 or id = 2)",
+		want: map[string]string{"eid": "x"},
+	}, {
+		sql:  "update a set name = 2 where eid = id",
+		want: map[string]string{},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.sql, func(t *testing.T) {
+			statement, err := sqlparser.Parse(tc.sql)
+			require.NoError(t, err)
+			plan, err := Build(statement, testSchema, false /* isReservedConn */, "dbName")
+			require.NoError(t, err)
+			got := make(map[string]string)
+			for _, equality := range plan.WhereEqualities {
+				b, err := equality.Value.MarshalJSON()
+				require.NoError(t, err)
+				got[equality.Column.String()] = strings.Trim(string(b), `"`)
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func loadSchema(name string) map[string]*schema.Table {
 	b, err := ioutil.ReadFile(locateFile(name))
 	if err != nil {
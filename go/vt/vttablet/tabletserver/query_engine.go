@@ -130,6 +130,11 @@ type QueryEngine struct {
 	// that we start more than one transaction per hot row (range).
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
+	// quarantine rejects for a while the query fingerprints that use too
+	// many resources.
+	quarantine *queryQuarantine
+	// resultMemory accounts for the bytes of the query results.
+	resultMemory *resultMemory
 
 	// Vars
 	maxResultSize    sync2.AtomicInt64
@@ -180,6 +185,8 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
 	qe.txSerializer = txserializer.New(env)
+	qe.quarantine = newQueryQuarantine(env, qe)
+	qe.resultMemory = newResultMemory(env)
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
@@ -259,6 +266,7 @@ func (qe *QueryEngine) Open() error {
 
 	qe.streamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
 	qe.se.RegisterNotifier("qe", qe.schemaChanged)
+	qe.quarantine.Open()
 	qe.isOpen = true
 	return nil
 }
@@ -271,6 +279,7 @@ func (qe *QueryEngine) Close() {
 		return
 	}
 	// Close in reverse order of Open.
+	qe.quarantine.Close()
 	qe.se.UnregisterNotifier("qe")
 	qe.plans.Clear()
 	qe.tables = make(map[string]*schema.Table)
@@ -311,7 +320,7 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	plan.buildAuthorized()
 	if plan.PlanID.IsSelect() {
 		if !skipQueryPlanCache && qe.enableQueryPlanFieldCaching && plan.FieldQuery != nil {
-			conn, err := qe.conns.Get(ctx)
+			conn, err := qe.conns.Get(withPlanPriority(ctx, plan))
 			if err != nil {
 				return nil, err
 			}
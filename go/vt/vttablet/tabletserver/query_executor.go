@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -57,6 +58,11 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+	// rule is the query rule whose limits the query is counted in.
+	rule *rules.Rule
+	// resultBytes are the bytes of the result held against the
+	// result memory of the tablet.
+	resultBytes int64
 }
 
 var sequenceFields = []*querypb.Field{
@@ -76,10 +82,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		qre.recordUserQuery("Execute", int64(duration))
 
 		mysqlTime := qre.logStats.MysqlResponseTime
-		tableName := qre.plan.TableName().String()
-		if tableName == "" {
-			tableName = "Join"
-		}
+		tableName := qre.statsTableName()
 
 		if reply == nil {
 			qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, 0, 1)
@@ -91,8 +94,13 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		qre.logStats.RowsAffected = int(reply.RowsAffected)
 		qre.logStats.Rows = reply.Rows
 		qre.tsv.Stats().ResultHistogram.Add(int64(len(reply.Rows)))
+		qre.tsv.qe.resultMemory.record(qre.plan, tableName, planName, resultBytes(reply), int64(len(reply.Rows)))
 	}(time.Now())
 
+	defer qre.releaseRule()
+	defer func() {
+		qre.releaseResult(err)
+	}()
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
@@ -134,6 +142,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		if err := qre.verifyRowCount(int64(len(qr.Rows)), maxrows); err != nil {
 			return nil, err
 		}
+		qre.warnResultBytes()
 		return qr, nil
 	case p.PlanOtherRead, p.PlanOtherAdmin, p.PlanFlush:
 		return qre.execOther()
@@ -141,7 +150,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		return qre.execOther()
 	case p.PlanInsert, p.PlanUpdate, p.PlanDelete, p.PlanInsertMessage, p.PlanDDL, p.PlanLoad:
 		return qre.execAutocommit(qre.txConnExec)
-	case p.PlanUpdateLimit, p.PlanDeleteLimit:
+	case p.PlanUpdateLimit, p.PlanDeleteLimit, p.PlanRequeueMessages:
 		return qre.execAsTransaction(qre.txConnExec)
 	case p.PlanCallProc:
 		return qre.execCallProc()
@@ -204,6 +213,8 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 		return qre.txFetch(conn, true)
 	case p.PlanUpdateLimit, p.PlanDeleteLimit:
 		return qre.execDMLLimit(conn)
+	case p.PlanRequeueMessages:
+		return qre.execRequeueMessages(conn)
 	case p.PlanOtherRead, p.PlanOtherAdmin, p.PlanFlush:
 		return qre.execStatefulConn(conn, qre.query, true)
 	case p.PlanSavepoint, p.PlanRelease, p.PlanSRollback:
@@ -221,6 +232,7 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 		if err := qre.verifyRowCount(int64(len(qr.Rows)), maxrows); err != nil {
 			return nil, err
 		}
+		qre.warnResultBytes()
 		return qr, nil
 	case p.PlanDDL:
 		return qre.execDDL(conn)
@@ -241,6 +253,7 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 		qre.recordUserQuery("Stream", int64(time.Since(start)))
 	}(time.Now())
 
+	defer qre.releaseRule()
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
@@ -270,8 +283,8 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	return qre.execStreamSQL(conn, sql, callback)
 }
 
-// MessageStream streams messages from a message table.
-func (qre *QueryExecutor) MessageStream(callback func(*sqltypes.Result) error) error {
+// MessageStream streams messages of the consumer group from a message table.
+func (qre *QueryExecutor) MessageStream(group string, callback func(*sqltypes.Result) error) error {
 	qre.logStats.OriginalSQL = qre.query
 	qre.logStats.PlanType = qre.plan.PlanID.String()
 
@@ -280,11 +293,12 @@ func (qre *QueryExecutor) MessageStream(callback func(*sqltypes.Result) error) e
 		qre.recordUserQuery("MessageStream", int64(time.Since(start)))
 	}(time.Now())
 
+	defer qre.releaseRule()
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
 
-	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), func(r *sqltypes.Result) error {
+	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), group, func(r *sqltypes.Result) error {
 		select {
 		case <-qre.ctx.Done():
 			return io.EOF
@@ -315,12 +329,8 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars)
-	switch action {
-	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
-	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+	if err := qre.applyRule(qre.plan.Rules.GetMatch(remoteAddr, username, qre.bindVars)); err != nil {
+		return err
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -358,6 +368,49 @@ func (qre *QueryExecutor) checkPermissions() error {
 	return nil
 }
 
+// applyRule performs the action of the query rule triggered by the query,
+// if any. A CONCURRENCY rule keeps its slot until releaseRule is called.
+func (qre *QueryExecutor) applyRule(qr *rules.Rule) error {
+	if qr == nil {
+		return nil
+	}
+	qre.tsv.stats.QueryRuleMatches.Add(qr.Name, 1)
+	switch qr.Action() {
+	case rules.QRFail:
+		qre.tsv.stats.QueryRuleRejections.Add(qr.Name, 1)
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qr.Description)
+	case rules.QRFailRetry:
+		qre.tsv.stats.QueryRuleRejections.Add(qr.Name, 1)
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qr.Description)
+	case rules.QRFailClusterEvent:
+		qre.tsv.stats.QueryRuleRejections.Add(qr.Name, 1)
+		return vterrors.Errorf(vtrpcpb.Code_CLUSTER_EVENT, "disallowed due to rule: %s", qr.Description)
+	case rules.QRThrottle, rules.QRConcurrency:
+		if !qr.Acquire() {
+			qre.tsv.stats.QueryRuleRejections.Add(qr.Name, 1)
+			return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "throttled due to rule: %s", qr.Description)
+		}
+		qre.rule = qr
+	case rules.QRDelay:
+		timer := time.NewTimer(qr.Delay())
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-qre.ctx.Done():
+			return vterrors.Wrapf(qre.ctx.Err(), "delayed due to rule: %s", qr.Description)
+		}
+	}
+	return nil
+}
+
+// releaseRule gives back the slot taken by a CONCURRENCY query rule.
+func (qre *QueryExecutor) releaseRule() {
+	if qre.rule != nil {
+		qre.rule.Release()
+		qre.rule = nil
+	}
+}
+
 func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
 	if !authorized.IsMember(callerID) {
@@ -550,6 +603,34 @@ func (qre *QueryExecutor) execDMLLimit(conn *StatefulConnection) (*sqltypes.Resu
 	return result, nil
 }
 
+// execRequeueMessages moves the dead letters back to the message table.
+// It returns the number of requeued dead letters.
+func (qre *QueryExecutor) execRequeueMessages(conn *StatefulConnection) (*sqltypes.Result, error) {
+	qre.bindVars["#time_now"] = sqltypes.Int64BindVariable(time.Now().UnixNano())
+	if _, err := qre.txFetch(conn, true); err != nil {
+		return nil, err
+	}
+	for _, query := range qre.plan.RequeueQueries {
+		if _, err := qre.execRequeueQuery(conn, query); err != nil {
+			return nil, err
+		}
+	}
+	return qre.execRequeueQuery(conn, qre.plan.DeleteQuery)
+}
+
+func (qre *QueryExecutor) execRequeueQuery(conn *StatefulConnection, query *sqlparser.ParsedQuery) (*sqltypes.Result, error) {
+	sql, _, err := qre.generateFinalSQL(query, qre.bindVars)
+	if err != nil {
+		return nil, err
+	}
+	result, err := qre.execStatefulConn(conn, sql, true)
+	if err != nil {
+		return nil, err
+	}
+	conn.TxProperties().RecordQuery(sql)
+	return result, nil
+}
+
 func (qre *QueryExecutor) verifyRowCount(count, maxrows int64) error {
 	if count > maxrows {
 		callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
@@ -564,6 +645,55 @@ func (qre *QueryExecutor) verifyRowCount(count, maxrows int64) error {
 	return nil
 }
 
+// checkResultRow holds the bytes of a row of a non-streaming result against
+// the result memory of the tablet as soon as the row is read, and fails the
+// query once its results exceed the per-query budget or the result memory
+// of the tablet is exhausted. The bytes are released by releaseResult.
+func (qre *QueryExecutor) checkResultRow(row []sqltypes.Value) error {
+	rm := qre.tsv.qe.resultMemory
+	var bytes int64
+	for _, v := range row {
+		bytes += int64(v.Len())
+	}
+	if max := rm.maxQueryBytes.Get(); max > 0 && qre.resultBytes+bytes > max {
+		callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
+		rm.rejections.Add("Query", 1)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "caller id: %s: result size exceeded %d bytes", callerID.GetUsername(), max)
+	}
+	if !rm.reserve(bytes) {
+		callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "caller id: %s: result rejected after %d bytes: tablet result memory of %d bytes exhausted", callerID.GetUsername(), qre.resultBytes, rm.maxTabletBytes.Get())
+	}
+	qre.resultBytes += bytes
+	return nil
+}
+
+// warnResultBytes logs the query if its results exceed the warning
+// threshold of the result bytes.
+func (qre *QueryExecutor) warnResultBytes() {
+	warnThreshold := qre.tsv.qe.resultMemory.warnQueryBytes.Get()
+	if warnThreshold > 0 && qre.resultBytes > warnThreshold {
+		callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
+		qre.tsv.Stats().Warnings.Add("ResultBytesExceeded", 1)
+		log.Warningf("caller id: %s result size %v bytes exceeds warning threshold %v: %q", callerID.GetUsername(), qre.resultBytes, warnThreshold, queryAsString(qre.plan.FullQuery.Query, qre.bindVars))
+	}
+}
+
+// releaseResult releases the bytes held by checkResultRow. If the result
+// is the reply of a gRPC call, they're held until the reply has been sent.
+func (qre *QueryExecutor) releaseResult(err error) {
+	bytes := qre.resultBytes
+	qre.resultBytes = 0
+	if bytes == 0 {
+		return
+	}
+	rm := qre.tsv.qe.resultMemory
+	if err == nil && servenv.ReleaseAfterReply(qre.ctx, func() { rm.release(bytes) }) {
+		return
+	}
+	rm.release(bytes)
+}
+
 func (qre *QueryExecutor) execOther() (*sqltypes.Result, error) {
 	conn, err := qre.getConn()
 	if err != nil {
@@ -792,7 +922,7 @@ func (qre *QueryExecutor) execDBConn(conn *connpool.DBConn, sql string, wantfiel
 	qre.tsv.statelessql.Add(qd)
 	defer qre.tsv.statelessql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	return conn.ExecWithRowCheck(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields, qre.checkResultRow)
 }
 
 func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string, wantfields bool) (*sqltypes.Result, error) {
@@ -805,14 +935,26 @@ func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string,
 	qre.tsv.statefulql.Add(qd)
 	defer qre.tsv.statefulql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	return conn.ExecWithRowCheck(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields, qre.checkResultRow)
 }
 
 func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callback func(*sqltypes.Result) error) error {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.execStreamSQL")
 	trace.AnnotateSQL(span, sql)
+	// Every chunk is held against the result memory of the tablet
+	// while it's sent.
+	rm := qre.tsv.qe.resultMemory
+	var bytes, rows int64
 	callBackClosingSpan := func(result *sqltypes.Result) error {
 		defer span.Finish()
+		n := resultBytes(result)
+		if !rm.reserve(n) {
+			callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
+			return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "caller id: %s: result of %d bytes rejected: tablet result memory of %d bytes exhausted", callerID.GetUsername(), n, rm.maxTabletBytes.Get())
+		}
+		defer rm.release(n)
+		bytes += n
+		rows += int64(len(result.Rows))
 		return callback(result)
 	}
 
@@ -823,6 +965,7 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 	start := time.Now()
 	err := conn.Stream(ctx, sql, callBackClosingSpan, int(qre.tsv.qe.streamBufferSize.Get()), sqltypes.IncludeFieldsOrDefault(qre.options))
 	qre.logStats.AddRewrittenSQL(sql, start)
+	rm.record(qre.plan, qre.statsTableName(), qre.plan.PlanID.String(), bytes, rows)
 	if err != nil {
 		// MySQL error that isn't due to a connection issue
 		return err
@@ -830,6 +973,15 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 	return nil
 }
 
+// statsTableName returns the name of the table of the query in the stats.
+func (qre *QueryExecutor) statsTableName() string {
+	tableName := qre.plan.TableName().String()
+	if tableName == "" {
+		return "Join"
+	}
+	return tableName
+}
+
 func (qre *QueryExecutor) recordUserQuery(queryType string, duration int64) {
 	username := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(qre.ctx))
 	if username == "" {
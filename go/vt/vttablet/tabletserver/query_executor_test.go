@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -532,6 +533,29 @@ func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestQueryExecutorRequeueMessages(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQueryPattern("insert into msg\\(priority, time_next, id, message\\) select priority, [0-9]+, id, message from msg_dead where id = 1", &sqltypes.Result{RowsAffected: 1})
+	db.AddQuery("delete from msg_dead where id = 1", &sqltypes.Result{RowsAffected: 1})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	qre := newTestQueryExecutor(ctx, tsv, "alter vitess_dead_letters msg requeue where id = 1", 0)
+	assert.Equal(t, planbuilder.PlanRequeueMessages, qre.plan.PlanID)
+	db.ResetQueryLog()
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, &sqltypes.Result{RowsAffected: 1}, got)
+	// The insert and the delete are in the same transaction.
+	log := strings.Split(db.QueryLog(), ";")
+	require.Len(t, log, 4)
+	assert.Equal(t, "begin", log[0])
+	assert.Equal(t, "delete from msg_dead where id = 1", log[2])
+	assert.Equal(t, "commit", log[3])
+}
+
 func TestQueryExecutorPlanNextval(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -694,7 +718,7 @@ func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	}
 
 	// Should not fail because u1 has permission.
-	err = qre.MessageStream(func(qr *sqltypes.Result) error {
+	err = qre.MessageStream("", func(qr *sqltypes.Result) error {
 		return io.EOF
 	})
 	if err != nil {
@@ -706,7 +730,7 @@ func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	}
 	qre.ctx = callerid.NewContext(context.Background(), nil, callerID)
 	// Should fail because u2 does not have permission.
-	err = qre.MessageStream(func(qr *sqltypes.Result) error {
+	err = qre.MessageStream("", func(qr *sqltypes.Result) error {
 		return io.EOF
 	})
 
@@ -1108,6 +1132,82 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorRuleLimits(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+
+	throttleRule := rules.NewQueryRule("throttle select", "throttle_select", rules.QRThrottle)
+	throttleRule.SetQueryCond("select.*")
+	throttleRule.SetMaxQPS(1)
+	concurrencyRule := rules.NewQueryRule("limit insert", "limit_insert", rules.QRConcurrency)
+	concurrencyRule.SetQueryCond("insert.*")
+	concurrencyRule.SetMaxConcurrency(1)
+
+	rulesName := "limitRules"
+	qrs := rules.New()
+	qrs.Add(throttleRule)
+	qrs.Add(concurrencyRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+	matches := tsv.stats.QueryRuleMatches.Counts()["throttle_select"]
+	rejections := tsv.stats.QueryRuleRejections.Counts()["throttle_select"]
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualError(t, err, "throttled due to rule: throttle select")
+	assert.Equal(t, matches+2, tsv.stats.QueryRuleMatches.Counts()["throttle_select"])
+	assert.Equal(t, rejections+1, tsv.stats.QueryRuleRejections.Counts()["throttle_select"])
+
+	// The concurrency slot is given back once the query is done.
+	insertQuery := "insert into test_table(pk, name, addr) values (1, 'a', 'b')"
+	qre := newTestQueryExecutor(ctx, tsv, insertQuery, 0)
+	require.NoError(t, qre.checkPermissions())
+	err = newTestQueryExecutor(ctx, tsv, insertQuery, 0).checkPermissions()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	qre.releaseRule()
+	require.NoError(t, newTestQueryExecutor(ctx, tsv, insertQuery, 0).checkPermissions())
+}
+
+func TestQueryExecutorRuleDelay(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+
+	delayRule := rules.NewQueryRule("delay select", "delay_select", rules.QRDelay)
+	delayRule.SetDelay(50 * time.Millisecond)
+
+	rulesName := "delayRules"
+	qrs := rules.New()
+	qrs.Add(delayRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+	start := time.Now()
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(50*time.Millisecond))
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = newTestQueryExecutor(cancelledCtx, tsv, query, 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_CANCELED, vterrors.Code(err))
+}
+
 type executorFlags int64
 
 const (
@@ -1200,7 +1300,7 @@ func initQueryExecutorTestDB(db *fakesqldb.DB) {
 		Rows: [][]sqltypes.Value{
 			mysql.BaseShowTablesRow("test_table", false, ""),
 			mysql.BaseShowTablesRow("seq", false, "vitess_sequence"),
-			mysql.BaseShowTablesRow("msg", false, "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30"),
+			mysql.BaseShowTablesRow("msg", false, "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_retries=3,vt_dead_letter_table=msg_dead"),
 		},
 	})
 	db.AddQuery("show status like 'Innodb_rows_read'", sqltypes.MakeTestResult(sqltypes.MakeTestFields(
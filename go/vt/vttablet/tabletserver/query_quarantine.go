@@ -0,0 +1,357 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// queryQuarantineSource is the query rule source of the quarantined
+// query fingerprints.
+const queryQuarantineSource = "QUERY_QUARANTINE"
+
+// sleepDuringQuarantineWatchFailure is how long to sleep before retrying
+// a failed watch of the query quarantine.
+// (it's a var not a const so the test can change the value).
+var sleepDuringQuarantineWatchFailure = 30 * time.Second
+
+// queryQuarantine checks the resource usage of the query fingerprints
+// at every interval, and quarantines the ones that exceed the thresholds:
+// it adds them to the query quarantine of the shard in the topo. All the
+// tablets of the shard watch it, and reject the queries of the quarantined
+// fingerprints until their quarantine expires.
+type queryQuarantine struct {
+	env    tabletenv.Env
+	qe     *QueryEngine
+	config tabletenv.QueryQuarantineConfig
+	ticks  *timer.Timer
+
+	// Set by InitDBConfig.
+	ts       *topo.Server
+	keyspace string
+	shard    string
+
+	// mu protects the following fields.
+	mu sync.Mutex
+	// cancel stops the watch of the topo, if any.
+	cancel context.CancelFunc
+	// usages are the usages of the plans at the last check, by query.
+	usages map[string]*queryUsage
+	// quarantine is the query quarantine of the shard.
+	quarantine map[string]*topo.QuarantinedQuery
+	// rules are the query rules enforcing the quarantine, one per
+	// quarantined fingerprint.
+	rules      *rules.Rules
+	rulesCount int
+
+	triggers *stats.CountersWithSingleLabel
+}
+
+// queryUsage is the resource usage of a plan, or of a query fingerprint.
+type queryUsage struct {
+	plan        *TabletPlan
+	fingerprint string
+	query       string
+	time        time.Duration
+	rows        uint64
+	errors      uint64
+}
+
+func newQueryQuarantine(env tabletenv.Env, qe *QueryEngine) *queryQuarantine {
+	qq := &queryQuarantine{
+		env:    env,
+		qe:     qe,
+		config: env.Config().QueryQuarantine,
+		ticks:  timer.NewTimer(env.Config().QueryQuarantine.CheckIntervalSeconds.Get()),
+		rules:  rules.New(),
+	}
+	qq.triggers = env.Exporter().NewCountersWithSingleLabel("QueryQuarantineTriggers", "Query fingerprints that exceeded a query quarantine threshold", "Threshold", "Time", "Rows", "Errors")
+	env.Exporter().NewGaugeFunc("QueryQuarantined", "Query fingerprints quarantined in the shard", qq.quarantinedCount)
+	if qq.config.Mode == tabletenv.Enable {
+		qe.queryRuleSources.RegisterSource(queryQuarantineSource)
+	}
+	return qq
+}
+
+// InitDBConfig sets the shard whose query quarantine is managed.
+func (qq *queryQuarantine) InitDBConfig(ts *topo.Server, keyspace, shard string) {
+	qq.ts = ts
+	qq.keyspace = keyspace
+	qq.shard = shard
+}
+
+// Open starts the checks and the watch of the query quarantine.
+func (qq *queryQuarantine) Open() {
+	if (qq.config.Mode != tabletenv.Enable && qq.config.Mode != tabletenv.Dryrun) || qq.ts == nil {
+		return
+	}
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	if qq.cancel != nil {
+		return
+	}
+	qq.usages = make(map[string]*queryUsage)
+	ctx, cancel := context.WithCancel(context.Background())
+	qq.cancel = cancel
+	if qq.config.Mode == tabletenv.Enable {
+		go qq.watch(ctx)
+	}
+	qq.ticks.Start(qq.check)
+}
+
+// Close stops the checks and the watch of the query quarantine.
+func (qq *queryQuarantine) Close() {
+	qq.mu.Lock()
+	if qq.cancel == nil {
+		qq.mu.Unlock()
+		return
+	}
+	qq.cancel()
+	qq.cancel = nil
+	qq.mu.Unlock()
+	// Stop waits for a check in progress, which needs mu.
+	qq.ticks.Stop()
+}
+
+// check quarantines the query fingerprints whose resource usage since
+// the last check exceeds a threshold, and expires the old quarantines.
+func (qq *queryQuarantine) check() {
+	now := time.Now()
+	newQuarantine := make(map[string]*topo.QuarantinedQuery)
+	for fingerprint, usage := range qq.collect() {
+		threshold, reason := qq.exceeded(usage)
+		if threshold == "" || qq.isQuarantined(fingerprint, now) {
+			continue
+		}
+		qq.triggers.Add(threshold, 1)
+		if qq.config.Mode != tabletenv.Enable {
+			log.Infof("Query fingerprint %s would have been quarantined, %s: %s", fingerprint, reason, usage.query)
+			continue
+		}
+		log.Infof("Quarantining query fingerprint %s, %s: %s", fingerprint, reason, usage.query)
+		newQuarantine[fingerprint] = &topo.QuarantinedQuery{
+			Query:      usage.query,
+			Reason:     reason,
+			ExpireTime: now.Add(qq.config.DurationSeconds.Get()),
+		}
+	}
+	if qq.config.Mode != tabletenv.Enable {
+		return
+	}
+
+	if len(newQuarantine) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+		defer cancel()
+		err := qq.ts.UpdateQueryQuarantine(ctx, qq.keyspace, qq.shard, func(quarantine map[string]*topo.QuarantinedQuery) error {
+			for fingerprint, quarantined := range quarantine {
+				if !now.Before(quarantined.ExpireTime) {
+					delete(quarantine, fingerprint)
+				}
+			}
+			for fingerprint, quarantined := range newQuarantine {
+				if _, ok := quarantine[fingerprint]; !ok {
+					quarantine[fingerprint] = quarantined
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Warningf("Could not publish the query quarantine in the topo: %v", err)
+		}
+	}
+
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	if qq.quarantine == nil {
+		qq.quarantine = make(map[string]*topo.QuarantinedQuery)
+	}
+	// The quarantine is enforced on this tablet without waiting for the watch.
+	for fingerprint, quarantined := range newQuarantine {
+		if _, ok := qq.quarantine[fingerprint]; !ok {
+			qq.quarantine[fingerprint] = quarantined
+		}
+	}
+	qq.applyRulesLocked(now)
+}
+
+// collect returns the resource usage of the query fingerprints since the
+// last check. The usage of the plans that were added to the plan cache
+// since then is counted from the time they were added.
+func (qq *queryQuarantine) collect() map[string]*queryUsage {
+	var plans []*TabletPlan
+	qq.qe.plans.ForEach(func(value interface{}) bool {
+		if plan := value.(*TabletPlan); plan.Original != "" {
+			plans = append(plans, plan)
+		}
+		return true
+	})
+
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	usages := make(map[string]*queryUsage, len(plans))
+	fingerprints := make(map[string]*queryUsage)
+	for _, plan := range plans {
+		_, duration, _, rowsAffected, rowsReturned, errorCount := plan.Stats()
+		usage := &queryUsage{
+			plan:   plan,
+			query:  plan.Original,
+			time:   duration,
+			rows:   rowsAffected + rowsReturned,
+			errors: errorCount,
+		}
+		delta := *usage
+		if last := qq.usages[plan.Original]; last != nil {
+			usage.fingerprint = last.fingerprint
+			if last.plan == plan {
+				delta.time -= last.time
+				delta.rows -= last.rows
+				delta.errors -= last.errors
+			}
+		} else {
+			usage.fingerprint = rules.Fingerprint(plan.Original)
+		}
+		usages[plan.Original] = usage
+
+		sum := fingerprints[usage.fingerprint]
+		if sum == nil {
+			sum = &queryUsage{query: sqlparser.TruncateForLog(plan.Original)}
+			fingerprints[usage.fingerprint] = sum
+		}
+		sum.time += delta.time
+		sum.rows += delta.rows
+		sum.errors += delta.errors
+	}
+	qq.usages = usages
+	return fingerprints
+}
+
+// exceeded returns the threshold the usage exceeded, if any, and why.
+func (qq *queryQuarantine) exceeded(usage *queryUsage) (threshold, reason string) {
+	interval := qq.config.CheckIntervalSeconds.Get()
+	if maxTime := qq.config.MaxTimeSeconds.Get(); maxTime > 0 && usage.time > maxTime {
+		return "Time", fmt.Sprintf("query time %v exceeded %v in %v", usage.time, maxTime, interval)
+	}
+	if maxRows := qq.config.MaxRows; maxRows > 0 && usage.rows > uint64(maxRows) {
+		return "Rows", fmt.Sprintf("%d rows exceeded %d in %v", usage.rows, maxRows, interval)
+	}
+	if maxErrors := qq.config.MaxErrors; maxErrors > 0 && usage.errors > uint64(maxErrors) {
+		return "Errors", fmt.Sprintf("%d errors exceeded %d in %v", usage.errors, maxErrors, interval)
+	}
+	return "", ""
+}
+
+func (qq *queryQuarantine) isQuarantined(fingerprint string, now time.Time) bool {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	quarantined, ok := qq.quarantine[fingerprint]
+	return ok && now.Before(quarantined.ExpireTime)
+}
+
+func (qq *queryQuarantine) quarantinedCount() int64 {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	return int64(qq.rulesCount)
+}
+
+// watch watches the query quarantine of the shard in the topo until ctx
+// is done.
+func (qq *queryQuarantine) watch(ctx context.Context) {
+	for {
+		current, changes, cancel := qq.ts.WatchQueryQuarantine(ctx, qq.keyspace, qq.shard)
+		if current.Err != nil {
+			if topo.IsErrType(current.Err, topo.NoNode) {
+				// No query is quarantined.
+				qq.setQuarantine(nil)
+			} else {
+				log.Warningf("Watch of the query quarantine in the topo failed: %v", current.Err)
+			}
+		} else {
+			qq.setQuarantine(current.Value)
+			for wd := range changes {
+				if wd.Err != nil {
+					if topo.IsErrType(wd.Err, topo.NoNode) {
+						qq.setQuarantine(nil)
+					} else if !topo.IsErrType(wd.Err, topo.Interrupted) {
+						log.Warningf("Watch of the query quarantine in the topo failed: %v", wd.Err)
+					}
+					break
+				}
+				qq.setQuarantine(wd.Value)
+			}
+			cancel()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(sleepDuringQuarantineWatchFailure):
+		}
+	}
+}
+
+func (qq *queryQuarantine) setQuarantine(quarantine map[string]*topo.QuarantinedQuery) {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	qq.quarantine = quarantine
+	qq.applyRulesLocked(time.Now())
+}
+
+// applyRulesLocked makes the query rules reject the queries of the
+// fingerprints whose quarantine has not expired yet.
+func (qq *queryQuarantine) applyRulesLocked(now time.Time) {
+	var fingerprints []string
+	for fingerprint, quarantined := range qq.quarantine {
+		if now.Before(quarantined.ExpireTime) {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+	}
+	sort.Strings(fingerprints)
+
+	qrs := rules.New()
+	for _, fingerprint := range fingerprints {
+		quarantined := qq.quarantine[fingerprint]
+		qr := rules.NewQueryRule(
+			fmt.Sprintf("quarantined until %s, %s", quarantined.ExpireTime.Format(time.RFC3339), quarantined.Reason),
+			"quarantine_"+fingerprint,
+			rules.QRFail)
+		qr.AddFingerprintCond(fingerprint)
+		qrs.Add(qr)
+	}
+	if qrs.Equal(qq.rules) {
+		return
+	}
+	if err := qq.qe.queryRuleSources.SetRules(queryQuarantineSource, qrs); err != nil {
+		log.Errorf("Could not apply the query quarantine: %v", err)
+		return
+	}
+	qq.qe.ClearQueryPlanCache()
+	qq.rules = qrs
+	qq.rulesCount = len(fingerprints)
+	log.Infof("Applied the query quarantine, %d query fingerprints quarantined", len(fingerprints))
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func newQuarantineTabletServer(t *testing.T, db *fakesqldb.DB, ts *topo.Server, mode string) *TabletServer {
+	t.Helper()
+	config := tabletenv.NewDefaultConfig()
+	config.QueryQuarantine.Mode = mode
+	// The checks are triggered by the tests.
+	config.QueryQuarantine.CheckIntervalSeconds = 3600
+	config.QueryQuarantine.MaxRows = 1
+	tsv := NewTabletServer("TabletServerTest", config, ts, topodatapb.TabletAlias{})
+	target := querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_MASTER}
+	require.NoError(t, tsv.StartService(target, newDBConfigs(db), nil /* mysqld */))
+	return tsv
+}
+
+func TestQueryQuarantine(t *testing.T) {
+	defer func(d time.Duration) { sleepDuringQuarantineWatchFailure = d }(sleepDuringQuarantineWatchFailure)
+	sleepDuringQuarantineWatchFailure = 10 * time.Millisecond
+
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(1), sqltypes.NewInt32(1)},
+			{sqltypes.NewInt32(2), sqltypes.NewInt32(2), sqltypes.NewInt32(2)},
+		},
+	})
+	ts := memorytopo.NewServer("cell1")
+	tsv := newQuarantineTabletServer(t, db, ts, tabletenv.Enable)
+	defer tsv.StopService()
+	ctx := context.Background()
+	qq := tsv.qe.quarantine
+
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	triggers := qq.triggers.Counts()["Rows"]
+	qq.check()
+	assert.Equal(t, triggers+1, qq.triggers.Counts()["Rows"])
+
+	// The quarantine is published for the other tablets of the shard.
+	fingerprint := rules.Fingerprint(query)
+	quarantine, err := ts.GetQueryQuarantine(ctx, "ks", "0")
+	require.NoError(t, err)
+	require.Contains(t, quarantine, fingerprint)
+	assert.Equal(t, query, quarantine[fingerprint].Query)
+	assert.Equal(t, "2 rows exceeded 1 in 1h0m0s", quarantine[fingerprint].Reason)
+
+	// The queries with the same fingerprint are rejected.
+	_, err = newTestQueryExecutor(ctx, tsv, "select * from test_table limit 1001", 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "disallowed due to rule: quarantined until ")
+	assert.Equal(t, int64(1), qq.quarantinedCount())
+
+	// An already quarantined fingerprint is not quarantined again.
+	qq.check()
+	assert.Equal(t, triggers+1, qq.triggers.Counts()["Rows"])
+
+	// Lifting the quarantine in the topo lifts it on the tablets.
+	require.NoError(t, ts.UpdateQueryQuarantine(ctx, "ks", "0", func(quarantine map[string]*topo.QuarantinedQuery) error {
+		delete(quarantine, fingerprint)
+		return nil
+	}))
+	for i := 0; qq.quarantinedCount() != 0; i++ {
+		require.Less(t, i, 100, "the quarantine was not lifted")
+		time.Sleep(10 * time.Millisecond)
+	}
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+}
+
+func TestQueryQuarantineExpiry(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	fingerprint := rules.Fingerprint(query)
+	require.NoError(t, ts.UpdateQueryQuarantine(ctx, "ks", "0", func(quarantine map[string]*topo.QuarantinedQuery) error {
+		quarantine[fingerprint] = &topo.QuarantinedQuery{Query: query, ExpireTime: time.Now().Add(500 * time.Millisecond)}
+		quarantine["expired"] = &topo.QuarantinedQuery{ExpireTime: time.Now().Add(-time.Hour)}
+		return nil
+	}))
+	tsv := newQuarantineTabletServer(t, db, ts, tabletenv.Enable)
+	defer tsv.StopService()
+	qq := tsv.qe.quarantine
+
+	for i := 0; qq.quarantinedCount() != 1; i++ {
+		require.Less(t, i, 100, "the quarantine was not loaded")
+		time.Sleep(10 * time.Millisecond)
+	}
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+
+	time.Sleep(500 * time.Millisecond)
+	qq.check()
+	assert.Equal(t, int64(0), qq.quarantinedCount())
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+}
+
+func TestQueryQuarantineDryRun(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(1), sqltypes.NewInt32(1)},
+			{sqltypes.NewInt32(2), sqltypes.NewInt32(2), sqltypes.NewInt32(2)},
+		},
+	})
+	ts := memorytopo.NewServer("cell1")
+	tsv := newQuarantineTabletServer(t, db, ts, tabletenv.Dryrun)
+	defer tsv.StopService()
+	ctx := context.Background()
+	qq := tsv.qe.quarantine
+
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	triggers := qq.triggers.Counts()["Rows"]
+	qq.check()
+	assert.Equal(t, triggers+1, qq.triggers.Counts()["Rows"])
+
+	quarantine, err := ts.GetQueryQuarantine(ctx, "ks", "0")
+	require.NoError(t, err)
+	assert.Empty(t, quarantine)
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+
+	// Only the usage since the last check counts.
+	qq.check()
+	assert.Equal(t, triggers+2, qq.triggers.Counts()["Rows"])
+	qq.check()
+	assert.Equal(t, triggers+2, qq.triggers.Counts()["Rows"])
+}
+
+func TestQueryQuarantineCollect(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	tsv := newTestTabletServer(context.Background(), noFlags, db)
+	defer tsv.StopService()
+	qq := tsv.qe.quarantine
+	qq.usages = make(map[string]*queryUsage)
+
+	plan1 := &TabletPlan{Original: "select a from t where id = 1"}
+	plan1.AddStats(1, time.Second, 0, 0, 10, 0)
+	plan2 := &TabletPlan{Original: "select a from t where id = 2"}
+	plan2.AddStats(1, time.Second, 0, 0, 5, 1)
+	tsv.qe.plans.Set(plan1.Original, plan1)
+	tsv.qe.plans.Set(plan2.Original, plan2)
+	tsv.qe.plans.Wait()
+
+	usages := qq.collect()
+	require.Len(t, usages, 1)
+	for fingerprint, usage := range usages {
+		assert.Equal(t, rules.Fingerprint("select a from t where id = :id"), fingerprint)
+		assert.True(t, strings.HasPrefix(usage.query, "select a from t where id = "))
+		assert.Equal(t, 2*time.Second, usage.time)
+		assert.Equal(t, uint64(15), usage.rows)
+		assert.Equal(t, uint64(1), usage.errors)
+	}
+
+	plan1.AddStats(1, time.Second, 0, 0, 1, 0)
+	usages = qq.collect()
+	for _, usage := range usages {
+		assert.Equal(t, time.Second, usage.time)
+		assert.Equal(t, uint64(1), usage.rows)
+		assert.Equal(t, uint64(0), usage.errors)
+	}
+}
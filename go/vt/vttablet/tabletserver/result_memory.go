@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// resultMemory accounts for the bytes of the query results held by the
+// tablet. The rows of the non-streaming queries are held as soon as they're
+// read from MySQL, until their reply has been sent, and the chunks of the
+// streaming queries while they're sent. A query is failed as soon as its
+// result exceeds the per-query budget, or would take the bytes held by all
+// the queries over the per-tablet budget.
+type resultMemory struct {
+	maxQueryBytes  sync2.AtomicInt64
+	warnQueryBytes sync2.AtomicInt64
+	maxTabletBytes sync2.AtomicInt64
+	inUse          sync2.AtomicInt64
+
+	// mu protects largest.
+	mu sync.Mutex
+	// largest are the queries with the largest results, by decreasing
+	// bytes, up to maxLargest. A query is listed once, with its largest
+	// result.
+	largest    []*largeResult
+	maxLargest int
+
+	bytes      *stats.CountersWithMultiLabels
+	rejections *stats.CountersWithSingleLabel
+}
+
+// largeResult is a query with one of the largest results.
+type largeResult struct {
+	Query string
+	Table string
+	Plan  string
+	Bytes int64
+	Rows  int64
+	Time  time.Time
+}
+
+func newResultMemory(env tabletenv.Env) *resultMemory {
+	config := env.Config().ResultMemory
+	rm := &resultMemory{
+		maxQueryBytes:  sync2.NewAtomicInt64(config.MaxQueryBytes),
+		warnQueryBytes: sync2.NewAtomicInt64(config.WarnQueryBytes),
+		maxTabletBytes: sync2.NewAtomicInt64(config.MaxTabletBytes),
+		maxLargest:     config.LargestResults,
+	}
+	env.Exporter().NewGaugeFunc("MaxResultBytes", "Query engine max result bytes", rm.maxQueryBytes.Get)
+	env.Exporter().NewGaugeFunc("WarnResultBytes", "Query engine warn result bytes", rm.warnQueryBytes.Get)
+	env.Exporter().NewGaugeFunc("MaxResultMemory", "Query engine max result memory", rm.maxTabletBytes.Get)
+	env.Exporter().NewGaugeFunc("ResultMemoryInUse", "Bytes of the query results held by the tablet", rm.inUse.Get)
+	rm.bytes = env.Exporter().NewCountersWithMultiLabels("QueryResultBytes", "query result bytes", []string{"Table", "Plan"})
+	rm.rejections = env.Exporter().NewCountersWithSingleLabel("ResultMemoryRejections", "Query results rejected because they exceeded a result memory budget", "Budget", "Query", "Tablet")
+	env.Exporter().HandleFunc("/debug/largest_results", rm.ServeHTTP)
+	return rm
+}
+
+// resultBytes returns the bytes of the row values of a result.
+func resultBytes(qr *sqltypes.Result) int64 {
+	var bytes int64
+	for _, row := range qr.Rows {
+		for _, v := range row {
+			bytes += int64(v.Len())
+		}
+	}
+	return bytes
+}
+
+// reserve holds bytes against the per-tablet budget. It returns false,
+// and holds nothing, if they would exceed it.
+func (rm *resultMemory) reserve(bytes int64) bool {
+	inUse := rm.inUse.Add(bytes)
+	if max := rm.maxTabletBytes.Get(); max > 0 && inUse > max {
+		rm.inUse.Add(-bytes)
+		rm.rejections.Add("Tablet", 1)
+		return false
+	}
+	return true
+}
+
+// release releases bytes held by reserve.
+func (rm *resultMemory) release(bytes int64) {
+	rm.inUse.Add(-bytes)
+}
+
+// record counts the bytes of the result of a query, and keeps the query
+// if its result is among the largest ones.
+func (rm *resultMemory) record(plan *TabletPlan, tableName, planName string, bytes, rows int64) {
+	rm.bytes.Add([]string{tableName, planName}, bytes)
+	if rm.maxLargest <= 0 || bytes == 0 {
+		return
+	}
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	for i, lr := range rm.largest {
+		if lr.Query != plan.Original {
+			continue
+		}
+		if bytes <= lr.Bytes {
+			return
+		}
+		rm.largest = append(rm.largest[:i], rm.largest[i+1:]...)
+		break
+	}
+	if len(rm.largest) == rm.maxLargest && bytes <= rm.largest[len(rm.largest)-1].Bytes {
+		return
+	}
+	i := sort.Search(len(rm.largest), func(i int) bool { return rm.largest[i].Bytes < bytes })
+	rm.largest = append(rm.largest, nil)
+	copy(rm.largest[i+1:], rm.largest[i:])
+	rm.largest[i] = &largeResult{
+		Query: plan.Original,
+		Table: tableName,
+		Plan:  planName,
+		Bytes: bytes,
+		Rows:  rows,
+		Time:  time.Now(),
+	}
+	if len(rm.largest) > rm.maxLargest {
+		rm.largest = rm.largest[:rm.maxLargest]
+	}
+}
+
+// largestResults returns a copy of the queries with the largest results.
+func (rm *resultMemory) largestResults() []largeResult {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	results := make([]largeResult, 0, len(rm.largest))
+	for _, lr := range rm.largest {
+		results = append(results, *lr)
+	}
+	return results
+}
+
+// ServeHTTP lists the queries with the largest results.
+func (rm *resultMemory) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	results := rm.largestResults()
+	for i := range results {
+		if *streamlog.RedactDebugUIQueries {
+			results[i].Query, _ = sqlparser.RedactSQLQuery(results[i].Query)
+		}
+		results[i].Query = unicoded(sqlparser.TruncateForUI(results[i].Query))
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
+	response.Write(b)
+}
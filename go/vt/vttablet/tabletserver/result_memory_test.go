@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestResultMemoryQueryBudget(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(10), sqltypes.NewInt32(100)},
+			{sqltypes.NewInt32(2), sqltypes.NewInt32(20), sqltypes.NewInt32(200)},
+		},
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	rm := tsv.qe.resultMemory
+	bytes := rm.bytes.Counts()["test_table.Select"]
+	rejections := rm.rejections.Counts()["Query"]
+
+	qr, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), resultBytes(qr))
+	assert.Equal(t, bytes+12, rm.bytes.Counts()["test_table.Select"])
+	assert.Zero(t, rm.inUse.Get())
+
+	tsv.SetMaxResultBytes(12)
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+
+	tsv.SetMaxResultBytes(11)
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "result size exceeded 11 bytes")
+	assert.Equal(t, rejections+1, rm.rejections.Counts()["Query"])
+	assert.Zero(t, rm.inUse.Get())
+
+	// Streaming queries are not limited by the per-query budget.
+	err = newTestQueryExecutor(ctx, tsv, query, 0).Stream(func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+}
+
+func TestResultMemoryTabletBudget(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(10), sqltypes.NewInt32(100)},
+		},
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	rm := tsv.qe.resultMemory
+	rejections := rm.rejections.Counts()["Tablet"]
+	tsv.SetMaxResultMemory(10)
+
+	// Another query holds most of the budget.
+	require.True(t, rm.reserve(5))
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "result rejected after 0 bytes: tablet result memory of 10 bytes exhausted")
+	err = newTestQueryExecutor(ctx, tsv, query, 0).Stream(func(*sqltypes.Result) error { return nil })
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Equal(t, rejections+2, rm.rejections.Counts()["Tablet"])
+	assert.Equal(t, int64(5), rm.inUse.Get())
+
+	rm.release(5)
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	var inUse int64
+	err = newTestQueryExecutor(ctx, tsv, query, 0).Stream(func(*sqltypes.Result) error {
+		inUse = rm.inUse.Get()
+		return nil
+	})
+	require.NoError(t, err)
+	// The chunks are held while they're sent.
+	assert.Equal(t, int64(6), inUse)
+	assert.Zero(t, rm.inUse.Get())
+
+	// The rows are held as they're read: the query fails at the first
+	// row that doesn't fit, and releases the rows it read.
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(10), sqltypes.NewInt32(100)},
+			{sqltypes.NewInt32(2), sqltypes.NewInt32(20), sqltypes.NewInt32(200)},
+		},
+	})
+	tsv.SetMaxResultMemory(16)
+	require.True(t, rm.reserve(5))
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "result rejected after 6 bytes: tablet result memory of 16 bytes exhausted")
+	assert.Equal(t, int64(5), rm.inUse.Get())
+	rm.release(5)
+}
+
+func TestResultMemoryLargest(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	tsv := newTestTabletServer(context.Background(), noFlags, db)
+	defer tsv.StopService()
+	rm := tsv.qe.resultMemory
+	rm.maxLargest = 3
+	bytes := rm.bytes.Counts()["t.Select"]
+
+	plans := make([]*TabletPlan, 5)
+	for i := range plans {
+		plans[i] = &TabletPlan{Original: fmt.Sprintf("select * from t where id = %d", i)}
+	}
+	rm.record(plans[0], "t", "Select", 10, 1)
+	rm.record(plans[1], "t", "Select", 30, 3)
+	rm.record(plans[2], "t", "Select", 20, 2)
+	rm.record(plans[3], "t", "Select", 5, 1)
+	rm.record(plans[4], "t", "Select", 0, 0)
+	// A query is listed once, with its largest result.
+	rm.record(plans[0], "t", "Select", 40, 4)
+	rm.record(plans[1], "t", "Select", 15, 2)
+
+	var got []string
+	for _, lr := range rm.largestResults() {
+		got = append(got, fmt.Sprintf("%s: %d", lr.Query, lr.Bytes))
+	}
+	assert.Equal(t, []string{
+		"select * from t where id = 0: 40",
+		"select * from t where id = 1: 30",
+		"select * from t where id = 2: 20",
+	}, got)
+	assert.Equal(t, bytes+120, rm.bytes.Counts()["t.Select"])
+}
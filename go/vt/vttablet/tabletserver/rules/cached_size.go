@@ -43,7 +43,7 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(184)
+		size += int64(240)
 	}
 	// field Description string
 	size += int64(len(cached.Description))
@@ -66,6 +66,13 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 			size += int64(len(elem))
 		}
 	}
+	// field fingerprints []string
+	{
+		size += int64(cap(cached.fingerprints)) * int64(16)
+		for _, elem := range cached.fingerprints {
+			size += int64(len(elem))
+		}
+	}
 	// field bindVarConds []vitess.io/vitess/go/vt/vttablet/tabletserver/rules.BindVarCond
 	{
 		size += int64(cap(cached.bindVarConds)) * int64(48)
@@ -73,6 +80,8 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
+	// field limits *vitess.io/vitess/go/vt/vttablet/tabletserver/rules.ruleLimits
+	size += cached.limits.CachedSize(true)
 	return size
 }
 func (cached *Rules) CachedSize(alloc bool) int64 {
@@ -122,3 +131,17 @@ func (cached *namedRegexp) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ruleLimits) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field rateLimiter *vitess.io/vitess/go/ratelimiter.RateLimiter
+	if cached.rateLimiter != nil {
+		size += int64(56)
+	}
+	return size
+}
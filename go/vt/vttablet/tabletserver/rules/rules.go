@@ -18,15 +18,21 @@ package rules
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
+	"vitess.io/vitess/go/ratelimiter"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 
@@ -154,11 +160,19 @@ func (qrs *Rules) MarshalJSON() ([]byte, error) {
 
 // FilterByPlan creates a new Rules by prefiltering on the query and planId. This allows
 // us to create query plan specific Rules out of the original Rules. In the new rules,
-// query, plans and tableNames predicates are empty.
+// query, plans, tableNames and fingerprints predicates are empty.
 func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableName string) (newqrs *Rules) {
+	// The fingerprint is only computed if a rule needs it.
+	fingerprint := ""
+	getFingerprint := func() string {
+		if fingerprint == "" {
+			fingerprint = Fingerprint(query)
+		}
+		return fingerprint
+	}
 	var newrules []*Rule
 	for _, qr := range qrs.rules {
-		if newrule := qr.FilterByPlan(query, planid, tableName); newrule != nil {
+		if newrule := qr.filterByPlan(query, planid, tableName, getFingerprint); newrule != nil {
 			newrules = append(newrules, newrule)
 		}
 	}
@@ -167,12 +181,22 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
 func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, desc string) {
+	if qr := qrs.GetMatch(ip, user, bindVars); qr != nil {
+		return qr.act, qr.Description
+	}
+	return QRContinue, ""
+}
+
+// GetMatch returns the first rule whose action is triggered by the input,
+// or nil if there is none. Unlike GetAction, it gives access to the limits
+// of the THROTTLE, CONCURRENCY and DELAY actions.
+func (qrs *Rules) GetMatch(ip, user string, bindVars map[string]*querypb.BindVariable) *Rule {
 	for _, qr := range qrs.rules {
 		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
-			return act, qr.Description
+			return qr
 		}
 	}
-	return QRContinue, ""
+	return nil
 }
 
 //-----------------------------------------------
@@ -200,11 +224,37 @@ type Rule struct {
 	// Any matched tableNames will make this condition true (OR)
 	tableNames []string
 
+	// Any matched query fingerprint will make this condition true (OR)
+	fingerprints []string
+
 	// All BindVar conditions have to be fulfilled to make this true (AND)
 	bindVarConds []BindVarCond
 
 	// Action to be performed on trigger
 	act Action
+
+	// Parameters of the THROTTLE, CONCURRENCY and DELAY actions.
+	maxQPS, maxConcurrency int
+	delay                  time.Duration
+
+	// limits is shared by all the copies of the rule, so that the
+	// THROTTLE and CONCURRENCY actions apply across the query plans
+	// the rule was filtered into.
+	limits *ruleLimits
+}
+
+// ruleLimits holds the state of the THROTTLE and CONCURRENCY actions.
+type ruleLimits struct {
+	rateLimiter *ratelimiter.RateLimiter
+	running     sync2.AtomicInt64
+}
+
+func newRuleLimits(maxQPS int) *ruleLimits {
+	limits := &ruleLimits{}
+	if maxQPS > 0 {
+		limits.rateLimiter = ratelimiter.NewRateLimiter(maxQPS, time.Second)
+	}
+	return limits
 }
 
 type namedRegexp struct {
@@ -228,7 +278,7 @@ func (nr namedRegexp) Equal(other namedRegexp) bool {
 // NewQueryRule creates a new Rule.
 func NewQueryRule(description, name string, act Action) (qr *Rule) {
 	// We ignore act because there's only one action right now
-	return &Rule{Description: description, Name: name, act: act}
+	return &Rule{Description: description, Name: name, act: act, limits: newRuleLimits(0)}
 }
 
 // Equal returns true if other is equal to this Rule, otherwise false.
@@ -243,19 +293,28 @@ func (qr *Rule) Equal(other *Rule) bool {
 		qr.query.Equal(other.query) &&
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
+		reflect.DeepEqual(qr.fingerprints, other.fingerprints) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.maxQPS == other.maxQPS &&
+		qr.maxConcurrency == other.maxConcurrency &&
+		qr.delay == other.delay)
 }
 
 // Copy performs a deep copy of a Rule.
+// The copy shares the rate and concurrency limits of the original.
 func (qr *Rule) Copy() (newqr *Rule) {
 	newqr = &Rule{
-		Description: qr.Description,
-		Name:        qr.Name,
-		requestIP:   qr.requestIP,
-		user:        qr.user,
-		query:       qr.query,
-		act:         qr.act,
+		Description:    qr.Description,
+		Name:           qr.Name,
+		requestIP:      qr.requestIP,
+		user:           qr.user,
+		query:          qr.query,
+		act:            qr.act,
+		maxQPS:         qr.maxQPS,
+		maxConcurrency: qr.maxConcurrency,
+		delay:          qr.delay,
+		limits:         qr.limits,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -265,6 +324,10 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		newqr.tableNames = make([]string, len(qr.tableNames))
 		copy(newqr.tableNames, qr.tableNames)
 	}
+	if qr.fingerprints != nil {
+		newqr.fingerprints = make([]string, len(qr.fingerprints))
+		copy(newqr.fingerprints, qr.fingerprints)
+	}
 	if qr.bindVarConds != nil {
 		newqr.bindVarConds = make([]BindVarCond, len(qr.bindVarConds))
 		copy(newqr.bindVarConds, qr.bindVarConds)
@@ -292,12 +355,24 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.tableNames != nil {
 		safeEncode(b, `,"TableNames":`, qr.tableNames)
 	}
+	if qr.fingerprints != nil {
+		safeEncode(b, `,"Fingerprints":`, qr.fingerprints)
+	}
 	if qr.bindVarConds != nil {
 		safeEncode(b, `,"BindVarConds":`, qr.bindVarConds)
 	}
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.maxQPS != 0 {
+		safeEncode(b, `,"MaxQPS":`, qr.maxQPS)
+	}
+	if qr.maxConcurrency != 0 {
+		safeEncode(b, `,"MaxConcurrency":`, qr.maxConcurrency)
+	}
+	if qr.delay != 0 {
+		safeEncode(b, `,"Delay":`, qr.delay.String())
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -332,6 +407,13 @@ func (qr *Rule) AddTableCond(tableName string) {
 	qr.tableNames = append(qr.tableNames, tableName)
 }
 
+// AddFingerprintCond adds to the list of query fingerprints that can be
+// matched for the rule to fire. The fingerprints are computed by Fingerprint.
+// This function acts as an OR: Any fingerprint match is considered a match.
+func (qr *Rule) AddFingerprintCond(fingerprint string) {
+	qr.fingerprints = append(qr.fingerprints, fingerprint)
+}
+
 // SetQueryCond adds a regular expression condition for the query.
 func (qr *Rule) SetQueryCond(pattern string) (err error) {
 	qr.query.name = pattern
@@ -407,6 +489,10 @@ Error:
 // than the plan and query. If the plan and query don't match the Rule,
 // then it returns nil.
 func (qr *Rule) FilterByPlan(query string, planid planbuilder.PlanType, tableName string) (newqr *Rule) {
+	return qr.filterByPlan(query, planid, tableName, func() string { return Fingerprint(query) })
+}
+
+func (qr *Rule) filterByPlan(query string, planid planbuilder.PlanType, tableName string, fingerprint func() string) (newqr *Rule) {
 	if !reMatch(qr.query.Regexp, query) {
 		return nil
 	}
@@ -416,13 +502,71 @@ func (qr *Rule) FilterByPlan(query string, planid planbuilder.PlanType, tableNam
 	if !tableMatch(qr.tableNames, tableName) {
 		return nil
 	}
+	if qr.fingerprints != nil && !tableMatch(qr.fingerprints, fingerprint()) {
+		return nil
+	}
 	newqr = qr.Copy()
 	newqr.query = namedRegexp{}
 	newqr.plans = nil
 	newqr.tableNames = nil
+	newqr.fingerprints = nil
 	return newqr
 }
 
+// SetMaxQPS sets the rate, in queries per second, above which the
+// THROTTLE action rejects the queries.
+func (qr *Rule) SetMaxQPS(maxQPS int) {
+	qr.maxQPS = maxQPS
+	qr.limits = newRuleLimits(maxQPS)
+}
+
+// SetMaxConcurrency sets the number of concurrent queries above which
+// the CONCURRENCY action rejects the queries.
+func (qr *Rule) SetMaxConcurrency(maxConcurrency int) {
+	qr.maxConcurrency = maxConcurrency
+	qr.limits = newRuleLimits(qr.maxQPS)
+}
+
+// SetDelay sets the time the DELAY action holds the queries for.
+func (qr *Rule) SetDelay(delay time.Duration) {
+	qr.delay = delay
+}
+
+// Action returns the action performed when the rule is triggered.
+func (qr *Rule) Action() Action {
+	return qr.act
+}
+
+// Delay returns the time the DELAY action holds the queries for.
+func (qr *Rule) Delay() time.Duration {
+	return qr.delay
+}
+
+// Acquire returns true if a query triggering the rule is within the limits
+// of its THROTTLE or CONCURRENCY action. A successful Acquire of a
+// CONCURRENCY rule must be followed by a Release once the query is done.
+// Other actions have no limits.
+func (qr *Rule) Acquire() bool {
+	switch qr.act {
+	case QRThrottle:
+		return qr.limits.rateLimiter != nil && qr.limits.rateLimiter.Allow()
+	case QRConcurrency:
+		if qr.limits.running.Add(1) > int64(qr.maxConcurrency) {
+			qr.limits.running.Add(-1)
+			return false
+		}
+	}
+	return true
+}
+
+// Release gives back the slot taken by a successful Acquire of a
+// CONCURRENCY rule.
+func (qr *Rule) Release() {
+	if qr.act == QRConcurrency {
+		qr.limits.running.Add(-1)
+	}
+}
+
 // GetAction returns the action for a single rule.
 func (qr *Rule) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) Action {
 	if !reMatch(qr.requestIP.Regexp, ip) {
@@ -439,6 +583,30 @@ func (qr *Rule) GetAction(ip, user string, bindVars map[string]*querypb.BindVari
 	return qr.act
 }
 
+// Fingerprint returns the fingerprint of a query: a hash of its text once
+// its values are replaced by anonymous bind variables, so that the queries
+// that differ only by their values, or by the names of their bind
+// variables, share the same fingerprint. Queries that cannot be parsed are
+// fingerprinted as they are.
+func Fingerprint(query string) string {
+	if stmt, reserved, err := sqlparser.Parse2(query); err == nil {
+		if err := sqlparser.Normalize(stmt, reserved, map[string]*querypb.BindVariable{}, "fp"); err == nil {
+			_ = sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+				switch cursor.Node().(type) {
+				case sqlparser.Argument:
+					cursor.Replace(sqlparser.Argument(":?"))
+				case sqlparser.ListArg:
+					cursor.Replace(sqlparser.ListArg("::?"))
+				}
+				return true
+			}, nil)
+			query = sqlparser.String(stmt)
+		}
+	}
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:8])
+}
+
 func reMatch(re *regexp.Regexp, val string) bool {
 	return re == nil || re.MatchString(val)
 }
@@ -486,10 +654,19 @@ func bvMatch(bvcond BindVarCond, bindVars map[string]*querypb.BindVariable) bool
 type Action int
 
 // These are actions.
+// QRThrottle rejects the queries above a rate, QRConcurrency rejects
+// them above a number of concurrent queries, and QRDelay holds them
+// for a fixed time before running them. QRFailClusterEvent rejects
+// the queries while a cluster operation, like the cutover of a
+// MoveTables workflow, changes their routing.
 const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRThrottle
+	QRConcurrency
+	QRDelay
+	QRFailClusterEvent
 )
 
 // MarshalJSON marshals to JSON.
@@ -501,6 +678,14 @@ func (act Action) MarshalJSON() ([]byte, error) {
 		str = "FAIL"
 	case QRFailRetry:
 		str = "FAIL_RETRY"
+	case QRThrottle:
+		str = "THROTTLE"
+	case QRConcurrency:
+		str = "CONCURRENCY"
+	case QRDelay:
+		str = "DELAY"
+	case QRFailClusterEvent:
+		str = "FAIL_CLUSTER_EVENT"
 	default:
 		str = "INVALID"
 	}
@@ -789,14 +974,24 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var iv int64
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "Delay":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
 			}
-		case "Plans", "BindVarConds", "TableNames":
+		case "MaxQPS", "MaxConcurrency":
+			nv, ok := v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s", k)
+			}
+			iv, err = nv.Int64()
+			if err != nil || iv <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want positive integer for %s: %s", k, nv)
+			}
+		case "Plans", "BindVarConds", "TableNames", "Fingerprints":
 			lv, ok = v.([]interface{})
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for %s", k)
@@ -844,6 +1039,14 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				}
 				qr.AddTableCond(tableName)
 			}
+		case "Fingerprints":
+			for _, f := range lv {
+				fingerprint, ok := f.(string)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for Fingerprints")
+				}
+				qr.AddFingerprintCond(fingerprint)
+			}
 		case "BindVarConds":
 			for _, bvc := range lv {
 				name, onAbsent, onMismatch, op, value, err := buildBindVarCondition(bvc)
@@ -861,14 +1064,50 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "THROTTLE":
+				qr.act = QRThrottle
+			case "CONCURRENCY":
+				qr.act = QRConcurrency
+			case "DELAY":
+				qr.act = QRDelay
+			case "FAIL_CLUSTER_EVENT":
+				qr.act = QRFailClusterEvent
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "MaxQPS":
+			qr.SetMaxQPS(int(iv))
+		case "MaxConcurrency":
+			qr.SetMaxConcurrency(int(iv))
+		case "Delay":
+			delay, err := time.ParseDuration(sv)
+			if err != nil || delay <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want positive duration for Delay: %s", sv)
+			}
+			qr.SetDelay(delay)
 		}
 	}
+	if err := qr.checkLimits(); err != nil {
+		return nil, err
+	}
 	return qr, nil
 }
 
+// checkLimits returns an error if the rule lacks the parameter of its
+// action, or has the parameters of another action.
+func (qr *Rule) checkLimits() error {
+	if (qr.act == QRThrottle) != (qr.maxQPS != 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxQPS must be set for, and only for, the THROTTLE action")
+	}
+	if (qr.act == QRConcurrency) != (qr.maxConcurrency != 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxConcurrency must be set for, and only for, the CONCURRENCY action")
+	}
+	if (qr.act == QRDelay) != (qr.delay != 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Delay must be set for, and only for, the DELAY action")
+	}
+	return nil
+}
+
 func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, err error) {
 	bvcinfo, ok := bvc.(map[string]interface{})
 	if !ok {
@@ -23,6 +23,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -563,6 +564,121 @@ func TestImport(t *testing.T) {
 	}
 }
 
+func TestImportLimits(t *testing.T) {
+	var qrs = New()
+	jsondata := `[{
+		"Description": "desc1",
+		"Name": "name1",
+		"Action": "THROTTLE",
+		"MaxQPS": 10
+	},{
+		"Description": "desc2",
+		"Name": "name2",
+		"Action": "CONCURRENCY",
+		"MaxConcurrency": 2
+	},{
+		"Description": "desc3",
+		"Name": "name3",
+		"Action": "DELAY",
+		"Delay": "1.5s"
+	}]`
+	err := qrs.UnmarshalJSON([]byte(jsondata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := marshalled(qrs)
+	want := compacted(jsondata)
+	if got != want {
+		t.Errorf("qrs:\n%s, want\n%s", got, want)
+	}
+	if !qrs.Equal(qrs.Copy()) {
+		t.Errorf("qrs.Copy() is not equal to qrs")
+	}
+	if d := qrs.Find("name3").Delay(); d != 1500*time.Millisecond {
+		t.Errorf("Delay: %v, want 1.5s", d)
+	}
+}
+
+func TestLimitActions(t *testing.T) {
+	throttle := NewQueryRule("throttle", "t", QRThrottle)
+	throttle.SetMaxQPS(2)
+	throttleCopy := throttle.Copy()
+	if !throttle.Acquire() || !throttleCopy.Acquire() {
+		t.Errorf("want the first two queries to be allowed")
+	}
+	if throttle.Acquire() || throttleCopy.Acquire() {
+		t.Errorf("want the copies to share the rate limit")
+	}
+
+	concurrency := NewQueryRule("concurrency", "c", QRConcurrency)
+	concurrency.SetMaxConcurrency(1)
+	qrs := New()
+	qrs.Add(concurrency)
+	filtered := qrs.FilterByPlan("select * from a", planbuilder.PlanSelect, "a")
+	match := filtered.GetMatch("", "", nil)
+	if match == nil || match.Action() != QRConcurrency {
+		t.Fatalf("GetMatch: %v, want the concurrency rule", match)
+	}
+	if !match.Acquire() {
+		t.Errorf("want the first query to be allowed")
+	}
+	if concurrency.Acquire() {
+		t.Errorf("want the copies to share the concurrency limit")
+	}
+	match.Release()
+	if !concurrency.Acquire() {
+		t.Errorf("want a query to be allowed after a release")
+	}
+
+	fail := NewQueryRule("fail", "f", QRFail)
+	if !fail.Acquire() {
+		t.Errorf("want actions without limits to be allowed")
+	}
+	if match := New().GetMatch("", "", nil); match != nil {
+		t.Errorf("GetMatch: %v, want nil", match)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	fp := Fingerprint("select a from t where id = 1 and b in (1, 2)")
+	if fp == "" {
+		t.Fatalf("Fingerprint: empty")
+	}
+	for _, query := range []string{
+		"select a from t where id = 2 and b in (3)",
+		"select a from t where id = :vtg1 and b in ::vtg2",
+		"SELECT a FROM t WHERE id = 'x' AND b IN (1, 2, 3)",
+	} {
+		if got := Fingerprint(query); got != fp {
+			t.Errorf("Fingerprint(%q): %s, want %s", query, got, fp)
+		}
+	}
+	if got := Fingerprint("select b from t where id = 1"); got == fp {
+		t.Errorf("Fingerprint of a different query: %s, want a different fingerprint", got)
+	}
+
+	qr := NewQueryRule("quarantine", "q", QRFail)
+	qr.AddFingerprintCond(fp)
+	qrs := New()
+	qrs.Add(qr)
+	if got := qrs.FilterByPlan("select a from t where id = 3 and b in (4)", planbuilder.PlanSelect, "t"); len(got.rules) != 1 {
+		t.Errorf("FilterByPlan: %d rules, want 1", len(got.rules))
+	} else if got.rules[0].fingerprints != nil {
+		t.Errorf("FilterByPlan: the fingerprints were not cleared: %v", got.rules[0].fingerprints)
+	}
+	if got := qrs.FilterByPlan("select b from t where id = 3", planbuilder.PlanSelect, "t"); len(got.rules) != 0 {
+		t.Errorf("FilterByPlan: %d rules, want 0", len(got.rules))
+	}
+
+	qrs1 := New()
+	if err := qrs1.UnmarshalJSON([]byte(marshalled(qrs))); err != nil {
+		t.Fatal(err)
+	}
+	if !qrs1.Equal(qrs) {
+		t.Errorf("UnmarshalJSON: %s, want %s", marshalled(qrs1), marshalled(qrs))
+	}
+}
+
 type ValidJSONCase struct {
 	input string
 	op    Operator
@@ -653,6 +769,7 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"Plans": [1] }]`, "want string for Plans"},
 	{`[{"Plans": ["invalid"] }]`, "invalid plan name: invalid"},
 	{`[{"TableNames": [1] }]`, "want string for TableNames"},
+	{`[{"Fingerprints": [1] }]`, "want string for Fingerprints"},
 	{`[{"BindVarConds": [1] }]`, "want json object for bind var conditions"},
 	{`[{"BindVarConds": [{}] }]`, "Name missing in BindVarConds"},
 	{`[{"BindVarConds": [{"Name": 1}] }]`, "want string for Name in BindVarConds"},
@@ -672,6 +789,15 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"MaxQPS": "1" }]`, "want number for MaxQPS"},
+	{`[{"MaxConcurrency": 0 }]`, "want positive integer for MaxConcurrency: 0"},
+	{`[{"Delay": 100 }]`, "want string for Delay"},
+	{`[{"Delay": "foo" }]`, "want positive duration for Delay: foo"},
+	{`[{"Action": "THROTTLE" }]`, "MaxQPS must be set for, and only for, the THROTTLE action"},
+	{`[{"Action": "FAIL", "MaxQPS": 10 }]`, "MaxQPS must be set for, and only for, the THROTTLE action"},
+	{`[{"Action": "CONCURRENCY" }]`, "MaxConcurrency must be set for, and only for, the CONCURRENCY action"},
+	{`[{"Action": "DELAY", "MaxConcurrency": 1 }]`, "MaxConcurrency must be set for, and only for, the CONCURRENCY action"},
+	{`[{"Action": "DELAY" }]`, "Delay must be set for, and only for, the DELAY action"},
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -110,6 +110,37 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	ta.MessageInfo.MaxRetries, _ = getNum(keyvals, "vt_max_retries")
+	ta.MessageInfo.DeadLetterTable = keyvals["vt_dead_letter_table"]
+	if ta.MessageInfo.MaxRetries > 0 && ta.MessageInfo.DeadLetterTable == "" {
+		return fmt.Errorf("attribute vt_dead_letter_table not specified for message table with vt_max_retries: %s", ta.Name.String())
+	}
+
+	// Consumer groups are separated by '|' because ',' separates the attributes.
+	if groups := keyvals["vt_consumer_groups"]; groups != "" {
+		if ta.MessageInfo.MaxRetries > 0 {
+			return fmt.Errorf("vt_max_retries is not supported with vt_consumer_groups: %s", ta.Name.String())
+		}
+		for _, group := range strings.Split(groups, "|") {
+			group = strings.ToLower(strings.TrimSpace(group))
+			if group == "" {
+				return fmt.Errorf("empty consumer group in vt_consumer_groups for message table: %s", ta.Name.String())
+			}
+			ta.MessageInfo.ConsumerGroups = append(ta.MessageInfo.ConsumerGroups, group)
+			for _, col := range []string{"time_next", "epoch", "time_acked"} {
+				col = GroupColumn(col, group)
+				hiddenCols[col] = struct{}{}
+				requiredCols = append(requiredCols, col)
+			}
+		}
+	}
+	ta.MessageInfo.PartitionColumn = keyvals["vt_partition_column"]
+	if col := ta.MessageInfo.PartitionColumn; col != "" {
+		if _, ok := hiddenCols[strings.ToLower(col)]; ok || ta.FindColumn(sqlparser.NewColIdent(col)) == -1 {
+			return fmt.Errorf("vt_partition_column %s is not a user-defined column of message table: %s", col, ta.Name.String())
+		}
+	}
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -127,6 +158,16 @@ func loadMessageInfo(ta *Table, comment string) error {
 	return nil
 }
 
+// GroupColumn returns the name of the column that holds the
+// delivery state col of a consumer group. The default group
+// uses the column itself.
+func GroupColumn(col, group string) string {
+	if group == "" {
+		return col
+	}
+	return col + "_" + group
+}
+
 func getDuration(in map[string]string, key string) (time.Duration, error) {
 	sv := in[key]
 	if sv == "" {
@@ -141,6 +141,39 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading max retries and dead letter table
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_retries=3,vt_dead_letter_table=test_table_dlq", db)
+	require.NoError(t, err)
+	want.MessageInfo.MaxRetries = 3
+	want.MessageInfo.DeadLetterTable = "test_table_dlq"
+	assert.Equal(t, want, table)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_retries=3", db)
+	assert.EqualError(t, err, "attribute vt_dead_letter_table not specified for message table with vt_max_retries: test_table")
+
+	// Test loading consumer groups and partition column
+	for query, result := range getMessageTableGroupQueries() {
+		db.AddQuery(query, result)
+	}
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=G1,vt_partition_column=message", db)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"g1"}, table.MessageInfo.ConsumerGroups)
+	assert.Equal(t, "message", table.MessageInfo.PartitionColumn)
+	assert.Equal(t, want.MessageInfo.Fields, table.MessageInfo.Fields)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=g1|g2", db)
+	assert.EqualError(t, err, "time_next_g2 missing from message table: test_table")
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=g1,vt_partition_column=epoch_g1", db)
+	assert.EqualError(t, err, "vt_partition_column epoch_g1 is not a user-defined column of message table: test_table")
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=g1,vt_max_retries=3,vt_dead_letter_table=test_table_dlq", db)
+	assert.EqualError(t, err, "vt_max_retries is not supported with vt_consumer_groups: test_table")
+
+	for query, result := range getMessageTableQueries() {
+		db.AddQuery(query, result)
+	}
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
@@ -218,3 +251,19 @@ func getMessageTableQueries() map[string]*sqltypes.Result {
 		},
 	}
 }
+
+func getMessageTableGroupQueries() map[string]*sqltypes.Result {
+	result := getMessageTableQueries()["select * from test_table where 1 != 1"]
+	fields := append([]*querypb.Field{}, result.Fields...)
+	for _, name := range []string{"time_next_g1", "epoch_g1", "time_acked_g1"} {
+		fields = append(fields, &querypb.Field{
+			Name: name,
+			Type: sqltypes.Int64,
+		})
+	}
+	return map[string]*sqltypes.Result{
+		"select * from test_table where 1 != 1": {
+			Fields: fields,
+		},
+	}
+}
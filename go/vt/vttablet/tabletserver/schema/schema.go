@@ -103,6 +103,29 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// MaxRetries specifies how many times an unacked message is
+	// resent before it's moved to the DeadLetterTable. Zero means
+	// that messages are resent forever.
+	MaxRetries int
+
+	// DeadLetterTable specifies the table where the messages that
+	// exceeded MaxRetries are moved to.
+	DeadLetterTable string
+
+	// ConsumerGroups lists the named consumer groups of the table.
+	// Every group keeps its own delivery state in the time_next_<group>,
+	// epoch_<group> and time_acked_<group> columns. The dead-letter table
+	// of a table with consumer groups needs a consumer_group column.
+	// Through vtgate, a group acks its messages with a DML on its columns:
+	//   update t set time_acked_<group> = <now in ns>, time_next_<group> = null
+	//   where id in (...) and time_acked_<group> is null
+	ConsumerGroups []string
+
+	// PartitionColumn specifies the column by which messages are
+	// partitioned. All the messages with the same value are sent
+	// to the same receiver of a group.
+	PartitionColumn string
 }
 
 // NewTable creates a new Table.
@@ -80,13 +80,23 @@ func (sc *StatefulConnection) IsInTransaction() bool {
 
 // Exec executes the statement in the dedicated connection
 func (sc *StatefulConnection) Exec(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+	return sc.exec(ctx, query, maxrows, wantfields, nil)
+}
+
+// ExecWithRowCheck is like Exec, but calls check with every row as it is
+// read, and fails the statement with the first error it returns.
+func (sc *StatefulConnection) ExecWithRowCheck(ctx context.Context, query string, maxrows int, wantfields bool, check func(row []sqltypes.Value) error) (*sqltypes.Result, error) {
+	return sc.exec(ctx, query, maxrows, wantfields, check)
+}
+
+func (sc *StatefulConnection) exec(ctx context.Context, query string, maxrows int, wantfields bool, check func(row []sqltypes.Value) error) (*sqltypes.Result, error) {
 	if sc.IsClosed() {
 		if sc.IsInTransaction() {
 			return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction was aborted: %v", sc.txProps.Conclusion)
 		}
 		return nil, vterrors.New(vtrpcpb.Code_ABORTED, "connection was aborted")
 	}
-	r, err := sc.dbConn.ExecOnce(ctx, query, maxrows, wantfields)
+	r, err := sc.dbConn.ExecOnceWithRowCheck(ctx, query, maxrows, wantfields, check)
 	if err != nil {
 		if mysql.IsConnErr(err) {
 			select {
@@ -25,6 +25,8 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -203,6 +205,27 @@ function drawQPSChart() {
 google.setOnLoadCallback(drawQPSChart);
 </script>
 
+`
+
+	adaptivePoolsTemplate = `
+<table>
+  <tr>
+    <th>Pool</th>
+    <th>Capacity</th>
+    <th>In Use</th>
+    <th>Max Capacity</th>
+    <th>Latest Resizes</th>
+  </tr>
+  {{range .}}
+  <tr>
+    <td>{{.Name}}</td>
+    <td>{{.Capacity}}</td>
+    <td>{{.InUse}}</td>
+    <td>{{.MaxCap}}</td>
+    <td>{{range .Decisions}}{{.Time.Format "Jan 2, 2006 at 15:04:05 (MST)"}}: {{.From}} to {{.To}} ({{.Reason}})<br>{{end}}</td>
+  </tr>
+  {{end}}
+</table>
 `
 )
 
@@ -213,6 +236,15 @@ type queryserviceStatus struct {
 	CurrentQPS float64
 }
 
+// adaptivePoolStatus is the status of an adaptive conn pool.
+type adaptivePoolStatus struct {
+	Name      string
+	Capacity  int64
+	InUse     int64
+	MaxCap    int64
+	Decisions []connpool.SizingDecision
+}
+
 type kv struct {
 	Key   string
 	Class string
@@ -255,6 +287,22 @@ func (tsv *TabletServer) AddStatusPart() {
 		return status
 	})
 
+	if len(tsv.adaptivePools()) != 0 {
+		tsv.exporter.AddStatusPart("Adaptive Connection Pools", adaptivePoolsTemplate, func() interface{} {
+			var status []adaptivePoolStatus
+			for _, pool := range tsv.adaptivePools() {
+				status = append(status, adaptivePoolStatus{
+					Name:      pool.name,
+					Capacity:  pool.Capacity(),
+					InUse:     pool.InUse(),
+					MaxCap:    pool.MaxCap(),
+					Decisions: pool.SizingDecisions(),
+				})
+			}
+			return status
+		})
+	}
+
 	tsv.exporter.HandleFunc("/debug/status_details", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		details := tsv.sm.AppendDetails(nil)
@@ -270,6 +318,28 @@ func (tsv *TabletServer) AddStatusPart() {
 	})
 }
 
+// namedPool is a conn pool with its name.
+type namedPool struct {
+	name string
+	*connpool.Pool
+}
+
+// adaptivePools returns the conn pools whose capacity adapts to the load.
+func (tsv *TabletServer) adaptivePools() []namedPool {
+	var pools []namedPool
+	for _, pool := range []namedPool{
+		{"ConnPool", tsv.qe.conns},
+		{"StreamConnPool", tsv.qe.streamConns},
+		{"TransactionPool", tsv.te.txPool.scp.conns},
+		{"FoundRowsPool", tsv.te.txPool.scp.foundRowsPool},
+	} {
+		if pool.IsAdaptive() {
+			pools = append(pools, pool)
+		}
+	}
+	return pools
+}
+
 var degradedThreshold sync2.AtomicDuration
 var unhealthyThreshold sync2.AtomicDuration
 
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -71,6 +72,8 @@ var (
 	// The following vars are used for custom initialization of Tabletconfig.
 	enableHotRowProtection       bool
 	enableHotRowProtectionDryRun bool
+	enableQueryQuarantine        bool
+	enableQueryQuarantineDryRun  bool
 	enableConsolidator           bool
 	enableConsolidatorReplicas   bool
 	enableHeartbeat              bool
@@ -90,6 +93,16 @@ func init() {
 	flag.IntVar(&deprecatedMessagePoolSize, "queryserver-config-message-conn-pool-size", 0, "DEPRECATED")
 	flag.IntVar(&deprecatedMessagePoolPrefillParallelism, "queryserver-config-message-conn-pool-prefill-parallelism", 0, "DEPRECATED: Unused.")
 	flag.IntVar(&currentConfig.TxPool.Size, "queryserver-config-transaction-cap", defaultConfig.TxPool.Size, "query server transaction cap is the maximum number of transactions allowed to happen at any given point of a time for a single vttablet. E.g. by setting transaction cap to 100, there are at most 100 transactions will be processed by a vttablet and the 101th transaction will be blocked (and fail if it cannot get connection within specified timeout)")
+	flag.IntVar(&currentConfig.OltpReadPool.MinSize, "queryserver-config-pool-min-size", defaultConfig.OltpReadPool.MinSize, "query server read pool min size, the size below which an adaptive read pool does not shrink. Requires -queryserver-config-pool-max-size.")
+	flag.IntVar(&currentConfig.OltpReadPool.MaxSize, "queryserver-config-pool-max-size", defaultConfig.OltpReadPool.MaxSize, "query server read pool max size, a non-zero value makes the read pool adaptive: its size changes between the min and max sizes with the load, starting at -queryserver-config-pool-size.")
+	flag.IntVar(&currentConfig.OlapReadPool.MinSize, "queryserver-config-stream-pool-min-size", defaultConfig.OlapReadPool.MinSize, "query server stream pool min size, the size below which an adaptive stream pool does not shrink. Requires -queryserver-config-stream-pool-max-size.")
+	flag.IntVar(&currentConfig.OlapReadPool.MaxSize, "queryserver-config-stream-pool-max-size", defaultConfig.OlapReadPool.MaxSize, "query server stream pool max size, a non-zero value makes the stream pool adaptive: its size changes between the min and max sizes with the load, starting at -queryserver-config-stream-pool-size.")
+	flag.IntVar(&currentConfig.TxPool.MinSize, "queryserver-config-transaction-min-cap", defaultConfig.TxPool.MinSize, "query server transaction min cap, the size below which an adaptive transaction pool does not shrink. Requires -queryserver-config-transaction-max-cap.")
+	flag.IntVar(&currentConfig.TxPool.MaxSize, "queryserver-config-transaction-max-cap", defaultConfig.TxPool.MaxSize, "query server transaction max cap, a non-zero value makes the transaction pool adaptive: its size changes between the min and max caps with the load, starting at -queryserver-config-transaction-cap.")
+	SecondsVar(&currentConfig.AdaptivePools.IntervalSeconds, "queryserver-config-adaptive-pool-interval", defaultConfig.AdaptivePools.IntervalSeconds, "query server adaptive pool interval (in seconds), how often the size of the adaptive pools is adjusted.")
+	SecondsVar(&currentConfig.AdaptivePools.WaitTimeSeconds, "queryserver-config-adaptive-pool-wait-time", defaultConfig.AdaptivePools.WaitTimeSeconds, "query server adaptive pool wait time (in seconds), an adaptive pool grows when the average time the requests waited for a connection during the last interval exceeds it.")
+	flag.IntVar(&currentConfig.AdaptivePools.MaxThreadsRunning, "queryserver-config-adaptive-pool-max-threads-running", defaultConfig.AdaptivePools.MaxThreadsRunning, "query server adaptive pool max threads running, the adaptive pools do not grow, and shrink, while MySQL Threads_running is at or above it. 0 disables the check.")
+	SecondsVar(&currentConfig.AdaptivePools.IdleTimeoutSeconds, "queryserver-config-adaptive-pool-idle-timeout", defaultConfig.AdaptivePools.IdleTimeoutSeconds, "query server adaptive pool idle timeout (in seconds), an adaptive pool shrinks to its peak usage when part of its size stayed unused for that long.")
 	flag.IntVar(&currentConfig.TxPool.PrefillParallelism, "queryserver-config-transaction-prefill-parallelism", defaultConfig.TxPool.PrefillParallelism, "query server transaction prefill parallelism, a non-zero value will prefill the pool using the specified parallism.")
 	flag.IntVar(&currentConfig.MessagePostponeParallelism, "queryserver-config-message-postpone-cap", defaultConfig.MessagePostponeParallelism, "query server message postpone cap is the maximum number of messages that can be postponed at any given time. Set this number to substantially lower than transaction cap, so that the transaction pool isn't exhausted by the message subsystem.")
 	flag.IntVar(&deprecatedFoundRowsPoolSize, "client-found-rows-pool-size", 0, "DEPRECATED: queryserver-config-transaction-cap will be used instead.")
@@ -98,6 +111,10 @@ func init() {
 	SecondsVar(&currentConfig.GracePeriods.ShutdownSeconds, "transaction_shutdown_grace_period", defaultConfig.GracePeriods.ShutdownSeconds, "DEPRECATED: use shutdown_grace_period instead.")
 	flag.IntVar(&currentConfig.Oltp.MaxRows, "queryserver-config-max-result-size", defaultConfig.Oltp.MaxRows, "query server max result size, maximum number of rows allowed to return from vttablet for non-streaming queries.")
 	flag.IntVar(&currentConfig.Oltp.WarnRows, "queryserver-config-warn-result-size", defaultConfig.Oltp.WarnRows, "query server result size warning threshold, warn if number of rows returned from vttablet for non-streaming queries exceeds this")
+	flag.Int64Var(&currentConfig.ResultMemory.MaxQueryBytes, "queryserver-config-max-result-bytes", defaultConfig.ResultMemory.MaxQueryBytes, "query server max result bytes, maximum number of bytes of row values allowed to return from vttablet for non-streaming queries. 0 disables the limit.")
+	flag.Int64Var(&currentConfig.ResultMemory.WarnQueryBytes, "queryserver-config-warn-result-bytes", defaultConfig.ResultMemory.WarnQueryBytes, "query server result bytes warning threshold, warn if number of bytes of row values returned from vttablet for non-streaming queries exceeds this")
+	flag.Int64Var(&currentConfig.ResultMemory.MaxTabletBytes, "queryserver-config-max-result-memory", defaultConfig.ResultMemory.MaxTabletBytes, "query server max result memory, maximum number of bytes of row values held at a time by all the queries of vttablet, including the streaming ones. The queries whose results would exceed it are rejected. 0 disables the limit.")
+	flag.IntVar(&currentConfig.ResultMemory.LargestResults, "queryserver-config-largest-results", defaultConfig.ResultMemory.LargestResults, "query server largest results, number of queries with the largest results by bytes that vttablet tracks in /debug/largest_results.")
 	flag.IntVar(&deprecatedMaxDMLRows, "queryserver-config-max-dml-rows", 0, "query server max dml rows per statement, maximum number of rows allowed to return at a time for an update or delete with either 1) an equality where clauses on primary keys, or 2) a subselect statement. For update and delete statements in above two categories, vttablet will split the original query into multiple small queries based on this configuration value. ")
 	flag.BoolVar(&currentConfig.PassthroughDML, "queryserver-config-passthrough-dmls", defaultConfig.PassthroughDML, "query server pass through all dml statements without rewriting")
 	flag.BoolVar(&deprecateAllowUnsafeDMLs, "queryserver-config-allowunsafe-dmls", false, "deprecated")
@@ -114,6 +131,8 @@ func init() {
 	SecondsVar(&currentConfig.OltpReadPool.IdleTimeoutSeconds, "queryserver-config-idle-timeout", defaultConfig.OltpReadPool.IdleTimeoutSeconds, "query server idle timeout (in seconds), vttablet manages various mysql connection pools. This config means if a connection has not been used in given idle timeout, this connection will be removed from pool. This effectively manages number of connection objects and optimize the pool performance.")
 	flag.IntVar(&currentConfig.OltpReadPool.MaxWaiters, "queryserver-config-query-pool-waiter-cap", defaultConfig.OltpReadPool.MaxWaiters, "query server query pool waiter limit, this is the maximum number of queries that can be queued waiting to get a connection")
 	flag.IntVar(&currentConfig.TxPool.MaxWaiters, "queryserver-config-txpool-waiter-cap", defaultConfig.TxPool.MaxWaiters, "query server transaction pool waiter limit, this is the maximum number of transactions that can be queued waiting to get a connection")
+	flag.IntVar(&currentConfig.OltpReadPool.LowPriorityMaxWaiters, "queryserver-config-query-pool-low-priority-waiter-cap", defaultConfig.OltpReadPool.LowPriorityMaxWaiters, "query server query pool low priority waiter limit, low priority queries are rejected when this many queries are already queued waiting to get a connection. 0 disables the limit.")
+	flag.IntVar(&currentConfig.TxPool.LowPriorityMaxWaiters, "queryserver-config-txpool-low-priority-waiter-cap", defaultConfig.TxPool.LowPriorityMaxWaiters, "query server transaction pool low priority waiter limit, low priority transactions are rejected when this many transactions are already queued waiting to get a connection. 0 disables the limit.")
 	// tableacl related configurations.
 	flag.BoolVar(&currentConfig.StrictTableACL, "queryserver-config-strict-table-acl", defaultConfig.StrictTableACL, "only allow queries that pass table acl checks")
 	flag.BoolVar(&currentConfig.EnableTableACLDryRun, "queryserver-config-enable-table-acl-dry-run", defaultConfig.EnableTableACLDryRun, "If this flag is enabled, tabletserver will emit monitoring metrics and let the request pass regardless of table acl check results")
@@ -122,6 +141,7 @@ func init() {
 	flag.StringVar(&deprecatedPoolNamePrefix, "pool-name-prefix", "", "Deprecated")
 	flag.BoolVar(&currentConfig.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to update schema when it sees a DDL.")
 	flag.BoolVar(&currentConfig.TrackSchemaVersions, "track_schema_versions", false, "When enabled, vttablet will store versions of schemas at each position that a DDL is applied and allow retrieval of the schema corresponding to a position")
+	flag.BoolVar(&currentConfig.SignalWhenSchemaChange, "queryserver-config-schema-change-signal", false, "When enabled, vttablet sends the names of the tables whose schema changed in the health stream, so that vtgate can track the table schemas")
 	flag.BoolVar(&deprecatedAutocommit, "enable-autocommit", true, "This flag is deprecated. Autocommit is always allowed.")
 	flag.BoolVar(&currentConfig.TwoPCEnable, "twopc_enable", defaultConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&currentConfig.TwoPCCoordinatorAddress, "twopc_coordinator_address", defaultConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
@@ -129,12 +149,22 @@ func init() {
 	flagutil.DualFormatBoolVar(&currentConfig.EnableTxThrottler, "enable_tx_throttler", defaultConfig.EnableTxThrottler, "If true replication-lag-based throttling on transactions will be enabled.")
 	flagutil.DualFormatStringVar(&currentConfig.TxThrottlerConfig, "tx_throttler_config", defaultConfig.TxThrottlerConfig, "The configuration of the transaction throttler as a text formatted throttlerdata.Configuration protocol buffer message")
 	flagutil.DualFormatStringListVar(&currentConfig.TxThrottlerHealthCheckCells, "tx_throttler_healthcheck_cells", defaultConfig.TxThrottlerHealthCheckCells, "A comma-separated list of cells. Only tabletservers running in these cells will be monitored for replication lag by the transaction throttler.")
+	flagutil.DualFormatBoolVar(&currentConfig.TxThrottlerSignals.CheckTabletThrottler, "tx_throttler_check_tablet_throttler", defaultConfig.TxThrottlerSignals.CheckTabletThrottler, "If true, the transaction throttler also throttles the transactions while the tablet throttler check of the shard fails. Requires -enable_lag_throttler.")
+	flagutil.DualFormatIntVar(&currentConfig.TxThrottlerSignals.MaxThreadsRunning, "tx_throttler_max_threads_running", defaultConfig.TxThrottlerSignals.MaxThreadsRunning, "MySQL Threads_running at or above which the transaction throttler throttles the transactions. 0 disables the signal.")
+	flagutil.DualFormatIntVar(&currentConfig.TxThrottlerSignals.MaxHistoryListLength, "tx_throttler_max_history_list_length", defaultConfig.TxThrottlerSignals.MaxHistoryListLength, "InnoDB history list length at or above which the transaction throttler throttles the transactions. 0 disables the signal.")
+	flagutil.DualFormatStringVar(&currentConfig.TxThrottlerSignals.CustomQuery, "tx_throttler_custom_query", defaultConfig.TxThrottlerSignals.CustomQuery, "A MySQL query returning a single number, at or above -tx_throttler_custom_query_threshold of which the transaction throttler throttles the transactions.")
+	flagutil.DualFormatFloat64Var(&currentConfig.TxThrottlerSignals.CustomQueryThreshold, "tx_throttler_custom_query_threshold", defaultConfig.TxThrottlerSignals.CustomQueryThreshold, "The threshold of -tx_throttler_custom_query.")
+	flagutil.DualFormatFloat64Var((*float64)(&currentConfig.TxThrottlerSignals.IntervalSeconds), "tx_throttler_signal_interval", float64(defaultConfig.TxThrottlerSignals.IntervalSeconds), "How often, in seconds, the transaction throttler reads its signals other than the replication lag.")
+	flagutil.DualFormatStringListVar(&currentConfig.TxThrottlerSignals.BatchCallers, "tx_throttler_batch_callers", defaultConfig.TxThrottlerSignals.BatchCallers, "A comma-separated list of the callers, by principal or username, of the batch workloads. The transaction throttler throttles their transactions before the interactive ones.")
+	flagutil.DualFormatFloat64Var(&currentConfig.TxThrottlerSignals.BatchThresholdRatio, "tx_throttler_batch_threshold_ratio", defaultConfig.TxThrottlerSignals.BatchThresholdRatio, "The fraction of the thresholds of the transaction throttler signals at which the transactions of the batch callers are throttled.")
 
 	flag.BoolVar(&enableHotRowProtection, "enable_hot_row_protection", false, "If true, incoming transactions for the same row (range) will be queued and cannot consume all txpool slots.")
 	flag.BoolVar(&enableHotRowProtectionDryRun, "enable_hot_row_protection_dry_run", false, "If true, hot row protection is not enforced but logs if transactions would have been queued.")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxQueueSize, "hot_row_protection_max_queue_size", defaultConfig.HotRowProtection.MaxQueueSize, "Maximum number of BeginExecute RPCs which will be queued for the same row (range).")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", defaultConfig.HotRowProtection.MaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxConcurrency, "hot_row_protection_concurrent_transactions", defaultConfig.HotRowProtection.MaxConcurrency, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
+	flag.BoolVar(&currentConfig.HotRowProtection.Autocommit, "hot_row_protection_autocommit", defaultConfig.HotRowProtection.Autocommit, "If true, hot row protection also queues the UPDATEs and DELETEs executed outside of a transaction for the same row (range).")
+	flag.Var((*flagutil.StringMapValue)(&currentConfig.HotRowProtection.KeyColumns), "hot_row_protection_key_columns", "Comma-separated list of table:columns pairs. Hot row protection identifies the rows of these tables by the values the WHERE clause gives to the columns, separated by '+', instead of by the whole WHERE clause. Use PRIMARY for the primary key columns, e.g. t1:PRIMARY,t2:user_id+item_id.")
 
 	flag.BoolVar(&currentConfig.EnableTransactionLimit, "enable_transaction_limit", defaultConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&currentConfig.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", defaultConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
@@ -156,6 +186,13 @@ func init() {
 	flag.DurationVar(&healthCheckInterval, "health_check_interval", 20*time.Second, "Interval between health checks")
 	flag.DurationVar(&degradedThreshold, "degraded_threshold", 30*time.Second, "replication lag after which a replica is considered degraded")
 	flag.DurationVar(&unhealthyThreshold, "unhealthy_threshold", 2*time.Hour, "replication lag after which a replica is considered unhealthy")
+	flag.BoolVar(&enableQueryQuarantine, "enable_query_quarantine", false, "If true, the query fingerprints that exceed the query quarantine thresholds are rejected for a while by all the tablets of the shard.")
+	flag.BoolVar(&enableQueryQuarantineDryRun, "enable_query_quarantine_dry_run", false, "If true, query quarantine is not enforced but logs the query fingerprints that would have been quarantined. Requires -enable_query_quarantine.")
+	SecondsVar(&currentConfig.QueryQuarantine.CheckIntervalSeconds, "query_quarantine_check_interval", defaultConfig.QueryQuarantine.CheckIntervalSeconds, "How often, in seconds, the resource usage of the query fingerprints is checked against the query quarantine thresholds.")
+	SecondsVar(&currentConfig.QueryQuarantine.DurationSeconds, "query_quarantine_duration", defaultConfig.QueryQuarantine.DurationSeconds, "How long, in seconds, a query fingerprint stays quarantined.")
+	SecondsVar(&currentConfig.QueryQuarantine.MaxTimeSeconds, "query_quarantine_max_time", defaultConfig.QueryQuarantine.MaxTimeSeconds, "Total execution time, in seconds, above which the queries of a fingerprint are quarantined, per check interval. 0 disables the threshold.")
+	flag.IntVar(&currentConfig.QueryQuarantine.MaxRows, "query_quarantine_max_rows", defaultConfig.QueryQuarantine.MaxRows, "Rows returned or affected above which the queries of a fingerprint are quarantined, per check interval. 0 disables the threshold.")
+	flag.IntVar(&currentConfig.QueryQuarantine.MaxErrors, "query_quarantine_max_errors", defaultConfig.QueryQuarantine.MaxErrors, "Errors above which the queries of a fingerprint are quarantined, per check interval. 0 disables the threshold.")
 	flag.DurationVar(&transitionGracePeriod, "serving_state_grace_period", 0, "how long to pause after broadcasting health to vtgate, before enforcing a new serving state")
 
 	flag.BoolVar(&enableReplicationReporter, "enable_replication_reporter", false, "Use polling to track replication lag.")
@@ -178,6 +215,16 @@ func Init() {
 		currentConfig.HotRowProtection.Mode = Disable
 	}
 
+	if enableQueryQuarantine {
+		if enableQueryQuarantineDryRun {
+			currentConfig.QueryQuarantine.Mode = Dryrun
+		} else {
+			currentConfig.QueryQuarantine.Mode = Enable
+		}
+	} else {
+		currentConfig.QueryQuarantine.Mode = Disable
+	}
+
 	switch {
 	case enableConsolidatorReplicas:
 		currentConfig.Consolidator = NotOnMaster
@@ -220,6 +267,10 @@ func Init() {
 		StatsLogger.ServeLogs(*queryLogHandler, streamlog.GetFormatter(StatsLogger))
 	}
 
+	if err := StatsLogger.LogToSinks(streamlog.GetFormatter(StatsLogger)); err != nil {
+		log.Exitf("Cannot start query log sinks: %v", err)
+	}
+
 	if *txLogHandler != "" {
 		TxLogger.ServeLogs(*txLogHandler, streamlog.GetFormatter(TxLogger))
 	}
@@ -235,6 +286,9 @@ type TabletConfig struct {
 
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
+	QueryQuarantine  QueryQuarantineConfig  `json:"queryQuarantine,omitempty"`
+	AdaptivePools    AdaptivePoolsConfig    `json:"adaptivePools,omitempty"`
+	ResultMemory     ResultMemoryConfig     `json:"resultMemory,omitempty"`
 
 	Healthcheck  HealthcheckConfig  `json:"healthcheck,omitempty"`
 	GracePeriods GracePeriodsConfig `json:"gracePeriods,omitempty"`
@@ -251,6 +305,7 @@ type TabletConfig struct {
 	SchemaReloadIntervalSeconds Seconds `json:"schemaReloadIntervalSeconds,omitempty"`
 	WatchReplication            bool    `json:"watchReplication,omitempty"`
 	TrackSchemaVersions         bool    `json:"trackSchemaVersions,omitempty"`
+	SignalWhenSchemaChange      bool    `json:"signalWhenSchemaChange,omitempty"`
 	TerseErrors                 bool    `json:"terseErrors,omitempty"`
 	MessagePostponeParallelism  int     `json:"messagePostponeParallelism,omitempty"`
 	CacheResultFields           bool    `json:"cacheResultFields,omitempty"`
@@ -268,6 +323,8 @@ type TabletConfig struct {
 	TxThrottlerConfig           string   `json:"-"`
 	TxThrottlerHealthCheckCells []string `json:"-"`
 
+	TxThrottlerSignals TxThrottlerSignalsConfig `json:"-"`
+
 	EnableLagThrottler bool `json:"-"`
 
 	TransactionLimitConfig `json:"-"`
@@ -282,6 +339,13 @@ type ConnPoolConfig struct {
 	IdleTimeoutSeconds Seconds `json:"idleTimeoutSeconds,omitempty"`
 	PrefillParallelism int     `json:"prefillParallelism,omitempty"`
 	MaxWaiters         int     `json:"maxWaiters,omitempty"`
+	// LowPriorityMaxWaiters is the number of waiters at which the low
+	// priority requests are rejected, before MaxWaiters is reached.
+	LowPriorityMaxWaiters int `json:"lowPriorityMaxWaiters,omitempty"`
+	// MinSize and MaxSize are the bounds of the size of an adaptive pool.
+	// The pool is adaptive if MaxSize is set.
+	MinSize int `json:"minSize,omitempty"`
+	MaxSize int `json:"maxSize,omitempty"`
 }
 
 // OltpConfig contains the config for oltp settings.
@@ -299,6 +363,74 @@ type HotRowProtectionConfig struct {
 	MaxQueueSize       int    `json:"maxQueueSize,omitempty"`
 	MaxGlobalQueueSize int    `json:"maxGlobalQueueSize,omitempty"`
 	MaxConcurrency     int    `json:"maxConcurrency,omitempty"`
+	// Autocommit also serializes the UPDATEs and DELETEs executed
+	// outside of a transaction.
+	Autocommit bool `json:"autocommit,omitempty"`
+	// KeyColumns maps a table to the columns, separated by '+', whose
+	// values in the WHERE clause key its rows instead of the whole clause.
+	// PRIMARY stands for the primary key columns of the table.
+	KeyColumns map[string]string `json:"keyColumns,omitempty"`
+}
+
+// PrimaryKeyColumns is the KeyColumns value of the tables whose rows
+// are keyed by their primary key.
+const PrimaryKeyColumns = "PRIMARY"
+
+// TableKeyColumns returns the columns keying the rows of each table
+// listed in KeyColumns.
+func (c *HotRowProtectionConfig) TableKeyColumns() map[string][]string {
+	if len(c.KeyColumns) == 0 {
+		return nil
+	}
+	keyColumns := make(map[string][]string, len(c.KeyColumns))
+	for table, columns := range c.KeyColumns {
+		keyColumns[table] = strings.Split(columns, "+")
+	}
+	return keyColumns
+}
+
+// QueryQuarantineConfig contains the config for query quarantine.
+type QueryQuarantineConfig struct {
+	// Mode can be disable, dryRun or enable. Default is disable.
+	Mode                 string  `json:"mode,omitempty"`
+	CheckIntervalSeconds Seconds `json:"checkIntervalSeconds,omitempty"`
+	DurationSeconds      Seconds `json:"durationSeconds,omitempty"`
+	MaxTimeSeconds       Seconds `json:"maxTimeSeconds,omitempty"`
+	MaxRows              int     `json:"maxRows,omitempty"`
+	MaxErrors            int     `json:"maxErrors,omitempty"`
+}
+
+// TxThrottlerSignalsConfig contains the config for the signals checked
+// by the transaction throttler besides the replication lag.
+type TxThrottlerSignalsConfig struct {
+	CheckTabletThrottler bool
+	MaxThreadsRunning    int
+	MaxHistoryListLength int
+	CustomQuery          string
+	CustomQueryThreshold float64
+	IntervalSeconds      Seconds
+	// BatchCallers are the callers whose transactions are throttled
+	// before the interactive ones.
+	BatchCallers        []string
+	BatchThresholdRatio float64
+}
+
+// AdaptivePoolsConfig contains the config for the sizing of the
+// adaptive conn pools.
+type AdaptivePoolsConfig struct {
+	IntervalSeconds    Seconds `json:"intervalSeconds,omitempty"`
+	WaitTimeSeconds    Seconds `json:"waitTimeSeconds,omitempty"`
+	MaxThreadsRunning  int     `json:"maxThreadsRunning,omitempty"`
+	IdleTimeoutSeconds Seconds `json:"idleTimeoutSeconds,omitempty"`
+}
+
+// ResultMemoryConfig contains the config for the memory accounting
+// of the query results.
+type ResultMemoryConfig struct {
+	MaxQueryBytes  int64 `json:"maxQueryBytes,omitempty"`
+	WarnQueryBytes int64 `json:"warnQueryBytes,omitempty"`
+	MaxTabletBytes int64 `json:"maxTabletBytes,omitempty"`
+	LargestResults int   `json:"largestResults,omitempty"`
 }
 
 // HealthcheckConfig contains the config for healthcheck.
@@ -370,6 +502,42 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	for table, columns := range c.HotRowProtection.TableKeyColumns() {
+		for _, column := range columns {
+			if column == "" {
+				return fmt.Errorf("-hot_row_protection_key_columns has an empty column for table %v (specified value: %v)", table, c.HotRowProtection.KeyColumns[table])
+			}
+		}
+	}
+	if c.QueryQuarantine.Mode != Disable && c.QueryQuarantine.Mode != "" {
+		if v := c.QueryQuarantine.CheckIntervalSeconds; v <= 0 {
+			return fmt.Errorf("-query_quarantine_check_interval must be > 0 (specified value: %v)", v)
+		}
+		if v := c.QueryQuarantine.DurationSeconds; v <= 0 {
+			return fmt.Errorf("-query_quarantine_duration must be > 0 (specified value: %v)", v)
+		}
+	}
+	for _, pool := range []struct {
+		name string
+		cfg  ConnPoolConfig
+	}{
+		{"pool", c.OltpReadPool},
+		{"stream pool", c.OlapReadPool},
+		{"transaction pool", c.TxPool},
+	} {
+		if pool.cfg.MaxWaiters > 0 && pool.cfg.LowPriorityMaxWaiters > pool.cfg.MaxWaiters {
+			return fmt.Errorf("%s low priority waiter cap must be <= waiter cap (specified values: %v, %v)", pool.name, pool.cfg.LowPriorityMaxWaiters, pool.cfg.MaxWaiters)
+		}
+		if pool.cfg.MaxSize == 0 {
+			continue
+		}
+		if pool.cfg.MinSize > pool.cfg.Size || pool.cfg.Size > pool.cfg.MaxSize {
+			return fmt.Errorf("adaptive %s sizes must be min size <= size <= max size (specified values: %v, %v, %v)", pool.name, pool.cfg.MinSize, pool.cfg.Size, pool.cfg.MaxSize)
+		}
+		if v := c.AdaptivePools.IntervalSeconds; v <= 0 {
+			return fmt.Errorf("-queryserver-config-adaptive-pool-interval must be > 0 (specified value: %v)", v)
+		}
+	}
 	return nil
 }
 
@@ -444,6 +612,21 @@ var defaultConfig = TabletConfig{
 		// of them ready in MySQL and profit from a pipelining effect.
 		MaxConcurrency: 5,
 	},
+	QueryQuarantine: QueryQuarantineConfig{
+		Mode:                 Disable,
+		CheckIntervalSeconds: 60,
+		DurationSeconds:      10 * 60,
+		// Five connections busy with the same query for the whole check interval.
+		MaxTimeSeconds: 5 * 60,
+	},
+	AdaptivePools: AdaptivePoolsConfig{
+		IntervalSeconds:    1,
+		WaitTimeSeconds:    0.01,
+		IdleTimeoutSeconds: 60,
+	},
+	ResultMemory: ResultMemoryConfig{
+		LargestResults: 20,
+	},
 	Consolidator: Enable,
 	// The value for StreamBufferSize was chosen after trying out a few of
 	// them. Too small buffers force too many packets to be sent. Too big
@@ -463,6 +646,12 @@ var defaultConfig = TabletConfig{
 	TxThrottlerConfig:           defaultTxThrottlerConfig(),
 	TxThrottlerHealthCheckCells: []string{},
 
+	TxThrottlerSignals: TxThrottlerSignalsConfig{
+		IntervalSeconds:     1,
+		BatchCallers:        []string{},
+		BatchThresholdRatio: 0.8,
+	},
+
 	EnableLagThrottler: false, // Feature flag; to switch to 'true' at some stage in the future
 
 	TransactionLimitConfig: defaultTransactionLimitConfig(),
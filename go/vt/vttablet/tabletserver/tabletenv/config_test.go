@@ -49,7 +49,8 @@ func TestConfigParse(t *testing.T) {
 	}
 	gotBytes, err := yaml2.Marshal(&cfg)
 	require.NoError(t, err)
-	wantBytes := `db:
+	wantBytes := `adaptivePools: {}
+db:
   allprivs:
     password: '****'
   app:
@@ -76,7 +77,9 @@ oltpReadPool:
   prefillParallelism: 30
   size: 16
   timeoutSeconds: 10
+queryQuarantine: {}
 replicationTracker: {}
+resultMemory: {}
 txPool: {}
 `
 	assert.Equal(t, wantBytes, string(gotBytes))
@@ -107,7 +110,11 @@ oltpReadPool:
 func TestDefaultConfig(t *testing.T) {
 	gotBytes, err := yaml2.Marshal(NewDefaultConfig())
 	require.NoError(t, err)
-	want := `cacheResultFields: true
+	want := `adaptivePools:
+  idleTimeoutSeconds: 60
+  intervalSeconds: 1
+  waitTimeSeconds: 0.01
+cacheResultFields: true
 consolidator: enable
 gracePeriods: {}
 healthcheck:
@@ -134,9 +141,16 @@ oltpReadPool:
 queryCacheLFU: true
 queryCacheMemory: 33554432
 queryCacheSize: 5000
+queryQuarantine:
+  checkIntervalSeconds: 60
+  durationSeconds: 600
+  maxTimeSeconds: 300
+  mode: disable
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
   mode: disable
+resultMemory:
+  largestResults: 20
 schemaReloadIntervalSeconds: 1800
 streamBufferSize: 32768
 txPool:
@@ -192,6 +206,19 @@ func TestFlags(t *testing.T) {
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
 		},
+		QueryQuarantine: QueryQuarantineConfig{
+			CheckIntervalSeconds: 60,
+			DurationSeconds:      600,
+			MaxTimeSeconds:       300,
+		},
+		AdaptivePools: AdaptivePoolsConfig{
+			IntervalSeconds:    1,
+			WaitTimeSeconds:    0.01,
+			IdleTimeoutSeconds: 60,
+		},
+		ResultMemory: ResultMemoryConfig{
+			LargestResults: 20,
+		},
 		StreamBufferSize:            32768,
 		QueryCacheSize:              int(cache.DefaultConfig.MaxEntries),
 		QueryCacheMemory:            cache.DefaultConfig.MaxMemoryUsage,
@@ -202,6 +229,11 @@ func TestFlags(t *testing.T) {
 		CacheResultFields:           true,
 		TxThrottlerConfig:           "target_replication_lag_sec: 2\nmax_replication_lag_sec: 10\ninitial_rate: 100\nmax_increase: 1\nemergency_decrease: 0.5\nmin_duration_between_increases_sec: 40\nmax_duration_between_increases_sec: 62\nmin_duration_between_decreases_sec: 20\nspread_backlog_across_sec: 20\nage_bad_rate_after_sec: 180\nbad_rate_increase: 0.1\nmax_rate_approach_threshold: 0.9\n",
 		TxThrottlerHealthCheckCells: []string{},
+		TxThrottlerSignals: TxThrottlerSignalsConfig{
+			IntervalSeconds:     1,
+			BatchCallers:        []string{},
+			BatchThresholdRatio: 0.8,
+		},
 		TransactionLimitConfig: TransactionLimitConfig{
 			TransactionLimitPerUser:     0.4,
 			TransactionLimitByUsername:  true,
@@ -218,6 +250,7 @@ func TestFlags(t *testing.T) {
 	want.OlapReadPool.IdleTimeoutSeconds = 1800
 	want.TxPool.IdleTimeoutSeconds = 1800
 	want.HotRowProtection.Mode = Disable
+	want.QueryQuarantine.Mode = Disable
 	want.Consolidator = Enable
 	want.Healthcheck.IntervalSeconds = 20
 	want.Healthcheck.DegradedThresholdSeconds = 30
@@ -251,6 +284,30 @@ func TestFlags(t *testing.T) {
 	want.HotRowProtection.Mode = Disable
 	assert.Equal(t, want, currentConfig)
 
+	enableQueryQuarantine = true
+	enableQueryQuarantineDryRun = true
+	Init()
+	want.QueryQuarantine.Mode = Dryrun
+	assert.Equal(t, want, currentConfig)
+
+	enableQueryQuarantine = true
+	enableQueryQuarantineDryRun = false
+	Init()
+	want.QueryQuarantine.Mode = Enable
+	assert.Equal(t, want, currentConfig)
+
+	enableQueryQuarantine = false
+	enableQueryQuarantineDryRun = true
+	Init()
+	want.QueryQuarantine.Mode = Disable
+	assert.Equal(t, want, currentConfig)
+
+	enableQueryQuarantine = false
+	enableQueryQuarantineDryRun = false
+	Init()
+	want.QueryQuarantine.Mode = Disable
+	assert.Equal(t, want, currentConfig)
+
 	enableConsolidator = true
 	enableConsolidatorReplicas = true
 	Init()
@@ -326,3 +383,21 @@ func TestFlags(t *testing.T) {
 	want.GracePeriods.TransitionSeconds = 4
 	assert.Equal(t, want, currentConfig)
 }
+
+func TestHotRowProtectionKeyColumns(t *testing.T) {
+	cfg := NewDefaultConfig()
+	assert.Nil(t, cfg.HotRowProtection.TableKeyColumns())
+
+	cfg.HotRowProtection.KeyColumns = map[string]string{
+		"t1": PrimaryKeyColumns,
+		"t2": "user_id+item_id",
+	}
+	assert.Equal(t, map[string][]string{
+		"t1": {PrimaryKeyColumns},
+		"t2": {"user_id", "item_id"},
+	}, cfg.HotRowProtection.TableKeyColumns())
+	assert.NoError(t, cfg.Verify())
+
+	cfg.HotRowProtection.KeyColumns["t3"] = "user_id+"
+	assert.EqualError(t, cfg.Verify(), "-hot_row_protection_key_columns has an empty column for table t3 (specified value: user_id+)")
+}
@@ -187,6 +187,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	}
 
 	rewrittenSQL := "[REDACTED]"
+	format := streamlog.LogFormat(params)
 	formattedBindVars := "\"[REDACTED]\""
 
 	if !*streamlog.RedactDebugUIQueries {
@@ -196,7 +197,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		formattedBindVars = sqltypes.FormatBindVariables(
 			stats.BindVariables,
 			fullBindParams,
-			*streamlog.QueryLogFormat == streamlog.QueryLogFormatJSON,
+			format == streamlog.QueryLogFormatJSON,
 		)
 	}
 
@@ -205,7 +206,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 
 	// Valid options for the QueryLogFormat are text or json
 	var fmtString string
-	switch *streamlog.QueryLogFormat {
+	switch format {
 	case streamlog.QueryLogFormatText:
 		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%q\t\n"
 	case streamlog.QueryLogFormatJSON:
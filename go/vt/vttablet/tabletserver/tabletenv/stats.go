@@ -43,6 +43,8 @@ type Stats struct {
 	TableaclAllowed        *stats.CountersWithMultiLabels // Number of allows
 	TableaclDenied         *stats.CountersWithMultiLabels // Number of denials
 	TableaclPseudoDenied   *stats.CountersWithMultiLabels // Number of pseudo denials
+	QueryRuleMatches       *stats.CountersWithSingleLabel // Per query rule matches
+	QueryRuleRejections    *stats.CountersWithSingleLabel // Per query rule rejections
 
 	UserActiveReservedCount *stats.CountersWithSingleLabel // Per CallerID active reserved connection counts
 	UserReservedCount       *stats.CountersWithSingleLabel // Per CallerID reserved connection counts
@@ -77,6 +79,7 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 			vtrpcpb.Code_INTERNAL.String(),
 			vtrpcpb.Code_UNAVAILABLE.String(),
 			vtrpcpb.Code_DATA_LOSS.String(),
+			vtrpcpb.Code_CLUSTER_EVENT.String(),
 		),
 		InternalErrors:         exporter.NewCountersWithSingleLabel("InternalErrors", "Internal component errors", "type", "Task", "StrayTransactions", "Panic", "HungQuery", "Schema", "TwopcCommit", "TwopcResurrection", "WatchdogFail", "Messages"),
 		Warnings:               exporter.NewCountersWithSingleLabel("Warnings", "Warnings", "type", "ResultsExceeded"),
@@ -89,6 +92,8 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 		TableaclAllowed:        exporter.NewCountersWithMultiLabels("TableACLAllowed", "ACL acceptances", []string{"TableName", "TableGroup", "PlanID", "Username"}),
 		TableaclDenied:         exporter.NewCountersWithMultiLabels("TableACLDenied", "ACL denials", []string{"TableName", "TableGroup", "PlanID", "Username"}),
 		TableaclPseudoDenied:   exporter.NewCountersWithMultiLabels("TableACLPseudoDenied", "ACL pseudodenials", []string{"TableName", "TableGroup", "PlanID", "Username"}),
+		QueryRuleMatches:       exporter.NewCountersWithSingleLabel("QueryRuleMatches", "Queries that triggered each query rule", "Rule"),
+		QueryRuleRejections:    exporter.NewCountersWithSingleLabel("QueryRuleRejections", "Queries rejected by each query rule", "Rule"),
 
 		UserActiveReservedCount: exporter.NewCountersWithSingleLabel("UserActiveReservedCount", "active reserved connection for each CallerID", "CallerID"),
 		UserReservedCount:       exporter.NewCountersWithSingleLabel("UserReservedCount", "reserved connection received for each CallerID", "CallerID"),
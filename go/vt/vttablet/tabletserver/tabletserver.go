@@ -55,6 +55,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/gc"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
@@ -98,6 +99,12 @@ type TabletServer struct {
 	enableHotRowProtection bool
 	topoServer             *topo.Server
 
+	// hotRowAutocommit is true if the hot row protection also serializes
+	// the autocommit DMLs. hotRowKeyColumns are the columns keying the
+	// rows of the tables listed by the hot row protection config.
+	hotRowAutocommit bool
+	hotRowKeyColumns map[string][]string
+
 	// These are sub-components of TabletServer.
 	statelessql  *QueryList
 	statefulql   *QueryList
@@ -153,6 +160,8 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 		TerseErrors:            config.TerseErrors,
 		enableHotRowProtection: config.HotRowProtection.Mode != tabletenv.Disable,
 		topoServer:             topoServer,
+		hotRowAutocommit:       config.HotRowProtection.Mode != tabletenv.Disable && config.HotRowProtection.Autocommit,
+		hotRowKeyColumns:       config.HotRowProtection.TableKeyColumns(),
 		alias:                  alias,
 	}
 
@@ -169,14 +178,14 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.statefulql = NewQueryList("oltp-stateful")
 	tsv.olapql = NewQueryList("olap")
 	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tabletTypeFunc)
-	tsv.hs = newHealthStreamer(tsv, alias)
 	tsv.se = schema.NewEngine(tsv)
+	tsv.hs = newHealthStreamer(tsv, alias, tsv.se)
 	tsv.rt = repltracker.NewReplTracker(tsv, alias)
 	tsv.vstreamer = vstreamer.NewEngine(tsv, srvTopoServer, tsv.se, tsv.lagThrottler, alias.Cell)
 	tsv.tracker = schema.NewTracker(tsv, tsv.vstreamer, tsv.se)
 	tsv.watcher = NewBinlogWatcher(tsv, tsv.vstreamer, tsv.config)
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
-	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
+	tsv.txThrottler = txthrottler.NewTxThrottler(tsv, topoServer, tsv.lagThrottler)
 	tsv.te = NewTxEngine(tsv)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
@@ -242,6 +251,7 @@ func (tsv *TabletServer) InitDBConfig(target querypb.Target, dbcfgs *dbconfigs.D
 	tsv.onlineDDLExecutor.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
 	tsv.lagThrottler.InitDBConfig(target.Keyspace, target.Shard)
 	tsv.tableGC.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
+	tsv.qe.quarantine.InitDBConfig(tsv.topoServer, target.Keyspace, target.Shard)
 	return nil
 }
 
@@ -470,7 +480,7 @@ func (tsv *TabletServer) begin(ctx context.Context, target *querypb.Target, preQ
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
 			startTime := time.Now()
-			if tsv.txThrottler.Throttle() {
+			if tsv.txThrottler.Throttle(ctx) {
 				return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "Transaction throttled")
 			}
 			var beginSQL string
@@ -717,6 +727,18 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			if err != nil {
 				return err
 			}
+			ctx = withPlanPriority(ctx, plan)
+			if tsv.hotRowAutocommit && transactionID == 0 && reservedID == 0 {
+				// Serialize the autocommit DMLs which target the same hot row
+				// range, like the transactions of BeginExecute.
+				txDone, err := tsv.waitForSameRangeAutocommit(ctx, plan, query, bindVariables)
+				if err != nil {
+					return err
+				}
+				if txDone != nil {
+					defer txDone()
+				}
+			}
 			// If both the values are non-zero then by design they are same value. So, it is safe to overwrite.
 			connID := reservedID
 			if transactionID != 0 {
@@ -794,6 +816,7 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 			if err != nil {
 				return err
 			}
+			ctx = withPlanPriority(ctx, plan)
 			qre := &QueryExecutor{
 				query:          query,
 				marginComments: comments,
@@ -974,6 +997,24 @@ func (tsv *TabletServer) beginWaitForSameRangeTransactions(ctx context.Context,
 	return txDone, err
 }
 
+// waitForSameRangeAutocommit waits, like beginWaitForSameRangeTransactions,
+// until an autocommit DML can run without exceeding the number of
+// concurrent transactions allowed for its row (range).
+func (tsv *TabletServer) waitForSameRangeAutocommit(ctx context.Context, plan *TabletPlan, sql string, bindVariables map[string]*querypb.BindVariable) (txserializer.DoneFunc, error) {
+	k, table := tsv.txSerializerKey(plan, sql, bindVariables)
+	if k == "" {
+		// Query is not subject to tx serialization/hot row protection.
+		return nil, nil
+	}
+
+	startTime := time.Now()
+	done, waited, err := tsv.qe.txSerializer.Wait(ctx, k, table)
+	if waited {
+		tsv.stats.WaitTimings.Record("TxSerializer", startTime)
+	}
+	return done, err
+}
+
 // computeTxSerializerKey returns a unique string ("key") used to determine
 // whether two queries would update the same row (range).
 // Additionally, it returns the table name (needed for updating stats vars).
@@ -987,7 +1028,12 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 		logComputeRowSerializerKey.Errorf("failed to get plan for query: %v err: %v", sql, err)
 		return "", ""
 	}
+	return tsv.txSerializerKey(plan, sql, bindVariables)
+}
 
+// txSerializerKey returns the key and table name of computeTxSerializerKey
+// for a query of the given plan.
+func (tsv *TabletServer) txSerializerKey(plan *TabletPlan, sql string, bindVariables map[string]*querypb.BindVariable) (string, string) {
 	switch plan.PlanID {
 	// Serialize only UPDATE or DELETE queries.
 	case planbuilder.PlanUpdate, planbuilder.PlanUpdateLimit,
@@ -1002,6 +1048,12 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 		return "", ""
 	}
 
+	if columns, ok := tsv.hotRowKeyColumns[tableName.String()]; ok {
+		if key := keyColumnsSerializerKey(plan, columns, bindVariables); key != "" {
+			return key, tableName.String()
+		}
+	}
+
 	where, err := plan.WhereClause.GenerateQuery(bindVariables, nil)
 	if err != nil {
 		logComputeRowSerializerKey.Errorf("failed to substitute bind vars in where clause: %v query: %v bind vars: %v", err, sql, bindVariables)
@@ -1013,6 +1065,49 @@ func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *t
 	return key, tableName.String()
 }
 
+// keyColumnsSerializerKey returns the key of the row which the WHERE
+// clause of the plan identifies by the values of the key columns,
+// e.g. "table1 where id = 1 and sub_id = 2" whatever the other conditions
+// and their order. It returns an empty string if the WHERE clause does not
+// compare all the key columns to a value.
+func keyColumnsSerializerKey(plan *TabletPlan, columns []string, bindVariables map[string]*querypb.BindVariable) string {
+	if len(columns) == 1 && columns[0] == tabletenv.PrimaryKeyColumns {
+		columns = make([]string, 0, len(plan.Table.PKColumns))
+		for _, i := range plan.Table.PKColumns {
+			columns = append(columns, plan.Table.Fields[i].Name)
+		}
+	}
+	if len(columns) == 0 {
+		return ""
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("%v where ", plan.TableName())
+	for i, column := range columns {
+		found := false
+		for _, equality := range plan.WhereEqualities {
+			if !equality.Column.EqualString(column) {
+				continue
+			}
+			value, err := equality.Value.ResolveValue(bindVariables)
+			if err != nil {
+				return ""
+			}
+			if i > 0 {
+				buf.WriteString(" and ")
+			}
+			buf.Myprintf("%v = ", sqlparser.NewColIdent(column))
+			value.EncodeSQL(buf)
+			found = true
+			break
+		}
+		if !found {
+			return ""
+		}
+	}
+	return buf.String()
+}
+
 // BeginExecuteBatch combines Begin and ExecuteBatch.
 func (tsv *TabletServer) BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 	// TODO(mberlin): Integrate hot row protection here as we did for BeginExecute()
@@ -1026,8 +1121,9 @@ func (tsv *TabletServer) BeginExecuteBatch(ctx context.Context, target *querypb.
 	return results, transactionID, alias, err
 }
 
-// MessageStream streams messages from the requested table.
-func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
+// MessageStream streams messages of the consumer group from the requested table.
+// An empty group is the default group.
+func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Target, name, group string, callback func(*sqltypes.Result) error) (err error) {
 	return tsv.execRequest(
 		ctx, 0,
 		"MessageStream", "stream", nil,
@@ -1044,33 +1140,39 @@ func (tsv *TabletServer) MessageStream(ctx context.Context, target *querypb.Targ
 				logStats: logStats,
 				tsv:      tsv,
 			}
-			return qre.MessageStream(callback)
+			return qre.MessageStream(group, callback)
 		},
 	)
 }
 
-// MessageAck acks the list of messages for a given message table.
+// MessageAck acks the list of messages of the consumer group for a given
+// message table. An empty group is the default group.
 // It returns the number of messages successfully acked.
-func (tsv *TabletServer) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error) {
+func (tsv *TabletServer) MessageAck(ctx context.Context, target *querypb.Target, name, group string, ids []*querypb.Value) (count int64, err error) {
 	sids := make([]string, 0, len(ids))
 	for _, val := range ids {
 		sids = append(sids, sqltypes.ProtoToValue(val).ToString())
 	}
 	count, err = tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
-		return tsv.messager.GenerateAckQuery(name, sids)
+		return tsv.messager.GenerateAckQuery(name, group, sids)
 	})
 	if err != nil {
 		return 0, err
 	}
-	messager.MessageStats.Add([]string{name, "Acked"}, count)
+	statsName := name
+	if group != "" {
+		statsName = name + "." + group
+	}
+	messager.MessageStats.Add([]string{statsName, "Acked"}, count)
 	return count, nil
 }
 
-// PostponeMessages postpones the list of messages for a given message table.
+// PostponeMessages postpones the list of messages of the consumer group
+// for a given message table.
 // It returns the number of messages successfully postponed.
-func (tsv *TabletServer) PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+func (tsv *TabletServer) PostponeMessages(ctx context.Context, target *querypb.Target, name, group string, ids []string) (count int64, err error) {
 	return tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
-		return tsv.messager.GeneratePostponeQuery(name, ids)
+		return tsv.messager.GeneratePostponeQuery(name, group, ids)
 	})
 }
 
@@ -1082,14 +1184,32 @@ func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// DeadLetterMessages moves the list of messages of the consumer group that
+// exceeded the max retries of a message table to its dead-letter table.
+// It returns the number of messages successfully moved.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name, group string, ids []string) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]string, map[string]*querypb.BindVariable, error) {
+		return tsv.messager.GenerateDeadLetterQueries(name, group, ids)
+	})
+}
+
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
+	return tsv.execDMLs(ctx, target, func() ([]string, map[string]*querypb.BindVariable, error) {
+		query, bv, err := queryGenerator()
+		return []string{query}, bv, err
+	})
+}
+
+// execDMLs executes the generated queries in a single transaction.
+// It returns the rows affected by the first query.
+func (tsv *TabletServer) execDMLs(ctx context.Context, target *querypb.Target, queryGenerator func() ([]string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
 	if err = tsv.sm.StartRequest(ctx, target, false /* allowOnShutdown */); err != nil {
 		return 0, err
 	}
 	defer tsv.sm.EndRequest()
 	defer tsv.handlePanicAndSendLogStats("ack", nil, nil)
 
-	query, bv, err := queryGenerator()
+	queries, bv, err := queryGenerator()
 	if err != nil {
 		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 	}
@@ -1105,16 +1225,21 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 			tsv.Rollback(ctx, target, transactionID)
 		}
 	}()
-	qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
-	if err != nil {
-		return 0, err
+	for i, query := range queries {
+		qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
+		if err != nil {
+			return 0, err
+		}
+		if i == 0 {
+			count = int64(qr.RowsAffected)
+		}
 	}
 	if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
 		transactionID = 0
 		return 0, err
 	}
 	transactionID = 0
-	return int64(qr.RowsAffected), nil
+	return count, nil
 }
 
 // VStream streams VReplication events.
@@ -1266,6 +1391,9 @@ func (tsv *TabletServer) execRequest(
 		cancel()
 		tsv.sm.EndRequest()
 	}()
+	if priority := options.GetPriority(); priority != querypb.ExecuteOptions_NORMAL {
+		ctx = connpool.NewPriorityContext(ctx, priority)
+	}
 
 	err = exec(ctx, logStats)
 	if err != nil {
@@ -1521,6 +1649,12 @@ func (tsv *TabletServer) TopoServer() *topo.Server {
 	return tsv.topoServer
 }
 
+// HotRows returns the rows (ranges) currently contended by several
+// transactions, as seen by the hot row protection.
+func (tsv *TabletServer) HotRows() []txserializer.HotRow {
+	return tsv.qe.txSerializer.HotRows()
+}
+
 // HandlePanic is part of the queryservice.QueryService interface
 func (tsv *TabletServer) HandlePanic(err *error) {
 	if x := recover(); x != nil {
@@ -1799,6 +1933,36 @@ func (tsv *TabletServer) WarnResultSize() int {
 	return int(tsv.qe.warnResultSize.Get())
 }
 
+// SetMaxResultBytes changes the max result bytes to the specified value.
+func (tsv *TabletServer) SetMaxResultBytes(val int) {
+	tsv.qe.resultMemory.maxQueryBytes.Set(int64(val))
+}
+
+// MaxResultBytes returns the max result bytes.
+func (tsv *TabletServer) MaxResultBytes() int {
+	return int(tsv.qe.resultMemory.maxQueryBytes.Get())
+}
+
+// SetWarnResultBytes changes the warn result bytes to the specified value.
+func (tsv *TabletServer) SetWarnResultBytes(val int) {
+	tsv.qe.resultMemory.warnQueryBytes.Set(int64(val))
+}
+
+// WarnResultBytes returns the warn result bytes.
+func (tsv *TabletServer) WarnResultBytes() int {
+	return int(tsv.qe.resultMemory.warnQueryBytes.Get())
+}
+
+// SetMaxResultMemory changes the max result memory to the specified value.
+func (tsv *TabletServer) SetMaxResultMemory(val int) {
+	tsv.qe.resultMemory.maxTabletBytes.Set(int64(val))
+}
+
+// MaxResultMemory returns the max result memory.
+func (tsv *TabletServer) MaxResultMemory() int {
+	return int(tsv.qe.resultMemory.maxTabletBytes.Get())
+}
+
 // SetPassthroughDMLs changes the setting to pass through all DMLs
 // It should only be used for testing
 func (tsv *TabletServer) SetPassthroughDMLs(val bool) {
@@ -1847,6 +2011,16 @@ func withTimeout(ctx context.Context, timeout time.Duration, options *querypb.Ex
 	return context.WithTimeout(ctx, timeout)
 }
 
+// withPlanPriority returns a context carrying the priority class set by
+// the priority directive of the query, which overrides the priority of
+// the ExecuteOptions.
+func withPlanPriority(ctx context.Context, plan *TabletPlan) context.Context {
+	if !plan.HasPriority {
+		return ctx
+	}
+	return connpool.NewPriorityContext(ctx, plan.Priority)
+}
+
 // skipQueryPlanCache returns true if the query plan should be cached
 func skipQueryPlanCache(options *querypb.ExecuteOptions) bool {
 	if options == nil {
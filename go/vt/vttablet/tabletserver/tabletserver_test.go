@@ -24,6 +24,7 @@ import (
 	"math/rand"
 	"os"
 	"reflect"
+	"runtime"
 	"strings"
 	"sync"
 	"syscall"
@@ -50,6 +51,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -991,6 +993,101 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 	}
 }
 
+func TestSerializeAutocommitDMLsSameRow(t *testing.T) {
+	// The two autocommit DMLs update the same primary key with different
+	// WHERE clauses. As the rows of test_table are keyed by their primary
+	// key, the second DML cannot start until the first one has finished.
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Enable
+	config.HotRowProtection.MaxConcurrency = 1
+	config.HotRowProtection.Autocommit = true
+	config.HotRowProtection.KeyColumns = map[string]string{"test_table": tabletenv.PrimaryKeyColumns}
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	countStart := tsv.stats.WaitTimings.Counts()["TabletServerTest.TxSerializer"]
+
+	q1 := "update test_table set name_string = 'tx1' where pk = :pk and `name` = :name"
+	q2 := "update test_table set name_string = 'tx2' where `name` = 2 and pk = :pk"
+	bvTx1 := map[string]*querypb.BindVariable{
+		"pk":   sqltypes.Int64BindVariable(1),
+		"name": sqltypes.Int64BindVariable(1),
+	}
+	bvTx2 := map[string]*querypb.BindVariable{
+		"pk": sqltypes.Int64BindVariable(1),
+	}
+	db.AddQuery("update test_table set name_string = 'tx2' where `name` = 2 and pk = 1 limit 10001", &sqltypes.Result{RowsAffected: 1})
+
+	tx1Started := make(chan struct{})
+	db.SetBeforeFunc("update test_table set name_string = 'tx1' where pk = 1 and `name` = 1 limit 10001",
+		func() {
+			close(tx1Started)
+			if err := waitForTxSerializationPendingQueries(tsv, "test_table where pk = 1", 2); err != nil {
+				t.Error(err)
+				return
+			}
+			want := []txserializer.HotRow{{Key: "test_table where pk = 1", Table: "test_table", Pending: 2, Max: 2, Count: 2}}
+			assert.Equal(t, want, tsv.HotRows())
+		})
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if _, err := tsv.Execute(ctx, &target, q1, bvTx1, 0, 0, nil); err != nil {
+			t.Errorf("failed to execute query: %s: %s", q1, err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		<-tx1Started
+		if _, err := tsv.Execute(ctx, &target, q2, bvTx2, 0, 0, nil); err != nil {
+			t.Errorf("failed to execute query: %s: %s", q2, err)
+		}
+	}()
+	wg.Wait()
+
+	got := tsv.stats.WaitTimings.Counts()["TabletServerTest.TxSerializer"]
+	assert.Equal(t, countStart+1, got, "the second DML should have been serialized")
+	assert.Empty(t, tsv.HotRows())
+}
+
+func TestTxSerializerKeyColumns(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Enable
+	config.HotRowProtection.KeyColumns = map[string]string{"test_table": "name+pk"}
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	logStats := tabletenv.NewLogStats(ctx, "TxSerializerKeyColumns")
+	bv := map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1)}
+	testcases := []struct {
+		sql  string
+		want string
+	}{{
+		sql:  "update test_table set name_string = 'a' where pk = :pk and `name` = 'x' and addr = 3",
+		want: "test_table where `name` = 'x' and pk = 1",
+	}, {
+		sql:  "delete from test_table where `name` = 'x' and pk = 1",
+		want: "test_table where `name` = 'x' and pk = 1",
+	}, {
+		// Without all the key columns, the WHERE clause is the key.
+		sql:  "update test_table set name_string = 'a' where pk = :pk",
+		want: "test_table where pk = 1",
+	}, {
+		sql:  "update test_table set name_string = 'a' where pk = :pk or `name` = 'x'",
+		want: "test_table where pk = 1 or `name` = 'x'",
+	}}
+	for _, tc := range testcases {
+		key, table := tsv.computeTxSerializerKey(ctx, logStats, tc.sql, bv)
+		assert.Equal(t, tc.want, key, tc.sql)
+		assert.Equal(t, "test_table", table, tc.sql)
+	}
+}
+
 func TestDMLQueryWithoutWhereClause(t *testing.T) {
 	config := tabletenv.NewDefaultConfig()
 	config.HotRowProtection.Mode = tabletenv.Enable
@@ -1560,7 +1657,7 @@ func TestMessageStream(t *testing.T) {
 	defer tsv.StopService()
 	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
 
-	err := tsv.MessageStream(ctx, &target, "nomsg", func(qr *sqltypes.Result) error {
+	err := tsv.MessageStream(ctx, &target, "nomsg", "", func(qr *sqltypes.Result) error {
 		return nil
 	})
 	wantErr := "table nomsg not found in schema"
@@ -1570,7 +1667,7 @@ func TestMessageStream(t *testing.T) {
 
 	// Check that the streaming mechanism works.
 	called := false
-	err = tsv.MessageStream(ctx, &target, "msg", func(qr *sqltypes.Result) error {
+	err = tsv.MessageStream(ctx, &target, "msg", "", func(qr *sqltypes.Result) error {
 		called = true
 		return io.EOF
 	})
@@ -1578,6 +1675,11 @@ func TestMessageStream(t *testing.T) {
 	if !called {
 		t.Fatal("callback was not called for MessageStream")
 	}
+
+	err = tsv.MessageStream(ctx, &target, "msg", "nogroup", func(qr *sqltypes.Result) error {
+		return nil
+	})
+	assert.EqualError(t, err, "consumer group nogroup not found for message table msg")
 }
 
 func TestMessageAck(t *testing.T) {
@@ -1593,43 +1695,106 @@ func TestMessageAck(t *testing.T) {
 		Type:  sqltypes.VarChar,
 		Value: []byte("2"),
 	}}
-	_, err := tsv.MessageAck(ctx, &target, "nonmsg", ids)
+	_, err := tsv.MessageAck(ctx, &target, "nonmsg", "", ids)
 	want := "message table nonmsg not found in schema"
 	if err == nil || strings.HasPrefix(err.Error(), want) {
 		t.Errorf("tsv.MessageAck(invalid): %v, want %s", err, want)
 	}
 
-	_, err = tsv.MessageAck(ctx, &target, "msg", ids)
+	_, err = tsv.MessageAck(ctx, &target, "msg", "", ids)
 	want = "query: 'update msg set time_acked"
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), want)
 
 	db.AddQueryPattern("update msg set time_acked = .*", &sqltypes.Result{RowsAffected: 1})
-	count, err := tsv.MessageAck(ctx, &target, "msg", ids)
+	count, err := tsv.MessageAck(ctx, &target, "msg", "", ids)
 	require.NoError(t, err)
 	if count != 1 {
 		t.Errorf("count: %d, want 1", count)
 	}
 }
 
+func TestMessageAckGroup(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.ClearQueryPattern()
+	db.AddQueryPattern(baseShowTablesPattern, &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("msg_group", false, "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_consumer_groups=g1"),
+		},
+	})
+	db.AddQuery(mysql.BaseShowPrimary, &sqltypes.Result{
+		Fields: mysql.ShowPrimaryFields,
+		Rows: [][]sqltypes.Value{
+			mysql.ShowPrimaryRow("msg_group", "id"),
+		},
+	})
+	db.AddQuery("select * from msg_group where 1 != 1", &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"id|priority|time_next|epoch|time_acked|time_next_g1|epoch_g1|time_acked_g1|message",
+			"int64|int64|int64|int64|int64|int64|int64|int64|varchar",
+		),
+	})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	// The message can only be purged after both groups acked it.
+	purge := db.AddQuery("delete from msg_group where time_acked < 3 and time_acked_g1 < 3 limit 500", &sqltypes.Result{})
+	acked := make(map[string]bool)
+	onAck := func(group string) func(string) {
+		return func(string) {
+			acked[group] = true
+			if acked[""] && acked["g1"] {
+				purge.Result = &sqltypes.Result{RowsAffected: 1}
+			}
+		}
+	}
+	db.AddQueryPatternWithCallback("update msg_group set time_acked = .*", &sqltypes.Result{RowsAffected: 1}, onAck(""))
+	db.AddQueryPatternWithCallback("update msg_group set time_acked_g1 = .*", &sqltypes.Result{RowsAffected: 1}, onAck("g1"))
+
+	ids := []*querypb.Value{{
+		Type:  sqltypes.VarChar,
+		Value: []byte("1"),
+	}}
+	_, err := tsv.MessageAck(ctx, &target, "msg_group", "g2", ids)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "consumer group g2 not found for message table msg_group")
+
+	count, err := tsv.MessageAck(ctx, &target, "msg_group", "", ids)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	count, err = tsv.PurgeMessages(ctx, &target, "msg_group", 3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+
+	count, err = tsv.MessageAck(ctx, &target, "msg_group", "g1", ids)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	count, err = tsv.PurgeMessages(ctx, &target, "msg_group", 3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+}
+
 func TestRescheduleMessages(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
 	defer tsv.StopService()
 	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
 
-	_, err := tsv.PostponeMessages(ctx, &target, "nonmsg", []string{"1", "2"})
+	_, err := tsv.PostponeMessages(ctx, &target, "nonmsg", "", []string{"1", "2"})
 	want := "message table nonmsg not found in schema"
 	if err == nil || strings.HasPrefix(err.Error(), want) {
 		t.Errorf("tsv.PostponeMessages(invalid): %v, want %s", err, want)
 	}
 
-	_, err = tsv.PostponeMessages(ctx, &target, "msg", []string{"1", "2"})
+	_, err = tsv.PostponeMessages(ctx, &target, "msg", "", []string{"1", "2"})
 	want = "query: 'update msg set time_next"
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), want)
 	db.AddQueryPattern("update msg set time_next = .*", &sqltypes.Result{RowsAffected: 1})
-	count, err := tsv.PostponeMessages(ctx, &target, "msg", []string{"1", "2"})
+	count, err := tsv.PostponeMessages(ctx, &target, "msg", "", []string{"1", "2"})
 	require.NoError(t, err)
 	if count != 1 {
 		t.Errorf("count: %d, want 1", count)
@@ -1661,6 +1826,29 @@ func TestPurgeMessages(t *testing.T) {
 	}
 }
 
+func TestDeadLetterMessages(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.DeadLetterMessages(ctx, &target, "nonmsg", "", []string{"1", "2"})
+	want := "message table nonmsg not found in schema"
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), want)
+
+	db.AddQueryPattern("insert into msg_dead\\(priority, epoch, time_dead, id, message\\) select priority, epoch, .* from msg where id in \\('1', '2'\\) and time_acked is null and epoch > 3", &sqltypes.Result{RowsAffected: 2})
+	_, err = tsv.DeadLetterMessages(ctx, &target, "msg", "", []string{"1", "2"})
+	want = "query: 'delete from msg where id in ('1', '2') and time_acked is null and epoch > 3 limit 10001'"
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), want)
+
+	db.AddQuery("delete from msg where id in ('1', '2') and time_acked is null and epoch > 3 limit 10001", &sqltypes.Result{RowsAffected: 2})
+	count, err := tsv.DeadLetterMessages(ctx, &target, "msg", "", []string{"1", "2"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+}
+
 func TestHandleExecUnknownError(t *testing.T) {
 	logStats := tabletenv.NewLogStats(ctx, "TestHandleExecError")
 	config := tabletenv.NewDefaultConfig()
@@ -1993,6 +2181,71 @@ func TestConfigChanges(t *testing.T) {
 	if val := int(tsv.qe.warnResultSize.Get()); val != newSize {
 		t.Errorf("tsv.qe.warnResultSize.Get: %d, want %d", val, newSize)
 	}
+
+	tsv.SetMaxResultBytes(newSize)
+	if val := tsv.MaxResultBytes(); val != newSize {
+		t.Errorf("MaxResultBytes: %d, want %d", val, newSize)
+	}
+
+	tsv.SetWarnResultBytes(newSize)
+	if val := tsv.WarnResultBytes(); val != newSize {
+		t.Errorf("WarnResultBytes: %d, want %d", val, newSize)
+	}
+
+	tsv.SetMaxResultMemory(newSize)
+	if val := tsv.MaxResultMemory(); val != newSize {
+		t.Errorf("MaxResultMemory: %d, want %d", val, newSize)
+	}
+}
+
+func TestAdaptivePools(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.OltpReadPool.MinSize = 4
+	config.OltpReadPool.MaxSize = 32
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	pools := tsv.adaptivePools()
+	require.Len(t, pools, 1)
+	assert.Equal(t, "ConnPool", pools[0].name)
+	assert.Equal(t, int64(16), pools[0].Capacity())
+	assert.Equal(t, int64(32), pools[0].MaxCap())
+}
+
+func TestExecutePriority(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.OltpReadPool.Size = 1
+	config.OltpReadPool.LowPriorityMaxWaiters = 1
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	conn, err := tsv.qe.conns.Get(ctx)
+	require.NoError(t, err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := tsv.Execute(ctx, &target, "select 42", nil, 0, 0, &querypb.ExecuteOptions{})
+		assert.NoError(t, err)
+	}()
+	for tsv.qe.conns.WaitersByPriority()["NORMAL"] != 1 {
+		runtime.Gosched()
+	}
+
+	// The low priority queries are rejected while the pool is overloaded.
+	// They differ from the waiting query so as not to be consolidated.
+	_, err = tsv.Execute(ctx, &target, "select 43", nil, 0, 0, &querypb.ExecuteOptions{Priority: querypb.ExecuteOptions_LOW})
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "low priority request rejected")
+	// The priority directive overrides the priority of the options.
+	_, err = tsv.Execute(ctx, &target, "select /*vt+ PRIORITY=low */ 42", nil, 0, 0, &querypb.ExecuteOptions{Priority: querypb.ExecuteOptions_HIGH})
+	assert.Contains(t, err.Error(), "low priority request rejected")
+
+	conn.Recycle()
+	<-done
 }
 
 func TestReserveBeginExecute(t *testing.T) {
@@ -21,6 +21,7 @@ package txserializer
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -165,7 +166,7 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 	q, ok := txs.queues[key]
 	if !ok {
 		// First transaction in the queue i.e. we don't wait and return immediately.
-		txs.queues[key] = newQueueForFirstTransaction(txs.concurrentTransactions)
+		txs.queues[key] = newQueueForFirstTransaction(table, txs.concurrentTransactions)
 		txs.globalSize++
 		return false, nil
 	}
@@ -304,7 +305,50 @@ func (txs *TxSerializer) Pending(key string) int {
 	return q.size
 }
 
-// ServeHTTP lists the most recent, cached queries and their count.
+// HotRow is a row (range) for which more than one transaction is
+// currently in flight or queued.
+type HotRow struct {
+	// Key identifies the row (range) e.g. by table name and WHERE clause.
+	Key   string
+	Table string
+	// Pending is the number of transactions in flight or queued.
+	Pending int
+	// Max is the maximum of Pending since the row was last idle.
+	Max int
+	// Count is the number of transactions since the row was last idle.
+	Count int
+}
+
+// HotRows returns the rows (ranges) which are currently hot, the most
+// contended ones first.
+func (txs *TxSerializer) HotRows() []HotRow {
+	txs.mu.Lock()
+	var hotRows []HotRow
+	for key, q := range txs.queues {
+		if q.size < 2 {
+			continue
+		}
+		hotRows = append(hotRows, HotRow{
+			Key:     key,
+			Table:   q.table,
+			Pending: q.size,
+			Max:     q.max,
+			Count:   q.count,
+		})
+	}
+	txs.mu.Unlock()
+
+	sort.Slice(hotRows, func(i, j int) bool {
+		if hotRows[i].Pending != hotRows[j].Pending {
+			return hotRows[i].Pending > hotRows[j].Pending
+		}
+		return hotRows[i].Key < hotRows[j].Key
+	})
+	return hotRows
+}
+
+// ServeHTTP lists the most recent, cached queries and their count,
+// followed by the rows which are currently hot.
 func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	if *streamlog.RedactDebugUIQueries {
 		response.Write([]byte(`
@@ -327,11 +371,20 @@ func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.R
 	response.Header().Set("Content-Type", "text/plain")
 	if items == nil {
 		response.Write([]byte("empty\n"))
+	} else {
+		response.Write([]byte(fmt.Sprintf("Length: %d\n", len(items))))
+		for _, v := range items {
+			response.Write([]byte(fmt.Sprintf("%v: %s\n", v.Count, v.Query)))
+		}
+	}
+
+	hotRows := txs.HotRows()
+	if len(hotRows) == 0 {
 		return
 	}
-	response.Write([]byte(fmt.Sprintf("Length: %d\n", len(items))))
-	for _, v := range items {
-		response.Write([]byte(fmt.Sprintf("%v: %s\n", v.Count, v.Query)))
+	response.Write([]byte(fmt.Sprintf("\nCurrent hot rows: %d\n", len(hotRows))))
+	for _, hotRow := range hotRows {
+		response.Write([]byte(fmt.Sprintf("%v pending (max: %v, total: %v): %s\n", hotRow.Pending, hotRow.Max, hotRow.Count, hotRow.Key)))
 	}
 }
 
@@ -344,6 +397,9 @@ func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.R
 // transactions which can access the tx pool). All queued transactions are
 // competing for these slots and try to add themselves to the channel.
 type queue struct {
+	// table is the table of the row (range).
+	table string
+
 	// NOTE: The following fields are guarded by TxSerializer.mu.
 	// size counts how many transactions are currently queued/in flight (includes
 	// the transactions which are not waiting.)
@@ -364,8 +420,9 @@ type queue struct {
 	availableSlots chan struct{}
 }
 
-func newQueueForFirstTransaction(concurrentTransactions int) *queue {
+func newQueueForFirstTransaction(table string, concurrentTransactions int) *queue {
 	return &queue{
+		table: table,
 		size:  1,
 		count: 1,
 		max:   1,
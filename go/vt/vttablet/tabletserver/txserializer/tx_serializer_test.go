@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -521,6 +522,62 @@ func TestTxSerializerPending(t *testing.T) {
 	}
 }
 
+func TestTxSerializerHotRows(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxConcurrency = 1
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	// A row without concurrent transactions is not hot.
+	done1, _, err := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done2, _, err := txs.Wait(context.Background(), "t2 where2", "t2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := txs.HotRows(); len(got) != 0 {
+		t.Errorf("there should be no hot row: got = %v", got)
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		done3, _, err := txs.Wait(context.Background(), "t1 where1", "t1")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		done3()
+	}()
+	if err := waitForPending(txs, "t1 where1", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []HotRow{{Key: "t1 where1", Table: "t1", Pending: 2, Max: 2, Count: 2}}
+	if got := txs.HotRows(); !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong hot rows: got = %v, want = %v", got, want)
+	}
+	req, err := http.NewRequest("GET", "/path-is-ignored-in-test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	txs.ServeHTTP(rr, req)
+	if got, want := rr.Body.String(), "\nCurrent hot rows: 1\n2 pending (max: 2, total: 2): t1 where1\n"; !strings.HasSuffix(got, want) {
+		t.Errorf("wrong content: got = \n%v\n want suffix = \n%v", got, want)
+	}
+
+	done1()
+	wg.Wait()
+	done2()
+	if got := txs.HotRows(); len(got) != 0 {
+		t.Errorf("there should be no hot row: got = %v", got)
+	}
+}
+
 func BenchmarkTxSerializer_NoHotRow(b *testing.B) {
 	config := tabletenv.NewDefaultConfig()
 	config.HotRowProtection.MaxQueueSize = 1
@@ -0,0 +1,267 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txthrottler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+)
+
+// Priority is the priority of a transaction for the throttler.
+type Priority int
+
+const (
+	// PriorityInteractive is the priority of the interactive traffic.
+	PriorityInteractive Priority = iota
+	// PriorityBatch is the priority of the transactions of the batch
+	// callers, which are throttled before the interactive ones.
+	PriorityBatch
+)
+
+func (p Priority) String() string {
+	if p == PriorityBatch {
+		return "Batch"
+	}
+	return "Interactive"
+}
+
+// Signal is a source of load checked by the transaction throttler
+// besides the replication lag.
+type Signal interface {
+	// Name is the name of the signal in the stats.
+	Name() string
+	// Refresh reads the current load. It's called at every signal
+	// interval.
+	Refresh(ctx context.Context) error
+	// Throttle returns true if a transaction of the priority must be
+	// throttled, according to the load read by the last Refresh.
+	Throttle(priority Priority) bool
+}
+
+// QueryFunc runs a query on MySQL.
+type QueryFunc func(ctx context.Context, query string) (*sqltypes.Result, error)
+
+// SignalEnv is what the signal factories get to create their signal.
+type SignalEnv struct {
+	// Config is the config of the signals.
+	Config tabletenv.TxThrottlerSignalsConfig
+	// Query runs the queries of the signals that read from MySQL.
+	Query QueryFunc
+	// LagThrottler is the tablet throttler, or nil if it's not enabled.
+	LagThrottler *throttle.Throttler
+}
+
+// SignalFactory creates a signal, or returns nil if the signal is not
+// configured.
+type SignalFactory func(env SignalEnv) Signal
+
+var (
+	signalFactories    = make(map[string]SignalFactory)
+	signalFactoryNames []string
+)
+
+// RegisterSignalFactory allows modules to register the signals checked
+// by the transaction throttler. Should be called on init(). The signals
+// are checked in the order they were registered.
+func RegisterSignalFactory(name string, factory SignalFactory) {
+	if _, ok := signalFactories[name]; ok {
+		log.Fatalf("RegisterSignalFactory %s already exists", name)
+	}
+	signalFactories[name] = factory
+	signalFactoryNames = append(signalFactoryNames, name)
+}
+
+// newSignals creates the configured signals.
+func newSignals(env SignalEnv) []Signal {
+	var signals []Signal
+	for _, name := range signalFactoryNames {
+		if signal := signalFactories[name](env); signal != nil {
+			signals = append(signals, signal)
+		}
+	}
+	return signals
+}
+
+func init() {
+	RegisterSignalFactory("TabletThrottler", func(env SignalEnv) Signal {
+		if !env.Config.CheckTabletThrottler {
+			return nil
+		}
+		return newThrottleCheckSignal(env.LagThrottler, env.Config.BatchThresholdRatio)
+	})
+	RegisterSignalFactory("ThreadsRunning", func(env SignalEnv) Signal {
+		if env.Config.MaxThreadsRunning <= 0 {
+			return nil
+		}
+		return newThreadsRunningSignal(env.Query, float64(env.Config.MaxThreadsRunning), env.Config.BatchThresholdRatio)
+	})
+	RegisterSignalFactory("HistoryListLength", func(env SignalEnv) Signal {
+		if env.Config.MaxHistoryListLength <= 0 {
+			return nil
+		}
+		return newHistoryListLengthSignal(env.Query, float64(env.Config.MaxHistoryListLength), env.Config.BatchThresholdRatio)
+	})
+	RegisterSignalFactory("CustomQuery", func(env SignalEnv) Signal {
+		if env.Config.CustomQuery == "" {
+			return nil
+		}
+		return newCustomQuerySignal(env.Query, env.Config.CustomQuery, env.Config.CustomQueryThreshold, env.Config.BatchThresholdRatio)
+	})
+}
+
+// metricSignal is a signal whose load is a number read from MySQL, that
+// throttles the transactions when it reaches its threshold, or when it
+// reaches its batch threshold for the batch transactions.
+type metricSignal struct {
+	name           string
+	threshold      float64
+	batchThreshold float64
+	read           func(ctx context.Context) (float64, error)
+	// value are the bits of the last value read.
+	value uint64
+}
+
+func newMetricSignal(name string, threshold, batchRatio float64, read func(ctx context.Context) (float64, error)) *metricSignal {
+	return &metricSignal{
+		name:           name,
+		threshold:      threshold,
+		batchThreshold: threshold * batchRatio,
+		read:           read,
+	}
+}
+
+// newThreadsRunningSignal returns the signal of the MySQL Threads_running.
+func newThreadsRunningSignal(query QueryFunc, threshold, batchRatio float64) Signal {
+	return newMetricSignal("ThreadsRunning", threshold, batchRatio, func(ctx context.Context) (float64, error) {
+		return queryValue(ctx, query, "show global status like 'Threads_running'", 1)
+	})
+}
+
+// newHistoryListLengthSignal returns the signal of the InnoDB history list
+// length, the number of the undo log records not purged yet.
+func newHistoryListLengthSignal(query QueryFunc, threshold, batchRatio float64) Signal {
+	return newMetricSignal("HistoryListLength", threshold, batchRatio, func(ctx context.Context) (float64, error) {
+		return queryValue(ctx, query, "select count from information_schema.innodb_metrics where name = 'trx_rseg_history_len'", 0)
+	})
+}
+
+// newCustomQuerySignal returns the signal of the number returned by
+// a custom query.
+func newCustomQuerySignal(query QueryFunc, sql string, threshold, batchRatio float64) Signal {
+	return newMetricSignal("CustomQuery", threshold, batchRatio, func(ctx context.Context) (float64, error) {
+		return queryValue(ctx, query, sql, 0)
+	})
+}
+
+// queryValue returns the number in the column of the single row returned
+// by the query.
+func queryValue(ctx context.Context, query QueryFunc, sql string, column int) (float64, error) {
+	qr, err := query(ctx, sql)
+	if err != nil {
+		return 0, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) <= column {
+		return 0, fmt.Errorf("unexpected result for %q: %v", sql, qr.Rows)
+	}
+	return strconv.ParseFloat(qr.Rows[0][column].ToString(), 64)
+}
+
+func (ms *metricSignal) Name() string {
+	return ms.name
+}
+
+// Refresh is part of the Signal interface. If the value can't be read,
+// the signal stops throttling until the next Refresh.
+func (ms *metricSignal) Refresh(ctx context.Context) error {
+	value, err := ms.read(ctx)
+	if err != nil {
+		value = 0
+	}
+	atomic.StoreUint64(&ms.value, math.Float64bits(value))
+	return err
+}
+
+func (ms *metricSignal) Throttle(priority Priority) bool {
+	value := math.Float64frombits(atomic.LoadUint64(&ms.value))
+	if priority == PriorityBatch {
+		return value >= ms.batchThreshold
+	}
+	return value >= ms.threshold
+}
+
+// throttleCheckSignal is the signal of the check of the tablet
+// throttler of the shard. The interactive transactions are throttled
+// while the check fails, and the batch ones as soon as the metric of
+// the check reaches the batch ratio of its threshold.
+type throttleCheckSignal struct {
+	check      func(ctx context.Context) *throttle.CheckResult
+	batchRatio float64
+	// throttled is 1 if the interactive transactions are throttled,
+	// batchThrottled if the batch ones are.
+	throttled      int32
+	batchThrottled int32
+}
+
+// TxThrottlerAppName is the app name of the transaction throttler
+// in the checks of the tablet throttler.
+const TxThrottlerAppName = "tx-throttler"
+
+func newThrottleCheckSignal(lagThrottler *throttle.Throttler, batchRatio float64) Signal {
+	return &throttleCheckSignal{
+		check: func(ctx context.Context) *throttle.CheckResult {
+			return lagThrottler.CheckByType(ctx, TxThrottlerAppName, "", throttle.StandardCheckFlags, throttle.ThrottleCheckPrimaryWrite)
+		},
+		batchRatio: batchRatio,
+	}
+}
+
+func (tc *throttleCheckSignal) Name() string {
+	return "TabletThrottler"
+}
+
+func (tc *throttleCheckSignal) Refresh(ctx context.Context) error {
+	result := tc.check(ctx)
+	// The app is denied if it was throttled through the tablet throttler.
+	throttled := result.StatusCode == http.StatusTooManyRequests || result.StatusCode == http.StatusExpectationFailed
+	batchThrottled := throttled || (result.StatusCode == http.StatusOK && result.Threshold > 0 && result.Value >= result.Threshold*tc.batchRatio)
+	atomic.StoreInt32(&tc.throttled, boolToInt32(throttled))
+	atomic.StoreInt32(&tc.batchThrottled, boolToInt32(batchThrottled))
+	return nil
+}
+
+func (tc *throttleCheckSignal) Throttle(priority Priority) bool {
+	if priority == PriorityBatch {
+		return atomic.LoadInt32(&tc.batchThrottled) == 1
+	}
+	return atomic.LoadInt32(&tc.throttled) == 1
+}
+
+func boolToInt32(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -25,11 +25,17 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/throttler"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	throttlerdatapb "vitess.io/vitess/go/vt/proto/throttlerdata"
@@ -40,9 +46,15 @@ import (
 // It's a thin wrapper around the throttler found in vitess/go/vt/throttler.
 // It uses a discovery.LegacyHealthCheck to send replication-lag updates to the wrapped throttler.
 //
+// Besides the replication lag, it can also throttle transactions on other
+// signals: the check of the tablet throttler, the MySQL Threads_running,
+// the InnoDB history list length, a custom MySQL query and the signals
+// registered with RegisterSignalFactory. The transactions of the batch
+// callers are throttled before the interactive ones.
+//
 // Intended Usage:
 //   // Assuming topoServer is a topo.Server variable pointing to a Vitess topology server.
-//   t := NewTxThrottler(config, topoServer)
+//   t := NewTxThrottler(env, topoServer, lagThrottler)
 //
 //   // A transaction throttler must be opened before its first use:
 //   if err := t.Open(keyspace, shard); err != nil {
@@ -50,7 +62,7 @@ import (
 //   }
 //
 //   // Checking whether to throttle can be done as follows before starting a transaction.
-//   if t.Throttle() {
+//   if t.Throttle(ctx) {
 //     return fmt.Errorf("Transaction throttled!")
 //   } else {
 //     // execute transaction.
@@ -73,6 +85,9 @@ type TxThrottler struct {
 	state *txThrottlerState
 
 	target querypb.Target
+
+	// throttled counts the throttled transactions by signal and priority.
+	throttled *stats.CountersWithMultiLabels
 }
 
 // NewTxThrottler tries to construct a TxThrottler from the
@@ -80,8 +95,8 @@ type TxThrottler struct {
 // any error occurs.
 // This function calls tryCreateTxThrottler that does the actual creation work
 // and returns an error if one occurred.
-func NewTxThrottler(config *tabletenv.TabletConfig, topoServer *topo.Server) *TxThrottler {
-	txThrottler, err := tryCreateTxThrottler(config, topoServer)
+func NewTxThrottler(env tabletenv.Env, topoServer *topo.Server, lagThrottler *throttle.Throttler) *TxThrottler {
+	txThrottler, err := tryCreateTxThrottler(env, topoServer, lagThrottler)
 	if err != nil {
 		log.Errorf("Error creating transaction throttler. Transaction throttling will"+
 			" be disabled. Error: %v", err)
@@ -100,7 +115,8 @@ func (t *TxThrottler) InitDBConfig(target querypb.Target) {
 	t.target = target
 }
 
-func tryCreateTxThrottler(config *tabletenv.TabletConfig, topoServer *topo.Server) (*TxThrottler, error) {
+func tryCreateTxThrottler(env tabletenv.Env, topoServer *topo.Server, lagThrottler *throttle.Throttler) (*TxThrottler, error) {
+	config := env.Config()
 	if !config.EnableTxThrottler {
 		return newTxThrottler(&txThrottlerConfig{enabled: false})
 	}
@@ -115,12 +131,33 @@ func tryCreateTxThrottler(config *tabletenv.TabletConfig, topoServer *topo.Serve
 	healthCheckCells := make([]string, len(config.TxThrottlerHealthCheckCells))
 	copy(healthCheckCells, config.TxThrottlerHealthCheckCells)
 
-	return newTxThrottler(&txThrottlerConfig{
+	signals := config.TxThrottlerSignals
+	if signals.CheckTabletThrottler && (lagThrottler == nil || !config.EnableLagThrottler) {
+		return nil, fmt.Errorf("tx_throttler_check_tablet_throttler requires the tablet throttler to be enabled")
+	}
+	if signals.CustomQuery != "" && signals.CustomQueryThreshold <= 0 {
+		return nil, fmt.Errorf("tx_throttler_custom_query requires a positive tx_throttler_custom_query_threshold")
+	}
+	batchCallers := make(map[string]bool, len(signals.BatchCallers))
+	for _, caller := range signals.BatchCallers {
+		batchCallers[caller] = true
+	}
+
+	txThrottler, err := newTxThrottler(&txThrottlerConfig{
 		enabled:          true,
 		topoServer:       topoServer,
 		throttlerConfig:  &throttlerConfig,
 		healthCheckCells: healthCheckCells,
+		env:              env,
+		lagThrottler:     lagThrottler,
+		signals:          signals,
+		batchCallers:     batchCallers,
 	})
+	if err != nil {
+		return nil, err
+	}
+	txThrottler.throttled = env.Exporter().NewCountersWithMultiLabels("TransactionThrottlerThrottled", "Transactions throttled by the transaction throttler", []string{"Signal", "Priority"})
+	return txThrottler, nil
 }
 
 // txThrottlerConfig holds the parameters that need to be
@@ -136,6 +173,13 @@ type txThrottlerConfig struct {
 	// healthCheckCells stores the cell names in which running vttablets will be monitored for
 	// replication lag.
 	healthCheckCells []string
+
+	env          tabletenv.Env
+	lagThrottler *throttle.Throttler
+	signals      tabletenv.TxThrottlerSignalsConfig
+	// batchCallers are the principals and usernames of the callers whose
+	// transactions have the batch priority.
+	batchCallers map[string]bool
 }
 
 // ThrottlerInterface defines the public interface that is implemented by go/vt/throttler.Throttler
@@ -169,6 +213,21 @@ type txThrottlerState struct {
 
 	healthCheck      discovery.LegacyHealthCheck
 	topologyWatchers []TopologyWatcherInterface
+
+	// signals are the signals checked besides the replication lag,
+	// refreshed by signalTicks.
+	signals     []Signal
+	signalTicks *timer.Timer
+	// pool is used by the signals that read from MySQL. It's opened by
+	// their first query, and protected by poolMu.
+	env    tabletenv.Env
+	poolMu sync.Mutex
+	pool   *connpool.Pool
+
+	// lastInteractiveThrottle is when the replication lag last throttled
+	// an interactive transaction, in unix nanoseconds. It's protected
+	// by throttleMu.
+	lastInteractiveThrottle int64
 }
 
 // These vars store the functions used to create the topo server, healthcheck,
@@ -251,14 +310,34 @@ func (t *TxThrottler) Close() {
 // It returns true if the transaction should not proceed (the caller
 // should back off). Throttle requires that Open() was previously called
 // successfully.
-func (t *TxThrottler) Throttle() (result bool) {
+func (t *TxThrottler) Throttle(ctx context.Context) (result bool) {
 	if !t.config.enabled {
 		return false
 	}
 	if t.state == nil {
 		panic("BUG: Throttle() called on a closed TxThrottler")
 	}
-	return t.state.throttle()
+	priority := t.priority(ctx)
+	signal := t.state.throttle(priority)
+	if signal == "" {
+		return false
+	}
+	t.throttled.Add([]string{signal, priority.String()}, 1)
+	return true
+}
+
+// priority returns the priority of the transactions of the caller.
+func (t *TxThrottler) priority(ctx context.Context) Priority {
+	if len(t.config.batchCallers) == 0 {
+		return PriorityInteractive
+	}
+	if principal := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)); principal != "" && t.config.batchCallers[principal] {
+		return PriorityBatch
+	}
+	if username := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx)); username != "" && t.config.batchCallers[username] {
+		return PriorityBatch
+	}
+	return PriorityInteractive
 }
 
 func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
@@ -278,6 +357,7 @@ func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
 	}
 	result := &txThrottlerState{
 		throttler: t,
+		env:       config.env,
 	}
 	result.healthCheck = healthCheckFactory()
 	result.healthCheck.SetListener(result, false /* sendDownEvents */)
@@ -295,19 +375,85 @@ func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
 				discovery.DefaultTopologyWatcherRefreshInterval,
 				discovery.DefaultTopoReadConcurrency))
 	}
+
+	result.signals = newSignals(SignalEnv{
+		Config:       config.signals,
+		Query:        result.query,
+		LagThrottler: config.lagThrottler,
+	})
+	result.signalTicks = timer.NewTimer(config.signals.IntervalSeconds.Get())
+	if len(result.signals) > 0 {
+		result.signalTicks.Start(result.refreshSignals)
+	}
 	return result, nil
 }
 
-func (ts *txThrottlerState) throttle() bool {
+// query runs a query for the signals that read from MySQL.
+func (ts *txThrottlerState) query(ctx context.Context, query string) (*sqltypes.Result, error) {
+	ts.poolMu.Lock()
+	if ts.pool == nil {
+		ts.pool = connpool.NewPool(ts.env, "TxThrottlerPool", tabletenv.ConnPoolConfig{
+			Size:               1,
+			IdleTimeoutSeconds: ts.env.Config().OltpReadPool.IdleTimeoutSeconds,
+		})
+		dbaParams := ts.env.Config().DB.DbaWithDB()
+		ts.pool.Open(dbaParams, dbaParams, dbaParams)
+	}
+	pool := ts.pool
+	ts.poolMu.Unlock()
+
+	conn, err := pool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	return conn.Exec(ctx, query, 1, false)
+}
+
+// refreshSignals reads the current load of all the signals.
+func (ts *txThrottlerState) refreshSignals() {
+	ctx, cancel := context.WithTimeout(context.Background(), ts.signalTicks.Interval())
+	defer cancel()
+	for _, signal := range ts.signals {
+		if err := signal.Refresh(ctx); err != nil {
+			log.Warningf("TxThrottler: could not refresh signal %s: %v", signal.Name(), err)
+		}
+	}
+}
+
+// throttle returns the name of the signal that throttles a transaction
+// of the priority, or "" if the transaction can proceed.
+func (ts *txThrottlerState) throttle(priority Priority) string {
 	if ts.throttler == nil {
 		panic("BUG: throttle called after deallocateResources was called.")
 	}
+	for _, signal := range ts.signals {
+		if signal.Throttle(priority) {
+			return signal.Name()
+		}
+	}
 	// Serialize calls to ts.throttle.Throttle()
 	ts.throttleMu.Lock()
 	defer ts.throttleMu.Unlock()
-	return ts.throttler.Throttle(0 /* threadId */) > 0
+	now := time.Now()
+	// The batch transactions back off as long as the replication lag
+	// recently throttled interactive ones, leaving them the available rate.
+	if priority == PriorityBatch && now.Sub(time.Unix(0, ts.lastInteractiveThrottle)) < ts.signalTicks.Interval() {
+		return replicationLagSignal
+	}
+	if ts.throttler.Throttle(0 /* threadId */) <= 0 {
+		return ""
+	}
+	if priority == PriorityInteractive {
+		ts.lastInteractiveThrottle = now.UnixNano()
+	}
+	return replicationLagSignal
 }
 
+// replicationLagSignal is the name in the stats of the replication lag
+// checked by the wrapped go/vt/throttler.
+const replicationLagSignal = "ReplicationLag"
+
 func (ts *txThrottlerState) deallocateResources() {
 	// We don't really need to nil out the fields here
 	// as deallocateResources is not expected to be called
@@ -324,6 +470,15 @@ func (ts *txThrottlerState) deallocateResources() {
 	// to be executing, so we can safely close the throttler.
 	ts.throttler.Close()
 	ts.throttler = nil
+
+	ts.signalTicks.Stop()
+	ts.signals = nil
+	ts.poolMu.Lock()
+	defer ts.poolMu.Unlock()
+	if ts.pool != nil {
+		ts.pool.Close()
+		ts.pool = nil
+	}
 }
 
 // StatsUpdate is part of the LegacyHealthCheckStatsListener interface.
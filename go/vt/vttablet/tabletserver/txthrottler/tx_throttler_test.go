@@ -22,15 +22,25 @@ package txthrottler
 //go:generate mockgen -destination mock_topology_watcher_test.go -package txthrottler vitess.io/vitess/go/vt/vttablet/tabletserver/txthrottler TopologyWatcherInterface
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -39,7 +49,7 @@ import (
 func TestDisabledThrottler(t *testing.T) {
 	config := tabletenv.NewDefaultConfig()
 	config.EnableTxThrottler = false
-	throttler := NewTxThrottler(config, nil)
+	throttler := NewTxThrottler(tabletenv.NewEnv(config, "TxThrottlerTest"), nil, nil)
 	throttler.InitDBConfig(querypb.Target{
 		Keyspace: "keyspace",
 		Shard:    "shard",
@@ -47,7 +57,7 @@ func TestDisabledThrottler(t *testing.T) {
 	if err := throttler.Open(); err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
-	if result := throttler.Throttle(); result != false {
+	if result := throttler.Throttle(context.Background()); result != false {
 		t.Errorf("want: false, got: %v", result)
 	}
 	throttler.Close()
@@ -118,7 +128,7 @@ func TestEnabledThrottler(t *testing.T) {
 	config.EnableTxThrottler = true
 	config.TxThrottlerHealthCheckCells = []string{"cell1", "cell2"}
 
-	throttler, err := tryCreateTxThrottler(config, ts)
+	throttler, err := tryCreateTxThrottler(tabletenv.NewEnv(config, "TxThrottlerTest"), ts, nil)
 	if err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
@@ -129,7 +139,7 @@ func TestEnabledThrottler(t *testing.T) {
 	if err := throttler.Open(); err != nil {
 		t.Fatalf("want: nil, got: %v", err)
 	}
-	if result := throttler.Throttle(); result != false {
+	if result := throttler.Throttle(context.Background()); result != false {
 		t.Errorf("want: false, got: %v", result)
 	}
 	hcListener.StatsUpdate(tabletStats)
@@ -141,8 +151,200 @@ func TestEnabledThrottler(t *testing.T) {
 	// This call should not be forwarded to the go/vt/throttler.Throttler object.
 	hcListener.StatsUpdate(rdonlyTabletStats)
 	// The second throttle call should reject.
-	if result := throttler.Throttle(); result != true {
+	if result := throttler.Throttle(context.Background()); result != true {
 		t.Errorf("want: true, got: %v", result)
 	}
 	throttler.Close()
 }
+
+// newMockedThrottler returns an open transaction throttler whose
+// replication lag throttler is mocked.
+func newMockedThrottler(t *testing.T, mockCtrl *gomock.Controller, config *tabletenv.TabletConfig) (*TxThrottler, *MockThrottlerInterface) {
+	mockHealthCheck := NewMockHealthCheck(mockCtrl)
+	mockHealthCheck.EXPECT().SetListener(gomock.Any(), false /* sendDownEvents */)
+	mockHealthCheck.EXPECT().Close()
+	healthCheckFactory = func() discovery.LegacyHealthCheck { return mockHealthCheck }
+	topologyWatcherFactory = func(topoServer *topo.Server, tr discovery.LegacyTabletRecorder, cell, keyspace, shard string, refreshInterval time.Duration, topoReadConcurrency int) TopologyWatcherInterface {
+		result := NewMockTopologyWatcherInterface(mockCtrl)
+		result.EXPECT().Stop()
+		return result
+	}
+	mockThrottler := NewMockThrottlerInterface(mockCtrl)
+	mockThrottler.EXPECT().UpdateConfiguration(gomock.Any(), true /* copyZeroValues */)
+	mockThrottler.EXPECT().Close()
+	throttlerFactory = func(name, unit string, threadCount int, maxRate, maxReplicationLag int64) (ThrottlerInterface, error) {
+		return mockThrottler, nil
+	}
+
+	config.EnableTxThrottler = true
+	config.TxThrottlerHealthCheckCells = []string{"cell1"}
+	// The signals are refreshed by the tests.
+	config.TxThrottlerSignals.IntervalSeconds = 3600
+	throttler, err := tryCreateTxThrottler(tabletenv.NewEnv(config, "TxThrottlerTest"), memorytopo.NewServer("cell1"), nil)
+	require.NoError(t, err)
+	throttler.InitDBConfig(querypb.Target{
+		Keyspace: "keyspace",
+		Shard:    "shard",
+	})
+	require.NoError(t, throttler.Open())
+	return throttler, mockThrottler
+}
+
+func batchContext() context.Context {
+	return callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("batch", "", ""), nil)
+}
+
+func TestThrottlerMySQLSignals(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	defer resetTxThrottlerFactories()
+
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("show global status like 'Threads_running'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+		"Threads_running|45",
+	))
+	db.AddQuery("select count(*) from big_table", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("count(*)", "int64"),
+		"10",
+	))
+
+	params, err := db.ConnParams().MysqlParams()
+	require.NoError(t, err)
+	config := tabletenv.NewDefaultConfig()
+	config.DB = dbconfigs.NewTestDBConfigs(*params, *params, "")
+	config.TxThrottlerSignals.MaxThreadsRunning = 50
+	config.TxThrottlerSignals.CustomQuery = "select count(*) from big_table"
+	config.TxThrottlerSignals.CustomQueryThreshold = 100
+	config.TxThrottlerSignals.BatchCallers = []string{"batch"}
+	throttler, mockThrottler := newMockedThrottler(t, mockCtrl, config)
+	defer throttler.Close()
+	throttled := throttler.throttled.Counts()
+
+	throttler.state.refreshSignals()
+	// Threads_running is above the batch threshold of 40, but below
+	// the interactive threshold of 50.
+	assert.True(t, throttler.Throttle(batchContext()))
+	mockThrottler.EXPECT().Throttle(0).Return(0 * time.Second)
+	assert.False(t, throttler.Throttle(context.Background()))
+
+	db.AddQuery("show global status like 'Threads_running'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+		"Threads_running|10",
+	))
+	db.AddQuery("select count(*) from big_table", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("count(*)", "int64"),
+		"100",
+	))
+	throttler.state.refreshSignals()
+	assert.True(t, throttler.Throttle(context.Background()))
+
+	// A signal that can't be read does not throttle.
+	db.AddRejectedQuery("select count(*) from big_table", fmt.Errorf("table is gone"))
+	throttler.state.refreshSignals()
+	mockThrottler.EXPECT().Throttle(0).Return(0 * time.Second)
+	assert.False(t, throttler.Throttle(context.Background()))
+
+	counts := throttler.throttled.Counts()
+	assert.Equal(t, int64(1), counts["ThreadsRunning.Batch"]-throttled["ThreadsRunning.Batch"])
+	assert.Equal(t, int64(1), counts["CustomQuery.Interactive"]-throttled["CustomQuery.Interactive"])
+}
+
+func TestThrottlerBatchPriority(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	defer resetTxThrottlerFactories()
+
+	config := tabletenv.NewDefaultConfig()
+	config.TxThrottlerSignals.BatchCallers = []string{"batch"}
+	throttler, mockThrottler := newMockedThrottler(t, mockCtrl, config)
+	defer throttler.Close()
+	throttled := throttler.throttled.Counts()
+
+	mockThrottler.EXPECT().Throttle(0).Return(0 * time.Second)
+	assert.False(t, throttler.Throttle(batchContext()))
+	mockThrottler.EXPECT().Throttle(0).Return(1 * time.Second)
+	assert.True(t, throttler.Throttle(context.Background()))
+	// The batch transactions back off without taking from the rate
+	// of the replication lag throttler.
+	assert.True(t, throttler.Throttle(batchContext()))
+	assert.True(t, throttler.Throttle(callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("batch"))))
+
+	counts := throttler.throttled.Counts()
+	assert.Equal(t, int64(2), counts["ReplicationLag.Batch"]-throttled["ReplicationLag.Batch"])
+	assert.Equal(t, int64(1), counts["ReplicationLag.Interactive"]-throttled["ReplicationLag.Interactive"])
+}
+
+func TestThrottleCheckSignal(t *testing.T) {
+	var result *throttle.CheckResult
+	signal := &throttleCheckSignal{
+		check:      func(ctx context.Context) *throttle.CheckResult { return result },
+		batchRatio: 0.8,
+	}
+	assert.Equal(t, "TabletThrottler", signal.Name())
+
+	testcases := []struct {
+		statusCode           int
+		value                float64
+		interactiveThrottled bool
+		batchThrottled       bool
+	}{
+		{http.StatusOK, 1, false, false},
+		{http.StatusOK, 4.5, false, true},
+		{http.StatusTooManyRequests, 6, true, true},
+		{http.StatusExpectationFailed, 0, true, true},
+		{http.StatusInternalServerError, 0, false, false},
+	}
+	for _, tc := range testcases {
+		result = &throttle.CheckResult{StatusCode: tc.statusCode, Value: tc.value, Threshold: 5}
+		require.NoError(t, signal.Refresh(context.Background()))
+		assert.Equal(t, tc.interactiveThrottled, signal.Throttle(PriorityInteractive), "%+v", tc)
+		assert.Equal(t, tc.batchThrottled, signal.Throttle(PriorityBatch), "%+v", tc)
+	}
+}
+
+func TestCheckTabletThrottlerRequiresLagThrottler(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.EnableTxThrottler = true
+	config.TxThrottlerHealthCheckCells = []string{"cell1"}
+	config.TxThrottlerSignals.CheckTabletThrottler = true
+	_, err := tryCreateTxThrottler(tabletenv.NewEnv(config, "TxThrottlerTest"), nil, nil)
+	assert.EqualError(t, err, "tx_throttler_check_tablet_throttler requires the tablet throttler to be enabled")
+}
+
+// fakeSignal throttles the transactions while throttled is set.
+type fakeSignal struct {
+	throttled bool
+}
+
+func (fs *fakeSignal) Name() string                      { return "Fake" }
+func (fs *fakeSignal) Refresh(ctx context.Context) error { return nil }
+func (fs *fakeSignal) Throttle(priority Priority) bool   { return fs.throttled }
+
+func TestThrottlerRegisteredSignal(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	defer resetTxThrottlerFactories()
+
+	signal := &fakeSignal{}
+	RegisterSignalFactory("Fake", func(env SignalEnv) Signal {
+		return signal
+	})
+	defer func() {
+		delete(signalFactories, "Fake")
+		signalFactoryNames = signalFactoryNames[:len(signalFactoryNames)-1]
+	}()
+
+	throttler, mockThrottler := newMockedThrottler(t, mockCtrl, tabletenv.NewDefaultConfig())
+	defer throttler.Close()
+	throttled := throttler.throttled.Counts()
+
+	mockThrottler.EXPECT().Throttle(0).Return(0 * time.Second)
+	assert.False(t, throttler.Throttle(context.Background()))
+	signal.throttled = true
+	assert.True(t, throttler.Throttle(context.Background()))
+
+	counts := throttler.throttled.Counts()
+	assert.Equal(t, int64(1), counts["Fake.Interactive"]-throttled["Fake.Interactive"])
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package vstreamer
 
 import (
+	"bytes"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -54,10 +55,29 @@ type Plan struct {
 type Opcode int
 
 const (
-	// Equal is used to filter an integer column on a specific value
+	// Equal is used to filter a column on a specific value
 	Equal = Opcode(iota)
 	// VindexMatch is used for an in_keyrange() construct
 	VindexMatch
+	// NotEqual is used to filter out a specific value of a column
+	NotEqual
+	// LessThan, LessThanEqual, GreaterThan and GreaterThanEqual are
+	// used to filter a column on a range of values
+	LessThan
+	LessThanEqual
+	GreaterThan
+	GreaterThanEqual
+	// IsNull and IsNotNull are used for the "is null" and
+	// "is not null" constructs
+	IsNull
+	IsNotNull
+	// In and NotIn are used to filter a column on a list of values
+	In
+	NotIn
+	// And matches a row if all its Filters match it
+	And
+	// Or matches a row if one of its Filters matches it
+	Or
 )
 
 // Filter contains opcodes for filtering.
@@ -66,6 +86,19 @@ type Filter struct {
 	ColNum int
 	Value  sqltypes.Value
 
+	// Expr, if set, is evaluated against the row to get the value to
+	// filter, instead of column ColNum. ExprColumns contains the
+	// column numbers that Expr refers to. The value of Expr is NULL
+	// if one of them is NULL.
+	Expr        evalengine.Expr
+	ExprColumns []int
+
+	// Values are the values of an In or NotIn filter.
+	Values []sqltypes.Value
+
+	// Filters are the operands of an And or Or filter.
+	Filters []Filter
+
 	// Parameters for VindexMatch.
 	// Vindex, VindexColumns and KeyRange, if set, will be used
 	// to filter the row.
@@ -112,23 +145,12 @@ func (plan *Plan) fields() []*querypb.Field {
 // If the row matched, it returns the columns to be sent.
 func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error) {
 	for _, filter := range plan.Filters {
-		switch filter.Opcode {
-		case Equal:
-			result, err := evalengine.NullsafeCompare(values[filter.ColNum], filter.Value)
-			if err != nil {
-				return false, nil, err
-			}
-			if result != 0 {
-				return false, nil, nil
-			}
-		case VindexMatch:
-			ksid, err := getKeyspaceID(values, filter.Vindex, filter.VindexColumns)
-			if err != nil {
-				return false, nil, err
-			}
-			if !key.KeyRangeContains(filter.KeyRange, ksid) {
-				return false, nil, nil
-			}
+		match, err := filter.match(values)
+		if err != nil {
+			return false, nil, err
+		}
+		if !match {
+			return false, nil, nil
 		}
 	}
 
@@ -154,6 +176,133 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 	return true, result, nil
 }
 
+// match returns true if the row matches the filter. As in MySQL, a NULL
+// value never matches a comparison.
+func (filter *Filter) match(values []sqltypes.Value) (bool, error) {
+	switch filter.Opcode {
+	case VindexMatch:
+		ksid, err := getKeyspaceID(values, filter.Vindex, filter.VindexColumns)
+		if err != nil {
+			return false, err
+		}
+		return key.KeyRangeContains(filter.KeyRange, ksid), nil
+	case And:
+		for i := range filter.Filters {
+			match, err := filter.Filters[i].match(values)
+			if err != nil || !match {
+				return false, err
+			}
+		}
+		return true, nil
+	case Or:
+		for i := range filter.Filters {
+			match, err := filter.Filters[i].match(values)
+			if err != nil || match {
+				return match, err
+			}
+		}
+		return false, nil
+	}
+
+	value, err := filter.value(values)
+	if err != nil {
+		return false, err
+	}
+	switch filter.Opcode {
+	case IsNull:
+		return value.IsNull(), nil
+	case IsNotNull:
+		return !value.IsNull(), nil
+	}
+	if value.IsNull() {
+		return false, nil
+	}
+	switch filter.Opcode {
+	case In:
+		for _, v := range filter.Values {
+			if result, ok := compareValues(value, v); ok && result == 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	case NotIn:
+		for _, v := range filter.Values {
+			if result, ok := compareValues(value, v); !ok || result == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	result, ok := compareValues(value, filter.Value)
+	if !ok {
+		return false, nil
+	}
+	switch filter.Opcode {
+	case Equal:
+		return result == 0, nil
+	case NotEqual:
+		return result != 0, nil
+	case LessThan:
+		return result < 0, nil
+	case LessThanEqual:
+		return result <= 0, nil
+	case GreaterThan:
+		return result > 0, nil
+	case GreaterThanEqual:
+		return result >= 0, nil
+	}
+	return false, fmt.Errorf("unexpected filter opcode: %d", filter.Opcode)
+}
+
+// value returns the value of the row that the filter applies to.
+func (filter *Filter) value(values []sqltypes.Value) (sqltypes.Value, error) {
+	if filter.Expr == nil {
+		return values[filter.ColNum], nil
+	}
+	for _, colnum := range filter.ExprColumns {
+		if values[colnum].IsNull() {
+			return sqltypes.NULL, nil
+		}
+	}
+	result, err := filter.Expr.Evaluate(evalengine.ExpressionEnv{Row: values})
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return result.Value(), nil
+}
+
+// compareValues compares numbers numerically, and the other values by
+// their bytes, as the binary collation does. If one of the values is a
+// number and the other one is not the text of a number, they are not
+// comparable: ok is false, and they don't match any filter.
+func compareValues(v1, v2 sqltypes.Value) (result int, ok bool) {
+	if !sqltypes.IsNumber(v1.Type()) && !sqltypes.IsNumber(v2.Type()) {
+		return bytes.Compare(v1.Raw(), v2.Raw()), true
+	}
+	if v1, ok = toNumber(v1); !ok {
+		return 0, false
+	}
+	if v2, ok = toNumber(v2); !ok {
+		return 0, false
+	}
+	result, err := evalengine.NullsafeCompare(v1, v2)
+	return result, err == nil
+}
+
+// toNumber returns the number that a value represents.
+func toNumber(v sqltypes.Value) (sqltypes.Value, bool) {
+	if sqltypes.IsNumber(v.Type()) {
+		return v, true
+	}
+	if _, err := strconv.ParseInt(string(v.Raw()), 10, 64); err == nil {
+		return sqltypes.MakeTrusted(sqltypes.Int64, v.Raw()), true
+	}
+	if _, err := strconv.ParseFloat(string(v.Raw()), 64); err == nil {
+		return sqltypes.MakeTrusted(sqltypes.Float64, v.Raw()), true
+	}
+	return sqltypes.NULL, false
+}
+
 func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumns []int) (key.DestinationKeyspaceID, error) {
 	vindexValues := make([]sqltypes.Value, 0, len(vindexColumns))
 	for _, col := range vindexColumns {
@@ -364,52 +513,290 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	}
 	exprs := splitAndExpression(nil, where.Expr)
 	for _, expr := range exprs {
-		switch expr := expr.(type) {
-		case *sqlparser.ComparisonExpr:
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
-			if err != nil {
-				return err
+		filter, err := plan.analyzeFilter(vschema, expr)
+		if err != nil {
+			return err
+		}
+		plan.Filters = append(plan.Filters, filter)
+	}
+	return nil
+}
+
+// comparisonOpcodes maps the supported comparison operators to their
+// opcodes, and reversedOpcodes maps the opcodes to the ones of the
+// comparisons with swapped operands.
+var (
+	comparisonOpcodes = map[sqlparser.ComparisonExprOperator]Opcode{
+		sqlparser.EqualOp:        Equal,
+		sqlparser.NotEqualOp:     NotEqual,
+		sqlparser.LessThanOp:     LessThan,
+		sqlparser.LessEqualOp:    LessThanEqual,
+		sqlparser.GreaterThanOp:  GreaterThan,
+		sqlparser.GreaterEqualOp: GreaterThanEqual,
+	}
+	reversedOpcodes = map[Opcode]Opcode{
+		Equal:            Equal,
+		NotEqual:         NotEqual,
+		LessThan:         GreaterThan,
+		LessThanEqual:    GreaterThanEqual,
+		GreaterThan:      LessThan,
+		GreaterThanEqual: LessThanEqual,
+	}
+)
+
+// analyzeFilter builds the filter of a constraint of the where clause.
+func (plan *Plan) analyzeFilter(vschema *localVSchema, expr sqlparser.Expr) (Filter, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.ComparisonExpr:
+		switch expr.Operator {
+		case sqlparser.InOp, sqlparser.NotInOp:
+			return plan.analyzeIn(expr)
+		}
+		opcode, ok := comparisonOpcodes[expr.Operator]
+		if !ok {
+			return Filter{}, fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+		}
+		left, right := expr.Left, expr.Right
+		if _, ok := left.(*sqlparser.Literal); ok {
+			left, right = right, left
+			opcode = reversedOpcodes[opcode]
+		}
+		filter, err := plan.analyzeOperand(left)
+		if err != nil {
+			return Filter{}, err
+		}
+		filter.Opcode = opcode
+		if filter.Value, err = literalValue(right); err != nil {
+			return Filter{}, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+		}
+		if opcode != Equal {
+			if err := plan.checkTextOrdering(filter, expr); err != nil {
+				return Filter{}, err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
+		}
+		return filter, nil
+	case *sqlparser.RangeCond:
+		filter, err := plan.analyzeOperand(expr.Left)
+		if err != nil {
+			return Filter{}, err
+		}
+		from, err := literalValue(expr.From)
+		if err != nil {
+			return Filter{}, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+		}
+		to, err := literalValue(expr.To)
+		if err != nil {
+			return Filter{}, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+		}
+		if err := plan.checkTextOrdering(filter, expr); err != nil {
+			return Filter{}, err
+		}
+		lower, upper := filter, filter
+		if expr.Operator == sqlparser.BetweenOp {
+			lower.Opcode, lower.Value = GreaterThanEqual, from
+			upper.Opcode, upper.Value = LessThanEqual, to
+			return Filter{Opcode: And, Filters: []Filter{lower, upper}}, nil
+		}
+		lower.Opcode, lower.Value = LessThan, from
+		upper.Opcode, upper.Value = GreaterThan, to
+		return Filter{Opcode: Or, Filters: []Filter{lower, upper}}, nil
+	case *sqlparser.IsExpr:
+		filter, err := plan.analyzeOperand(expr.Expr)
+		if err != nil {
+			return Filter{}, err
+		}
+		switch expr.Operator {
+		case sqlparser.IsNullOp:
+			filter.Opcode = IsNull
+		case sqlparser.IsNotNullOp:
+			filter.Opcode = IsNotNull
+		default:
+			return Filter{}, fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+		}
+		return filter, nil
+	case *sqlparser.AndExpr:
+		return plan.analyzeFilters(vschema, And, splitAndExpression(nil, expr))
+	case *sqlparser.OrExpr:
+		return plan.analyzeFilters(vschema, Or, splitOrExpression(nil, expr))
+	case *sqlparser.FuncExpr:
+		if !expr.Name.EqualString("in_keyrange") {
+			return Filter{}, fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+		}
+		return plan.analyzeInKeyRange(vschema, expr.Exprs)
+	}
+	return Filter{}, fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+}
+
+func (plan *Plan) analyzeFilters(vschema *localVSchema, opcode Opcode, exprs []sqlparser.Expr) (Filter, error) {
+	filter := Filter{Opcode: opcode}
+	for _, expr := range exprs {
+		operand, err := plan.analyzeFilter(vschema, expr)
+		if err != nil {
+			return Filter{}, err
+		}
+		filter.Filters = append(filter.Filters, operand)
+	}
+	return filter, nil
+}
+
+func (plan *Plan) analyzeIn(expr *sqlparser.ComparisonExpr) (Filter, error) {
+	filter, err := plan.analyzeOperand(expr.Left)
+	if err != nil {
+		return Filter{}, err
+	}
+	filter.Opcode = In
+	if expr.Operator == sqlparser.NotInOp {
+		filter.Opcode = NotIn
+	}
+	tuple, ok := expr.Right.(sqlparser.ValTuple)
+	if !ok {
+		return Filter{}, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	for _, val := range tuple {
+		value, err := literalValue(val)
+		if err != nil {
+			return Filter{}, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+		}
+		filter.Values = append(filter.Values, value)
+	}
+	if err := plan.checkTextOrdering(filter, expr); err != nil {
+		return Filter{}, err
+	}
+	return filter, nil
+}
+
+// checkTextOrdering returns an error if the filter compares the text of a
+// column that has a character set. The filters compare text by its bytes,
+// while MySQL compares it with the collation of the column, which may be
+// case or accent insensitive. The equality is still allowed on such
+// columns, as it was before the other comparisons were supported.
+func (plan *Plan) checkTextOrdering(filter Filter, expr sqlparser.Expr) error {
+	columns := filter.ExprColumns
+	if filter.Expr == nil {
+		columns = []int{filter.ColNum}
+	} else {
+		// An expression that returns a number compares numerically.
+		typ, err := filter.Expr.Type(evalengine.ExpressionEnv{})
+		if err != nil {
+			return err
+		}
+		if sqltypes.IsNumber(typ) {
+			return nil
+		}
+	}
+	for _, colnum := range columns {
+		if field := plan.Table.Fields[colnum]; sqltypes.IsText(field.Type) {
+			return fmt.Errorf("unsupported constraint on the text column %s, which is not binary: %v", field.Name, sqlparser.String(expr))
+		}
+	}
+	return nil
+}
+
+// analyzeOperand returns a filter on the value of a column, or of an
+// expression of the columns evaluated by evalengine.
+func (plan *Plan) analyzeOperand(expr sqlparser.Expr) (Filter, error) {
+	if colName, ok := expr.(*sqlparser.ColName); ok {
+		colnum, err := plan.analyzeColName(colName)
+		if err != nil {
+			return Filter{}, err
+		}
+		return Filter{ColNum: colnum}, nil
+	}
+	filter := Filter{}
+	var err error
+	if filter.Expr, err = plan.convertExpr(expr, &filter.ExprColumns); err != nil {
+		return Filter{}, err
+	}
+	if len(filter.ExprColumns) == 0 {
+		return Filter{}, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	return filter, nil
+}
+
+// convertExpr converts an expression of the columns to an evalengine
+// expression. The columns it refers to are appended to columns.
+func (plan *Plan) convertExpr(expr sqlparser.Expr, columns *[]int) (evalengine.Expr, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.ColName:
+		colnum, err := plan.analyzeColName(expr)
+		if err != nil {
+			return nil, err
+		}
+		*columns = append(*columns, colnum)
+		return evalengine.NewColumn(colnum), nil
+	case *sqlparser.Literal:
+		return sqlparser.Convert(expr)
+	case *sqlparser.BinaryExpr:
+		var op evalengine.BinaryExpr
+		switch expr.Operator {
+		case sqlparser.PlusOp:
+			op = &evalengine.Addition{}
+		case sqlparser.MinusOp:
+			op = &evalengine.Subtraction{}
+		case sqlparser.MultOp:
+			op = &evalengine.Multiplication{}
+		case sqlparser.DivOp:
+			op = &evalengine.Division{}
+		default:
+			return nil, fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+		}
+		left, err := plan.convertExpr(expr.Left, columns)
+		if err != nil {
+			return nil, err
+		}
+		right, err := plan.convertExpr(expr.Right, columns)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.BinaryOp{Expr: op, Left: left, Right: right}, nil
+	case *sqlparser.FuncExpr:
+		if !expr.Qualifier.IsEmpty() || expr.Distinct {
+			return nil, fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+		}
+		args := make([]evalengine.Expr, 0, len(expr.Exprs))
+		for _, arg := range expr.Exprs {
+			aliased, ok := arg.(*sqlparser.AliasedExpr)
 			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+				return nil, fmt.Errorf("unsupported: %v", sqlparser.String(expr))
 			}
-			pv, err := sqlparser.NewPlanValue(val)
+			converted, err := plan.convertExpr(aliased.Expr, columns)
 			if err != nil {
-				return err
-			}
-			resolved, err := pv.ResolveValue(nil)
-			if err != nil {
-				return err
-			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: Equal,
-				ColNum: colnum,
-				Value:  resolved,
-			})
-		case *sqlparser.FuncExpr:
-			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
-			}
-			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
-				return err
+				return nil, err
 			}
-		default:
-			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			args = append(args, converted)
+		}
+		f, err := evalengine.NewFunction(expr.Name.String(), args)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported function: %v", sqlparser.String(expr))
 		}
+		return f, nil
 	}
-	return nil
+	return nil, fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+}
+
+func (plan *Plan) analyzeColName(colName *sqlparser.ColName) (int, error) {
+	if !colName.Qualifier.IsEmpty() {
+		return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(colName))
+	}
+	return findColumn(plan.Table, colName.Name)
+}
+
+// literalValue returns the value of an integer, float or string literal.
+func literalValue(expr sqlparser.Expr) (sqltypes.Value, error) {
+	val, ok := expr.(*sqlparser.Literal)
+	if !ok {
+		return sqltypes.NULL, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	switch val.Type {
+	case sqlparser.IntVal, sqlparser.FloatVal, sqlparser.StrVal:
+	default:
+		return sqltypes.NULL, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return pv.ResolveValue(nil)
 }
 
 // splitAndExpression breaks up the Expr into AND-separated conditions
@@ -427,6 +814,15 @@ func splitAndExpression(filters []sqlparser.Expr, node sqlparser.Expr) []sqlpars
 	return append(filters, node)
 }
 
+// splitOrExpression breaks up the Expr into OR-separated conditions.
+func splitOrExpression(filters []sqlparser.Expr, node sqlparser.Expr) []sqlparser.Expr {
+	if node, ok := node.(*sqlparser.OrExpr); ok {
+		filters = splitOrExpression(filters, node.Left)
+		return splitOrExpression(filters, node.Right)
+	}
+	return append(filters, node)
+}
+
 func (plan *Plan) analyzeExprs(vschema *localVSchema, selExprs sqlparser.SelectExprs) error {
 	if _, ok := selExprs[0].(*sqlparser.StarExpr); !ok {
 		for _, expr := range selExprs {
@@ -523,7 +919,7 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
 // "in_keyrange(col, 'hash', '-80')", "in_keyrange(col, 'local_vindex', '-80')", or
 // "in_keyrange(col, 'ks.external_vindex', '-80')".
-func (plan *Plan) analyzeInKeyRange(vschema *localVSchema, exprs sqlparser.SelectExprs) error {
+func (plan *Plan) analyzeInKeyRange(vschema *localVSchema, exprs sqlparser.SelectExprs) (Filter, error) {
 	var colnames []sqlparser.ColIdent
 	var krExpr sqlparser.SelectExpr
 	whereFilter := Filter{
@@ -533,7 +929,7 @@ func (plan *Plan) analyzeInKeyRange(vschema *localVSchema, exprs sqlparser.Selec
 	case len(exprs) == 1:
 		cv, err := vschema.FindColVindex(plan.Table.Name)
 		if err != nil {
-			return err
+			return Filter{}, err
 		}
 		colnames = cv.Columns
 		whereFilter.Vindex = cv.Vindex
@@ -542,53 +938,52 @@ func (plan *Plan) analyzeInKeyRange(vschema *localVSchema, exprs sqlparser.Selec
 		for _, expr := range exprs[:len(exprs)-2] {
 			aexpr, ok := expr.(*sqlparser.AliasedExpr)
 			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+				return Filter{}, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 			}
 			qualifiedName, ok := aexpr.Expr.(*sqlparser.ColName)
 			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+				return Filter{}, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 			}
 			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+				return Filter{}, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
 			}
 			colnames = append(colnames, qualifiedName.Name)
 		}
 
 		vtype, err := selString(exprs[len(exprs)-2])
 		if err != nil {
-			return err
+			return Filter{}, err
 		}
 		whereFilter.Vindex, err = vschema.FindOrCreateVindex(vtype)
 		if err != nil {
-			return err
+			return Filter{}, err
 		}
 		if !whereFilter.Vindex.IsUnique() {
-			return fmt.Errorf("vindex must be Unique to be used for VReplication: %s", vtype)
+			return Filter{}, fmt.Errorf("vindex must be Unique to be used for VReplication: %s", vtype)
 		}
 
 		krExpr = exprs[len(exprs)-1]
 	default:
-		return fmt.Errorf("unexpected in_keyrange parameters: %v", sqlparser.String(exprs))
+		return Filter{}, fmt.Errorf("unexpected in_keyrange parameters: %v", sqlparser.String(exprs))
 	}
 	var err error
 	whereFilter.VindexColumns, err = buildVindexColumns(plan.Table, colnames)
 	if err != nil {
-		return err
+		return Filter{}, err
 	}
 	kr, err := selString(krExpr)
 	if err != nil {
-		return err
+		return Filter{}, err
 	}
 	keyranges, err := key.ParseShardingSpec(kr)
 	if err != nil {
-		return err
+		return Filter{}, err
 	}
 	if len(keyranges) != 1 {
-		return fmt.Errorf("unexpected in_keyrange parameter: %v", sqlparser.String(krExpr))
+		return Filter{}, fmt.Errorf("unexpected in_keyrange parameter: %v", sqlparser.String(krExpr))
 	}
 	whereFilter.KeyRange = keyranges[0]
-	plan.Filters = append(plan.Filters, whereFilter)
-	return nil
+	return whereFilter, nil
 }
 
 func selString(expr sqlparser.SelectExpr) (string, error) {
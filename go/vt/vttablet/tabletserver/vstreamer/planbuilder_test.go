@@ -21,9 +21,13 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -393,6 +397,44 @@ func TestPlanbuilder(t *testing.T) {
 				KeyRange:      nil,
 			}},
 		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id from t1 where 10 > id and val in ('a', 'b') and (id between 1 and 5 or val is null)"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Field: &querypb.Field{
+					Name: "id",
+					Type: sqltypes.Int64,
+				},
+			}},
+			Filters: []Filter{{
+				Opcode: LessThan,
+				ColNum: 0,
+				Value:  sqltypes.NewInt64(10),
+			}, {
+				Opcode: In,
+				ColNum: 1,
+				Values: []sqltypes.Value{sqltypes.NewVarBinary("a"), sqltypes.NewVarBinary("b")},
+			}, {
+				Opcode: Or,
+				Filters: []Filter{{
+					Opcode: And,
+					Filters: []Filter{{
+						Opcode: GreaterThanEqual,
+						ColNum: 0,
+						Value:  sqltypes.NewInt64(1),
+					}, {
+						Opcode: LessThanEqual,
+						ColNum: 0,
+						Value:  sqltypes.NewInt64(5),
+					}},
+				}, {
+					Opcode: IsNull,
+					ColNum: 1,
+				}},
+			}},
+		},
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
@@ -493,6 +535,34 @@ func TestPlanbuilder(t *testing.T) {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where max(id)"},
 		outErr:  `unsupported constraint: max(id)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where val like 'a%'"},
+		outErr:  `unsupported constraint: val like 'a%'`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = val"},
+		outErr:  `unexpected: id = val`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where 1 = 1"},
+		outErr:  `unexpected: 1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id in (1, val)"},
+		outErr:  `unexpected: id in (1, val)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where foo(id) = 1"},
+		outErr:  `unsupported function: foo(id)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id % 2 = 1"},
+		outErr:  `unsupported: id % 2`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where t1.id is not null"},
+		outErr:  `unsupported qualifier for column: t1.id`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id)"},
@@ -577,3 +647,73 @@ func TestPlanbuilder(t *testing.T) {
 		}
 	}
 }
+
+func TestPlanFilter(t *testing.T) {
+	t1 := &Table{
+		Name:   "t1",
+		Fields: sqltypes.MakeTestFields("id|val|created|title", "int64|varbinary|datetime|varchar"),
+	}
+	rows := sqltypes.MakeTestResult(t1.Fields,
+		"1|Abc|2020-12-31 23:59:59|a",
+		"2|def|2021-01-01 00:00:00|B",
+		"3|null|2021-06-15 12:00:00|12",
+		"4|ghi|null|null",
+	).Rows
+
+	testcases := []struct {
+		where string
+		want  []int64
+	}{
+		{"id != 2", []int64{1, 3, 4}},
+		{"id >= 2 and id < 4", []int64{2, 3}},
+		{"created >= '2021-01-01'", []int64{2, 3}},
+		{"created < '2021-01-01' or created is null", []int64{1, 4}},
+		{"val is not null", []int64{1, 2, 4}},
+		{"val in ('def', 'ghi', 'xyz')", []int64{2, 4}},
+		// A NULL value never matches a comparison.
+		{"val not in ('def')", []int64{1, 4}},
+		{"id not between 2 and 3", []int64{1, 4}},
+		{"lower(val) = 'abc'", []int64{1}},
+		{"length(val) > 3 or mod(id, 2) = 0", []int64{2, 4}},
+		{"id * 10 - 5 > 20", []int64{3, 4}},
+		{"abs(id - 3) <= 1 and val != 'def'", []int64{4}},
+		{"title = 'B' or length(title) > 1", []int64{2, 3}},
+		// A text that is not a number doesn't match a comparison with
+		// a number.
+		{"title = 12", []int64{3}},
+		{"title = 12.0", []int64{3}},
+		{"id != 'x'", nil},
+		{"id in (2, 'x')", []int64{2}},
+		{"id not in (2, 'x')", nil},
+	}
+	for _, tcase := range testcases {
+		plan, err := buildTablePlan(t1, testLocalVSchema, "select id from t1 where "+tcase.where)
+		require.NoError(t, err, tcase.where)
+		var got []int64
+		for _, row := range rows {
+			ok, values, err := plan.filter(row)
+			require.NoError(t, err, tcase.where)
+			if ok {
+				id, err := evalengine.ToInt64(values[0])
+				require.NoError(t, err)
+				got = append(got, id)
+			}
+		}
+		assert.Equal(t, tcase.want, got, tcase.where)
+	}
+
+	// Only the equality compares the text columns, because the other
+	// comparisons depend on their collation.
+	for _, tcase := range []struct {
+		where, constraint string
+	}{
+		{"title != 'a'", "title != 'a'"},
+		{"title > 'a'", "title > 'a'"},
+		{"title not between 'a' and 'c'", "title not between 'a' and 'c'"},
+		{"id = 1 or title in ('a', 'b')", "title in ('a', 'b')"},
+		{"lower(title) < 'b'", "lower(title) < 'b'"},
+	} {
+		_, err := buildTablePlan(t1, testLocalVSchema, "select id from t1 where "+tcase.where)
+		assert.EqualError(t, err, "unsupported constraint on the text column title, which is not binary: "+tcase.constraint, tcase.where)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -80,6 +81,9 @@ type Controller struct {
 	// TS is the return value for TopoServer.
 	TS *topo.Server
 
+	// HotRowsResult is the return value for HotRows.
+	HotRowsResult []txserializer.HotRow
+
 	// mu protects the next fields in this structure. They are
 	// accessed by both the methods in this interface, and the
 	// background health check.
@@ -225,6 +229,11 @@ func (tqsc *Controller) TopoServer() *topo.Server {
 	return tqsc.TS
 }
 
+// HotRows is part of the tabletserver.Controller interface.
+func (tqsc *Controller) HotRows() []txserializer.HotRow {
+	return tqsc.HotRowsResult
+}
+
 // EnterLameduck implements tabletserver.Controller.
 func (tqsc *Controller) EnterLameduck() {
 	tqsc.mu.Lock()
@@ -52,6 +52,10 @@ type TabletManagerClient interface {
 	// GetPermissions asks the remote tablet for its permissions list
 	GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error)
 
+	// GetHotRows asks the remote tablet for the rows contended by
+	// several transactions
+	GetHotRows(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.HotRow, error)
+
 	//
 	// Various read-write methods
 	//
@@ -145,6 +149,10 @@ type TabletManagerClient interface {
 	VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error
 
+	// VDiff starts, stops, resumes, shows or deletes the vdiffs of a
+	// workflow on a target master tablet.
+	VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error)
+
 	//
 	// Reparenting related functions
 	//
@@ -335,6 +335,33 @@ func tmRPCTestGetPermissionsPanic(ctx context.Context, t *testing.T, client tmcl
 	expectHandleRPCPanic(t, "GetPermissions", false /*verbose*/, err)
 }
 
+var testGetHotRowsReply = []*tabletmanagerdatapb.HotRow{
+	{
+		Key:     "t1 where id = 1",
+		Table:   "t1",
+		Pending: 3,
+		Max:     4,
+		Count:   7,
+	},
+}
+
+func (fra *fakeRPCTM) GetHotRows(ctx context.Context) ([]*tabletmanagerdatapb.HotRow, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGetHotRowsReply, nil
+}
+
+func tmRPCTestGetHotRows(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetHotRows(ctx, tablet)
+	compareError(t, "GetHotRows", err, result, testGetHotRowsReply)
+}
+
+func tmRPCTestGetHotRowsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetHotRows(ctx, tablet)
+	expectHandleRPCPanic(t, "GetHotRows", false /*verbose*/, err)
+}
+
 //
 // Various read-write methods
 //
@@ -899,6 +926,37 @@ func tmRPCTestVReplicationWaitForPosPanic(ctx context.Context, t *testing.T, cli
 	expectHandleRPCPanic(t, "VReplicationWaitForPos", true /*verbose*/, err)
 }
 
+var testVDiffRequest = &tabletmanagerdatapb.VDiffRequest{
+	Keyspace:  "ks",
+	Workflow:  "wf",
+	Action:    "show",
+	ActionArg: "last",
+}
+
+var testVDiffResponse = &tabletmanagerdatapb.VDiffResponse{
+	Id:        3,
+	Output:    testExecuteFetchResult,
+	VdiffUuid: "uuid",
+}
+
+func (fra *fakeRPCTM) VDiff(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "VDiff req", req, testVDiffRequest)
+	return testVDiffResponse, nil
+}
+
+func tmRPCTestVDiff(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	response, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	compareError(t, "VDiff", err, response, testVDiffResponse)
+}
+
+func tmRPCTestVDiffPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	expectHandleRPCPanic(t, "VDiff", true /*verbose*/, err)
+}
+
 //
 // Reparenting related functions
 //
@@ -1245,6 +1303,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPing(ctx, t, client, tablet)
 	tmRPCTestGetSchema(ctx, t, client, tablet)
 	tmRPCTestGetPermissions(ctx, t, client, tablet)
+	tmRPCTestGetHotRows(ctx, t, client, tablet)
 
 	// Various read-write methods
 	tmRPCTestSetReadOnly(ctx, t, client, tablet)
@@ -1273,6 +1332,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
+	tmRPCTestVDiff(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplication(ctx, t, client, tablet)
@@ -1301,6 +1361,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPingPanic(ctx, t, client, tablet)
 	tmRPCTestGetSchemaPanic(ctx, t, client, tablet)
 	tmRPCTestGetPermissionsPanic(ctx, t, client, tablet)
+	tmRPCTestGetHotRowsPanic(ctx, t, client, tablet)
 
 	// Various read-write methods
 	tmRPCTestSetReadOnlyPanic(ctx, t, client, tablet)
@@ -1325,6 +1386,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
+	tmRPCTestVDiffPanic(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplicationPanic(ctx, t, client, tablet)
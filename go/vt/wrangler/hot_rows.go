@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// GetHotRows returns the rows contended by several transactions
+// on a remote tablet.
+func (wr *Wrangler) GetHotRows(ctx context.Context, tabletAlias *topodatapb.TabletAlias) ([]*tabletmanagerdatapb.HotRow, error) {
+	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.tmc.GetHotRows(ctx, ti.Tablet)
+}
+
+// GetShardHotRows returns the hot rows of all the tablets of a shard,
+// keyed by tablet alias. Tablets without hot rows are omitted.
+func (wr *Wrangler) GetShardHotRows(ctx context.Context, keyspace, shard string) (map[string][]*tabletmanagerdatapb.HotRow, error) {
+	tablets, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, fmt.Errorf("GetTabletMapForShard(%v/%v) failed: %v", keyspace, shard, err)
+	}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		rec    concurrency.AllErrorRecorder
+		result = make(map[string][]*tabletmanagerdatapb.HotRow)
+	)
+	for _, ti := range tablets {
+		wg.Add(1)
+		go func(tablet *topodatapb.Tablet) {
+			defer wg.Done()
+			alias := topoproto.TabletAliasString(tablet.Alias)
+			hotRows, err := wr.tmc.GetHotRows(ctx, tablet)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("GetHotRows(%v) failed: %v", alias, err))
+				return
+			}
+			if len(hotRows) == 0 {
+				return
+			}
+			mu.Lock()
+			result[alias] = hotRows
+			mu.Unlock()
+		}(ti.Tablet)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+	return result, nil
+}
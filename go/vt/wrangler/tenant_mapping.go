@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const numericTopoMapType = "numeric_topo_map"
+
+// waitForTenantCopyInterval is how often the copy of a tenant is checked.
+var waitForTenantCopyInterval = 1 * time.Second
+
+// UpdateTenantMapping maps tenantID to keyspaceID in the mapping of the
+// numeric_topo_map vindex of the keyspace, which is stored in the tenant
+// mappings of the global topo and copied to every cell. If the tenant moves to a different shard,
+// its rows in the tables that use the vindex as primary vindex are first
+// copied to the new shard by a vreplication stream. The mapping is switched
+// once the copy is done, and the stream is deleted after it has caught up
+// with the writes the old shard received before the switch. The rows left
+// behind on the old shard are not deleted.
+func (wr *Wrangler) UpdateTenantMapping(ctx context.Context, keyspace, vindexName string, tenantID, keyspaceID uint64, skipCopy bool, timeout time.Duration) error {
+	vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	vindex, ok := vschema.Vindexes[vindexName]
+	if !ok {
+		return fmt.Errorf("vindex %s not found in keyspace %s", vindexName, keyspace)
+	}
+	if vindex.Type != numericTopoMapType {
+		return fmt.Errorf("vindex %s is of type %s, expected %s", vindexName, vindex.Type, numericTopoMapType)
+	}
+	kschema, err := vindexes.BuildKeyspaceSchema(vschema, keyspace)
+	if err != nil {
+		return err
+	}
+	topoMap := kschema.Vindexes[vindexName].(*vindexes.NumericTopoMap)
+
+	switchMapping := func() error {
+		if err := wr.ts.UpdateTenantMapping(ctx, keyspace, topoMap.MappingName(), tenantID, keyspaceID); err != nil {
+			return err
+		}
+		if err := wr.ts.RebuildSrvTenantMappings(ctx, nil); err != nil {
+			return err
+		}
+		wr.Logger().Infof("Tenant %d of vindex %s in keyspace %s is now mapped to keyspace id %d", tenantID, vindexName, keyspace, keyspaceID)
+		return nil
+	}
+	if skipCopy {
+		return switchMapping()
+	}
+	return wr.moveTenant(ctx, keyspace, kschema, topoMap, tenantID, keyspaceID, timeout, switchMapping)
+}
+
+// moveTenant copies the rows of the tenant to the shard of keyspaceID,
+// calls switchMapping once the copy is done, and waits for the stream
+// to catch up before deleting it.
+func (wr *Wrangler) moveTenant(ctx context.Context, keyspace string, kschema *vindexes.KeyspaceSchema, topoMap *vindexes.NumericTopoMap, tenantID, keyspaceID uint64, timeout time.Duration, switchMapping func() error) error {
+	vindexName := topoMap.String()
+	mappings, err := wr.ts.GetTenantMappings(ctx)
+	if err != nil {
+		return err
+	}
+	oldDest, err := topoMap.MapWithMapping(nil, vindexes.NumericLookupTable(mappings[keyspace][topoMap.MappingName()]), []sqltypes.Value{sqltypes.NewUint64(tenantID)})
+	if err != nil {
+		return err
+	}
+	oldKsid, ok := oldDest[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return fmt.Errorf("tenant %d is not mapped to a keyspace id: %v", tenantID, oldDest[0])
+	}
+	var newKsid [8]byte
+	binary.BigEndian.PutUint64(newKsid[:], keyspaceID)
+
+	shards, err := wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	source, err := shardForKeyspaceID(shards, oldKsid)
+	if err != nil {
+		return err
+	}
+	target, err := shardForKeyspaceID(shards, newKsid[:])
+	if err != nil {
+		return err
+	}
+	if source.ShardName() == target.ShardName() {
+		wr.Logger().Infof("Tenant %d stays on shard %s/%s, no copy needed", tenantID, keyspace, source.ShardName())
+		return switchMapping()
+	}
+
+	rules := tenantRules(kschema, vindexName, tenantID)
+	if len(rules) == 0 {
+		wr.Logger().Infof("No table uses vindex %s as primary vindex, no copy needed", vindexName)
+		return switchMapping()
+	}
+
+	if source.MasterAlias == nil || target.MasterAlias == nil {
+		return fmt.Errorf("shards %s and %s of keyspace %s must have a master", source.ShardName(), target.ShardName(), keyspace)
+	}
+	sourceMaster, err := wr.ts.GetTablet(ctx, source.MasterAlias)
+	if err != nil {
+		return err
+	}
+	targetMaster, err := wr.ts.GetTablet(ctx, target.MasterAlias)
+	if err != nil {
+		return err
+	}
+
+	workflow := fmt.Sprintf("%s_tenant_%d", vindexName, tenantID)
+	ig := vreplication.NewInsertGenerator(binlogplayer.BlpRunning, targetMaster.DbName())
+	ig.AddRow(workflow, &binlogdatapb.BinlogSource{
+		Keyspace: keyspace,
+		Shard:    source.ShardName(),
+		Filter:   &binlogdatapb.Filter{Rules: rules},
+	}, "", "", "")
+	qr, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, ig.String())
+	if err != nil {
+		return vterrors.Wrapf(err, "VReplicationExec(%v)", targetMaster.AliasString())
+	}
+	id := uint32(qr.InsertId)
+	wr.Logger().Infof("Copying tenant %d from shard %s to shard %s with workflow %s (id %d)", tenantID, source.ShardName(), target.ShardName(), workflow, id)
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := wr.waitForTenantCopy(waitCtx, targetMaster.Tablet, id); err != nil {
+		return vterrors.Wrapf(err, "workflow %s on %v was left in place", workflow, targetMaster.AliasString())
+	}
+	if err := switchMapping(); err != nil {
+		return vterrors.Wrapf(err, "workflow %s on %v was left in place", workflow, targetMaster.AliasString())
+	}
+
+	// Writes may still reach the old shard until every vtgate has seen
+	// the new mapping. Wait for the stream to replay them before deleting it.
+	pos, err := wr.tmc.MasterPosition(waitCtx, sourceMaster.Tablet)
+	if err != nil {
+		return vterrors.Wrapf(err, "workflow %s on %v was left in place", workflow, targetMaster.AliasString())
+	}
+	if err := wr.tmc.VReplicationWaitForPos(waitCtx, targetMaster.Tablet, int(id), pos); err != nil {
+		return vterrors.Wrapf(err, "workflow %s on %v was left in place", workflow, targetMaster.AliasString())
+	}
+	if _, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, binlogplayer.DeleteVReplication(id)); err != nil {
+		return vterrors.Wrapf(err, "VReplicationExec(%v)", targetMaster.AliasString())
+	}
+	wr.Logger().Warningf("The rows of tenant %d are still present on shard %s/%s and need to be cleaned up", tenantID, keyspace, source.ShardName())
+	return nil
+}
+
+// waitForTenantCopy waits until the copy phase of the stream is done.
+// The stream is created running with an empty position: it only gets a
+// position once the copy has started, and its copy_state rows are only
+// all deleted once the copy is done. So the copy is done when the stream
+// is running with a position and no copy_state row.
+func (wr *Wrangler) waitForTenantCopy(ctx context.Context, tablet *topodatapb.Tablet, id uint32) error {
+	ticker := time.NewTicker(waitForTenantCopyInterval)
+	defer ticker.Stop()
+	for {
+		done, err := wr.tenantCopyDone(ctx, tablet, id)
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the copy of stream %d", id)
+		case <-ticker.C:
+		}
+	}
+}
+
+// tenantCopyDone returns true if the copy phase of the stream is done.
+func (wr *Wrangler) tenantCopyDone(ctx context.Context, tablet *topodatapb.Tablet, id uint32) (bool, error) {
+	qr, err := wr.tmc.VReplicationExec(ctx, tablet, fmt.Sprintf("select state, message, pos from _vt.vreplication where id=%d", id))
+	if err != nil {
+		return false, err
+	}
+	if len(qr.Rows) != 1 {
+		return false, fmt.Errorf("stream %d not found", id)
+	}
+	row := sqltypes.Proto3ToResult(qr).Rows[0]
+	switch {
+	case row[0].ToString() == binlogplayer.BlpError:
+		return false, fmt.Errorf("stream %d failed: %s", id, row[1].ToString())
+	case row[0].ToString() != binlogplayer.BlpRunning, row[2].ToString() == "":
+		return false, nil
+	}
+	qr, err = wr.tmc.VReplicationExec(ctx, tablet, fmt.Sprintf("select count(*) from _vt.copy_state where vrepl_id=%d", id))
+	if err != nil {
+		return false, err
+	}
+	if len(qr.Rows) != 1 {
+		return false, fmt.Errorf("unexpected copy_state count for stream %d: %v", id, qr.Rows)
+	}
+	count, err := sqltypes.Proto3ToResult(qr).Rows[0][0].ToInt64()
+	if err != nil {
+		return false, err
+	}
+	return count == 0, nil
+}
+
+// tenantRules returns the vreplication rules that select the rows of
+// the tenant from the tables that use the vindex as primary vindex.
+func tenantRules(kschema *vindexes.KeyspaceSchema, vindexName string, tenantID uint64) []*binlogdatapb.Rule {
+	var tables []string
+	for name, table := range kschema.Tables {
+		if len(table.ColumnVindexes) == 0 || table.ColumnVindexes[0].Name != vindexName {
+			continue
+		}
+		tables = append(tables, name)
+	}
+	sort.Strings(tables)
+	rules := make([]*binlogdatapb.Rule, 0, len(tables))
+	for _, name := range tables {
+		col := kschema.Tables[name].ColumnVindexes[0].Columns[0]
+		rules = append(rules, &binlogdatapb.Rule{
+			Match:  name,
+			Filter: fmt.Sprintf("select * from %s where %s = %d", sqlescape.EscapeID(name), sqlescape.EscapeID(col.String()), tenantID),
+		})
+	}
+	return rules
+}
+
+func shardForKeyspaceID(shards map[string]*topo.ShardInfo, ksid []byte) (*topo.ShardInfo, error) {
+	for _, si := range shards {
+		if key.KeyRangeContains(si.KeyRange, ksid) {
+			return si, nil
+		}
+	}
+	return nil, fmt.Errorf("no shard contains keyspace id %x", ksid)
+}
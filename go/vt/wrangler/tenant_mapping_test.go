@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+)
+
+func TestTenantRules(t *testing.T) {
+	kschema, err := vindexes.BuildKeyspaceSchema(&vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"tenant": {Type: "numeric_topo_map"},
+			"hash":   {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"orders": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "tenant_id", Name: "tenant"}},
+			},
+			"customers": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "tenant_id", Name: "tenant"}},
+			},
+			"other": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}, {Column: "tenant_id", Name: "tenant"}},
+			},
+		},
+	}, "ks")
+	require.NoError(t, err)
+
+	want := []*binlogdatapb.Rule{{
+		Match:  "customers",
+		Filter: "select * from `customers` where `tenant_id` = 12",
+	}, {
+		Match:  "orders",
+		Filter: "select * from `orders` where `tenant_id` = 12",
+	}}
+	assert.Equal(t, want, tenantRules(kschema, "tenant", 12))
+	assert.Empty(t, tenantRules(kschema, "unknown", 12))
+}
+
+func TestShardForKeyspaceID(t *testing.T) {
+	shards := make(map[string]*topo.ShardInfo)
+	for _, name := range []string{"-80", "80-"} {
+		keyRange, err := key.ParseShardingSpec(name)
+		require.NoError(t, err)
+		shards[name] = topo.NewShardInfo("ks", name, &topodatapb.Shard{KeyRange: keyRange[0]}, nil)
+	}
+
+	si, err := shardForKeyspaceID(shards, []byte("\x00\x00\x00\x00\x00\x00\x00\x01"))
+	require.NoError(t, err)
+	assert.Equal(t, "-80", si.ShardName())
+
+	si, err = shardForKeyspaceID(shards, []byte("\x90\x00\x00\x00\x00\x00\x00\x00"))
+	require.NoError(t, err)
+	assert.Equal(t, "80-", si.ShardName())
+
+	delete(shards, "80-")
+	_, err = shardForKeyspaceID(shards, []byte("\x90\x00\x00\x00\x00\x00\x00\x00"))
+	assert.EqualError(t, err, "no shard contains keyspace id 9000000000000000")
+}
+
+// tenantCopyTMClient returns the successive states of a stream to
+// waitForTenantCopy.
+type tenantCopyTMClient struct {
+	tmclient.TabletManagerClient
+	// states are the state and pos of the stream, one per poll.
+	states [][2]string
+	// copyStates are the number of copy_state rows, one per poll of a
+	// running stream with a position.
+	copyStates []int
+}
+
+func (tmc *tenantCopyTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	if strings.Contains(query, "_vt.copy_state") {
+		count := tmc.copyStates[0]
+		tmc.copyStates = tmc.copyStates[1:]
+		return sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("count(*)", "int64"), fmt.Sprint(count))), nil
+	}
+	state := tmc.states[0]
+	if len(tmc.states) > 1 {
+		tmc.states = tmc.states[1:]
+	}
+	return sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("state|message|pos", "varbinary|varbinary|varbinary"), state[0]+"||"+state[1])), nil
+}
+
+func TestWaitForTenantCopy(t *testing.T) {
+	defer func(d time.Duration) { waitForTenantCopyInterval = d }(waitForTenantCopyInterval)
+	waitForTenantCopyInterval = time.Millisecond
+	ctx := context.Background()
+
+	// The stream is running before the copy has started: it has no
+	// position yet and no copy_state rows, which must not be taken for a
+	// finished copy.
+	tmc := &tenantCopyTMClient{
+		states: [][2]string{
+			{"Running", ""},
+			{"Running", ""},
+			{"Running", "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10"},
+			{"Running", "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20"},
+		},
+		copyStates: []int{2, 0},
+	}
+	wr := New(logutil.NewConsoleLogger(), memorytopo.NewServer("cell"), tmc)
+	require.NoError(t, wr.waitForTenantCopy(ctx, &topodatapb.Tablet{}, 1))
+	assert.Empty(t, tmc.copyStates)
+
+	tmc = &tenantCopyTMClient{states: [][2]string{{"Running", ""}}}
+	wr = New(logutil.NewConsoleLogger(), memorytopo.NewServer("cell"), tmc)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	assert.EqualError(t, wr.waitForTenantCopy(timeoutCtx, &topodatapb.Tablet{}, 1), "timed out waiting for the copy of stream 1")
+}
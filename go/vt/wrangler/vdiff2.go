@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// VDiff2 performs a vdiff action on the target masters of a workflow,
+// which run the vdiffs themselves. A new vdiff gets the same uuid on all
+// the targets, so that it can be stopped, resumed or shown as a whole.
+// It returns the uuid of the vdiff, and for the show action the
+// combined output of the targets.
+func (wr *Wrangler) VDiff2(ctx context.Context, keyspace, workflow, action, actionArg string, options *tabletmanagerdatapb.VDiffOptions) (string, *sqltypes.Result, error) {
+	ti, err := wr.buildTargets(ctx, keyspace, workflow)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(ti.targets) == 0 {
+		return "", nil, fmt.Errorf("no streams found in keyspace %s for workflow %s", keyspace, workflow)
+	}
+
+	req := &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  keyspace,
+		Workflow:  workflow,
+		Action:    action,
+		ActionArg: actionArg,
+		Options:   options,
+	}
+	switch action {
+	case tabletvdiff.StartAction:
+		req.VdiffUuid = uuid.New().String()
+	case tabletvdiff.StopAction, tabletvdiff.ResumeAction:
+		if actionArg == "" {
+			return "", nil, fmt.Errorf("the vdiff uuid is required to %s a vdiff", action)
+		}
+		req.VdiffUuid = actionArg
+	case tabletvdiff.ShowAction, tabletvdiff.DeleteAction:
+		if actionArg == "" {
+			return "", nil, fmt.Errorf("a vdiff uuid, %q or %q is required to %s vdiffs", tabletvdiff.LastActionArg, tabletvdiff.AllActionArg, action)
+		}
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		rec     concurrency.AllErrorRecorder
+		results = make(map[*topo.TabletInfo]*sqltypes.Result)
+	)
+	for _, target := range ti.targets {
+		wg.Add(1)
+		go func(target *tsTarget) {
+			defer wg.Done()
+			resp, err := wr.tmc.VDiff(ctx, target.master.Tablet, req)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("VDiff(%v) failed: %v", target.master.AliasString(), err))
+				return
+			}
+			if resp.Output == nil {
+				return
+			}
+			mu.Lock()
+			results[target.master] = sqltypes.Proto3ToResult(resp.Output)
+			mu.Unlock()
+		}(target)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return req.VdiffUuid, nil, rec.Error()
+	}
+	if len(results) == 0 {
+		return req.VdiffUuid, nil, nil
+	}
+	return req.VdiffUuid, wr.QueryResultForTabletResults(results), nil
+}
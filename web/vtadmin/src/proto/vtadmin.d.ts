@@ -12021,6 +12021,630 @@ export namespace tabletmanagerdata {
          */
         public toJSON(): { [k: string]: any };
     }
+
+    /** Properties of a GetHotRowsRequest. */
+    interface IGetHotRowsRequest {
+    }
+
+    /** Represents a GetHotRowsRequest. */
+    class GetHotRowsRequest implements IGetHotRowsRequest {
+
+        /**
+         * Constructs a new GetHotRowsRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IGetHotRowsRequest);
+
+        /**
+         * Creates a new GetHotRowsRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetHotRowsRequest instance
+         */
+        public static create(properties?: tabletmanagerdata.IGetHotRowsRequest): tabletmanagerdata.GetHotRowsRequest;
+
+        /**
+         * Encodes the specified GetHotRowsRequest message. Does not implicitly {@link tabletmanagerdata.GetHotRowsRequest.verify|verify} messages.
+         * @param message GetHotRowsRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IGetHotRowsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetHotRowsRequest message, length delimited. Does not implicitly {@link tabletmanagerdata.GetHotRowsRequest.verify|verify} messages.
+         * @param message GetHotRowsRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IGetHotRowsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetHotRowsRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetHotRowsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.GetHotRowsRequest;
+
+        /**
+         * Decodes a GetHotRowsRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetHotRowsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.GetHotRowsRequest;
+
+        /**
+         * Verifies a GetHotRowsRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetHotRowsRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetHotRowsRequest
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.GetHotRowsRequest;
+
+        /**
+         * Creates a plain object from a GetHotRowsRequest message. Also converts values to other types if specified.
+         * @param message GetHotRowsRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.GetHotRowsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetHotRowsRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a HotRow. */
+    interface IHotRow {
+
+        /** HotRow key */
+        key?: (string|null);
+
+        /** HotRow table */
+        table?: (string|null);
+
+        /** HotRow pending */
+        pending?: (number|Long|null);
+
+        /** HotRow max */
+        max?: (number|Long|null);
+
+        /** HotRow count */
+        count?: (number|Long|null);
+    }
+
+    /** Represents a HotRow. */
+    class HotRow implements IHotRow {
+
+        /**
+         * Constructs a new HotRow.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IHotRow);
+
+        /** HotRow key. */
+        public key: string;
+
+        /** HotRow table. */
+        public table: string;
+
+        /** HotRow pending. */
+        public pending: (number|Long);
+
+        /** HotRow max. */
+        public max: (number|Long);
+
+        /** HotRow count. */
+        public count: (number|Long);
+
+        /**
+         * Creates a new HotRow instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns HotRow instance
+         */
+        public static create(properties?: tabletmanagerdata.IHotRow): tabletmanagerdata.HotRow;
+
+        /**
+         * Encodes the specified HotRow message. Does not implicitly {@link tabletmanagerdata.HotRow.verify|verify} messages.
+         * @param message HotRow message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IHotRow, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified HotRow message, length delimited. Does not implicitly {@link tabletmanagerdata.HotRow.verify|verify} messages.
+         * @param message HotRow message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IHotRow, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a HotRow message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns HotRow
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.HotRow;
+
+        /**
+         * Decodes a HotRow message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns HotRow
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.HotRow;
+
+        /**
+         * Verifies a HotRow message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a HotRow message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns HotRow
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.HotRow;
+
+        /**
+         * Creates a plain object from a HotRow message. Also converts values to other types if specified.
+         * @param message HotRow
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.HotRow, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this HotRow to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a GetHotRowsResponse. */
+    interface IGetHotRowsResponse {
+
+        /** GetHotRowsResponse hot_rows */
+        hot_rows?: (tabletmanagerdata.IHotRow[]|null);
+    }
+
+    /** Represents a GetHotRowsResponse. */
+    class GetHotRowsResponse implements IGetHotRowsResponse {
+
+        /**
+         * Constructs a new GetHotRowsResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IGetHotRowsResponse);
+
+        /** GetHotRowsResponse hot_rows. */
+        public hot_rows: tabletmanagerdata.IHotRow[];
+
+        /**
+         * Creates a new GetHotRowsResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns GetHotRowsResponse instance
+         */
+        public static create(properties?: tabletmanagerdata.IGetHotRowsResponse): tabletmanagerdata.GetHotRowsResponse;
+
+        /**
+         * Encodes the specified GetHotRowsResponse message. Does not implicitly {@link tabletmanagerdata.GetHotRowsResponse.verify|verify} messages.
+         * @param message GetHotRowsResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IGetHotRowsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified GetHotRowsResponse message, length delimited. Does not implicitly {@link tabletmanagerdata.GetHotRowsResponse.verify|verify} messages.
+         * @param message GetHotRowsResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IGetHotRowsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a GetHotRowsResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns GetHotRowsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.GetHotRowsResponse;
+
+        /**
+         * Decodes a GetHotRowsResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns GetHotRowsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.GetHotRowsResponse;
+
+        /**
+         * Verifies a GetHotRowsResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a GetHotRowsResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns GetHotRowsResponse
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.GetHotRowsResponse;
+
+        /**
+         * Creates a plain object from a GetHotRowsResponse message. Also converts values to other types if specified.
+         * @param message GetHotRowsResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.GetHotRowsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this GetHotRowsResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a VDiffOptions. */
+    interface IVDiffOptions {
+
+        /** VDiffOptions source_cell */
+        source_cell?: (string|null);
+
+        /** VDiffOptions tablet_types */
+        tablet_types?: (string|null);
+
+        /** VDiffOptions tables */
+        tables?: (string|null);
+
+        /** VDiffOptions max_rows */
+        max_rows?: (number|Long|null);
+
+        /** VDiffOptions filtered_replication_wait_time */
+        filtered_replication_wait_time?: (number|Long|null);
+    }
+
+    /** Represents a VDiffOptions. */
+    class VDiffOptions implements IVDiffOptions {
+
+        /**
+         * Constructs a new VDiffOptions.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IVDiffOptions);
+
+        /** VDiffOptions source_cell. */
+        public source_cell: string;
+
+        /** VDiffOptions tablet_types. */
+        public tablet_types: string;
+
+        /** VDiffOptions tables. */
+        public tables: string;
+
+        /** VDiffOptions max_rows. */
+        public max_rows: (number|Long);
+
+        /** VDiffOptions filtered_replication_wait_time. */
+        public filtered_replication_wait_time: (number|Long);
+
+        /**
+         * Creates a new VDiffOptions instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns VDiffOptions instance
+         */
+        public static create(properties?: tabletmanagerdata.IVDiffOptions): tabletmanagerdata.VDiffOptions;
+
+        /**
+         * Encodes the specified VDiffOptions message. Does not implicitly {@link tabletmanagerdata.VDiffOptions.verify|verify} messages.
+         * @param message VDiffOptions message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IVDiffOptions, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified VDiffOptions message, length delimited. Does not implicitly {@link tabletmanagerdata.VDiffOptions.verify|verify} messages.
+         * @param message VDiffOptions message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IVDiffOptions, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a VDiffOptions message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns VDiffOptions
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.VDiffOptions;
+
+        /**
+         * Decodes a VDiffOptions message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns VDiffOptions
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.VDiffOptions;
+
+        /**
+         * Verifies a VDiffOptions message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a VDiffOptions message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns VDiffOptions
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.VDiffOptions;
+
+        /**
+         * Creates a plain object from a VDiffOptions message. Also converts values to other types if specified.
+         * @param message VDiffOptions
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.VDiffOptions, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this VDiffOptions to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a VDiffRequest. */
+    interface IVDiffRequest {
+
+        /** VDiffRequest keyspace */
+        keyspace?: (string|null);
+
+        /** VDiffRequest workflow */
+        workflow?: (string|null);
+
+        /** VDiffRequest action */
+        action?: (string|null);
+
+        /** VDiffRequest action_arg */
+        action_arg?: (string|null);
+
+        /** VDiffRequest vdiff_uuid */
+        vdiff_uuid?: (string|null);
+
+        /** VDiffRequest options */
+        options?: (tabletmanagerdata.IVDiffOptions|null);
+    }
+
+    /** Represents a VDiffRequest. */
+    class VDiffRequest implements IVDiffRequest {
+
+        /**
+         * Constructs a new VDiffRequest.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IVDiffRequest);
+
+        /** VDiffRequest keyspace. */
+        public keyspace: string;
+
+        /** VDiffRequest workflow. */
+        public workflow: string;
+
+        /** VDiffRequest action. */
+        public action: string;
+
+        /** VDiffRequest action_arg. */
+        public action_arg: string;
+
+        /** VDiffRequest vdiff_uuid. */
+        public vdiff_uuid: string;
+
+        /** VDiffRequest options. */
+        public options?: (tabletmanagerdata.IVDiffOptions|null);
+
+        /**
+         * Creates a new VDiffRequest instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns VDiffRequest instance
+         */
+        public static create(properties?: tabletmanagerdata.IVDiffRequest): tabletmanagerdata.VDiffRequest;
+
+        /**
+         * Encodes the specified VDiffRequest message. Does not implicitly {@link tabletmanagerdata.VDiffRequest.verify|verify} messages.
+         * @param message VDiffRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IVDiffRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified VDiffRequest message, length delimited. Does not implicitly {@link tabletmanagerdata.VDiffRequest.verify|verify} messages.
+         * @param message VDiffRequest message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IVDiffRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a VDiffRequest message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns VDiffRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.VDiffRequest;
+
+        /**
+         * Decodes a VDiffRequest message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns VDiffRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.VDiffRequest;
+
+        /**
+         * Verifies a VDiffRequest message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a VDiffRequest message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns VDiffRequest
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.VDiffRequest;
+
+        /**
+         * Creates a plain object from a VDiffRequest message. Also converts values to other types if specified.
+         * @param message VDiffRequest
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.VDiffRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this VDiffRequest to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
+
+    /** Properties of a VDiffResponse. */
+    interface IVDiffResponse {
+
+        /** VDiffResponse id */
+        id?: (number|Long|null);
+
+        /** VDiffResponse output */
+        output?: (query.IQueryResult|null);
+
+        /** VDiffResponse vdiff_uuid */
+        vdiff_uuid?: (string|null);
+    }
+
+    /** Represents a VDiffResponse. */
+    class VDiffResponse implements IVDiffResponse {
+
+        /**
+         * Constructs a new VDiffResponse.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: tabletmanagerdata.IVDiffResponse);
+
+        /** VDiffResponse id. */
+        public id: (number|Long);
+
+        /** VDiffResponse output. */
+        public output?: (query.IQueryResult|null);
+
+        /** VDiffResponse vdiff_uuid. */
+        public vdiff_uuid: string;
+
+        /**
+         * Creates a new VDiffResponse instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns VDiffResponse instance
+         */
+        public static create(properties?: tabletmanagerdata.IVDiffResponse): tabletmanagerdata.VDiffResponse;
+
+        /**
+         * Encodes the specified VDiffResponse message. Does not implicitly {@link tabletmanagerdata.VDiffResponse.verify|verify} messages.
+         * @param message VDiffResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: tabletmanagerdata.IVDiffResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified VDiffResponse message, length delimited. Does not implicitly {@link tabletmanagerdata.VDiffResponse.verify|verify} messages.
+         * @param message VDiffResponse message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: tabletmanagerdata.IVDiffResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a VDiffResponse message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns VDiffResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): tabletmanagerdata.VDiffResponse;
+
+        /**
+         * Decodes a VDiffResponse message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns VDiffResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): tabletmanagerdata.VDiffResponse;
+
+        /**
+         * Verifies a VDiffResponse message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a VDiffResponse message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns VDiffResponse
+         */
+        public static fromObject(object: { [k: string]: any }): tabletmanagerdata.VDiffResponse;
+
+        /**
+         * Creates a plain object from a VDiffResponse message. Also converts values to other types if specified.
+         * @param message VDiffResponse
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: tabletmanagerdata.VDiffResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this VDiffResponse to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
 }
 
 /** Namespace query. */
@@ -12723,6 +13347,9 @@ export namespace query {
 
         /** ExecuteOptions has_created_temp_tables */
         has_created_temp_tables?: (boolean|null);
+
+        /** ExecuteOptions priority */
+        priority?: (query.ExecuteOptions.Priority|null);
     }
 
     /** Represents an ExecuteOptions. */
@@ -12758,6 +13385,9 @@ export namespace query {
         /** ExecuteOptions has_created_temp_tables. */
         public has_created_temp_tables: boolean;
 
+        /** ExecuteOptions priority. */
+        public priority: query.ExecuteOptions.Priority;
+
         /**
          * Creates a new ExecuteOptions instance using the specified properties.
          * @param [properties] Properties to set
@@ -12866,6 +13496,13 @@ export namespace query {
             Gen4Left2Right = 4,
             Gen4WithFallback = 5
         }
+
+        /** Priority enum. */
+        enum Priority {
+            NORMAL = 0,
+            HIGH = 1,
+            LOW = 2
+        }
     }
 
     /** Properties of a Field. */
@@ -16927,6 +17564,9 @@ export namespace query {
 
         /** MessageStreamRequest name */
         name?: (string|null);
+
+        /** MessageStreamRequest group */
+        group?: (string|null);
     }
 
     /** Represents a MessageStreamRequest. */
@@ -16950,6 +17590,9 @@ export namespace query {
         /** MessageStreamRequest name. */
         public name: string;
 
+        /** MessageStreamRequest group. */
+        public group: string;
+
         /**
          * Creates a new MessageStreamRequest instance using the specified properties.
          * @param [properties] Properties to set
@@ -17128,6 +17771,9 @@ export namespace query {
 
         /** MessageAckRequest ids */
         ids?: (query.IValue[]|null);
+
+        /** MessageAckRequest group */
+        group?: (string|null);
     }
 
     /** Represents a MessageAckRequest. */
@@ -17154,6 +17800,9 @@ export namespace query {
         /** MessageAckRequest ids. */
         public ids: query.IValue[];
 
+        /** MessageAckRequest group. */
+        public group: string;
+
         /**
          * Creates a new MessageAckRequest instance using the specified properties.
          * @param [properties] Properties to set
@@ -18085,6 +18734,9 @@ export namespace query {
 
         /** RealtimeStats qps */
         qps?: (number|null);
+
+        /** RealtimeStats table_schema_changed */
+        table_schema_changed?: (string[]|null);
     }
 
     /** Represents a RealtimeStats. */
@@ -18114,6 +18766,9 @@ export namespace query {
         /** RealtimeStats qps. */
         public qps: number;
 
+        /** RealtimeStats table_schema_changed. */
+        public table_schema_changed: string[];
+
         /**
          * Creates a new RealtimeStats instance using the specified properties.
          * @param [properties] Properties to set
@@ -21052,7 +21707,8 @@ export namespace vtrpc {
         UNIMPLEMENTED = 12,
         INTERNAL = 13,
         UNAVAILABLE = 14,
-        DATA_LOSS = 15
+        DATA_LOSS = 15,
+        CLUSTER_EVENT = 17
     }
 
     /** LegacyErrorCode enum. */
@@ -21862,6 +22518,9 @@ export namespace vschema {
 
         /** Keyspace require_explicit_routing */
         require_explicit_routing?: (boolean|null);
+
+        /** Keyspace views */
+        views?: ({ [k: string]: string }|null);
     }
 
     /** Represents a Keyspace. */
@@ -21885,6 +22544,9 @@ export namespace vschema {
         /** Keyspace require_explicit_routing. */
         public require_explicit_routing: boolean;
 
+        /** Keyspace views. */
+        public views: { [k: string]: string };
+
         /**
          * Creates a new Keyspace instance using the specified properties.
          * @param [properties] Properties to set
@@ -22078,6 +22740,9 @@ export namespace vschema {
 
         /** Table column_list_authoritative */
         column_list_authoritative?: (boolean|null);
+
+        /** Table result_cache */
+        result_cache?: (boolean|null);
     }
 
     /** Represents a Table. */
@@ -22107,6 +22772,9 @@ export namespace vschema {
         /** Table column_list_authoritative. */
         public column_list_authoritative: boolean;
 
+        /** Table result_cache. */
+        public result_cache: boolean;
+
         /**
          * Creates a new Table instance using the specified properties.
          * @param [properties] Properties to set
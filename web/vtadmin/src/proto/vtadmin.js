@@ -26530,6 +26530,1550 @@ $root.tabletmanagerdata = (function() {
         return VExecResponse;
     })();
 
+    tabletmanagerdata.GetHotRowsRequest = (function() {
+
+        /**
+         * Properties of a GetHotRowsRequest.
+         * @memberof tabletmanagerdata
+         * @interface IGetHotRowsRequest
+         */
+
+        /**
+         * Constructs a new GetHotRowsRequest.
+         * @memberof tabletmanagerdata
+         * @classdesc Represents a GetHotRowsRequest.
+         * @implements IGetHotRowsRequest
+         * @constructor
+         * @param {tabletmanagerdata.IGetHotRowsRequest=} [properties] Properties to set
+         */
+        function GetHotRowsRequest(properties) {
+            if (properties)
+                for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                    if (properties[keys[i]] != null)
+                        this[keys[i]] = properties[keys[i]];
+        }
+
+        /**
+         * Creates a new GetHotRowsRequest instance using the specified properties.
+         * @function create
+         * @memberof tabletmanagerdata.GetHotRowsRequest
+         * @static
+         * @param {tabletmanagerdata.IGetHotRowsRequest=} [properties] Properties to set
+         * @returns {tabletmanagerdata.GetHotRowsRequest} GetHotRowsRequest instance
+         */
+        GetHotRowsRequest.create = function create(properties) {
+            return new GetHotRowsRequest(properties);
+        };
+
+        /**
+         * Encodes the specified GetHotRowsRequest message. Does not implicitly {@link tabletmanagerdata.GetHotRowsRequest.verify|verify} messages.
+         * @function encode
+         * @memberof tabletmanagerdata.GetHotRowsRequest
+         * @static
+         * @param {tabletmanagerdata.IGetHotRowsRequest} message GetHotRowsRequest message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        GetHotRowsRequest.encode = function encode(message, writer) {
+            if (!writer)
+                writer = $Writer.create();
+            return writer;
+        };
+
+        /**
+         * Encodes the specified GetHotRowsRequest message, length delimited. Does not implicitly {@link tabletmanagerdata.GetHotRowsRequest.verify|verify} messages.
+         * @function encodeDelimited
+         * @memberof tabletmanagerdata.GetHotRowsRequest
+         * @static
+         * @param {tabletmanagerdata.IGetHotRowsRequest} message GetHotRowsRequest message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        GetHotRowsRequest.encodeDelimited = function encodeDelimited(message, writer) {
+            return this.encode(message, writer).ldelim();
+        };
+
+        /**
+         * Decodes a GetHotRowsRequest message from the specified reader or buffer.
+         * @function decode
+         * @memberof tabletmanagerdata.GetHotRowsRequest
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @param {number} [length] Message length if known beforehand
+         * @returns {tabletmanagerdata.GetHotRowsRequest} GetHotRowsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        GetHotRowsRequest.decode = function decode(reader, length) {
+            if (!(reader instanceof $Reader))
+                reader = $Reader.create(reader);
+            var end = length === undefined ? reader.len : reader.pos + length, message = new $root.tabletmanagerdata.GetHotRowsRequest();
+            while (reader.pos < end) {
+                var tag = reader.uint32();
+                switch (tag >>> 3) {
+                default:
+                    reader.skipType(tag & 7);
+                    break;
+                }
+            }
+            return message;
+        };
+
+        /**
+         * Decodes a GetHotRowsRequest message from the specified reader or buffer, length delimited.
+         * @function decodeDelimited
+         * @memberof tabletmanagerdata.GetHotRowsRequest
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @returns {tabletmanagerdata.GetHotRowsRequest} GetHotRowsRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        GetHotRowsRequest.decodeDelimited = function decodeDelimited(reader) {
+            if (!(reader instanceof $Reader))
+                reader = new $Reader(reader);
+            return this.decode(reader, reader.uint32());
+        };
+
+        /**
+         * Verifies a GetHotRowsRequest message.
+         * @function verify
+         * @memberof tabletmanagerdata.GetHotRowsRequest
+         * @static
+         * @param {Object.<string,*>} message Plain object to verify
+         * @returns {string|null} `null` if valid, otherwise the reason why it is not
+         */
+        GetHotRowsRequest.verify = function verify(message) {
+            if (typeof message !== "object" || message === null)
+                return "object expected";
+            return null;
+        };
+
+        /**
+         * Creates a GetHotRowsRequest message from a plain object. Also converts values to their respective internal types.
+         * @function fromObject
+         * @memberof tabletmanagerdata.GetHotRowsRequest
+         * @static
+         * @param {Object.<string,*>} object Plain object
+         * @returns {tabletmanagerdata.GetHotRowsRequest} GetHotRowsRequest
+         */
+        GetHotRowsRequest.fromObject = function fromObject(object) {
+            if (object instanceof $root.tabletmanagerdata.GetHotRowsRequest)
+                return object;
+            return new $root.tabletmanagerdata.GetHotRowsRequest();
+        };
+
+        /**
+         * Creates a plain object from a GetHotRowsRequest message. Also converts values to other types if specified.
+         * @function toObject
+         * @memberof tabletmanagerdata.GetHotRowsRequest
+         * @static
+         * @param {tabletmanagerdata.GetHotRowsRequest} message GetHotRowsRequest
+         * @param {$protobuf.IConversionOptions} [options] Conversion options
+         * @returns {Object.<string,*>} Plain object
+         */
+        GetHotRowsRequest.toObject = function toObject() {
+            return {};
+        };
+
+        /**
+         * Converts this GetHotRowsRequest to JSON.
+         * @function toJSON
+         * @memberof tabletmanagerdata.GetHotRowsRequest
+         * @instance
+         * @returns {Object.<string,*>} JSON object
+         */
+        GetHotRowsRequest.prototype.toJSON = function toJSON() {
+            return this.constructor.toObject(this, $protobuf.util.toJSONOptions);
+        };
+
+        return GetHotRowsRequest;
+    })();
+
+    tabletmanagerdata.HotRow = (function() {
+
+        /**
+         * Properties of a HotRow.
+         * @memberof tabletmanagerdata
+         * @interface IHotRow
+         * @property {string|null} [key] HotRow key
+         * @property {string|null} [table] HotRow table
+         * @property {number|Long|null} [pending] HotRow pending
+         * @property {number|Long|null} [max] HotRow max
+         * @property {number|Long|null} [count] HotRow count
+         */
+
+        /**
+         * Constructs a new HotRow.
+         * @memberof tabletmanagerdata
+         * @classdesc Represents a HotRow.
+         * @implements IHotRow
+         * @constructor
+         * @param {tabletmanagerdata.IHotRow=} [properties] Properties to set
+         */
+        function HotRow(properties) {
+            if (properties)
+                for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                    if (properties[keys[i]] != null)
+                        this[keys[i]] = properties[keys[i]];
+        }
+
+        /**
+         * HotRow key.
+         * @member {string} key
+         * @memberof tabletmanagerdata.HotRow
+         * @instance
+         */
+        HotRow.prototype.key = "";
+
+        /**
+         * HotRow table.
+         * @member {string} table
+         * @memberof tabletmanagerdata.HotRow
+         * @instance
+         */
+        HotRow.prototype.table = "";
+
+        /**
+         * HotRow pending.
+         * @member {number|Long} pending
+         * @memberof tabletmanagerdata.HotRow
+         * @instance
+         */
+        HotRow.prototype.pending = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+
+        /**
+         * HotRow max.
+         * @member {number|Long} max
+         * @memberof tabletmanagerdata.HotRow
+         * @instance
+         */
+        HotRow.prototype.max = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+
+        /**
+         * HotRow count.
+         * @member {number|Long} count
+         * @memberof tabletmanagerdata.HotRow
+         * @instance
+         */
+        HotRow.prototype.count = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+
+        /**
+         * Creates a new HotRow instance using the specified properties.
+         * @function create
+         * @memberof tabletmanagerdata.HotRow
+         * @static
+         * @param {tabletmanagerdata.IHotRow=} [properties] Properties to set
+         * @returns {tabletmanagerdata.HotRow} HotRow instance
+         */
+        HotRow.create = function create(properties) {
+            return new HotRow(properties);
+        };
+
+        /**
+         * Encodes the specified HotRow message. Does not implicitly {@link tabletmanagerdata.HotRow.verify|verify} messages.
+         * @function encode
+         * @memberof tabletmanagerdata.HotRow
+         * @static
+         * @param {tabletmanagerdata.IHotRow} message HotRow message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        HotRow.encode = function encode(message, writer) {
+            if (!writer)
+                writer = $Writer.create();
+            if (message.key != null && Object.hasOwnProperty.call(message, "key"))
+                writer.uint32(/* id 1, wireType 2 =*/10).string(message.key);
+            if (message.table != null && Object.hasOwnProperty.call(message, "table"))
+                writer.uint32(/* id 2, wireType 2 =*/18).string(message.table);
+            if (message.pending != null && Object.hasOwnProperty.call(message, "pending"))
+                writer.uint32(/* id 3, wireType 0 =*/24).int64(message.pending);
+            if (message.max != null && Object.hasOwnProperty.call(message, "max"))
+                writer.uint32(/* id 4, wireType 0 =*/32).int64(message.max);
+            if (message.count != null && Object.hasOwnProperty.call(message, "count"))
+                writer.uint32(/* id 5, wireType 0 =*/40).int64(message.count);
+            return writer;
+        };
+
+        /**
+         * Encodes the specified HotRow message, length delimited. Does not implicitly {@link tabletmanagerdata.HotRow.verify|verify} messages.
+         * @function encodeDelimited
+         * @memberof tabletmanagerdata.HotRow
+         * @static
+         * @param {tabletmanagerdata.IHotRow} message HotRow message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        HotRow.encodeDelimited = function encodeDelimited(message, writer) {
+            return this.encode(message, writer).ldelim();
+        };
+
+        /**
+         * Decodes a HotRow message from the specified reader or buffer.
+         * @function decode
+         * @memberof tabletmanagerdata.HotRow
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @param {number} [length] Message length if known beforehand
+         * @returns {tabletmanagerdata.HotRow} HotRow
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        HotRow.decode = function decode(reader, length) {
+            if (!(reader instanceof $Reader))
+                reader = $Reader.create(reader);
+            var end = length === undefined ? reader.len : reader.pos + length, message = new $root.tabletmanagerdata.HotRow();
+            while (reader.pos < end) {
+                var tag = reader.uint32();
+                switch (tag >>> 3) {
+                case 1:
+                    message.key = reader.string();
+                    break;
+                case 2:
+                    message.table = reader.string();
+                    break;
+                case 3:
+                    message.pending = reader.int64();
+                    break;
+                case 4:
+                    message.max = reader.int64();
+                    break;
+                case 5:
+                    message.count = reader.int64();
+                    break;
+                default:
+                    reader.skipType(tag & 7);
+                    break;
+                }
+            }
+            return message;
+        };
+
+        /**
+         * Decodes a HotRow message from the specified reader or buffer, length delimited.
+         * @function decodeDelimited
+         * @memberof tabletmanagerdata.HotRow
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @returns {tabletmanagerdata.HotRow} HotRow
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        HotRow.decodeDelimited = function decodeDelimited(reader) {
+            if (!(reader instanceof $Reader))
+                reader = new $Reader(reader);
+            return this.decode(reader, reader.uint32());
+        };
+
+        /**
+         * Verifies a HotRow message.
+         * @function verify
+         * @memberof tabletmanagerdata.HotRow
+         * @static
+         * @param {Object.<string,*>} message Plain object to verify
+         * @returns {string|null} `null` if valid, otherwise the reason why it is not
+         */
+        HotRow.verify = function verify(message) {
+            if (typeof message !== "object" || message === null)
+                return "object expected";
+            if (message.key != null && message.hasOwnProperty("key"))
+                if (!$util.isString(message.key))
+                    return "key: string expected";
+            if (message.table != null && message.hasOwnProperty("table"))
+                if (!$util.isString(message.table))
+                    return "table: string expected";
+            if (message.pending != null && message.hasOwnProperty("pending"))
+                if (!$util.isInteger(message.pending) && !(message.pending && $util.isInteger(message.pending.low) && $util.isInteger(message.pending.high)))
+                    return "pending: integer|Long expected";
+            if (message.max != null && message.hasOwnProperty("max"))
+                if (!$util.isInteger(message.max) && !(message.max && $util.isInteger(message.max.low) && $util.isInteger(message.max.high)))
+                    return "max: integer|Long expected";
+            if (message.count != null && message.hasOwnProperty("count"))
+                if (!$util.isInteger(message.count) && !(message.count && $util.isInteger(message.count.low) && $util.isInteger(message.count.high)))
+                    return "count: integer|Long expected";
+            return null;
+        };
+
+        /**
+         * Creates a HotRow message from a plain object. Also converts values to their respective internal types.
+         * @function fromObject
+         * @memberof tabletmanagerdata.HotRow
+         * @static
+         * @param {Object.<string,*>} object Plain object
+         * @returns {tabletmanagerdata.HotRow} HotRow
+         */
+        HotRow.fromObject = function fromObject(object) {
+            if (object instanceof $root.tabletmanagerdata.HotRow)
+                return object;
+            var message = new $root.tabletmanagerdata.HotRow();
+            if (object.key != null)
+                message.key = String(object.key);
+            if (object.table != null)
+                message.table = String(object.table);
+            if (object.pending != null)
+                if ($util.Long)
+                    (message.pending = $util.Long.fromValue(object.pending)).unsigned = false;
+                else if (typeof object.pending === "string")
+                    message.pending = parseInt(object.pending, 10);
+                else if (typeof object.pending === "number")
+                    message.pending = object.pending;
+                else if (typeof object.pending === "object")
+                    message.pending = new $util.LongBits(object.pending.low >>> 0, object.pending.high >>> 0).toNumber();
+            if (object.max != null)
+                if ($util.Long)
+                    (message.max = $util.Long.fromValue(object.max)).unsigned = false;
+                else if (typeof object.max === "string")
+                    message.max = parseInt(object.max, 10);
+                else if (typeof object.max === "number")
+                    message.max = object.max;
+                else if (typeof object.max === "object")
+                    message.max = new $util.LongBits(object.max.low >>> 0, object.max.high >>> 0).toNumber();
+            if (object.count != null)
+                if ($util.Long)
+                    (message.count = $util.Long.fromValue(object.count)).unsigned = false;
+                else if (typeof object.count === "string")
+                    message.count = parseInt(object.count, 10);
+                else if (typeof object.count === "number")
+                    message.count = object.count;
+                else if (typeof object.count === "object")
+                    message.count = new $util.LongBits(object.count.low >>> 0, object.count.high >>> 0).toNumber();
+            return message;
+        };
+
+        /**
+         * Creates a plain object from a HotRow message. Also converts values to other types if specified.
+         * @function toObject
+         * @memberof tabletmanagerdata.HotRow
+         * @static
+         * @param {tabletmanagerdata.HotRow} message HotRow
+         * @param {$protobuf.IConversionOptions} [options] Conversion options
+         * @returns {Object.<string,*>} Plain object
+         */
+        HotRow.toObject = function toObject(message, options) {
+            if (!options)
+                options = {};
+            var object = {};
+            if (options.defaults) {
+                object.key = "";
+                object.table = "";
+                if ($util.Long) {
+                    var long = new $util.Long(0, 0, false);
+                    object.pending = options.longs === String ? long.toString() : options.longs === Number ? long.toNumber() : long;
+                } else
+                    object.pending = options.longs === String ? "0" : 0;
+                if ($util.Long) {
+                    var long = new $util.Long(0, 0, false);
+                    object.max = options.longs === String ? long.toString() : options.longs === Number ? long.toNumber() : long;
+                } else
+                    object.max = options.longs === String ? "0" : 0;
+                if ($util.Long) {
+                    var long = new $util.Long(0, 0, false);
+                    object.count = options.longs === String ? long.toString() : options.longs === Number ? long.toNumber() : long;
+                } else
+                    object.count = options.longs === String ? "0" : 0;
+            }
+            if (message.key != null && message.hasOwnProperty("key"))
+                object.key = message.key;
+            if (message.table != null && message.hasOwnProperty("table"))
+                object.table = message.table;
+            if (message.pending != null && message.hasOwnProperty("pending"))
+                if (typeof message.pending === "number")
+                    object.pending = options.longs === String ? String(message.pending) : message.pending;
+                else
+                    object.pending = options.longs === String ? $util.Long.prototype.toString.call(message.pending) : options.longs === Number ? new $util.LongBits(message.pending.low >>> 0, message.pending.high >>> 0).toNumber() : message.pending;
+            if (message.max != null && message.hasOwnProperty("max"))
+                if (typeof message.max === "number")
+                    object.max = options.longs === String ? String(message.max) : message.max;
+                else
+                    object.max = options.longs === String ? $util.Long.prototype.toString.call(message.max) : options.longs === Number ? new $util.LongBits(message.max.low >>> 0, message.max.high >>> 0).toNumber() : message.max;
+            if (message.count != null && message.hasOwnProperty("count"))
+                if (typeof message.count === "number")
+                    object.count = options.longs === String ? String(message.count) : message.count;
+                else
+                    object.count = options.longs === String ? $util.Long.prototype.toString.call(message.count) : options.longs === Number ? new $util.LongBits(message.count.low >>> 0, message.count.high >>> 0).toNumber() : message.count;
+            return object;
+        };
+
+        /**
+         * Converts this HotRow to JSON.
+         * @function toJSON
+         * @memberof tabletmanagerdata.HotRow
+         * @instance
+         * @returns {Object.<string,*>} JSON object
+         */
+        HotRow.prototype.toJSON = function toJSON() {
+            return this.constructor.toObject(this, $protobuf.util.toJSONOptions);
+        };
+
+        return HotRow;
+    })();
+
+    tabletmanagerdata.GetHotRowsResponse = (function() {
+
+        /**
+         * Properties of a GetHotRowsResponse.
+         * @memberof tabletmanagerdata
+         * @interface IGetHotRowsResponse
+         * @property {Array.<tabletmanagerdata.IHotRow>|null} [hot_rows] GetHotRowsResponse hot_rows
+         */
+
+        /**
+         * Constructs a new GetHotRowsResponse.
+         * @memberof tabletmanagerdata
+         * @classdesc Represents a GetHotRowsResponse.
+         * @implements IGetHotRowsResponse
+         * @constructor
+         * @param {tabletmanagerdata.IGetHotRowsResponse=} [properties] Properties to set
+         */
+        function GetHotRowsResponse(properties) {
+            this.hot_rows = [];
+            if (properties)
+                for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                    if (properties[keys[i]] != null)
+                        this[keys[i]] = properties[keys[i]];
+        }
+
+        /**
+         * GetHotRowsResponse hot_rows.
+         * @member {Array.<tabletmanagerdata.IHotRow>} hot_rows
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @instance
+         */
+        GetHotRowsResponse.prototype.hot_rows = $util.emptyArray;
+
+        /**
+         * Creates a new GetHotRowsResponse instance using the specified properties.
+         * @function create
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @static
+         * @param {tabletmanagerdata.IGetHotRowsResponse=} [properties] Properties to set
+         * @returns {tabletmanagerdata.GetHotRowsResponse} GetHotRowsResponse instance
+         */
+        GetHotRowsResponse.create = function create(properties) {
+            return new GetHotRowsResponse(properties);
+        };
+
+        /**
+         * Encodes the specified GetHotRowsResponse message. Does not implicitly {@link tabletmanagerdata.GetHotRowsResponse.verify|verify} messages.
+         * @function encode
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @static
+         * @param {tabletmanagerdata.IGetHotRowsResponse} message GetHotRowsResponse message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        GetHotRowsResponse.encode = function encode(message, writer) {
+            if (!writer)
+                writer = $Writer.create();
+            if (message.hot_rows != null && message.hot_rows.length)
+                for (var i = 0; i < message.hot_rows.length; ++i)
+                    $root.tabletmanagerdata.HotRow.encode(message.hot_rows[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+            return writer;
+        };
+
+        /**
+         * Encodes the specified GetHotRowsResponse message, length delimited. Does not implicitly {@link tabletmanagerdata.GetHotRowsResponse.verify|verify} messages.
+         * @function encodeDelimited
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @static
+         * @param {tabletmanagerdata.IGetHotRowsResponse} message GetHotRowsResponse message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        GetHotRowsResponse.encodeDelimited = function encodeDelimited(message, writer) {
+            return this.encode(message, writer).ldelim();
+        };
+
+        /**
+         * Decodes a GetHotRowsResponse message from the specified reader or buffer.
+         * @function decode
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @param {number} [length] Message length if known beforehand
+         * @returns {tabletmanagerdata.GetHotRowsResponse} GetHotRowsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        GetHotRowsResponse.decode = function decode(reader, length) {
+            if (!(reader instanceof $Reader))
+                reader = $Reader.create(reader);
+            var end = length === undefined ? reader.len : reader.pos + length, message = new $root.tabletmanagerdata.GetHotRowsResponse();
+            while (reader.pos < end) {
+                var tag = reader.uint32();
+                switch (tag >>> 3) {
+                case 1:
+                    if (!(message.hot_rows && message.hot_rows.length))
+                        message.hot_rows = [];
+                    message.hot_rows.push($root.tabletmanagerdata.HotRow.decode(reader, reader.uint32()));
+                    break;
+                default:
+                    reader.skipType(tag & 7);
+                    break;
+                }
+            }
+            return message;
+        };
+
+        /**
+         * Decodes a GetHotRowsResponse message from the specified reader or buffer, length delimited.
+         * @function decodeDelimited
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @returns {tabletmanagerdata.GetHotRowsResponse} GetHotRowsResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        GetHotRowsResponse.decodeDelimited = function decodeDelimited(reader) {
+            if (!(reader instanceof $Reader))
+                reader = new $Reader(reader);
+            return this.decode(reader, reader.uint32());
+        };
+
+        /**
+         * Verifies a GetHotRowsResponse message.
+         * @function verify
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @static
+         * @param {Object.<string,*>} message Plain object to verify
+         * @returns {string|null} `null` if valid, otherwise the reason why it is not
+         */
+        GetHotRowsResponse.verify = function verify(message) {
+            if (typeof message !== "object" || message === null)
+                return "object expected";
+            if (message.hot_rows != null && message.hasOwnProperty("hot_rows")) {
+                if (!Array.isArray(message.hot_rows))
+                    return "hot_rows: array expected";
+                for (var i = 0; i < message.hot_rows.length; ++i) {
+                    var error = $root.tabletmanagerdata.HotRow.verify(message.hot_rows[i]);
+                    if (error)
+                        return "hot_rows." + error;
+                }
+            }
+            return null;
+        };
+
+        /**
+         * Creates a GetHotRowsResponse message from a plain object. Also converts values to their respective internal types.
+         * @function fromObject
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @static
+         * @param {Object.<string,*>} object Plain object
+         * @returns {tabletmanagerdata.GetHotRowsResponse} GetHotRowsResponse
+         */
+        GetHotRowsResponse.fromObject = function fromObject(object) {
+            if (object instanceof $root.tabletmanagerdata.GetHotRowsResponse)
+                return object;
+            var message = new $root.tabletmanagerdata.GetHotRowsResponse();
+            if (object.hot_rows) {
+                if (!Array.isArray(object.hot_rows))
+                    throw TypeError(".tabletmanagerdata.GetHotRowsResponse.hot_rows: array expected");
+                message.hot_rows = [];
+                for (var i = 0; i < object.hot_rows.length; ++i) {
+                    if (typeof object.hot_rows[i] !== "object")
+                        throw TypeError(".tabletmanagerdata.GetHotRowsResponse.hot_rows: object expected");
+                    message.hot_rows[i] = $root.tabletmanagerdata.HotRow.fromObject(object.hot_rows[i]);
+                }
+            }
+            return message;
+        };
+
+        /**
+         * Creates a plain object from a GetHotRowsResponse message. Also converts values to other types if specified.
+         * @function toObject
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @static
+         * @param {tabletmanagerdata.GetHotRowsResponse} message GetHotRowsResponse
+         * @param {$protobuf.IConversionOptions} [options] Conversion options
+         * @returns {Object.<string,*>} Plain object
+         */
+        GetHotRowsResponse.toObject = function toObject(message, options) {
+            if (!options)
+                options = {};
+            var object = {};
+            if (options.arrays || options.defaults)
+                object.hot_rows = [];
+            if (message.hot_rows && message.hot_rows.length) {
+                object.hot_rows = [];
+                for (var j = 0; j < message.hot_rows.length; ++j)
+                    object.hot_rows[j] = $root.tabletmanagerdata.HotRow.toObject(message.hot_rows[j], options);
+            }
+            return object;
+        };
+
+        /**
+         * Converts this GetHotRowsResponse to JSON.
+         * @function toJSON
+         * @memberof tabletmanagerdata.GetHotRowsResponse
+         * @instance
+         * @returns {Object.<string,*>} JSON object
+         */
+        GetHotRowsResponse.prototype.toJSON = function toJSON() {
+            return this.constructor.toObject(this, $protobuf.util.toJSONOptions);
+        };
+
+        return GetHotRowsResponse;
+    })();
+
+    tabletmanagerdata.VDiffOptions = (function() {
+
+        /**
+         * Properties of a VDiffOptions.
+         * @memberof tabletmanagerdata
+         * @interface IVDiffOptions
+         * @property {string|null} [source_cell] VDiffOptions source_cell
+         * @property {string|null} [tablet_types] VDiffOptions tablet_types
+         * @property {string|null} [tables] VDiffOptions tables
+         * @property {number|Long|null} [max_rows] VDiffOptions max_rows
+         * @property {number|Long|null} [filtered_replication_wait_time] VDiffOptions filtered_replication_wait_time
+         */
+
+        /**
+         * Constructs a new VDiffOptions.
+         * @memberof tabletmanagerdata
+         * @classdesc Represents a VDiffOptions.
+         * @implements IVDiffOptions
+         * @constructor
+         * @param {tabletmanagerdata.IVDiffOptions=} [properties] Properties to set
+         */
+        function VDiffOptions(properties) {
+            if (properties)
+                for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                    if (properties[keys[i]] != null)
+                        this[keys[i]] = properties[keys[i]];
+        }
+
+        /**
+         * VDiffOptions source_cell.
+         * @member {string} source_cell
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @instance
+         */
+        VDiffOptions.prototype.source_cell = "";
+
+        /**
+         * VDiffOptions tablet_types.
+         * @member {string} tablet_types
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @instance
+         */
+        VDiffOptions.prototype.tablet_types = "";
+
+        /**
+         * VDiffOptions tables.
+         * @member {string} tables
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @instance
+         */
+        VDiffOptions.prototype.tables = "";
+
+        /**
+         * VDiffOptions max_rows.
+         * @member {number|Long} max_rows
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @instance
+         */
+        VDiffOptions.prototype.max_rows = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+
+        /**
+         * VDiffOptions filtered_replication_wait_time.
+         * @member {number|Long} filtered_replication_wait_time
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @instance
+         */
+        VDiffOptions.prototype.filtered_replication_wait_time = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+
+        /**
+         * Creates a new VDiffOptions instance using the specified properties.
+         * @function create
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @static
+         * @param {tabletmanagerdata.IVDiffOptions=} [properties] Properties to set
+         * @returns {tabletmanagerdata.VDiffOptions} VDiffOptions instance
+         */
+        VDiffOptions.create = function create(properties) {
+            return new VDiffOptions(properties);
+        };
+
+        /**
+         * Encodes the specified VDiffOptions message. Does not implicitly {@link tabletmanagerdata.VDiffOptions.verify|verify} messages.
+         * @function encode
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @static
+         * @param {tabletmanagerdata.IVDiffOptions} message VDiffOptions message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        VDiffOptions.encode = function encode(message, writer) {
+            if (!writer)
+                writer = $Writer.create();
+            if (message.source_cell != null && Object.hasOwnProperty.call(message, "source_cell"))
+                writer.uint32(/* id 1, wireType 2 =*/10).string(message.source_cell);
+            if (message.tablet_types != null && Object.hasOwnProperty.call(message, "tablet_types"))
+                writer.uint32(/* id 2, wireType 2 =*/18).string(message.tablet_types);
+            if (message.tables != null && Object.hasOwnProperty.call(message, "tables"))
+                writer.uint32(/* id 3, wireType 2 =*/26).string(message.tables);
+            if (message.max_rows != null && Object.hasOwnProperty.call(message, "max_rows"))
+                writer.uint32(/* id 4, wireType 0 =*/32).int64(message.max_rows);
+            if (message.filtered_replication_wait_time != null && Object.hasOwnProperty.call(message, "filtered_replication_wait_time"))
+                writer.uint32(/* id 5, wireType 0 =*/40).int64(message.filtered_replication_wait_time);
+            return writer;
+        };
+
+        /**
+         * Encodes the specified VDiffOptions message, length delimited. Does not implicitly {@link tabletmanagerdata.VDiffOptions.verify|verify} messages.
+         * @function encodeDelimited
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @static
+         * @param {tabletmanagerdata.IVDiffOptions} message VDiffOptions message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        VDiffOptions.encodeDelimited = function encodeDelimited(message, writer) {
+            return this.encode(message, writer).ldelim();
+        };
+
+        /**
+         * Decodes a VDiffOptions message from the specified reader or buffer.
+         * @function decode
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @param {number} [length] Message length if known beforehand
+         * @returns {tabletmanagerdata.VDiffOptions} VDiffOptions
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        VDiffOptions.decode = function decode(reader, length) {
+            if (!(reader instanceof $Reader))
+                reader = $Reader.create(reader);
+            var end = length === undefined ? reader.len : reader.pos + length, message = new $root.tabletmanagerdata.VDiffOptions();
+            while (reader.pos < end) {
+                var tag = reader.uint32();
+                switch (tag >>> 3) {
+                case 1:
+                    message.source_cell = reader.string();
+                    break;
+                case 2:
+                    message.tablet_types = reader.string();
+                    break;
+                case 3:
+                    message.tables = reader.string();
+                    break;
+                case 4:
+                    message.max_rows = reader.int64();
+                    break;
+                case 5:
+                    message.filtered_replication_wait_time = reader.int64();
+                    break;
+                default:
+                    reader.skipType(tag & 7);
+                    break;
+                }
+            }
+            return message;
+        };
+
+        /**
+         * Decodes a VDiffOptions message from the specified reader or buffer, length delimited.
+         * @function decodeDelimited
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @returns {tabletmanagerdata.VDiffOptions} VDiffOptions
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        VDiffOptions.decodeDelimited = function decodeDelimited(reader) {
+            if (!(reader instanceof $Reader))
+                reader = new $Reader(reader);
+            return this.decode(reader, reader.uint32());
+        };
+
+        /**
+         * Verifies a VDiffOptions message.
+         * @function verify
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @static
+         * @param {Object.<string,*>} message Plain object to verify
+         * @returns {string|null} `null` if valid, otherwise the reason why it is not
+         */
+        VDiffOptions.verify = function verify(message) {
+            if (typeof message !== "object" || message === null)
+                return "object expected";
+            if (message.source_cell != null && message.hasOwnProperty("source_cell"))
+                if (!$util.isString(message.source_cell))
+                    return "source_cell: string expected";
+            if (message.tablet_types != null && message.hasOwnProperty("tablet_types"))
+                if (!$util.isString(message.tablet_types))
+                    return "tablet_types: string expected";
+            if (message.tables != null && message.hasOwnProperty("tables"))
+                if (!$util.isString(message.tables))
+                    return "tables: string expected";
+            if (message.max_rows != null && message.hasOwnProperty("max_rows"))
+                if (!$util.isInteger(message.max_rows) && !(message.max_rows && $util.isInteger(message.max_rows.low) && $util.isInteger(message.max_rows.high)))
+                    return "max_rows: integer|Long expected";
+            if (message.filtered_replication_wait_time != null && message.hasOwnProperty("filtered_replication_wait_time"))
+                if (!$util.isInteger(message.filtered_replication_wait_time) && !(message.filtered_replication_wait_time && $util.isInteger(message.filtered_replication_wait_time.low) && $util.isInteger(message.filtered_replication_wait_time.high)))
+                    return "filtered_replication_wait_time: integer|Long expected";
+            return null;
+        };
+
+        /**
+         * Creates a VDiffOptions message from a plain object. Also converts values to their respective internal types.
+         * @function fromObject
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @static
+         * @param {Object.<string,*>} object Plain object
+         * @returns {tabletmanagerdata.VDiffOptions} VDiffOptions
+         */
+        VDiffOptions.fromObject = function fromObject(object) {
+            if (object instanceof $root.tabletmanagerdata.VDiffOptions)
+                return object;
+            var message = new $root.tabletmanagerdata.VDiffOptions();
+            if (object.source_cell != null)
+                message.source_cell = String(object.source_cell);
+            if (object.tablet_types != null)
+                message.tablet_types = String(object.tablet_types);
+            if (object.tables != null)
+                message.tables = String(object.tables);
+            if (object.max_rows != null)
+                if ($util.Long)
+                    (message.max_rows = $util.Long.fromValue(object.max_rows)).unsigned = false;
+                else if (typeof object.max_rows === "string")
+                    message.max_rows = parseInt(object.max_rows, 10);
+                else if (typeof object.max_rows === "number")
+                    message.max_rows = object.max_rows;
+                else if (typeof object.max_rows === "object")
+                    message.max_rows = new $util.LongBits(object.max_rows.low >>> 0, object.max_rows.high >>> 0).toNumber();
+            if (object.filtered_replication_wait_time != null)
+                if ($util.Long)
+                    (message.filtered_replication_wait_time = $util.Long.fromValue(object.filtered_replication_wait_time)).unsigned = false;
+                else if (typeof object.filtered_replication_wait_time === "string")
+                    message.filtered_replication_wait_time = parseInt(object.filtered_replication_wait_time, 10);
+                else if (typeof object.filtered_replication_wait_time === "number")
+                    message.filtered_replication_wait_time = object.filtered_replication_wait_time;
+                else if (typeof object.filtered_replication_wait_time === "object")
+                    message.filtered_replication_wait_time = new $util.LongBits(object.filtered_replication_wait_time.low >>> 0, object.filtered_replication_wait_time.high >>> 0).toNumber();
+            return message;
+        };
+
+        /**
+         * Creates a plain object from a VDiffOptions message. Also converts values to other types if specified.
+         * @function toObject
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @static
+         * @param {tabletmanagerdata.VDiffOptions} message VDiffOptions
+         * @param {$protobuf.IConversionOptions} [options] Conversion options
+         * @returns {Object.<string,*>} Plain object
+         */
+        VDiffOptions.toObject = function toObject(message, options) {
+            if (!options)
+                options = {};
+            var object = {};
+            if (options.defaults) {
+                object.source_cell = "";
+                object.tablet_types = "";
+                object.tables = "";
+                if ($util.Long) {
+                    var long = new $util.Long(0, 0, false);
+                    object.max_rows = options.longs === String ? long.toString() : options.longs === Number ? long.toNumber() : long;
+                } else
+                    object.max_rows = options.longs === String ? "0" : 0;
+                if ($util.Long) {
+                    var long = new $util.Long(0, 0, false);
+                    object.filtered_replication_wait_time = options.longs === String ? long.toString() : options.longs === Number ? long.toNumber() : long;
+                } else
+                    object.filtered_replication_wait_time = options.longs === String ? "0" : 0;
+            }
+            if (message.source_cell != null && message.hasOwnProperty("source_cell"))
+                object.source_cell = message.source_cell;
+            if (message.tablet_types != null && message.hasOwnProperty("tablet_types"))
+                object.tablet_types = message.tablet_types;
+            if (message.tables != null && message.hasOwnProperty("tables"))
+                object.tables = message.tables;
+            if (message.max_rows != null && message.hasOwnProperty("max_rows"))
+                if (typeof message.max_rows === "number")
+                    object.max_rows = options.longs === String ? String(message.max_rows) : message.max_rows;
+                else
+                    object.max_rows = options.longs === String ? $util.Long.prototype.toString.call(message.max_rows) : options.longs === Number ? new $util.LongBits(message.max_rows.low >>> 0, message.max_rows.high >>> 0).toNumber() : message.max_rows;
+            if (message.filtered_replication_wait_time != null && message.hasOwnProperty("filtered_replication_wait_time"))
+                if (typeof message.filtered_replication_wait_time === "number")
+                    object.filtered_replication_wait_time = options.longs === String ? String(message.filtered_replication_wait_time) : message.filtered_replication_wait_time;
+                else
+                    object.filtered_replication_wait_time = options.longs === String ? $util.Long.prototype.toString.call(message.filtered_replication_wait_time) : options.longs === Number ? new $util.LongBits(message.filtered_replication_wait_time.low >>> 0, message.filtered_replication_wait_time.high >>> 0).toNumber() : message.filtered_replication_wait_time;
+            return object;
+        };
+
+        /**
+         * Converts this VDiffOptions to JSON.
+         * @function toJSON
+         * @memberof tabletmanagerdata.VDiffOptions
+         * @instance
+         * @returns {Object.<string,*>} JSON object
+         */
+        VDiffOptions.prototype.toJSON = function toJSON() {
+            return this.constructor.toObject(this, $protobuf.util.toJSONOptions);
+        };
+
+        return VDiffOptions;
+    })();
+
+    tabletmanagerdata.VDiffRequest = (function() {
+
+        /**
+         * Properties of a VDiffRequest.
+         * @memberof tabletmanagerdata
+         * @interface IVDiffRequest
+         * @property {string|null} [keyspace] VDiffRequest keyspace
+         * @property {string|null} [workflow] VDiffRequest workflow
+         * @property {string|null} [action] VDiffRequest action
+         * @property {string|null} [action_arg] VDiffRequest action_arg
+         * @property {string|null} [vdiff_uuid] VDiffRequest vdiff_uuid
+         * @property {tabletmanagerdata.IVDiffOptions|null} [options] VDiffRequest options
+         */
+
+        /**
+         * Constructs a new VDiffRequest.
+         * @memberof tabletmanagerdata
+         * @classdesc Represents a VDiffRequest.
+         * @implements IVDiffRequest
+         * @constructor
+         * @param {tabletmanagerdata.IVDiffRequest=} [properties] Properties to set
+         */
+        function VDiffRequest(properties) {
+            if (properties)
+                for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                    if (properties[keys[i]] != null)
+                        this[keys[i]] = properties[keys[i]];
+        }
+
+        /**
+         * VDiffRequest keyspace.
+         * @member {string} keyspace
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @instance
+         */
+        VDiffRequest.prototype.keyspace = "";
+
+        /**
+         * VDiffRequest workflow.
+         * @member {string} workflow
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @instance
+         */
+        VDiffRequest.prototype.workflow = "";
+
+        /**
+         * VDiffRequest action.
+         * @member {string} action
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @instance
+         */
+        VDiffRequest.prototype.action = "";
+
+        /**
+         * VDiffRequest action_arg.
+         * @member {string} action_arg
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @instance
+         */
+        VDiffRequest.prototype.action_arg = "";
+
+        /**
+         * VDiffRequest vdiff_uuid.
+         * @member {string} vdiff_uuid
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @instance
+         */
+        VDiffRequest.prototype.vdiff_uuid = "";
+
+        /**
+         * VDiffRequest options.
+         * @member {tabletmanagerdata.IVDiffOptions|null|undefined} options
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @instance
+         */
+        VDiffRequest.prototype.options = null;
+
+        /**
+         * Creates a new VDiffRequest instance using the specified properties.
+         * @function create
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @static
+         * @param {tabletmanagerdata.IVDiffRequest=} [properties] Properties to set
+         * @returns {tabletmanagerdata.VDiffRequest} VDiffRequest instance
+         */
+        VDiffRequest.create = function create(properties) {
+            return new VDiffRequest(properties);
+        };
+
+        /**
+         * Encodes the specified VDiffRequest message. Does not implicitly {@link tabletmanagerdata.VDiffRequest.verify|verify} messages.
+         * @function encode
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @static
+         * @param {tabletmanagerdata.IVDiffRequest} message VDiffRequest message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        VDiffRequest.encode = function encode(message, writer) {
+            if (!writer)
+                writer = $Writer.create();
+            if (message.keyspace != null && Object.hasOwnProperty.call(message, "keyspace"))
+                writer.uint32(/* id 1, wireType 2 =*/10).string(message.keyspace);
+            if (message.workflow != null && Object.hasOwnProperty.call(message, "workflow"))
+                writer.uint32(/* id 2, wireType 2 =*/18).string(message.workflow);
+            if (message.action != null && Object.hasOwnProperty.call(message, "action"))
+                writer.uint32(/* id 3, wireType 2 =*/26).string(message.action);
+            if (message.action_arg != null && Object.hasOwnProperty.call(message, "action_arg"))
+                writer.uint32(/* id 4, wireType 2 =*/34).string(message.action_arg);
+            if (message.vdiff_uuid != null && Object.hasOwnProperty.call(message, "vdiff_uuid"))
+                writer.uint32(/* id 5, wireType 2 =*/42).string(message.vdiff_uuid);
+            if (message.options != null && Object.hasOwnProperty.call(message, "options"))
+                $root.tabletmanagerdata.VDiffOptions.encode(message.options, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
+            return writer;
+        };
+
+        /**
+         * Encodes the specified VDiffRequest message, length delimited. Does not implicitly {@link tabletmanagerdata.VDiffRequest.verify|verify} messages.
+         * @function encodeDelimited
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @static
+         * @param {tabletmanagerdata.IVDiffRequest} message VDiffRequest message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        VDiffRequest.encodeDelimited = function encodeDelimited(message, writer) {
+            return this.encode(message, writer).ldelim();
+        };
+
+        /**
+         * Decodes a VDiffRequest message from the specified reader or buffer.
+         * @function decode
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @param {number} [length] Message length if known beforehand
+         * @returns {tabletmanagerdata.VDiffRequest} VDiffRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        VDiffRequest.decode = function decode(reader, length) {
+            if (!(reader instanceof $Reader))
+                reader = $Reader.create(reader);
+            var end = length === undefined ? reader.len : reader.pos + length, message = new $root.tabletmanagerdata.VDiffRequest();
+            while (reader.pos < end) {
+                var tag = reader.uint32();
+                switch (tag >>> 3) {
+                case 1:
+                    message.keyspace = reader.string();
+                    break;
+                case 2:
+                    message.workflow = reader.string();
+                    break;
+                case 3:
+                    message.action = reader.string();
+                    break;
+                case 4:
+                    message.action_arg = reader.string();
+                    break;
+                case 5:
+                    message.vdiff_uuid = reader.string();
+                    break;
+                case 6:
+                    message.options = $root.tabletmanagerdata.VDiffOptions.decode(reader, reader.uint32());
+                    break;
+                default:
+                    reader.skipType(tag & 7);
+                    break;
+                }
+            }
+            return message;
+        };
+
+        /**
+         * Decodes a VDiffRequest message from the specified reader or buffer, length delimited.
+         * @function decodeDelimited
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @returns {tabletmanagerdata.VDiffRequest} VDiffRequest
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        VDiffRequest.decodeDelimited = function decodeDelimited(reader) {
+            if (!(reader instanceof $Reader))
+                reader = new $Reader(reader);
+            return this.decode(reader, reader.uint32());
+        };
+
+        /**
+         * Verifies a VDiffRequest message.
+         * @function verify
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @static
+         * @param {Object.<string,*>} message Plain object to verify
+         * @returns {string|null} `null` if valid, otherwise the reason why it is not
+         */
+        VDiffRequest.verify = function verify(message) {
+            if (typeof message !== "object" || message === null)
+                return "object expected";
+            if (message.keyspace != null && message.hasOwnProperty("keyspace"))
+                if (!$util.isString(message.keyspace))
+                    return "keyspace: string expected";
+            if (message.workflow != null && message.hasOwnProperty("workflow"))
+                if (!$util.isString(message.workflow))
+                    return "workflow: string expected";
+            if (message.action != null && message.hasOwnProperty("action"))
+                if (!$util.isString(message.action))
+                    return "action: string expected";
+            if (message.action_arg != null && message.hasOwnProperty("action_arg"))
+                if (!$util.isString(message.action_arg))
+                    return "action_arg: string expected";
+            if (message.vdiff_uuid != null && message.hasOwnProperty("vdiff_uuid"))
+                if (!$util.isString(message.vdiff_uuid))
+                    return "vdiff_uuid: string expected";
+            if (message.options != null && message.hasOwnProperty("options")) {
+                var error = $root.tabletmanagerdata.VDiffOptions.verify(message.options);
+                if (error)
+                    return "options." + error;
+            }
+            return null;
+        };
+
+        /**
+         * Creates a VDiffRequest message from a plain object. Also converts values to their respective internal types.
+         * @function fromObject
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @static
+         * @param {Object.<string,*>} object Plain object
+         * @returns {tabletmanagerdata.VDiffRequest} VDiffRequest
+         */
+        VDiffRequest.fromObject = function fromObject(object) {
+            if (object instanceof $root.tabletmanagerdata.VDiffRequest)
+                return object;
+            var message = new $root.tabletmanagerdata.VDiffRequest();
+            if (object.keyspace != null)
+                message.keyspace = String(object.keyspace);
+            if (object.workflow != null)
+                message.workflow = String(object.workflow);
+            if (object.action != null)
+                message.action = String(object.action);
+            if (object.action_arg != null)
+                message.action_arg = String(object.action_arg);
+            if (object.vdiff_uuid != null)
+                message.vdiff_uuid = String(object.vdiff_uuid);
+            if (object.options != null) {
+                if (typeof object.options !== "object")
+                    throw TypeError(".tabletmanagerdata.VDiffRequest.options: object expected");
+                message.options = $root.tabletmanagerdata.VDiffOptions.fromObject(object.options);
+            }
+            return message;
+        };
+
+        /**
+         * Creates a plain object from a VDiffRequest message. Also converts values to other types if specified.
+         * @function toObject
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @static
+         * @param {tabletmanagerdata.VDiffRequest} message VDiffRequest
+         * @param {$protobuf.IConversionOptions} [options] Conversion options
+         * @returns {Object.<string,*>} Plain object
+         */
+        VDiffRequest.toObject = function toObject(message, options) {
+            if (!options)
+                options = {};
+            var object = {};
+            if (options.defaults) {
+                object.keyspace = "";
+                object.workflow = "";
+                object.action = "";
+                object.action_arg = "";
+                object.vdiff_uuid = "";
+                object.options = null;
+            }
+            if (message.keyspace != null && message.hasOwnProperty("keyspace"))
+                object.keyspace = message.keyspace;
+            if (message.workflow != null && message.hasOwnProperty("workflow"))
+                object.workflow = message.workflow;
+            if (message.action != null && message.hasOwnProperty("action"))
+                object.action = message.action;
+            if (message.action_arg != null && message.hasOwnProperty("action_arg"))
+                object.action_arg = message.action_arg;
+            if (message.vdiff_uuid != null && message.hasOwnProperty("vdiff_uuid"))
+                object.vdiff_uuid = message.vdiff_uuid;
+            if (message.options != null && message.hasOwnProperty("options"))
+                object.options = $root.tabletmanagerdata.VDiffOptions.toObject(message.options, options);
+            return object;
+        };
+
+        /**
+         * Converts this VDiffRequest to JSON.
+         * @function toJSON
+         * @memberof tabletmanagerdata.VDiffRequest
+         * @instance
+         * @returns {Object.<string,*>} JSON object
+         */
+        VDiffRequest.prototype.toJSON = function toJSON() {
+            return this.constructor.toObject(this, $protobuf.util.toJSONOptions);
+        };
+
+        return VDiffRequest;
+    })();
+
+    tabletmanagerdata.VDiffResponse = (function() {
+
+        /**
+         * Properties of a VDiffResponse.
+         * @memberof tabletmanagerdata
+         * @interface IVDiffResponse
+         * @property {number|Long|null} [id] VDiffResponse id
+         * @property {query.IQueryResult|null} [output] VDiffResponse output
+         * @property {string|null} [vdiff_uuid] VDiffResponse vdiff_uuid
+         */
+
+        /**
+         * Constructs a new VDiffResponse.
+         * @memberof tabletmanagerdata
+         * @classdesc Represents a VDiffResponse.
+         * @implements IVDiffResponse
+         * @constructor
+         * @param {tabletmanagerdata.IVDiffResponse=} [properties] Properties to set
+         */
+        function VDiffResponse(properties) {
+            if (properties)
+                for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                    if (properties[keys[i]] != null)
+                        this[keys[i]] = properties[keys[i]];
+        }
+
+        /**
+         * VDiffResponse id.
+         * @member {number|Long} id
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @instance
+         */
+        VDiffResponse.prototype.id = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+
+        /**
+         * VDiffResponse output.
+         * @member {query.IQueryResult|null|undefined} output
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @instance
+         */
+        VDiffResponse.prototype.output = null;
+
+        /**
+         * VDiffResponse vdiff_uuid.
+         * @member {string} vdiff_uuid
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @instance
+         */
+        VDiffResponse.prototype.vdiff_uuid = "";
+
+        /**
+         * Creates a new VDiffResponse instance using the specified properties.
+         * @function create
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @static
+         * @param {tabletmanagerdata.IVDiffResponse=} [properties] Properties to set
+         * @returns {tabletmanagerdata.VDiffResponse} VDiffResponse instance
+         */
+        VDiffResponse.create = function create(properties) {
+            return new VDiffResponse(properties);
+        };
+
+        /**
+         * Encodes the specified VDiffResponse message. Does not implicitly {@link tabletmanagerdata.VDiffResponse.verify|verify} messages.
+         * @function encode
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @static
+         * @param {tabletmanagerdata.IVDiffResponse} message VDiffResponse message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        VDiffResponse.encode = function encode(message, writer) {
+            if (!writer)
+                writer = $Writer.create();
+            if (message.id != null && Object.hasOwnProperty.call(message, "id"))
+                writer.uint32(/* id 1, wireType 0 =*/8).int64(message.id);
+            if (message.output != null && Object.hasOwnProperty.call(message, "output"))
+                $root.query.QueryResult.encode(message.output, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+            if (message.vdiff_uuid != null && Object.hasOwnProperty.call(message, "vdiff_uuid"))
+                writer.uint32(/* id 3, wireType 2 =*/26).string(message.vdiff_uuid);
+            return writer;
+        };
+
+        /**
+         * Encodes the specified VDiffResponse message, length delimited. Does not implicitly {@link tabletmanagerdata.VDiffResponse.verify|verify} messages.
+         * @function encodeDelimited
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @static
+         * @param {tabletmanagerdata.IVDiffResponse} message VDiffResponse message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        VDiffResponse.encodeDelimited = function encodeDelimited(message, writer) {
+            return this.encode(message, writer).ldelim();
+        };
+
+        /**
+         * Decodes a VDiffResponse message from the specified reader or buffer.
+         * @function decode
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @param {number} [length] Message length if known beforehand
+         * @returns {tabletmanagerdata.VDiffResponse} VDiffResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        VDiffResponse.decode = function decode(reader, length) {
+            if (!(reader instanceof $Reader))
+                reader = $Reader.create(reader);
+            var end = length === undefined ? reader.len : reader.pos + length, message = new $root.tabletmanagerdata.VDiffResponse();
+            while (reader.pos < end) {
+                var tag = reader.uint32();
+                switch (tag >>> 3) {
+                case 1:
+                    message.id = reader.int64();
+                    break;
+                case 2:
+                    message.output = $root.query.QueryResult.decode(reader, reader.uint32());
+                    break;
+                case 3:
+                    message.vdiff_uuid = reader.string();
+                    break;
+                default:
+                    reader.skipType(tag & 7);
+                    break;
+                }
+            }
+            return message;
+        };
+
+        /**
+         * Decodes a VDiffResponse message from the specified reader or buffer, length delimited.
+         * @function decodeDelimited
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @returns {tabletmanagerdata.VDiffResponse} VDiffResponse
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        VDiffResponse.decodeDelimited = function decodeDelimited(reader) {
+            if (!(reader instanceof $Reader))
+                reader = new $Reader(reader);
+            return this.decode(reader, reader.uint32());
+        };
+
+        /**
+         * Verifies a VDiffResponse message.
+         * @function verify
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @static
+         * @param {Object.<string,*>} message Plain object to verify
+         * @returns {string|null} `null` if valid, otherwise the reason why it is not
+         */
+        VDiffResponse.verify = function verify(message) {
+            if (typeof message !== "object" || message === null)
+                return "object expected";
+            if (message.id != null && message.hasOwnProperty("id"))
+                if (!$util.isInteger(message.id) && !(message.id && $util.isInteger(message.id.low) && $util.isInteger(message.id.high)))
+                    return "id: integer|Long expected";
+            if (message.output != null && message.hasOwnProperty("output")) {
+                var error = $root.query.QueryResult.verify(message.output);
+                if (error)
+                    return "output." + error;
+            }
+            if (message.vdiff_uuid != null && message.hasOwnProperty("vdiff_uuid"))
+                if (!$util.isString(message.vdiff_uuid))
+                    return "vdiff_uuid: string expected";
+            return null;
+        };
+
+        /**
+         * Creates a VDiffResponse message from a plain object. Also converts values to their respective internal types.
+         * @function fromObject
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @static
+         * @param {Object.<string,*>} object Plain object
+         * @returns {tabletmanagerdata.VDiffResponse} VDiffResponse
+         */
+        VDiffResponse.fromObject = function fromObject(object) {
+            if (object instanceof $root.tabletmanagerdata.VDiffResponse)
+                return object;
+            var message = new $root.tabletmanagerdata.VDiffResponse();
+            if (object.id != null)
+                if ($util.Long)
+                    (message.id = $util.Long.fromValue(object.id)).unsigned = false;
+                else if (typeof object.id === "string")
+                    message.id = parseInt(object.id, 10);
+                else if (typeof object.id === "number")
+                    message.id = object.id;
+                else if (typeof object.id === "object")
+                    message.id = new $util.LongBits(object.id.low >>> 0, object.id.high >>> 0).toNumber();
+            if (object.output != null) {
+                if (typeof object.output !== "object")
+                    throw TypeError(".tabletmanagerdata.VDiffResponse.output: object expected");
+                message.output = $root.query.QueryResult.fromObject(object.output);
+            }
+            if (object.vdiff_uuid != null)
+                message.vdiff_uuid = String(object.vdiff_uuid);
+            return message;
+        };
+
+        /**
+         * Creates a plain object from a VDiffResponse message. Also converts values to other types if specified.
+         * @function toObject
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @static
+         * @param {tabletmanagerdata.VDiffResponse} message VDiffResponse
+         * @param {$protobuf.IConversionOptions} [options] Conversion options
+         * @returns {Object.<string,*>} Plain object
+         */
+        VDiffResponse.toObject = function toObject(message, options) {
+            if (!options)
+                options = {};
+            var object = {};
+            if (options.defaults) {
+                if ($util.Long) {
+                    var long = new $util.Long(0, 0, false);
+                    object.id = options.longs === String ? long.toString() : options.longs === Number ? long.toNumber() : long;
+                } else
+                    object.id = options.longs === String ? "0" : 0;
+                object.output = null;
+                object.vdiff_uuid = "";
+            }
+            if (message.id != null && message.hasOwnProperty("id"))
+                if (typeof message.id === "number")
+                    object.id = options.longs === String ? String(message.id) : message.id;
+                else
+                    object.id = options.longs === String ? $util.Long.prototype.toString.call(message.id) : options.longs === Number ? new $util.LongBits(message.id.low >>> 0, message.id.high >>> 0).toNumber() : message.id;
+            if (message.output != null && message.hasOwnProperty("output"))
+                object.output = $root.query.QueryResult.toObject(message.output, options);
+            if (message.vdiff_uuid != null && message.hasOwnProperty("vdiff_uuid"))
+                object.vdiff_uuid = message.vdiff_uuid;
+            return object;
+        };
+
+        /**
+         * Converts this VDiffResponse to JSON.
+         * @function toJSON
+         * @memberof tabletmanagerdata.VDiffResponse
+         * @instance
+         * @returns {Object.<string,*>} JSON object
+         */
+        VDiffResponse.prototype.toJSON = function toJSON() {
+            return this.constructor.toObject(this, $protobuf.util.toJSONOptions);
+        };
+
+        return VDiffResponse;
+    })();
+
     return tabletmanagerdata;
 })();
 
@@ -28543,6 +30087,7 @@ $root.query = (function() {
          * @property {boolean|null} [skip_query_plan_cache] ExecuteOptions skip_query_plan_cache
          * @property {query.ExecuteOptions.PlannerVersion|null} [planner_version] ExecuteOptions planner_version
          * @property {boolean|null} [has_created_temp_tables] ExecuteOptions has_created_temp_tables
+         * @property {query.ExecuteOptions.Priority|null} [priority] ExecuteOptions priority
          */
 
         /**
@@ -28624,6 +30169,14 @@ $root.query = (function() {
          */
         ExecuteOptions.prototype.has_created_temp_tables = false;
 
+        /**
+         * ExecuteOptions priority.
+         * @member {query.ExecuteOptions.Priority} priority
+         * @memberof query.ExecuteOptions
+         * @instance
+         */
+        ExecuteOptions.prototype.priority = 0;
+
         /**
          * Creates a new ExecuteOptions instance using the specified properties.
          * @function create
@@ -28664,6 +30217,8 @@ $root.query = (function() {
                 writer.uint32(/* id 11, wireType 0 =*/88).int32(message.planner_version);
             if (message.has_created_temp_tables != null && Object.hasOwnProperty.call(message, "has_created_temp_tables"))
                 writer.uint32(/* id 12, wireType 0 =*/96).bool(message.has_created_temp_tables);
+            if (message.priority != null && Object.hasOwnProperty.call(message, "priority"))
+                writer.uint32(/* id 13, wireType 0 =*/104).int32(message.priority);
             return writer;
         };
 
@@ -28722,6 +30277,9 @@ $root.query = (function() {
                 case 12:
                     message.has_created_temp_tables = reader.bool();
                     break;
+                case 13:
+                    message.priority = reader.int32();
+                    break;
                 default:
                     reader.skipType(tag & 7);
                     break;
@@ -28813,6 +30371,15 @@ $root.query = (function() {
             if (message.has_created_temp_tables != null && message.hasOwnProperty("has_created_temp_tables"))
                 if (typeof message.has_created_temp_tables !== "boolean")
                     return "has_created_temp_tables: boolean expected";
+            if (message.priority != null && message.hasOwnProperty("priority"))
+                switch (message.priority) {
+                default:
+                    return "priority: enum value expected";
+                case 0:
+                case 1:
+                case 2:
+                    break;
+                }
             return null;
         };
 
@@ -28931,6 +30498,20 @@ $root.query = (function() {
             }
             if (object.has_created_temp_tables != null)
                 message.has_created_temp_tables = Boolean(object.has_created_temp_tables);
+            switch (object.priority) {
+            case "NORMAL":
+            case 0:
+                message.priority = 0;
+                break;
+            case "HIGH":
+            case 1:
+                message.priority = 1;
+                break;
+            case "LOW":
+            case 2:
+                message.priority = 2;
+                break;
+            }
             return message;
         };
 
@@ -28960,6 +30541,7 @@ $root.query = (function() {
                 object.skip_query_plan_cache = false;
                 object.planner_version = options.enums === String ? "DEFAULT_PLANNER" : 0;
                 object.has_created_temp_tables = false;
+                object.priority = options.enums === String ? "NORMAL" : 0;
             }
             if (message.included_fields != null && message.hasOwnProperty("included_fields"))
                 object.included_fields = options.enums === String ? $root.query.ExecuteOptions.IncludedFields[message.included_fields] : message.included_fields;
@@ -28980,6 +30562,8 @@ $root.query = (function() {
                 object.planner_version = options.enums === String ? $root.query.ExecuteOptions.PlannerVersion[message.planner_version] : message.planner_version;
             if (message.has_created_temp_tables != null && message.hasOwnProperty("has_created_temp_tables"))
                 object.has_created_temp_tables = message.has_created_temp_tables;
+            if (message.priority != null && message.hasOwnProperty("priority"))
+                object.priority = options.enums === String ? $root.query.ExecuteOptions.Priority[message.priority] : message.priority;
             return object;
         };
 
@@ -29074,6 +30658,22 @@ $root.query = (function() {
             return values;
         })();
 
+        /**
+         * Priority enum.
+         * @name query.ExecuteOptions.Priority
+         * @enum {number}
+         * @property {number} NORMAL=0 NORMAL value
+         * @property {number} HIGH=1 HIGH value
+         * @property {number} LOW=2 LOW value
+         */
+        ExecuteOptions.Priority = (function() {
+            var valuesById = {}, values = Object.create(valuesById);
+            values[valuesById[0] = "NORMAL"] = 0;
+            values[valuesById[1] = "HIGH"] = 1;
+            values[valuesById[2] = "LOW"] = 2;
+            return values;
+        })();
+
         return ExecuteOptions;
     })();
 
@@ -39366,6 +40966,7 @@ $root.query = (function() {
          * @property {query.IVTGateCallerID|null} [immediate_caller_id] MessageStreamRequest immediate_caller_id
          * @property {query.ITarget|null} [target] MessageStreamRequest target
          * @property {string|null} [name] MessageStreamRequest name
+         * @property {string|null} [group] MessageStreamRequest group
          */
 
         /**
@@ -39415,6 +41016,14 @@ $root.query = (function() {
          */
         MessageStreamRequest.prototype.name = "";
 
+        /**
+         * MessageStreamRequest group.
+         * @member {string} group
+         * @memberof query.MessageStreamRequest
+         * @instance
+         */
+        MessageStreamRequest.prototype.group = "";
+
         /**
          * Creates a new MessageStreamRequest instance using the specified properties.
          * @function create
@@ -39447,6 +41056,8 @@ $root.query = (function() {
                 $root.query.Target.encode(message.target, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
             if (message.name != null && Object.hasOwnProperty.call(message, "name"))
                 writer.uint32(/* id 4, wireType 2 =*/34).string(message.name);
+            if (message.group != null && Object.hasOwnProperty.call(message, "group"))
+                writer.uint32(/* id 5, wireType 2 =*/42).string(message.group);
             return writer;
         };
 
@@ -39493,6 +41104,9 @@ $root.query = (function() {
                 case 4:
                     message.name = reader.string();
                     break;
+                case 5:
+                    message.group = reader.string();
+                    break;
                 default:
                     reader.skipType(tag & 7);
                     break;
@@ -39546,6 +41160,9 @@ $root.query = (function() {
             if (message.name != null && message.hasOwnProperty("name"))
                 if (!$util.isString(message.name))
                     return "name: string expected";
+            if (message.group != null && message.hasOwnProperty("group"))
+                if (!$util.isString(message.group))
+                    return "group: string expected";
             return null;
         };
 
@@ -39578,6 +41195,8 @@ $root.query = (function() {
             }
             if (object.name != null)
                 message.name = String(object.name);
+            if (object.group != null)
+                message.group = String(object.group);
             return message;
         };
 
@@ -39599,6 +41218,7 @@ $root.query = (function() {
                 object.immediate_caller_id = null;
                 object.target = null;
                 object.name = "";
+                object.group = "";
             }
             if (message.effective_caller_id != null && message.hasOwnProperty("effective_caller_id"))
                 object.effective_caller_id = $root.vtrpc.CallerID.toObject(message.effective_caller_id, options);
@@ -39608,6 +41228,8 @@ $root.query = (function() {
                 object.target = $root.query.Target.toObject(message.target, options);
             if (message.name != null && message.hasOwnProperty("name"))
                 object.name = message.name;
+            if (message.group != null && message.hasOwnProperty("group"))
+                object.group = message.group;
             return object;
         };
 
@@ -39828,6 +41450,7 @@ $root.query = (function() {
          * @property {query.ITarget|null} [target] MessageAckRequest target
          * @property {string|null} [name] MessageAckRequest name
          * @property {Array.<query.IValue>|null} [ids] MessageAckRequest ids
+         * @property {string|null} [group] MessageAckRequest group
          */
 
         /**
@@ -39886,6 +41509,14 @@ $root.query = (function() {
          */
         MessageAckRequest.prototype.ids = $util.emptyArray;
 
+        /**
+         * MessageAckRequest group.
+         * @member {string} group
+         * @memberof query.MessageAckRequest
+         * @instance
+         */
+        MessageAckRequest.prototype.group = "";
+
         /**
          * Creates a new MessageAckRequest instance using the specified properties.
          * @function create
@@ -39921,6 +41552,8 @@ $root.query = (function() {
             if (message.ids != null && message.ids.length)
                 for (var i = 0; i < message.ids.length; ++i)
                     $root.query.Value.encode(message.ids[i], writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
+            if (message.group != null && Object.hasOwnProperty.call(message, "group"))
+                writer.uint32(/* id 6, wireType 2 =*/50).string(message.group);
             return writer;
         };
 
@@ -39972,6 +41605,9 @@ $root.query = (function() {
                         message.ids = [];
                     message.ids.push($root.query.Value.decode(reader, reader.uint32()));
                     break;
+                case 6:
+                    message.group = reader.string();
+                    break;
                 default:
                     reader.skipType(tag & 7);
                     break;
@@ -40034,6 +41670,9 @@ $root.query = (function() {
                         return "ids." + error;
                 }
             }
+            if (message.group != null && message.hasOwnProperty("group"))
+                if (!$util.isString(message.group))
+                    return "group: string expected";
             return null;
         };
 
@@ -40076,6 +41715,8 @@ $root.query = (function() {
                     message.ids[i] = $root.query.Value.fromObject(object.ids[i]);
                 }
             }
+            if (object.group != null)
+                message.group = String(object.group);
             return message;
         };
 
@@ -40099,6 +41740,7 @@ $root.query = (function() {
                 object.immediate_caller_id = null;
                 object.target = null;
                 object.name = "";
+                object.group = "";
             }
             if (message.effective_caller_id != null && message.hasOwnProperty("effective_caller_id"))
                 object.effective_caller_id = $root.vtrpc.CallerID.toObject(message.effective_caller_id, options);
@@ -40113,6 +41755,8 @@ $root.query = (function() {
                 for (var j = 0; j < message.ids.length; ++j)
                     object.ids[j] = $root.query.Value.toObject(message.ids[j], options);
             }
+            if (message.group != null && message.hasOwnProperty("group"))
+                object.group = message.group;
             return object;
         };
 
@@ -42291,6 +43935,7 @@ $root.query = (function() {
          * @property {number|Long|null} [seconds_behind_master_filtered_replication] RealtimeStats seconds_behind_master_filtered_replication
          * @property {number|null} [cpu_usage] RealtimeStats cpu_usage
          * @property {number|null} [qps] RealtimeStats qps
+         * @property {Array.<string>|null} [table_schema_changed] RealtimeStats table_schema_changed
          */
 
         /**
@@ -42302,6 +43947,7 @@ $root.query = (function() {
          * @param {query.IRealtimeStats=} [properties] Properties to set
          */
         function RealtimeStats(properties) {
+            this.table_schema_changed = [];
             if (properties)
                 for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                     if (properties[keys[i]] != null)
@@ -42356,6 +44002,14 @@ $root.query = (function() {
          */
         RealtimeStats.prototype.qps = 0;
 
+        /**
+         * RealtimeStats table_schema_changed.
+         * @member {Array.<string>} table_schema_changed
+         * @memberof query.RealtimeStats
+         * @instance
+         */
+        RealtimeStats.prototype.table_schema_changed = $util.emptyArray;
+
         /**
          * Creates a new RealtimeStats instance using the specified properties.
          * @function create
@@ -42392,6 +44046,9 @@ $root.query = (function() {
                 writer.uint32(/* id 5, wireType 1 =*/41).double(message.cpu_usage);
             if (message.qps != null && Object.hasOwnProperty.call(message, "qps"))
                 writer.uint32(/* id 6, wireType 1 =*/49).double(message.qps);
+            if (message.table_schema_changed != null && message.table_schema_changed.length)
+                for (var i = 0; i < message.table_schema_changed.length; ++i)
+                    writer.uint32(/* id 7, wireType 2 =*/58).string(message.table_schema_changed[i]);
             return writer;
         };
 
@@ -42444,6 +44101,11 @@ $root.query = (function() {
                 case 6:
                     message.qps = reader.double();
                     break;
+                case 7:
+                    if (!(message.table_schema_changed && message.table_schema_changed.length))
+                        message.table_schema_changed = [];
+                    message.table_schema_changed.push(reader.string());
+                    break;
                 default:
                     reader.skipType(tag & 7);
                     break;
@@ -42497,6 +44159,13 @@ $root.query = (function() {
             if (message.qps != null && message.hasOwnProperty("qps"))
                 if (typeof message.qps !== "number")
                     return "qps: number expected";
+            if (message.table_schema_changed != null && message.hasOwnProperty("table_schema_changed")) {
+                if (!Array.isArray(message.table_schema_changed))
+                    return "table_schema_changed: array expected";
+                for (var i = 0; i < message.table_schema_changed.length; ++i)
+                    if (!$util.isString(message.table_schema_changed[i]))
+                        return "table_schema_changed: string[] expected";
+            }
             return null;
         };
 
@@ -42531,6 +44200,13 @@ $root.query = (function() {
                 message.cpu_usage = Number(object.cpu_usage);
             if (object.qps != null)
                 message.qps = Number(object.qps);
+            if (object.table_schema_changed) {
+                if (!Array.isArray(object.table_schema_changed))
+                    throw TypeError(".query.RealtimeStats.table_schema_changed: array expected");
+                message.table_schema_changed = [];
+                for (var i = 0; i < object.table_schema_changed.length; ++i)
+                    message.table_schema_changed[i] = String(object.table_schema_changed[i]);
+            }
             return message;
         };
 
@@ -42547,6 +44223,8 @@ $root.query = (function() {
             if (!options)
                 options = {};
             var object = {};
+            if (options.arrays || options.defaults)
+                object.table_schema_changed = [];
             if (options.defaults) {
                 object.health_error = "";
                 object.seconds_behind_master = 0;
@@ -42574,6 +44252,11 @@ $root.query = (function() {
                 object.cpu_usage = options.json && !isFinite(message.cpu_usage) ? String(message.cpu_usage) : message.cpu_usage;
             if (message.qps != null && message.hasOwnProperty("qps"))
                 object.qps = options.json && !isFinite(message.qps) ? String(message.qps) : message.qps;
+            if (message.table_schema_changed && message.table_schema_changed.length) {
+                object.table_schema_changed = [];
+                for (var j = 0; j < message.table_schema_changed.length; ++j)
+                    object.table_schema_changed[j] = message.table_schema_changed[j];
+            }
             return object;
         };
 
@@ -50065,6 +51748,7 @@ $root.vtrpc = (function() {
      * @property {number} INTERNAL=13 INTERNAL value
      * @property {number} UNAVAILABLE=14 UNAVAILABLE value
      * @property {number} DATA_LOSS=15 DATA_LOSS value
+     * @property {number} CLUSTER_EVENT=17 CLUSTER_EVENT value
      */
     vtrpc.Code = (function() {
         var valuesById = {}, values = Object.create(valuesById);
@@ -50085,6 +51769,7 @@ $root.vtrpc = (function() {
         values[valuesById[13] = "INTERNAL"] = 13;
         values[valuesById[14] = "UNAVAILABLE"] = 14;
         values[valuesById[15] = "DATA_LOSS"] = 15;
+        values[valuesById[17] = "CLUSTER_EVENT"] = 17;
         return values;
     })();
 
@@ -50325,6 +52010,7 @@ $root.vtrpc = (function() {
                 case 13:
                 case 14:
                 case 15:
+                case 17:
                     break;
                 }
             return null;
@@ -50467,6 +52153,10 @@ $root.vtrpc = (function() {
             case 15:
                 message.code = 15;
                 break;
+            case "CLUSTER_EVENT":
+            case 17:
+                message.code = 17;
+                break;
             }
             return message;
         };
@@ -52202,6 +53892,7 @@ $root.vschema = (function() {
          * @property {Object.<string,vschema.IVindex>|null} [vindexes] Keyspace vindexes
          * @property {Object.<string,vschema.ITable>|null} [tables] Keyspace tables
          * @property {boolean|null} [require_explicit_routing] Keyspace require_explicit_routing
+         * @property {Object.<string,string>|null} [views] Keyspace views
          */
 
         /**
@@ -52215,6 +53906,7 @@ $root.vschema = (function() {
         function Keyspace(properties) {
             this.vindexes = {};
             this.tables = {};
+            this.views = {};
             if (properties)
                 for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                     if (properties[keys[i]] != null)
@@ -52253,6 +53945,14 @@ $root.vschema = (function() {
          */
         Keyspace.prototype.require_explicit_routing = false;
 
+        /**
+         * Keyspace views.
+         * @member {Object.<string,string>} views
+         * @memberof vschema.Keyspace
+         * @instance
+         */
+        Keyspace.prototype.views = $util.emptyObject;
+
         /**
          * Creates a new Keyspace instance using the specified properties.
          * @function create
@@ -52291,6 +53991,9 @@ $root.vschema = (function() {
                 }
             if (message.require_explicit_routing != null && Object.hasOwnProperty.call(message, "require_explicit_routing"))
                 writer.uint32(/* id 4, wireType 0 =*/32).bool(message.require_explicit_routing);
+            if (message.views != null && Object.hasOwnProperty.call(message, "views"))
+                for (var keys = Object.keys(message.views), i = 0; i < keys.length; ++i)
+                    writer.uint32(/* id 5, wireType 2 =*/42).fork().uint32(/* id 1, wireType 2 =*/10).string(keys[i]).uint32(/* id 2, wireType 2 =*/18).string(message.views[keys[i]]).ldelim();
             return writer;
         };
 
@@ -52375,6 +54078,28 @@ $root.vschema = (function() {
                 case 4:
                     message.require_explicit_routing = reader.bool();
                     break;
+                case 5:
+                    if (message.views === $util.emptyObject)
+                        message.views = {};
+                    var end2 = reader.uint32() + reader.pos;
+                    key = "";
+                    value = "";
+                    while (reader.pos < end2) {
+                        var tag2 = reader.uint32();
+                        switch (tag2 >>> 3) {
+                        case 1:
+                            key = reader.string();
+                            break;
+                        case 2:
+                            value = reader.string();
+                            break;
+                        default:
+                            reader.skipType(tag2 & 7);
+                            break;
+                        }
+                    }
+                    message.views[key] = value;
+                    break;
                 default:
                     reader.skipType(tag & 7);
                     break;
@@ -52436,6 +54161,14 @@ $root.vschema = (function() {
             if (message.require_explicit_routing != null && message.hasOwnProperty("require_explicit_routing"))
                 if (typeof message.require_explicit_routing !== "boolean")
                     return "require_explicit_routing: boolean expected";
+            if (message.views != null && message.hasOwnProperty("views")) {
+                if (!$util.isObject(message.views))
+                    return "views: object expected";
+                var key = Object.keys(message.views);
+                for (var i = 0; i < key.length; ++i)
+                    if (!$util.isString(message.views[key[i]]))
+                        return "views: string{k:string} expected";
+            }
             return null;
         };
 
@@ -52475,6 +54208,13 @@ $root.vschema = (function() {
             }
             if (object.require_explicit_routing != null)
                 message.require_explicit_routing = Boolean(object.require_explicit_routing);
+            if (object.views) {
+                if (typeof object.views !== "object")
+                    throw TypeError(".vschema.Keyspace.views: object expected");
+                message.views = {};
+                for (var keys = Object.keys(object.views), i = 0; i < keys.length; ++i)
+                    message.views[keys[i]] = String(object.views[keys[i]]);
+            }
             return message;
         };
 
@@ -52494,6 +54234,7 @@ $root.vschema = (function() {
             if (options.objects || options.defaults) {
                 object.vindexes = {};
                 object.tables = {};
+                object.views = {};
             }
             if (options.defaults) {
                 object.sharded = false;
@@ -52514,6 +54255,11 @@ $root.vschema = (function() {
             }
             if (message.require_explicit_routing != null && message.hasOwnProperty("require_explicit_routing"))
                 object.require_explicit_routing = message.require_explicit_routing;
+            if (message.views && (keys2 = Object.keys(message.views)).length) {
+                object.views = {};
+                for (var j = 0; j < keys2.length; ++j)
+                    object.views[keys2[j]] = message.views[keys2[j]];
+            }
             return object;
         };
 
@@ -52811,6 +54557,7 @@ $root.vschema = (function() {
          * @property {Array.<vschema.IColumn>|null} [columns] Table columns
          * @property {string|null} [pinned] Table pinned
          * @property {boolean|null} [column_list_authoritative] Table column_list_authoritative
+         * @property {boolean|null} [result_cache] Table result_cache
          */
 
         /**
@@ -52878,6 +54625,14 @@ $root.vschema = (function() {
          */
         Table.prototype.column_list_authoritative = false;
 
+        /**
+         * Table result_cache.
+         * @member {boolean} result_cache
+         * @memberof vschema.Table
+         * @instance
+         */
+        Table.prototype.result_cache = false;
+
         /**
          * Creates a new Table instance using the specified properties.
          * @function create
@@ -52916,6 +54671,8 @@ $root.vschema = (function() {
                 writer.uint32(/* id 5, wireType 2 =*/42).string(message.pinned);
             if (message.column_list_authoritative != null && Object.hasOwnProperty.call(message, "column_list_authoritative"))
                 writer.uint32(/* id 6, wireType 0 =*/48).bool(message.column_list_authoritative);
+            if (message.result_cache != null && Object.hasOwnProperty.call(message, "result_cache"))
+                writer.uint32(/* id 7, wireType 0 =*/56).bool(message.result_cache);
             return writer;
         };
 
@@ -52972,6 +54729,9 @@ $root.vschema = (function() {
                 case 6:
                     message.column_list_authoritative = reader.bool();
                     break;
+                case 7:
+                    message.result_cache = reader.bool();
+                    break;
                 default:
                     reader.skipType(tag & 7);
                     break;
@@ -53039,6 +54799,9 @@ $root.vschema = (function() {
             if (message.column_list_authoritative != null && message.hasOwnProperty("column_list_authoritative"))
                 if (typeof message.column_list_authoritative !== "boolean")
                     return "column_list_authoritative: boolean expected";
+            if (message.result_cache != null && message.hasOwnProperty("result_cache"))
+                if (typeof message.result_cache !== "boolean")
+                    return "result_cache: boolean expected";
             return null;
         };
 
@@ -53085,6 +54848,8 @@ $root.vschema = (function() {
                 message.pinned = String(object.pinned);
             if (object.column_list_authoritative != null)
                 message.column_list_authoritative = Boolean(object.column_list_authoritative);
+            if (object.result_cache != null)
+                message.result_cache = Boolean(object.result_cache);
             return message;
         };
 
@@ -53110,6 +54875,7 @@ $root.vschema = (function() {
                 object.auto_increment = null;
                 object.pinned = "";
                 object.column_list_authoritative = false;
+                object.result_cache = false;
             }
             if (message.type != null && message.hasOwnProperty("type"))
                 object.type = message.type;
@@ -53129,6 +54895,8 @@ $root.vschema = (function() {
                 object.pinned = message.pinned;
             if (message.column_list_authoritative != null && message.hasOwnProperty("column_list_authoritative"))
                 object.column_list_authoritative = message.column_list_authoritative;
+            if (message.result_cache != null && message.hasOwnProperty("result_cache"))
+                object.result_cache = message.result_cache;
             return object;
         };
 